@@ -0,0 +1,209 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+)
+
+// InsertAllOptions changes how the generated InsertAll methods split a
+// slice of rows into batches.
+type InsertAllOptions struct {
+	// BatchSize is the most rows inserted per batch, 0 uses the package's
+	// InsertAllBatchSize and a negative size inserts everything as one batch.
+	BatchSize int
+	// SingleTransaction inserts every batch in one transaction instead of
+	// committing each batch in its own.
+	SingleTransaction bool
+}
+
+// InsertBatches calls insert for rows 0 to n-1 in batches of size, each
+// batch in its own transaction (or all in one with single) when exec can
+// begin transactions. Inside a transaction every distinct query is prepared
+// once and the statement reused for the rest of the transaction.
+//
+// It returns how many rows were inserted for good: the rows of the batches
+// committed before an error, none when everything shares one transaction,
+// and every row before the failing one when exec is already a transaction.
+func InsertBatches(exec Executor, n, size int, single bool, insert func(exec Executor, i int) error) (int, error) {
+	return insertBatches(nil, exec, n, size, single, insert)
+}
+
+// InsertBatchesContext is InsertBatches with a context, which is used to
+// begin the transactions and prepare the statements.
+func InsertBatchesContext(ctx context.Context, exec ContextExecutor, n, size int, single bool, insert func(exec ContextExecutor, i int) error) (int, error) {
+	return insertBatches(ctx, exec, n, size, single, func(exec Executor, i int) error {
+		return insert(exec.(ContextExecutor), i)
+	})
+}
+
+func insertBatches(ctx context.Context, exec Executor, n, size int, single bool, insert func(exec Executor, i int) error) (int, error) {
+	if size <= 0 || single {
+		size = n
+	}
+
+	begin := beginFunc(ctx, exec)
+	if begin == nil {
+		stmts := newStmtExecutor(exec)
+		defer stmts.close()
+		for i := 0; i < n; i++ {
+			if err := insert(stmts.executor(), i); err != nil {
+				return i, err
+			}
+		}
+		return n, nil
+	}
+
+	inserted := 0
+	var tx *sql.Tx
+	var stmts *stmtExecutor
+	for i := 0; i < n; i++ {
+		if tx == nil {
+			var err error
+			if tx, err = begin(); err != nil {
+				return inserted, err
+			}
+			stmts = newStmtExecutor(tx)
+		}
+
+		if err := insert(stmts.executor(), i); err != nil {
+			stmts.close()
+			_ = tx.Rollback()
+			return inserted, err
+		}
+
+		if (i+1)%size == 0 || i == n-1 {
+			stmts.close()
+			if err := tx.Commit(); err != nil {
+				return inserted, err
+			}
+			tx = nil
+			if single {
+				inserted = n
+			} else {
+				inserted = i + 1
+			}
+		}
+	}
+
+	return inserted, nil
+}
+
+// beginFunc returns a function beginning a transaction on exec, nil when
+// exec can't begin one, ex: because it's a transaction itself.
+func beginFunc(ctx context.Context, exec Executor) func() (*sql.Tx, error) {
+	if ctx != nil {
+		if b, ok := exec.(ContextBeginner); ok {
+			return func() (*sql.Tx, error) { return b.BeginTx(ctx, nil) }
+		}
+	}
+	if b, ok := exec.(Beginner); ok {
+		return b.Begin
+	}
+	return nil
+}
+
+type preparer interface {
+	Prepare(query string) (*sql.Stmt, error)
+}
+
+type contextPreparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// stmtExecutor runs queries through statements prepared once per query.
+// When a statement can't be prepared the query runs on the executor.
+type stmtExecutor struct {
+	exec  Executor
+	stmts map[string]*sql.Stmt
+}
+
+func newStmtExecutor(exec Executor) *stmtExecutor {
+	return &stmtExecutor{exec: exec, stmts: make(map[string]*sql.Stmt)}
+}
+
+// executor returns s, or the wrapped executor when it can't prepare
+func (s *stmtExecutor) executor() Executor {
+	switch s.exec.(type) {
+	case preparer, contextPreparer:
+		return s
+	}
+	return s.exec
+}
+
+func (s *stmtExecutor) stmt(ctx context.Context, query string) (*sql.Stmt, error) {
+	if stmt, ok := s.stmts[query]; ok {
+		return stmt, nil
+	}
+
+	var stmt *sql.Stmt
+	var err error
+	if p, ok := s.exec.(contextPreparer); ok && ctx != nil {
+		stmt, err = p.PrepareContext(ctx, query)
+	} else if p, ok := s.exec.(preparer); ok {
+		stmt, err = p.Prepare(query)
+	} else {
+		stmt, err = s.exec.(contextPreparer).PrepareContext(context.Background(), query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.stmts[query] = stmt
+	return stmt, nil
+}
+
+func (s *stmtExecutor) close() {
+	for query, stmt := range s.stmts {
+		_ = stmt.Close()
+		delete(s.stmts, query)
+	}
+}
+
+func (s *stmtExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	stmt, err := s.stmt(nil, query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Exec(args...)
+}
+
+func (s *stmtExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	stmt, err := s.stmt(nil, query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Query(args...)
+}
+
+func (s *stmtExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	stmt, err := s.stmt(nil, query)
+	if err != nil {
+		// sql.Row can't be built with an error, let the executor report it
+		return s.exec.QueryRow(query, args...)
+	}
+	return stmt.QueryRow(args...)
+}
+
+func (s *stmtExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	stmt, err := s.stmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.ExecContext(ctx, args...)
+}
+
+func (s *stmtExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	stmt, err := s.stmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.QueryContext(ctx, args...)
+}
+
+func (s *stmtExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	stmt, err := s.stmt(ctx, query)
+	if err != nil {
+		return s.exec.(ContextExecutor).QueryRowContext(ctx, query, args...)
+	}
+	return stmt.QueryRowContext(ctx, args...)
+}
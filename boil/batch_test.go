@@ -0,0 +1,145 @@
+package boil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestInsertBatches(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// 5 rows in batches of 2 is 3 transactions, each preparing the insert once
+	for _, rows := range []int{2, 2, 1} {
+		mock.ExpectBegin()
+		prep := mock.ExpectPrepare(`INSERT INTO pilots`)
+		for i := 0; i < rows; i++ {
+			prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+	}
+
+	n, err := InsertBatchesContext(context.Background(), db, 5, 2, false, func(exec ContextExecutor, i int) error {
+		_, err := exec.ExecContext(context.Background(), "INSERT INTO pilots (id) VALUES (?)", i)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 {
+		t.Errorf("want 5 rows inserted, got %d", n)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInsertBatchesPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(`INSERT INTO pilots`)
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	prep = mock.ExpectPrepare(`INSERT INTO pilots`)
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WillReturnError(errors.New("duplicate key"))
+	mock.ExpectRollback()
+
+	n, err := InsertBatches(db, 5, 2, false, func(exec Executor, i int) error {
+		_, err := exec.Exec("INSERT INTO pilots (id) VALUES (?)", i)
+		return err
+	})
+	if err == nil {
+		t.Fatal("want the insert error")
+	}
+	if n != 2 {
+		t.Errorf("want the first batch of 2 rows inserted, got %d", n)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInsertBatchesSingleTransaction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(`INSERT INTO pilots`)
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WillReturnError(errors.New("duplicate key"))
+	mock.ExpectRollback()
+
+	n, err := InsertBatchesContext(context.Background(), db, 5, 2, true, func(exec ContextExecutor, i int) error {
+		_, err := exec.ExecContext(context.Background(), "INSERT INTO pilots (id) VALUES (?)", i)
+		return err
+	})
+	if err == nil {
+		t.Fatal("want the insert error")
+	}
+	if n != 0 {
+		t.Errorf("want nothing inserted when the transaction rolls back, got %d", n)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInsertBatchesInTransaction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(`INSERT INTO pilots`)
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WillReturnError(errors.New("duplicate key"))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the caller owns the transaction, so the rows before the failure count
+	n, err := InsertBatchesContext(context.Background(), tx, 5, 2, false, func(exec ContextExecutor, i int) error {
+		_, err := exec.ExecContext(context.Background(), "INSERT INTO pilots (id) VALUES (?)", i)
+		return err
+	})
+	if err == nil {
+		t.Fatal("want the insert error")
+	}
+	if n != 2 {
+		t.Errorf("want 2 rows inserted into the transaction, got %d", n)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
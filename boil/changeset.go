@@ -0,0 +1,31 @@
+package boil
+
+// Change is a column changed by an update with its values before and after
+type Change struct {
+	Column string
+	Old    interface{}
+	New    interface{}
+}
+
+// Changeset lists the columns changed by an update, ex: by the generated
+// UpdateWithChangeset methods, in the order of the table's columns.
+type Changeset []Change
+
+// Columns returns the names of the changed columns
+func (c Changeset) Columns() []string {
+	cols := make([]string, len(c))
+	for i, change := range c {
+		cols[i] = change.Column
+	}
+	return cols
+}
+
+// Change returns the change of the column and whether it changed
+func (c Changeset) Change(column string) (Change, bool) {
+	for _, change := range c {
+		if change.Column == column {
+			return change, true
+		}
+	}
+	return Change{}, false
+}
@@ -0,0 +1,25 @@
+package boil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangeset(t *testing.T) {
+	t.Parallel()
+
+	changes := Changeset{
+		{Column: "name", Old: "pat", New: "hat"},
+		{Column: "age", Old: 3, New: 4},
+	}
+	if got := changes.Columns(); !reflect.DeepEqual(got, []string{"name", "age"}) {
+		t.Error("wrong columns:", got)
+	}
+
+	if change, ok := changes.Change("age"); !ok || change.Old != 3 || change.New != 4 {
+		t.Errorf("wrong change: %#v", change)
+	}
+	if _, ok := changes.Change("id"); ok {
+		t.Error("id didn't change")
+	}
+}
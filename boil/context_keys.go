@@ -7,4 +7,6 @@ const (
 	ctxSkipTimestamps
 	ctxDebug
 	ctxDebugWriter
+	ctxQueryLog
+	ctxQueryComment
 )
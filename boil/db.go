@@ -67,3 +67,35 @@ func BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
 
 	return creator.BeginTx(ctx, opts)
 }
+
+// InTx runs fn in a transaction begun on exec, committed when fn succeeds
+// and rolled back when it fails. When exec can't begin transactions, ex:
+// because it's a transaction already, fn runs on exec as is.
+func InTx(exec Executor, fn func(exec Executor) error) error {
+	return inTx(nil, exec, fn)
+}
+
+// InTxContext is InTx with a context, which is used to begin the transaction.
+func InTxContext(ctx context.Context, exec ContextExecutor, fn func(exec ContextExecutor) error) error {
+	return inTx(ctx, exec, func(exec Executor) error {
+		return fn(exec.(ContextExecutor))
+	})
+}
+
+func inTx(ctx context.Context, exec Executor, fn func(exec Executor) error) error {
+	begin := beginFunc(ctx, exec)
+	if begin == nil {
+		return fn(exec)
+	}
+
+	tx, err := begin()
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
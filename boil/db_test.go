@@ -1,8 +1,12 @@
 package boil
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
 )
 
 func TestGetSetDB(t *testing.T) {
@@ -14,3 +18,77 @@ func TestGetSetDB(t *testing.T) {
 		t.Errorf("Expected GetDB to return a database handle, got nil")
 	}
 }
+
+func TestInTx(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	update := func(exec ContextExecutor) error {
+		if _, ok := exec.(*sql.Tx); !ok {
+			t.Errorf("want a transaction, got %T", exec)
+		}
+		_, err := exec.ExecContext(ctx, "UPDATE pilots SET rank = 1")
+		return err
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE pilots`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if err = InTxContext(ctx, db, update); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := errors.New("failed")
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE pilots`).WillReturnError(failed)
+	mock.ExpectRollback()
+	if err = InTxContext(ctx, db, update); err != failed {
+		t.Errorf("want the error of fn, got %v", err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInTxOnTransaction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE pilots`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = InTx(tx, func(exec Executor) error {
+		if exec != tx {
+			t.Error("want fn to run on the transaction")
+		}
+		_, err := exec.Exec("UPDATE pilots SET rank = 1")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
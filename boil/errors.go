@@ -1,5 +1,7 @@
 package boil
 
+import "strings"
+
 type boilErr struct {
 	error
 }
@@ -21,3 +23,15 @@ func IsBoilErr(err error) bool {
 	_, ok := err.(boilErr)
 	return ok
 }
+
+// ValidationErrors is every problem found by a generated Validate method
+type ValidationErrors []error
+
+// Error joins the messages of the errors
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -22,3 +22,16 @@ func TestErrors(t *testing.T) {
 		t.Errorf("Expected true")
 	}
 }
+
+func TestValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	var err error = ValidationErrors{
+		errors.New("models: pilots.name is required"),
+		errors.New("models: pilots.code is longer than 4 characters"),
+	}
+	want := "models: pilots.name is required; models: pilots.code is longer than 4 characters"
+	if got := err.Error(); got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
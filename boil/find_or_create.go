@@ -0,0 +1,90 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+// uniqueViolationMessages are parts of the messages the supported databases
+// fail an insert with when it violates a unique constraint or primary key
+var uniqueViolationMessages = []string{
+	"duplicate key",                        // postgres, mssql: Cannot insert duplicate key row
+	"violation of unique key",              // mssql
+	"violation of primary key",             // mssql
+	"error 1062",                           // mysql: Duplicate entry
+	"unique constraint failed",             // sqlite3
+	"a duplicate value cannot be inserted", // sql server compact
+}
+
+// uniqueViolation is the check of IsUniqueViolation
+var uniqueViolation = func(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, m := range uniqueViolationMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetUniqueViolationCheck replaces the check of IsUniqueViolation, ex: with
+// one testing the error codes of the database driver in use. By default the
+// messages of the supported databases are matched.
+func SetUniqueViolationCheck(check func(err error) bool) {
+	uniqueViolation = check
+}
+
+// IsUniqueViolation reports whether err is a query failing because it
+// violates a unique constraint or primary key, ex: inserting a duplicate key.
+func IsUniqueViolation(err error) bool {
+	return err != nil && uniqueViolation(err)
+}
+
+// FindOrCreate runs find and, when it finds no row, ie returns sql.ErrNoRows,
+// create in a transaction begun on exec, see InTx. It reports whether create
+// ran. When create fails with a unique violation a concurrent insert won the
+// race, so find runs again on exec to get that row instead.
+//
+// Retrying can't work within a transaction that a failed statement aborts,
+// ex: on postgres, there the unique violation is returned.
+func FindOrCreate(exec Executor, find, create func(exec Executor) error) (bool, error) {
+	return findOrCreate(nil, exec, find, create)
+}
+
+// FindOrCreateContext is FindOrCreate with a context, which is used to begin
+// the transaction.
+func FindOrCreateContext(ctx context.Context, exec ContextExecutor, find, create func(exec ContextExecutor) error) (bool, error) {
+	return findOrCreate(ctx, exec, func(exec Executor) error {
+		return find(exec.(ContextExecutor))
+	}, func(exec Executor) error {
+		return create(exec.(ContextExecutor))
+	})
+}
+
+func findOrCreate(ctx context.Context, exec Executor, find, create func(exec Executor) error) (bool, error) {
+	created := false
+	err := inTx(ctx, exec, func(exec Executor) error {
+		err := find(exec)
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if err = create(exec); err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err == nil {
+		return created, nil
+	}
+	if !created && IsUniqueViolation(err) {
+		if findErr := find(exec); findErr == nil {
+			return false, nil
+		}
+	}
+
+	return false, err
+}
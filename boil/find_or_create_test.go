@@ -0,0 +1,104 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestFindOrCreate(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	var id int
+	find := func(exec ContextExecutor) error {
+		return exec.QueryRowContext(ctx, "SELECT id FROM pilots WHERE name = ?", "Larry").Scan(&id)
+	}
+	create := func(exec ContextExecutor) error {
+		if _, ok := exec.(*sql.Tx); !ok {
+			t.Errorf("want a transaction, got %T", exec)
+		}
+		_, err := exec.ExecContext(ctx, "INSERT INTO pilots (name) VALUES (?)", "Larry")
+		return err
+	}
+
+	// Found
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM pilots`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectCommit()
+	created, err := FindOrCreateContext(ctx, db, find, create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created || id != 3 {
+		t.Errorf("want the row found, got created %t and id %d", created, id)
+	}
+
+	// Created
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM pilots`).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(`INSERT INTO pilots`).WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.ExpectCommit()
+	if created, err = FindOrCreateContext(ctx, db, find, create); err != nil {
+		t.Fatal(err)
+	}
+	if !created {
+		t.Error("want the row created")
+	}
+
+	// A concurrent insert wins the race, its row is found after the rollback
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM pilots`).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(`INSERT INTO pilots`).WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "pilots_name_key"`))
+	mock.ExpectRollback()
+	mock.ExpectQuery(`SELECT id FROM pilots`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	if created, err = FindOrCreateContext(ctx, db, find, create); err != nil {
+		t.Fatal(err)
+	}
+	if created || id != 5 {
+		t.Errorf("want the row of the concurrent insert, got created %t and id %d", created, id)
+	}
+
+	// Other errors are returned as is
+	failed := errors.New("failed")
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM pilots`).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(`INSERT INTO pilots`).WillReturnError(failed)
+	mock.ExpectRollback()
+	if _, err = FindOrCreateContext(ctx, db, find, create); err != failed {
+		t.Errorf("want the error of create, got %v", err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	t.Parallel()
+
+	for _, msg := range []string{
+		`pq: duplicate key value violates unique constraint "pilots_name_key"`,
+		"mssql: Violation of UNIQUE KEY constraint 'uq_pilots_name'. Cannot insert duplicate key in object 'dbo.pilots'.",
+		"mssql: Violation of PRIMARY KEY constraint 'pk_pilots'.",
+		"Error 1062: Duplicate entry 'Larry' for key 'name'",
+		"UNIQUE constraint failed: pilots.name",
+	} {
+		if !IsUniqueViolation(errors.New(msg)) {
+			t.Errorf("want a unique violation: %s", msg)
+		}
+	}
+
+	if IsUniqueViolation(nil) || IsUniqueViolation(errors.New("connection refused")) {
+		t.Error("want no unique violation")
+	}
+}
@@ -0,0 +1,48 @@
+package boil
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSONField is a named value written by MarshalJSONObject
+type JSONField struct {
+	Name  string
+	Value interface{}
+}
+
+// MarshalJSONObject writes the fields as a JSON object in the order given,
+// for generated MarshalJSON methods. A field whose value marshals to null,
+// ex: an invalid null.String, is left out when omitNull is true.
+func MarshalJSONObject(fields []JSONField, omitNull bool) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+
+	first := true
+	for _, f := range fields {
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		if omitNull && bytes.Equal(value, []byte("null")) {
+			continue
+		}
+
+		name, err := json.Marshal(f.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
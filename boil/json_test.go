@@ -0,0 +1,41 @@
+package boil
+
+import (
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMarshalJSONObject(t *testing.T) {
+	t.Parallel()
+
+	fields := []JSONField{
+		{Name: "id", Value: 5},
+		{Name: "name", Value: null.String{}},
+		{Name: "nick", Value: null.StringFrom("tim")},
+	}
+
+	b, err := MarshalJSONObject(fields, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"id":5,"name":null,"nick":"tim"}`; got != want {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+
+	b, err = MarshalJSONObject(fields, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"id":5,"nick":"tim"}`; got != want {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+
+	b, err = MarshalJSONObject([]JSONField{{Name: "name", Value: null.String{}}}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(b); got != "{}" {
+		t.Errorf("want an empty object, got: %s", got)
+	}
+}
@@ -0,0 +1,89 @@
+package boil
+
+import (
+	"context"
+	"strings"
+)
+
+// queryComment is what the comments of a context from WithQueryComments
+// are made of
+type queryComment struct {
+	trace string
+	model string
+	op    string
+}
+
+// WithQueryComments modifies a context so every query the generated code
+// runs with it starts with a comment naming the model and operation running
+// it, and trace when it isn't empty, ex:
+//
+//	/* model=Pilot op=Find trace=4bf92f3577b34da6 */ SELECT * FROM [pilots] ...
+//
+// Database profilers and slow query logs can then attribute queries to the
+// code, or the request, that made them. Queries run with a statement from
+// the statement cache have no trace: a statement's text is fixed once it's
+// prepared, so only the model and operation, which don't change from one
+// call to the next, are part of it.
+func WithQueryComments(ctx context.Context, trace string) context.Context {
+	c, _ := ctx.Value(ctxQueryComment).(queryComment)
+	c.trace = sanitizeQueryComment(trace)
+	return context.WithValue(ctx, ctxQueryComment, c)
+}
+
+// WithQueryOp records the model and operation running the queries made
+// with ctx for the comments of WithQueryComments. The generated methods call
+// it, it returns ctx as is when the context has no query comments.
+func WithQueryOp(ctx context.Context, model, op string) context.Context {
+	c, ok := ctx.Value(ctxQueryComment).(queryComment)
+	if !ok {
+		return ctx
+	}
+
+	c.model, c.op = sanitizeQueryComment(model), sanitizeQueryComment(op)
+	return context.WithValue(ctx, ctxQueryComment, c)
+}
+
+// commentQuery returns the query with the comment of the context, and
+// the query with the comment stripped of its trace for the statement cache.
+// Both are the query as is when the context has no query comments.
+func commentQuery(ctx context.Context, query string) (traced, cached string) {
+	c, ok := ctx.Value(ctxQueryComment).(queryComment)
+	if !ok {
+		return query, query
+	}
+
+	var fields []string
+	if len(c.model) != 0 {
+		fields = append(fields, "model="+c.model)
+	}
+	if len(c.op) != 0 {
+		fields = append(fields, "op="+c.op)
+	}
+	if len(fields) != 0 {
+		cached = "/* " + strings.Join(fields, " ") + " */ " + query
+	} else {
+		cached = query
+	}
+
+	if len(c.trace) == 0 {
+		return cached, cached
+	}
+	fields = append(fields, "trace="+c.trace)
+	// The comment leads the statement: some databases, ex: SQL Server
+	// Compact, reject anything after the statement's terminator
+	return "/* " + strings.Join(fields, " ") + " */ " + query, cached
+}
+
+// sanitizeQueryComment keeps the characters of s that can't end the comment
+// or split its fields, ex: a trace id from a request header
+func sanitizeQueryComment(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '-', r == '_', r == '.', r == ':':
+			return r
+		}
+		return -1
+	}, s)
+}
@@ -0,0 +1,73 @@
+package boil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestQueryComments(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if WithQueryOp(ctx, "Pilot", "Update") != ctx {
+		t.Error("want the context as is without query comments")
+	}
+
+	mock.ExpectExec("UPDATE pilots SET rank = 1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("/* model=Pilot op=Update trace=4bf92f35drop */ UPDATE pilots SET rank = 1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("/* trace=4bf92f35drop */ UPDATE pilots SET rank = 1").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if _, err = ExecContext(ctx, db, "UPDATE pilots SET rank = 1"); err != nil {
+		t.Fatal(err)
+	}
+	commented := WithQueryComments(ctx, "4bf92f35 */ drop")
+	if _, err = ExecContext(WithQueryOp(commented, "Pilot", "Update"), db, "UPDATE pilots SET rank = 1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ExecContext(commented, db, "UPDATE pilots SET rank = 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQueryCommentsStmtCache(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	SetStmtCacheSize(10)
+	defer SetStmtCacheSize(0)
+
+	// The trace changes with every request, it mustn't prepare the query again
+	prep := mock.ExpectPrepare("/* model=Pilot op=Update */ UPDATE pilots SET rank = ?")
+	for i := 0; i < 2; i++ {
+		prep.ExpectExec().WithArgs(i).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	for i, trace := range []string{"4bf92f35", "00f067aa"} {
+		ctx := WithQueryOp(WithQueryComments(context.Background(), trace), "Pilot", "Update")
+		if _, err = ExecContext(ctx, db, "UPDATE pilots SET rank = ?", i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := stmts.len(); n != 1 {
+		t.Errorf("want 1 cached statement, got %d", n)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,109 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// maxLogArgLen is the most characters of a single argument written to the
+// query log, longer values are cut short
+const maxLogArgLen = 64
+
+// WithQueryLog modifies a context so every query run with it through an
+// executor from NewQueryLogExecutor is written to w along with its
+// arguments. Unlike WithDebug it only traces queries that carry this
+// context, ex: the ones made while serving a single request.
+func WithQueryLog(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, ctxQueryLog, w)
+}
+
+// QueryLogFrom returns the query log writer of the context, nil if the
+// context isn't being logged.
+func QueryLogFrom(ctx context.Context) io.Writer {
+	w, _ := ctx.Value(ctxQueryLog).(io.Writer)
+	return w
+}
+
+// NewQueryLogExecutor wraps exec so queries made with a context from
+// WithQueryLog are logged before they run. Queries without a context are
+// never logged.
+func NewQueryLogExecutor(exec ContextExecutor) ContextExecutor {
+	return queryLogExecutor{ContextExecutor: exec}
+}
+
+type queryLogExecutor struct {
+	ContextExecutor
+}
+
+func (q queryLogExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	logQuery(ctx, query, args)
+	return q.ContextExecutor.ExecContext(ctx, query, args...)
+}
+
+func (q queryLogExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	logQuery(ctx, query, args)
+	return q.ContextExecutor.QueryContext(ctx, query, args...)
+}
+
+func (q queryLogExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	logQuery(ctx, query, args)
+	return q.ContextExecutor.QueryRowContext(ctx, query, args...)
+}
+
+// logQuery writes the query and its arguments to the context's query log
+func logQuery(ctx context.Context, query string, args []interface{}) {
+	w := QueryLogFrom(ctx)
+	if w == nil {
+		return
+	}
+
+	rendered := make([]string, len(args))
+	for i, arg := range args {
+		rendered[i] = LogArg(arg)
+	}
+
+	fmt.Fprintln(w, query)
+	fmt.Fprintf(w, "[%s]\n", strings.Join(rendered, ", "))
+}
+
+// LogArg renders a query argument for logs: driver.Valuers (like the null
+// package's types) by their value, nulls as NULL, byte slices by length and
+// a hex prefix, and anything longer than a few dozen characters cut short.
+func LogArg(arg interface{}) string {
+	if v := reflect.ValueOf(arg); v.Kind() == reflect.Ptr && v.IsNil() {
+		return "NULL"
+	}
+
+	if valuer, ok := arg.(driver.Valuer); ok {
+		val, err := valuer.Value()
+		if err != nil {
+			return fmt.Sprintf("<invalid: %v>", err)
+		}
+		arg = val
+	}
+
+	var s string
+	switch a := arg.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		if len(a) > maxLogArgLen/2 {
+			return fmt.Sprintf("[]byte(%d) %x...", len(a), a[:maxLogArgLen/2])
+		}
+		return fmt.Sprintf("[]byte(%d) %x", len(a), a)
+	case string:
+		s = fmt.Sprintf("%q", a)
+	default:
+		s = fmt.Sprint(a)
+	}
+
+	if len(s) > maxLogArgLen {
+		return s[:maxLogArgLen] + "..."
+	}
+	return s
+}
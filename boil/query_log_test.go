@@ -0,0 +1,76 @@
+package boil
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestQueryLogExecutor(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE pilots`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM pilots`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	buf := &bytes.Buffer{}
+	exec := NewQueryLogExecutor(db)
+
+	ctx := WithQueryLog(context.Background(), buf)
+	photo := bytes.Repeat([]byte{0xff}, 100)
+	if _, err = exec.ExecContext(ctx, "UPDATE pilots SET name = $1, photo = $2 WHERE id = $3", null.StringFromPtr(nil), photo, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "UPDATE pilots SET name = $1, photo = $2 WHERE id = $3\n") {
+		t.Error("want the query logged, got:", out)
+	}
+	if !strings.Contains(out, "[NULL, []byte(100) ffff") || !strings.Contains(out, "..., 5]\n") {
+		t.Error("want the args rendered safely, got:", out)
+	}
+
+	buf.Reset()
+	if _, err = exec.ExecContext(context.Background(), "DELETE FROM pilots WHERE id = $1", 5); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Error("want queries without a logged context left out, got:", buf.String())
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLogArg(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Arg  interface{}
+		Want string
+	}{
+		{nil, "NULL"},
+		{null.String{}, "NULL"},
+		{null.StringFrom("tim"), `"tim"`},
+		{(*int)(nil), "NULL"},
+		{[]byte{0xab, 0xcd}, "[]byte(2) abcd"},
+		{strings.Repeat("a", 100), `"` + strings.Repeat("a", 63) + "..."},
+		{42, "42"},
+	}
+
+	for i, test := range tests {
+		if got := LogArg(test.Arg); got != test.Want {
+			t.Errorf("%d) want %s, got %s", i, test.Want, got)
+		}
+	}
+}
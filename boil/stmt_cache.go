@@ -0,0 +1,245 @@
+package boil
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// stmts is the statement cache of the package, disabled until
+// SetStmtCacheSize gives it a size.
+var stmts = newStmtCache(0)
+
+// SetStmtCacheSize turns on caching the prepared statements of the generated
+// queries, keeping at most size of them. Once full the least recently used
+// statement is closed to make room. A size of 0 (the default) turns caching
+// off and closes the cached statements.
+//
+// Statements are cached per executor and query. Only executors that can
+// prepare statements and aren't transactions are cached, ex: *sql.DB and
+// *sql.Conn. A statement of a *sql.DB works on every connection of its pool,
+// one of a *sql.Conn only on that connection: call ClearStmtCache before
+// closing a *sql.Conn that was used with the cache.
+func SetStmtCacheSize(size int) {
+	stmts.resize(size)
+}
+
+// ClearStmtCache closes every cached statement, statements in use are closed
+// once their query returns.
+func ClearStmtCache() {
+	stmts.clear()
+}
+
+// ExecContext runs the query on exec with a cached prepared statement when
+// the statement cache is on, and directly otherwise. The query starts with
+// the comment of the context when it has one, see WithQueryComments, and is
+// timed out after the statement timeout when the context has no deadline,
+// see SetStatementTimeout.
+func ExecContext(ctx context.Context, exec ContextExecutor, query string, args ...interface{}) (sql.Result, error) {
+	ctx, cancel := withStatementTimeout(ctx)
+	defer cancel()
+
+	traced, cached := commentQuery(ctx, query)
+	entry, err := stmts.get(ctx, exec, cached)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return exec.ExecContext(ctx, traced, args...)
+	}
+
+	defer stmts.release(entry)
+	return entry.stmt.ExecContext(ctx, args...)
+}
+
+// QueryContext runs the query on exec with a cached prepared statement when
+// the statement cache is on, and directly otherwise. The rows are read after
+// it returns so the statement timeout doesn't apply.
+func QueryContext(ctx context.Context, exec ContextExecutor, query string, args ...interface{}) (*sql.Rows, error) {
+	traced, cached := commentQuery(ctx, query)
+	entry, err := stmts.get(ctx, exec, cached)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return exec.QueryContext(ctx, traced, args...)
+	}
+
+	defer stmts.release(entry)
+	return entry.stmt.QueryContext(ctx, args...)
+}
+
+// QueryRowContext runs the query on exec with a cached prepared statement
+// when the statement cache is on, and directly otherwise. Like QueryContext
+// the statement timeout doesn't apply.
+func QueryRowContext(ctx context.Context, exec ContextExecutor, query string, args ...interface{}) *sql.Row {
+	traced, cached := commentQuery(ctx, query)
+	entry, err := stmts.get(ctx, exec, cached)
+	if err != nil || entry == nil {
+		// sql.Row can't be built with an error, let the executor report it
+		return exec.QueryRowContext(ctx, traced, args...)
+	}
+
+	defer stmts.release(entry)
+	return entry.stmt.QueryRowContext(ctx, args...)
+}
+
+type stmtKey struct {
+	exec  ContextExecutor
+	query string
+}
+
+// cachedStmt is a statement of the cache, users counts the queries running
+// on it so an evicted statement is only closed once they're done.
+type cachedStmt struct {
+	key     stmtKey
+	stmt    *sql.Stmt
+	users   int
+	evicted bool
+}
+
+// stmtCache keeps the most recently used prepared statements
+type stmtCache struct {
+	// on is 1 while the cache has a size, it's read without the lock so
+	// queries don't contend for it when the cache is off
+	on int32
+
+	mu      sync.Mutex
+	size    int
+	entries map[stmtKey]*list.Element
+	lru     *list.List
+}
+
+func newStmtCache(size int) *stmtCache {
+	c := &stmtCache{
+		entries: make(map[stmtKey]*list.Element),
+		lru:     list.New(),
+	}
+	c.resize(size)
+	return c
+}
+
+// cacheable is true for executors whose statements outlive a single query
+// and can be used as map keys
+func cacheable(exec ContextExecutor) bool {
+	if _, ok := exec.(contextPreparer); !ok {
+		return false
+	}
+	if _, ok := exec.(ContextTransactor); ok {
+		return false
+	}
+	return reflect.TypeOf(exec).Comparable()
+}
+
+// get returns the statement of the query on exec, preparing it when it isn't
+// cached yet. It returns nil when the cache is off or exec isn't cacheable.
+// A statement returned must be given back with release.
+func (c *stmtCache) get(ctx context.Context, exec ContextExecutor, query string) (*cachedStmt, error) {
+	if atomic.LoadInt32(&c.on) == 0 {
+		return nil, nil
+	}
+
+	c.mu.Lock()
+	if c.size <= 0 || !cacheable(exec) {
+		c.mu.Unlock()
+		return nil, nil
+	}
+
+	key := stmtKey{exec: exec, query: query}
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cachedStmt)
+		entry.users++
+		c.lru.MoveToFront(elem)
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.mu.Unlock()
+
+	// Prepare without the lock, queries on other statements shouldn't wait
+	// for a round trip to the database
+	stmt, err := exec.(contextPreparer).PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		// Prepared concurrently by another query, keep the cached one
+		_ = stmt.Close()
+		entry := elem.Value.(*cachedStmt)
+		entry.users++
+		c.lru.MoveToFront(elem)
+		return entry, nil
+	}
+
+	entry := &cachedStmt{key: key, stmt: stmt, users: 1}
+	if c.size <= 0 {
+		// Turned off while preparing, use the statement this once
+		entry.evicted = true
+		return entry, nil
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.size {
+		c.evict(c.lru.Back())
+	}
+	return entry, nil
+}
+
+// release gives back a statement from get, closing it if it was evicted in
+// the meantime and this was its last user
+func (c *stmtCache) release(entry *cachedStmt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.users--
+	if entry.evicted && entry.users == 0 {
+		_ = entry.stmt.Close()
+	}
+}
+
+// evict removes the element from the cache, c.mu must be held
+func (c *stmtCache) evict(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cachedStmt)
+	delete(c.entries, entry.key)
+	entry.evicted = true
+	if entry.users == 0 {
+		_ = entry.stmt.Close()
+	}
+}
+
+func (c *stmtCache) resize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size = size
+	if size > 0 {
+		atomic.StoreInt32(&c.on, 1)
+	} else {
+		atomic.StoreInt32(&c.on, 0)
+	}
+	for c.lru.Len() > 0 && c.lru.Len() > size {
+		c.evict(c.lru.Back())
+	}
+}
+
+func (c *stmtCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.lru.Len() > 0 {
+		c.evict(c.lru.Back())
+	}
+}
+
+// len is the number of cached statements
+func (c *stmtCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
@@ -0,0 +1,221 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// The tests using the package's cache can't run in parallel, they change its size
+
+func TestStmtCachePreparesOnce(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	SetStmtCacheSize(10)
+	defer SetStmtCacheSize(0)
+
+	prep := mock.ExpectPrepare(`UPDATE pilots`)
+	for i := 0; i < 3; i++ {
+		prep.ExpectExec().WithArgs(i).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	prep = mock.ExpectPrepare(`SELECT name FROM pilots`)
+	for i := 0; i < 2; i++ {
+		prep.ExpectQuery().WithArgs(i).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Larry"))
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, err := ExecContext(ctx, db, "UPDATE pilots SET rank = ?", i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var name string
+	if err = QueryRowContext(ctx, db, "SELECT name FROM pilots WHERE id = ?", 0).Scan(&name); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := QueryContext(ctx, db, "SELECT name FROM pilots WHERE id = ?", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+
+	if n := stmts.len(); n != 2 {
+		t.Errorf("want 2 cached statements, got %d", n)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStmtCacheOff(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// Off by default, the query runs without preparing it
+	mock.ExpectExec(`UPDATE pilots`).WillReturnResult(sqlmock.NewResult(0, 1))
+	if _, err := ExecContext(context.Background(), db, "UPDATE pilots SET rank = 1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// An off cache doesn't take its lock
+	c := newStmtCache(0)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		_, _ = c.get(context.Background(), db, "SELECT 1")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("want get to skip the lock of an off cache")
+	}
+}
+
+func TestStmtCacheSkipsTransactions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	SetStmtCacheSize(10)
+	defer SetStmtCacheSize(0)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE pilots`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ExecContext(context.Background(), tx, "UPDATE pilots SET rank = 1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := stmts.len(); n != 0 {
+		t.Errorf("want no cached statements, got %d", n)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStmtCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectPrepare(`SELECT 1`).WillBeClosed()
+	mock.ExpectPrepare(`SELECT 2`).WillBeClosed()
+
+	ctx := context.Background()
+	cache := newStmtCache(1)
+	one, err := cache.get(ctx, db, "SELECT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.release(one)
+
+	// One is in use while two evicts it, it's closed once released
+	one, err = cache.get(ctx, db, "SELECT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	two, err := cache.get(ctx, db, "SELECT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !one.evicted || cache.len() != 1 {
+		t.Error("want the least recently used statement evicted")
+	}
+	cache.release(one)
+	cache.release(two)
+
+	cache.clear()
+	if cache.len() != 0 {
+		t.Error("want an empty cache")
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func BenchmarkExecContext(b *testing.B) {
+	benchExecContext(b)
+}
+
+func BenchmarkExecContextCached(b *testing.B) {
+	SetStmtCacheSize(10)
+	defer SetStmtCacheSize(0)
+
+	benchExecContext(b)
+}
+
+func benchExecContext(b *testing.B) {
+	conn := &benchConn{}
+	db := sql.OpenDB(benchConnector{conn: conn})
+	defer db.Close()
+
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ExecContext(ctx, db, "UPDATE pilots SET rank = ?", i); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(atomic.LoadInt64(&conn.prepares))/float64(b.N), "prepares/op")
+}
+
+// benchConnector hands out a connection without a database, preparing on it
+// takes about as long as a round trip to a database on the same host
+type benchConnector struct {
+	conn *benchConn
+}
+
+func (b benchConnector) Connect(context.Context) (driver.Conn, error) { return b.conn, nil }
+func (benchConnector) Driver() driver.Driver                          { return nil }
+
+type benchConn struct {
+	prepares int64
+}
+
+func (b *benchConn) Prepare(string) (driver.Stmt, error) {
+	atomic.AddInt64(&b.prepares, 1)
+	time.Sleep(50 * time.Microsecond)
+	return benchStmt{}, nil
+}
+func (*benchConn) Close() error              { return nil }
+func (*benchConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type benchStmt struct{}
+
+func (benchStmt) Close() error                               { return nil }
+func (benchStmt) NumInput() int                              { return -1 }
+func (benchStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(1), nil }
+func (benchStmt) Query([]driver.Value) (driver.Rows, error)  { return nil, driver.ErrSkip }
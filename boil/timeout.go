@@ -0,0 +1,44 @@
+package boil
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// statementTimeout is the timeout in nanoseconds of the statements run with
+// a context that has no deadline, 0 for none. It's read and set atomically,
+// it may change while queries run.
+var statementTimeout int64
+
+// SetStatementTimeout sets the default timeout of the statements of the
+// generated package that don't return rows, see ExecContext. It only applies
+// to the statements run with a context that has no deadline of its own, a
+// statement that runs past it fails with context.DeadlineExceeded. A timeout
+// of 0 (the default) turns it off.
+//
+// Queries returning rows aren't timed out: the rows are read after the query
+// returns, with no way to end the timeout once they're closed. Give them a
+// context with a deadline instead. The --no-context methods don't have a
+// context and are never timed out.
+func SetStatementTimeout(timeout time.Duration) {
+	atomic.StoreInt64(&statementTimeout, int64(timeout))
+}
+
+// GetStatementTimeout retrieves the default timeout of the statements
+func GetStatementTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64(&statementTimeout))
+}
+
+// withStatementTimeout returns ctx with the statement timeout when it's on
+// and ctx has no deadline
+func withStatementTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := GetStatementTimeout()
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
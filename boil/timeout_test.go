@@ -0,0 +1,108 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// The tests using the statement timeout can't run in parallel, they change it
+
+func TestStatementTimeout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	SetStatementTimeout(10 * time.Millisecond)
+	defer SetStatementTimeout(0)
+
+	mock.ExpectExec(`UPDATE pilots`).WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT name FROM pilots`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Larry"))
+
+	// The driver reports the cancelation its own way, the exec must not
+	// wait for the database
+	ctx := context.Background()
+	start := time.Now()
+	if _, err = ExecContext(ctx, db, "UPDATE pilots SET rank = 1"); err == nil {
+		t.Error("want the exec timed out")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("want the exec timed out, took %s", elapsed)
+	}
+
+	// Rows are read after the query returns, past the timeout
+	rows, err := QueryContext(ctx, db, "SELECT name FROM pilots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	time.Sleep(20 * time.Millisecond)
+	if !rows.Next() {
+		t.Fatal("want the rows readable past the timeout, got:", rows.Err())
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStatementTimeoutContextDeadline(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	SetStatementTimeout(10 * time.Millisecond)
+	defer SetStatementTimeout(0)
+
+	// The deadline of the context wins over the statement timeout
+	mock.ExpectExec(`UPDATE pilots`).WillDelayFor(50 * time.Millisecond).WillReturnResult(sqlmock.NewResult(0, 1))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err = ExecContext(ctx, db, "UPDATE pilots SET rank = 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// An expired one aborts the query before it's sent
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if _, err = ExecContext(expired, db, "UPDATE pilots SET rank = 2"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want the deadline exceeded, got: %v", err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStatementTimeoutTxDone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	SetStatementTimeout(time.Second)
+	defer SetStatementTimeout(0)
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = ExecContext(context.Background(), tx, "UPDATE pilots SET rank = 1"); !errors.Is(err, sql.ErrTxDone) {
+		t.Errorf("want the transaction done, got: %v", err)
+	}
+}
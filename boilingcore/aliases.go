@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/volatiletech/sqlboiler/v4/drivers"
-	"github.com/volatiletech/strmangle"
 )
 
 // Aliases defines aliases for the generation run
@@ -36,6 +35,14 @@ type RelationshipAlias struct {
 // This leaves us with a complete list of Go names for all tables,
 // columns, and relationships.
 func FillAliases(a *Aliases, tables []drivers.Table) {
+	fillAliases(a, tables, nil, Initialisms{}, nil)
+}
+
+// fillAliases is FillAliases with the user's inflection overrides
+// applied when singularizing and pluralizing names, the user's initialisms
+// when casing them, and the user's name rewrites applied to the names the
+// aliases are derived from.
+func fillAliases(a *Aliases, tables []drivers.Table, inflections Inflections, initialisms Initialisms, rewrites nameRewriter) {
 	if a.Tables == nil {
 		a.Tables = make(map[string]TableAlias)
 	}
@@ -52,18 +59,19 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 		}
 
 		table := a.Tables[t.Name]
+		name := rewrites.Rewrite(t.Name)
 
 		if len(table.UpPlural) == 0 {
-			table.UpPlural = strmangle.TitleCase(strmangle.Plural(t.Name))
+			table.UpPlural = initialisms.TitleCase(inflections.Plural(name))
 		}
 		if len(table.UpSingular) == 0 {
-			table.UpSingular = strmangle.TitleCase(strmangle.Singular(t.Name))
+			table.UpSingular = initialisms.TitleCase(inflections.Singular(name))
 		}
 		if len(table.DownPlural) == 0 {
-			table.DownPlural = strmangle.CamelCase(strmangle.Plural(t.Name))
+			table.DownPlural = initialisms.CamelCase(inflections.Plural(name))
 		}
 		if len(table.DownSingular) == 0 {
-			table.DownSingular = strmangle.CamelCase(strmangle.Singular(t.Name))
+			table.DownSingular = initialisms.CamelCase(inflections.Singular(name))
 		}
 
 		if table.Columns == nil {
@@ -75,7 +83,7 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 
 		for _, c := range t.Columns {
 			if _, ok := table.Columns[c.Name]; !ok {
-				table.Columns[c.Name] = strmangle.TitleCase(c.Name)
+				table.Columns[c.Name] = initialisms.TitleCase(rewrites.Rewrite(c.Name))
 			}
 		}
 
@@ -87,7 +95,7 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 				continue
 			}
 
-			local, foreign := txtNameToOne(k)
+			local, foreign := txtNameToOne(rewrites.ForeignKey(k), inflections, initialisms)
 			if len(r.Local) == 0 {
 				r.Local = local
 			}
@@ -127,7 +135,7 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 		// videos_tags.relationships.fk_video_id.foreign = "Videos"
 		// Consistent, yes. Confusing? Also yes.
 
-		lhsName, rhsName := txtNameToMany(lhs, rhs)
+		lhsName, rhsName := txtNameToMany(rewrites.ForeignKey(lhs), rewrites.ForeignKey(rhs), inflections, initialisms)
 
 		if len(lhsAlias.Local) != 0 {
 			rhsName = lhsAlias.Local
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
@@ -44,6 +45,22 @@ type State struct {
 	Tables  []drivers.Table
 	Dialect drivers.Dialect
 
+	Functions []drivers.Function
+	Enums     []drivers.Enum
+
+	// SchemaVersion is a hash of the tables as they were assembled
+	SchemaVersion string
+	// EngineVersion is the version of the database engine, see
+	// drivers.EngineVersioner
+	EngineVersion string
+	// SchemaQuery reads the live columns for VerifySchema, see drivers.DBInfo
+	SchemaQuery string
+
+	Embeds      []resolvedEmbed
+	Projections []resolvedProjection
+	Mixins      []resolvedMixin
+	Scanners    []Scanner
+
 	Templates     *templateList
 	TestTemplates *templateList
 }
@@ -88,6 +105,44 @@ func New(config *Config) (*State, error) {
 		)
 	}
 
+	switch config.JSONNullPolicy {
+	case "", "render", "omit":
+	default:
+		return nil, errors.Errorf("unknown json null policy %q, must be render or omit", config.JSONNullPolicy)
+	}
+
+	switch config.DTONullStyle {
+	case "", "pointer", "null":
+	default:
+		return nil, errors.Errorf("unknown dto null style %q, must be pointer or null", config.DTONullStyle)
+	}
+
+	switch config.NullableStyle {
+	case "", NullableStyleNull, NullableStylePointers:
+	default:
+		return nil, errors.Errorf("unknown nullable style %q, must be pointers or null", config.NullableStyle)
+	}
+
+	switch config.NullablePackage {
+	case "", NullablePackageVolatiletech:
+	case NullablePackageStdlib:
+		if config.NullableStyle == NullableStylePointers {
+			return nil, errors.New("the stdlib nullable package can't be used with pointers, pointers don't use a package")
+		}
+	default:
+		return nil, errors.Errorf("unknown nullable package %q, must be stdlib or volatiletech", config.NullablePackage)
+	}
+
+	if config.Wipe && config.Incremental {
+		return nil, errors.New("wipe and incremental can't be used together, wiping rewrites every file")
+	}
+
+	switch config.OrderColumns {
+	case "", OrderColumnsOrdinal, OrderColumnsAlphabetical:
+	default:
+		return nil, errors.Errorf("unknown column order %q, must be alphabetical or ordinal", config.OrderColumns)
+	}
+
 	s.Driver = drivers.GetDriver(config.DriverName)
 
 	err := s.initDBInfo(config.DriverConfig)
@@ -104,9 +159,34 @@ func New(config *Config) (*State, error) {
 		s.Config.Imports.Test.Standard = append(s.Config.Imports.Test.Standard, `"context"`)
 	}
 
+	if s.Config.JSONMethods {
+		s.Config.Imports.All.Standard = append(s.Config.Imports.All.Standard, `"encoding/json"`)
+	}
+
+	s.addFunctionImports()
+	s.addSchemaImports()
+	s.processScanners()
+
 	if err := s.processTypeReplacements(); err != nil {
 		return nil, err
 	}
+	if config.NullableStyle == NullableStylePointers {
+		usePointerTypes(s.Tables)
+	}
+	if config.NullablePackage == NullablePackageStdlib {
+		if s.Config.Imports.BasedOnType == nil {
+			s.Config.Imports.BasedOnType = importers.Map{}
+		}
+		useStdlibNullTypes(s.Tables, s.Config.Imports.BasedOnType)
+	}
+
+	orderTables(s.Tables, config.OrderColumns == OrderColumnsAlphabetical)
+
+	if config.EmitNameConstants {
+		if err := checkNameConstants(s.Tables); err != nil {
+			return nil, err
+		}
+	}
 
 	templates, err = s.initTemplates()
 	if err != nil {
@@ -128,6 +208,18 @@ func New(config *Config) (*State, error) {
 		return nil, errors.Wrap(err, "unable to initialize aliases")
 	}
 
+	if err := s.initEmbeds(); err != nil {
+		return nil, errors.Wrap(err, "unable to initialize embeds")
+	}
+
+	if err := s.initProjections(); err != nil {
+		return nil, errors.Wrap(err, "unable to initialize projections")
+	}
+
+	if err := s.initMixins(); err != nil {
+		return nil, errors.Wrap(err, "unable to initialize mixins")
+	}
+
 	return s, nil
 }
 
@@ -135,31 +227,55 @@ func New(config *Config) (*State, error) {
 // state given.
 func (s *State) Run() error {
 	data := &templateData{
-		Tables:            s.Tables,
-		Aliases:           s.Config.Aliases,
-		DriverName:        s.Config.DriverName,
-		PkgName:           s.Config.PkgName,
-		AddGlobal:         s.Config.AddGlobal,
-		AddPanic:          s.Config.AddPanic,
-		AddSoftDeletes:    s.Config.AddSoftDeletes,
-		NoContext:         s.Config.NoContext,
-		NoHooks:           s.Config.NoHooks,
-		NoAutoTimestamps:  s.Config.NoAutoTimestamps,
-		NoRowsAffected:    s.Config.NoRowsAffected,
-		NoDriverTemplates: s.Config.NoDriverTemplates,
-		NoBackReferencing: s.Config.NoBackReferencing,
-		StructTagCasing:   s.Config.StructTagCasing,
-		TagIgnore:         make(map[string]struct{}),
-		Tags:              s.Config.Tags,
-		RelationTag:       s.Config.RelationTag,
-		Dialect:           s.Dialect,
-		Schema:            s.Schema,
-		LQ:                strmangle.QuoteCharacter(s.Dialect.LQ),
-		RQ:                strmangle.QuoteCharacter(s.Dialect.RQ),
-		OutputDirDepth:    s.Config.OutputDirDepth(),
+		Tables:                s.Tables,
+		Functions:             s.Functions,
+		Enums:                 s.Enums,
+		SchemaVersion:         s.SchemaVersion,
+		EngineVersion:         s.EngineVersion,
+		SchemaQuery:           s.SchemaQuery,
+		Embeds:                s.Embeds,
+		Projections:           s.Projections,
+		Mixins:                s.Mixins,
+		Scanners:              s.Scanners,
+		Aliases:               s.Config.Aliases,
+		DriverName:            s.Config.DriverName,
+		PkgName:               s.Config.PkgName,
+		AddGlobal:             s.Config.AddGlobal,
+		AddPanic:              s.Config.AddPanic,
+		AddSoftDeletes:        s.Config.AddSoftDeletes,
+		NoContext:             s.Config.NoContext,
+		NoHooks:               s.Config.NoHooks,
+		NoAutoTimestamps:      s.Config.NoAutoTimestamps,
+		NoRowsAffected:        s.Config.NoRowsAffected,
+		NoDriverTemplates:     s.Config.NoDriverTemplates,
+		NoBackReferencing:     s.Config.NoBackReferencing,
+		GenerateIndexMetadata: s.Config.GenerateIndexMetadata,
+		GenerateInterfaces:    s.Config.GenerateInterfaces,
+		GenerateDTOs:          s.Config.GenerateDTOs,
+		DTONullStyle:          s.Config.DTONullStyle,
+		GenerateValidate:      s.Config.GenerateValidate,
+		GenerateConstructors:  s.Config.GenerateConstructors,
+		GenerateChangesets:    s.Config.GenerateChangesets,
+		GenerateDeleteCascade: s.Config.GenerateDeleteCascade,
+		GenerateInsertIgnore:  s.Config.GenerateInsertIgnore,
+		GenerateSchemaVersion: s.Config.GenerateSchemaVersion,
+		JSONMethods:           s.Config.JSONMethods,
+		JSONNullPolicy:        s.Config.JSONNullPolicy,
+		BulkInsertBatchSize:   s.Config.BulkInsertBatchSize,
+		EmitNameConstants:     s.Config.EmitNameConstants,
+		StructTagCasing:       s.Config.StructTagCasing,
+		StructTagCases:        s.Config.StructTagCases,
+		TagIgnore:             make(map[string]struct{}),
+		Tags:                  s.Config.Tags,
+		RelationTag:           s.Config.RelationTag,
+		Dialect:               s.Dialect,
+		Schema:                s.Schema,
+		LQ:                    strmangle.QuoteCharacter(s.Dialect.LQ),
+		RQ:                    strmangle.QuoteCharacter(s.Dialect.RQ),
+		OutputDirDepth:        s.Config.OutputDirDepth(),
 
 		DBTypes:     make(once),
-		StringFuncs: templateStringMappers,
+		StringFuncs: s.Config.Initialisms.stringMappers(),
 	}
 
 	for _, v := range s.Config.TagIgnore {
@@ -168,6 +284,8 @@ func (s *State) Run() error {
 		}
 		data.TagIgnore[v] = struct{}{}
 	}
+	data.Whitelist, _ = s.Config.DriverConfig.StringSlice(drivers.ConfigWhitelist)
+	data.Blacklist, _ = s.Config.DriverConfig.StringSlice(drivers.ConfigBlacklist)
 
 	if err := generateSingletonOutput(s, data); err != nil {
 		return errors.Wrap(err, "singleton template output")
@@ -197,8 +315,9 @@ func (s *State) Run() error {
 			return errors.Wrap(err, "unable to generate output")
 		}
 
-		// Generate the test templates
-		if !s.Config.NoTests {
+		// Generate the test templates, views and read only tables can't
+		// insert the rows they need
+		if !s.Config.NoTests && !table.IsReadOnly() {
 			if err := generateTestOutput(s, testDirExtMap, data); err != nil {
 				return errors.Wrap(err, "unable to generate test output")
 			}
@@ -217,7 +336,9 @@ func (s *State) Cleanup() error {
 // initTemplates loads all template folders into the state object.
 //
 // If TemplateDirs is set it uses those, else it pulls from assets.
-// Then it allows drivers to override, followed by replacements.
+// Then it allows drivers to override, then OverrideTemplateDirs,
+// followed by replacements. So a template of the same name is taken
+// from the user's override dirs, else the driver, else sqlboiler.
 //
 // Because there's the chance for windows paths to jumped in
 // all paths are converted to the native OS's slash style.
@@ -229,20 +350,8 @@ func (s *State) initTemplates() ([]lazyTemplate, error) {
 
 	templates := make(map[string]templateLoader)
 	if len(s.Config.TemplateDirs) != 0 {
-		for _, dir := range s.Config.TemplateDirs {
-			abs, err := filepath.Abs(dir)
-			if err != nil {
-				return nil, errors.Wrap(err, "could not find abs dir of templates directory")
-			}
-
-			base := filepath.Base(abs)
-			root := filepath.Dir(abs)
-			tpls, err := findTemplates(root, base)
-			if err != nil {
-				return nil, err
-			}
-
-			mergeTemplates(templates, tpls)
+		if err = mergeTemplateDirs(templates, s.Config.TemplateDirs); err != nil {
+			return nil, err
 		}
 	} else {
 		for _, a := range templatebin.AssetNames() {
@@ -263,6 +372,10 @@ func (s *State) initTemplates() ([]lazyTemplate, error) {
 		}
 	}
 
+	if err = mergeTemplateDirs(templates, s.Config.OverrideTemplateDirs); err != nil {
+		return nil, err
+	}
+
 	for _, replace := range s.Config.Replacements {
 		splits := strings.Split(replace, ";")
 		if len(splits) != 2 {
@@ -294,16 +407,26 @@ func (s *State) initTemplates() ([]lazyTemplate, error) {
 		})
 	}
 
+	inflections := template.FuncMap{
+		"singular":  s.Config.Inflections.Singular,
+		"plural":    s.Config.Inflections.Plural,
+		"titleCase": s.Config.Initialisms.TitleCase,
+		"camelCase": s.Config.Initialisms.CamelCase,
+		"tagCase":   s.Config.Initialisms.TagCase,
+	}
+
 	s.Templates, err = loadTemplates(lazyTemplates, false)
 	if err != nil {
 		return nil, err
 	}
+	s.Templates.Funcs(inflections)
 
 	if !s.Config.NoTests {
 		s.TestTemplates, err = loadTemplates(lazyTemplates, true)
 		if err != nil {
 			return nil, err
 		}
+		s.TestTemplates.Funcs(inflections)
 	}
 
 	return lazyTemplates, nil
@@ -342,6 +465,28 @@ func groupTemplates(templates *templateList) dirExtMap {
 	return dirs
 }
 
+// mergeTemplateDirs adds the templates of each directory to dst, keyed by
+// their path relative to the directory's parent, ex: templates/00_struct.go.tpl
+func mergeTemplateDirs(dst map[string]templateLoader, dirs []string) error {
+	for _, dir := range dirs {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return errors.Wrap(err, "could not find abs dir of templates directory")
+		}
+
+		base := filepath.Base(abs)
+		root := filepath.Dir(abs)
+		tpls, err := findTemplates(root, base)
+		if err != nil {
+			return err
+		}
+
+		mergeTemplates(dst, tpls)
+	}
+
+	return nil
+}
+
 // findTemplates uses a root path: (/home/user/gopath/src/../sqlboiler/)
 // and a base path: /templates
 // to create a bunch of file loaders of the form:
@@ -392,13 +537,97 @@ func (s *State) initDBInfo(config map[string]interface{}) error {
 		return err
 	}
 
+	// The driver's read_only_tables are already marked, these add to them
+	for i := range dbInfo.Tables {
+		if strmangle.SetInclude(dbInfo.Tables[i].Name, s.Config.ReadOnlyTables) {
+			dbInfo.Tables[i].ReadOnly = true
+		}
+	}
+
 	s.Schema = dbInfo.Schema
 	s.Tables = dbInfo.Tables
 	s.Dialect = dbInfo.Dialect
+	s.Functions = dbInfo.Functions
+	s.Enums = dbInfo.Enums
+	s.SchemaVersion = schemaVersion(dbInfo.Tables)
+	s.EngineVersion = dbInfo.EngineVersion
+	s.SchemaQuery = dbInfo.SchemaQuery
+
+	if warning := schemaWarning(dbInfo); len(warning) != 0 {
+		fmt.Fprintln(os.Stderr, warning)
+	}
 
 	return nil
 }
 
+// schemaWarning explains that a non-default schema was asked for but the
+// dialect won't qualify table names with it, so the generated queries would
+// resolve against the default schema instead. Empty when that's not the case.
+func schemaWarning(dbInfo *drivers.DBInfo) string {
+	if dbInfo.Dialect.UseSchema || len(dbInfo.DefaultSchema) == 0 || len(dbInfo.Schema) == 0 {
+		return ""
+	}
+	if dbInfo.Schema == dbInfo.DefaultSchema {
+		return ""
+	}
+
+	return fmt.Sprintf("warning: schema %q is not the default schema %q but table names will not be qualified, set %s = true to qualify them",
+		dbInfo.Schema, dbInfo.DefaultSchema, drivers.ConfigUseSchema)
+}
+
+// addFunctionImports adds the imports of the types the functions take and
+// return to the boil_functions singleton
+func (s *State) addFunctionImports() {
+	if len(s.Functions) == 0 {
+		return
+	}
+
+	var types []string
+	for _, f := range s.Functions {
+		types = append(types, f.ReturnType)
+		for _, p := range f.Params {
+			types = append(types, p.Type)
+		}
+		for _, c := range f.Columns {
+			types = append(types, c.Type)
+		}
+	}
+
+	if !s.Config.NoContext {
+		s.addSingletonImport("boil_functions", `"context"`)
+	}
+	imps := s.Config.Imports.Singleton["boil_functions"]
+	s.Config.Imports.Singleton["boil_functions"] = importers.AddTypeImports(imps, s.Config.Imports.BasedOnType, types)
+}
+
+// addSchemaImports adds the imports of VerifySchema to boil_schema, which is
+// only generated when the driver has a query to read the schema with
+func (s *State) addSchemaImports() {
+	if len(s.SchemaQuery) == 0 {
+		return
+	}
+
+	if !s.Config.NoContext {
+		s.addSingletonImport("boil_schema", `"context"`)
+	}
+	for _, imp := range []string{`"fmt"`, `"sort"`, `"strings"`} {
+		s.addSingletonImport("boil_schema", imp)
+	}
+	imps := s.Config.Imports.Singleton["boil_schema"]
+	imps.ThirdParty = append(imps.ThirdParty, `"github.com/friendsofgo/errors"`, `"github.com/volatiletech/sqlboiler/v4/boil"`)
+	s.Config.Imports.Singleton["boil_schema"] = imps
+}
+
+// addSingletonImport adds a standard library import to a singleton template
+func (s *State) addSingletonImport(singleton, imp string) {
+	if s.Config.Imports.Singleton == nil {
+		s.Config.Imports.Singleton = make(importers.Map)
+	}
+	imps := s.Config.Imports.Singleton[singleton]
+	imps.Standard = append(imps.Standard, imp)
+	s.Config.Imports.Singleton[singleton] = imps
+}
+
 // mergeDriverImports calls the driver and asks for its set
 // of imports, then merges it into the current configuration's
 // imports.
@@ -596,8 +825,13 @@ func (s *State) initTags(tags []string) error {
 }
 
 func (s *State) initAliases(a *Aliases) error {
-	FillAliases(a, s.Tables)
-	return nil
+	rewrites, err := compileNameRewrites(s.Config.NameRewrites)
+	if err != nil {
+		return err
+	}
+
+	fillAliases(a, s.Tables, s.Config.Inflections, s.Config.Initialisms, rewrites)
+	return checkAliasCollisions(*a, s.Tables)
 }
 
 // checkPKeys ensures every table has a primary key column
@@ -10,12 +10,14 @@ import (
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/volatiletech/sqlboiler/v4/importers"
 
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 	_ "github.com/volatiletech/sqlboiler/v4/drivers/mocks"
+	mssql "github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-mssql/driver"
 )
 
 var state *State
@@ -303,3 +305,165 @@ func TestProcessTypeReplacements(t *testing.T) {
 		t.Error("imports were not adjusted")
 	}
 }
+
+func TestOverrideTemplateDirs(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "boil_override")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	userDir := filepath.Join(dir, "templates")
+	if err = os.Mkdir(userDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// 17_upsert.go.tpl is overridden by the mssql driver
+	user := "// user upsert for {{.Table.Name}}\n"
+	if err = ioutil.WriteFile(filepath.Join(userDir, "17_upsert.go.tpl"), []byte(user), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	name := normalizeSlashes("templates/17_upsert.go.tpl")
+	s := &State{Driver: &mssql.MSSQLDriver{}, Config: &Config{NoTests: true}}
+	if _, err = s.initTemplates(); err != nil {
+		t.Fatal(err)
+	}
+	if tpl := s.Templates.Lookup(name); tpl == nil || !strings.Contains(tpl.Tree.Root.String(), "UpsertWithResult") {
+		t.Error("want the driver's upsert without overrides")
+	}
+
+	s = &State{Driver: &mssql.MSSQLDriver{}, Config: &Config{NoTests: true, OverrideTemplateDirs: []string{userDir}}}
+	if _, err = s.initTemplates(); err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err = s.Templates.ExecuteTemplate(buf, name, &templateData{Table: drivers.Table{Name: "pilots"}}); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); out != "// user upsert for pilots\n" {
+		t.Error("want the user's upsert over the driver's:\n", out)
+	}
+	if s.Templates.Lookup(normalizeSlashes("templates/00_struct.go.tpl")) == nil {
+		t.Error("templates that aren't overridden should be kept")
+	}
+}
+
+func TestReadOnlyTables(t *testing.T) {
+	t.Parallel()
+
+	// The top level option and the driver's do the same
+	configs := map[string]func(c *Config){
+		"config": func(c *Config) { c.ReadOnlyTables = []string{"airports"} },
+		"driver": func(c *Config) { c.DriverConfig[drivers.ConfigReadOnlyTables] = []string{"airports"} },
+	}
+	for name, configure := range configs {
+		configure := configure
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			testReadOnlyTables(t, configure)
+		})
+	}
+}
+
+func testReadOnlyTables(t *testing.T, configure func(c *Config)) {
+	files := generateMock(t, func(c *Config) {
+		c.NoTests = false
+		configure(c)
+	})
+
+	airports := string(files["airports.go"])
+	for _, absent := range []string{") Insert(", ") Update(", ") Delete(", ") UpdateAll(", ") DeleteAll("} {
+		if strings.Contains(airports, absent) {
+			t.Errorf("read only table should not have %s", absent)
+		}
+	}
+	for _, present := range []string{"func FindAirport(", "func Airports(", ") All(", "func AirportExists("} {
+		if !strings.Contains(airports, present) {
+			t.Errorf("read only table should have %s", present)
+		}
+	}
+	if strings.Contains(airports, "func (o *Airport) AddJets(") {
+		t.Error("read only table should not have relationship setops")
+	}
+
+	jets := string(files["jets.go"])
+	if !strings.Contains(jets, ") LoadAirport(") {
+		t.Error("writable tables should still load read only relationships")
+	}
+	if strings.Contains(jets, "func (o *Jet) SetAirport(") {
+		t.Error("writable tables should not set read only relationships")
+	}
+	if !strings.Contains(jets, "func (o *Jet) SetPilot(") {
+		t.Error("writable tables should set writable relationships")
+	}
+
+	if _, ok := files["airports_test.go"]; ok {
+		t.Error("read only table should not have tests")
+	}
+	if strings.Contains(string(files["jets_test.go"]), "ToOneAirport") || strings.Contains(string(files["jets_test.go"]), "SetOpAirport") {
+		t.Error("writable table tests should not insert into read only tables")
+	}
+	if strings.Contains(string(files["boil_suites_test.go"]), "testAirports") {
+		t.Error("suites should not run read only table tests")
+	}
+}
+
+func TestSchemaQualification(t *testing.T) {
+	t.Parallel()
+
+	qualified := generateMock(t, func(c *Config) {
+		c.DriverConfig[drivers.ConfigUseSchema] = true
+	})
+	if !strings.Contains(string(qualified["jets.go"]), `\"schema\".\"jets\"`) {
+		t.Error("want table names qualified with the schema")
+	}
+
+	unqualified := generateMock(t, nil)
+	if strings.Contains(string(unqualified["jets.go"]), `\"schema\".`) {
+		t.Error("want table names left unqualified")
+	}
+
+	tests := []struct {
+		Schema    string
+		Default   string
+		UseSchema bool
+		Warn      bool
+	}{
+		{"sales", "dbo", false, true},
+		{"sales", "dbo", true, false},
+		{"dbo", "dbo", false, false},
+		{"sales", "", false, false},
+	}
+
+	for i, test := range tests {
+		info := &drivers.DBInfo{
+			Schema:        test.Schema,
+			DefaultSchema: test.Default,
+			Dialect:       drivers.Dialect{UseSchema: test.UseSchema},
+		}
+		if got := schemaWarning(info); (len(got) != 0) != test.Warn {
+			t.Errorf("%d) want warning %t, got: %q", i, test.Warn, got)
+		}
+	}
+}
+
+func TestFunctionWrappers(t *testing.T) {
+	t.Parallel()
+
+	files := generateMock(t, nil)
+	out := string(files["boil_functions.go"])
+
+	for _, want := range []string{
+		"func PilotJetCount(ctx context.Context, exec boil.ContextExecutor, pilotID int) (int, error)",
+		`queries.Raw("SELECT \"pilot_jet_count\"($1)", pilotID)`,
+		"type JetsForPilotRow struct",
+		"func JetsForPilot(ctx context.Context, exec boil.ContextExecutor, pilotID int, name string) ([]*JetsForPilotRow, error)",
+		`queries.Raw("SELECT * FROM \"jets_for_pilot\"($1,$2)", pilotID, name)`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package boilingcore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// cascadeDelete is a statement DeleteCascade runs before deleting the row
+// itself, it deletes the rows of Table referencing the row through a chain of
+// foreign keys. Args are the columns of the row its placeholders are bound to.
+type cascadeDelete struct {
+	Table string
+	SQL   string
+	Args  []string
+}
+
+// cascadePlan is what DeleteCascade deletes for a table, the rows furthest
+// from it first. Cycle names the tables of a cycle in the foreign keys, the
+// plan can't be run when there is one.
+type cascadePlan struct {
+	Deletes []cascadeDelete
+	Cycle   []string
+}
+
+// DeleteCascadePlan walks the foreign keys referencing the table to find the
+// rows that must be deleted along with one of its rows. Keys the database
+// resolves itself, with ON DELETE SET NULL or SET DEFAULT, are skipped, and so
+// are ON DELETE CASCADE ones unless rows further down need deleting by hand.
+func (t templateData) DeleteCascadePlan(table string) cascadePlan {
+	var plan cascadePlan
+
+	// visit plans the deletes of the rows referencing the last table of stack,
+	// reached from the table through path, and reports whether it planned any
+	var visit func(stack []string, path []drivers.ForeignKey) bool
+	visit = func(stack []string, path []drivers.ForeignKey) bool {
+		planned := false
+		for _, child := range t.Tables {
+			for _, fk := range child.FKeys {
+				if fk.ForeignTable != stack[len(stack)-1] || fk.SetsOnDelete() {
+					continue
+				}
+				if i := indexOf(stack, child.Name); i >= 0 {
+					if plan.Cycle == nil {
+						plan.Cycle = append(append([]string{}, stack[i:]...), child.Name)
+					}
+					continue
+				}
+
+				childPath := append(path[:len(path):len(path)], fk)
+				deeper := visit(append(stack[:len(stack):len(stack)], child.Name), childPath)
+				if deeper || !fk.CascadesOnDelete() {
+					plan.Deletes = append(plan.Deletes, t.cascadeDelete(childPath))
+					planned = true
+				}
+			}
+		}
+		return planned
+	}
+	visit([]string{table}, nil)
+
+	if plan.Cycle != nil {
+		plan.Deletes = nil
+	}
+	return plan
+}
+
+// cascadeDelete builds the statement deleting the rows at the end of path,
+// joined back to the row being deleted through the tables before them.
+func (t templateData) cascadeDelete(path []drivers.ForeignKey) cascadeDelete {
+	last := path[len(path)-1]
+	del := cascadeDelete{Table: last.Table, Args: path[0].ForeignColumns}
+
+	var conds []string
+	for i := len(path) - 1; i > 0; i-- {
+		fk := path[i]
+		for j, c := range fk.Columns {
+			conds = append(conds, fmt.Sprintf("%s.%s = %s.%s",
+				t.SchemaTable(fk.Table), t.Quotes(c), t.SchemaTable(fk.ForeignTable), t.Quotes(fk.ForeignColumns[j])))
+		}
+	}
+	for i, c := range path[0].Columns {
+		placeholder := "?"
+		if t.Dialect.UseIndexPlaceholders {
+			placeholder = fmt.Sprintf("$%d", i+1)
+		}
+		conds = append(conds, fmt.Sprintf("%s.%s = %s", t.SchemaTable(path[0].Table), t.Quotes(c), placeholder))
+	}
+
+	if len(path) == 1 {
+		del.SQL = fmt.Sprintf("DELETE FROM %s WHERE %s", t.SchemaTable(last.Table), strings.Join(conds, " AND "))
+		return del
+	}
+
+	joined := make([]string, 0, len(path)-1)
+	for i := len(path) - 2; i >= 0; i-- {
+		joined = append(joined, t.SchemaTable(path[i].Table))
+	}
+	del.SQL = fmt.Sprintf("DELETE FROM %s WHERE EXISTS (SELECT 1 FROM %s WHERE %s)",
+		t.SchemaTable(last.Table), strings.Join(joined, ", "), strings.Join(conds, " AND "))
+	return del
+}
+
+func indexOf(s []string, v string) int {
+	for i, e := range s {
+		if e == v {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,149 @@
+package boilingcore
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func cascadeTables(fkeys map[string][]drivers.ForeignKey) []drivers.Table {
+	var tables []drivers.Table
+	for _, name := range []string{"airlines", "jets", "seats", "crews"} {
+		table := drivers.Table{
+			Name:    name,
+			Columns: []drivers.Column{{Name: "id", Type: "int"}},
+			PKey:    &drivers.PrimaryKey{Name: "pk_" + name, Columns: []string{"id"}},
+			FKeys:   fkeys[name],
+		}
+		for _, fk := range fkeys[name] {
+			table.Columns = append(table.Columns, drivers.Column{Name: fk.Column, Type: "int"})
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+func cascadeFKey(table, column, foreignTable, onDelete string) drivers.ForeignKey {
+	return drivers.ForeignKey{
+		Name: "fk_" + table + "_" + column, Table: table, Column: column, ForeignTable: foreignTable, ForeignColumn: "id",
+		Columns: []string{column}, ForeignColumns: []string{"id"}, OnDelete: onDelete,
+	}
+}
+
+func TestDeleteCascadePlan(t *testing.T) {
+	t.Parallel()
+
+	data := templateData{
+		Tables: cascadeTables(map[string][]drivers.ForeignKey{
+			"jets":  {cascadeFKey("jets", "airline_id", "airlines", "NO ACTION")},
+			"seats": {cascadeFKey("seats", "jet_id", "jets", "NO ACTION")},
+			"crews": {cascadeFKey("crews", "airline_id", "airlines", "SET NULL")},
+		}),
+		Dialect: drivers.Dialect{UseIndexPlaceholders: true},
+		LQ:      `"`,
+		RQ:      `"`,
+	}
+
+	plan := data.DeleteCascadePlan("airlines")
+	if plan.Cycle != nil {
+		t.Fatal("want no cycle, got:", plan.Cycle)
+	}
+	// Grandchildren first, the crews are set to null by the database
+	want := []cascadeDelete{
+		{
+			Table: "seats",
+			SQL:   `DELETE FROM "seats" WHERE EXISTS (SELECT 1 FROM "jets" WHERE "seats"."jet_id" = "jets"."id" AND "jets"."airline_id" = $1)`,
+			Args:  []string{"id"},
+		},
+		{
+			Table: "jets",
+			SQL:   `DELETE FROM "jets" WHERE "jets"."airline_id" = $1`,
+			Args:  []string{"id"},
+		},
+	}
+	if !reflect.DeepEqual(plan.Deletes, want) {
+		t.Errorf("want deletes:\n%#v\ngot:\n%#v", want, plan.Deletes)
+	}
+
+	if plan := data.DeleteCascadePlan("seats"); len(plan.Deletes) != 0 || plan.Cycle != nil {
+		t.Errorf("want nothing to delete for a table without references, got: %#v", plan)
+	}
+}
+
+func TestDeleteCascadePlanDatabaseCascade(t *testing.T) {
+	t.Parallel()
+
+	data := templateData{
+		Tables: cascadeTables(map[string][]drivers.ForeignKey{
+			"jets":  {cascadeFKey("jets", "airline_id", "airlines", "CASCADE")},
+			"seats": {cascadeFKey("seats", "jet_id", "jets", "CASCADE")},
+		}),
+		LQ: `"`,
+		RQ: `"`,
+	}
+	if plan := data.DeleteCascadePlan("airlines"); len(plan.Deletes) != 0 {
+		t.Errorf("want the database's cascades left to it, got: %#v", plan.Deletes)
+	}
+
+	// The jets are deleted by hand once their seats must be
+	data.Tables[2].FKeys[0].OnDelete = "NO ACTION"
+	plan := data.DeleteCascadePlan("airlines")
+	if len(plan.Deletes) != 2 || plan.Deletes[0].Table != "seats" || plan.Deletes[1].Table != "jets" {
+		t.Errorf("want the seats then the jets deleted, got: %#v", plan.Deletes)
+	}
+	if !strings.Contains(plan.Deletes[0].SQL, `"jets"."airline_id" = ?`) {
+		t.Errorf("want question mark placeholders, got: %s", plan.Deletes[0].SQL)
+	}
+}
+
+func TestDeleteCascadePlanCycle(t *testing.T) {
+	t.Parallel()
+
+	data := templateData{
+		Tables: cascadeTables(map[string][]drivers.ForeignKey{
+			"jets":     {cascadeFKey("jets", "airline_id", "airlines", "NO ACTION")},
+			"seats":    {cascadeFKey("seats", "jet_id", "jets", "NO ACTION")},
+			"airlines": {cascadeFKey("airlines", "flagship_seat_id", "seats", "NO ACTION")},
+		}),
+		LQ: `"`,
+		RQ: `"`,
+	}
+
+	plan := data.DeleteCascadePlan("airlines")
+	if want := []string{"airlines", "jets", "seats", "airlines"}; !reflect.DeepEqual(plan.Cycle, want) {
+		t.Errorf("want cycle %v, got: %v", want, plan.Cycle)
+	}
+	if len(plan.Deletes) != 0 {
+		t.Errorf("want no deletes with a cycle, got: %#v", plan.Deletes)
+	}
+
+	b, err := assetLoader("templates/34_delete_cascade.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err := template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data.Table = data.Tables[0]
+	data.PkgName = "models"
+	data.GenerateDeleteCascade = true
+	data.StringFuncs = templateStringMappers
+	FillAliases(&data.Aliases, data.Tables)
+
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, data); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, `return errors.New("models: unable to cascade the delete of airlines, the foreign keys of airlines -> jets -> seats -> airlines form a cycle")`) {
+		t.Error("want the cycle returned as an error:\n", out)
+	}
+	if strings.Contains(out, "InTx") {
+		t.Error("want nothing deleted with a cycle:\n", out)
+	}
+}
@@ -14,31 +14,60 @@ type Config struct {
 	DriverName   string         `toml:"driver_name,omitempty" json:"driver_name,omitempty"`
 	DriverConfig drivers.Config `toml:"driver_config,omitempty" json:"driver_config,omitempty"`
 
-	PkgName           string   `toml:"pkg_name,omitempty" json:"pkg_name,omitempty"`
-	OutFolder         string   `toml:"out_folder,omitempty" json:"out_folder,omitempty"`
-	TemplateDirs      []string `toml:"template_dirs,omitempty" json:"template_dirs,omitempty"`
-	Tags              []string `toml:"tags,omitempty" json:"tags,omitempty"`
-	Replacements      []string `toml:"replacements,omitempty" json:"replacements,omitempty"`
-	Debug             bool     `toml:"debug,omitempty" json:"debug,omitempty"`
-	AddGlobal         bool     `toml:"add_global,omitempty" json:"add_global,omitempty"`
-	AddPanic          bool     `toml:"add_panic,omitempty" json:"add_panic,omitempty"`
-	AddSoftDeletes    bool     `toml:"add_soft_deletes,omitempty" json:"add_soft_deletes,omitempty"`
-	NoContext         bool     `toml:"no_context,omitempty" json:"no_context,omitempty"`
-	NoTests           bool     `toml:"no_tests,omitempty" json:"no_tests,omitempty"`
-	NoHooks           bool     `toml:"no_hooks,omitempty" json:"no_hooks,omitempty"`
-	NoAutoTimestamps  bool     `toml:"no_auto_timestamps,omitempty" json:"no_auto_timestamps,omitempty"`
-	NoRowsAffected    bool     `toml:"no_rows_affected,omitempty" json:"no_rows_affected,omitempty"`
-	NoDriverTemplates bool     `toml:"no_driver_templates,omitempty" json:"no_driver_templates,omitempty"`
-	NoBackReferencing bool     `toml:"no_back_reference,omitempty" json:"no_back_reference,omitempty"`
-	Wipe              bool     `toml:"wipe,omitempty" json:"wipe,omitempty"`
-	StructTagCasing   string   `toml:"struct_tag_casing,omitempty" json:"struct_tag_casing,omitempty"`
-	RelationTag       string   `toml:"relation_tag,omitempty" json:"relation_tag,omitempty"`
-	TagIgnore         []string `toml:"tag_ignore,omitempty" json:"tag_ignore,omitempty"`
+	PkgName               string   `toml:"pkg_name,omitempty" json:"pkg_name,omitempty"`
+	OutFolder             string   `toml:"out_folder,omitempty" json:"out_folder,omitempty"`
+	TemplateDirs          []string `toml:"template_dirs,omitempty" json:"template_dirs,omitempty"`
+	OverrideTemplateDirs  []string `toml:"override_template_dirs,omitempty" json:"override_template_dirs,omitempty"`
+	Tags                  []string `toml:"tags,omitempty" json:"tags,omitempty"`
+	Replacements          []string `toml:"replacements,omitempty" json:"replacements,omitempty"`
+	Debug                 bool     `toml:"debug,omitempty" json:"debug,omitempty"`
+	AddGlobal             bool     `toml:"add_global,omitempty" json:"add_global,omitempty"`
+	AddPanic              bool     `toml:"add_panic,omitempty" json:"add_panic,omitempty"`
+	AddSoftDeletes        bool     `toml:"add_soft_deletes,omitempty" json:"add_soft_deletes,omitempty"`
+	NoContext             bool     `toml:"no_context,omitempty" json:"no_context,omitempty"`
+	NoTests               bool     `toml:"no_tests,omitempty" json:"no_tests,omitempty"`
+	NoHooks               bool     `toml:"no_hooks,omitempty" json:"no_hooks,omitempty"`
+	NoAutoTimestamps      bool     `toml:"no_auto_timestamps,omitempty" json:"no_auto_timestamps,omitempty"`
+	NoRowsAffected        bool     `toml:"no_rows_affected,omitempty" json:"no_rows_affected,omitempty"`
+	NoDriverTemplates     bool     `toml:"no_driver_templates,omitempty" json:"no_driver_templates,omitempty"`
+	NoBackReferencing     bool     `toml:"no_back_reference,omitempty" json:"no_back_reference,omitempty"`
+	EmitNameConstants     bool     `toml:"emit_name_constants,omitempty" json:"emit_name_constants,omitempty"`
+	GenerateIndexMetadata bool     `toml:"generate_index_metadata,omitempty" json:"generate_index_metadata,omitempty"`
+	GenerateInterfaces    bool     `toml:"generate_interfaces,omitempty" json:"generate_interfaces,omitempty"`
+	GenerateDTOs          bool     `toml:"generate_dtos,omitempty" json:"generate_dtos,omitempty"`
+	DTONullStyle          string   `toml:"dto_null_style,omitempty" json:"dto_null_style,omitempty"`
+	GenerateValidate      bool     `toml:"generate_validate,omitempty" json:"generate_validate,omitempty"`
+	GenerateConstructors  bool     `toml:"generate_constructors,omitempty" json:"generate_constructors,omitempty"`
+	GenerateChangesets    bool     `toml:"generate_changesets,omitempty" json:"generate_changesets,omitempty"`
+	GenerateDeleteCascade bool     `toml:"generate_delete_cascade,omitempty" json:"generate_delete_cascade,omitempty"`
+	GenerateInsertIgnore  bool     `toml:"generate_insert_ignore,omitempty" json:"generate_insert_ignore,omitempty"`
+	GenerateSchemaVersion bool     `toml:"generate_schema_version,omitempty" json:"generate_schema_version,omitempty"`
+	JSONMethods           bool     `toml:"json_methods,omitempty" json:"json_methods,omitempty"`
+	JSONNullPolicy        string   `toml:"json_null_policy,omitempty" json:"json_null_policy,omitempty"`
+	NullableStyle         string   `toml:"nullable_style,omitempty" json:"nullable_style,omitempty"`
+	NullablePackage       string   `toml:"nullable_package,omitempty" json:"nullable_package,omitempty"`
+	OrderColumns          string   `toml:"order_columns,omitempty" json:"order_columns,omitempty"`
+	BulkInsertBatchSize   int      `toml:"bulk_insert_batch_size,omitempty" json:"bulk_insert_batch_size,omitempty"`
+	Wipe                  bool     `toml:"wipe,omitempty" json:"wipe,omitempty"`
+	Incremental           bool     `toml:"incremental,omitempty" json:"incremental,omitempty"`
+	StructTagCasing       string   `toml:"struct_tag_casing,omitempty" json:"struct_tag_casing,omitempty"`
+	RelationTag           string   `toml:"relation_tag,omitempty" json:"relation_tag,omitempty"`
+	TagIgnore             []string `toml:"tag_ignore,omitempty" json:"tag_ignore,omitempty"`
+	ReadOnlyTables        []string `toml:"read_only_tables,omitempty" json:"read_only_tables,omitempty"`
+
+	StructTagCases StructTagCases `toml:"struct_tag_cases,omitempty" json:"struct_tag_cases,omitempty"`
 
 	Imports importers.Collection `toml:"imports,omitempty" json:"imports,omitempty"`
 
 	Aliases      Aliases       `toml:"aliases,omitempty" json:"aliases,omitempty"`
 	TypeReplaces []TypeReplace `toml:"type_replaces,omitempty" json:"type_replaces,omitempty"`
+	Inflections  Inflections   `toml:"inflections,omitempty" json:"inflections,omitempty"`
+	Initialisms  Initialisms   `toml:"initialisms,omitempty" json:"initialisms,omitempty"`
+	NameRewrites []NameRewrite `toml:"name_rewrites,omitempty" json:"name_rewrites,omitempty"`
+	Embeds       []Embed       `toml:"embed,omitempty" json:"embed,omitempty"`
+	Projections  []Projection  `toml:"projection,omitempty" json:"projection,omitempty"`
+	Mixins       []Mixin       `toml:"mixin,omitempty" json:"mixin,omitempty"`
+	Scanners     []Scanner     `toml:"scanners,omitempty" json:"scanners,omitempty"`
 
 	Version string `toml:"version" json:"version"`
 }
@@ -0,0 +1,173 @@
+package boilingcore
+
+import (
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cast"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+	"github.com/volatiletech/strmangle"
+)
+
+// Embed is a group of columns generated as a struct of their own, which is
+// embedded in every model that has all of them instead of repeating them as
+// fields of the model, ex: AuditFields for created_at and updated_at.
+type Embed struct {
+	Name    string   `toml:"name,omitempty" json:"name,omitempty"`
+	Columns []string `toml:"columns,omitempty" json:"columns,omitempty"`
+}
+
+// EmbedField is a column of an embedded struct
+type EmbedField struct {
+	Name   string
+	Column drivers.Column
+}
+
+// resolvedEmbed is an embed with its fields and the tables embedding it
+type resolvedEmbed struct {
+	Name   string
+	Fields []EmbedField
+	Tables []string
+}
+
+// ConvertEmbeds is necessary because viper
+//
+// It supports the following syntax:
+//
+//	[[embed]]
+//	name = "AuditFields"
+//	columns = ["created_at", "updated_at"]
+func ConvertEmbeds(i interface{}) []Embed {
+	if i == nil {
+		return nil
+	}
+
+	intfArray := i.([]interface{})
+	embeds := make([]Embed, 0, len(intfArray))
+	for _, e := range intfArray {
+		embedIntf := cast.ToStringMap(e)
+		if embedIntf["name"] == nil || embedIntf["columns"] == nil {
+			panic("embeds must specify both name and columns")
+		}
+
+		embeds = append(embeds, Embed{
+			Name:    cast.ToString(embedIntf["name"]),
+			Columns: cast.ToStringSlice(embedIntf["columns"]),
+		})
+	}
+
+	return embeds
+}
+
+// initEmbeds finds the tables each embed applies to. The first table with all
+// of an embed's columns decides the types and names of its fields, the other
+// tables only embed it when their columns match those exactly and keep the
+// columns as their own fields otherwise. Embeds no table matches are dropped.
+func (s *State) initEmbeds() error {
+	claimed := make(map[string]string)
+	for _, e := range s.Config.Embeds {
+		if len(e.Name) == 0 || len(e.Columns) == 0 {
+			return errors.New("embeds must specify both name and columns")
+		}
+		for _, c := range e.Columns {
+			if other, ok := claimed[c]; ok {
+				return errors.Errorf("column %s is in both the %s and %s embeds", c, other, e.Name)
+			}
+			claimed[c] = e.Name
+		}
+
+		resolved := resolvedEmbed{Name: e.Name}
+		for _, t := range s.Tables {
+			if t.IsJoinTable {
+				continue
+			}
+
+			alias := s.Config.Aliases.Table(t.Name)
+			fields := make([]EmbedField, 0, len(e.Columns))
+			for _, name := range e.Columns {
+				col, ok := findColumn(t, name)
+				if !ok {
+					break
+				}
+				fields = append(fields, EmbedField{Name: alias.Column(name), Column: col})
+			}
+			if len(fields) != len(e.Columns) {
+				continue
+			}
+
+			if resolved.Fields == nil {
+				resolved.Fields = fields
+			} else if !sameEmbedFields(resolved.Fields, fields) {
+				continue
+			}
+			resolved.Tables = append(resolved.Tables, t.Name)
+		}
+
+		if len(resolved.Tables) != 0 {
+			s.Embeds = append(s.Embeds, resolved)
+		}
+	}
+
+	if len(s.Embeds) == 0 {
+		return nil
+	}
+
+	var types []string
+	for _, e := range s.Embeds {
+		for _, f := range e.Fields {
+			types = append(types, f.Column.Type)
+		}
+	}
+
+	if s.Config.Imports.Singleton == nil {
+		s.Config.Imports.Singleton = make(importers.Map)
+	}
+	imps := s.Config.Imports.Singleton["boil_embeds"]
+	s.Config.Imports.Singleton["boil_embeds"] = importers.AddTypeImports(imps, s.Config.Imports.BasedOnType, types)
+
+	return nil
+}
+
+func findColumn(t drivers.Table, name string) (drivers.Column, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+
+	return drivers.Column{}, false
+}
+
+func sameEmbedFields(a, b []EmbedField) bool {
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Column.Type != b[i].Column.Type {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TableEmbeds returns the embeds of the table
+func (t templateData) TableEmbeds(table string) []resolvedEmbed {
+	var embeds []resolvedEmbed
+	for _, e := range t.Embeds {
+		if strmangle.SetInclude(table, e.Tables) {
+			embeds = append(embeds, e)
+		}
+	}
+
+	return embeds
+}
+
+// Embedded is true when the column of the table is a field of an embed
+func (t templateData) Embedded(table, column string) bool {
+	for _, e := range t.TableEmbeds(table) {
+		for _, f := range e.Fields {
+			if f.Column.Name == column {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+package boilingcore
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+func TestEmbeds(t *testing.T) {
+	t.Parallel()
+
+	audit := []drivers.Column{
+		{Name: "created_at", Type: "time.Time", DBType: "timestamp"},
+		{Name: "updated_at", Type: "null.Time", DBType: "timestamp", Nullable: true},
+	}
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: append([]drivers.Column{{Name: "id", Type: "int"}, {Name: "name", Type: "string"}}, audit...),
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	jets := drivers.Table{
+		Name:    "jets",
+		Columns: append([]drivers.Column{{Name: "id", Type: "int"}}, audit...),
+		PKey:    &drivers.PrimaryKey{Name: "pk_jets", Columns: []string{"id"}},
+	}
+	// licenses only has one of the columns so it keeps it as a field
+	licenses := drivers.Table{
+		Name:    "licenses",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}, audit[0]},
+		PKey:    &drivers.PrimaryKey{Name: "pk_licenses", Columns: []string{"id"}},
+	}
+
+	s := &State{
+		Config: &Config{
+			Imports: importers.Collection{BasedOnType: importers.Map{
+				"null.Time": {ThirdParty: importers.List{`"github.com/volatiletech/null/v8"`}},
+			}},
+			Embeds: []Embed{{Name: "AuditFields", Columns: []string{"created_at", "updated_at"}}},
+		},
+		Tables: []drivers.Table{pilots, jets, licenses},
+	}
+	FillAliases(&s.Config.Aliases, s.Tables)
+	if err := s.initEmbeds(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.Embeds) != 1 {
+		t.Fatalf("want one embed, got %d", len(s.Embeds))
+	}
+	if got := strings.Join(s.Embeds[0].Tables, ","); got != "pilots,jets" {
+		t.Errorf("want the embed in pilots and jets, got: %s", got)
+	}
+	if imps := s.Config.Imports.Singleton["boil_embeds"]; !strings.Contains(strings.Join(imps.ThirdParty, ","), "null") {
+		t.Errorf("want the field type imports, got: %#v", imps)
+	}
+
+	b, err := assetLoader("templates/00_struct.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err := template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := &templateData{
+		Aliases:     s.Config.Aliases,
+		Embeds:      s.Embeds,
+		PkgName:     "models",
+		Dialect:     drivers.Dialect{LQ: '"', RQ: '"'},
+		LQ:          `\"`,
+		RQ:          `\"`,
+		DBTypes:     make(once),
+		StringFuncs: templateStringMappers,
+	}
+	for _, table := range []drivers.Table{pilots, jets} {
+		data.Table = table
+		buf := &bytes.Buffer{}
+		if err = tpl.Execute(buf, data); err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		if !strings.Contains(out, "\tAuditFields `boil:\",inline\" yaml:\",inline\"`\n") {
+			t.Errorf("%s: want the audit fields embedded:\n%s", table.Name, out)
+		}
+		if strings.Contains(out, `boil:"created_at"`) || strings.Contains(out, `boil:"updated_at"`) {
+			t.Errorf("%s: want no audit columns on the model:\n%s", table.Name, out)
+		}
+		if !strings.Contains(out, `CreatedAt string`) {
+			t.Errorf("%s: want the column names kept:\n%s", table.Name, out)
+		}
+	}
+
+	data.Table = licenses
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, data); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); strings.Contains(out, "AuditFields") || !strings.Contains(out, `boil:"created_at"`) {
+		t.Errorf("want licenses to keep its column:\n%s", out)
+	}
+
+	b, err = assetLoader("templates/singleton/boil_embeds.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err = template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if err = tpl.Execute(buf, data); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"type AuditFields struct",
+		"CreatedAt time.Time `boil:\"created_at\" json:\"created_at\"",
+		"UpdatedAt null.Time `boil:\"updated_at\" json:\"updated_at,omitempty\"",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+}
+
+func TestEmbedsOverlap(t *testing.T) {
+	t.Parallel()
+
+	s := &State{
+		Config: &Config{
+			Embeds: []Embed{
+				{Name: "AuditFields", Columns: []string{"created_at", "updated_at"}},
+				{Name: "Created", Columns: []string{"created_at"}},
+			},
+		},
+	}
+	if err := s.initEmbeds(); err == nil {
+		t.Error("want an error for a column in two embeds")
+	}
+}
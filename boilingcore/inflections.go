@@ -0,0 +1,62 @@
+package boilingcore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/strmangle"
+)
+
+// Inflections maps plural words to their singular form, ex: metadata = "metadata".
+// They are consulted before strmangle's rules, which get some irregular
+// words wrong. Like strmangle only the last word of a snake_case name is
+// inflected, and casing (acronyms like ID) is still left to TitleCase.
+type Inflections map[string]string
+
+// Singular returns the singular form of name
+func (i Inflections) Singular(name string) string {
+	prefix, word := splitLastWord(name)
+	if singular, ok := i[strings.ToLower(word)]; ok {
+		return prefix + singular
+	}
+	for _, plural := range i.plurals() {
+		if strings.EqualFold(i[plural], word) {
+			return name
+		}
+	}
+
+	return strmangle.Singular(name)
+}
+
+// Plural returns the plural form of name
+func (i Inflections) Plural(name string) string {
+	prefix, word := splitLastWord(name)
+	for _, plural := range i.plurals() {
+		if strings.EqualFold(i[plural], word) {
+			return prefix + plural
+		}
+	}
+	if _, ok := i[strings.ToLower(word)]; ok {
+		return name
+	}
+
+	return strmangle.Plural(name)
+}
+
+// plurals returns the plural words in sorted order, so that when several
+// plurals share a singular the same one is picked on every run
+func (i Inflections) plurals() []string {
+	plurals := make([]string, 0, len(i))
+	for plural := range i {
+		plurals = append(plurals, plural)
+	}
+	sort.Strings(plurals)
+
+	return plurals
+}
+
+// splitLastWord splits a snake_case name before its last word
+func splitLastWord(name string) (prefix, word string) {
+	idx := strings.LastIndexByte(name, '_')
+	return name[:idx+1], name[idx+1:]
+}
@@ -0,0 +1,93 @@
+package boilingcore
+
+import (
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestInflections(t *testing.T) {
+	t.Parallel()
+
+	inflections := Inflections{"metadata": "metadata", "cpus": "cpu"}
+
+	tests := []struct {
+		Plural   string
+		Singular string
+	}{
+		{"metadata", "metadata"},
+		{"video_metadata", "video_metadata"},
+		{"cpus", "cpu"},
+		{"server_cpus", "server_cpu"},
+		{"videos", "video"},
+	}
+
+	for i, test := range tests {
+		if got := inflections.Singular(test.Plural); got != test.Singular {
+			t.Errorf("%d) singular want: %s, got: %s", i, test.Singular, got)
+		}
+		if got := inflections.Plural(test.Singular); got != test.Plural {
+			t.Errorf("%d) plural want: %s, got: %s", i, test.Plural, got)
+		}
+	}
+}
+
+func TestInflectionsSharedSingular(t *testing.T) {
+	t.Parallel()
+
+	inflections := Inflections{"persons": "person", "people": "person"}
+
+	for i := 0; i < 50; i++ {
+		if got := inflections.Plural("person"); got != "people" {
+			t.Fatalf("%d) plural want: people, got: %s", i, got)
+		}
+	}
+	if got := inflections.Singular("persons"); got != "person" {
+		t.Error("singular want: person, got:", got)
+	}
+}
+
+func TestAliasesInflections(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name:    "cpus",
+			Columns: []drivers.Column{{Name: "id"}},
+		},
+		{
+			Name:    "video_metadata",
+			Columns: []drivers.Column{{Name: "id"}, {Name: "cpu_id"}},
+			FKeys: []drivers.ForeignKey{
+				{
+					Name:          "video_metadata_cpu_fkey",
+					Table:         "video_metadata",
+					Column:        "cpu_id",
+					ForeignTable:  "cpus",
+					ForeignColumn: "id",
+				},
+			},
+		},
+	}
+
+	a := Aliases{}
+	fillAliases(&a, tables, Inflections{"metadata": "metadata", "cpus": "cpu"}, Initialisms{}, nil)
+
+	if got := a.Tables["video_metadata"].UpSingular; got != "VideoMetadata" {
+		t.Error("wrong singular model name:", got)
+	}
+	if got := a.Tables["cpus"].UpSingular; got != "CPU" {
+		t.Error("wrong singular model name, acronyms should be kept:", got)
+	}
+	if got := a.Tables["cpus"].DownSingular; got != "cpu" {
+		t.Error("wrong singular variable name:", got)
+	}
+
+	rel := a.Tables["video_metadata"].Relationships["video_metadata_cpu_fkey"]
+	if rel.Foreign != "CPU" {
+		t.Error("wrong to one relationship name:", rel.Foreign)
+	}
+	if rel.Local != "VideoMetadata" {
+		t.Error("wrong to many relationship name:", rel.Local)
+	}
+}
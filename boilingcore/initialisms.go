@@ -0,0 +1,110 @@
+package boilingcore
+
+import (
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/volatiletech/strmangle"
+)
+
+// Initialisms changes the words that are fully uppercased in generated names,
+// ex: the ID of UserID. By default strmangle's list is used (id, url, uuid...)
+// along with its rule that words without vowels are initialisms too. Add
+// uppercases more words, Remove only capitalizes the words of the list and
+// Clear drops the list and the rule altogether, leaving only Add.
+type Initialisms struct {
+	Add    []string `toml:"add,omitempty" json:"add,omitempty"`
+	Remove []string `toml:"remove,omitempty" json:"remove,omitempty"`
+	Clear  bool     `toml:"clear,omitempty" json:"clear,omitempty"`
+}
+
+// ConvertInitialisms is necessary because viper
+//
+// It supports the following syntax:
+//
+//	[initialisms]
+//	add = ["sku"]
+//	remove = ["url"]
+//	clear = false
+func ConvertInitialisms(i interface{}) Initialisms {
+	if i == nil {
+		return Initialisms{}
+	}
+
+	m := cast.ToStringMap(i)
+	return Initialisms{
+		Add:    cast.ToStringSlice(m["add"]),
+		Remove: cast.ToStringSlice(m["remove"]),
+		Clear:  cast.ToBool(m["clear"]),
+	}
+}
+
+// TitleCase is strmangle.TitleCase with the initialisms changed, ex: url
+// becomes Url instead of URL when url is removed.
+func (i Initialisms) TitleCase(name string) string {
+	if len(i.Add) == 0 && len(i.Remove) == 0 && !i.Clear {
+		return strmangle.TitleCase(name)
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	for _, word := range strings.Split(name, "_") {
+		if len(word) == 0 {
+			continue
+		}
+
+		// Like strmangle, trailing digits don't keep a word from matching
+		key := strings.ToLower(strings.TrimRight(word, "0123456789"))
+		switch {
+		case i.has(i.Add, key):
+			buf.WriteString(strings.ToUpper(word))
+		case i.Clear || i.has(i.Remove, key):
+			buf.WriteString(strings.ToUpper(word[:1]))
+			buf.WriteString(word[1:])
+		default:
+			buf.WriteString(strmangle.TitleCase(word))
+		}
+	}
+
+	return buf.String()
+}
+
+// CamelCase is strmangle.CamelCase with the initialisms changed, the first
+// word is lowercased all the same.
+func (i Initialisms) CamelCase(name string) string {
+	name = strings.TrimLeft(name, "_")
+	if len(name) == 0 {
+		return ""
+	}
+
+	first, rest := name, ""
+	if idx := strings.IndexByte(name, '_'); idx >= 0 {
+		first, rest = name[:idx], name[idx+1:]
+	}
+
+	return strings.ToLower(first[:1]) + first[1:] + i.TitleCase(rest)
+}
+
+// stringMappers returns templateStringMappers with their casing changed by the
+// initialisms
+func (i Initialisms) stringMappers() map[string]func(string) string {
+	mappers := make(map[string]func(string) string, len(templateStringMappers))
+	for name, fn := range templateStringMappers {
+		mappers[name] = fn
+	}
+	mappers["titleCase"] = i.TitleCase
+	mappers["camelCase"] = i.CamelCase
+
+	return mappers
+}
+
+func (i Initialisms) has(words []string, key string) bool {
+	for _, w := range words {
+		if strings.EqualFold(w, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package boilingcore
+
+import (
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestInitialisms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Initialisms Initialisms
+		In          string
+		Title       string
+		Camel       string
+	}{
+		{Initialisms{}, "avatar_url", "AvatarURL", "avatarURL"},
+		{Initialisms{Remove: []string{"url"}}, "avatar_url", "AvatarUrl", "avatarUrl"},
+		{Initialisms{Remove: []string{"URL"}}, "url", "Url", "url"},
+		{Initialisms{Remove: []string{"id"}}, "user_id", "UserId", "userId"},
+		{Initialisms{Remove: []string{"id"}}, "Id", "Id", "id"},
+		{Initialisms{Remove: []string{"id"}}, "user_uuid", "UserUUID", "userUUID"},
+		{Initialisms{Remove: []string{"utf"}}, "name_utf8", "NameUtf8", "nameUtf8"},
+		{Initialisms{Add: []string{"sku"}}, "product_sku", "ProductSKU", "productSKU"},
+		{Initialisms{Add: []string{"sku"}}, "__product__sku", "ProductSKU", "productSKU"},
+		{Initialisms{Clear: true, Add: []string{"id"}}, "user_id_url_xml", "UserIDUrlXml", "userIDUrlXml"},
+	}
+
+	for i, test := range tests {
+		if got := test.Initialisms.TitleCase(test.In); got != test.Title {
+			t.Errorf("%d) title case want: %s, got: %s", i, test.Title, got)
+		}
+		if got := test.Initialisms.CamelCase(test.In); got != test.Camel {
+			t.Errorf("%d) camel case want: %s, got: %s", i, test.Camel, got)
+		}
+	}
+}
+
+func TestConvertInitialisms(t *testing.T) {
+	t.Parallel()
+
+	got := ConvertInitialisms(map[string]interface{}{
+		"add":    []interface{}{"sku"},
+		"remove": []interface{}{"url", "id"},
+		"clear":  true,
+	})
+	if len(got.Add) != 1 || got.Add[0] != "sku" || len(got.Remove) != 2 || !got.Clear {
+		t.Errorf("wrong initialisms: %#v", got)
+	}
+
+	if got = ConvertInitialisms(nil); got.Clear || got.Add != nil || got.Remove != nil {
+		t.Errorf("want no changes, got: %#v", got)
+	}
+}
+
+func TestAliasesInitialisms(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name:    "urls",
+			Columns: []drivers.Column{{Name: "id"}, {Name: "target_url"}},
+		},
+		{
+			Name:    "clicks",
+			Columns: []drivers.Column{{Name: "id"}, {Name: "url_id"}},
+			FKeys: []drivers.ForeignKey{
+				{
+					Name:          "clicks_url_fkey",
+					Table:         "clicks",
+					Column:        "url_id",
+					ForeignTable:  "urls",
+					ForeignColumn: "id",
+				},
+			},
+		},
+	}
+
+	a := Aliases{}
+	fillAliases(&a, tables, nil, Initialisms{}, nil)
+	if got := a.Tables["urls"].UpSingular; got != "URL" {
+		t.Error("want initialisms by default, got:", got)
+	}
+
+	a = Aliases{}
+	fillAliases(&a, tables, nil, Initialisms{Remove: []string{"url"}}, nil)
+	urls := a.Tables["urls"]
+	if urls.UpSingular != "Url" || urls.UpPlural != "Urls" || urls.DownSingular != "url" {
+		t.Errorf("wrong model names: %#v", urls)
+	}
+	if got := urls.Columns["target_url"]; got != "TargetUrl" {
+		t.Error("wrong column name:", got)
+	}
+	// Words still on the list are kept uppercased
+	if got := urls.Columns["id"]; got != "ID" {
+		t.Error("wrong column name:", got)
+	}
+	if rel := a.Tables["clicks"].Relationships["clicks_url_fkey"]; rel.Foreign != "Url" {
+		t.Error("wrong to one relationship name:", rel.Foreign)
+	}
+}
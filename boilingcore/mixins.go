@@ -0,0 +1,170 @@
+package boilingcore
+
+import (
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cast"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+	"github.com/volatiletech/strmangle"
+)
+
+// Mixin is a group of columns generated as an interface of getters, which
+// every model that has all of them implements, ex: Timestamped for created_at
+// and updated_at. Code written against the interface works with any of those
+// models.
+type Mixin struct {
+	Name    string   `toml:"name,omitempty" json:"name,omitempty"`
+	Columns []string `toml:"columns,omitempty" json:"columns,omitempty"`
+}
+
+// MixinGetter is a getter method of a mixin interface
+type MixinGetter struct {
+	Name   string
+	Column drivers.Column
+}
+
+// resolvedMixin is a mixin with its getters and the tables implementing it
+type resolvedMixin struct {
+	Name    string
+	Getters []MixinGetter
+	Tables  []string
+}
+
+// ConvertMixins is necessary because viper
+//
+// It supports the following syntax:
+//
+//	[[mixin]]
+//	name = "Timestamped"
+//	columns = ["created_at", "updated_at"]
+func ConvertMixins(i interface{}) []Mixin {
+	if i == nil {
+		return nil
+	}
+
+	intfArray := i.([]interface{})
+	mixins := make([]Mixin, 0, len(intfArray))
+	for _, m := range intfArray {
+		mixinIntf := cast.ToStringMap(m)
+		if mixinIntf["name"] == nil || mixinIntf["columns"] == nil {
+			panic("mixins must specify both name and columns")
+		}
+
+		mixins = append(mixins, Mixin{
+			Name:    cast.ToString(mixinIntf["name"]),
+			Columns: cast.ToStringSlice(mixinIntf["columns"]),
+		})
+	}
+
+	return mixins
+}
+
+// initMixins finds the tables implementing each mixin. Like embeds, the first
+// table with all of a mixin's columns decides the names and types of its
+// getters, the other tables only implement it when their columns match those
+// exactly. Mixins no table matches are dropped.
+func (s *State) initMixins() error {
+	names := make(map[string]struct{})
+	for _, e := range s.Config.Embeds {
+		names[e.Name] = struct{}{}
+	}
+	for _, m := range s.Config.Mixins {
+		if len(m.Name) == 0 || len(m.Columns) == 0 {
+			return errors.New("mixins must specify both name and columns")
+		}
+		if _, ok := names[m.Name]; ok {
+			return errors.Errorf("mixin %s is defined twice or shares its name with an embed", m.Name)
+		}
+		names[m.Name] = struct{}{}
+
+		resolved := resolvedMixin{Name: m.Name}
+		for _, t := range s.Tables {
+			if t.IsJoinTable {
+				continue
+			}
+
+			alias := s.Config.Aliases.Table(t.Name)
+			getters := make([]MixinGetter, 0, len(m.Columns))
+			for _, name := range m.Columns {
+				col, ok := findColumn(t, name)
+				if !ok {
+					break
+				}
+				getters = append(getters, MixinGetter{Name: "Get" + alias.Column(name), Column: col})
+			}
+			if len(getters) != len(m.Columns) {
+				continue
+			}
+
+			if resolved.Getters == nil {
+				resolved.Getters = getters
+			} else if !sameMixinGetters(resolved.Getters, getters) {
+				continue
+			}
+			resolved.Tables = append(resolved.Tables, t.Name)
+		}
+
+		if len(resolved.Tables) != 0 {
+			s.Mixins = append(s.Mixins, resolved)
+		}
+	}
+
+	if len(s.Mixins) == 0 {
+		return nil
+	}
+
+	var types []string
+	for _, m := range s.Mixins {
+		for _, g := range m.Getters {
+			types = append(types, g.Column.Type)
+		}
+	}
+
+	if s.Config.Imports.Singleton == nil {
+		s.Config.Imports.Singleton = make(importers.Map)
+	}
+	imps := s.Config.Imports.Singleton["boil_mixins"]
+	s.Config.Imports.Singleton["boil_mixins"] = importers.AddTypeImports(imps, s.Config.Imports.BasedOnType, types)
+
+	return nil
+}
+
+func sameMixinGetters(a, b []MixinGetter) bool {
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Column.Type != b[i].Column.Type {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TableMixins returns the mixins the table implements
+func (t templateData) TableMixins(table string) []resolvedMixin {
+	var mixins []resolvedMixin
+	for _, m := range t.Mixins {
+		if strmangle.SetInclude(table, m.Tables) {
+			mixins = append(mixins, m)
+		}
+	}
+
+	return mixins
+}
+
+// MixinGetters returns the getters the table needs for its mixins, once each
+// when a column is in more than one of them
+func (t templateData) MixinGetters(table string) []MixinGetter {
+	var getters []MixinGetter
+	seen := make(map[string]struct{})
+	for _, m := range t.TableMixins(table) {
+		for _, g := range m.Getters {
+			if _, ok := seen[g.Name]; ok {
+				continue
+			}
+			seen[g.Name] = struct{}{}
+			getters = append(getters, g)
+		}
+	}
+
+	return getters
+}
@@ -0,0 +1,164 @@
+package boilingcore
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+func TestMixins(t *testing.T) {
+	t.Parallel()
+
+	timestamps := []drivers.Column{
+		{Name: "created_at", Type: "time.Time", DBType: "timestamp"},
+		{Name: "updated_at", Type: "null.Time", DBType: "timestamp", Nullable: true},
+	}
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: append([]drivers.Column{{Name: "id", Type: "int"}, {Name: "name", Type: "string"}}, timestamps...),
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	jets := drivers.Table{
+		Name:    "jets",
+		Columns: append([]drivers.Column{{Name: "id", Type: "int"}}, timestamps...),
+		PKey:    &drivers.PrimaryKey{Name: "pk_jets", Columns: []string{"id"}},
+	}
+	// licenses has a different type for updated_at so it doesn't implement it
+	licenses := drivers.Table{
+		Name: "licenses",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			timestamps[0],
+			{Name: "updated_at", Type: "time.Time", DBType: "timestamp"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_licenses", Columns: []string{"id"}},
+	}
+
+	s := &State{
+		Config: &Config{
+			Imports: importers.Collection{BasedOnType: importers.Map{
+				"time.Time": {Standard: importers.List{`"time"`}},
+				"null.Time": {ThirdParty: importers.List{`"github.com/volatiletech/null/v8"`}},
+			}},
+			Mixins: []Mixin{
+				{Name: "Timestamped", Columns: []string{"created_at", "updated_at"}},
+				{Name: "Model", Columns: []string{"id", "created_at"}},
+			},
+		},
+		Tables: []drivers.Table{pilots, jets, licenses},
+	}
+	FillAliases(&s.Config.Aliases, s.Tables)
+	if err := s.initMixins(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.Mixins) != 2 {
+		t.Fatalf("want two mixins, got %d", len(s.Mixins))
+	}
+	if got := strings.Join(s.Mixins[0].Tables, ","); got != "pilots,jets" {
+		t.Errorf("want Timestamped implemented by pilots and jets, got: %s", got)
+	}
+	if got := strings.Join(s.Mixins[1].Tables, ","); got != "pilots,jets,licenses" {
+		t.Errorf("want Model implemented by every table, got: %s", got)
+	}
+	imps := s.Config.Imports.Singleton["boil_mixins"]
+	if !strings.Contains(strings.Join(imps.Standard, ","), "time") || !strings.Contains(strings.Join(imps.ThirdParty, ","), "null") {
+		t.Errorf("want the getter type imports, got: %#v", imps)
+	}
+
+	b, err := assetLoader("templates/singleton/boil_mixins.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err := template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := &templateData{
+		Aliases:     s.Config.Aliases,
+		Mixins:      s.Mixins,
+		PkgName:     "models",
+		StringFuncs: templateStringMappers,
+	}
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, data); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"type Timestamped interface {\n\tGetCreatedAt() time.Time\n\tGetUpdatedAt() null.Time\n}",
+		"type Model interface {\n\tGetID() int\n\tGetCreatedAt() time.Time\n}",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+
+	b, err = assetLoader("templates/31_mixins.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err = template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, table := range []drivers.Table{pilots, jets} {
+		data.Table = table
+		alias := data.Aliases.Table(table.Name).UpSingular
+		buf.Reset()
+		if err = tpl.Execute(buf, data); err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		for _, want := range []string{
+			"_ Timestamped = &" + alias + "{}",
+			"_ Model = &" + alias + "{}",
+			"func (o *" + alias + ") GetCreatedAt() time.Time {\n\treturn o.CreatedAt\n}",
+			"func (o *" + alias + ") GetUpdatedAt() null.Time {\n\treturn o.UpdatedAt\n}",
+			"func (o *" + alias + ") GetID() int {\n\treturn o.ID\n}",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s: missing %s:\n%s", table.Name, want, out)
+			}
+		}
+		// created_at is in both mixins but only gets one getter
+		if n := strings.Count(out, "GetCreatedAt()"); n != 1 {
+			t.Errorf("%s: want one GetCreatedAt, got %d:\n%s", table.Name, n, out)
+		}
+	}
+
+	data.Table = licenses
+	buf.Reset()
+	if err = tpl.Execute(buf, data); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); strings.Contains(out, "Timestamped") || strings.Contains(out, "GetUpdatedAt") {
+		t.Errorf("want licenses to not implement Timestamped:\n%s", out)
+	}
+}
+
+func TestMixinsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []Config{
+		{Mixins: []Mixin{{Name: "Timestamped"}}},
+		{Mixins: []Mixin{{Name: "Model", Columns: []string{"id"}}, {Name: "Model", Columns: []string{"created_at"}}}},
+		{
+			Embeds: []Embed{{Name: "AuditFields", Columns: []string{"created_at"}}},
+			Mixins: []Mixin{{Name: "AuditFields", Columns: []string{"created_at"}}},
+		},
+	}
+	for i, config := range tests {
+		config := config
+		s := &State{Config: &config}
+		if err := s.initMixins(); err == nil {
+			t.Errorf("%d) want an error", i)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package boilingcore
+
+import (
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/strmangle"
+)
+
+// checkNameConstants returns an error when two tables or columns would get
+// the same constant from EmitNameConstants, ex: tables user_roles and
+// UserRoles both title case to TableUserRoles.
+func checkNameConstants(tables []drivers.Table) error {
+	seen := make(map[string]string)
+	add := func(constant, name string) error {
+		if other, ok := seen[constant]; ok {
+			return errors.Errorf("emit name constants: %s and %s both generate %s", other, name, constant)
+		}
+		seen[constant] = name
+		return nil
+	}
+
+	for _, t := range tables {
+		table := strmangle.TitleCase(t.Name)
+		if err := add("Table"+table, t.Name); err != nil {
+			return err
+		}
+		for _, c := range t.Columns {
+			if err := add("Column"+table+"_"+strmangle.TitleCase(c.Name), t.Name+"."+c.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package boilingcore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestCheckNameConstants(t *testing.T) {
+	t.Parallel()
+
+	// The separator keeps these apart, ColumnUserRole_ID and ColumnUser_RoleID
+	tables := []drivers.Table{
+		{Name: "user_role", Columns: []drivers.Column{{Name: "id"}}},
+		{Name: "user", Columns: []drivers.Column{{Name: "role_id"}}},
+	}
+	if err := checkNameConstants(tables); err != nil {
+		t.Error(err)
+	}
+
+	tests := []struct {
+		Tables []drivers.Table
+		Want   string
+	}{
+		{
+			Tables: []drivers.Table{{Name: "user_roles"}, {Name: "UserRoles"}},
+			Want:   "user_roles and UserRoles both generate TableUserRoles",
+		},
+		{
+			Tables: []drivers.Table{{Name: "users", Columns: []drivers.Column{{Name: "role_id"}, {Name: "RoleID"}}}},
+			Want:   "users.role_id and users.RoleID both generate ColumnUsers_RoleID",
+		},
+	}
+	for _, test := range tests {
+		err := checkNameConstants(test.Tables)
+		if err == nil || !strings.Contains(err.Error(), test.Want) {
+			t.Errorf("want an error with %q, got: %v", test.Want, err)
+		}
+	}
+}
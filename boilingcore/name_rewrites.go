@@ -0,0 +1,137 @@
+package boilingcore
+
+import (
+	"regexp"
+
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cast"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// NameRewrite replaces matches of Pattern in table and column names before
+// their Go names are derived, ex: ^tbl_ with "" to generate User for tbl_users.
+// The names used in SQL are left as they are.
+type NameRewrite struct {
+	Pattern string `toml:"pattern,omitempty" json:"pattern,omitempty"`
+	Replace string `toml:"replace,omitempty" json:"replace,omitempty"`
+}
+
+// ConvertNameRewrites is necessary because viper
+//
+// It supports the following syntax, the rewrites are applied in order:
+//
+//	[[name-rewrite]]
+//	pattern = "^tbl_"
+//	replace = ""
+func ConvertNameRewrites(i interface{}) []NameRewrite {
+	if i == nil {
+		return nil
+	}
+
+	intfArray := i.([]interface{})
+	rewrites := make([]NameRewrite, 0, len(intfArray))
+	for _, r := range intfArray {
+		rewriteIntf := cast.ToStringMap(r)
+		if rewriteIntf["pattern"] == nil {
+			panic("name rewrites must specify a pattern")
+		}
+
+		rewrites = append(rewrites, NameRewrite{
+			Pattern: cast.ToString(rewriteIntf["pattern"]),
+			Replace: cast.ToString(rewriteIntf["replace"]),
+		})
+	}
+
+	return rewrites
+}
+
+// nameRewriter holds the compiled name rewrites, a nil one leaves names alone
+type nameRewriter []compiledRewrite
+
+type compiledRewrite struct {
+	rgx     *regexp.Regexp
+	replace string
+}
+
+// compileNameRewrites compiles the patterns of the rewrites
+func compileNameRewrites(rewrites []NameRewrite) (nameRewriter, error) {
+	var n nameRewriter
+	for _, r := range rewrites {
+		rgx, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid name rewrite pattern %q", r.Pattern)
+		}
+
+		n = append(n, compiledRewrite{rgx: rgx, replace: r.Replace})
+	}
+
+	return n, nil
+}
+
+// Rewrite applies every rewrite to name in order
+func (n nameRewriter) Rewrite(name string) string {
+	for _, r := range n {
+		name = r.rgx.ReplaceAllString(name, r.replace)
+	}
+
+	return name
+}
+
+// ForeignKey rewrites the table and column names of a foreign key
+func (n nameRewriter) ForeignKey(fk drivers.ForeignKey) drivers.ForeignKey {
+	if len(n) == 0 {
+		return fk
+	}
+
+	fk.Table = n.Rewrite(fk.Table)
+	fk.Column = n.Rewrite(fk.Column)
+	fk.ForeignTable = n.Rewrite(fk.ForeignTable)
+	fk.ForeignColumn = n.Rewrite(fk.ForeignColumn)
+	fk.Columns = n.rewriteAll(fk.Columns)
+	fk.ForeignColumns = n.rewriteAll(fk.ForeignColumns)
+	return fk
+}
+
+// rewriteAll rewrites a copy of names, the key's slices are shared with
+// the tables
+func (n nameRewriter) rewriteAll(names []string) []string {
+	if names == nil {
+		return nil
+	}
+
+	rewritten := make([]string, len(names))
+	for i, name := range names {
+		rewritten[i] = n.Rewrite(name)
+	}
+
+	return rewritten
+}
+
+// checkAliasCollisions returns an error if two tables, or two columns of a
+// table, ended up with the same Go name, ex: after rewriting tbl_users and
+// users to the same name.
+func checkAliasCollisions(a Aliases, tables []drivers.Table) error {
+	seen := make(map[string]string)
+	for _, t := range tables {
+		if t.IsJoinTable {
+			continue
+		}
+
+		table := a.Table(t.Name)
+		if other, ok := seen[table.UpSingular]; ok {
+			return errors.Errorf("tables %s and %s would both be named %s", other, t.Name, table.UpSingular)
+		}
+		seen[table.UpSingular] = t.Name
+
+		columns := make(map[string]string)
+		for _, c := range t.Columns {
+			name := table.Column(c.Name)
+			if other, ok := columns[name]; ok {
+				return errors.Errorf("columns %s.%s and %s.%s would both be named %s", t.Name, other, t.Name, c.Name, name)
+			}
+			columns[name] = c.Name
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package boilingcore
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestNameRewrites(t *testing.T) {
+	t.Parallel()
+
+	rewrites, err := compileNameRewrites(ConvertNameRewrites([]interface{}{
+		map[string]interface{}{"pattern": "^tbl_", "replace": ""},
+		map[string]interface{}{"pattern": "^fld_"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables := []drivers.Table{
+		{
+			Name:    "tbl_users",
+			Columns: []drivers.Column{{Name: "fld_id"}, {Name: "fld_name"}},
+		},
+		{
+			Name:    "tbl_videos",
+			Columns: []drivers.Column{{Name: "fld_id"}, {Name: "fld_user_id"}},
+			FKeys: []drivers.ForeignKey{{
+				Name:          "tbl_videos_fld_user_id_fkey",
+				Table:         "tbl_videos",
+				Column:        "fld_user_id",
+				ForeignTable:  "tbl_users",
+				ForeignColumn: "fld_id",
+			}},
+		},
+	}
+
+	var a Aliases
+	fillAliases(&a, tables, nil, Initialisms{}, rewrites)
+	if err = checkAliasCollisions(a, tables); err != nil {
+		t.Fatal(err)
+	}
+
+	users := a.Table("tbl_users")
+	if users.UpSingular != "User" || users.DownPlural != "users" {
+		t.Errorf("wrong table alias: %#v", users)
+	}
+	if got := users.Column("fld_name"); got != "Name" {
+		t.Error("want column alias Name, got:", got)
+	}
+	if got := a.Table("tbl_videos").Relationship("tbl_videos_fld_user_id_fkey"); got.Local != "Videos" || got.Foreign != "User" {
+		t.Errorf("wrong relationship alias: %#v", got)
+	}
+
+	b, err := assetLoader("templates/13_all.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err := template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, &templateData{Table: tables[0], Aliases: a}); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "func Users(mods ...qm.QueryMod) userQuery {") {
+		t.Error("want the rewritten model name:\n", out)
+	}
+	if !strings.Contains(out, `qm.From("tbl_users")`) {
+		t.Error("want the database name in sql:\n", out)
+	}
+}
+
+func TestNameRewritesCompositeForeignKey(t *testing.T) {
+	t.Parallel()
+
+	rewrites, err := compileNameRewrites([]NameRewrite{{Pattern: "^fld_"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fk := drivers.ForeignKey{
+		Name:           "fk_flights_pilots",
+		Table:          "flights",
+		Column:         "fld_pilot_airline_id",
+		ForeignTable:   "pilots",
+		ForeignColumn:  "fld_airline_id",
+		Columns:        []string{"fld_pilot_airline_id", "fld_pilot_code"},
+		ForeignColumns: []string{"fld_airline_id", "fld_code"},
+	}
+
+	got := rewrites.ForeignKey(fk)
+	if want := []string{"pilot_airline_id", "pilot_code"}; !reflect.DeepEqual(got.Columns, want) {
+		t.Errorf("want columns %v, got: %v", want, got.Columns)
+	}
+	if want := []string{"airline_id", "code"}; !reflect.DeepEqual(got.ForeignColumns, want) {
+		t.Errorf("want foreign columns %v, got: %v", want, got.ForeignColumns)
+	}
+	if fk.Columns[0] != "fld_pilot_airline_id" || fk.ForeignColumns[0] != "fld_airline_id" {
+		t.Error("the table's key should be left alone:", fk.Columns, fk.ForeignColumns)
+	}
+	if got := keyName(got); got != "pilot" {
+		t.Error("want the composite key named after the rewritten columns, got:", got)
+	}
+}
+
+func TestNameRewritesCollision(t *testing.T) {
+	t.Parallel()
+
+	rewrites, err := compileNameRewrites([]NameRewrite{{Pattern: "^tbl_"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables := []drivers.Table{
+		{Name: "tbl_users", Columns: []drivers.Column{{Name: "id"}}},
+		{Name: "users", Columns: []drivers.Column{{Name: "id"}}},
+	}
+
+	var a Aliases
+	fillAliases(&a, tables, nil, Initialisms{}, rewrites)
+	if err = checkAliasCollisions(a, tables); err == nil {
+		t.Error("want an error for tables rewritten to the same name")
+	}
+
+	tables = []drivers.Table{{Name: "users", Columns: []drivers.Column{{Name: "tbl_id"}, {Name: "id"}}}}
+	a = Aliases{}
+	fillAliases(&a, tables, nil, Initialisms{}, rewrites)
+	if err = checkAliasCollisions(a, tables); err == nil {
+		t.Error("want an error for columns rewritten to the same name")
+	}
+
+	if _, err = compileNameRewrites([]NameRewrite{{Pattern: "("}}); err == nil {
+		t.Error("want an error for an invalid pattern")
+	}
+}
@@ -0,0 +1,62 @@
+package boilingcore
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+// Null column styles for Config.NullableStyle
+const (
+	NullableStyleNull     = "null"
+	NullableStylePointers = "pointers"
+)
+
+// Packages of the null types for Config.NullablePackage
+const (
+	NullablePackageVolatiletech = "volatiletech"
+	NullablePackageStdlib       = "stdlib"
+)
+
+// stdlibNullTypes maps the null package's types to their database/sql
+// counterparts, the ones holding the same type in a field of the same name
+var stdlibNullTypes = map[string]string{
+	"null.Bool":    "sql.NullBool",
+	"null.Float64": "sql.NullFloat64",
+	"null.Int32":   "sql.NullInt32",
+	"null.Int64":   "sql.NullInt64",
+	"null.String":  "sql.NullString",
+	"null.Time":    "sql.NullTime",
+}
+
+// useStdlibNullTypes replaces the null package types the drivers give
+// nullable columns with database/sql's, ex: sql.NullString for null.String,
+// and makes them import database/sql. Types without a counterpart, ex:
+// null.Int or null.JSON, are left as they are and still import the null package.
+func useStdlibNullTypes(tables []drivers.Table, imports importers.Map) {
+	for i := range tables {
+		for j := range tables[i].Columns {
+			c := &tables[i].Columns[j]
+			if typ, ok := stdlibNullTypes[c.Type]; ok {
+				c.Type = typ
+			}
+		}
+	}
+
+	for _, typ := range stdlibNullTypes {
+		imports[typ] = importers.Set{Standard: importers.List{`"database/sql"`}}
+	}
+}
+
+// usePointerTypes replaces the null package types the drivers give nullable
+// columns with plain pointers, ex: *string for null.String. Types without a
+// pointer form, ex: types.NullDecimal, are left as they are.
+func usePointerTypes(tables []drivers.Table) {
+	for i := range tables {
+		for j := range tables[i].Columns {
+			c := &tables[i].Columns[j]
+			if typ := nullPointerType(c.Type); typ != "" {
+				c.Type = typ
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package boilingcore
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNullableStyle(t *testing.T) {
+	t.Parallel()
+
+	nullJets := generateMock(t, nil)["jets.go"]
+	for _, want := range []string{
+		"Color      null.String",
+		`"github.com/volatiletech/null/v8"`,
+		"Color      whereHelpernull_String",
+		"if o.Color.Valid && len([]rune(o.Color.String)) > 16 {",
+	} {
+		if !bytes.Contains(nullJets, []byte(want)) {
+			t.Errorf("want %q with null types:\n%s", want, nullJets)
+		}
+	}
+
+	ptrJets := generateMock(t, func(c *Config) { c.NullableStyle = NullableStylePointers })["jets.go"]
+	for _, want := range []string{
+		"Color      *string",
+		"Color      whereHelper_ptr_string",
+		"if o.Color != nil && len([]rune(*o.Color)) > 16 {",
+	} {
+		if !bytes.Contains(ptrJets, []byte(want)) {
+			t.Errorf("want %q with pointers:\n%s", want, ptrJets)
+		}
+	}
+	if bytes.Contains(ptrJets, []byte("null.")) {
+		t.Errorf("want no null package with pointers:\n%s", ptrJets)
+	}
+}
+
+func TestNullableStyleInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(&Config{DriverName: "mock", NullableStyle: "optional"}); err == nil {
+		t.Error("want an error for an unknown nullable style")
+	}
+}
+
+func TestNullablePackage(t *testing.T) {
+	t.Parallel()
+
+	hangars := generateMock(t, nil)["hangars.go"]
+	for _, want := range []string{"Name null.String", `"github.com/volatiletech/null/v8"`} {
+		if !bytes.Contains(hangars, []byte(want)) {
+			t.Errorf("want %q with the volatiletech package:\n%s", want, hangars)
+		}
+	}
+
+	files := generateMock(t, func(c *Config) { c.NullablePackage = NullablePackageStdlib })
+	hangars = files["hangars.go"]
+	for _, want := range []string{
+		`"database/sql"`,
+		"Name sql.NullString",
+		"Name whereHelpersql_NullString",
+	} {
+		if !bytes.Contains(hangars, []byte(want)) {
+			t.Errorf("want %q with the stdlib package:\n%s", want, hangars)
+		}
+	}
+	if bytes.Contains(hangars, []byte("null.")) {
+		t.Errorf("want no null package with the stdlib package:\n%s", hangars)
+	}
+
+	// null.Int has no database/sql counterpart and keeps its import
+	jets := files["jets.go"]
+	for _, want := range []string{
+		"Color      sql.NullString",
+		"PilotID    null.Int",
+		`"github.com/volatiletech/null/v8"`,
+		"if o.Color.Valid && len([]rune(o.Color.String)) > 16 {",
+	} {
+		if !bytes.Contains(jets, []byte(want)) {
+			t.Errorf("want %q with the stdlib package:\n%s", want, jets)
+		}
+	}
+}
+
+func TestNullablePackageInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(&Config{DriverName: "mock", NullablePackage: "gopkg"}); err == nil {
+		t.Error("want an error for an unknown nullable package")
+	}
+	if _, err := New(&Config{DriverName: "mock", NullablePackage: NullablePackageStdlib, NullableStyle: NullableStylePointers}); err == nil {
+		t.Error("want an error for the stdlib package with pointers")
+	}
+}
@@ -0,0 +1,65 @@
+package boilingcore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// Column orderings for Config.OrderColumns
+const (
+	OrderColumnsOrdinal      = "ordinal"
+	OrderColumnsAlphabetical = "alphabetical"
+)
+
+// orderTables sorts the tables and everything in them that's rendered into
+// the generated code by a stable key, so generating the same schema twice
+// gives the same output no matter what order the database listed things in.
+//
+// Columns stay in ordinal position (the order the driver returned them in)
+// unless alphabetical is set. Primary key columns keep the key's order.
+func orderTables(tables []drivers.Table, alphabetical bool) {
+	sort.SliceStable(tables, func(i, j int) bool {
+		return tables[i].Name < tables[j].Name
+	})
+
+	for i := range tables {
+		t := &tables[i]
+
+		if alphabetical {
+			sort.SliceStable(t.Columns, func(i, j int) bool {
+				return t.Columns[i].Name < t.Columns[j].Name
+			})
+		}
+
+		sort.SliceStable(t.FKeys, func(i, j int) bool {
+			a, b := t.FKeys[i], t.FKeys[j]
+			if a.Name != b.Name {
+				return a.Name < b.Name
+			}
+			return strings.Join(a.Columns, ",") < strings.Join(b.Columns, ",")
+		})
+		sort.SliceStable(t.PolymorphicKeys, func(i, j int) bool {
+			return t.PolymorphicKeys[i].Name < t.PolymorphicKeys[j].Name
+		})
+		sort.SliceStable(t.Indexes, func(i, j int) bool {
+			return t.Indexes[i].Name < t.Indexes[j].Name
+		})
+		sort.Strings(t.Triggers)
+
+		sort.SliceStable(t.ToOneRelationships, func(i, j int) bool {
+			a, b := t.ToOneRelationships[i], t.ToOneRelationships[j]
+			return relationshipKey(a.ForeignTable, a.Name, a.Columns) < relationshipKey(b.ForeignTable, b.Name, b.Columns)
+		})
+		sort.SliceStable(t.ToManyRelationships, func(i, j int) bool {
+			a, b := t.ToManyRelationships[i], t.ToManyRelationships[j]
+			return relationshipKey(a.ForeignTable, a.JoinTable+"."+a.Name, a.ForeignColumns) < relationshipKey(b.ForeignTable, b.JoinTable+"."+b.Name, b.ForeignColumns)
+		})
+	}
+}
+
+// relationshipKey is the sort key of a relationship
+func relationshipKey(table, name string, columns []string) string {
+	return table + "\x00" + name + "\x00" + strings.Join(columns, ",")
+}
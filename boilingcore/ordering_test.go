@@ -0,0 +1,96 @@
+package boilingcore
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+// generateMock runs the templates against the mock driver and returns the
+// generated files by name, configure may change the config before New.
+func generateMock(t *testing.T, configure func(*Config)) map[string][]byte {
+	t.Helper()
+
+	out, err := ioutil.TempDir("", "boil_ordering")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+
+	config := &Config{
+		DriverName: "mock",
+		PkgName:    "models",
+		OutFolder:  out,
+		NoTests:    true,
+		DriverConfig: map[string]interface{}{
+			drivers.ConfigSchema: "schema",
+		},
+		Imports: importers.NewDefaultImports(),
+	}
+	if configure != nil {
+		configure(config)
+	}
+
+	s, err := New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string][]byte)
+	entries, err := ioutil.ReadDir(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		b, err := ioutil.ReadFile(filepath.Join(out, e.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[e.Name()] = b
+	}
+
+	return files
+}
+
+func TestOrderColumns(t *testing.T) {
+	t.Parallel()
+
+	first := generateMock(t, nil)
+	second := generateMock(t, nil)
+	if len(first) == 0 || len(first) != len(second) {
+		t.Fatalf("want the same files, got %d and %d", len(first), len(second))
+	}
+	for name, b := range first {
+		if !bytes.Equal(b, second[name]) {
+			t.Errorf("%s differs between runs", name)
+		}
+	}
+
+	rgxFields := regexp.MustCompile(`(?s)type Jet struct \{\s+ID .*AirportID `)
+	if !rgxFields.Match(first["jets.go"]) {
+		t.Error("want the jet fields in ordinal position:\n", string(first["jets.go"]))
+	}
+
+	alphabetical := generateMock(t, func(c *Config) { c.OrderColumns = OrderColumnsAlphabetical })
+	rgxFields = regexp.MustCompile(`(?s)type Jet struct \{\s+AirportID .*Cargo .*ID `)
+	if !rgxFields.Match(alphabetical["jets.go"]) {
+		t.Error("want the jet fields in alphabetical order:\n", string(alphabetical["jets.go"]))
+	}
+}
+
+func TestOrderColumnsInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(&Config{DriverName: "mock", OrderColumns: "random"}); err == nil {
+		t.Error("want an error for an unknown column order")
+	}
+}
@@ -147,7 +147,7 @@ func executeTemplates(e executeTemplateData) error {
 				fName = filepath.Join(dir, fName)
 			}
 
-			if err := writeFile(e.state.Config.OutFolder, fName, out, isGo); err != nil {
+			if err := writeFile(e.state.Config.OutFolder, fName, out, isGo, e.state.Config.Incremental); err != nil {
 				return err
 			}
 		}
@@ -172,7 +172,17 @@ func executeSingletonTemplates(e executeTemplateData) error {
 		fName = fName[:strings.IndexByte(fName, '.')]
 
 		out.Reset()
+		if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
+			return err
+		}
 
+		// Singletons with nothing to say, ex: no functions to wrap, are skipped
+		if len(bytes.TrimSpace(out.Bytes())) == 0 {
+			continue
+		}
+		body := append([]byte(nil), out.Bytes()...)
+
+		out.Reset()
 		if isGo {
 			imps := importers.Set{
 				Standard:   e.importNamedSet[denormalizeSlashes(fName)].Standard,
@@ -187,12 +197,9 @@ func executeSingletonTemplates(e executeTemplateData) error {
 			writePackageName(out, pkgName)
 			writeImports(out, imps)
 		}
+		_, _ = out.Write(body)
 
-		if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
-			return err
-		}
-
-		if err := writeFile(e.state.Config.OutFolder, normalized, out, isGo); err != nil {
+		if err := writeFile(e.state.Config.OutFolder, normalized, out, isGo, e.state.Config.Incremental); err != nil {
 			return err
 		}
 	}
@@ -221,8 +228,9 @@ func writeImports(out *bytes.Buffer, imps importers.Set) {
 }
 
 // writeFile writes to the given folder and filename, formatting the buffer
-// given.
-func writeFile(outFolder string, fileName string, input *bytes.Buffer, format bool) error {
+// given. When incremental, a file that already holds the same content isn't
+// written again so it keeps its modification time.
+func writeFile(outFolder string, fileName string, input *bytes.Buffer, format, incremental bool) error {
 	var byt []byte
 	var err error
 	if format {
@@ -235,6 +243,11 @@ func writeFile(outFolder string, fileName string, input *bytes.Buffer, format bo
 	}
 
 	path := filepath.Join(outFolder, fileName)
+	if incremental {
+		if existing, err := ioutil.ReadFile(path); err == nil && bytes.Equal(existing, byt) {
+			return nil
+		}
+	}
 	if err := testHarnessWriteFile(path, byt, 0664); err != nil {
 		return errors.Wrapf(err, "failed to write output file %s", path)
 	}
@@ -4,9 +4,15 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
 )
 
 type NopWriteCloser struct {
@@ -41,7 +47,7 @@ func TestWriteFile(t *testing.T) {
 	writePackageName(buf, "pkg")
 	fmt.Fprintf(buf, "func hello() {}\n\n\nfunc world() {\nreturn\n}\n\n\n\n")
 
-	if err := writeFile("", "", buf, true); err != nil {
+	if err := writeFile("", "", buf, true, false); err != nil {
 		t.Error(err)
 	}
 
@@ -104,3 +110,84 @@ func TestOutputFilenameParts(t *testing.T) {
 		}
 	}
 }
+
+func TestIncremental(t *testing.T) {
+	t.Parallel()
+
+	out, err := ioutil.TempDir("", "boil_incremental")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+
+	generate := func(aliases Aliases) {
+		t.Helper()
+		s, err := New(&Config{
+			DriverName:  "mock",
+			PkgName:     "models",
+			OutFolder:   out,
+			NoTests:     true,
+			Incremental: true,
+			DriverConfig: map[string]interface{}{
+				drivers.ConfigSchema: "schema",
+			},
+			Imports: importers.NewDefaultImports(),
+			Aliases: aliases,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = s.Run(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	generate(Aliases{})
+
+	// Age every file so a rewrite shows in its modification time
+	past := time.Now().Add(-time.Hour)
+	entries, err := ioutil.ReadDir(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if err = os.Chtimes(filepath.Join(out, e.Name()), past, past); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only the airports table changes
+	generate(Aliases{Tables: map[string]TableAlias{
+		"airports": {Columns: map[string]string{"size": "Capacity"}},
+	}})
+
+	entries, err = ioutil.ReadDir(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rewritten []string
+	for _, e := range entries {
+		if e.ModTime().After(past) {
+			rewritten = append(rewritten, e.Name())
+		}
+	}
+	if strings.Join(rewritten, ",") != "airports.go" {
+		t.Errorf("want only airports.go rewritten, got: %v", rewritten)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(out, "airports.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(b, []byte("Capacity null.Int")) {
+		t.Errorf("want the new alias in airports.go:\n%s", b)
+	}
+}
+
+func TestIncrementalWipe(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(&Config{DriverName: "mock", Wipe: true, Incremental: true}); err == nil {
+		t.Error("want an error for wipe and incremental together")
+	}
+}
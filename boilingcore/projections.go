@@ -0,0 +1,111 @@
+package boilingcore
+
+import (
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cast"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// Projection is a subset of the columns of a table generated as a struct of
+// its own, along with a query finisher that only selects those columns,
+// ex: JetSummary for the id and name of jets.
+type Projection struct {
+	Table   string   `toml:"table,omitempty" json:"table,omitempty"`
+	Name    string   `toml:"name,omitempty" json:"name,omitempty"`
+	Columns []string `toml:"columns,omitempty" json:"columns,omitempty"`
+}
+
+// resolvedProjection is a projection with the columns of its table
+type resolvedProjection struct {
+	Name    string
+	Table   string
+	Columns []drivers.Column
+}
+
+// ConvertProjections is necessary because viper
+//
+// It supports the following syntax:
+//
+//	[[projection]]
+//	table = "jets"
+//	name = "Summary"
+//	columns = ["id", "name"]
+func ConvertProjections(i interface{}) []Projection {
+	if i == nil {
+		return nil
+	}
+
+	intfArray := i.([]interface{})
+	projections := make([]Projection, 0, len(intfArray))
+	for _, p := range intfArray {
+		projIntf := cast.ToStringMap(p)
+		if projIntf["table"] == nil || projIntf["name"] == nil || projIntf["columns"] == nil {
+			panic("projections must specify table, name and columns")
+		}
+
+		projections = append(projections, Projection{
+			Table:   cast.ToString(projIntf["table"]),
+			Name:    cast.ToString(projIntf["name"]),
+			Columns: cast.ToStringSlice(projIntf["columns"]),
+		})
+	}
+
+	return projections
+}
+
+// initProjections checks the tables and columns of the projections exist and
+// that their names are unique per table
+func (s *State) initProjections() error {
+	names := make(map[string]struct{})
+	for _, p := range s.Config.Projections {
+		if len(p.Table) == 0 || len(p.Name) == 0 || len(p.Columns) == 0 {
+			return errors.New("projections must specify table, name and columns")
+		}
+
+		key := p.Table + "." + p.Name
+		if _, ok := names[key]; ok {
+			return errors.Errorf("projection %s is defined twice for table %s", p.Name, p.Table)
+		}
+		names[key] = struct{}{}
+
+		t, ok := findTable(s.Tables, p.Table)
+		if !ok {
+			return errors.Errorf("projection %s is for table %s which doesn't exist", p.Name, p.Table)
+		}
+
+		resolved := resolvedProjection{Name: p.Name, Table: p.Table}
+		for _, name := range p.Columns {
+			col, ok := findColumn(t, name)
+			if !ok {
+				return errors.Errorf("projection %s has column %s which doesn't exist in table %s", p.Name, name, p.Table)
+			}
+			resolved.Columns = append(resolved.Columns, col)
+		}
+
+		s.Projections = append(s.Projections, resolved)
+	}
+
+	return nil
+}
+
+func findTable(tables []drivers.Table, name string) (drivers.Table, bool) {
+	for _, t := range tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+
+	return drivers.Table{}, false
+}
+
+// TableProjections returns the projections of the table
+func (t templateData) TableProjections(table string) []resolvedProjection {
+	var projections []resolvedProjection
+	for _, p := range t.Projections {
+		if p.Table == table {
+			projections = append(projections, p)
+		}
+	}
+
+	return projections
+}
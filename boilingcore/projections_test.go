@@ -0,0 +1,109 @@
+package boilingcore
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestProjections(t *testing.T) {
+	t.Parallel()
+
+	jets := drivers.Table{
+		Name: "jets",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "name", Type: "string"},
+			{Name: "color", Type: "null.String", Nullable: true},
+			{Name: "manual", Type: "[]byte"},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+
+	s := &State{
+		Config: &Config{
+			Projections: []Projection{{Table: "jets", Name: "Summary", Columns: []string{"id", "color"}}},
+		},
+		Tables: []drivers.Table{jets},
+	}
+	FillAliases(&s.Config.Aliases, s.Tables)
+	if err := s.initProjections(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := assetLoader("templates/28_projection.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl, err := template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := &templateData{
+		Table:       jets,
+		Aliases:     s.Config.Aliases,
+		Projections: s.Projections,
+		PkgName:     "models",
+		Schema:      "dbo",
+		Dialect:     drivers.Dialect{LQ: '[', RQ: ']', UseSchema: true},
+		LQ:          "[",
+		RQ:          "]",
+		StringFuncs: templateStringMappers,
+	}
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, data); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"type JetSummary struct {",
+		"ID int `boil:\"id\" json:\"id\" toml:\"id\" yaml:\"id\"`",
+		"Color null.String `boil:\"color\" json:\"color,omitempty\" toml:\"color\" yaml:\"color,omitempty\"`",
+		"func (q jetQuery) AsSummary(ctx context.Context, exec boil.ContextExecutor) ([]*JetSummary, error) {",
+		`queries.SetSelect(q.Query, []string{"[dbo].[jets].[id]", "[dbo].[jets].[color]"})`,
+		"err := q.Bind(ctx, exec, &o)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Manual") || strings.Contains(out, "Name ") {
+		t.Errorf("want only the projection's columns:\n%s", out)
+	}
+}
+
+func TestProjectionsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{{
+		Name:    "jets",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}},
+	}}
+
+	tests := []struct {
+		Projection Projection
+		Err        string
+	}{
+		{Projection{Table: "jets", Name: "Summary"}, "projections must specify table, name and columns"},
+		{Projection{Table: "pilots", Name: "Summary", Columns: []string{"id"}}, "projection Summary is for table pilots which doesn't exist"},
+		{Projection{Table: "jets", Name: "Summary", Columns: []string{"id", "wings"}}, "projection Summary has column wings which doesn't exist in table jets"},
+	}
+
+	for _, test := range tests {
+		s := &State{Config: &Config{Projections: []Projection{test.Projection}}, Tables: tables}
+		if err := s.initProjections(); err == nil || err.Error() != test.Err {
+			t.Errorf("want error %q, got: %v", test.Err, err)
+		}
+	}
+
+	twice := Projection{Table: "jets", Name: "Summary", Columns: []string{"id"}}
+	s := &State{Config: &Config{Projections: []Projection{twice, twice}}, Tables: tables}
+	if err := s.initProjections(); err == nil || err.Error() != "projection Summary is defined twice for table jets" {
+		t.Errorf("want the duplicate rejected, got: %v", err)
+	}
+}
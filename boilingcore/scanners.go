@@ -0,0 +1,100 @@
+package boilingcore
+
+import (
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+// Scanner generates every column of a database type as a Go type of the
+// user's that implements sql.Scanner and driver.Valuer, ex: all xml columns
+// as myxml.Document. The type handles nulls itself, so nullable columns get
+// it too. Type replacements are applied afterwards and win over it.
+type Scanner struct {
+	DBType  string        `toml:"db_type,omitempty" json:"db_type,omitempty"`
+	Type    string        `toml:"type,omitempty" json:"type,omitempty"`
+	Imports importers.Set `toml:"imports,omitempty" json:"imports,omitempty"`
+}
+
+// ConvertScanners is necessary because viper
+//
+// It supports the following syntax:
+//
+//	[[scanners]]
+//	db_type = "xml"
+//	type = "myxml.Document"
+//
+//	[scanners.imports]
+//	third_party = ['"github.com/me/myxml"']
+func ConvertScanners(i interface{}) []Scanner {
+	if i == nil {
+		return nil
+	}
+
+	intfArray := i.([]interface{})
+	scanners := make([]Scanner, 0, len(intfArray))
+	for _, s := range intfArray {
+		scannerIntf := cast.ToStringMap(s)
+		if scannerIntf["db_type"] == nil || scannerIntf["type"] == nil {
+			panic("scanners must specify both db_type and type")
+		}
+
+		scanner := Scanner{
+			DBType: cast.ToString(scannerIntf["db_type"]),
+			Type:   cast.ToString(scannerIntf["type"]),
+		}
+		if imps := scannerIntf["imports"]; imps != nil {
+			var err error
+			scanner.Imports, err = importers.SetFromInterface(cast.ToStringMap(imps))
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		scanners = append(scanners, scanner)
+	}
+
+	return scanners
+}
+
+// processScanners sets the type of every column matching a scanner's
+// database type, and keeps the scanners that matched a column for the
+// boil_scanners singleton.
+func (s *State) processScanners() {
+	var types []string
+	for _, sc := range s.Config.Scanners {
+		matched := false
+		for i := range s.Tables {
+			for j, c := range s.Tables[i].Columns {
+				if !strings.EqualFold(c.DBType, sc.DBType) {
+					continue
+				}
+
+				s.Tables[i].Columns[j].Type = sc.Type
+				matched = true
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		if len(sc.Imports.Standard) != 0 || len(sc.Imports.ThirdParty) != 0 {
+			if s.Config.Imports.BasedOnType == nil {
+				s.Config.Imports.BasedOnType = make(importers.Map)
+			}
+			s.Config.Imports.BasedOnType[sc.Type] = sc.Imports
+		}
+		s.Scanners = append(s.Scanners, sc)
+		types = append(types, sc.Type)
+	}
+
+	if len(s.Scanners) == 0 {
+		return
+	}
+
+	s.addSingletonImport("boil_scanners", `"database/sql"`)
+	s.addSingletonImport("boil_scanners", `"database/sql/driver"`)
+	imps := s.Config.Imports.Singleton["boil_scanners"]
+	s.Config.Imports.Singleton["boil_scanners"] = importers.AddTypeImports(imps, s.Config.Imports.BasedOnType, types)
+}
@@ -0,0 +1,63 @@
+package boilingcore
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
+)
+
+func TestScanners(t *testing.T) {
+	t.Parallel()
+
+	// the mock's character columns stand in for xml ones
+	files := generateMock(t, func(c *Config) {
+		c.Scanners = []Scanner{{
+			DBType:  "character",
+			Type:    "myxml.Document",
+			Imports: importers.Set{ThirdParty: importers.List{`"github.com/me/myxml"`}},
+		}}
+		c.TypeReplaces = []TypeReplace{{
+			Tables:  []string{"languages"},
+			Match:   drivers.Column{DBType: "character"},
+			Replace: drivers.Column{Type: "string"},
+		}}
+	})
+
+	for file, fields := range map[string][]string{
+		"pilots.go": {"Name"},
+		"jets.go":   {"Name", "Color"},
+	} {
+		out := string(files[file])
+		if !strings.Contains(out, `"github.com/me/myxml"`) {
+			t.Errorf("%s: want the scanner's import:\n%s", file, out)
+		}
+		for _, field := range fields {
+			if !regexp.MustCompile(`\t` + field + `\s+myxml\.Document\s`).MatchString(out) {
+				t.Errorf("%s: want %s as myxml.Document:\n%s", file, field, out)
+			}
+		}
+	}
+
+	// type replacements are more specific and win
+	if out := string(files["languages.go"]); strings.Contains(out, "myxml") {
+		t.Errorf("want the type replacement to win:\n%s", out)
+	}
+
+	out := string(files["boil_scanners.go"])
+	for _, want := range []string{
+		`"github.com/me/myxml"`,
+		"_ sql.Scanner   = new(myxml.Document) // character",
+		"_ driver.Valuer = *new(myxml.Document)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+
+	if files = generateMock(t, nil); files["boil_scanners.go"] != nil {
+		t.Error("want no scanners file without scanners")
+	}
+}
@@ -0,0 +1,81 @@
+package boilingcore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// schemaVersion hashes the parts of the tables that shape the generated
+// code: names, column types and nullability, primary and foreign keys. The
+// hash doesn't depend on the order the database listed things in, so it only
+// changes when the schema does.
+func schemaVersion(tables []drivers.Table) string {
+	type column struct {
+		Name     string
+		Type     string
+		Nullable bool
+	}
+	type fkey struct {
+		Name           string
+		Columns        []string
+		ForeignTable   string
+		ForeignColumns []string
+	}
+	type table struct {
+		Name    string
+		Columns []column
+		PKey    []string
+		FKeys   []fkey
+	}
+
+	schema := make([]table, len(tables))
+	for i, t := range tables {
+		st := table{Name: t.Name}
+
+		for _, c := range t.Columns {
+			typ := c.FullDBType
+			if len(typ) == 0 {
+				typ = c.DBType
+			}
+			st.Columns = append(st.Columns, column{Name: c.Name, Type: typ, Nullable: c.Nullable})
+		}
+		sort.Slice(st.Columns, func(i, j int) bool { return st.Columns[i].Name < st.Columns[j].Name })
+
+		if t.PKey != nil {
+			st.PKey = t.PKey.Columns
+		}
+
+		for _, f := range t.FKeys {
+			key := fkey{Name: f.Name, Columns: f.Columns, ForeignTable: f.ForeignTable, ForeignColumns: f.ForeignColumns}
+			if len(key.Columns) == 0 {
+				key.Columns, key.ForeignColumns = []string{f.Column}, []string{f.ForeignColumn}
+			}
+			st.FKeys = append(st.FKeys, key)
+		}
+		sort.Slice(st.FKeys, func(i, j int) bool { return st.FKeys[i].Name < st.FKeys[j].Name })
+
+		schema[i] = st
+	}
+	sort.Slice(schema, func(i, j int) bool { return schema[i].Name < schema[j].Name })
+
+	// Marshaling plain structs and slices can't fail
+	b, _ := json.Marshal(schema)
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// SchemaWhitelist returns the columns of a table the whitelist keeps, none
+// when it keeps them all
+func (t templateData) SchemaWhitelist(table string) []string {
+	return drivers.ColumnsFromList(t.Whitelist, table)
+}
+
+// SchemaBlacklist returns the columns of a table the blacklist drops
+func (t templateData) SchemaBlacklist(table string) []string {
+	return drivers.BlacklistColumnsFromList(t.Blacklist, table)
+}
@@ -0,0 +1,147 @@
+package boilingcore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestSchemaVersion(t *testing.T) {
+	t.Parallel()
+
+	tables := func() []drivers.Table {
+		return []drivers.Table{
+			{
+				Name: "pilots",
+				Columns: []drivers.Column{
+					{Name: "id", DBType: "integer"},
+					{Name: "name", DBType: "character", FullDBType: "character(64)"},
+				},
+				PKey: &drivers.PrimaryKey{Name: "pilots_pkey", Columns: []string{"id"}},
+			},
+			{
+				Name: "jets",
+				Columns: []drivers.Column{
+					{Name: "id", DBType: "integer"},
+					{Name: "pilot_id", DBType: "integer", Nullable: true},
+				},
+				PKey: &drivers.PrimaryKey{Name: "jets_pkey", Columns: []string{"id"}},
+				FKeys: []drivers.ForeignKey{
+					{Name: "jets_pilot_id_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
+				},
+			},
+		}
+	}
+
+	want := schemaVersion(tables())
+	if len(want) == 0 {
+		t.Fatal("want a version")
+	}
+	if got := schemaVersion(tables()); got != want {
+		t.Errorf("want the version to be stable, got %s and %s", want, got)
+	}
+
+	// the order tables and columns are listed in doesn't matter
+	reordered := tables()
+	reordered[0], reordered[1] = reordered[1], reordered[0]
+	cols := reordered[1].Columns
+	cols[0], cols[1] = cols[1], cols[0]
+	if got := schemaVersion(reordered); got != want {
+		t.Errorf("want the version to ignore ordering, got %s and %s", want, got)
+	}
+
+	changes := map[string]func(tables []drivers.Table){
+		"column type": func(tables []drivers.Table) { tables[0].Columns[1].FullDBType = "character(128)" },
+		"nullability": func(tables []drivers.Table) { tables[1].Columns[1].Nullable = false },
+		"new column": func(tables []drivers.Table) {
+			tables[0].Columns = append(tables[0].Columns, drivers.Column{Name: "rank", DBType: "integer"})
+		},
+		"foreign key": func(tables []drivers.Table) { tables[1].FKeys = nil },
+	}
+	for name, change := range changes {
+		changed := tables()
+		change(changed)
+		if got := schemaVersion(changed); got == want {
+			t.Errorf("%s: want the version to change", name)
+		}
+	}
+}
+
+func TestVerifySchema(t *testing.T) {
+	t.Parallel()
+
+	files := generateMock(t, nil)
+	if _, ok := files["boil_schema.go"]; ok {
+		t.Error("want no boil_schema.go without generate_schema_version")
+	}
+
+	files = generateMock(t, func(c *Config) { c.GenerateSchemaVersion = true })
+	out := string(files["boil_schema.go"])
+
+	for _, want := range []string{
+		`const SchemaVersion = "`,
+		`const EngineVersion = "mock 1.0"`,
+		`{"pilots", []schemaColumn{{"id", false}, {"name", false}}, nil, nil},`,
+		`{"airports", []schemaColumn{{"id", false}, {"size", true}}, nil, nil},`,
+		"func VerifySchema(ctx context.Context, exec boil.ContextExecutor) error",
+		`"SELECT table_name, column_name, is_nullable FROM information_schema.columns WHERE table_schema = $1", "schema")`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+
+	files = generateMock(t, func(c *Config) {
+		c.GenerateSchemaVersion = true
+		c.NoContext = true
+	})
+	if out = string(files["boil_schema.go"]); !strings.Contains(out, "func VerifySchema(exec boil.Executor) error") {
+		t.Errorf("want a context free VerifySchema:\n%s", out)
+	}
+
+	// Columns the whitelist or blacklist kept out of the models aren't drift
+	files = generateMock(t, func(c *Config) {
+		c.GenerateSchemaVersion = true
+		c.DriverConfig[drivers.ConfigBlacklist] = []string{"airports.secret", "*.row_version"}
+	})
+	out = string(files["boil_schema.go"])
+	for _, want := range []string{
+		`{"pilots", []schemaColumn{{"id", false}, {"name", false}}, nil, []string{"row_version"}},`,
+		`{"airports", []schemaColumn{{"id", false}, {"size", true}}, nil, []string{"secret", "row_version"}},`,
+		"if !table.filtered(name) {",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+}
+
+func TestVerifySchemaTemplate(t *testing.T) {
+	t.Parallel()
+
+	table := drivers.Table{Name: "pilots", Columns: []drivers.Column{{Name: "id"}, {Name: "name"}}}
+	out := renderTemplate(t, "templates/singleton/boil_schema.go.tpl", drivers.Table{}, &templateData{
+		Tables:                []drivers.Table{table},
+		GenerateSchemaVersion: true,
+		SchemaQuery:           "SELECT table_name, column_name, is_nullable FROM information_schema.columns",
+		Whitelist:             []string{"pilots.id", "pilots.name"},
+	})
+	if want := `{"pilots", []schemaColumn{{"id", false}, {"name", false}}, []string{"id", "name"}, nil},`; !strings.Contains(out, want) {
+		t.Errorf("missing %s:\n%s", want, out)
+	}
+	if want := `"SELECT table_name, column_name, is_nullable FROM information_schema.columns")`; !strings.Contains(out, want) {
+		t.Errorf("want the query without a schema, missing %s:\n%s", want, out)
+	}
+
+	out = renderTemplate(t, "templates/singleton/boil_schema.go.tpl", drivers.Table{}, &templateData{
+		GenerateSchemaVersion: true,
+		SchemaVersion:         "abc",
+	})
+	if !strings.Contains(out, `const SchemaVersion = "abc"`) {
+		t.Errorf("want the schema version:\n%s", out)
+	}
+	if strings.Contains(out, "VerifySchema") {
+		t.Errorf("want no VerifySchema without a schema query:\n%s", out)
+	}
+}
@@ -0,0 +1,117 @@
+package boilingcore
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cast"
+)
+
+// StructTagCases overrides StructTagCasing for the json, toml and yaml struct
+// tags one at a time, empty fields keep StructTagCasing.
+type StructTagCases struct {
+	JSON string `toml:"json,omitempty" json:"json,omitempty"`
+	TOML string `toml:"toml,omitempty" json:"toml,omitempty"`
+	YAML string `toml:"yaml,omitempty" json:"yaml,omitempty"`
+}
+
+// ConvertStructTagCases is necessary because viper
+//
+// It supports the following syntax:
+//
+//	[struct-tag-cases]
+//	json = "split_camel"
+//	yaml = "snake"
+func ConvertStructTagCases(i interface{}) StructTagCases {
+	if i == nil {
+		return StructTagCases{}
+	}
+
+	m := cast.ToStringMap(i)
+	return StructTagCases{
+		JSON: strings.ToLower(cast.ToString(m["json"])),
+		TOML: strings.ToLower(cast.ToString(m["toml"])),
+		YAML: strings.ToLower(cast.ToString(m["yaml"])),
+	}
+}
+
+// TagCasing returns the casing of the json, toml or yaml struct tag
+func (t templateData) TagCasing(tag string) string {
+	var casing string
+	switch tag {
+	case "json":
+		casing = t.StructTagCases.JSON
+	case "toml":
+		casing = t.StructTagCases.TOML
+	case "yaml":
+		casing = t.StructTagCases.YAML
+	}
+	if len(casing) == 0 {
+		return t.StructTagCasing
+	}
+
+	return casing
+}
+
+// TagCase converts a column name to a struct tag casing:
+//
+//	snake:       the column name as is
+//	camel:       camelCase, ex: user_id is userID
+//	title:       TitleCase, ex: user_id is UserID
+//	alias:       the column's alias
+//	split_snake: snake_case split on case changes too, ex: UserID is user_id
+//	split_camel: camelCase split on case changes too, ex: UserID is userId
+func (i Initialisms) TagCase(casing, name, alias string) string {
+	switch casing {
+	case "camel":
+		return i.CamelCase(name)
+	case "title":
+		return i.TitleCase(name)
+	case "alias":
+		return alias
+	case "split_snake":
+		return strings.Join(splitWords(name), "_")
+	case "split_camel":
+		words := splitWords(name)
+		for j := 1; j < len(words); j++ {
+			words[j] = strings.ToUpper(words[j][:1]) + words[j][1:]
+		}
+		return strings.Join(words, "")
+	default:
+		return name
+	}
+}
+
+// splitWords lowercases the words of a PascalCase or snake_case name,
+// breaking on underscores and case changes. Acronyms are one word.
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+
+	flush := func(end int) {
+		if end > start {
+			words = append(words, strings.ToLower(string(runes[start:end])))
+		}
+	}
+
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			flush(i)
+			start = i + 1
+		case i > start && unicode.IsUpper(r):
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// Break before an upper after a lower (userID) and before the
+			// last upper of an acronym followed by a lower (HTTPServer)
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush(i)
+				start = i
+			}
+		}
+	}
+	flush(len(runes))
+
+	return words
+}
@@ -0,0 +1,62 @@
+package boilingcore
+
+import "testing"
+
+func TestTagCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Name  string
+		Snake string
+		Camel string
+	}{
+		{"UserID", "user_id", "userId"},
+		{"user_id", "user_id", "userId"},
+		{"ID", "id", "id"},
+		{"HTTPServerName", "http_server_name", "httpServerName"},
+		{"Address2Line", "address2_line", "address2Line"},
+		{"name", "name", "name"},
+	}
+
+	var i Initialisms
+	for n, test := range tests {
+		if got := i.TagCase("split_snake", test.Name, ""); got != test.Snake {
+			t.Errorf("%d) split_snake want: %s, got: %s", n, test.Snake, got)
+		}
+		if got := i.TagCase("split_camel", test.Name, ""); got != test.Camel {
+			t.Errorf("%d) split_camel want: %s, got: %s", n, test.Camel, got)
+		}
+	}
+
+	if got := i.TagCase("snake", "UserID", ""); got != "UserID" {
+		t.Error("snake should leave the name alone, got:", got)
+	}
+	if got := i.TagCase("camel", "user_id", ""); got != "userID" {
+		t.Error("wrong camel name:", got)
+	}
+	if got := i.TagCase("title", "user_id", ""); got != "UserID" {
+		t.Error("wrong title name:", got)
+	}
+	if got := i.TagCase("alias", "user_id", "Owner"); got != "Owner" {
+		t.Error("wrong alias name:", got)
+	}
+}
+
+func TestTagCasing(t *testing.T) {
+	t.Parallel()
+
+	data := templateData{
+		StructTagCasing: "camel",
+		StructTagCases:  StructTagCases{JSON: "split_camel"},
+	}
+
+	if got := data.TagCasing("json"); got != "split_camel" {
+		t.Error("json should use its own casing, got:", got)
+	}
+	if got := data.TagCasing("toml"); got != "camel" {
+		t.Error("toml should fall back to the struct tag casing, got:", got)
+	}
+	if got := data.TagCasing("yaml"); got != "camel" {
+		t.Error("yaml should fall back to the struct tag casing, got:", got)
+	}
+}
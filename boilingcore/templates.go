@@ -23,6 +23,38 @@ type templateData struct {
 	Table   drivers.Table
 	Aliases Aliases
 
+	// Functions are the database's user defined functions
+	Functions []drivers.Function
+
+	// Enums are the lookup tables generated as Go types with constants
+	Enums []drivers.Enum
+
+	// SchemaVersion is a hash of the schema the models are generated from
+	SchemaVersion string
+	// EngineVersion is the version of the database engine the models are
+	// generated from, empty if the driver can't tell
+	EngineVersion string
+	// SchemaQuery is the driver's query VerifySchema reads the live columns
+	// with, see drivers.DBInfo
+	SchemaQuery string
+
+	// Whitelist and Blacklist are the driver's, VerifySchema ignores the
+	// columns they kept out of the models
+	Whitelist []string
+	Blacklist []string
+
+	// Embeds are the column groups generated as embedded structs
+	Embeds []resolvedEmbed
+
+	// Projections are the column subsets of tables generated as structs
+	Projections []resolvedProjection
+
+	// Mixins are the column groups generated as interfaces of getters
+	Mixins []resolvedMixin
+
+	// Scanners are the types that replaced every column of a database type
+	Scanners []Scanner
+
 	// Controls what names are output
 	PkgName string
 	Schema  string
@@ -37,15 +69,36 @@ type templateData struct {
 	RQ string
 
 	// Control various generation features
-	AddGlobal         bool
-	AddPanic          bool
-	AddSoftDeletes    bool
-	NoContext         bool
-	NoHooks           bool
-	NoAutoTimestamps  bool
-	NoRowsAffected    bool
-	NoDriverTemplates bool
-	NoBackReferencing bool
+	AddGlobal             bool
+	AddPanic              bool
+	AddSoftDeletes        bool
+	NoContext             bool
+	NoHooks               bool
+	NoAutoTimestamps      bool
+	NoRowsAffected        bool
+	NoDriverTemplates     bool
+	NoBackReferencing     bool
+	EmitNameConstants     bool
+	GenerateIndexMetadata bool
+	GenerateInterfaces    bool
+	GenerateDTOs          bool
+	GenerateValidate      bool
+	GenerateConstructors  bool
+	GenerateChangesets    bool
+	GenerateDeleteCascade bool
+	GenerateInsertIgnore  bool
+	GenerateSchemaVersion bool
+	JSONMethods           bool
+
+	// DTONullStyle is how GenerateDTOs write null columns: pointer or null
+	DTONullStyle string
+
+	// JSONNullPolicy is how JSONMethods write null columns: render or omit
+	JSONNullPolicy string
+
+	// BulkInsertBatchSize is the default number of rows InsertAll commits
+	// per transaction, 0 for all of them
+	BulkInsertBatchSize int
 
 	// Tags control which tags are added to the struct
 	Tags []string
@@ -55,6 +108,8 @@ type templateData struct {
 
 	// Generate struct tags as camelCase or snake_case
 	StructTagCasing string
+	// StructTagCases overrides StructTagCasing per tag, see TagCasing
+	StructTagCases StructTagCases
 
 	// Contains field names that should have tags values set to '-'
 	TagIgnore map[string]struct{}
@@ -74,6 +129,23 @@ func (t templateData) Quotes(s string) string {
 	return fmt.Sprintf("%s%s%s", t.LQ, s, t.RQ)
 }
 
+// ReadOnly returns true if any of the named tables is read only, empty
+// names are skipped so a relationship's optional join table can be passed.
+func (t templateData) ReadOnly(names ...string) bool {
+	for _, name := range names {
+		if len(name) != 0 && drivers.GetTable(t.Tables, name).IsReadOnly() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Placeholders returns the bind parameter placeholders for n arguments
+func (t templateData) Placeholders(n int) string {
+	return strmangle.Placeholders(t.Dialect.UseIndexPlaceholders, n, 1, 1)
+}
+
 func (t templateData) SchemaTable(table string) string {
 	return strmangle.SchemaTable(t.LQ, t.RQ, t.Dialect.UseSchema, t.Schema, table)
 }
@@ -250,7 +322,7 @@ var templateStringMappers = map[string]func(string) string{
 	"camelCase": strmangle.CamelCase,
 }
 
-var goVarnameReplacer = strings.NewReplacer("[", "_", "]", "_", ".", "_")
+var goVarnameReplacer = strings.NewReplacer("[", "_", "]", "_", ".", "_", "*", "_ptr_")
 
 // templateFunctions is a map of all the functions that get passed into the
 // templates. If you wish to pass a new function into your own template,
@@ -268,6 +340,7 @@ var templateFunctions = template.FuncMap{
 	// Casing
 	"titleCase": strmangle.TitleCase,
 	"camelCase": strmangle.CamelCase,
+	"tagCase":   Initialisms{}.TagCase,
 	"ignore":    strmangle.Ignore,
 
 	// String Slice ops
@@ -299,9 +372,16 @@ var templateFunctions = template.FuncMap{
 	"whereClause": strmangle.WhereClause,
 
 	// Alias and text helping
-	"aliasCols":      func(ta TableAlias) func(string) string { return ta.Column },
-	"usesPrimitives": usesPrimitives,
-	"isPrimitive":    isPrimitive,
+	"aliasCols":        func(ta TableAlias) func(string) string { return ta.Column },
+	"usesPrimitives":   usesPrimitives,
+	"isPrimitive":      isPrimitive,
+	"loadKeyColumns":   loadKeyColumns,
+	"loadByPrimaryKey": loadByPrimaryKey,
+	"nullPointerType":  nullPointerType,
+	"isPointerType":    func(typ string) bool { return strings.HasPrefix(typ, "*") },
+	"isSQLNullType":    func(typ string) bool { return strings.HasPrefix(typ, "sql.Null") },
+	"columnComment":    columnComment,
+	"escapeString":     escapeString,
 	"splitLines": func(a string) []string {
 		if a == "" {
 			return nil
@@ -310,11 +390,13 @@ var templateFunctions = template.FuncMap{
 	},
 
 	// dbdrivers ops
-	"filterColumnsByAuto":    drivers.FilterColumnsByAuto,
-	"filterColumnsByDefault": drivers.FilterColumnsByDefault,
-	"filterColumnsByEnum":    drivers.FilterColumnsByEnum,
-	"sqlColDefinitions":      drivers.SQLColDefinitions,
-	"columnNames":            drivers.ColumnNames,
-	"columnDBTypes":          drivers.ColumnDBTypes,
-	"getTable":               drivers.GetTable,
+	"filterColumnsByAuto":          drivers.FilterColumnsByAuto,
+	"filterColumnsByAutoIncrement": drivers.FilterColumnsByAutoIncrement,
+	"filterColumnsByDefault":       drivers.FilterColumnsByDefault,
+	"filterColumnsByEnum":          drivers.FilterColumnsByEnum,
+	"filterColumnsByRequired":      drivers.FilterColumnsByRequired,
+	"sqlColDefinitions":            drivers.SQLColDefinitions,
+	"columnNames":                  drivers.ColumnNames,
+	"columnDBTypes":                drivers.ColumnDBTypes,
+	"getTable":                     drivers.GetTable,
 }
@@ -1,11 +1,114 @@
 package boilingcore
 
 import (
+	"bytes"
+	"go/parser"
+	"go/token"
 	"sort"
+	"strings"
 	"testing"
 	"text/template"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	mssql "github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-mssql/driver"
 )
 
+// renderTemplate executes the template name for table the way the generator
+// would: with the auto timestamp helpers, a package name, the string mappers,
+// fresh DBTypes and the aliases of table and data.Tables filled in. Names
+// starting with mssql: are the mssql driver's override templates. The output
+// has to parse as the Go file it ends up in.
+func renderTemplate(t *testing.T, name string, table drivers.Table, data *templateData) string {
+	t.Helper()
+
+	var loader templateLoader = assetLoader(name)
+	if file := strings.TrimPrefix(name, "mssql:"); file != name {
+		driverTemplates, err := (&mssql.MSSQLDriver{}).Templates()
+		if err != nil {
+			t.Fatal(err)
+		}
+		loader = base64Loader(driverTemplates[file])
+	}
+
+	b, err := loader.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	timestamps, err := assetLoader("templates/21_auto_timestamps.go.tpl").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tpl, err := template.New("").Funcs(templateFunctions).Parse(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tpl.New("timestamps").Parse(string(timestamps)); err != nil {
+		t.Fatal(err)
+	}
+
+	data.Table = table
+	data.DBTypes = make(once)
+	if len(data.PkgName) == 0 {
+		data.PkgName = "models"
+	}
+	if data.StringFuncs == nil {
+		data.StringFuncs = templateStringMappers
+	}
+	tables := data.Tables
+	if len(table.Name) != 0 {
+		tables = append(tables[:len(tables):len(tables)], table)
+	}
+	FillAliases(&data.Aliases, tables)
+
+	buf := &bytes.Buffer{}
+	if err = tpl.Execute(buf, data); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	src := "package " + data.PkgName + "\n\n" + out
+	if _, err = parser.ParseFile(token.NewFileSet(), name, src, 0); err != nil {
+		t.Fatalf("%s doesn't parse: %v\n%s", name, err, out)
+	}
+
+	return out
+}
+
+// templateTest renders Template for Table, with Data changing a copy of the
+// test's base data first. The output must contain Want and not NotWant.
+type templateTest struct {
+	Name     string
+	Template string
+	Table    drivers.Table
+	Data     func(*templateData)
+	Want     []string
+	NotWant  []string
+}
+
+func testTemplates(t *testing.T, base templateData, tests []templateTest) {
+	t.Helper()
+
+	for _, test := range tests {
+		data := base
+		if test.Data != nil {
+			test.Data(&data)
+		}
+
+		out := renderTemplate(t, test.Template, test.Table, &data)
+		for _, want := range test.Want {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s: missing %s:\n%s", test.Name, want, out)
+			}
+		}
+		for _, notWant := range test.NotWant {
+			if strings.Contains(out, notWant) {
+				t.Errorf("%s: don't want %s:\n%s", test.Name, notWant, out)
+			}
+		}
+	}
+}
+
 func TestTemplateNameListSort(t *testing.T) {
 	t.Parallel()
 
@@ -66,3 +169,1631 @@ func TestTemplateList_Templates(t *testing.T) {
 		t.Error("don't want not")
 	}
 }
+
+func TestTableNamesConstants(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/singleton/boil_table_names.go.tpl"
+	base := templateData{
+		Tables: []drivers.Table{
+			{Name: "pilot_languages", Columns: []drivers.Column{{Name: "pilot_id"}, {Name: "language_id"}}},
+		},
+	}
+
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "disabled",
+			Template: name,
+			NotWant:  []string{"TablePilotLanguages"},
+		},
+		{
+			Name:     "enabled",
+			Template: name,
+			Data:     func(d *templateData) { d.EmitNameConstants = true },
+			Want: []string{
+				`TablePilotLanguages = "pilot_languages"`,
+				`ColumnPilotLanguages_LanguageID = "language_id"`,
+			},
+		},
+	})
+}
+
+func TestLookupEnums(t *testing.T) {
+	t.Parallel()
+
+	data := &templateData{
+		Enums: []drivers.Enum{
+			{Name: "OrderStatusID", Table: "order_status", Type: "int", Values: []drivers.EnumValue{
+				{Name: "OrderStatusPending", Key: "1"},
+				{Name: "OrderStatusShipped", Key: "2"},
+			}},
+			{Name: "Currency", Table: "currencies", Type: "string", Values: []drivers.EnumValue{
+				{Name: "CurrencyEuro", Key: "EUR"},
+			}},
+			{Name: "ColorID", Table: "colors", Type: "int"},
+		},
+	}
+
+	out := renderTemplate(t, "templates/singleton/boil_lookup_enums.go.tpl", drivers.Table{}, data)
+	for _, want := range []string{
+		"type OrderStatusID int\n",
+		"OrderStatusPending OrderStatusID = 1\n",
+		"OrderStatusShipped OrderStatusID = 2\n",
+		"type Currency string\n",
+		`CurrencyEuro Currency = "EUR"`,
+		"type ColorID int\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "const (") != 2 {
+		t.Error("want no constants for an empty table:\n", out)
+	}
+}
+
+func TestQueriesDialectCountBig(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/singleton/boil_queries.go.tpl"
+	testTemplates(t, templateData{}, []templateTest{
+		{
+			Name:     "off by default",
+			Template: name,
+			Want:     []string{"UseCountBig:             false"},
+		},
+		{
+			Name:     "passed on to the runtime dialect",
+			Template: name,
+			Data:     func(d *templateData) { d.Dialect.UseCountBig = true },
+			Want:     []string{"UseCountBig:             true"},
+		},
+	})
+}
+
+func TestQueriesInsertAllBatchSize(t *testing.T) {
+	t.Parallel()
+
+	testTemplates(t, templateData{BulkInsertBatchSize: 250}, []templateTest{
+		{
+			Name:     "passed on to the runtime",
+			Template: "templates/singleton/boil_queries.go.tpl",
+			Want:     []string{"var InsertAllBatchSize = 250"},
+		},
+	})
+}
+
+func TestIndexMetadata(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/23_indexes.go.tpl"
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id"}, {Name: "name"}, {Name: "deleted_at"}},
+		Indexes: []drivers.Index{
+			{Name: "pk_pilots", Columns: []string{"id"}, Unique: true},
+			{Name: "ix_pilots_name", Columns: []string{"name", "deleted_at"}, Filter: "([deleted_at] IS NULL)"},
+		},
+	}
+
+	testTemplates(t, templateData{}, []templateTest{
+		{
+			Name:     "disabled",
+			Template: name,
+			Table:    pilots,
+			NotWant:  []string{"PilotIndexes"},
+		},
+		{
+			Name:     "enabled",
+			Template: name,
+			Table:    pilots,
+			Data:     func(d *templateData) { d.GenerateIndexMetadata = true },
+			Want: []string{
+				`{Name: "pk_pilots", Columns: []string{"id"}, Unique: true},`,
+				`{Name: "ix_pilots_name", Columns: []string{"name", "deleted_at"}, Unique: false, Filter: "([deleted_at] IS NULL)"},`,
+			},
+		},
+	})
+}
+
+func TestDeleteAllReturning(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/18_delete.go.tpl"
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	triggered := pilots
+	triggered.Triggers = []string{"tr_pilots"}
+
+	base := templateData{Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseOutputClause: true}}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "output clause",
+			Template: name,
+			Table:    pilots,
+			Want:     []string{"queries.SetOutput(q.Query, pilotPrimaryKeyColumns...)"},
+		},
+		{
+			Name:     "rows affected fallback on tables with triggers",
+			Template: name,
+			Table:    triggered,
+			Want:     []string{"func (q pilotQuery) DeleteAllReturning(", "return nil, rowsAff, nil"},
+			NotWant:  []string{"queries.SetOutput"},
+		},
+	})
+}
+
+func TestUpdateColumns(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/16_update.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "name", Type: "string"},
+			{Name: "row_version", Type: "[]byte", AutoGenerated: true},
+			{Name: "updated_at", Type: "time.Time"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseAutoColumns: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "update columns",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func (o *Pilot) UpdateColumns(ctx context.Context, exec boil.ContextExecutor, cols ...string) (int64, error) {",
+				"wl := strmangle.SetComplement(cols, pilotPrimaryKeyColumns)",
+				// Update leaves the auto generated columns out of the whitelist
+				"wl = strmangle.SetComplement(wl, pilotColumnsWithAuto)",
+				"if !boil.TimestampsAreSkipped(ctx) && !strmangle.SetInclude(\"updated_at\", wl) {\n\t\twl = append(wl, \"updated_at\")",
+				"return o.Update(ctx, exec, boil.Whitelist(wl...))",
+				// The whitelist is the SET clause, with positional placeholders
+				"strmangle.SetParamNames(\"[\", \"]\", 1, wl)",
+			},
+		},
+		{
+			Name:     "context free without rows affected or auto timestamps",
+			Template: name,
+			Table:    pilots,
+			Data: func(d *templateData) {
+				d.NoAutoTimestamps = true
+				d.NoContext = true
+				d.NoRowsAffected = true
+			},
+			Want:    []string{"func (o *Pilot) UpdateColumns(exec boil.Executor, cols ...string) error {"},
+			NotWant: []string{`wl = append(wl, "updated_at")`},
+		},
+	})
+}
+
+func TestColumnsStruct(t *testing.T) {
+	t.Parallel()
+
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "first_name", Type: "string"},
+			{Name: `nick"name`, Type: "string"},
+		},
+	}
+	base := templateData{
+		Aliases: Aliases{Tables: map[string]TableAlias{
+			"pilots": {Columns: map[string]string{`nick"name`: "NickName"}},
+		}},
+	}
+
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "quoted column names",
+			Template: "templates/00_struct.go.tpl",
+			Table:    pilots,
+			Want: []string{
+				`ID: "id",`,
+				`FirstName: "first_name",`,
+				`NickName: "nick\"name",`,
+				"var PilotAllColumns = []string{\n\t\"id\",\n\t\"first_name\",\n\t\"nick\\\"name\",\n",
+				// Struct tags and helper fields are escaped too
+				"NickName string `boil:\"nick\\\"name\" json:\"nick\\\"name\"",
+				`NickName: whereHelperstring{field: "pilots.nick\"name"},`,
+			},
+		},
+	})
+}
+
+func TestStructTagCases(t *testing.T) {
+	t.Parallel()
+
+	pilots := drivers.Table{
+		Name: "Pilots",
+		Columns: []drivers.Column{
+			{Name: "UserID", Type: "int"},
+			{Name: "NickName", Type: "null.String", Nullable: true},
+		},
+	}
+	base := templateData{
+		StructTagCasing: "title",
+		StructTagCases:  StructTagCases{JSON: "split_camel", YAML: "split_snake"},
+	}
+
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "each tag uses its own casing",
+			Template: "templates/00_struct.go.tpl",
+			Table:    pilots,
+			Want: []string{
+				`boil:"UserID" json:"userId" toml:"UserID" yaml:"user_id"`,
+				// Nullable columns keep omitempty
+				`boil:"NickName" json:"nickName,omitempty" toml:"NickName" yaml:"nick_name,omitempty"`,
+			},
+		},
+	})
+}
+
+func TestWhereAndOrderByHelpers(t *testing.T) {
+	t.Parallel()
+
+	table := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "nick", Type: "null.String", Nullable: true},
+		},
+	}
+
+	testTemplates(t, templateData{LQ: `\"`, RQ: `\"`}, []templateTest{
+		{
+			Name:     "where and order by helpers",
+			Template: "templates/00_struct.go.tpl",
+			Table:    table,
+			Want: []string{
+				"func (w whereHelperint) EQ(x int) qm.QueryMod",
+				"func (w whereHelperint) IN(slice []int) qm.QueryMod",
+				"func (w whereHelpernull_String) IsNull() qm.QueryMod",
+				"func (w whereHelpernull_String) IsNotNull() qm.QueryMod",
+				`Nick: whereHelpernull_String{field: "\"pilots\".\"nick\""},`,
+				`ID: orderByHelper{field: "\"pilots\".\"id\""},`,
+			},
+			// Not null columns have no IsNull or IsNotNull
+			NotWant: []string{"func (w whereHelperint) IsNull()", "func (w whereHelperint) IsNotNull()"},
+		},
+	})
+}
+
+func TestDeprecatedColumn(t *testing.T) {
+	t.Parallel()
+
+	table := drivers.Table{
+		Name: "jets",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "price", Type: "int", Comment: "Price in dollars\n@deprecated use price_cents"},
+			{Name: "price_cents", Type: "int"},
+		},
+	}
+
+	testTemplates(t, templateData{LQ: `\"`, RQ: `\"`}, []templateTest{
+		{
+			Name:     "deprecated column",
+			Template: "templates/00_struct.go.tpl",
+			Table:    table,
+			Want:     []string{"\t// Price in dollars\n\t//\n\t// Deprecated: use price_cents\n\tPrice int `"},
+		},
+	})
+}
+
+func TestOrderByHelperDirections(t *testing.T) {
+	t.Parallel()
+
+	table := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}, {Name: "status", Type: "string"}},
+	}
+
+	testTemplates(t, templateData{}, []templateTest{
+		{
+			Name:     "directions",
+			Template: "templates/singleton/boil_queries.go.tpl",
+			Want: []string{
+				`func (o orderByHelper) Asc() qm.QueryMod { return qm.OrderBy(o.field + " ASC") }`,
+				`func (o orderByHelper) Desc() qm.QueryMod { return qm.OrderBy(o.field + " DESC") }`,
+			},
+		},
+		{
+			Name:     "quoted with the dialect's quotes and schema",
+			Template: "templates/00_struct.go.tpl",
+			Table:    table,
+			Data: func(d *templateData) {
+				d.Schema = "dbo"
+				d.Dialect = drivers.Dialect{LQ: '[', RQ: ']', UseSchema: true}
+				d.LQ, d.RQ = "[", "]"
+			},
+			Want: []string{`Status: orderByHelper{field: "[dbo].[pilots].[status]"},`},
+		},
+	})
+}
+
+func TestNewModel(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/00_struct.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", AutoIncrement: true},
+			{Name: "name", Type: "string"},
+			{Name: "nick", Type: "null.String", Nullable: true},
+			{Name: "rank", Type: "int", Default: "1", GoDefault: "1"},
+			{Name: "callsign", Type: "null.String", Nullable: true, Default: "'ace'", GoDefault: `null.StringFrom("ace")`},
+			{Name: "row_version", Type: "[]byte", AutoGenerated: true},
+			{Name: "type", Type: "string"},
+		},
+	}
+
+	testTemplates(t, templateData{}, []templateTest{
+		{
+			Name:     "constructor",
+			Template: name,
+			Table:    pilots,
+			Data:     func(d *templateData) { d.GenerateConstructors = true },
+			Want: []string{
+				// The required columns only
+				"func NewPilot(name string, type_ string) *Pilot {\n\tpilotObj := &Pilot{}\n\tpilotObj.Name = name\n\tpilotObj.Type = type_\n",
+				// The literal defaults filled in
+				"\tpilotObj.Rank = 1\n\tpilotObj.Callsign = null.StringFrom(\"ace\")\n",
+			},
+		},
+		{
+			Name:     "constructors are opt in",
+			Template: name,
+			Table:    pilots,
+			NotWant:  []string{"func NewPilot("},
+		},
+	})
+}
+
+func TestQueryOps(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/14_find.go.tpl"
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+
+	testTemplates(t, templateData{}, []templateTest{
+		{
+			Name:     "find names its operation for the query comments",
+			Template: name,
+			Table:    pilots,
+			Want:     []string{"(*Pilot, error) {\n\tctx = boil.WithQueryOp(ctx, \"Pilot\", \"Find\")\n"},
+		},
+		{
+			Name:     "no operation without a context",
+			Template: name,
+			Table:    pilots,
+			Data:     func(d *templateData) { d.NoContext = true },
+			NotWant:  []string{"WithQueryOp"},
+		},
+	})
+}
+
+func TestSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "removed_at", Type: "null.Time", Nullable: true},
+		},
+		PKey:             &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+		SoftDeleteColumn: "removed_at",
+	}
+	hardOnly := pilots
+	hardOnly.SoftDeleteColumn = ""
+
+	base := templateData{
+		Dialect:        drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:             "[",
+		RQ:             "]",
+		AddSoftDeletes: true,
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "delete",
+			Template: "templates/18_delete.go.tpl",
+			Table:    pilots,
+			Want: []string{
+				"func (o *Pilot) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool)",
+				// The soft delete column is set
+				"o.RemovedAt = null.TimeFrom(currTime)",
+				`wl := []string{"removed_at"}`,
+				// Unless it's a hard delete
+				`sql = "DELETE FROM [pilots] WHERE [id]=$1"`,
+				`queries.SetUpdate(q.Query, M{"removed_at": currTime})`,
+			},
+		},
+		{
+			Name:     "soft deleted rows filtered from selects",
+			Template: "templates/13_all.go.tpl",
+			Table:    pilots,
+			Want:     []string{`qmhelper.WhereIsNull("[pilots].[removed_at]")`},
+		},
+		{
+			Name:     "tables without a soft delete column are not filtered",
+			Template: "templates/13_all.go.tpl",
+			Table:    hardOnly,
+			NotWant:  []string{"WhereIsNull"},
+		},
+	})
+}
+
+func TestFindByUniqueKeys(t *testing.T) {
+	t.Parallel()
+
+	table := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Unique: true},
+			{Name: "email", Type: "string", Unique: true},
+			{Name: "airline_id", Type: "int"},
+			{Name: "code", Type: "string"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+		Indexes: []drivers.Index{
+			{Name: "uq_pilots_code", Columns: []string{"airline_id", "code"}, Unique: true},
+		},
+	}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "unique keys",
+			Template: "templates/14_find.go.tpl",
+			Table:    table,
+			Want: []string{
+				"func FindPilotByEmail(ctx context.Context, exec boil.ContextExecutor, email string, selectCols ...string) (*Pilot, error)",
+				// A single column finder binds its column
+				"from [pilots] where [email]=$1",
+				"queries.Raw(query, email)",
+				"func FindPilotByAirlineIDAndCode(ctx context.Context, exec boil.ContextExecutor, airlineID int, code string, selectCols ...string) (*Pilot, error)",
+				// A composite finder binds all its columns
+				"from [pilots] where [airline_id]=$1 AND [code]=$2",
+				"queries.Raw(query, airlineID, code)",
+			},
+			// The primary key has no finder of its own
+			NotWant: []string{"FindPilotByID"},
+		},
+	})
+}
+
+func TestFindRowID(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/14_find.go.tpl"
+	rowID := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto"},
+			{Name: "name", Type: "string"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}, RowID: true},
+	}
+	noRowID := rowID
+	noRowID.PKey = &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "rowid tables prebuild their find query",
+			Template: name,
+			Table:    rowID,
+			Want: []string{
+				`var pilotFindQuery = "select * from [pilots] where [id]=$1"`,
+				"query := pilotFindQuery",
+				// The query is still built for selected columns
+				`query = fmt.Sprintf("select %s from [pilots] where [id]=$1", sel)`,
+			},
+		},
+		{
+			Name:     "find builds its query",
+			Template: name,
+			Table:    noRowID,
+			Want:     []string{`"select %s from [pilots] where [id]=$1", sel,`},
+			NotWant:  []string{"pilotFindQuery"},
+		},
+	})
+}
+
+func TestJSONMethods(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/24_json.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "first_name", Type: "null.String", Nullable: true},
+			{Name: "pass", Type: "string"},
+		},
+	}
+
+	base := templateData{
+		StructTagCasing: "camel",
+		TagIgnore:       map[string]struct{}{"pass": {}},
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "disabled",
+			Template: name,
+			Table:    pilots,
+			NotWant:  []string{"MarshalJSON"},
+		},
+		{
+			Name:     "null columns rendered",
+			Template: name,
+			Table:    pilots,
+			Data: func(d *templateData) {
+				d.JSONMethods = true
+				d.JSONNullPolicy = "render"
+			},
+			// Fields are named like the struct tags
+			Want: []string{`{Name: "id", Value: o.ID},`, `{Name: "firstName", Value: o.FirstName},`, "}, false)"},
+			// Ignored columns aren't marshaled
+			NotWant: []string{"o.Pass"},
+		},
+		{
+			Name:     "null columns omitted",
+			Template: name,
+			Table:    pilots,
+			Data: func(d *templateData) {
+				d.JSONMethods = true
+				d.JSONNullPolicy = "omit"
+			},
+			Want: []string{"}, true)"},
+		},
+	})
+}
+
+func TestGenerateInterfaces(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/25_repository.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "name", Type: "string"},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+	view := pilots
+	view.IsView = true
+
+	base := templateData{GenerateInterfaces: true}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "table",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"type PilotRepository interface {",
+				"Find(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Pilot, error)",
+				"Insert(ctx context.Context, exec boil.ContextExecutor, o *Pilot, columns boil.Columns) error",
+				"Update(ctx context.Context, exec boil.ContextExecutor, o *Pilot, columns boil.Columns) (int64, error)",
+				"Delete(ctx context.Context, exec boil.ContextExecutor, o *Pilot) (int64, error)",
+				"return o.Delete(ctx, exec)",
+				"func NewPilotRepository() PilotRepository {",
+			},
+		},
+		{
+			Name:     "a view keeps its read methods only",
+			Template: name,
+			Table:    view,
+			Want:     []string{"Find(ctx context.Context", "All(ctx context.Context"},
+			NotWant:  []string{"Insert(", "Update(", "Delete("},
+		},
+	})
+}
+
+func TestGenerateDTOs(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/26_dto.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "name", Type: "string"},
+			{Name: "nickname", Type: "null.String", Nullable: true},
+			{Name: "retired_at", Type: "null.Time", Nullable: true},
+			{Name: "salary", Type: "types.NullDecimal", Nullable: true},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+
+	base := templateData{GenerateDTOs: true}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "pointer style",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"type PilotDTO struct {",
+				"Name string `json:\"name\"`",
+				"Nickname *string `json:\"nickname,omitempty\"`",
+				"RetiredAt *time.Time `json:\"retired_at,omitempty\"`",
+				// Types without a null package counterpart keep their own null
+				"Salary types.NullDecimal `json:\"salary,omitempty\"`",
+				// A null column is a nil pointer in the DTO and null again from it
+				"Nickname: o.Nickname.Ptr(),",
+				"o.Nickname = null.StringFromPtr(d.Nickname)",
+				"o.RetiredAt = null.TimeFromPtr(d.RetiredAt)",
+				"Name: o.Name,",
+				"o.Salary = d.Salary",
+			},
+		},
+		{
+			Name:     "null style",
+			Template: name,
+			Table:    pilots,
+			Data:     func(d *templateData) { d.DTONullStyle = "null" },
+			Want:     []string{"Nickname null.String", "Nickname: o.Nickname,", "o.Nickname = d.Nickname"},
+		},
+	})
+
+	if out := renderTemplate(t, name, pilots, &templateData{}); strings.TrimSpace(out) != "" {
+		t.Errorf("want no DTO unless enabled:\n%s", out)
+	}
+}
+
+func TestGenerateValidate(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/22_validate_lengths.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto", AutoIncrement: true},
+			{Name: "name", Type: "string", FullDBType: "nvarchar(50)"},
+			{Name: "code", Type: "string", FullDBType: "nchar(4)", Default: "'AAAA'"},
+			{Name: "hired_at", Type: "time.Time", FullDBType: "datetime"},
+			{Name: "rank", Type: "int", FullDBType: "int"},
+			{Name: "nickname", Type: "null.String", FullDBType: "nvarchar(20)", Nullable: true},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+
+	out := renderTemplate(t, name, pilots, &templateData{GenerateValidate: true})
+	validate := out[strings.Index(out, "func (o *Pilot) Validate() error {"):]
+	for _, want := range []string{
+		// A missing required field
+		"if len(o.Name) == 0 {\n\t\terrs = append(errs, errors.New(\"models: pilots.name is required\"))",
+		"if o.HiredAt.IsZero() {",
+		// Too long strings are listed along with them
+		"errs = append(errs, o.lengthErrors()...)",
+	} {
+		if !strings.Contains(validate, want) {
+			t.Errorf("want %q in:\n%s", want, validate)
+		}
+	}
+	for _, col := range []string{"Code", "Rank", "Nickname"} {
+		if strings.Contains(validate, "o."+col) {
+			t.Errorf("%s isn't required and shouldn't be checked:\n%s", col, validate)
+		}
+	}
+
+	testTemplates(t, templateData{}, []templateTest{
+		{
+			Name:     "lengths",
+			Template: name,
+			Table:    pilots,
+			Data:     func(d *templateData) { d.GenerateValidate = true },
+			Want: []string{
+				"if len([]rune(o.Name)) > 50 {\n\t\terrs = append(errs, errors.New(\"models: pilots.name is longer than 50 characters\"))",
+				"if o.Nickname.Valid && len([]rune(o.Nickname.String)) > 20 {",
+			},
+		},
+		{
+			Name:     "disabled",
+			Template: name,
+			Table:    pilots,
+			NotWant:  []string{"Validate()"},
+		},
+	})
+}
+
+func TestIdentitySeedStep(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/00_struct.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto", AutoIncrement: true, IdentitySeed: 1000, IdentityStep: 10},
+			{Name: "name", Type: "string"},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+	unreported := pilots
+	unreported.Columns = []drivers.Column{
+		{Name: "id", Type: "int", Default: "auto", AutoIncrement: true},
+		{Name: "name", Type: "string"},
+	}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '"', RQ: '"'},
+		LQ:      `\"`,
+		RQ:      `\"`,
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "identity constants",
+			Template: name,
+			Table:    pilots,
+			Want:     []string{"PilotIDIdentitySeed int64 = 1000", "PilotIDIdentityStep int64 = 10"},
+		},
+		{
+			Name:     "not reported by the database, nothing to generate",
+			Template: name,
+			Table:    unreported,
+			NotWant:  []string{"IdentitySeed"},
+		},
+	})
+}
+
+func TestGenerateChangesets(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/27_changeset.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto", AutoIncrement: true},
+			{Name: "name", Type: "string"},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+
+	base := templateData{
+		GenerateChangesets: true,
+		Dialect:            drivers.Dialect{UseAutoColumns: true},
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "changeset",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func (o *Pilot) UpdateWithChangeset(ctx context.Context, exec boil.ContextExecutor) (boil.Changeset, error) {",
+				// Read and update in one transaction
+				"err := boil.InTxContext(ctx, exec, func(exec boil.ContextExecutor) error {",
+				"current, err := FindPilot(ctx, exec, o.ID)",
+				"cols = strmangle.SetComplement(cols, pilotColumnsWithAuto)",
+				"changes = queries.Changes(current, o, cols)",
+				// Only the changed columns are updated
+				"_, err = o.UpdateColumns(ctx, exec, changes.Columns()...)",
+			},
+		},
+		{
+			Name:     "transaction without a context",
+			Template: name,
+			Table:    pilots,
+			Data:     func(d *templateData) { d.NoContext = true },
+			Want:     []string{"err := boil.InTx(exec, func(exec boil.Executor) error {"},
+		},
+		{
+			Name:     "disabled",
+			Template: name,
+			Table:    pilots,
+			Data:     func(d *templateData) { d.GenerateChangesets = false },
+			NotWant:  []string{"UpdateWithChangeset"},
+		},
+	})
+}
+
+func TestInsertIgnore(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/29_insert_ignore.go.tpl"
+	// The identity primary key never conflicts, only the unique name does
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto", AutoIncrement: true},
+			{Name: "name", Type: "string", Unique: true},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+	languages := drivers.Table{
+		Name:    "languages",
+		Columns: []drivers.Column{{Name: "code", Type: "string"}},
+		PKey:    &drivers.PrimaryKey{Columns: []string{"code"}},
+	}
+	noKey := drivers.Table{Name: "pilots", Columns: pilots.Columns[:1], PKey: pilots.PKey}
+
+	base := templateData{
+		Tables:               []drivers.Table{pilots, languages},
+		Schema:               "dbo",
+		Dialect:              drivers.Dialect{LQ: '[', RQ: ']', UseSchema: true, UseIndexPlaceholders: true, UseTableHints: true},
+		LQ:                   "[",
+		RQ:                   "]",
+		GenerateInsertIgnore: true,
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "by unique column",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func (o *Pilot) InsertIgnoreByName(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (bool, error) {",
+				`query := "select 1 from [dbo].[pilots] with (updlock, holdlock) where [name]=$1"`,
+				"return o.insertIgnore(ctx, exec, columns, query, []interface{}{o.Name})",
+				"err := boil.InTxContext(ctx, exec, func(exec boil.ContextExecutor) error {",
+				// A row found skips the insert, no row inserts
+				"if err == nil {\n\t\t\t// A row has the key already, nothing to insert\n\t\t\treturn nil\n\t\t}",
+				"if err = o.Insert(ctx, exec, columns); err != nil {",
+				"inserted = true",
+				// An insert losing the race to a concurrent one rolls back
+				// and reports nothing inserted
+				"conflict = boil.IsUniqueViolation(err)\n\t\t\treturn err",
+				"if conflict {\n\t\treturn false, nil\n\t}",
+			},
+			// Not on an identity primary key
+			NotWant: []string{"func (o *Pilot) InsertIgnore("},
+		},
+		{
+			Name:     "by primary key",
+			Template: name,
+			Table:    languages,
+			Data: func(d *templateData) {
+				d.Dialect = drivers.Dialect{LQ: '"', RQ: '"'}
+				d.LQ, d.RQ, d.Schema = `\"`, `\"`, ""
+			},
+			Want: []string{
+				"func (o *Language) InsertIgnore(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (bool, error) {",
+				`query := "select 1 from \"languages\" where \"code\"=?"`,
+			},
+		},
+		{
+			Name:     "nothing to conflict on, nothing generated",
+			Template: name,
+			Table:    noKey,
+			NotWant:  []string{"InsertIgnore"},
+		},
+		{
+			Name:     "disabled",
+			Template: name,
+			Table:    languages,
+			Data:     func(d *templateData) { d.GenerateInsertIgnore = false },
+			NotWant:  []string{"InsertIgnore"},
+		},
+	})
+}
+
+func TestFindOrCreate(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/32_find_or_create.go.tpl"
+	// The identity primary key is never looked up, only the unique name
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto", AutoIncrement: true},
+			{Name: "first_name", Type: "string"},
+			{Name: "last_name", Type: "string"},
+		},
+		PKey:    &drivers.PrimaryKey{Columns: []string{"id"}},
+		Indexes: []drivers.Index{{Name: "uq_pilots_name", Columns: []string{"first_name", "last_name"}, Unique: true}},
+	}
+	languages := drivers.Table{
+		Name:    "languages",
+		Columns: []drivers.Column{{Name: "code", Type: "string"}},
+		PKey:    &drivers.PrimaryKey{Columns: []string{"code"}},
+	}
+
+	base := templateData{Tables: []drivers.Table{pilots, languages}}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "by unique key",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func FindOrCreatePilotByFirstNameAndLastName(ctx context.Context, exec boil.ContextExecutor, o *Pilot, columns boil.Columns) (*Pilot, bool, error) {",
+				"created, err := boil.FindOrCreateContext(ctx, exec, func(exec boil.ContextExecutor) error {",
+				// The lookup reuses the typed finder of the unique key
+				"found, err = FindPilotByFirstNameAndLastName(ctx, exec, o.FirstName, o.LastName)",
+				"return o.Insert(ctx, exec, columns)",
+				"if created {\n\t\treturn o, true, nil\n\t}\n\n\treturn found, false, nil",
+			},
+			// Not on an identity primary key
+			NotWant: []string{"func FindOrCreatePilot("},
+		},
+		{
+			Name:     "by primary key without a context",
+			Template: name,
+			Table:    languages,
+			Data:     func(d *templateData) { d.NoContext = true },
+			Want: []string{
+				"func FindOrCreateLanguage(exec boil.Executor, o *Language, columns boil.Columns) (*Language, bool, error) {",
+				"created, err := boil.FindOrCreate(exec, func(exec boil.Executor) error {",
+				"found, err = FindLanguage(exec, o.Code)",
+				"return o.Insert(exec, columns)",
+			},
+		},
+	})
+}
+
+func TestToColumnMap(t *testing.T) {
+	t.Parallel()
+
+	jets := drivers.Table{
+		Name: "jets",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto", AutoIncrement: true},
+			{Name: "pilot_name", Type: "string"},
+			{Name: "color", Type: "null.String", Nullable: true},
+			{Name: "manual", Type: "null.Bytes", Nullable: true},
+			{Name: "photo", Type: "[]byte"},
+			{Name: "nick", Type: "*string", Nullable: true},
+		},
+		PKey: &drivers.PrimaryKey{Columns: []string{"id"}},
+	}
+
+	testTemplates(t, templateData{}, []templateTest{
+		{
+			Name:     "column map",
+			Template: "templates/30_column_map.go.tpl",
+			Table:    jets,
+			Want: []string{
+				"func (o *Jet) ToColumnMap(excludeAuto bool) M {",
+				// Keyed by the column names of the database
+				"if !excludeAuto {\n\tm[\"id\"] = o.ID\n\t}",
+				`m["pilot_name"] = o.PilotName`,
+				`m["photo"] = o.Photo`,
+				// Null values unwrapped
+				"if o.Color.Valid {\n\t\tm[\"color\"] = o.Color.String\n\t} else {\n\t\tm[\"color\"] = nil\n\t}",
+				`m["manual"] = o.Manual.Bytes`,
+				"if o.Nick != nil {\n\t\tm[\"nick\"] = *o.Nick\n\t} else {\n\t\tm[\"nick\"] = nil\n\t}",
+			},
+		},
+	})
+}
+
+func TestInsertAutoIncrement(t *testing.T) {
+	t.Parallel()
+
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", Default: "auto", AutoIncrement: true},
+			{Name: "rank", Type: "int", Default: "0"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	noIdentity := pilots
+	noIdentity.Columns = []drivers.Column{
+		{Name: "id", Type: "int", Default: "auto"},
+		{Name: "rank", Type: "int", Default: "0"},
+	}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "only the identity column is auto increment",
+			Template: "templates/01_types.go.tpl",
+			Table:    pilots,
+			Want: []string{
+				`pilotColumnsWithAutoIncrement = []string{"id"}`,
+				// The defaulted columns are unchanged
+				`pilotColumnsWithDefault    = []string{"id","rank"}`,
+			},
+		},
+		{
+			Name:     "identity columns left out of the insert",
+			Template: "templates/15_insert.go.tpl",
+			Table:    pilots,
+			Want:     []string{"nzDefaults = strmangle.SetComplement(nzDefaults, pilotColumnsWithAutoIncrement)"},
+		},
+		{
+			Name:     "tables without identity columns insert set defaults",
+			Template: "templates/15_insert.go.tpl",
+			Table:    noIdentity,
+			NotWant:  []string{"AutoIncrement"},
+		},
+	})
+}
+
+func TestDeleteAllByPK(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/18_delete.go.tpl"
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}, {Name: "name", Type: "string"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	orders := drivers.Table{
+		Name:    "orders",
+		Columns: []drivers.Column{{Name: "region", Type: "string"}, {Name: "number", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_orders", Columns: []string{"region", "number"}},
+	}
+	softPilots := pilots
+	softPilots.Columns = []drivers.Column{{Name: "id", Type: "int"}, {Name: "deleted_at", Type: "null.Time", Nullable: true}}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, MaxParams: 2100},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "single column key",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func PilotDeleteAllByPK(ctx context.Context, exec boil.ContextExecutor, pks ...int) (int64, error)",
+				// A key per parameter, less the margin
+				"chunkSize := queries.KeyChunkSize(dialect.KeyParams(), 0, 1)\n",
+				`mod := qm.WhereIn("[pilots].[id] in ?", args...)`,
+			},
+		},
+		{
+			Name:     "composite key",
+			Template: name,
+			Table:    orders,
+			Want: []string{
+				"func OrderDeleteAllByPK(ctx context.Context, exec boil.ContextExecutor, pks ...[]interface{}) (int64, error)",
+				// Chunks sized by the key's columns
+				"chunkSize := queries.KeyChunkSize(dialect.KeyParams(), 0, len(orderPrimaryKeyColumns))",
+				// Or'd equality groups on the key
+				`mod := qmhelper.WhereInTuples([]string{"[orders].[region]", "[orders].[number]"}, pks[start:end])`,
+			},
+		},
+		{
+			Name:     "soft delete",
+			Template: name,
+			Table:    softPilots,
+			Data:     func(d *templateData) { d.AddSoftDeletes = true },
+			Want: []string{
+				"func PilotDeleteAllByPK(ctx context.Context, exec boil.ContextExecutor, hardDelete bool, pks ...int) (int64, error)",
+				// The deletion time takes a parameter of its own
+				"chunkSize := queries.KeyChunkSize(dialect.KeyParams(), 1, 1)\n",
+			},
+		},
+	})
+}
+
+func TestCompositePrimaryKey(t *testing.T) {
+	t.Parallel()
+
+	orders := drivers.Table{
+		Name:    "orders",
+		Columns: []drivers.Column{{Name: "region", Type: "string"}, {Name: "number", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_orders", Columns: []string{"region", "number"}},
+	}
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+
+	base := templateData{
+		Tables:  []drivers.Table{orders, pilots},
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "find by key",
+			Template: "templates/14_find.go.tpl",
+			Table:    orders,
+			Want: []string{
+				"type OrderPrimaryKey struct {\n\tRegion string\n\tNumber int\n",
+				`return fmt.Sprintf("region=%v, number=%v", pk.Region, pk.Number)`,
+				"func FindOrderByPK(ctx context.Context, exec boil.ContextExecutor, pk OrderPrimaryKey, selectCols ...string) (*Order, error)",
+				"return FindOrder(ctx, exec, pk.Region, pk.Number, selectCols...)",
+			},
+		},
+		{
+			Name:     "exists by key",
+			Template: "templates/20_exists.go.tpl",
+			Table:    orders,
+			Want:     []string{"return OrderExists(ctx, exec, pk.Region, pk.Number)"},
+		},
+		{
+			Name:     "delete by key",
+			Template: "templates/18_delete.go.tpl",
+			Table:    orders,
+			Want:     []string{"return OrderDeleteAllByPK(ctx, exec, []interface{}{pk.Region, pk.Number})"},
+		},
+		{
+			Name:     "single column keys keep the scalar signature",
+			Template: "templates/14_find.go.tpl",
+			Table:    pilots,
+			NotWant:  []string{"PilotPrimaryKey"},
+		},
+	})
+}
+
+func TestCompositeForeignKeySetops(t *testing.T) {
+	t.Parallel()
+
+	orders := drivers.Table{
+		Name:    "orders",
+		Columns: []drivers.Column{{Name: "region", Type: "string"}, {Name: "number", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_orders", Columns: []string{"region", "number"}},
+	}
+	lines := drivers.Table{
+		Name: "order_lines",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "order_region", Type: "null.String", Nullable: true},
+			{Name: "order_number", Type: "null.Int", Nullable: true},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_order_lines", Columns: []string{"id"}},
+		FKeys: []drivers.ForeignKey{{
+			Table:          "order_lines",
+			Name:           "fk_order_lines_orders",
+			Column:         "order_region",
+			Nullable:       true,
+			ForeignTable:   "orders",
+			ForeignColumn:  "region",
+			Columns:        []string{"order_region", "order_number"},
+			ForeignColumns: []string{"region", "number"},
+		}},
+	}
+	tables := []drivers.Table{orders, lines}
+	tables[0].ToManyRelationships = drivers.ToManyRelationships("orders", tables)
+
+	base := templateData{
+		Tables:  tables,
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "to many",
+			Template: "templates/12_relationship_to_many_setops.go.tpl",
+			Table:    tables[0],
+			Want: []string{
+				"if queries.HasNil(o.Region, o.Number) {",
+				"return boil.InTxContext(ctx, exec, func(exec boil.ContextExecutor) error {\n\t\treturn o.addOrderLines(ctx, exec, insert, related...)",
+				"queries.Assign(&rel.OrderRegion, o.Region)\n\t\t\tqueries.Assign(&rel.OrderNumber, o.Number)",
+				`cols := []string{"order_region", "order_number"}`,
+				"strmangle.WhereClause(\"[\", \"]\", len(cols)+1, orderLinePrimaryKeyColumns)",
+				"values := []interface{}{o.Region, o.Number, rel.ID}",
+				"update [order_lines] set [order_region] = null, [order_number] = null where %s",
+				"return o.removeOrderLines(ctx, exec, related...)",
+				"queries.SetScanner(&rel.OrderRegion, nil)\n\t\tqueries.SetScanner(&rel.OrderNumber, nil)",
+				`rel.Update(ctx, exec, boil.Whitelist("order_region", "order_number"))`,
+			},
+		},
+		{
+			Name:     "to one",
+			Template: "templates/10_relationship_to_one_setops.go.tpl",
+			Table:    tables[1],
+			Want: []string{
+				"return o.setOrder(ctx, exec, insert, related)",
+				"if queries.HasNil(related.Region, related.Number) {",
+				"values := []interface{}{related.Region, related.Number, o.ID}",
+				"queries.SetScanner(&o.OrderRegion, nil)\n\tqueries.SetScanner(&o.OrderNumber, nil)",
+				`o.Update(ctx, exec, boil.Whitelist("order_region", "order_number"))`,
+			},
+		},
+	})
+}
+
+func TestCountRelationship(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/06_relationship_to_many.go.tpl"
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	jets := drivers.Table{
+		Name: "jets",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "pilot_id", Type: "int"},
+			{Name: "removed_at", Type: "null.Time", Nullable: true},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_jets", Columns: []string{"id"}},
+		FKeys: []drivers.ForeignKey{{
+			Table:          "jets",
+			Name:           "fk_jets_pilots",
+			Column:         "pilot_id",
+			ForeignTable:   "pilots",
+			ForeignColumn:  "id",
+			Columns:        []string{"pilot_id"},
+			ForeignColumns: []string{"id"},
+		}},
+		SoftDeleteColumn: "removed_at",
+	}
+	tables := []drivers.Table{pilots, jets}
+	tables[0].ToManyRelationships = drivers.ToManyRelationships("pilots", tables)
+
+	base := templateData{
+		Tables:         tables,
+		Dialect:        drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:             "[",
+		RQ:             "]",
+		AddSoftDeletes: true,
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "soft deletes",
+			Template: name,
+			Table:    tables[0],
+			Want: []string{
+				"func (o *Pilot) CountJets(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) (int64, error) {\n\treturn o.Jets(mods...).Count(ctx, exec)",
+				`qm.Where("[jets].[pilot_id]=?", o.ID),`,
+				`qmhelper.WhereIsNull("[jets].[removed_at]"),`,
+			},
+		},
+		{
+			Name:     "without soft deletes every row is counted",
+			Template: name,
+			Table:    tables[0],
+			Data: func(d *templateData) {
+				d.AddSoftDeletes = false
+				d.NoContext = true
+			},
+			Want:    []string{"func (o *Pilot) CountJets(exec boil.Executor, mods ...qm.QueryMod) (int64, error) {\n\treturn o.Jets(mods...).Count(exec)"},
+			NotWant: []string{"WhereIsNull"},
+		},
+	})
+
+	// A composite key filters on each of its columns
+	orders := drivers.Table{
+		Name:    "orders",
+		Columns: []drivers.Column{{Name: "region", Type: "string"}, {Name: "number", Type: "int"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_orders", Columns: []string{"region", "number"}},
+	}
+	lines := drivers.Table{
+		Name: "order_lines",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "order_region", Type: "string"},
+			{Name: "order_number", Type: "int"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_order_lines", Columns: []string{"id"}},
+		FKeys: []drivers.ForeignKey{{
+			Table:          "order_lines",
+			Name:           "fk_order_lines_orders",
+			Column:         "order_region",
+			ForeignTable:   "orders",
+			ForeignColumn:  "region",
+			Columns:        []string{"order_region", "order_number"},
+			ForeignColumns: []string{"region", "number"},
+		}},
+	}
+	tables = []drivers.Table{orders, lines}
+	tables[0].ToManyRelationships = drivers.ToManyRelationships("orders", tables)
+
+	base.Tables, base.NoContext = tables, true
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "composite key",
+			Template: name,
+			Table:    tables[0],
+			Want: []string{
+				"func (o *Order) CountOrderLines(exec boil.Executor, mods ...qm.QueryMod) (int64, error) {",
+				`qm.Where("[order_lines].[order_region]=?", o.Region),`,
+				`qm.Where("[order_lines].[order_number]=?", o.Number),`,
+			},
+		},
+	})
+}
+
+func TestPage(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/13_all.go.tpl"
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int"}, {Name: "name", Type: "string"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "offset fetch",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func PilotPage(ctx context.Context, exec boil.ContextExecutor, pageNum, pageSize int, order []string, mods ...qm.QueryMod) (PilotSlice, int64, error)",
+				"// At least one order clause is required.",
+				"queries.SetPage(q.Query, pageNum, pageSize, order)",
+				"total, err := Pilots(mods...).Count(ctx, exec)",
+			},
+		},
+		{
+			Name:     "limit offset without a context",
+			Template: name,
+			Table:    pilots,
+			Data: func(d *templateData) {
+				d.Dialect.UseTopClause = false
+				d.NoContext = true
+			},
+			Want: []string{"func PilotPage(exec boil.Executor, pageNum, pageSize int, order []string, mods ...qm.QueryMod) (PilotSlice, int64, error)"},
+			// No order requirement without OFFSET ... FETCH
+			NotWant: []string{"order clause is required"},
+		},
+	})
+}
+
+func TestMSSQLUpsertWithResult(t *testing.T) {
+	t.Parallel()
+
+	const name = "mssql:templates/17_upsert.go.tpl"
+	pilots := drivers.Table{
+		Name:    "pilots",
+		Columns: []drivers.Column{{Name: "id", Type: "int", DBType: "int", FullDBType: "int", AutoIncrement: true}, {Name: "name", Type: "string"}},
+		PKey:    &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	triggered := pilots
+	triggered.Triggers = []string{"tr_pilots"}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseOutputClause: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "output clause",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func (o *Pilot) UpsertWithResult(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (inserted bool, err error)",
+				"_, err := o.UpsertWithResult(ctx, exec, updateColumns, insertColumns)",
+				`buildUpsertQueryMSSQL(dialect, "[pilots]", pilotPrimaryKeyColumns, update, insert, ret, "")`,
+				"returns := []interface{}{&action}",
+				`inserted = action.String == "INSERT"`,
+			},
+		},
+		{
+			// OUTPUT needs INTO on tables with triggers, the action is read back
+			// from the table variable
+			Name:     "triggers",
+			Template: name,
+			Table:    triggered,
+			Want: []string{
+				`"DECLARE @upsert_action TABLE ([action] nvarchar(10), [id] int);\n"`,
+				`buildUpsertQueryMSSQL(dialect, "[pilots]", pilotPrimaryKeyColumns, update, insert, pilotPrimaryKeyColumns, "@upsert_action")`,
+				`FROM @upsert_action [u] INNER JOIN [pilots] [t] ON [t].[id] = [u].[id];", selectCols)`,
+				"if err == sql.ErrNoRows && updateColumns.IsNone() {",
+			},
+		},
+	})
+}
+
+func TestDecimalPrecision(t *testing.T) {
+	t.Parallel()
+
+	invoices := drivers.Table{
+		Name: "invoices",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int", DBType: "int"},
+			{Name: "total", Type: "types.Decimal", DBType: "decimal", Precision: 10, Scale: 2},
+			{Name: "discount", Type: "types.NullDecimal", DBType: "numeric", Precision: 5, Scale: 4, Nullable: true},
+			{Name: "rate", Type: "types.NullDecimal", DBType: "numeric", Nullable: true},
+			{Name: "issued_at", Type: "time.Time", DBType: "datetime2", Precision: 3},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_invoices", Columns: []string{"id"}},
+	}
+
+	base := templateData{
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "validate",
+			Template: "templates/22_validate_lengths.go.tpl",
+			Table:    invoices,
+			Want: []string{
+				"if !o.Total.Fits(10, 2) {\n\t\terrs = append(errs, errors.New(\"models: invoices.total does not fit in decimal(10,2)\"))",
+				"if !o.Discount.Fits(5, 4) {",
+			},
+			// Decimals without a precision aren't checked
+			NotWant: []string{"o.Rate.Fits"},
+		},
+		{
+			Name:     "struct",
+			Template: "templates/00_struct.go.tpl",
+			Table:    invoices,
+			Want: []string{
+				"// Stored as decimal(10,2), see ValidateLengths.\n\tTotal types.Decimal",
+				"// Stored with 3 fractional second digits",
+			},
+			// Decimals without a precision aren't commented
+			NotWant: []string{"decimal(0,0)"},
+		},
+	})
+}
+
+func TestReservedWordColumns(t *testing.T) {
+	t.Parallel()
+
+	settings := drivers.Table{
+		Name: "settings",
+		Columns: []drivers.Column{
+			{Name: "key", Type: "string"},
+			{Name: "order", Type: "int"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_settings", Columns: []string{"key"}},
+	}
+	overrides := drivers.Table{
+		Name: "overrides",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "key", Type: "string"},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_overrides", Columns: []string{"id"}},
+		FKeys: []drivers.ForeignKey{{
+			Name: "fk_overrides_settings", Table: "overrides", Column: "key",
+			ForeignTable: "settings", ForeignColumn: "key",
+		}},
+	}
+
+	base := templateData{
+		Tables:  []drivers.Table{settings, overrides},
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "find",
+			Template: "templates/14_find.go.tpl",
+			Table:    settings,
+			Want:     []string{`"select %s from [settings] where [key]=$1"`},
+		},
+		{
+			Name:     "insert",
+			Template: "templates/15_insert.go.tpl",
+			Table:    settings,
+			Want:     []string{`"INSERT INTO [settings] ([%s]) %%sVALUES (%s)%%s", strings.Join(wl, "],[")`},
+		},
+		{
+			Name:     "types",
+			Template: "templates/01_types.go.tpl",
+			Table:    settings,
+			Want:     []string{`settingAllColumns               = []string{"key", "order"}`},
+		},
+		{
+			Name:     "eager load",
+			Template: "templates/07_relationship_to_one_eager.go.tpl",
+			Table:    overrides,
+			Want: []string{
+				`qm.From("[settings]")`,
+				`qm.WhereIn("[settings].[key] in ?", args...)`,
+			},
+		},
+	})
+}
+
+func TestLoadByKeys(t *testing.T) {
+	t.Parallel()
+
+	const name = "templates/33_load_by_keys.go.tpl"
+	pilots := drivers.Table{
+		Name: "pilots",
+		Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "email", Type: "string", Unique: true},
+			{Name: "badge", Type: "null.String", Nullable: true, Unique: true},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_pilots", Columns: []string{"id"}},
+	}
+	orders := drivers.Table{
+		Name: "orders",
+		Columns: []drivers.Column{
+			{Name: "region", Type: "string"},
+			{Name: "number", Type: "int"},
+			{Name: "code", Type: "string", Unique: true},
+		},
+		PKey: &drivers.PrimaryKey{Name: "pk_orders", Columns: []string{"region", "number"}},
+	}
+
+	base := templateData{
+		Tables:  []drivers.Table{pilots, orders},
+		Dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, MaxParams: 2100},
+		LQ:      "[",
+		RQ:      "]",
+	}
+	testTemplates(t, base, []templateTest{
+		{
+			Name:     "single column keys",
+			Template: name,
+			Table:    pilots,
+			Want: []string{
+				"func PilotLoadByKeys(ctx context.Context, exec boil.ContextExecutor, keys []int) (map[int]*Pilot, error)",
+				"func PilotLoadByEmailKeys(ctx context.Context, exec boil.ContextExecutor, keys []string) (map[string]*Pilot, error)",
+				// Chunks of LoadChunkSize keys, an IN on each of them
+				"chunkSize := LoadChunkSize\n",
+				`Pilots(qm.WhereIn("[pilots].[email] in ?", args[start:end]...)).All(ctx, exec)`,
+				// The rows are mapped by their key
+				"found[o.Email] = o",
+			},
+			// A nullable key can't be compared in a map
+			NotWant: []string{"LoadByBadgeKeys"},
+		},
+		{
+			Name:     "composite primary key",
+			Template: name,
+			Table:    orders,
+			Want:     []string{"func OrderLoadByCodeKeys("},
+			NotWant:  []string{"func OrderLoadByKeys("},
+		},
+	})
+}
+
+// TestTemplatesConcatenated renders the per table templates together as the
+// generator does, each has to end on a new line for the next one to start
+// on its own.
+func TestTemplatesConcatenated(t *testing.T) {
+	t.Parallel()
+
+	files := generateMock(t, func(c *Config) {
+		c.AddGlobal = true
+		c.AddPanic = true
+		c.AddSoftDeletes = true
+		c.EmitNameConstants = true
+		c.GenerateIndexMetadata = true
+		c.GenerateInterfaces = true
+		c.GenerateDTOs = true
+		c.GenerateValidate = true
+		c.GenerateConstructors = true
+		c.GenerateChangesets = true
+		c.GenerateDeleteCascade = true
+		c.GenerateInsertIgnore = true
+		c.GenerateSchemaVersion = true
+		c.JSONMethods = true
+	})
+
+	for name, b := range files {
+		for i, line := range strings.Split(string(b), "\n") {
+			if strings.HasPrefix(line, "}") && strings.Contains(line, "//") {
+				t.Errorf("%s:%d: the next template starts on a closing brace: %s", name, i+1, line)
+			}
+		}
+	}
+}
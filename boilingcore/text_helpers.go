@@ -1,6 +1,7 @@
 package boilingcore
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/volatiletech/sqlboiler/v4/drivers"
@@ -37,31 +38,36 @@ import (
 //
 // fk == table = industry.Industry | industry.Industry
 // fk != table = industry.ParentIndustry | industry.Industry
-func txtNameToOne(fk drivers.ForeignKey) (localFn, foreignFn string) {
-	fkColumnTrimmedSuffixes := strmangle.Singular(trimSuffixes(fk.Column))
-	fkNotTableName := fkColumnTrimmedSuffixes != strmangle.Singular(fk.ForeignTable)
-	singularForeignTable := strmangle.Singular(fk.ForeignTable)
+//
+// Composite keys are named by their columns' common prefix:
+// orders - order_lines : order_region, order_number
+//
+// order.OrderLines | orderLine.Order
+func txtNameToOne(fk drivers.ForeignKey, inflections Inflections, initialisms Initialisms) (localFn, foreignFn string) {
+	fkColumnTrimmedSuffixes := inflections.Singular(trimSuffixes(keyName(fk)))
+	fkNotTableName := fkColumnTrimmedSuffixes != inflections.Singular(fk.ForeignTable)
+	singularForeignTable := inflections.Singular(fk.ForeignTable)
 
 	if fkColumnTrimmedSuffixes == singularForeignTable {
-		foreignFn = strmangle.TitleCase(strmangle.Singular(fk.Table) + "_" + fkColumnTrimmedSuffixes)
+		foreignFn = initialisms.TitleCase(inflections.Singular(fk.Table) + "_" + fkColumnTrimmedSuffixes)
 		if fk.Column != singularForeignTable {
-			foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+			foreignFn = initialisms.TitleCase(fkColumnTrimmedSuffixes)
 		}
 	} else if fkColumnTrimmedSuffixes == fk.Column {
-		foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes + "_" + strmangle.Singular(fk.ForeignTable))
+		foreignFn = initialisms.TitleCase(fkColumnTrimmedSuffixes + "_" + inflections.Singular(fk.ForeignTable))
 	} else {
-		foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+		foreignFn = initialisms.TitleCase(fkColumnTrimmedSuffixes)
 	}
 
 	if fkNotTableName {
-		localFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+		localFn = initialisms.TitleCase(fkColumnTrimmedSuffixes)
 	}
 
-	plurality := strmangle.Plural
+	plurality := inflections.Plural
 	if fk.Unique {
-		plurality = strmangle.Singular
+		plurality = inflections.Singular
 	}
-	localFn += strmangle.TitleCase(plurality(fk.Table))
+	localFn += initialisms.TitleCase(plurality(fk.Table))
 
 	return localFn, foreignFn
 }
@@ -91,19 +97,19 @@ func txtNameToOne(fk drivers.ForeignKey) (localFn, foreignFn string) {
 // industry_id  mapped_industry_id
 // fk == table = industry.Industries
 // fk != table = industry.MappedIndustryIndustry
-func txtNameToMany(lhs, rhs drivers.ForeignKey) (lhsFn, rhsFn string) {
-	lhsKey := strmangle.Singular(trimSuffixes(lhs.Column))
-	rhsKey := strmangle.Singular(trimSuffixes(rhs.Column))
+func txtNameToMany(lhs, rhs drivers.ForeignKey, inflections Inflections, initialisms Initialisms) (lhsFn, rhsFn string) {
+	lhsKey := inflections.Singular(trimSuffixes(lhs.Column))
+	rhsKey := inflections.Singular(trimSuffixes(rhs.Column))
 
-	if lhsKey != strmangle.Singular(lhs.ForeignTable) {
-		lhsFn = strmangle.TitleCase(lhsKey)
+	if lhsKey != inflections.Singular(lhs.ForeignTable) {
+		lhsFn = initialisms.TitleCase(lhsKey)
 	}
-	lhsFn += strmangle.TitleCase(strmangle.Plural(lhs.ForeignTable))
+	lhsFn += initialisms.TitleCase(inflections.Plural(lhs.ForeignTable))
 
-	if rhsKey != strmangle.Singular(rhs.ForeignTable) {
-		rhsFn = strmangle.TitleCase(rhsKey)
+	if rhsKey != inflections.Singular(rhs.ForeignTable) {
+		rhsFn = initialisms.TitleCase(rhsKey)
 	}
-	rhsFn += strmangle.TitleCase(strmangle.Plural(rhs.ForeignTable))
+	rhsFn += initialisms.TitleCase(inflections.Plural(rhs.ForeignTable))
 
 	return lhsFn, rhsFn
 }
@@ -124,6 +130,28 @@ func usesPrimitives(tables []drivers.Table, table, column, foreignTable, foreign
 var identifierSuffixes = []string{"_id", "_uuid", "_guid", "_oid"}
 
 // trimSuffixes from the identifier
+// keyName is the column naming a foreign key, for composite keys the prefix
+// their columns share, ex: order for order_region and order_number.
+// It falls back to the first column when they don't share one.
+func keyName(fk drivers.ForeignKey) string {
+	if !fk.IsComposite() {
+		return fk.Column
+	}
+
+	prefix := fk.Columns[0]
+	for _, c := range fk.Columns[1:] {
+		for !strings.HasPrefix(c, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	if idx := strings.LastIndexByte(prefix, '_'); idx > 0 {
+		return prefix[:idx]
+	}
+
+	return fk.Column
+}
+
 func trimSuffixes(str string) string {
 	ln := len(str)
 	for _, s := range identifierSuffixes {
@@ -151,3 +179,109 @@ func isPrimitive(typ string) bool {
 
 	return false
 }
+
+// loadKeyColumns returns the columns the LoadByKeys functions are generated
+// for, the primary key first: the single column keys, primary or unique, of a
+// primitive type so the keys compare in a map as they do in the database.
+func loadKeyColumns(table drivers.Table) []string {
+	var keys [][]string
+	if table.PKey != nil {
+		keys = append(keys, table.PKey.Columns)
+	}
+	keys = append(keys, table.UniqueKeys()...)
+
+	var cols []string
+	for _, key := range keys {
+		if len(key) != 1 || strmangle.SetInclude(key[0], cols) {
+			continue
+		}
+		if isPrimitive(table.GetColumn(key[0]).Type) {
+			cols = append(cols, key[0])
+		}
+	}
+
+	return cols
+}
+
+// loadByPrimaryKey reports whether the primary key of the table gets the
+// <Model>LoadByKeys function, the others are named after their column.
+func loadByPrimaryKey(table drivers.Table) bool {
+	cols := loadKeyColumns(table)
+	return table.PKey != nil && len(table.PKey.Columns) == 1 && len(cols) != 0 && cols[0] == table.PKey.Columns[0]
+}
+
+// nullPointerTypes maps the null package's types to the pointer types their
+// Ptr methods return
+var nullPointerTypes = map[string]string{
+	"null.Bool":    "*bool",
+	"null.Byte":    "*byte",
+	"null.Bytes":   "*[]byte",
+	"null.Float32": "*float32",
+	"null.Float64": "*float64",
+	"null.Int":     "*int",
+	"null.Int8":    "*int8",
+	"null.Int16":   "*int16",
+	"null.Int32":   "*int32",
+	"null.Int64":   "*int64",
+	"null.JSON":    "*[]byte",
+	"null.String":  "*string",
+	"null.Time":    "*time.Time",
+	"null.Uint":    "*uint",
+	"null.Uint8":   "*uint8",
+	"null.Uint16":  "*uint16",
+	"null.Uint32":  "*uint32",
+	"null.Uint64":  "*uint64",
+}
+
+// nullPointerType returns the pointer type standing in for a null package
+// type, ex: *string for null.String. It returns "" for other types.
+func nullPointerType(typ string) string {
+	return nullPointerTypes[typ]
+}
+
+// deprecatedMarker in a column's comment marks its field as deprecated, the
+// rest of the line tells what to use instead
+const deprecatedMarker = "@deprecated"
+
+// columnComment returns the lines of the comment of a column's field. A line
+// with the @deprecated marker becomes a "Deprecated:" paragraph at the end,
+// the convention go vet and editors use to flag the uses of the field.
+func columnComment(comment string) []string {
+	if len(comment) == 0 {
+		return nil
+	}
+
+	var lines []string
+	deprecated, reason := false, ""
+	for _, line := range strings.Split(comment, "\n") {
+		i := strings.Index(strings.ToLower(line), deprecatedMarker)
+		if i < 0 || deprecated {
+			lines = append(lines, line)
+			continue
+		}
+
+		deprecated = true
+		reason = strings.TrimSpace(line[i+len(deprecatedMarker):])
+		if before := strings.TrimSpace(line[:i]); len(before) != 0 {
+			lines = append(lines, before)
+		}
+	}
+
+	if !deprecated {
+		return lines
+	}
+	if len(reason) == 0 {
+		reason = "this column is no longer used."
+	}
+	if len(lines) != 0 {
+		lines = append(lines, "")
+	}
+	return append(lines, "Deprecated: "+reason)
+}
+
+// escapeString escapes a name to go between the double quotes of a Go string
+// or a struct tag value, ex: a column named nick"name
+func escapeString(s string) string {
+	quoted := strconv.Quote(s)
+	return quoted[1 : len(quoted)-1]
+}
@@ -1,6 +1,7 @@
 package boilingcore
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/volatiletech/sqlboiler/v4/drivers"
@@ -60,7 +61,39 @@ func TestTxtNameToOne(t *testing.T) {
 			ForeignTable: test.ForeignTable, ForeignColumn: test.ForeignColumn, ForeignColumnUnique: test.ForeignColumnUnique,
 		}
 
-		local, foreign := txtNameToOne(fk)
+		local, foreign := txtNameToOne(fk, nil, Initialisms{})
+		if local != test.LocalFn {
+			t.Error(i, "local wrong:", local, "want:", test.LocalFn)
+		}
+		if foreign != test.ForeignFn {
+			t.Error(i, "foreign wrong:", foreign, "want:", test.ForeignFn)
+		}
+	}
+}
+
+func TestTxtNameToOneComposite(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Columns   []string
+		Unique    bool
+		LocalFn   string
+		ForeignFn string
+	}{
+		{[]string{"order_region", "order_number"}, false, "OrderLines", "Order"},
+		{[]string{"order_region", "order_number"}, true, "OrderLine", "Order"},
+		{[]string{"parent_order_region", "parent_order_number"}, false, "ParentOrderOrderLines", "ParentOrder"},
+		{[]string{"region", "number"}, false, "RegionOrderLines", "RegionOrder"},
+	}
+
+	for i, test := range tests {
+		fk := drivers.ForeignKey{
+			Table: "order_lines", Column: test.Columns[0], Unique: test.Unique,
+			ForeignTable: "orders", ForeignColumn: "region", ForeignColumnUnique: true,
+			Columns: test.Columns, ForeignColumns: []string{"region", "number"},
+		}
+
+		local, foreign := txtNameToOne(fk, nil, Initialisms{})
 		if local != test.LocalFn {
 			t.Error(i, "local wrong:", local, "want:", test.LocalFn)
 		}
@@ -104,7 +137,7 @@ func TestTxtNameToMany(t *testing.T) {
 			Column:       test.RHSColumn,
 		}
 
-		lhs, rhs := txtNameToMany(lhsFk, rhsFk)
+		lhs, rhs := txtNameToMany(lhsFk, rhsFk, nil, Initialisms{})
 		if lhs != test.LHSFn {
 			t.Error(i, "local wrong:", lhs, "want:", test.LHSFn)
 		}
@@ -125,3 +158,24 @@ func TestTrimSuffixes(t *testing.T) {
 		}
 	}
 }
+
+func TestColumnComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Comment string
+		Want    []string
+	}{
+		{"", nil},
+		{"The price\nin dollars", []string{"The price", "in dollars"}},
+		{"@deprecated", []string{"Deprecated: this column is no longer used."}},
+		{"The price\n@Deprecated use price_cents", []string{"The price", "", "Deprecated: use price_cents"}},
+		{"The price @deprecated use price_cents\nin dollars", []string{"The price", "in dollars", "", "Deprecated: use price_cents"}},
+	}
+
+	for i, test := range tests {
+		if got := columnComment(test.Comment); !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("%d) want: %q, got: %q", i, test.Want, got)
+		}
+	}
+}
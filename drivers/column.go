@@ -1,7 +1,11 @@
 package drivers
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/volatiletech/strmangle"
 )
@@ -17,6 +21,8 @@ type Column struct {
 	Nullable  bool   `json:"nullable" toml:"nullable"`
 	Unique    bool   `json:"unique" toml:"unique"`
 	Validated bool   `json:"validated" toml:"validated"`
+	// Unsigned integer columns are translated to uint types, see UnsignedType.
+	Unsigned bool `json:"unsigned" toml:"unsigned"`
 
 	// Postgres only extension bits
 	// ArrType is the underlying data type of the Postgres
@@ -38,6 +44,237 @@ type Column struct {
 	// Used to indicate that the value
 	// for this column is auto generated by database on insert (i.e. - timestamp (old) or rowversion (new))
 	AutoGenerated bool `json:"auto_generated" toml:"auto_generated"`
+	// AutoIncrement is true for identity columns, Insert leaves them to
+	// the database instead of guessing from the value whether to send it.
+	AutoIncrement bool `json:"auto_increment" toml:"auto_increment"`
+	// IdentitySeed and IdentityStep are the first value and the increment
+	// of an identity column, ex: 1000 and 10 for identity(1000,10). Both are
+	// 0 when the database doesn't report them.
+	IdentitySeed int64 `json:"identity_seed" toml:"identity_seed"`
+	IdentityStep int64 `json:"identity_step" toml:"identity_step"`
+	// Precision is the number of fractional second digits a time column
+	// keeps, ex: 3 for datetime2(3), or the number of digits a decimal
+	// column keeps, ex: 10 for decimal(10,2). 0 when the database reports none.
+	Precision int `json:"precision" toml:"precision"`
+	// Scale is the number of fractional digits of a decimal column,
+	// ex: 2 for decimal(10,2). Only meaningful when Precision is set.
+	Scale int `json:"scale" toml:"scale"`
+
+	// GoDefault is the default as a Go expression, see GoInitializer.
+	// Only set when ConfigGoDefaults is enabled.
+	GoDefault string `json:"go_default" toml:"go_default"`
+
+	// IndexHints lists the indexes the column is part of.
+	// Only set when ConfigIndexHints is enabled.
+	IndexHints []IndexHint `json:"index_hints" toml:"index_hints"`
+}
+
+// IsDecimal is true for fixed point columns, ex: decimal(10,2), whose
+// Precision and Scale limit the digits of their values.
+func (c Column) IsDecimal() bool {
+	switch strings.ToLower(c.DBType) {
+	case "decimal", "numeric", "dec":
+		return true
+	}
+	return false
+}
+
+// MaxLength returns the length declared in FullDBType for sized types,
+// ex: 16 for varbinary(16). It returns 0 when the type has no single length
+// or is unbounded, like varbinary(max) or the -1 sentinel some catalogs use.
+func (c Column) MaxLength() int {
+	start := strings.IndexByte(c.FullDBType, '(')
+	end := strings.LastIndexByte(c.FullDBType, ')')
+	if start < 0 || end < start {
+		return 0
+	}
+
+	length, err := strconv.Atoi(strings.TrimSpace(c.FullDBType[start+1 : end]))
+	if err != nil || length < 0 {
+		return 0
+	}
+
+	return length
+}
+
+// UnsignedType returns the unsigned counterpart of a Go integer type, ex:
+// uint16 for int16 or null.Uint16 for null.Int16. Other types are returned as is.
+func UnsignedType(typ string) string {
+	switch typ {
+	case "int", "int8", "int16", "int32", "int64":
+		return "u" + typ
+	case "null.Int", "null.Int8", "null.Int16", "null.Int32", "null.Int64":
+		return "null.Ui" + strings.TrimPrefix(typ, "null.I")
+	}
+
+	return typ
+}
+
+// nullCounterparts are the nullable types that don't follow the null.Int64
+// or pkg.NullName naming of their non-null type
+var nullCounterparts = map[string]string{
+	"[]byte":     "null.Bytes",
+	"time.Time":  "null.Time",
+	"types.JSON": "null.JSON",
+}
+
+// TypeMappingMismatch translates a column as both not null and nullable and
+// returns false when the two types don't pair up, ex: a DBType handled in only
+// one branch of TranslateColumnType falls through to the other's default.
+func TypeMappingMismatch(translate func(Column) Column, c Column) (typ, nullType string, ok bool) {
+	c.Nullable = false
+	typ = translate(c).Type
+	c.Nullable = true
+	nullType = translate(c).Type
+
+	if typ == nullType {
+		return typ, nullType, true
+	}
+	if len(typ) == 0 {
+		return typ, nullType, false
+	}
+	if want, known := nullCounterparts[typ]; known {
+		return typ, nullType, nullType == want
+	}
+
+	if dot := strings.IndexByte(typ, '.'); dot >= 0 {
+		return typ, nullType, nullType == typ[:dot+1]+"Null"+typ[dot+1:]
+	}
+	return typ, nullType, nullType == "null."+strings.ToUpper(typ[:1])+typ[1:]
+}
+
+// rgxKeyword matches bare defaults like CURRENT_USER that aren't strings
+var rgxKeyword = regexp.MustCompile(`^[A-Z_]+$`)
+
+// GoInitializer returns a Go expression for the column's default when it's a
+// literal number, string, bool or date, ex: 1 for ((1)) or null.StringFrom("a")
+// for ('a') on a nullable column. Expressions like getdate() return "".
+func (c Column) GoInitializer() string {
+	lit, quoted, ok := DefaultLiteral(c.Default)
+	if !ok {
+		return ""
+	}
+
+	typ := strings.TrimPrefix(c.Type, "null.")
+
+	var expr string
+	switch strings.ToLower(typ) {
+	case "string":
+		// Drivers that unwrap defaults report strings bare, ex: foo for
+		// (N'foo'), but a wrapped number or a keyword isn't a string
+		if !quoted && (lit != c.Default || c.Default == "auto" || rgxKeyword.MatchString(lit)) {
+			return ""
+		}
+		expr = strconv.Quote(lit)
+	case "bool":
+		switch strings.ToLower(lit) {
+		case "1", "true":
+			expr = "true"
+		case "0", "false":
+			expr = "false"
+		default:
+			return ""
+		}
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		if _, err := strconv.ParseInt(lit, 10, 64); err != nil {
+			if _, err := strconv.ParseUint(lit, 10, 64); err != nil {
+				return ""
+			}
+		}
+		expr = lit
+	case "float32", "float64":
+		if _, err := strconv.ParseFloat(lit, 64); err != nil {
+			return ""
+		}
+		expr = lit
+	case "time", "time.time":
+		t, ok := parseDefaultTime(lit)
+		if !ok {
+			return ""
+		}
+		expr = fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, 0, time.UTC)",
+			t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second())
+	default:
+		return ""
+	}
+
+	if typ != c.Type {
+		return fmt.Sprintf("null.%sFrom(%s)", typ, expr)
+	}
+	return expr
+}
+
+// DefaultLiteral unwraps a column default as reported by the database,
+// ex: ((1)), (N'a') or 'a'::character varying. quoted is true for string
+// literals and ok is false for anything that calls a function.
+func DefaultLiteral(def string) (lit string, quoted, ok bool) {
+	def = strings.TrimSpace(def)
+	for len(def) >= 2 && def[0] == '(' && def[len(def)-1] == ')' && balanced(def[1:len(def)-1]) {
+		def = strings.TrimSpace(def[1 : len(def)-1])
+	}
+
+	if strings.HasPrefix(def, "N'") {
+		def = def[1:]
+	}
+
+	if strings.HasPrefix(def, "'") {
+		var b strings.Builder
+		for i := 1; i < len(def); i++ {
+			if def[i] != '\'' {
+				b.WriteByte(def[i])
+				continue
+			}
+			if i+1 < len(def) && def[i+1] == '\'' {
+				b.WriteByte('\'')
+				i++
+				continue
+			}
+
+			// Anything after the closing quote must be a postgres cast
+			rest := def[i+1:]
+			if len(rest) != 0 && !strings.HasPrefix(rest, "::") {
+				return "", false, false
+			}
+			return b.String(), true, true
+		}
+		return "", false, false
+	}
+
+	if len(def) == 0 || strings.ContainsAny(def, "()'") {
+		return "", false, false
+	}
+
+	return def, false, true
+}
+
+// balanced returns true if the parentheses in s all match up
+func balanced(s string) bool {
+	depth := 0
+	for _, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+
+	return depth == 0
+}
+
+// parseDefaultTime parses the date and datetime literals databases report
+// as defaults.
+func parseDefaultTime(lit string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", "20060102", "2006-01-02 15:04:05", "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, lit); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
 }
 
 // ColumnNames of the columns.
@@ -74,6 +311,19 @@ func FilterColumnsByAuto(auto bool, columns []Column) []Column {
 	return cols
 }
 
+// FilterColumnsByAutoIncrement generates the list of identity columns
+func FilterColumnsByAutoIncrement(autoIncrement bool, columns []Column) []Column {
+	var cols []Column
+
+	for _, c := range columns {
+		if c.AutoIncrement == autoIncrement {
+			cols = append(cols, c)
+		}
+	}
+
+	return cols
+}
+
 // FilterColumnsByDefault generates the list of columns that have default values
 func FilterColumnsByDefault(defaults bool, columns []Column) []Column {
 	var cols []Column
@@ -87,6 +337,21 @@ func FilterColumnsByDefault(defaults bool, columns []Column) []Column {
 	return cols
 }
 
+// FilterColumnsByRequired generates the list of columns an insert must be
+// given a value for: NOT NULL, without a default and not generated by the
+// database.
+func FilterColumnsByRequired(columns []Column) []Column {
+	var cols []Column
+
+	for _, c := range columns {
+		if !c.Nullable && len(c.Default) == 0 && !c.AutoGenerated && !c.AutoIncrement {
+			cols = append(cols, c)
+		}
+	}
+
+	return cols
+}
+
 // FilterColumnsByEnum generates the list of columns that are enum values.
 func FilterColumnsByEnum(columns []Column) []Column {
 	var cols []Column
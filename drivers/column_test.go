@@ -20,6 +20,115 @@ func TestColumnNames(t *testing.T) {
 	}
 }
 
+func TestColumnMaxLength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		FullDBType string
+		Want       int
+	}{
+		{"varbinary(16)", 16},
+		{"binary(4)", 4},
+		{"nvarchar(255)", 255},
+		{"varbinary(max)", 0},
+		{"varchar(-1)", 0},
+		{"decimal(10,2)", 0},
+		{"int", 0},
+		{"", 0},
+	}
+
+	for i, test := range tests {
+		c := Column{FullDBType: test.FullDBType}
+		if got := c.MaxLength(); got != test.Want {
+			t.Errorf("%d) %s: want %d, got %d", i, test.FullDBType, test.Want, got)
+		}
+	}
+}
+
+func TestColumnGoInitializer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Type    string
+		Default string
+		Want    string
+	}{
+		{"int", "((1))", "1"},
+		{"int64", "((-42))", "-42"},
+		{"null.Int", "((0))", "null.IntFrom(0)"},
+		{"string", "('abc')", `"abc"`},
+		{"string", "(N'it''s')", `"it's"`},
+		{"string", "'a'::character varying", `"a"`},
+		{"null.String", "('')", `null.StringFrom("")`},
+		{"bool", "((1))", "true"},
+		{"bool", "false", "false"},
+		{"null.Bool", "((0))", "null.BoolFrom(false)"},
+		{"float64", "((1.1))", "1.1"},
+		{"time.Time", "('1999-01-08')", "time.Date(1999, time.January, 8, 0, 0, 0, 0, time.UTC)"},
+		{"null.Time", "('19990108')", "null.TimeFrom(time.Date(1999, time.January, 8, 0, 0, 0, 0, time.UTC))"},
+		{"time.Time", "(getdate())", ""},
+		{"[]byte", "(CONVERT([varbinary](max),'a'))", ""},
+		{"string", "((1))", ""},
+		{"int", "('a')", ""},
+		{"int", "((1)+(2))", ""},
+		{"string", "auto", ""},
+		{"string", "abc", `"abc"`},
+		{"null.String", "''", `null.StringFrom("")`},
+		{"string", "CURRENT_USER", ""},
+		{"int", "", ""},
+	}
+
+	for i, test := range tests {
+		c := Column{Type: test.Type, Default: test.Default}
+		if got := c.GoInitializer(); got != test.Want {
+			t.Errorf("%d) %s %s: want %s, got %s", i, test.Type, test.Default, test.Want, got)
+		}
+	}
+}
+
+func TestTypeMappingMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Maps date only when nullable, like a case missing from one branch
+	translate := func(c Column) Column {
+		switch {
+		case c.DBType == "int" && c.Nullable:
+			c.Type = "null.Int"
+		case c.DBType == "int":
+			c.Type = "int"
+		case c.DBType == "date" && c.Nullable:
+			c.Type = "null.Time"
+		case c.DBType == "decimal" && c.Nullable:
+			c.Type = "types.NullDecimal"
+		case c.DBType == "decimal":
+			c.Type = "types.Decimal"
+		case c.DBType == "varbinary" && c.Nullable:
+			c.Type = "null.Bytes"
+		case c.DBType == "varbinary":
+			c.Type = "[]byte"
+		case c.Nullable:
+			c.Type = "null.String"
+		default:
+			c.Type = "string"
+		}
+		return c
+	}
+
+	for _, dbType := range []string{"int", "decimal", "varbinary", "text"} {
+		if typ, nullType, ok := TypeMappingMismatch(translate, Column{DBType: dbType}); !ok {
+			t.Errorf("%s: %s and %s should pair up", dbType, typ, nullType)
+		}
+	}
+
+	typ, nullType, ok := TypeMappingMismatch(translate, Column{DBType: "date"})
+	if ok {
+		t.Error("date should be reported as asymmetric")
+	}
+	if typ != "string" || nullType != "null.Time" {
+		t.Errorf("want string and null.Time, got: %s and %s", typ, nullType)
+	}
+}
+
 func TestColumnDBTypes(t *testing.T) {
 	cols := []Column{
 		{Name: "test_one", DBType: "integer"},
@@ -67,6 +176,30 @@ func TestFilterColumnsByDefault(t *testing.T) {
 	}
 }
 
+func TestFilterColumnsByRequired(t *testing.T) {
+	t.Parallel()
+
+	cols := []Column{
+		{Name: "col1"},
+		{Name: "col2", Nullable: true},
+		{Name: "col3", Default: "things"},
+		{Name: "col4", AutoGenerated: true},
+		{Name: "col5", AutoIncrement: true},
+		{Name: "col6"},
+	}
+
+	res := FilterColumnsByRequired(cols)
+	if len(res) != 2 {
+		t.Fatalf("Invalid result: %#v", res)
+	}
+	if res[0].Name != `col1` {
+		t.Errorf("Invalid result: %#v", res)
+	}
+	if res[1].Name != `col6` {
+		t.Errorf("Invalid result: %#v", res)
+	}
+}
+
 func TestFilterColumnsByEnum(t *testing.T) {
 	t.Parallel()
 
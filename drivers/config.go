@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/strmangle"
 )
 
 // Config is a map with helper functions
@@ -134,6 +135,38 @@ func (c Config) DefaultInt(key string, def int) int {
 	return i
 }
 
+// Bool retrieves a bool, the bool says if it exists and is of the appropriate
+// type. Coerces strings like "true" because environment variables are strings.
+func (c Config) Bool(key string) (bool, bool) {
+	b, ok := c[key]
+	if !ok {
+		return false, false
+	}
+
+	switch t := b.(type) {
+	case bool:
+		return t, true
+	case string:
+		value, err := strconv.ParseBool(t)
+		if err != nil {
+			return false, false
+		}
+		return value, true
+	default:
+		return false, false
+	}
+}
+
+// DefaultBool retrieves a bool or the default value provided.
+func (c Config) DefaultBool(key string, def bool) bool {
+	b, ok := c.Bool(key)
+	if !ok {
+		return def
+	}
+
+	return b
+}
+
 // StringSlice retrieves an string slice, the bool says if it exists, is of the appropriate type,
 // is non-nil and non-zero length
 func (c Config) StringSlice(key string) ([]string, bool) {
@@ -211,3 +244,18 @@ func ColumnsFromList(list []string, tablename string) []string {
 
 	return columns
 }
+
+// BlacklistColumnsFromList takes a blacklist and returns the columns to
+// exclude for a given table. These are the table's own table.column entries
+// merged with the *.column entries, which exclude the column from every
+// table, ex: *.row_version. Bare entries only ever name tables.
+func BlacklistColumnsFromList(list []string, tablename string) []string {
+	columns := ColumnsFromList(list, tablename)
+	for _, c := range ColumnsFromList(list, "*") {
+		if !strmangle.SetInclude(c, columns) {
+			columns = append(columns, c)
+		}
+	}
+
+	return columns
+}
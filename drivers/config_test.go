@@ -197,6 +197,63 @@ func TestConfigInt(t *testing.T) {
 	}
 }
 
+func TestConfigBool(t *testing.T) {
+	t.Parallel()
+
+	key := "boolean"
+	tests := []struct {
+		Config map[string]interface{}
+		Value  bool
+		Ok     bool
+	}{
+		{
+			Config: map[string]interface{}{key: true},
+			Value:  true,
+			Ok:     true,
+		},
+		{
+			Config: map[string]interface{}{key: false},
+			Value:  false,
+			Ok:     true,
+		},
+		{
+			Config: map[string]interface{}{key: "true"},
+			Value:  true,
+			Ok:     true,
+		},
+		{
+			Config: map[string]interface{}{key: "yes"},
+			Value:  false,
+			Ok:     false,
+		},
+		{
+			Config: map[string]interface{}{key: 1},
+			Value:  false,
+			Ok:     false,
+		},
+		{
+			Config: map[string]interface{}{},
+			Value:  false,
+			Ok:     false,
+		},
+	}
+
+	for i, test := range tests {
+		value, ok := Config(test.Config).Bool(key)
+
+		if ok != test.Ok {
+			t.Error(i, "ok =", ok)
+		}
+		if value != test.Value {
+			t.Error(i, "want:", test.Value, "got:", value)
+		}
+	}
+
+	if !Config(map[string]interface{}{}).DefaultBool(key, true) {
+		t.Error("want the default value")
+	}
+}
+
 func TestConfigStringSlice(t *testing.T) {
 	t.Parallel()
 
@@ -271,3 +328,22 @@ func TestColumnsFromList(t *testing.T) {
 		t.Error("list was wrong:", got)
 	}
 }
+
+func TestBlacklistColumnsFromList(t *testing.T) {
+	t.Parallel()
+
+	if BlacklistColumnsFromList(nil, "table") != nil {
+		t.Error("expected a shortcut to getting nil back")
+	}
+
+	list := []string{"*.row_version", "audits", "users.password", "videos.row_version"}
+	if got := BlacklistColumnsFromList(list, "users"); !reflect.DeepEqual(got, []string{"password", "row_version"}) {
+		t.Error("list was wrong:", got)
+	}
+	if got := BlacklistColumnsFromList(list, "videos"); !reflect.DeepEqual(got, []string{"row_version"}) {
+		t.Error("list was wrong:", got)
+	}
+	if got := BlacklistColumnsFromList([]string{"row_version"}, "users"); got != nil {
+		t.Error("a bare entry names a table, not a column:", got)
+	}
+}
@@ -7,13 +7,15 @@ import (
 	"os"
 )
 
-// DriverMain helps dry up the implementation of main.go for drivers
+// DriverMain helps dry up the implementation of main.go for drivers.
+// Besides the assemble, templates and imports methods it supports selftest
+// for drivers implementing SelfTester, which reads a config like assemble.
 func DriverMain(driver Interface) {
 	method := os.Args[1]
 	var config Config
 
 	switch method {
-	case "assemble":
+	case "assemble", "selftest", "-selftest":
 		b, err := ioutil.ReadAll(os.Stdin)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "failed to read from stdin")
@@ -33,6 +35,22 @@ func DriverMain(driver Interface) {
 
 	var output interface{}
 	switch method {
+	case "selftest", "-selftest":
+		tester, ok := driver.(SelfTester)
+		if !ok {
+			fmt.Fprintln(os.Stderr, "driver does not support selftest")
+			os.Exit(1)
+		}
+		report, err := tester.SelfTest(config)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Fprint(os.Stdout, report)
+		if report.Failed() {
+			os.Exit(1)
+		}
+		return
 	case "assemble":
 		dinfo, err := driver.Assemble(config)
 		if err != nil {
@@ -0,0 +1,141 @@
+package drivers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/strmangle"
+)
+
+// Enum is a lookup table generated as a Go type with a constant for each
+// of its rows, see ConfigEnumFromTable.
+type Enum struct {
+	// Name is the Go type of the enum
+	Name  string `json:"name"`
+	Table string `json:"table"`
+	// Type is the Go type underlying the enum, the type of the table's key
+	Type   string      `json:"type"`
+	Values []EnumValue `json:"values"`
+}
+
+// EnumValue is a row of a lookup table
+type EnumValue struct {
+	// Name is the name column of the row, or once read by EnumsFromTables
+	// the name of its Go constant
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// EnumConstructor can optionally be implemented by a Constructor able to
+// read the rows of a table. It's required by ConfigEnumFromTable.
+type EnumConstructor interface {
+	// EnumValues returns the key and name columns of every row of the
+	// table, ordered by key
+	EnumValues(schema, tableName, keyColumn, nameColumn string) ([]EnumValue, error)
+}
+
+// EnumNameColumn is the column holding the names of a lookup table's rows
+const EnumNameColumn = "name"
+
+var rgxEnumNameInvalid = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// EnumsFromTables reads the lookup tables listed by ConfigEnumFromTable.
+// Each is listed as "table" or "table:GoType" and must have a single column
+// primary key of an integer or string type, and a name column. The type
+// defaults to the table's model name followed by ID, and the constants are
+// named after the model and the name of their row, ex: OrderStatusShipped.
+func EnumsFromTables(c interface{}, schema string, tables []Table, list []string) ([]Enum, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	ec, ok := c.(EnumConstructor)
+	if !ok {
+		return nil, errors.Errorf("%s is not supported by this driver", ConfigEnumFromTable)
+	}
+
+	enums := make([]Enum, 0, len(list))
+	for _, item := range list {
+		name, typeName := item, ""
+		if i := strings.IndexByte(item, ':'); i >= 0 {
+			name, typeName = item[:i], item[i+1:]
+		}
+		prefix := strmangle.TitleCase(strmangle.Singular(name))
+		if len(typeName) == 0 {
+			typeName = prefix + "ID"
+		}
+
+		var table *Table
+		for i := range tables {
+			if tables[i].Name == name {
+				table = &tables[i]
+			}
+		}
+		if table == nil {
+			return nil, errors.Errorf("enum table %s was not found, it must not be blacklisted", name)
+		}
+		if table.PKey == nil || len(table.PKey.Columns) != 1 {
+			return nil, errors.Errorf("enum table %s must have a single column primary key", name)
+		}
+
+		var key *Column
+		hasName := false
+		for i, col := range table.Columns {
+			switch col.Name {
+			case table.PKey.Columns[0]:
+				key = &table.Columns[i]
+			case EnumNameColumn:
+				hasName = true
+			}
+		}
+		if key == nil || !hasName {
+			return nil, errors.Errorf("enum table %s must have a %s column", name, EnumNameColumn)
+		}
+
+		enum := Enum{Name: typeName, Table: name, Type: key.Type}
+		if !isIntegerType(key.Type) && key.Type != "string" {
+			return nil, errors.Errorf("key %s of enum table %s must be an integer or a string, not %s", key.Name, name, key.Type)
+		}
+
+		values, err := ec.EnumValues(schema, name, key.Name, EnumNameColumn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read enum table %s", name)
+		}
+
+		seen := make(map[string]struct{}, len(values))
+		for _, v := range values {
+			label := strings.Trim(rgxEnumNameInvalid.ReplaceAllString(v.Name, "_"), "_")
+			if len(label) == 0 {
+				return nil, errors.Errorf("enum table %s has a row without a usable name: %q", name, v.Name)
+			}
+
+			constName := prefix + strmangle.TitleCase(label)
+			if _, ok := seen[constName]; ok {
+				return nil, errors.Errorf("enum table %s has two rows named %s", name, constName)
+			}
+			seen[constName] = struct{}{}
+
+			if enum.Type != "string" {
+				if _, err := strconv.ParseInt(v.Key, 10, 64); err != nil {
+					return nil, errors.Errorf("enum table %s has a non-integer key: %q", name, v.Key)
+				}
+			}
+
+			enum.Values = append(enum.Values, EnumValue{Name: constName, Key: v.Key})
+		}
+
+		enums = append(enums, enum)
+	}
+
+	return enums, nil
+}
+
+func isIntegerType(typ string) bool {
+	switch typ {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
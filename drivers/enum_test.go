@@ -0,0 +1,80 @@
+package drivers
+
+import (
+	"strings"
+	"testing"
+)
+
+type testEnumConstructor map[string][]EnumValue
+
+func (t testEnumConstructor) EnumValues(schema, tableName, keyColumn, nameColumn string) ([]EnumValue, error) {
+	return t[tableName], nil
+}
+
+func TestEnumsFromTables(t *testing.T) {
+	t.Parallel()
+
+	lookup := func(name, keyType string) Table {
+		return Table{
+			Name:    name,
+			Columns: []Column{{Name: "id", Type: keyType}, {Name: "name", Type: "string"}},
+			PKey:    &PrimaryKey{Columns: []string{"id"}},
+		}
+	}
+	tables := []Table{
+		lookup("order_statuses", "int"),
+		lookup("currencies", "string"),
+		lookup("colors", "int"),
+		lookup("tags", "types.Decimal"),
+		{Name: "notes", Columns: []Column{{Name: "id", Type: "int"}}, PKey: &PrimaryKey{Columns: []string{"id"}}},
+	}
+	c := testEnumConstructor{
+		"order_statuses": {{Key: "1", Name: "pending"}, {Key: "2", Name: "Shipped"}},
+		"currencies":     {{Key: "EUR", Name: "euro"}, {Key: "USD", Name: "us dollar"}},
+		"colors":         {{Key: "blue", Name: "blue"}},
+	}
+
+	enums, err := EnumsFromTables(c, "dbo", tables, []string{"order_statuses", "currencies:Currency"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enums) != 2 {
+		t.Fatalf("want 2 enums, got %#v", enums)
+	}
+
+	status := enums[0]
+	if status.Name != "OrderStatusID" || status.Type != "int" || len(status.Values) != 2 ||
+		status.Values[0] != (EnumValue{Name: "OrderStatusPending", Key: "1"}) ||
+		status.Values[1] != (EnumValue{Name: "OrderStatusShipped", Key: "2"}) {
+		t.Errorf("wrong int enum: %#v", status)
+	}
+	currency := enums[1]
+	if currency.Name != "Currency" || currency.Type != "string" ||
+		currency.Values[1] != (EnumValue{Name: "CurrencyUsDollar", Key: "USD"}) {
+		t.Errorf("wrong string enum: %#v", currency)
+	}
+
+	// An empty table is a type without constants
+	c["order_statuses"] = nil
+	if enums, err = EnumsFromTables(c, "dbo", tables, []string{"order_statuses"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(enums) != 1 || len(enums[0].Values) != 0 {
+		t.Errorf("want an enum without values: %#v", enums)
+	}
+
+	for list, want := range map[string]string{
+		"missing": "was not found",
+		"notes":   "must have a name column",
+		"tags":    "must be an integer or a string",
+		"colors":  "non-integer key",
+	} {
+		if _, err := EnumsFromTables(c, "dbo", tables, []string{list}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: want an error containing %q, got %v", list, want, err)
+		}
+	}
+
+	if _, err := EnumsFromTables(struct{}{}, "dbo", tables, []string{"order_statuses"}); err == nil {
+		t.Error("want an error from drivers that can't read tables")
+	}
+}
@@ -0,0 +1,56 @@
+package drivers
+
+import (
+	"sort"
+
+	"github.com/friendsofgo/errors"
+)
+
+// Function is a user defined function callable from queries. Scalar
+// functions return a single value of ReturnType, table valued ones return
+// rows of Columns.
+type Function struct {
+	Name       string          `json:"name"`
+	Params     []FunctionParam `json:"params"`
+	ReturnType string          `json:"return_type"`
+	Columns    []Column        `json:"columns"`
+}
+
+// FunctionParam is a parameter of a Function, in call order
+type FunctionParam struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	DBType string `json:"db_type"`
+}
+
+// IsTableValued is true when the function returns rows instead of a value
+func (f Function) IsTableValued() bool {
+	return len(f.Columns) != 0
+}
+
+// FunctionConstructor can optionally be implemented by a Constructor whose
+// database has user defined functions it's able to list. When it is,
+// drivers.Functions returns them so the templates can generate wrappers.
+type FunctionConstructor interface {
+	FunctionInfo(schema string) ([]Function, error)
+}
+
+// Functions returns the functions of the schema sorted by name, or none
+// when the constructor isn't a FunctionConstructor.
+func Functions(c interface{}, schema string) ([]Function, error) {
+	fc, ok := c.(FunctionConstructor)
+	if !ok {
+		return nil, nil
+	}
+
+	functions, err := fc.FunctionInfo(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch function info")
+	}
+
+	sort.SliceStable(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+
+	return functions, nil
+}
@@ -0,0 +1,31 @@
+package drivers
+
+import "testing"
+
+type testFunctionConstructor struct{}
+
+func (testFunctionConstructor) FunctionInfo(schema string) ([]Function, error) {
+	return []Function{
+		{Name: "total", ReturnType: "int"},
+		{Name: "active_users", Columns: []Column{{Name: "id", Type: "int"}}},
+	}, nil
+}
+
+func TestFunctions(t *testing.T) {
+	t.Parallel()
+
+	functions, err := Functions(testFunctionConstructor{}, "dbo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(functions) != 2 || functions[0].Name != "active_users" || functions[1].Name != "total" {
+		t.Errorf("want the functions sorted by name: %#v", functions)
+	}
+	if !functions[0].IsTableValued() || functions[1].IsTableValued() {
+		t.Error("only functions returning columns are table valued")
+	}
+
+	if functions, err = Functions(struct{}{}, "dbo"); err != nil || functions != nil {
+		t.Errorf("want no functions from other constructors, got %#v %v", functions, err)
+	}
+}
@@ -3,7 +3,12 @@
 package drivers
 
 import (
+	"context"
+	"io"
 	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/importers"
@@ -22,6 +27,93 @@ const (
 	ConfigPort    = "port"
 	ConfigDBName  = "dbname"
 	ConfigSSLMode = "sslmode"
+
+	// ConfigChar36AsUUID maps char(36)/nchar(36) columns to a uuid type
+	// instead of string for drivers that support it.
+	ConfigChar36AsUUID = "char36_as_uuid"
+
+	// ConfigBitAsInt maps bit columns to types.Bit for providers that
+	// return them as the integers 0 and 1 instead of a bool.
+	ConfigBitAsInt = "bit_as_int"
+
+	// ConfigBitDefaults is how the defaults of bit columns are reported,
+	// numeric (0 and 1) or bool (false and true), whatever they're written as.
+	ConfigBitDefaults = "bit_defaults"
+
+	// ConfigIdentityColumnExpr overrides the SQL expression used to detect
+	// identity columns, for catalogs that don't answer the default one.
+	ConfigIdentityColumnExpr = "identity_column_expr"
+
+	// ConfigEmbedStruct names a struct to embed in every generated model,
+	// ex: Base, for cross-cutting fields that aren't columns.
+	ConfigEmbedStruct = "embed_struct"
+
+	// ConfigVerifyColumnCount compares the columns read for each table against
+	// a plain count from the catalog and warns when they differ.
+	ConfigVerifyColumnCount = "verify_column_count"
+
+	// ConfigOptimisticLocking lists the tables whose rowversion column is
+	// checked on update, see Table.VersionColumn.
+	ConfigOptimisticLocking = "optimistic_locking"
+
+	// ConfigMoneyColumns lists decimal columns to generate as types.Money,
+	// either as table.column or a bare column name for every table.
+	ConfigMoneyColumns = "money_columns"
+
+	// ConfigPolymorphicPattern is the pair of column suffixes that make up
+	// a polymorphic key, ex: ["_type", "_id"] for owner_type and owner_id.
+	ConfigPolymorphicPattern = "polymorphic_pattern"
+
+	// ConfigGoDefaults fills Column.GoDefault with a Go expression for
+	// literal column defaults, see Column.GoInitializer.
+	ConfigGoDefaults = "go_defaults"
+
+	// ConfigIndexHints fills Column.IndexHints from the table's indexes
+	// for drivers that read them.
+	ConfigIndexHints = "index_hints"
+
+	// ConfigInferForeignKeys adds foreign keys by naming convention where none
+	// are declared, ex: a user_id column referencing the users primary key.
+	ConfigInferForeignKeys = "infer_foreign_keys"
+
+	// ConfigSoftDeleteColumn names the nullable timestamp column that marks
+	// rows as deleted when soft deletes are enabled, defaults to deleted_at.
+	ConfigSoftDeleteColumn = "soft_delete_column"
+
+	// ConfigSoftDeleteTables limits soft deletes to the listed tables.
+	ConfigSoftDeleteTables = "soft_delete_tables"
+
+	// ConfigSoftDeleteExclude lists tables that delete rows even though
+	// they have a soft delete column.
+	ConfigSoftDeleteExclude = "soft_delete_exclude"
+
+	// ConfigReadOnlyTables lists base tables generated like views, with
+	// query code only, ex: lookup data managed out of band.
+	ConfigReadOnlyTables = "read_only_tables"
+
+	// ConfigUseSchema sets Dialect.UseSchema, whether generated queries
+	// qualify table names with the schema. Defaults per driver.
+	ConfigUseSchema = "use_schema"
+
+	// ConfigIntrospectDSN is a connection string used only for reading the
+	// schema, ex: a read-only copy, in place of the user/host/dbname keys.
+	ConfigIntrospectDSN = "introspect_dsn"
+
+	// ConfigEnumFromTable lists lookup tables whose rows are read while
+	// generating to emit a Go type with a constant per row, see EnumsFromTables.
+	ConfigEnumFromTable = "enum_from_table"
+
+	// ConfigAssembleTimeout bounds the time introspecting the tables may
+	// take, see AssembleOptions.
+	ConfigAssembleTimeout = "assemble_timeout"
+
+	// ConfigAssembleConcurrency is how many tables are introspected at
+	// once, see AssembleOptions.
+	ConfigAssembleConcurrency = "assemble_concurrency"
+
+	// ConfigSelfTestTable is the table the selftest method introspects,
+	// defaults to the first table found.
+	ConfigSelfTestTable = "selftest_table"
 )
 
 // Interface abstracts either a side-effect imported driver or a binary
@@ -40,6 +132,28 @@ type DBInfo struct {
 	Schema  string  `json:"schema"`
 	Tables  []Table `json:"tables"`
 	Dialect Dialect `json:"dialect"`
+
+	// DefaultSchema is the schema the database resolves unqualified names
+	// against, empty if the driver doesn't know it.
+	DefaultSchema string `json:"default_schema"`
+
+	// Functions are the user defined functions of the schema, see
+	// FunctionConstructor.
+	Functions []Function `json:"functions"`
+
+	// Enums are the lookup tables read for ConfigEnumFromTable
+	Enums []Enum `json:"enums"`
+
+	// EngineVersion is the version of the database engine the tables were
+	// read from, empty if the driver can't tell, see EngineVersioner.
+	EngineVersion string `json:"engine_version"`
+
+	// SchemaQuery is the query the generated VerifySchema reads the live
+	// columns with. It selects the table name, column name and nullability
+	// (YES or NO) of every column of the schema, which is bound as its only
+	// parameter when Schema is set. Empty if the driver has none, then
+	// VerifySchema isn't generated.
+	SchemaQuery string `json:"schema_query"`
 }
 
 // Dialect describes the databases requirements in terms of which features
@@ -57,11 +171,45 @@ type Dialect struct {
 	UseSchema            bool `json:"use_schema"`
 	UseDefaultKeyword    bool `json:"use_default_keyword"`
 
+	// MaxParams is the most bind parameters a single statement may use,
+	// 0 means the database has no practical limit.
+	MaxParams int `json:"max_params"`
+
 	// The following is mostly for T-SQL/MSSQL, what a show
 	UseAutoColumns          bool `json:"use_auto_columns"`
 	UseTopClause            bool `json:"use_top_clause"`
 	UseOutputClause         bool `json:"use_output_clause"`
 	UseCaseWhenExistsClause bool `json:"use_case_when_exists_clause"`
+	// UseCountBig counts with COUNT_BIG, which doesn't overflow on
+	// tables with more than 2^31 rows.
+	UseCountBig bool `json:"use_count_big"`
+	// UseTableHints allows table hints like WITH (NOLOCK) after the table
+	// name in a FROM clause, see qm.WithTableHint.
+	UseTableHints bool `json:"use_table_hints"`
+	// UseDistinctOn selects the first row of each group with DISTINCT ON,
+	// see qm.DistinctOn.
+	UseDistinctOn bool `json:"use_distinct_on"`
+	// UseWindowFunctions allows ROW_NUMBER() OVER (...), used for
+	// qm.DistinctOn without UseDistinctOn.
+	UseWindowFunctions bool `json:"use_window_functions"`
+	// UseForUpdate locks the selected rows with FOR UPDATE, see
+	// qm.LockForUpdate. Dialects with UseTableHints lock them with the
+	// UPDLOCK hint instead.
+	UseForUpdate bool `json:"use_for_update"`
+}
+
+// ParamsMargin is the part of MaxParams statements binding lists of keys
+// leave unused: sp_executesql takes fewer than the 2100 parameters mssql
+// allows and drivers may bind a few of their own.
+const ParamsMargin = 10
+
+// KeyParams is the most parameters a statement should bind for a list of
+// keys, MaxParams less ParamsMargin. 0 means there's no limit.
+func (d Dialect) KeyParams() int {
+	if d.MaxParams <= ParamsMargin {
+		return 0
+	}
+	return d.MaxParams - ParamsMargin
 }
 
 // Constructor breaks down the functionality required to implement a driver
@@ -77,9 +225,166 @@ type Constructor interface {
 	TranslateColumnType(Column) Column
 }
 
+// TriggerConstructor can optionally be implemented by a Constructor whose
+// database exposes triggers in its catalog. When it is, drivers.Tables records
+// the trigger names on each table so templates can avoid statements triggers
+// break, like a bare OUTPUT clause in T-SQL.
+type TriggerConstructor interface {
+	Triggers(schema, tableName string) ([]string, error)
+}
+
+// IndexConstructor can optionally be implemented by a Constructor able to
+// list the indexes of a table. When it is, drivers.Tables records them on
+// each table for the index metadata templates.
+type IndexConstructor interface {
+	IndexInfo(schema, tableName string) ([]Index, error)
+}
+
+// CheckConstraintConstructor can optionally be implemented by a Constructor
+// able to list the CHECK constraints of a table. When it is, drivers.Tables
+// records them on each table. Databases without constraint metadata return
+// none rather than an error.
+type CheckConstraintConstructor interface {
+	CheckConstraintInfo(schema, tableName string) ([]CheckConstraint, error)
+}
+
+// EngineVersioner can optionally be implemented by a driver able to report
+// the version of the database engine it's connected to, see EngineVersion.
+type EngineVersioner interface {
+	EngineVersion() (string, error)
+}
+
+// EngineVersion returns the engine version of a driver implementing
+// EngineVersioner. Drivers that don't, or whose database can't answer, get an
+// empty version rather than an error: the version is only informative.
+func EngineVersion(driver interface{}) string {
+	v, ok := driver.(EngineVersioner)
+	if !ok {
+		return ""
+	}
+
+	version, err := v.EngineVersion()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(version)
+}
+
+// AssembleOptions bound the introspection of the tables by TablesWithOptions,
+// see AssembleOptionsFromConfig.
+type AssembleOptions struct {
+	// Timeout is the most time introspecting the tables may take, 0 for no
+	// limit
+	Timeout time.Duration
+	// Concurrency is how many tables are introspected at once, 1 when 0.
+	Concurrency int
+}
+
+// AssembleOptionsFromConfig reads ConfigAssembleTimeout, a duration like
+// "2m" or a number of seconds, and ConfigAssembleConcurrency.
+func AssembleOptionsFromConfig(config Config) (AssembleOptions, error) {
+	var opts AssembleOptions
+
+	if seconds, ok := config.Int(ConfigAssembleTimeout); ok {
+		opts.Timeout = time.Duration(seconds) * time.Second
+	} else if s, ok := config.String(ConfigAssembleTimeout); ok {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return opts, errors.Wrapf(err, "invalid %s", ConfigAssembleTimeout)
+		}
+		opts.Timeout = d
+	}
+	if opts.Timeout < 0 {
+		return opts, errors.Errorf("%s must not be negative", ConfigAssembleTimeout)
+	}
+
+	opts.Concurrency = config.DefaultInt(ConfigAssembleConcurrency, 1)
+	if opts.Concurrency < 1 {
+		return opts, errors.Errorf("%s must be at least 1", ConfigAssembleConcurrency)
+	}
+
+	return opts, nil
+}
+
 // Tables returns the metadata for all tables, minus the tables
 // specified in the blacklist.
 func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Table, error) {
+	return TablesWithOptions(c, schema, whitelist, blacklist, AssembleOptions{})
+}
+
+// TablesWithOptions is Tables introspecting opts.Concurrency tables at once
+// and giving up once opts.Timeout has passed, with an error wrapping
+// context.DeadlineExceeded. The deadline covers reading the table names too.
+// Past it the running queries are abandoned, the caller should close the
+// connection without waiting for them. The tables are the same whatever the
+// concurrency: each is stored in the position of its name.
+func TablesWithOptions(c Constructor, schema string, whitelist, blacklist []string, opts AssembleOptions) ([]Table, error) {
+	if opts.Timeout <= 0 {
+		return assembleTables(c, schema, whitelist, blacklist, opts, nil, nil)
+	}
+
+	timer := time.NewTimer(opts.Timeout)
+	defer timer.Stop()
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	p := &progress{total: -1}
+	// Buffered so the introspection never blocks on a result nobody waits
+	// for anymore
+	result := make(chan tablesResult, 1)
+	go func() {
+		var r tablesResult
+		defer func() {
+			if e := recover(); e != nil {
+				r = tablesResult{err: errors.Errorf("unable to fetch tables: %v", e)}
+			}
+			result <- r
+		}()
+
+		r.tables, r.err = assembleTables(c, schema, whitelist, blacklist, opts, p, stop)
+	}()
+
+	select {
+	case r := <-result:
+		return r.tables, r.err
+	case <-timer.C:
+		total := atomic.LoadInt32(&p.total)
+		if total < 0 {
+			return nil, errors.Wrapf(context.DeadlineExceeded, "%s of %s passed reading the table names", ConfigAssembleTimeout, opts.Timeout)
+		}
+		return nil, errors.Wrapf(context.DeadlineExceeded, "%s of %s passed with %d of %d tables introspected", ConfigAssembleTimeout, opts.Timeout, atomic.LoadInt32(&p.done), total)
+	}
+}
+
+// CloseConn closes the connection Assemble introspected the tables with,
+// err being the error Assemble returns. When TablesWithOptions gave up at the
+// deadline it closes it in the background instead of waiting: Close waits
+// for the queries still running, which were abandoned.
+func CloseConn(conn io.Closer, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		go conn.Close()
+		return nil
+	}
+	return conn.Close()
+}
+
+// tablesResult is the outcome of the introspection run by TablesWithOptions
+type tablesResult struct {
+	tables []Table
+	err    error
+}
+
+// progress counts the tables introspected so far, read and written
+// atomically. total is -1 until the table names are read.
+type progress struct {
+	total int32
+	done  int32
+}
+
+// assembleTables introspects the tables, stopping once stop is closed. p, when
+// not nil, is kept up to date as the tables are introspected.
+func assembleTables(c Constructor, schema string, whitelist, blacklist []string, opts AssembleOptions, p *progress, stop <-chan struct{}) ([]Table, error) {
 	var err error
 
 	names, err := c.TableNames(schema, whitelist, blacklist)
@@ -88,47 +393,374 @@ func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Tabl
 	}
 
 	sort.Strings(names)
+	if p != nil {
+		atomic.StoreInt32(&p.total, int32(len(names)))
+	}
 
 	var tables []Table
-	for _, name := range names {
-		t := Table{
-			Name: name,
+	if opts.Concurrency <= 1 && stop == nil {
+		for _, name := range names {
+			t, err := tableInfo(c, schema, name, whitelist, blacklist)
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, t)
 		}
+	} else if tables, err = tableInfos(c, schema, names, whitelist, blacklist, opts, p, stop); err != nil {
+		return nil, err
+	}
 
-		if t.Columns, err = c.Columns(schema, name, whitelist, blacklist); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table column info (%s)", name)
-		}
+	// Relationships have a dependency on foreign key nullability.
+	for i := range tables {
+		tbl := &tables[i]
+		setForeignKeyConstraints(tbl, tables)
+	}
+	for i := range tables {
+		tbl := &tables[i]
+		setRelationships(tbl, tables)
+	}
+
+	return tables, nil
+}
+
+// tableResult is a table introspected by a worker of tableInfos
+type tableResult struct {
+	index int
+	table Table
+	err   error
+}
 
-		for i, col := range t.Columns {
-			t.Columns[i] = c.TranslateColumnType(col)
+// tableInfos introspects the named tables in a pool of opts.Concurrency
+// workers. On an error it stops handing out tables and returns the error of
+// the first table in name order once the running ones are done. Once stop
+// is closed it returns at once, the running introspections finish in the
+// background without starting any other.
+func tableInfos(c Constructor, schema string, names, whitelist, blacklist []string, opts AssembleOptions, p *progress, stop <-chan struct{}) ([]Table, error) {
+	workers := opts.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan int)
+	defer close(jobs)
+	// Buffered so workers never block on a result nobody waits for anymore
+	results := make(chan tableResult, len(names))
+	for i := 0; i < workers && i < len(names); i++ {
+		go func() {
+			for index := range jobs {
+				t, err := safeTableInfo(c, schema, names[index], whitelist, blacklist)
+				results <- tableResult{index: index, table: t, err: err}
+			}
+		}()
+	}
+
+	tables := make([]Table, len(names))
+	next, running := 0, 0
+	failed := -1
+	var err error
+	for running > 0 || (next < len(names) && failed < 0) {
+		var send chan<- int
+		if next < len(names) && failed < 0 {
+			send = jobs
 		}
 
-		if t.PKey, err = c.PrimaryKeyInfo(schema, name); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table pkey info (%s)", name)
+		select {
+		case send <- next:
+			next++
+			running++
+		case r := <-results:
+			running--
+			if p != nil {
+				atomic.AddInt32(&p.done, 1)
+			}
+			tables[r.index] = r.table
+			if r.err != nil && (failed < 0 || r.index < failed) {
+				failed, err = r.index, r.err
+			}
+		case <-stop:
+			return nil, context.Canceled
 		}
+	}
 
-		if t.FKeys, err = c.ForeignKeyInfo(schema, name); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table fkey info (%s)", name)
+	if err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// safeTableInfo is tableInfo returning a panic as an error, a panic in a
+// worker would otherwise take the program down with it
+func safeTableInfo(c Constructor, schema, name string, whitelist, blacklist []string) (t Table, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("unable to fetch table info (%s): %v", name, r)
 		}
+	}()
 
-		filterForeignKeys(&t, whitelist, blacklist)
+	return tableInfo(c, schema, name, whitelist, blacklist)
+}
 
-		setIsJoinTable(&t)
+// tableInfo introspects a single table
+func tableInfo(c Constructor, schema, name string, whitelist, blacklist []string) (Table, error) {
+	var err error
+	t := Table{
+		Name: name,
+	}
 
-		tables = append(tables, t)
+	if t.Columns, err = c.Columns(schema, name, whitelist, blacklist); err != nil {
+		return t, errors.Wrapf(err, "unable to fetch table column info (%s)", name)
 	}
 
-	// Relationships have a dependency on foreign key nullability.
-	for i := range tables {
-		tbl := &tables[i]
-		setForeignKeyConstraints(tbl, tables)
+	for i, col := range t.Columns {
+		t.Columns[i] = c.TranslateColumnType(col)
+	}
+
+	if t.PKey, err = c.PrimaryKeyInfo(schema, name); err != nil {
+		return t, errors.Wrapf(err, "unable to fetch table pkey info (%s)", name)
+	}
+
+	if t.FKeys, err = c.ForeignKeyInfo(schema, name); err != nil {
+		return t, errors.Wrapf(err, "unable to fetch table fkey info (%s)", name)
+	}
+
+	if tc, ok := c.(TriggerConstructor); ok {
+		if t.Triggers, err = tc.Triggers(schema, name); err != nil {
+			return t, errors.Wrapf(err, "unable to fetch table trigger info (%s)", name)
+		}
 	}
+
+	if ic, ok := c.(IndexConstructor); ok {
+		if t.Indexes, err = ic.IndexInfo(schema, name); err != nil {
+			return t, errors.Wrapf(err, "unable to fetch table index info (%s)", name)
+		}
+	}
+
+	if cc, ok := c.(CheckConstraintConstructor); ok {
+		if t.CheckConstraints, err = cc.CheckConstraintInfo(schema, name); err != nil {
+			return t, errors.Wrapf(err, "unable to fetch table check constraint info (%s)", name)
+		}
+	}
+
+	filterForeignKeys(&t, whitelist, blacklist)
+
+	setIsJoinTable(&t)
+	setRowIDPKey(&t)
+
+	return t, nil
+}
+
+// ApplyConfig decorates the assembled tables with the driver agnostic
+// options from the config, drivers call it after Tables.
+func ApplyConfig(config Config, tables []Table) {
+	embed, _ := config.String(ConfigEmbedStruct)
+	locking, _ := config.StringSlice(ConfigOptimisticLocking)
+	money, _ := config.StringSlice(ConfigMoneyColumns)
+	goDefaults := config.DefaultBool(ConfigGoDefaults, false)
+	indexHints := config.DefaultBool(ConfigIndexHints, false)
+	softDelete := config.DefaultString(ConfigSoftDeleteColumn, "deleted_at")
+	softDeleteTables, _ := config.StringSlice(ConfigSoftDeleteTables)
+	softDeleteExclude, _ := config.StringSlice(ConfigSoftDeleteExclude)
+	readOnly, _ := config.StringSlice(ConfigReadOnlyTables)
 	for i := range tables {
-		tbl := &tables[i]
-		setRelationships(tbl, tables)
+		tables[i].EmbedStruct = embed
+		tables[i].ReadOnly = strmangle.SetInclude(tables[i].Name, readOnly)
+		tables[i].SoftDeleteColumn = ""
+		if (len(softDeleteTables) == 0 || strmangle.SetInclude(tables[i].Name, softDeleteTables)) &&
+			!strmangle.SetInclude(tables[i].Name, softDeleteExclude) {
+			tables[i].SoftDeleteColumn = softDeleteColumn(tables[i], softDelete)
+		}
+		tables[i].NoSoftDelete = len(tables[i].SoftDeleteColumn) == 0
+		tables[i].VersionColumn = ""
+		if strmangle.SetInclude(tables[i].Name, locking) {
+			tables[i].VersionColumn = versionColumn(tables[i])
+		}
+
+		if len(money) != 0 {
+			// Bare names are columns of every table here, there are no tables to list
+			setMoneyColumns(&tables[i], append(ColumnsFromList(money, tables[i].Name), TablesFromList(money)...))
+		}
+
+		if goDefaults {
+			for j, c := range tables[i].Columns {
+				tables[i].Columns[j].GoDefault = c.GoInitializer()
+			}
+		}
+
+		if indexHints {
+			setIndexHints(&tables[i])
+		}
 	}
 
-	return tables, nil
+	var rebuild bool
+	if config.DefaultBool(ConfigInferForeignKeys, false) {
+		for i := range tables {
+			inferForeignKeys(&tables[i], tables)
+		}
+		for i := range tables {
+			setIsJoinTable(&tables[i])
+		}
+		rebuild = true
+	}
+
+	if pattern, _ := config.StringSlice(ConfigPolymorphicPattern); len(pattern) == 2 {
+		for i := range tables {
+			setPolymorphicKeys(&tables[i], pattern[0], pattern[1])
+		}
+		rebuild = true
+	}
+
+	// Relationships are rebuilt with the foreign keys added or dropped above
+	if rebuild {
+		for i := range tables {
+			setForeignKeyConstraints(&tables[i], tables)
+			setRelationships(&tables[i], tables)
+		}
+	}
+}
+
+// softDeleteColumn returns name if the table has it as a null.Time
+// column, and empty otherwise.
+func softDeleteColumn(t Table, name string) string {
+	for _, c := range t.Columns {
+		if c.Name == name && c.Type == "null.Time" {
+			return c.Name
+		}
+	}
+
+	return ""
+}
+
+// inferForeignKeys adds a foreign key for each <name>_id column without one
+// when a table named <name>, or its plural, has a single column primary key
+// of the same type.
+func inferForeignKeys(t *Table, tables []Table) {
+Columns:
+	for _, c := range t.Columns {
+		if !strings.HasSuffix(c.Name, "_id") || len(c.Name) == len("_id") {
+			continue
+		}
+		for _, f := range t.FKeys {
+			if f.Column == c.Name {
+				continue Columns
+			}
+		}
+
+		name := strings.TrimSuffix(c.Name, "_id")
+		for _, foreign := range tables {
+			if foreign.Name != name && foreign.Name != strmangle.Plural(name) {
+				continue
+			}
+			if foreign.PKey == nil || len(foreign.PKey.Columns) != 1 {
+				continue
+			}
+
+			pkey := foreign.GetColumn(foreign.PKey.Columns[0])
+			if pkey.DBType != c.DBType {
+				continue
+			}
+
+			t.FKeys = append(t.FKeys, ForeignKey{
+				Table:          t.Name,
+				Name:           t.Name + "_" + c.Name + "_inferred_fkey",
+				Column:         c.Name,
+				ForeignTable:   foreign.Name,
+				ForeignColumn:  pkey.Name,
+				Columns:        []string{c.Name},
+				ForeignColumns: []string{pkey.Name},
+			})
+			break
+		}
+	}
+}
+
+// setIndexHints records on each column the indexes it's a key column of
+func setIndexHints(t *Table) {
+	for i := range t.Columns {
+		t.Columns[i].IndexHints = nil
+	}
+
+	for _, index := range t.Indexes {
+		for pos, name := range index.Columns {
+			for i := range t.Columns {
+				if t.Columns[i].Name == name {
+					t.Columns[i].IndexHints = append(t.Columns[i].IndexHints, IndexHint{
+						Name:    index.Name,
+						Leading: pos == 0,
+					})
+				}
+			}
+		}
+	}
+}
+
+// setPolymorphicKeys pairs up columns named <name><typeSuffix> and
+// <name><idSuffix> and drops any foreign key on the id column, since it
+// only points at one of the tables the id can refer to.
+func setPolymorphicKeys(t *Table, typeSuffix, idSuffix string) {
+	t.PolymorphicKeys = nil
+	for _, c := range t.Columns {
+		if !strings.HasSuffix(c.Name, typeSuffix) || len(c.Name) == len(typeSuffix) {
+			continue
+		}
+
+		name := strings.TrimSuffix(c.Name, typeSuffix)
+		for _, id := range t.Columns {
+			if id.Name == name+idSuffix {
+				t.PolymorphicKeys = append(t.PolymorphicKeys, PolymorphicKey{
+					Name:       name,
+					TypeColumn: c.Name,
+					IDColumn:   id.Name,
+				})
+			}
+		}
+	}
+
+	if len(t.PolymorphicKeys) == 0 {
+		return
+	}
+
+	var fkeys []ForeignKey
+	for _, fkey := range t.FKeys {
+		polymorphic := false
+		for _, p := range t.PolymorphicKeys {
+			if fkey.Column == p.IDColumn {
+				polymorphic = true
+			}
+		}
+		if !polymorphic {
+			fkeys = append(fkeys, fkey)
+		}
+	}
+	t.FKeys = fkeys
+}
+
+// setMoneyColumns switches the named decimal columns over to the
+// types.Money family, other column types are left alone.
+func setMoneyColumns(t *Table, names []string) {
+	for i, c := range t.Columns {
+		if !strmangle.SetInclude(c.Name, names) {
+			continue
+		}
+
+		switch c.Type {
+		case "types.Decimal":
+			t.Columns[i].Type = "types.Money"
+		case "types.NullDecimal":
+			t.Columns[i].Type = "types.NullMoney"
+		}
+	}
+}
+
+// versionColumn returns the first column the database generates
+// on every write (rowversion), or the empty string.
+func versionColumn(t Table) string {
+	for _, c := range t.Columns {
+		if c.AutoGenerated {
+			return c.Name
+		}
+	}
+
+	return ""
 }
 
 // filterForeignKeys filter FK whose ForeignTable is not in whitelist or in blacklist
@@ -145,7 +777,7 @@ func filterForeignKeys(t *Table, whitelist, blacklist []string) {
 
 // setIsJoinTable if there are:
 // A composite primary key involving two columns
-// Both primary key columns are also foreign keys
+// Both primary key columns are also single column foreign keys
 func setIsJoinTable(t *Table) {
 	if t.PKey == nil || len(t.PKey.Columns) != 2 || len(t.FKeys) < 2 || len(t.Columns) > 2 {
 		return
@@ -154,7 +786,7 @@ func setIsJoinTable(t *Table) {
 	for _, c := range t.PKey.Columns {
 		found := false
 		for _, f := range t.FKeys {
-			if c == f.Column {
+			if c == f.Column && !f.IsComposite() {
 				found = true
 				break
 			}
@@ -167,10 +799,48 @@ func setIsJoinTable(t *Table) {
 	t.IsJoinTable = true
 }
 
+// setRowIDPKey flags primary keys made of a single integer column
+// the database generates, ex: identity, auto_increment or serial.
+func setRowIDPKey(t *Table) {
+	if t.PKey == nil || len(t.PKey.Columns) != 1 {
+		return
+	}
+
+	for _, c := range t.Columns {
+		if c.Name != t.PKey.Columns[0] {
+			continue
+		}
+
+		switch c.Type {
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		default:
+			return
+		}
+
+		t.PKey.RowID = c.Default == "auto" || c.Default == "auto_increment" ||
+			strings.HasPrefix(c.Default, "nextval(")
+		return
+	}
+}
+
 func setForeignKeyConstraints(t *Table, tables []Table) {
 	for i, fkey := range t.FKeys {
-		localColumn := t.GetColumn(fkey.Column)
+		if len(fkey.Columns) == 0 {
+			t.FKeys[i].Columns = []string{fkey.Column}
+			t.FKeys[i].ForeignColumns = []string{fkey.ForeignColumn}
+		}
+
 		foreignTable := GetTable(tables, fkey.ForeignTable)
+		if fkey.IsComposite() {
+			// A composite key is null when any of its columns is
+			t.FKeys[i].Nullable = anyNullable(*t, fkey.Columns)
+			t.FKeys[i].Unique = t.IsUniqueKey(fkey.Columns)
+			t.FKeys[i].ForeignColumnNullable = anyNullable(foreignTable, fkey.ForeignColumns)
+			t.FKeys[i].ForeignColumnUnique = foreignTable.IsUniqueKey(fkey.ForeignColumns)
+			continue
+		}
+
+		localColumn := t.GetColumn(fkey.Column)
 		foreignColumn := foreignTable.GetColumn(fkey.ForeignColumn)
 
 		t.FKeys[i].Nullable = localColumn.Nullable
@@ -180,6 +850,17 @@ func setForeignKeyConstraints(t *Table, tables []Table) {
 	}
 }
 
+// anyNullable returns true if one of the named columns is nullable
+func anyNullable(t Table, cols []string) bool {
+	for _, c := range cols {
+		if t.GetColumn(c).Nullable {
+			return true
+		}
+	}
+
+	return false
+}
+
 func setRelationships(t *Table, tables []Table) {
 	t.ToOneRelationships = toOneRelationships(*t, tables)
 	t.ToManyRelationships = toManyRelationships(*t, tables)
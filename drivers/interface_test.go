@@ -1,7 +1,12 @@
 package drivers
 
 import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/volatiletech/strmangle"
 )
@@ -165,6 +170,548 @@ func TestTables(t *testing.T) {
 	}
 }
 
+// testSlowDriver takes longer on the tables that sort first, so they finish
+// last when introspected concurrently
+type testSlowDriver struct {
+	testMockDriver
+}
+
+func (m testSlowDriver) Columns(schema, tableName string, whitelist, blacklist []string) ([]Column, error) {
+	time.Sleep(time.Duration('z'-tableName[0]) * 100 * time.Microsecond)
+	return m.testMockDriver.Columns(schema, tableName, whitelist, blacklist)
+}
+
+func TestTablesWithOptionsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	want, err := Tables(testMockDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, concurrency := range []int{2, 4, 16} {
+		tables, err := TablesWithOptions(testSlowDriver{}, "public", nil, nil, AssembleOptions{Concurrency: concurrency})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tables, want) {
+			t.Errorf("concurrency %d: want the tables of a serial run", concurrency)
+		}
+	}
+}
+
+// testBlockedDriver blocks reading the columns of a table until unblock is
+// closed, counting the tables it started on
+type testBlockedDriver struct {
+	testMockDriver
+	started *int32
+	unblock chan struct{}
+}
+
+func (m testBlockedDriver) Columns(schema, tableName string, whitelist, blacklist []string) ([]Column, error) {
+	atomic.AddInt32(m.started, 1)
+	<-m.unblock
+	return m.testMockDriver.Columns(schema, tableName, whitelist, blacklist)
+}
+
+func TestTablesWithOptionsTimeout(t *testing.T) {
+	t.Parallel()
+
+	driver := testBlockedDriver{started: new(int32), unblock: make(chan struct{})}
+	defer close(driver.unblock)
+
+	start := time.Now()
+	_, err := TablesWithOptions(driver, "public", nil, nil, AssembleOptions{Timeout: 20 * time.Millisecond, Concurrency: 2})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("want the deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("want the introspection abandoned at the deadline, took %s", elapsed)
+	}
+	if started := atomic.LoadInt32(driver.started); started != 2 {
+		t.Errorf("want only the tables of the 2 workers started, got %d", started)
+	}
+}
+
+// testBlockedNamesDriver blocks reading the table names until unblock is
+// closed
+type testBlockedNamesDriver struct {
+	testMockDriver
+	unblock chan struct{}
+}
+
+func (m testBlockedNamesDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	<-m.unblock
+	return m.testMockDriver.TableNames(schema, whitelist, blacklist)
+}
+
+func TestTablesWithOptionsTimeoutTableNames(t *testing.T) {
+	t.Parallel()
+
+	driver := testBlockedNamesDriver{unblock: make(chan struct{})}
+	defer close(driver.unblock)
+
+	start := time.Now()
+	_, err := TablesWithOptions(driver, "public", nil, nil, AssembleOptions{Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("want the deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("want the table names abandoned at the deadline, took %s", elapsed)
+	}
+}
+
+func TestAssembleOptionsFromConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		config Config
+		want   AssembleOptions
+		err    bool
+	}{
+		{config: Config{}, want: AssembleOptions{Concurrency: 1}},
+		{config: Config{ConfigAssembleTimeout: "2m30s", ConfigAssembleConcurrency: 4}, want: AssembleOptions{Timeout: 150 * time.Second, Concurrency: 4}},
+		{config: Config{ConfigAssembleTimeout: float64(90)}, want: AssembleOptions{Timeout: 90 * time.Second, Concurrency: 1}},
+		{config: Config{ConfigAssembleTimeout: "soon"}, err: true},
+		{config: Config{ConfigAssembleConcurrency: -1}, err: true},
+	}
+
+	for i, test := range tests {
+		opts, err := AssembleOptionsFromConfig(test.config)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d) want an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+		}
+		if opts != test.want {
+			t.Errorf("%d) want %#v, got %#v", i, test.want, opts)
+		}
+	}
+}
+
+type testEngineVersionDriver struct {
+	version string
+	err     error
+}
+
+func (m testEngineVersionDriver) EngineVersion() (string, error) { return m.version, m.err }
+
+func TestEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	if got := EngineVersion(testEngineVersionDriver{version: "4.0.8876.1\n"}); got != "4.0.8876.1" {
+		t.Errorf("want the version trimmed, got: %q", got)
+	}
+	if got := EngineVersion(testEngineVersionDriver{err: errors.New("not supported")}); got != "" {
+		t.Errorf("want no version on an error, got: %q", got)
+	}
+	if got := EngineVersion(testMockDriver{}); got != "" {
+		t.Errorf("want no version for a driver without EngineVersion, got: %q", got)
+	}
+}
+
+type testCheckConstraintDriver struct {
+	testMockDriver
+}
+
+// CheckConstraintInfo returns a mock check constraint for the pilots table
+func (m testCheckConstraintDriver) CheckConstraintInfo(schema, tableName string) ([]CheckConstraint, error) {
+	return map[string][]CheckConstraint{
+		"pilots": {{Name: "ck_pilots_age", Column: "age", Expression: "([age]>=(0))"}},
+	}[tableName], nil
+}
+
+func TestTablesCheckConstraints(t *testing.T) {
+	t.Parallel()
+
+	tables, err := Tables(testCheckConstraintDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []CheckConstraint{{Name: "ck_pilots_age", Column: "age", Expression: "([age]>=(0))"}}
+	if pilots := GetTable(tables, "pilots"); !reflect.DeepEqual(pilots.CheckConstraints, want) {
+		t.Errorf("want %#v, got %#v", want, pilots.CheckConstraints)
+	}
+	if jets := GetTable(tables, "jets"); len(jets.CheckConstraints) != 0 {
+		t.Error("jets should not have check constraints:", jets.CheckConstraints)
+	}
+}
+
+type testTriggerDriver struct {
+	testMockDriver
+}
+
+// Triggers returns mock trigger names for the passed in table name
+func (m testTriggerDriver) Triggers(schema, tableName string) ([]string, error) {
+	return map[string][]string{
+		"jets": {"jets_audit"},
+	}[tableName], nil
+}
+
+func TestTablesTriggers(t *testing.T) {
+	t.Parallel()
+
+	tables, err := Tables(testTriggerDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jets := GetTable(tables, "jets")
+	if !jets.HasTriggers() {
+		t.Error("jets should have triggers")
+	}
+	if len(jets.Triggers) != 1 || jets.Triggers[0] != "jets_audit" {
+		t.Error("wrong triggers:", jets.Triggers)
+	}
+
+	if GetTable(tables, "pilots").HasTriggers() {
+		t.Error("pilots should not have triggers")
+	}
+
+	tables, err = Tables(testMockDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if GetTable(tables, "jets").HasTriggers() {
+		t.Error("drivers without trigger support should not report triggers")
+	}
+}
+
+type testCascadeDriver struct {
+	testMockDriver
+}
+
+// ForeignKeyInfo returns the mock foreign keys with licenses cascading
+func (m testCascadeDriver) ForeignKeyInfo(schema, tableName string) ([]ForeignKey, error) {
+	fkeys, err := m.testMockDriver.ForeignKeyInfo(schema, tableName)
+	for i := range fkeys {
+		if fkeys[i].Name == "licenses_pilot_id_fk" {
+			fkeys[i].OnDelete = "CASCADE"
+		}
+	}
+	return fkeys, err
+}
+
+func TestTablesOnDeleteCascade(t *testing.T) {
+	t.Parallel()
+
+	tables, err := Tables(testCascadeDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	licenses := GetTable(tables, "licenses")
+	if !licenses.FKeys[0].CascadesOnDelete() {
+		t.Error("licenses foreign key should cascade")
+	}
+
+	pilots := GetTable(tables, "pilots")
+	for _, rel := range pilots.ToManyRelationships {
+		if want := rel.ForeignTable == "licenses"; rel.OnDeleteCascade != want {
+			t.Errorf("%s relationship cascade should be %t", rel.ForeignTable, want)
+		}
+	}
+	for _, rel := range pilots.ToOneRelationships {
+		if rel.OnDeleteCascade {
+			t.Errorf("%s relationship should not cascade", rel.ForeignTable)
+		}
+	}
+}
+
+func TestApplyConfigEmbedStruct(t *testing.T) {
+	t.Parallel()
+
+	tables, err := Tables(testMockDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ApplyConfig(Config{ConfigEmbedStruct: "Base"}, tables)
+	for _, table := range tables {
+		if table.EmbedStruct != "Base" {
+			t.Errorf("%s: want embed directive, got: %q", table.Name, table.EmbedStruct)
+		}
+	}
+
+	ApplyConfig(Config{}, tables)
+	for _, table := range tables {
+		if len(table.EmbedStruct) != 0 {
+			t.Errorf("%s: want no embed directive, got: %q", table.Name, table.EmbedStruct)
+		}
+	}
+}
+
+func TestApplyConfigOptimisticLocking(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{Name: "one", Columns: []Column{{Name: "id"}, {Name: "version", AutoGenerated: true}}},
+		{Name: "two", Columns: []Column{{Name: "id"}, {Name: "version", AutoGenerated: true}}},
+		{Name: "three", Columns: []Column{{Name: "id"}}},
+	}
+
+	ApplyConfig(Config{ConfigOptimisticLocking: []interface{}{"one", "three"}}, tables)
+	if tables[0].VersionColumn != "version" {
+		t.Errorf("want version column, got: %q", tables[0].VersionColumn)
+	}
+	if len(tables[1].VersionColumn) != 0 {
+		t.Errorf("table not listed should not be locked, got: %q", tables[1].VersionColumn)
+	}
+	if len(tables[2].VersionColumn) != 0 {
+		t.Errorf("table without rowversion should not be locked, got: %q", tables[2].VersionColumn)
+	}
+}
+
+func TestApplyConfigReadOnlyTables(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{{Name: "countries"}, {Name: "users"}, {Name: "active_users", IsView: true}}
+
+	ApplyConfig(Config{ConfigReadOnlyTables: []interface{}{"countries"}}, tables)
+	if !tables[0].ReadOnly || !tables[0].IsReadOnly() {
+		t.Error("listed table should be read only")
+	}
+	if tables[1].IsReadOnly() {
+		t.Error("table not listed should be writable")
+	}
+	if tables[2].ReadOnly || !tables[2].IsReadOnly() {
+		t.Error("views are read only without being listed")
+	}
+}
+
+func TestDialectKeyParams(t *testing.T) {
+	t.Parallel()
+
+	if got := (Dialect{MaxParams: 2100}).KeyParams(); got != 2100-ParamsMargin {
+		t.Error("want the limit less the margin, got:", got)
+	}
+	if got := (Dialect{}).KeyParams(); got != 0 {
+		t.Error("want no limit, got:", got)
+	}
+}
+
+func TestApplyConfigSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{Name: "one", Columns: []Column{{Name: "deleted_at", Type: "null.Time", Nullable: true}}},
+		{Name: "two", Columns: []Column{{Name: "deleted_at", Type: "time.Time"}}},
+		{Name: "three", Columns: []Column{{Name: "removed_at", Type: "null.Time", Nullable: true}}},
+	}
+
+	ApplyConfig(Config{}, tables)
+	if !tables[0].CanSoftDelete() || tables[0].SoftDeleteColumn != "deleted_at" {
+		t.Errorf("want deleted_at soft delete column, got: %q", tables[0].SoftDeleteColumn)
+	}
+	if tables[1].CanSoftDelete() {
+		t.Error("non-nullable column should not soft delete")
+	}
+	if tables[2].CanSoftDelete() {
+		t.Error("other columns should not soft delete by default")
+	}
+
+	ApplyConfig(Config{ConfigSoftDeleteColumn: "removed_at"}, tables)
+	if tables[0].CanSoftDelete() {
+		t.Error("deleted_at should not soft delete once renamed")
+	}
+	if tables[2].SoftDeleteColumn != "removed_at" {
+		t.Errorf("want removed_at soft delete column, got: %q", tables[2].SoftDeleteColumn)
+	}
+
+	ApplyConfig(Config{ConfigSoftDeleteExclude: []interface{}{"one"}}, tables)
+	if tables[0].CanSoftDelete() {
+		t.Error("excluded table should not soft delete")
+	}
+
+	tables = append(tables, Table{Name: "four", Columns: tables[0].Columns})
+	ApplyConfig(Config{ConfigSoftDeleteTables: []interface{}{"four"}}, tables)
+	if tables[0].CanSoftDelete() || !tables[3].CanSoftDelete() {
+		t.Error("only listed tables should soft delete")
+	}
+}
+
+func TestApplyConfigMoneyColumns(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{Name: "products", Columns: []Column{
+			{Name: "price", Type: "types.Decimal"},
+			{Name: "discount", Type: "types.NullDecimal"},
+			{Name: "weight", Type: "types.Decimal"},
+		}},
+		{Name: "orders", Columns: []Column{
+			{Name: "price", Type: "types.NullDecimal"},
+			{Name: "discount", Type: "string"},
+		}},
+	}
+
+	ApplyConfig(Config{ConfigMoneyColumns: []interface{}{"price", "discount"}}, tables)
+
+	want := [][]string{
+		{"types.Money", "types.NullMoney", "types.Decimal"},
+		{"types.NullMoney", "string"},
+	}
+	for i, table := range tables {
+		for j, c := range table.Columns {
+			if c.Type != want[i][j] {
+				t.Errorf("%s.%s: want type %s, got: %s", table.Name, c.Name, want[i][j], c.Type)
+			}
+		}
+	}
+}
+
+func TestApplyConfigGoDefaults(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{{Name: "videos", Columns: []Column{{Name: "views", Type: "int", Default: "((0))"}}}}
+
+	ApplyConfig(Config{}, tables)
+	if got := tables[0].Columns[0].GoDefault; got != "" {
+		t.Error("go defaults should be opt-in, got:", got)
+	}
+
+	ApplyConfig(Config{ConfigGoDefaults: true}, tables)
+	if got := tables[0].Columns[0].GoDefault; got != "0" {
+		t.Error("want go default 0, got:", got)
+	}
+}
+
+func TestApplyConfigIndexHints(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{{
+		Name:    "videos",
+		Columns: []Column{{Name: "id"}, {Name: "user_id"}, {Name: "title"}},
+		Indexes: []Index{
+			{Name: "pk_videos", Columns: []string{"id"}, Unique: true},
+			{Name: "ix_videos_user", Columns: []string{"user_id", "id"}},
+		},
+	}}
+
+	ApplyConfig(Config{}, tables)
+	if hints := tables[0].Columns[0].IndexHints; hints != nil {
+		t.Error("index hints should be opt-in, got:", hints)
+	}
+
+	ApplyConfig(Config{ConfigIndexHints: true}, tables)
+	want := [][]IndexHint{
+		{{Name: "pk_videos", Leading: true}, {Name: "ix_videos_user"}},
+		{{Name: "ix_videos_user", Leading: true}},
+		nil,
+	}
+	for i, c := range tables[0].Columns {
+		if !reflect.DeepEqual(c.IndexHints, want[i]) {
+			t.Errorf("%s: want hints %#v, got: %#v", c.Name, want[i], c.IndexHints)
+		}
+	}
+}
+
+func TestApplyConfigInferForeignKeys(t *testing.T) {
+	t.Parallel()
+
+	newTables := func() []Table {
+		return []Table{
+			{
+				Name:    "users",
+				Columns: []Column{{Name: "id", DBType: "int"}},
+				PKey:    &PrimaryKey{Columns: []string{"id"}},
+			},
+			{
+				Name: "videos",
+				Columns: []Column{
+					{Name: "id", DBType: "int"},
+					{Name: "user_id", DBType: "int", Nullable: true},
+					{Name: "sponsor_id", DBType: "int"},
+				},
+				PKey: &PrimaryKey{Columns: []string{"id"}},
+			},
+		}
+	}
+
+	tables := newTables()
+	ApplyConfig(Config{}, tables)
+	if len(tables[1].FKeys) != 0 {
+		t.Error("foreign keys should only be inferred when enabled:", tables[1].FKeys)
+	}
+
+	tables = newTables()
+	ApplyConfig(Config{ConfigInferForeignKeys: true}, tables)
+
+	want := []ForeignKey{{
+		Table:          "videos",
+		Name:           "videos_user_id_inferred_fkey",
+		Column:         "user_id",
+		Nullable:       true,
+		ForeignTable:   "users",
+		ForeignColumn:  "id",
+		Columns:        []string{"user_id"},
+		ForeignColumns: []string{"id"},
+	}}
+	if !reflect.DeepEqual(tables[1].FKeys, want) {
+		t.Errorf("want inferred key %#v, got: %#v", want, tables[1].FKeys)
+	}
+	if len(tables[0].ToManyRelationships) != 1 || tables[0].ToManyRelationships[0].ForeignTable != "videos" {
+		t.Errorf("want a users to videos relationship, got: %#v", tables[0].ToManyRelationships)
+	}
+
+	// A declared key on the column is kept as is
+	tables = newTables()
+	declared := ForeignKey{Table: "videos", Name: "fk_videos_users", Column: "user_id", ForeignTable: "users", ForeignColumn: "id", Nullable: true,
+		Columns: []string{"user_id"}, ForeignColumns: []string{"id"}}
+	tables[1].FKeys = []ForeignKey{declared}
+	ApplyConfig(Config{ConfigInferForeignKeys: true}, tables)
+	if !reflect.DeepEqual(tables[1].FKeys, []ForeignKey{declared}) {
+		t.Errorf("want only the declared key, got: %#v", tables[1].FKeys)
+	}
+}
+
+func TestApplyConfigPolymorphicPattern(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{Name: "users", Columns: []Column{{Name: "id"}}},
+		{Name: "teams", Columns: []Column{{Name: "id"}}},
+		{
+			Name: "comments",
+			Columns: []Column{
+				{Name: "id"},
+				{Name: "owner_type"},
+				{Name: "owner_id"},
+				{Name: "author_id"},
+			},
+			FKeys: []ForeignKey{
+				{Name: "comments_owner_fkey", Table: "comments", Column: "owner_id", ForeignTable: "users", ForeignColumn: "id"},
+				{Name: "comments_author_fkey", Table: "comments", Column: "author_id", ForeignTable: "users", ForeignColumn: "id"},
+			},
+		},
+	}
+	for i := range tables {
+		setRelationships(&tables[i], tables)
+	}
+
+	ApplyConfig(Config{ConfigPolymorphicPattern: []interface{}{"_type", "_id"}}, tables)
+
+	comments := tables[2]
+	want := []PolymorphicKey{{Name: "owner", TypeColumn: "owner_type", IDColumn: "owner_id"}}
+	if !reflect.DeepEqual(comments.PolymorphicKeys, want) {
+		t.Errorf("want polymorphic keys %#v, got: %#v", want, comments.PolymorphicKeys)
+	}
+
+	if len(comments.FKeys) != 1 || comments.FKeys[0].Name != "comments_author_fkey" {
+		t.Errorf("want the polymorphic foreign key dropped, got: %#v", comments.FKeys)
+	}
+
+	users := tables[0]
+	if len(users.ToManyRelationships) != 1 || users.ToManyRelationships[0].Name != "comments_author_fkey" {
+		t.Errorf("want no relationship through the polymorphic key, got: %#v", users.ToManyRelationships)
+	}
+}
+
 func TestFilterForeignKeys(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +803,34 @@ func TestSetIsJoinTable(t *testing.T) {
 	}
 }
 
+func TestSetRowIDPKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Pkey    []string
+		Columns []Column
+		Should  bool
+	}{
+		{Pkey: []string{"id"}, Columns: []Column{{Name: "id", Type: "int", Default: "auto"}}, Should: true},
+		{Pkey: []string{"id"}, Columns: []Column{{Name: "id", Type: "int64", Default: "auto_increment"}}, Should: true},
+		{Pkey: []string{"id"}, Columns: []Column{{Name: "id", Type: "int", Default: "nextval('jets_id_seq'::regclass)"}}, Should: true},
+
+		{Pkey: []string{"id"}, Columns: []Column{{Name: "id", Type: "int"}}, Should: false},
+		{Pkey: []string{"id"}, Columns: []Column{{Name: "id", Type: "string", Default: "auto"}}, Should: false},
+		{Pkey: []string{"id"}, Columns: []Column{{Name: "id", Type: "int", Default: "42"}}, Should: false},
+		{Pkey: []string{"one", "two"}, Columns: []Column{{Name: "one", Type: "int", Default: "auto"}, {Name: "two", Type: "int"}}, Should: false},
+	}
+
+	for i, test := range tests {
+		table := Table{Columns: test.Columns, PKey: &PrimaryKey{Columns: test.Pkey}}
+
+		setRowIDPKey(&table)
+		if is := table.PKey.RowID; is != test.Should {
+			t.Errorf("%d) want: %t, got: %t\nTest: %#v", i, test.Should, is, test)
+		}
+	}
+}
+
 func TestSetForeignKeyConstraints(t *testing.T) {
 	t.Parallel()
 
@@ -1,11 +1,18 @@
 package drivers
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // PrimaryKey represents a primary key constraint in a database
 type PrimaryKey struct {
 	Name    string   `json:"name"`
 	Columns []string `json:"columns"`
+
+	// RowID is true when the key is a single integer identity column,
+	// making it an alias for the row like sqlite's rowid.
+	RowID bool `json:"row_id"`
 }
 
 // ForeignKey represents a foreign key constraint in a database
@@ -20,6 +27,85 @@ type ForeignKey struct {
 	ForeignColumn         string `json:"foreign_column"`
 	ForeignColumnNullable bool   `json:"foreign_column_nullable"`
 	ForeignColumnUnique   bool   `json:"foreign_column_unique"`
+
+	// Columns and ForeignColumns pair up every column of a composite
+	// key in order, Column and ForeignColumn are their first entries.
+	Columns        []string `json:"columns"`
+	ForeignColumns []string `json:"foreign_columns"`
+
+	// OnDelete is the referential action as reported by the database,
+	// ex: CASCADE or NO ACTION. Empty when the driver doesn't capture it.
+	OnDelete string `json:"on_delete"`
+}
+
+// IsComposite returns true if the key spans more than one column.
+func (f ForeignKey) IsComposite() bool {
+	return len(f.Columns) > 1
+}
+
+// CascadesOnDelete returns true if deleting the foreign row deletes
+// the rows referencing it.
+func (f ForeignKey) CascadesOnDelete() bool {
+	return strings.EqualFold(f.OnDelete, "CASCADE")
+}
+
+// SetsOnDelete returns true if deleting the foreign row sets the columns
+// referencing it to null or their default.
+func (f ForeignKey) SetsOnDelete() bool {
+	return strings.EqualFold(f.OnDelete, "SET NULL") || strings.EqualFold(f.OnDelete, "SET DEFAULT")
+}
+
+// PolymorphicKey is a pair of columns that together reference a row in one
+// of several tables, ex: owner_type names the table and owner_id the row.
+type PolymorphicKey struct {
+	Name       string `json:"name"`
+	TypeColumn string `json:"type_column"`
+	IDColumn   string `json:"id_column"`
+}
+
+// Index represents an index on a table, Filter is the predicate of a
+// partial (filtered) index and empty for regular ones.
+type Index struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique"`
+	Filter  string   `json:"filter"`
+}
+
+// CheckConstraint is a CHECK constraint of a table, Column is the column a
+// column level constraint is declared on and empty for table level ones.
+// Expression is the constraint's SQL as the database reports it.
+type CheckConstraint struct {
+	Name       string `json:"name"`
+	Column     string `json:"column"`
+	Expression string `json:"expression"`
+}
+
+// IndexHint marks a column as part of an index, see ConfigIndexHints.
+// Leading is true when the column is the first key column of the index, so
+// a filter on the column alone can seek it.
+type IndexHint struct {
+	Name    string `json:"name" toml:"name"`
+	Leading bool   `json:"leading" toml:"leading"`
+}
+
+// Identity describes a table's identity column, Current is the last value
+// it generated and nil before the first insert.
+type Identity struct {
+	Column    string `json:"column"`
+	Seed      int64  `json:"seed"`
+	Increment int64  `json:"increment"`
+	Current   *int64 `json:"current"`
+}
+
+// Next returns the value the identity generates on the next insert,
+// ex: so fixtures can pick keys that won't collide with it.
+func (i Identity) Next() int64 {
+	if i.Current == nil {
+		return i.Seed
+	}
+
+	return *i.Current + i.Increment
 }
 
 // SQLColumnDef formats a column name and type like an SQL column definition.
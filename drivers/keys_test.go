@@ -64,3 +64,18 @@ func TestNames(t *testing.T) {
 		t.Error("wrong type:", ret[1])
 	}
 }
+
+func TestIdentityNext(t *testing.T) {
+	t.Parallel()
+
+	identity := Identity{Seed: 100, Increment: 5}
+	if got := identity.Next(); got != 100 {
+		t.Error("want the seed before the first insert, got:", got)
+	}
+
+	current := int64(120)
+	identity.Current = &current
+	if got := identity.Next(); got != 125 {
+		t.Error("want current plus increment, got:", got)
+	}
+}
@@ -45,6 +45,7 @@ func (m *MockDriver) Imports() (importers.Collection, error) {
 // Assemble the DBInfo
 func (m *MockDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, err error) {
 	dbinfo = &drivers.DBInfo{
+		SchemaQuery: "SELECT table_name, column_name, is_nullable FROM information_schema.columns WHERE table_schema = $1",
 		Dialect: drivers.Dialect{
 			LQ: '"',
 			RQ: '"',
@@ -65,15 +66,56 @@ func (m *MockDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, er
 	schema := config.MustString(drivers.ConfigSchema)
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+	assembleOpts, err := drivers.AssembleOptionsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
 
-	dbinfo.Tables, err = drivers.Tables(m, schema, whitelist, blacklist)
+	dbinfo.Schema = schema
+	dbinfo.Dialect.UseSchema = config.DefaultBool(drivers.ConfigUseSchema, false)
+
+	dbinfo.Tables, err = drivers.TablesWithOptions(m, schema, whitelist, blacklist, assembleOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	drivers.ApplyConfig(config, dbinfo.Tables)
+	dbinfo.EngineVersion = drivers.EngineVersion(m)
+
+	if dbinfo.Functions, err = drivers.Functions(m, schema); err != nil {
+		return nil, err
+	}
+
 	return dbinfo, err
 }
 
+// EngineVersion returns a mock engine version
+func (m *MockDriver) EngineVersion() (string, error) {
+	return "mock 1.0", nil
+}
+
+// FunctionInfo returns a mock scalar and table valued function
+func (m *MockDriver) FunctionInfo(schema string) ([]drivers.Function, error) {
+	return []drivers.Function{
+		{
+			Name:       "pilot_jet_count",
+			Params:     []drivers.FunctionParam{{Name: "pilot_id", Type: "int", DBType: "integer"}},
+			ReturnType: "int",
+		},
+		{
+			Name: "jets_for_pilot",
+			Params: []drivers.FunctionParam{
+				{Name: "pilot_id", Type: "int", DBType: "integer"},
+				{Name: "name", Type: "string", DBType: "character"},
+			},
+			Columns: []drivers.Column{
+				{Name: "id", Type: "int", DBType: "integer"},
+				{Name: "name", Type: "string", DBType: "character"},
+			},
+		},
+	}, nil
+}
+
 // TableNames returns a list of mock table names
 func (m *MockDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
 	if len(whitelist) > 0 {
@@ -98,12 +140,12 @@ func (m *MockDriver) Columns(schema, tableName string, whitelist, blacklist []st
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "pilot_id", Type: "int", DBType: "integer", Nullable: true, Unique: true},
 			{Name: "airport_id", Type: "int", DBType: "integer"},
-			{Name: "name", Type: "string", DBType: "character", Nullable: false},
-			{Name: "color", Type: "null.String", DBType: "character", Nullable: true},
+			{Name: "name", Type: "string", DBType: "character", FullDBType: "character(64)", Nullable: false},
+			{Name: "color", Type: "null.String", DBType: "character", FullDBType: "character(16)", Nullable: true},
 			{Name: "uuid", Type: "string", DBType: "uuid", Nullable: true},
 			{Name: "identifier", Type: "string", DBType: "uuid", Nullable: false},
-			{Name: "cargo", Type: "[]byte", DBType: "bytea", Nullable: false},
-			{Name: "manifest", Type: "[]byte", DBType: "bytea", Nullable: true, Unique: true},
+			{Name: "cargo", Type: "[]byte", DBType: "bytea", FullDBType: "bytea(16)", Nullable: false},
+			{Name: "manifest", Type: "[]byte", DBType: "bytea", FullDBType: "bytea(32)", Nullable: true, Unique: true},
 		},
 		"licenses": {
 			{Name: "id", Type: "int", DBType: "integer"},
@@ -1,9 +1,16 @@
 package drivers
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
 
-// registeredDrivers are all the drivers which are currently registered
-var registeredDrivers = map[string]Interface{}
+var (
+	// registeredDrivers are all the drivers which are currently registered
+	registeredDrivers = map[string]Interface{}
+	registeredMut     sync.RWMutex
+)
 
 // RegisterBinary is used to register drivers that are binaries.
 // Panics if a driver with the same name has been previously loaded.
@@ -20,14 +27,40 @@ func RegisterFromInit(name string, driver Interface) {
 
 // GetDriver retrieves the driver by name
 func GetDriver(name string) Interface {
-	if d, ok := registeredDrivers[name]; ok {
+	if d, ok := Get(name); ok {
 		return d
 	}
 
 	panic(fmt.Sprintf("drivers: sqlboiler driver %s has not been registered", name))
 }
 
+// Get retrieves the driver by name, ok is false if it hasn't been registered
+func Get(name string) (driver Interface, ok bool) {
+	registeredMut.RLock()
+	defer registeredMut.RUnlock()
+
+	driver, ok = registeredDrivers[name]
+	return driver, ok
+}
+
+// Registered returns the names of all registered drivers in sorted order
+func Registered() []string {
+	registeredMut.RLock()
+	defer registeredMut.RUnlock()
+
+	names := make([]string, 0, len(registeredDrivers))
+	for name := range registeredDrivers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 func register(name string, driver Interface) {
+	registeredMut.Lock()
+	defer registeredMut.Unlock()
+
 	if _, ok := registeredDrivers[name]; ok {
 		panic(fmt.Sprintf("drivers: sqlboiler driver %s already loaded", name))
 	}
@@ -96,3 +96,32 @@ func TestReregister(t *testing.T) {
 		t.Error("expected to recover from a panic")
 	}
 }
+
+func TestRegistered(t *testing.T) {
+	one, two := testRegistrationDriver{}, binaryDriver("/bin/true")
+	RegisterFromInit("mock5", one)
+	RegisterFromInit("mock6", two)
+
+	names := Registered()
+	for _, name := range []string{"mock5", "mock6"} {
+		found := false
+		for _, n := range names {
+			if n == name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s missing from registered drivers: %v", name, names)
+		}
+	}
+
+	if d, ok := Get("mock5"); !ok || d != one {
+		t.Error("got the wrong driver back:", d, ok)
+	}
+	if d, ok := Get("mock6"); !ok || d != two {
+		t.Error("got the wrong driver back:", d, ok)
+	}
+	if _, ok := Get("notpresentdriver"); ok {
+		t.Error("driver should not be found")
+	}
+}
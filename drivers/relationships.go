@@ -16,6 +16,15 @@ type ToOneRelationship struct {
 	ForeignColumn         string `json:"foreign_column"`
 	ForeignColumnNullable bool   `json:"foreign_column_nullable"`
 	ForeignColumnUnique   bool   `json:"foreign_column_unique"`
+
+	// Columns and ForeignColumns pair up every column of a composite
+	// foreign key, Column and ForeignColumn are their first entries.
+	Columns        []string `json:"columns"`
+	ForeignColumns []string `json:"foreign_columns"`
+
+	// OnDeleteCascade is true when deleting the local row also deletes
+	// the foreign row through an ON DELETE CASCADE foreign key.
+	OnDeleteCascade bool `json:"on_delete_cascade"`
 }
 
 // ToManyRelationship describes a relationship between two tables where the
@@ -46,6 +55,17 @@ type ToManyRelationship struct {
 	JoinForeignColumn         string `json:"join_foreign_column"`
 	JoinForeignColumnNullable bool   `json:"join_foreign_column_nullable"`
 	JoinForeignColumnUnique   bool   `json:"join_foreign_column_unique"`
+
+	// Columns and ForeignColumns pair up every column of a composite
+	// foreign key, Column and ForeignColumn are their first entries.
+	// Keys through a join table always have a single column.
+	Columns        []string `json:"columns"`
+	ForeignColumns []string `json:"foreign_columns"`
+
+	// OnDeleteCascade is true when deleting the local row also deletes
+	// the foreign rows, or the join table rows for many-to-many relationships,
+	// through an ON DELETE CASCADE foreign key.
+	OnDeleteCascade bool `json:"on_delete_cascade"`
 }
 
 // ToOneRelationships relationship lookups
@@ -103,9 +123,24 @@ func buildToOneRelationship(localTable Table, foreignKey ForeignKey, foreignTabl
 		ForeignColumn:         foreignKey.Column,
 		ForeignColumnNullable: foreignKey.Nullable,
 		ForeignColumnUnique:   foreignKey.Unique,
+
+		Columns:        foreignKey.ForeignColumns,
+		ForeignColumns: foreignKey.Columns,
+
+		OnDeleteCascade: foreignKey.CascadesOnDelete(),
 	}
 }
 
+// IsComposite returns true if the relationship's key spans more than one column.
+func (r ToOneRelationship) IsComposite() bool {
+	return len(r.Columns) > 1
+}
+
+// IsComposite returns true if the relationship's key spans more than one column.
+func (r ToManyRelationship) IsComposite() bool {
+	return len(r.Columns) > 1
+}
+
 func buildToManyRelationship(localTable Table, foreignKey ForeignKey, foreignTable Table, tables []Table) ToManyRelationship {
 	if !foreignTable.IsJoinTable {
 		return ToManyRelationship{
@@ -118,7 +153,10 @@ func buildToManyRelationship(localTable Table, foreignKey ForeignKey, foreignTab
 			ForeignColumn:         foreignKey.Column,
 			ForeignColumnNullable: foreignKey.Nullable,
 			ForeignColumnUnique:   foreignKey.Unique,
+			Columns:               foreignKey.ForeignColumns,
+			ForeignColumns:        foreignKey.Columns,
 			ToJoinTable:           false,
+			OnDeleteCascade:       foreignKey.CascadesOnDelete(),
 		}
 	}
 
@@ -135,6 +173,10 @@ func buildToManyRelationship(localTable Table, foreignKey ForeignKey, foreignTab
 		JoinLocalColumn:         foreignKey.Column,
 		JoinLocalColumnNullable: foreignKey.Nullable,
 		JoinLocalColumnUnique:   foreignKey.Unique,
+
+		Columns: foreignKey.ForeignColumns,
+
+		OnDeleteCascade: foreignKey.CascadesOnDelete(),
 	}
 
 	for _, fk := range foreignTable.FKeys {
@@ -151,6 +193,7 @@ func buildToManyRelationship(localTable Table, foreignKey ForeignKey, foreignTab
 		relationship.ForeignColumn = fk.ForeignColumn
 		relationship.ForeignColumnNullable = fk.ForeignColumnNullable
 		relationship.ForeignColumnUnique = fk.ForeignColumnUnique
+		relationship.ForeignColumns = fk.ForeignColumns
 	}
 
 	return relationship
@@ -315,3 +315,48 @@ func TestToManyRelationshipsNull(t *testing.T) {
 		}
 	}
 }
+
+func TestCompositeRelationships(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{
+			Name:    "orders",
+			Columns: []Column{{Name: "region"}, {Name: "number"}},
+			PKey:    &PrimaryKey{Columns: []string{"region", "number"}},
+		},
+		{
+			Name:    "order_lines",
+			Columns: []Column{{Name: "id"}, {Name: "order_region"}, {Name: "order_number", Nullable: true}},
+			PKey:    &PrimaryKey{Columns: []string{"id"}},
+			FKeys: []ForeignKey{{
+				Table: "order_lines", Name: "fk_order_lines_orders",
+				Column: "order_region", ForeignTable: "orders", ForeignColumn: "region",
+				Columns:        []string{"order_region", "order_number"},
+				ForeignColumns: []string{"region", "number"},
+			}},
+		},
+	}
+
+	for i := range tables {
+		setForeignKeyConstraints(&tables[i], tables)
+	}
+	for i := range tables {
+		setRelationships(&tables[i], tables)
+	}
+
+	fkey := tables[1].FKeys[0]
+	if !fkey.Nullable || fkey.Unique || fkey.ForeignColumnNullable || !fkey.ForeignColumnUnique {
+		t.Errorf("wrong composite key constraints: %#v", fkey)
+	}
+
+	rels := tables[0].ToManyRelationships
+	if len(rels) != 1 {
+		t.Fatalf("want one relationship for the composite key, got: %#v", rels)
+	}
+	if rel := rels[0]; !rel.IsComposite() ||
+		!reflect.DeepEqual(rel.Columns, []string{"region", "number"}) ||
+		!reflect.DeepEqual(rel.ForeignColumns, []string{"order_region", "order_number"}) {
+		t.Errorf("want both column pairs, got: %v -> %v", rel.Columns, rel.ForeignColumns)
+	}
+}
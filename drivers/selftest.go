@@ -0,0 +1,95 @@
+package drivers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// SelfTester is implemented by drivers that can connect and run each of
+// their introspection queries on their own. This helps tell apart database
+// engine incompatibilities from problems in the generation itself.
+type SelfTester interface {
+	SelfTest(config Config) (SelfTestReport, error)
+}
+
+// SelfTestResult is the outcome of a single introspection method
+type SelfTestResult struct {
+	Method string `json:"method"`
+	Error  string `json:"error,omitempty"`
+}
+
+// SelfTestReport is the outcome of each introspection method in the order
+// they were run
+type SelfTestReport []SelfTestResult
+
+// Failed returns true if any of the methods failed
+func (s SelfTestReport) Failed() bool {
+	for _, r := range s {
+		if len(r.Error) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String formats the report with a line per method
+func (s SelfTestReport) String() string {
+	buf := &strings.Builder{}
+	for _, r := range s {
+		if len(r.Error) == 0 {
+			fmt.Fprintf(buf, "ok   %s\n", r.Method)
+		} else {
+			fmt.Fprintf(buf, "FAIL %s: %s\n", r.Method, r.Error)
+		}
+	}
+
+	return buf.String()
+}
+
+// SelfTest runs each of the Constructor's introspection queries against
+// tableName, or the first table found when tableName is empty. Unlike Tables
+// it carries on when a query fails so every method is reported.
+func SelfTest(c Constructor, schema, tableName string) SelfTestReport {
+	var report SelfTestReport
+	add := func(method string, err error) {
+		result := SelfTestResult{Method: method}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		report = append(report, result)
+	}
+
+	names, err := c.TableNames(schema, nil, nil)
+	if err == nil && len(tableName) == 0 {
+		if len(names) == 0 {
+			err = errors.New("no tables found to test against")
+		} else {
+			tableName = names[0]
+		}
+	}
+	add("TableNames", err)
+	if len(tableName) == 0 {
+		return report
+	}
+
+	_, err = c.Columns(schema, tableName, nil, nil)
+	add("Columns", err)
+	_, err = c.PrimaryKeyInfo(schema, tableName)
+	add("PrimaryKeyInfo", err)
+	_, err = c.ForeignKeyInfo(schema, tableName)
+	add("ForeignKeyInfo", err)
+
+	if tc, ok := c.(TriggerConstructor); ok {
+		_, err = tc.Triggers(schema, tableName)
+		add("Triggers", err)
+	}
+	if cc, ok := c.(CheckConstraintConstructor); ok {
+		_, err = cc.CheckConstraintInfo(schema, tableName)
+		add("CheckConstraintInfo", err)
+	}
+
+	return report
+}
@@ -0,0 +1,59 @@
+package drivers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type testBrokenFKeyDriver struct {
+	testMockDriver
+}
+
+func (m testBrokenFKeyDriver) ForeignKeyInfo(schema, tableName string) ([]ForeignKey, error) {
+	return nil, errors.New("unsupported catalog view")
+}
+
+func TestSelfTest(t *testing.T) {
+	t.Parallel()
+
+	report := SelfTest(testBrokenFKeyDriver{}, "public", "jets")
+	if !report.Failed() {
+		t.Error("report should have failed")
+	}
+
+	want := []string{"TableNames", "Columns", "PrimaryKeyInfo", "ForeignKeyInfo"}
+	if len(report) != len(want) {
+		t.Fatalf("want %d results, got: %#v", len(want), report)
+	}
+
+	for i, r := range report {
+		if r.Method != want[i] {
+			t.Errorf("%d) want method %s, got %s", i, want[i], r.Method)
+		}
+
+		if r.Method == "ForeignKeyInfo" {
+			if r.Error != "unsupported catalog view" {
+				t.Error("wrong error:", r.Error)
+			}
+		} else if len(r.Error) != 0 {
+			t.Errorf("%s should have succeeded: %s", r.Method, r.Error)
+		}
+	}
+
+	if out := report.String(); !strings.Contains(out, "FAIL ForeignKeyInfo: unsupported catalog view") {
+		t.Error("wrong output:", out)
+	}
+}
+
+func TestSelfTestTriggers(t *testing.T) {
+	t.Parallel()
+
+	report := SelfTest(testTriggerDriver{}, "public", "")
+	if report.Failed() {
+		t.Error("report should not have failed:", report)
+	}
+	if len(report) != 5 || report[4].Method != "Triggers" {
+		t.Errorf("want triggers to be tested: %#v", report)
+	}
+}
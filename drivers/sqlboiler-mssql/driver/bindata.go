@@ -1,10 +1,13 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// override/templates/17_upsert.go.tpl (5.921kB)
-// override/templates/singleton/mssql_upsert.go.tpl (1.385kB)
+// override/templates/16_update_optimistic.go.tpl (5.142kB)
+// override/templates/17_upsert.go.tpl (7.571kB)
+// override/templates/singleton/mssql_optimistic.go.tpl (226B)
+// override/templates/singleton/mssql_upsert.go.tpl (1.603kB)
 // override/templates_test/singleton/mssql_main_test.go.tpl (3.945kB)
-// override/templates_test/singleton/mssql_suites_test.go.tpl (255B)
-// override/templates_test/upsert.go.tpl (1.723kB)
+// override/templates_test/singleton/mssql_suites_test.go.tpl (567B)
+// override/templates_test/update_optimistic.go.tpl (2.757kB)
+// override/templates_test/upsert.go.tpl (1.897kB)
 
 package driver
 
@@ -24,7 +27,7 @@ import (
 func bindataRead(data []byte, name string) ([]byte, error) {
 	gz, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 
 	var buf bytes.Buffer
@@ -32,7 +35,7 @@ func bindataRead(data []byte, name string) ([]byte, error) {
 	clErr := gz.Close()
 
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 	if clErr != nil {
 		return nil, err
@@ -73,7 +76,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\xdb\xc8\x11\x7f\x26\x3f\xc5\xc4\x28\x2e\x64\xab\xd0\xed\xab\x0b\x3d\xd8\x49\x2e\x35\xee\xec\xea\xa2\x4b\x03\xd4\x30\x8c\x15\x39\x94\x16\x5e\xed\x32\xcb\xa5\x64\x95\xe5\x77\x2f\x66\x77\x29\x92\xb2\x64\xcb\xb9\x73\x71\x0f\x81\x45\xee\x70\xe6\x37\xbf\xf9\xb7\x93\xba\x7e\x07\x7f\x62\x82\xb3\x12\xce\xc6\x90\x9c\xd3\x2f\x2c\x93\x5f\xd9\x4c\x20\xb8\x3f\xc9\x35\x5b\x62\xd3\x84\x56\xb4\x4c\x17\xb8\x64\xee\x98\x3e\xe8\x24\xe0\xbf\x90\x4c\xbb\x53\xfb\x01\xcf\x21\x39\xcf\xb2\x4f\x42\xcd\x98\x80\x77\x4d\x13\x9e\x9e\xc2\x97\xa2\x44\x6d\x3e\x01\x33\x06\x97\x85\x29\x81\x49\xe0\x92\xde\x8d\x80\xc9\x0c\x32\x85\xf6\x5d\x55\x64\xcc\x20\x28\x0d\x7c\x2e\x95\x46\x50\x12\x52\x25\x73\xc1\x53\x93\x84\x79\x25\x53\x88\x14\xfc\xb9\xae\x1d\xfe\xe4\x4b\x31\xe5\x72\x5e\x09\xa6\x9b\x26\x6e\xad\x44\x16\x84\x54\x06\x92\x6b\xf5\x5e\x49\x83\x0f\xa6\x69\x52\xf3\x40\xaa\xe8\x21\xf1\x2f\x47\x50\xd7\x28\x33\x02\xe9\x2d\xbf\x57\xa2\x5a\xca\x72\xe4\xc1\xf9\x47\x98\x29\x2e\x12\xff\x10\x03\x6a\xad\x34\xd4\x61\xa0\xd1\x54\x5a\x82\x4a\x9c\x61\x67\xb7\x6f\xd3\x7e\xf7\x09\xcd\x87\x8b\x28\xae\x6b\x14\x25\x5a\x1c\x23\x68\x0f\xbc\xa4\x3f\x97\x59\xd3\x8c\x9e\x44\x12\x87\x4d\x18\x6e\x41\x87\x8e\x6e\x22\xb0\x47\x39\xfd\x9c\x30\xc9\xd3\x1d\xf2\x27\xbf\x8d\x7d\xb0\x3a\x4b\x7a\x67\x09\x38\x3a\x1c\x93\xd7\x8e\x47\x1d\x06\x3c\x27\x50\x94\x9d\xff\xcf\x60\xfc\xdd\x1a\x7d\x33\x06\xc9\x05\xa1\x08\x0a\xa2\x28\xb2\xfa\xbe\x6a\x56\x7c\xd4\x3a\x42\xad\xe3\x38\x0c\x9a\x7d\x81\x3b\x10\xa9\x7d\x81\x82\xaa\xe4\x72\x4e\xcf\xf8\x80\x69\x65\x94\x7e\x49\xe1\xf4\x54\x17\xdf\x17\xc5\xc9\x63\x3e\x09\x88\xe3\xee\xa3\x87\xd4\x63\xf5\x71\x68\x3b\x71\xff\xaa\xf7\xd5\xf3\x5c\x1f\x1f\xf2\x3d\x79\xd6\xcf\x2b\x82\xf1\x7a\x61\xdd\x12\xfd\xbb\x87\xf0\xb8\x30\xfd\xb1\xa2\xb4\x6d\x94\x3c\x07\x05\xe3\x8e\x50\xdf\x38\xed\x79\x99\x5c\xe3\x3a\x3a\xa9\xeb\x64\x72\x3f\x77\x63\xe7\x0c\xa4\x82\xba\x1e\x8c\x22\x28\xb4\x5a\xf1\x0c\x33\xc8\x95\x86\xca\x7a\x7b\x62\x23\x10\x06\x34\xa5\x88\x6d\x41\xfc\x9d\x18\xbe\xc4\xd2\xb0\x65\x71\xe7\xa4\xee\x16\x28\x0a\xd4\x27\x90\x40\xe3\xa4\xbb\x2c\xf9\x87\x52\xf7\xa5\x0d\xdd\x20\x9f\x32\x75\x81\xb9\xd2\xe8\x48\xb5\x42\x47\x27\xd7\xe3\xf4\xe9\xbc\x25\xb8\x16\xad\xe5\x32\x0c\x03\xf9\x9f\x0f\x98\xb3\x4a\x18\x3b\x8a\xbf\x55\xa8\x39\x96\xc9\xb5\x92\xff\x46\xad\xfc\xd1\x14\x29\xac\x3e\xe8\x1f\xd4\x5a\x76\x61\xf7\x4c\x7f\xe5\x66\xe1\x85\x47\xa0\xe2\x30\x0c\x4e\x4f\xe1\xa2\xe2\x22\x83\x94\xa5\x0b\x84\x7b\xdc\x00\x97\xef\x04\x97\x08\xd5\x5c\x70\xb1\x81\x77\xb0\xdc\x94\xdf\x04\xac\x4a\x28\xe8\x6f\xa1\xd5\x4c\xe0\xb2\x0c\x83\x59\x95\x13\x98\xd2\xe8\x25\x93\x73\x81\xd4\x1a\x2f\xaa\x3c\x47\x1d\xc5\xf6\x34\xf9\xaa\xb9\xc1\xa9\xd1\x5c\xce\xa3\xd2\xe8\x54\xc9\x55\x72\x69\x14\x8b\x06\xb9\x91\xfc\xc4\x65\x46\x45\x42\x01\xbb\x1b\x41\x4a\x5a\x35\x93\x73\x1c\xe6\x10\xe5\x4b\x69\x89\xda\xd5\x9d\xda\xf8\x76\xaf\x2f\x36\x06\xa3\xb7\xc9\xdb\xe7\x60\x0c\x72\xf2\x09\x18\x43\xb9\xef\x81\xf1\x58\x67\x2f\xa2\x4f\xe8\xa2\x80\x9c\x8d\x81\x4e\xfd\x41\x1c\x06\x1d\xe3\x93\xaa\x65\x7c\x56\xe5\xb1\xcd\xd9\xbd\xf1\x77\xf9\xf9\x9e\x62\x7c\x55\x99\xe4\xf3\xcf\x2a\xbd\x27\x4d\x36\xea\x23\x17\xfc\x8c\x0c\x3d\xff\xfd\xcd\x3d\x6e\x6e\x8f\x36\xf4\x45\x0a\x67\x2a\x0c\x56\x4c\xdb\x84\xb7\xc5\x1c\xda\x3a\x7a\xe3\x0d\x13\x01\xed\x3d\x43\xa3\x21\x20\x43\xca\x2f\x7b\x4f\x94\xe6\x61\x10\x1c\x42\x70\x2e\x44\xdb\x73\x9e\x90\xda\x53\x10\xc7\x49\xab\xca\xf4\x3f\xe8\xa2\x48\x8f\xf1\xd6\x0f\xe8\xd7\xc5\x94\xae\x0c\xcb\x42\xe0\x12\xa5\x89\x5a\x47\x9f\xb7\x75\x5e\x19\x45\x2a\x29\x79\xf8\x08\x56\xbb\x09\x69\x79\x23\x1e\x3b\x53\xd4\x70\x18\x97\xe5\xb9\xdc\x1c\xea\x05\x13\xcd\x97\x4c\x6f\x7e\xc2\xcd\xb6\x37\xaf\x62\xf8\xe1\x87\x97\x69\xd9\xd7\x51\x56\xb1\x43\xd4\x71\xc0\x8a\x02\x65\xe6\x5d\xbe\x39\xe3\xb7\xed\x1c\xb8\xe1\x7f\xf9\xdb\xd9\x6d\x92\x24\xe4\x1f\x25\xba\xfd\xc7\x73\x10\x28\xbd\x78\x4c\x83\xe0\xaf\x4e\xe3\xb3\x73\xa0\x92\x76\xeb\x30\xca\x77\xfc\xdd\xa9\x30\x82\x54\x55\x22\xb3\x7d\x79\x66\x1b\x9e\xc7\x98\x5a\x3f\x40\xf0\xd2\x4e\x09\x3b\x26\xc8\xdc\x6e\x00\xaf\x50\xcf\x31\xd2\xf8\xa2\xc0\xfd\x56\x3d\x9e\x59\xaa\x9e\xc0\x4f\xfd\xb3\xf1\x4e\x53\xfc\xd2\x7b\xfa\x5d\x4a\xe3\x71\x7e\xf8\xcc\xf6\x08\x0e\x67\xb6\x13\x38\x9e\x20\x17\xf1\x37\x43\x7f\x2e\xcb\x6b\x25\x31\xb2\x19\x49\xc9\xe0\x4e\x5f\x39\x19\xbc\x6b\x7b\x93\xc1\xf6\xa8\x84\x46\xee\x06\xc6\x4e\xde\x75\xb9\x5f\xe8\xd5\xd5\x74\xfa\xcb\xcf\x51\xc6\x99\xc0\xd4\x8c\xe0\xa4\xae\xfb\x6b\x70\xd3\x9c\x1c\xa4\x63\x4f\x1d\xb6\x04\xf6\x7a\xa1\x65\x69\xbd\xe0\x06\x09\x15\x85\x7f\xc9\xee\x31\xba\xb9\x2d\xed\x38\x18\x59\x8e\x8e\xb5\x40\xd3\x2d\x48\x55\xb1\x89\xb6\x1a\x8f\x87\x17\x0f\x80\x6c\x6b\xbb\xa7\xc9\xc1\xf7\x45\xfd\xb4\xa8\xf3\xd0\x8a\x6e\x29\x5e\x31\x51\xe1\x15\x2b\x0a\xeb\x17\x8d\x8a\xee\xa6\x73\xc1\x65\xe6\x8f\x0e\x79\xfb\xeb\xa6\x38\x9c\x7b\x5b\xb5\x5b\x0c\xb1\xcb\xbe\x9d\x2b\xd8\xe0\x0e\xd6\xef\x49\x14\x0a\x12\xf4\x39\xe8\x10\x6b\x34\xaf\x8d\xd7\xa6\x40\xb0\x17\xea\x10\x6b\xdb\x44\x1b\x3b\x6a\x45\x65\x5b\x85\xc6\x9c\xf2\x32\xb9\x94\x19\xd7\x98\x9a\xa8\x7d\xf1\x2f\x92\xf8\x67\x1e\x29\x4a\x89\x15\x13\x83\x6b\xa5\x3d\x2c\x7f\xd4\x6a\xd9\xba\x60\x15\xfa\x7b\xc2\x20\x4e\xb1\x9b\xeb\x0e\x49\x09\x37\xb7\x5c\x1a\xd4\x39\x4b\xb1\x76\x57\x65\xe2\x6e\x97\xac\x1e\x91\xed\x87\x9d\xf1\x89\xd1\x87\x4d\xf7\x74\xb4\x37\xfa\xc1\x1a\xb3\xbd\xa1\xdb\xfd\xe2\x03\xce\xaa\xf9\x95\xca\xd0\x9a\xca\x97\x26\xf9\xb1\xd0\x5c\x1a\x21\xa3\xee\xdc\x5e\xba\x74\x6b\xc0\x96\x79\xfc\xbc\x34\x51\x16\xfb\x5b\x3a\x6d\x49\x43\xc3\x97\xa5\x15\x8e\x52\xf3\xe0\x46\xe2\xda\x7e\x66\x6f\x72\x3b\xaa\xc8\x55\x2b\xb7\x6b\x73\x7d\x04\xae\xf5\x3e\x34\xed\x8a\x79\x04\xfb\x7b\xd9\x0b\x5c\x26\xd3\x8a\x92\xd8\x16\xf7\x59\xad\xa3\x1e\x0a\x67\x8e\x4a\x37\x99\xa6\xcc\x56\x06\x85\xd0\x97\x7d\x9f\x8e\x7d\x9a\xbc\xa9\xc8\x2e\x44\x2f\xd1\xea\xdd\xda\x56\xc2\x78\x0c\xe5\x37\x91\x7c\xd4\xfa\x5a\x7d\x56\x6b\x77\x8b\xf6\x16\xa9\x44\x4e\x4f\xc1\xf6\x66\xbb\x36\xcb\xb7\xc6\xe7\x28\x30\xb9\x31\x0b\xda\xaf\xd7\x0b\x94\x60\x16\xa8\xf1\x6d\x49\x7b\xa4\xeb\x5e\xbe\x88\xc0\x7a\x71\x98\xa3\xbb\xb6\xe0\xad\x73\xb4\xfb\xee\xa7\x68\x97\x91\xc7\xdf\x3d\x4f\xc8\xd0\xff\x6e\x03\xdd\xbb\x39\xd2\x48\xfc\xaa\x59\x11\xa1\xd6\x76\x1c\xbd\x64\x30\xb6\x7b\xf2\xce\xd5\xfc\xb8\xbb\x7e\xbb\x53\x1c\x21\x6e\x77\x08\x18\x3b\x77\x8f\x36\xb0\xdd\x25\x82\x27\xb6\xf3\xed\x7f\xb5\x66\xea\x3c\x37\xa8\xbf\x6b\x33\xf7\xbb\xf7\x36\x6c\x5e\xa9\xe4\xa2\xbf\x95\x37\xe1\xff\x02\x00\x00\xff\xff\x8d\x76\x7d\x11\x21\x17\x00\x00")
+var _templates16_update_optimisticGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x6d\x6f\xe3\xb8\x11\xfe\x2c\xfd\x8a\x39\xa3\x57\x48\xad\x56\xb9\x03\x8a\xa2\xb8\x85\x81\x7a\x1d\xef\x5e\x70\x79\x3b\xdb\xb9\x7c\x58\x2c\x16\x8c\x34\xb2\xd9\x50\xa4\x8e\xa4\x56\x31\x54\xfd\xf7\x62\x28\xc9\x96\xed\xb8\xbb\x49\x5b\xf4\x53\x1c\x71\xe6\x99\xb7\x67\x86\xc3\xba\x7e\x03\x3c\x03\x26\x53\x88\x97\xec\x41\x60\xfc\x1b\x6a\xc3\x95\x9c\x2a\x51\xe6\x12\x02\xa9\x6c\x7f\x72\x61\xe6\xc8\xd2\x1b\x29\x36\x21\xbc\x69\x1a\x9f\x74\xff\xc0\x04\x67\x06\x7e\x1a\x43\x3c\xa1\x5f\x68\x5a\xe1\x5e\xe7\x9a\xe5\xb8\x13\x36\xc9\x1a\x73\xe6\x4e\x9c\xca\x40\xe6\x9f\x10\x2f\x06\xa7\x5b\x95\x2f\x5b\x77\x06\x1a\xfb\x3e\x1e\xca\xbe\xe7\x28\x52\x92\x6e\x9d\x8b\x3b\xb1\xfe\x78\xaa\x44\xd3\xf8\x5f\x98\x86\xc0\xf7\xea\xba\x13\x3a\x57\x95\x5c\x70\xb9\x2a\x05\xd3\x4d\x73\x57\xa4\xcc\xe2\x4d\x61\x79\xce\x8d\xe5\xc9\x94\x25\x6b\xbc\x2a\x2d\x98\x8d\x4c\xe2\xf9\xfd\x55\x69\xf1\xe9\x65\xda\x30\x86\x9c\x3d\x62\x90\xb3\xe2\xa3\xb1\x9a\xcb\xd5\xa7\xd2\xc9\x39\xec\xd0\x0f\x7d\xbf\xae\x79\x06\xf1\x24\x4d\x3f\x08\xf5\xc0\x84\xcb\xdb\xd9\x19\x1c\xc2\x7d\x00\x06\x86\xcb\x95\x40\xd8\x3a\x70\x57\xec\xcc\x83\xc6\x44\xe9\x14\x4a\x12\x02\xbb\x46\x58\xb5\x78\xf8\x84\x49\x69\x95\x8e\xfd\xb3\x33\x58\x20\x1e\x21\x43\xa6\x34\xe4\x4a\x23\xa4\x2a\x29\x73\x94\x96\x59\xae\x64\xec\x67\xa5\x4c\x20\x50\xf0\xa7\x67\x0d\x86\xc7\x2e\x06\x2e\x16\x47\x9e\x6b\x35\x55\xd2\xe2\x93\x6d\x9a\xc4\x3e\x41\xd2\xfe\x13\x77\x1f\x23\xa8\x6b\x94\x29\xc5\x0a\x89\x2b\x94\x81\x07\xc5\x45\x57\x35\x13\x82\x43\x8a\xaf\xd5\x5c\x55\x66\x92\x65\x98\x58\x4c\x9b\x06\xb5\x56\xba\xae\x51\x18\x6c\x9a\x80\x4b\xfb\xd7\xbf\x44\xe0\x3e\x86\x3b\xc0\xda\xf7\x34\xda\x52\x4b\x50\xf1\xa1\x8b\x41\x8f\xbb\xf5\xce\x99\xfd\x80\xf6\xfc\x5d\x10\xf6\xc8\x89\x7d\x8a\xa0\x3f\xe8\x24\xbb\x73\x99\x36\x4d\xd4\xfb\x1c\xfa\x8d\xef\x6f\x0d\x0f\x4a\x79\xcb\x24\x4f\x4e\x55\xf2\x16\x4a\x83\x06\x98\xdc\x96\x06\xac\x82\x96\x16\xae\x70\xcf\xa6\x3b\x72\xed\x5a\x10\xb0\x01\x25\xdb\xa8\xff\xf7\x35\xbd\x3d\xce\x18\x79\xdd\x66\x67\xd6\xf9\x3f\xc8\xdb\x71\xa5\x77\xe2\xdd\xa7\x81\xd6\x5e\x36\x9f\x63\x40\xc7\xa5\x7d\x16\xb8\xba\xef\xd5\xfb\xb4\xac\x6e\x35\x3b\xc2\x39\x06\xd1\x90\x38\xc5\x8c\x0e\xa3\xf3\xb4\x63\xc2\xce\x14\xc5\x32\xa8\xbe\xc7\x33\xaa\x03\x7c\x37\x06\xc9\x05\xd4\xbe\xe7\xb9\x02\x05\x2e\x92\x7b\xcd\x8a\x99\xd6\x01\x6a\x1d\x86\xbe\xd7\xd0\xe4\x78\x03\x3b\x23\xfb\x8e\xfa\x5b\xd6\x76\x2e\xfb\xde\xd6\xee\x01\xcd\x9e\xe1\xd4\xab\x28\x05\x4a\x8a\x0d\xf0\x8c\x48\xc4\xad\xa1\xb9\x32\x9c\x96\x5d\x9c\x60\x2c\x17\x02\xd6\x4a\xa4\xc6\xd1\xf3\x0b\x13\x25\xa1\x32\x0b\x15\x33\x20\x14\x4b\x31\x6d\xe9\xa9\x31\xd3\x68\xd6\x68\x08\x72\x07\xe7\x66\x73\xd3\x40\xa6\x55\xee\x20\x52\x66\xd9\x03\x33\x08\x2c\xb3\xa8\x2b\xa6\x53\xe3\xa8\x3c\x77\x7d\x6b\x60\xa6\xf5\x54\xc9\xa4\xd4\x1a\xa5\xbd\x52\x29\xcf\x78\xe2\x86\x12\xa5\x8f\x00\xb4\xaa\x9c\xf1\x64\xcd\xe4\x0a\x53\x50\x1a\x52\x14\x68\x31\xa5\x21\x99\x20\xf0\xa1\x73\x07\x6d\xf2\x5f\x6b\x8e\xff\x6f\x6f\xbc\x7a\x3a\x3e\xc3\x74\x37\xab\x3c\xf2\x71\xdc\x9a\xba\xe7\x76\xfd\x6b\x89\x7a\x73\x53\x04\xae\x09\x46\xcf\xe6\x64\x14\xc1\xa8\xed\xa4\x51\xe8\x0f\x19\xeb\xb8\x6e\x31\x2f\x04\x25\x7c\x64\x79\x8e\xc6\xb2\xbc\xf8\xdc\xce\xb9\xcf\x6b\x14\x05\xea\x11\xc4\xce\xb2\xef\xd1\xcd\x4c\xad\xe4\xdc\xdd\x77\xf1\x67\xa5\x1e\x8d\x13\xeb\xfb\x8d\xfa\x37\x55\xef\x30\x53\x1a\x5b\xeb\x4e\xe6\x9b\x5b\x38\x7c\x7b\xd8\xb6\x5d\xeb\xd5\xf5\xa9\xf6\xfc\x61\x0f\x43\xeb\xae\x9f\xbb\x2f\xbe\xef\x3d\xe2\x86\x06\x0b\xdd\xf6\xee\x6e\xff\x05\x37\x41\x57\xbc\x88\xa6\x43\xf8\xe2\xb5\x23\x9e\x5f\xaa\xe4\x31\x08\x7d\x2f\xa1\x2f\x11\xb8\x3f\x29\x59\x79\x09\xd2\xc7\x47\xdc\x7c\x7a\x85\xf1\x3b\x29\x5a\xf3\x2e\xed\xdf\x75\xc6\x29\x59\x95\x20\x1f\xba\xe0\xba\x41\xda\x52\x73\x81\x36\xf0\x3d\xef\x94\xb1\x89\x10\x1d\x85\xa3\x7f\x23\x75\xab\x79\xce\xf4\xe6\x17\xdc\x0c\x84\xc3\xd6\xee\x18\x8c\xd5\x39\xa3\x35\x28\x5e\xa0\x9d\xaa\xbc\x10\x48\x1d\x1c\x54\x22\x3a\x95\x96\x0e\x86\x28\x3d\x29\xad\x22\xa8\x61\xa1\xe9\xdb\xb2\xe7\xa7\x21\x9a\xb5\x01\x77\xf1\x5d\x98\xfb\x35\xb7\x28\xb8\xb1\x41\xe8\x66\xfc\xd7\x1d\xf9\xf8\xa9\x5d\xf6\xea\x51\xa2\x91\x59\x4c\x3f\x33\x3b\x6a\xc8\x30\xa1\xef\x68\xe3\x2c\x09\x94\x41\x25\x42\x18\x8f\xe1\x87\x16\xff\xc5\x6c\x54\xda\xc4\xd7\x58\x05\xa3\xba\x8e\x6f\x1f\x57\xb4\x5b\x37\xcd\x4f\x50\x4a\x5a\x9c\x07\x57\x41\x5d\x0f\x36\xf4\xf6\xea\x2d\x45\xea\x12\xf1\x50\x72\x91\x42\xd5\x87\x3a\x6a\x9d\xf5\x3d\xaf\x5a\xa3\x46\x2a\x38\x2b\x0a\x94\x69\xd0\xfd\xd9\x86\xd8\x44\xf0\xad\x85\x8c\xe3\x38\x8c\x60\x74\x70\xcf\xb8\xc9\xe1\x9d\x9d\xc1\x72\x8d\x20\xb1\x82\xee\x10\xb8\x81\x84\x15\xb6\xd4\x98\x02\x97\x56\x01\x03\x4b\xde\xc3\x17\xa6\xb9\xfb\xd1\xce\x7a\x06\x85\x60\x5c\xb6\x20\x37\x77\xcb\xdb\xbb\x25\x24\x82\x95\x06\x09\x42\xe3\x3f\x5c\xd6\x68\x69\x72\xea\x06\x2a\x6e\xd7\x60\x35\x5f\xad\x50\x1b\xdf\x6b\xfb\x2b\xfe\x9d\xc6\x1d\x8c\x21\xcb\x6d\xbc\x28\x34\x97\x36\x0b\x46\xe7\xb3\xe9\xe5\x64\x3e\x83\xbf\xf7\x4e\x2d\x27\xef\x2e\x67\x10\x7c\x3c\x08\xe2\x13\x3c\x70\xc9\xf4\x26\xf8\x5b\x18\xbe\x85\xbb\xdb\xf3\xc9\x72\x46\x79\x19\x3c\x7e\x9a\x06\x16\xb3\x25\x7c\x6f\x7a\x1f\x2f\xae\x17\xb3\xf9\x72\x76\x1e\x1f\x83\x5d\x5c\x2f\x6f\x76\x36\xef\x7f\x9e\xcd\x67\xf0\xbd\x79\x0b\x8b\xd9\xe5\x6c\xba\x84\x63\x85\xf7\xf3\x9b\xab\xad\xc2\xdb\x91\xeb\xaf\x3d\x86\xde\x32\xcd\x72\x22\x86\x71\x2c\xb9\xfc\xb5\x1d\xde\x75\x1d\xcf\xdb\x9f\x3f\x46\x50\x89\xf0\x40\xf1\x9e\x8a\x3f\x75\xb9\x3c\xa1\xd6\xb1\xf7\xcf\xa4\x4e\xc2\x61\xdf\xad\x6d\x52\xdd\xb2\x70\xc5\x8a\x82\xcb\x55\xd4\x0d\x6f\x4a\x34\x47\x13\xbf\xe3\x32\xed\x8e\x82\x13\x14\x5a\x6e\x0a\x3c\xc9\xaf\x2d\x6c\x47\x49\xea\x3c\xc7\x56\xe2\x19\xf1\xf7\x78\x3d\x7b\xcd\xa0\xa7\x49\x4f\x9d\xe0\x42\x71\xaf\xde\x3e\x80\xdf\xdc\x97\xf7\x5a\xe5\x7d\x18\x1a\x33\x81\x89\x8d\x2f\x64\xca\x35\x26\x76\xfb\xc1\x89\xde\x64\x81\x0a\xc3\x08\x8e\x53\x43\x2d\x70\xb0\x4c\x6c\x6f\x3c\x77\x1f\x9f\xe3\x43\xb9\xba\x52\x29\xba\x09\x41\x14\x7d\xef\x28\x2a\x64\xb0\x3b\xbf\xd7\xdc\xa2\xee\xf1\xc9\xcb\x4d\xf8\x75\x69\xe7\x87\xe9\x37\x54\xda\x1e\xf6\x4d\x5f\x18\x27\x4e\x6b\x40\xe8\xac\x57\x4e\x91\x12\x71\x08\x46\xa9\x70\x72\x87\x56\xab\x6f\xf0\xac\x7a\xde\x9f\xed\x0d\xfb\x6c\x7e\x5a\x46\xd1\xda\x15\xbb\x75\x65\xae\xaa\x60\x60\xa4\x47\x23\x46\xc4\x8b\x84\xc9\xe0\x8f\x2a\x3e\x5a\x4c\xc3\xfd\xc0\x5b\x4c\x17\x5b\x8f\xd9\xd9\xa4\xd8\xa2\xfe\x15\xf0\x3a\x2b\x32\xdd\x5f\x65\xc6\x60\x7e\x17\xf1\x4c\xeb\x96\x88\xaf\xd8\x46\x4e\x2e\xcb\xbe\xb7\xb3\xf3\x9f\xec\x3a\x74\xbb\xd0\x63\x86\x5e\x32\x11\xbc\xf0\x8e\x01\xad\x2a\xba\x4c\x9a\xe3\x3d\xe2\xa5\x2b\x49\xbf\x0e\xbd\x48\xd1\xad\x3f\x30\x6e\x7b\xe2\x15\x46\xb7\x6b\x90\xb7\xe5\xe0\xf1\x5e\xfa\xd5\x94\xfe\x38\x4c\x29\x2d\xaf\x13\x7a\xf8\xbc\x6a\x77\x25\x27\xde\xc0\x8e\xad\x2f\xb3\x2d\xb9\xe8\x00\xe8\xed\xed\x37\x83\x77\xe5\xbf\x06\x00\x70\x48\xe4\x44\x16\x14\x00\x00")
+
+func templates16_update_optimisticGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates16_update_optimisticGoTpl,
+		"templates/16_update_optimistic.go.tpl",
+	)
+}
+
+func templates16_update_optimisticGoTpl() (*asset, error) {
+	bytes, err := templates16_update_optimisticGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/16_update_optimistic.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4f, 0xe7, 0x7e, 0xb6, 0x66, 0xde, 0xa, 0x37, 0x62, 0x3c, 0x33, 0xad, 0x85, 0x31, 0x9f, 0xd5, 0x36, 0x9, 0xc8, 0xa, 0xe0, 0x62, 0x5e, 0xec, 0xd5, 0x6d, 0xe2, 0xa1, 0x93, 0xdd, 0xdf, 0x86}}
+	return a, nil
+}
+
+var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x6f\xdb\x38\x12\x7f\x96\x3f\xc5\x54\xc8\xb5\xf2\x45\x55\x76\x5f\x53\x18\xb8\xfc\x71\xbb\xd9\x36\x4e\x36\x4e\xae\xc0\x79\x8d\x82\x96\x46\x36\x2f\x34\xa9\x52\x54\x5c\x9f\x4f\xdf\x7d\x31\x14\x25\xcb\x4e\xd2\x38\xbb\x6d\xb1\x0f\x45\x2d\x72\x38\xff\x7e\x33\xc3\xe1\x64\xb5\x7a\x0d\x3c\x05\xa9\x0c\x44\xd7\x6c\x22\x30\x3a\xcb\xaf\x90\x25\x17\x52\x2c\xe1\x75\x59\x76\x88\x60\x8f\x09\xce\x72\x38\xec\x41\x74\x44\xbf\x30\xaf\x68\xeb\x23\x03\x36\xc7\x9a\x34\x8f\x67\x38\x67\x76\xdd\x1e\x58\x53\xc0\xff\x21\x1a\xae\x77\xed\x01\x9e\x42\x74\x94\x24\xef\x84\x9a\x30\x61\xe5\x1d\x1c\xc0\x4d\x96\xa3\x36\xef\x80\x19\x83\xf3\xcc\xe4\xc0\x24\x70\x49\x6b\x21\x30\x99\x40\xa2\xd0\xae\x15\x59\xc2\x0c\x82\xd2\xc0\xa7\x52\x69\x04\x25\x21\x56\x32\x15\x3c\x36\x51\x27\x2d\x64\x0c\x81\x82\x7f\xae\x56\x95\xfe\xd1\x4d\x36\xe4\x72\x5a\x08\xa6\xcb\xb2\x5b\x4b\x09\x56\xab\xda\xfe\x81\x3a\x51\xd2\xe0\x17\x53\x96\xb1\xf9\x42\xac\xe8\x23\x72\x8b\x21\xac\x56\x28\x13\x52\xd2\x49\x3e\x51\xa2\x98\xcb\x3c\x74\xca\xb9\x4f\x98\x28\x2e\x22\xf7\xd1\x05\xd4\x5a\x69\x58\x75\x3c\x8d\xa6\xd0\x12\x54\x54\x09\xae\xe4\xb6\x65\xda\x73\xef\xd0\x9c\x1e\x07\xdd\xd5\x0a\x45\x8e\x56\x8f\x10\xea\x0d\x47\xe9\xf6\x65\x52\x96\xe1\x57\x35\xe9\x76\xca\x4e\xa7\x51\x9a\x7e\xf2\xd4\x3a\xb0\xe5\x72\xfa\x79\xc9\x24\x8f\xb7\x9c\x7f\xf9\xd7\xbc\x0f\x96\x67\x4e\x88\x58\x07\xec\x0c\xc7\xe5\xf7\xc6\x63\xd5\xf1\x78\x4a\xa8\x50\x74\xfe\x48\x30\xde\x58\xa1\x2f\x7a\x20\xb9\xa0\x78\xf0\x32\x72\x51\x60\x05\x7d\xd4\x2c\xeb\x6b\x1d\xa0\xd6\xdd\x6e\xc7\x2b\x1f\x02\xee\x11\xa4\x1e\x02\x0a\x8a\x9c\xcb\x29\x7d\xe3\x17\x8c\x0b\xa3\xf4\x73\x12\xa7\xc5\x3a\xfb\x73\x28\x5e\xde\xf7\x27\x29\x52\xf9\xae\xef\x54\x6a\x79\xf5\x3e\xb4\x6b\x72\xb7\xd4\x3a\xf5\xb4\xaf\x77\x87\xfc\x81\x38\x6b\xc7\x15\xa9\xf1\xfd\x60\x6d\x1c\xfd\xcd\x21\xdc\x0d\xa6\xbf\x17\x4a\x4d\xa1\xfc\x14\x6e\x63\xf5\x91\x9b\xd9\x15\xe6\x85\xf8\x66\xa8\x35\xe5\x18\xb5\xee\xb4\xa1\x58\x8b\x02\x9e\xbb\x35\x30\x33\x66\x80\x89\x5c\x81\xc6\x4c\x69\x93\xc3\x62\xc6\xe3\x19\x4c\x34\x93\xf1\x0c\x54\x0a\x66\x86\x70\xde\xbf\x7a\xd7\x07\xcd\x64\x48\x75\xb4\x32\x15\x13\x62\x93\x32\x91\x23\x2c\x66\x28\x2d\xa1\x56\x0b\x58\xb0\xdc\x69\x98\x50\x1e\x0a\x4c\x49\x82\x92\xb8\x2b\x78\xdb\x3e\xf9\xbb\xc0\x18\x34\x86\x4f\x94\x12\x15\x94\x16\x5a\x5b\x7a\x1f\x80\xcf\xe6\x82\x47\x8a\xf5\x2a\x9d\xc9\xb2\xdf\x0a\xd4\xcb\x8b\x2c\xb0\xf9\xe8\x3f\xe8\x08\x3f\x04\xbf\x72\x85\xdf\xed\x74\xbc\x75\x5e\x51\x7d\x57\xd0\x5b\x67\xa4\x83\xda\xa2\x60\x15\x52\x3a\x8f\x06\xb8\x08\xfc\xd5\x2a\xba\xbc\x9d\x52\x73\x52\x96\x87\x20\x15\xac\x56\x1b\x2d\x0d\x64\x5a\xdd\xf1\x04\x13\x48\x95\x86\xa2\x96\xe6\x95\x56\xe0\x6b\xa0\xc2\x2b\x28\x0f\x7d\xc3\xe7\x98\x1b\x36\xcf\x3e\x55\x54\x9f\x66\x28\x32\xd4\x3e\x44\x40\xa9\xbe\x61\xf8\x2f\x4a\xdd\xe6\x8d\xaa\x4d\xac\x27\xea\x18\x53\xa5\xb1\x32\xca\x12\xed\x1c\xee\xf7\xcb\xd0\x3d\xa3\xa9\xfe\x58\xa5\x2d\xa6\x9d\x8e\x27\xff\x77\x8a\x29\x2b\x84\xb1\x9d\xdd\xe7\x02\x35\xc7\x3c\x1a\x28\xf9\x1f\xd4\xca\x6d\x0d\xd1\x04\x8d\xf7\x4f\xd5\x42\xae\xfd\xef\x10\x27\xb4\x1c\x71\x08\x8a\x90\x38\x38\x80\xe3\x82\x8b\x04\x62\x16\xcf\x10\x6e\x71\x09\x5c\xbe\x16\x5c\x22\x14\x53\xc1\xa9\xaf\x84\xf9\x32\xff\x2c\xe0\x2e\x87\x8c\xfe\xcf\xb4\x9a\x08\x9c\xe7\x1d\x6f\x52\xa4\xa4\x4c\x6e\xf4\x9c\xc9\xa9\x40\xba\x82\x8f\x8b\x34\x45\x1d\x74\xed\x6e\xf4\x51\x73\x83\x43\xa3\xb9\x9c\x06\x73\x76\x8b\x27\x24\xe4\x3d\x2e\x83\xad\x18\x95\x5c\x74\xdb\x47\x8e\x97\x06\x83\x57\xd1\xab\xa7\xd8\x6c\xc4\xf6\x57\xd9\x50\x48\x7c\x0a\x21\x26\x85\x35\x93\x53\x84\x96\x47\x09\x82\x6d\x39\xb1\x8d\x1c\x8f\x1c\x72\xd8\x03\xda\x75\x1b\xdd\x8e\xb7\xb6\xf8\xb2\xa8\x2d\x9e\x14\x29\xf9\xf3\x11\xff\x57\x61\x62\xcd\x3f\x2f\x4c\x74\xf5\x41\xc5\xb7\xe4\x24\xeb\xf5\xb0\x72\x7e\x42\xba\x3d\x7d\x7e\x74\x8b\xcb\xf1\xce\x82\x6e\xa4\xa8\x44\xd9\xf0\x7d\xe1\x04\x91\xc1\x75\x9b\xa8\xd1\x90\xe0\x0d\x57\x46\x67\xad\x2f\x0a\xab\x8e\xe7\x3d\x26\xf1\x48\x88\x1a\x80\xaf\x50\x3d\x10\x80\xbb\x51\xab\xc2\xb4\x0f\xac\x51\x0b\x3b\x9e\xd7\x6d\xec\x80\x76\x1c\x0e\xd1\x9c\xa8\x79\x26\x70\x8e\xd2\xb8\x20\x09\xe1\x69\x59\x47\x85\x51\xc4\x92\x82\x85\x87\x70\xb7\x0e\x16\x27\x84\xfc\x46\x7e\x5c\x8b\xa2\xba\xc8\xb8\xcc\x8f\xe4\xf2\xb1\xdc\xbb\xd4\x7c\xce\xf4\xf2\x3d\x2e\x9d\xa8\x10\xee\xba\xf0\xf2\xe5\xf3\xb8\xb4\xd4\xac\xfd\x41\x6c\xac\x46\x6b\x1f\xb0\x2c\x43\x99\x38\x93\x47\x87\x7c\x5c\xd7\xff\x11\xdf\xff\xf9\x70\x1c\x45\x11\xd9\x47\x81\x6d\xff\xf1\x14\x04\x4a\x47\xde\xa5\x32\xfc\x13\x15\xfe\xdd\xab\x70\x21\xa9\x00\x83\x51\xae\xde\x6e\xd7\xe4\x10\x62\x55\x88\xc4\x16\xd3\x89\xad\x33\x4e\xd5\xd8\x9a\x03\x82\xe7\xb6\x46\xdb\x22\x4d\x52\xb7\x71\x3c\x47\x3d\xc5\x40\xe3\xb3\xf0\xfb\xab\x7c\x9c\x83\x29\x69\x3c\xd7\xbb\x1d\xf6\x36\xef\xd5\xe8\xa6\xf5\xf5\x4d\x32\xe4\x7e\x98\xb8\x00\x77\x1a\x3c\x1e\xe0\x15\xc1\xee\x0e\xaa\x80\x7f\xb1\x69\xcf\x59\x3e\x50\x12\x03\x1b\x98\x14\x13\xd5\xee\x8f\x89\x09\x67\xe1\x83\x31\x61\x2f\x55\x77\xfe\x17\x96\x5f\x6b\x3e\x9d\xa2\x76\x37\xb2\x77\x70\x00\x17\x37\xd7\x97\x37\xd7\xb0\xa8\x6a\x05\x9c\x0d\xae\x2f\xa8\x8d\xd3\xf8\x5f\x8c\x0d\x26\xf4\x1e\x32\x74\x3a\xb7\x24\x60\x1c\x83\x10\x54\x61\xb2\xc2\x50\x93\x57\x31\x62\xb1\xe1\x4a\xda\xc6\x9d\x1a\xbf\xa6\x35\xa2\x2b\x80\x4b\xa3\x80\x55\x8c\xe0\x8e\x69\x6e\x7f\x10\x69\x8e\x02\xe3\x16\x97\xca\x4b\x98\x40\x5c\x77\x5c\xcb\xaa\x25\xad\xd8\x10\x21\xe4\x6c\x8e\x30\x61\x26\x9e\x51\x76\x1a\x64\x49\x68\xd7\x6f\x71\x59\xa9\x32\xe5\x77\x28\x6d\x1b\x43\x6f\x8c\x04\xa5\xe1\x66\x49\x56\x91\xcf\x88\x52\x49\x6c\xba\xd3\xa9\x32\x1d\xcf\xb3\x95\x3d\xa2\xc6\x60\x09\x3d\xf0\x4f\xfb\x27\x1f\x8e\xae\xfa\xf0\x2f\xd7\xe1\x38\xe3\xae\x8f\x8e\x3f\xf4\x21\x18\x55\x9f\x63\x90\x77\x4c\xc7\x33\xa6\x83\x9f\x7f\x22\x8f\xdb\x96\xa3\xaa\x78\x7b\xb1\x12\xad\x99\xd0\xe5\x7b\x5c\xd6\x7d\x63\x59\xae\x56\x7b\x31\x6d\xee\xb9\xdd\x77\xe8\xee\x0a\x7b\x8c\x20\x1e\x11\x09\xfd\x1c\xc3\x6a\xa5\x34\xec\xc5\xd1\xdb\x42\x88\xd3\xe3\xeb\x65\x46\xcc\xa3\xea\x17\xb5\x55\x94\x11\x76\xae\x45\xee\xdc\x8b\xa3\xb3\xfc\x14\x63\x3e\x67\x82\xc8\x2e\x35\xc6\x3c\xe7\x4a\x96\x25\x55\xc6\x8d\x85\xd0\x2e\x0c\x63\x46\xf3\xa9\xfa\x61\xef\xfe\xeb\xbe\xf9\x5d\xfa\xb0\x4f\xdc\x6d\x8c\x55\x17\xb0\xed\x54\xcf\x87\xc3\xdf\x3e\x04\x09\x67\x04\x5c\xd5\xb0\xb6\xe6\x60\x65\xe9\x87\xb0\x73\x8a\xba\xd0\xad\xab\xec\x73\x4e\xfa\x9b\xd0\xd8\x2a\x58\x05\xd3\x89\x12\xb6\xcb\xf3\x47\xc5\x38\xaa\xa1\xf2\xd7\xe5\x5a\xa3\xe9\xc2\x8b\x26\x2f\x5b\x87\xf6\x7b\xe0\x87\x30\x32\xe3\x68\xe4\xc3\x3e\x15\x0c\x2e\xa7\x79\xf4\xab\xe2\xf6\x54\x08\xfe\x38\xac\x76\xbb\xb0\x0f\xfe\xd8\x77\xf7\x40\x3b\x78\xf6\x7b\x90\xce\x4d\x34\xcc\x34\x97\x26\x0d\xfc\xdf\xe5\xb0\xff\xa1\x7f\x72\x0d\xff\xc8\xe1\xed\xd5\xc5\xf9\x76\x48\x8d\x8a\x31\x9c\x0d\x06\xfd\x2b\xf8\xf5\xe2\x6c\x00\xdb\xde\x24\x6d\xe0\x82\xd6\x5d\x5c\xf1\xf0\xa9\xd8\xe2\x29\xec\xf1\xb2\x84\xa3\xc1\x29\x38\x38\xad\xd2\xeb\x90\xea\xc1\xa8\x68\x2f\x38\xaa\x37\x7e\xe8\xf2\x91\xbc\x41\x0e\xad\x9e\x52\xae\x58\x6c\xa6\xc8\x0f\x0d\x8b\xca\xf9\xbe\x53\xa9\x9e\x29\x78\xde\x62\xc6\x0d\xd2\x25\x48\x78\x53\x87\x1c\x8c\xc6\x15\x6a\xa1\xbd\x99\x77\x95\x48\xdd\xb3\x17\xab\x6c\x19\x34\x1c\x77\x57\xb7\xbb\xa1\x48\xd3\x44\xb4\x38\x55\x51\xee\xba\x87\xaf\x93\x56\x89\x60\x49\x1b\x97\xdf\x31\x51\xe0\x39\xcb\x32\x6b\x17\xbd\xa1\xd6\x4f\x98\x63\x2e\x13\xb7\xf5\x58\xeb\x43\x85\xe2\x51\x6b\x1a\xb6\x8d\x0e\x64\x0e\x4f\xb7\x9f\x58\xf7\xaf\xaf\xcd\x1e\x68\x2b\xa9\xaa\x58\xd1\x68\xbe\xb7\xda\x24\xb7\xe3\x3d\xa8\xf1\x83\x2a\xd7\xbd\x1b\x5d\x8e\xd6\xaf\x14\x39\x1a\x53\xaa\x01\xd1\x99\x4c\xb8\xc6\xd8\x04\xf5\xc2\xbf\x89\xe2\x22\x0d\x14\x05\xc8\x1d\x13\x1b\xaf\x47\xbb\x99\xbf\xd5\x6a\x5e\x5b\x62\x19\xba\xe7\xc8\x06\x6a\x5d\xfb\x52\xbc\x6e\x06\x27\xd5\xdd\x99\x03\x37\x39\xec\xb9\x42\xc0\x66\xc8\x92\x7a\xbe\xb2\x7d\x0d\x92\x74\x5d\x5f\xb1\xf9\x67\x11\x0d\x0a\x21\xaa\x17\x55\x3d\xde\xb1\xba\x8d\xc6\x5c\x1a\xd4\x29\x8b\x71\x55\xae\x5e\x56\x07\xaa\x31\x01\xa1\xb4\x0d\x4b\x0b\xb2\x9a\x49\x13\x94\x6e\x21\x6c\xec\xbd\x34\xfa\x71\x6b\x5b\x3c\x6d\xf0\xba\xb1\xc1\xc6\xb0\xa6\x19\x03\xd8\xd1\xc7\x29\x4e\x8a\xe9\xb9\x4a\xd0\x8a\xa7\x9a\xf9\xd6\xd6\x4c\x21\x83\xf5\xbe\x7d\x52\xea\x5a\x08\x69\xb2\xec\x3e\x4d\x4d\x48\x75\xdd\x0c\x60\x5d\xc0\x6a\xc1\x74\x41\x4e\x8a\x29\xcd\x5b\xba\x56\xf6\xc2\x1e\x23\xf7\x6d\xb3\x22\x73\x2d\xdd\xb6\xcc\xc5\x0e\x7a\x2d\x1e\xd2\xa6\xa9\x5d\x0f\xfa\xa6\xca\x6d\x1a\x69\x45\xf6\xae\xbd\x52\x0b\x87\x99\x15\x51\xf1\x22\xff\x46\xc3\x98\xd9\xa4\x2b\xb4\xb4\x0b\x9b\xa6\x56\x7c\xac\x35\x35\x1f\x27\x87\xac\x09\xdd\xd4\xf0\x19\x9c\x9d\xda\x75\x9a\xf5\x7a\x36\x08\xfb\x5a\x0f\xd4\x95\x5a\xe4\xd4\xf5\x3e\xd2\x0f\x93\x83\xed\x11\x9b\x98\x07\x07\x60\x2f\x0a\x3b\xdb\x95\xaf\x8c\x0b\x74\x60\x72\x69\x66\x34\x04\xae\x07\x87\x1a\x5f\x51\xbb\xe6\xb8\x76\xbc\xb5\xec\x56\x8a\xdf\x4b\x70\x1a\x76\xd1\xdf\x17\x68\x0a\x1d\xc2\x33\x1b\x6b\xba\x5e\x48\x4c\xdd\xb8\xf6\x5c\xbe\xb9\xc9\x05\xb5\xf2\xfe\xd9\x60\xd8\xbf\xba\xf6\xef\x8f\x03\x76\x9b\x27\xd4\x73\x8b\x1d\xc8\xed\x9c\x02\x7a\x55\xe4\xef\x2c\xa0\x99\x57\x78\x5f\x19\xc4\x39\xb7\xd5\x86\x86\x76\x1e\x77\x94\x1a\xd4\x7f\x6a\x1c\xe7\x26\x6d\x4d\xf0\xdd\x63\x2f\xb9\x68\x4f\xe3\xca\xd6\xdf\x05\xfe\x18\x00\x12\xfc\x72\x4b\x93\x1d\x00\x00")
 
 func templates17_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,11 +112,31 @@ func templates17_upsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/17_upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x5a, 0x66, 0xe6, 0xd9, 0x6b, 0x35, 0xfd, 0x39, 0xa6, 0x4b, 0x6, 0x12, 0x46, 0xd4, 0x7a, 0x27, 0xf9, 0xeb, 0x17, 0x59, 0x9b, 0x95, 0xa5, 0xe6, 0xf2, 0xcd, 0x3f, 0xa2, 0x5d, 0xbb, 0x6a}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x15, 0xe7, 0x11, 0x1a, 0xd8, 0x97, 0x51, 0x3e, 0x83, 0xba, 0x3b, 0x8c, 0x25, 0xe5, 0xda, 0xdd, 0x27, 0x4b, 0x2d, 0x24, 0x24, 0xb3, 0x54, 0x2a, 0xea, 0xf3, 0x52, 0xb0, 0x6c, 0xd2, 0x97, 0x18}}
 	return a, nil
 }
 
-var _templatesSingletonMssql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x4d\x6f\xda\x40\x14\x3c\x7b\x7f\xc5\xab\xa5\x48\x5e\x65\xe5\x34\xd7\x46\x54\xa2\xc1\x4d\xa8\x88\x81\xd8\xb4\x07\xc2\x61\xc1\xcf\x64\x25\xb3\xa0\xfd\x40\x8d\xaa\xfc\xf7\xea\xd9\xa6\x40\xf0\xa9\x17\xf0\xbe\x8f\xd1\xec\xcc\xd8\x37\x37\xb0\xf4\xaa\x2a\x66\x3b\x8b\xc6\x4d\x3d\x9a\xb7\xa7\x2c\x9b\x8e\x9a\xaa\x05\x09\x74\xb0\x4e\x3a\xdc\xa0\x76\x60\x9d\x51\x7a\x0d\xde\xd2\xaf\x7b\x45\xf0\xf5\xe2\x40\x3a\x09\x3b\xb3\xdd\xab\x02\x8b\x98\x95\x5e\xaf\xba\x71\xa3\x42\x49\x28\x8c\xda\xa3\xb1\xf1\x40\xc9\x0a\x57\x4e\x80\x93\xcb\x0a\x53\xb9\xc1\x16\x5f\xc0\xce\xa8\x8d\x34\x6f\x02\xfc\xae\x90\x0e\x05\x28\x4d\x40\x30\x5f\x1c\x26\xb6\xde\xed\xfc\xb1\xc0\x0f\xd4\xfe\xb0\xa0\x9d\xed\x51\x69\x23\xf5\xba\xc2\x78\x58\xa0\x76\x53\xbf\x75\x98\x55\x6a\x85\x44\x23\x1e\x4d\x05\xd0\xff\xf3\xf4\x00\xcf\x19\x0b\x96\xbe\x84\x2f\xa7\xab\x0f\xe8\xbe\xf9\xb2\x44\x13\x71\x16\x14\x58\xa2\x39\x69\x4e\xfc\xa1\xb9\xf4\x25\xad\x5b\x27\x8d\x1b\xea\x02\x7f\x13\xca\x2d\x63\x41\xb9\x71\xf1\xf7\x9d\x51\xda\x95\x34\x24\x20\x7c\x4a\x9e\x1f\x12\x18\xa6\xf9\x18\xae\x2c\x48\x0b\x73\xb7\x78\xd1\xe1\x89\x0e\xbc\x6b\x6d\x96\x0d\xd3\x07\x88\xb2\x64\x94\xdc\xe7\x70\x65\x79\xbd\x6a\x17\x10\xcd\xaf\xec\x82\x13\x02\x0b\x82\x13\x6e\x95\x5c\xe1\xeb\xb6\x2a\xd0\xd8\xfa\xc2\x33\x8b\x35\xb3\xd3\x86\x80\x0a\x75\xd4\xca\xcd\x05\x1c\xf9\x0b\xb8\xe5\x2d\xa0\xd2\x6b\x1b\xff\xd8\xaa\x7f\x83\xa2\x55\x3b\x6a\xf4\xe3\xd7\xa1\x08\xaf\x4f\x4a\xa3\x29\xe7\x67\x77\x68\xaf\x30\x4e\x21\x0a\xa9\xb1\x35\xa0\x04\xec\x49\x23\x23\xf5\x1a\x0f\x86\x93\x7d\x81\x2a\x41\xc1\xa7\x1e\x7c\xae\x4f\x97\x28\xd0\x4f\x07\x40\x30\xc1\x3b\x0b\x3a\x84\x9a\xdb\x45\x4c\x92\x40\x8f\x94\xad\x1f\x43\x01\x7b\x01\x7b\xce\x68\xe5\x02\x90\xb4\xfb\x60\xde\x75\xef\x4c\x18\xc6\x88\x15\x55\x9a\x40\x72\xf8\xda\xd2\xbb\x00\xfb\xf5\x98\xa4\xf0\xd4\xcf\xef\x1f\x93\x01\xe4\x74\xa8\xa9\x76\xf8\x39\x19\xf4\xf3\x04\xb2\x84\xcc\xac\xfd\x3f\x7a\x97\xa1\x9b\x48\x23\x37\x14\x06\x1b\x9d\x2b\xfb\x51\xfc\x73\xd3\x5a\x7e\xc4\xb8\xe3\x3e\x6d\x97\x64\xe8\xd0\xa1\xa6\x9e\x8e\xf3\x4b\xfa\x97\xec\x87\x69\x96\x3c\xe7\x10\x51\x0e\x7f\xf6\x47\xb3\x24\xab\x9f\xc3\x8b\xc8\x34\xaf\x96\x80\x90\x84\xfe\xef\x84\xb6\x2f\xe8\xc7\x80\x9e\x18\xd3\x7c\x10\xba\x8c\x39\x50\x7e\xd1\xe3\x59\x3e\x99\xe5\xd0\x70\x4f\x06\x75\x34\xee\xc2\x83\xa0\x2d\xe1\x06\x48\x40\xb8\x10\xc7\xc1\x90\xf2\xfc\x0e\x58\x59\xec\xb6\xfd\x2e\x6c\x55\x35\xe8\xbc\xd1\xb0\xf4\x65\x9c\x35\x3e\x71\xf6\xce\xfe\x06\x00\x00\xff\xff\x96\xf9\x01\xfb\x69\x05\x00\x00")
+var _templatesSingletonMssql_optimisticGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\x31\x4e\xc3\x40\x10\x85\xe1\xde\xa7\x78\x4a\x05\x8d\xdd\x23\x51\x21\x4a\x02\x0d\x07\x58\x76\x1e\xf6\x08\x7b\x26\x9a\x1d\xb3\x8a\xa2\xdc\x1d\x81\x04\x25\x07\x78\xef\xff\xa6\x09\x8f\x11\x0f\x6e\x75\x8f\xa0\xe5\x93\x8b\xbe\x6b\x2d\xa9\x6e\xf0\x5a\xf7\x68\x90\x3d\xd4\x66\xbc\x9e\xa4\x24\x9f\x4f\xa9\x9b\xb6\xd4\x8a\xbe\xd0\x90\x0b\x11\xde\xd1\x4b\x1b\xa6\x09\x75\x29\x36\x53\xe0\x01\xe1\xca\xa4\xe0\xed\x8c\xe6\x1b\xdd\x08\xae\x8d\x68\x6a\x95\xd0\xfc\x9e\x60\xf5\x22\x94\x71\xf8\x2c\xf1\x0f\xe4\x1e\x8c\xf0\x68\xe3\x91\xfd\xe6\x70\xb9\x8c\x2f\x1f\xf3\xb1\x6c\xbc\x5e\xef\x7e\xe3\xd8\x7e\xe4\x14\xd4\xbf\x8f\xf5\x7c\xb8\x1d\xbe\x06\x00\x25\xf6\x35\x51\xe2\x00\x00\x00")
+
+func templatesSingletonMssql_optimisticGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSingletonMssql_optimisticGoTpl,
+		"templates/singleton/mssql_optimistic.go.tpl",
+	)
+}
+
+func templatesSingletonMssql_optimisticGoTpl() (*asset, error) {
+	bytes, err := templatesSingletonMssql_optimisticGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/singleton/mssql_optimistic.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6e, 0xbd, 0xee, 0xea, 0xad, 0xe4, 0x91, 0xeb, 0xed, 0xda, 0x1e, 0xd6, 0x2d, 0x46, 0x48, 0x9c, 0x82, 0xda, 0x68, 0x7d, 0xd2, 0x91, 0x77, 0x46, 0xe5, 0x2a, 0x6e, 0xe8, 0x62, 0xf1, 0x26, 0x9f}}
+	return a, nil
+}
+
+var _templatesSingletonMssql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x4b\x6f\xe3\x36\x10\x3e\x8b\xbf\x62\x2a\x34\xa8\x88\x10\x72\x73\x6d\xe1\x02\x6e\xac\x26\x2e\x1c\xf9\x21\x79\xf7\xe0\xf8\x40\x5b\x94\x4d\x40\xa6\x0c\x3e\x9c\x0d\x82\xfc\xf7\xc5\xe8\x91\xc8\x8f\xdd\xc3\x5e\x6c\x71\x86\xf3\xf1\xfb\xbe\x19\xb2\xd7\x83\xb5\x93\x45\xb6\x38\x18\xa1\xed\xcc\x09\xfd\xfa\x94\x24\xb3\x71\x1d\x35\xc0\x01\x17\xc6\x72\x2b\xf6\x42\x59\x30\x56\x4b\xb5\x05\x67\xf0\xd7\xee\x04\xb8\xaa\x70\xc8\x2d\x87\x83\x2e\x8f\x32\x13\x59\x48\x7a\x3d\x48\x77\x02\x9e\xa2\xf9\x43\x04\xa5\xb3\x07\x67\x0d\x48\x6b\xe0\x77\xbe\xb1\xb2\x54\x90\x97\x45\x51\xbe\x88\x0c\xd6\xaf\x15\x4a\xbd\x07\x36\x65\xe1\xf6\xca\x30\x90\xca\x96\x98\x40\xa4\x3a\x37\xaa\x22\x7c\x5d\x08\x78\xd9\x09\x05\xd2\xfe\x61\xc0\x08\x1b\x92\xdc\xa9\xcd\x75\x11\x41\x26\x39\x64\x5a\x1e\x85\x36\xe1\x50\xf2\x42\x6c\x2c\xab\x41\x62\xbe\x17\x8d\x18\x06\x07\x2d\xf7\x5c\xbf\x32\x70\x87\x8c\x5b\x81\xc7\x23\x10\x2c\x57\xed\x8e\x86\xdf\x79\xa0\x22\x55\x87\x68\x6b\xcd\x1b\xf1\x9a\xf2\x3e\x86\xf6\x5c\x6d\x0b\x11\x8e\x32\xa1\xec\xcc\x95\x56\x24\x85\xdc\x08\x64\x16\x8e\x67\x0c\xf0\x7f\x3e\x6b\x4f\xa4\x84\x78\x6b\x97\xc3\x5f\xdd\xd2\x07\x61\xff\x75\x79\x2e\x74\x40\x89\x97\x89\x5c\xe8\x4e\x72\xea\xda\xe4\xda\xe5\x58\x6e\x2c\xd7\x76\xa4\x32\xf1\x0d\x51\xee\x08\xf1\xf2\xbd\x0d\xff\x3b\x68\xa9\x6c\x1e\xac\x5d\xce\xc0\xaf\xfb\x32\x8a\xd3\x09\xdc\x18\xe0\x06\x96\x76\xf5\xac\xfc\x8e\x35\xf4\x5a\xd9\x22\x19\xc5\x0f\x10\x24\xd1\x38\xba\x4f\xe1\xc6\xd0\xaa\xd4\xac\x20\x58\xde\x98\x15\x45\x04\xe2\x79\x1d\x6e\x05\xdf\x88\x5d\x59\x64\x42\x9b\x4a\xf0\xc2\x88\x8a\x59\x37\xc1\xa0\x10\x2a\x68\x3a\x40\x19\x7c\xf2\x67\x70\x47\x1b\x40\xa9\xb6\x26\xfc\xbf\x94\x1f\x1b\x59\xe3\x76\x05\x3b\x9f\xd1\x5b\x9f\xf9\xb7\x9d\xd0\x78\x46\xe9\x89\x86\x46\xc2\x24\x86\xc0\xc7\x44\xa9\x41\x32\x38\xa2\x47\x9a\xab\xad\x68\x67\x00\xde\x88\xe7\xc9\x1c\x24\xfc\xd6\x87\x3f\xab\xd5\x25\x0a\x0c\xe2\x21\x20\x8c\xf7\x4e\xbc\x2b\x46\x2d\xcd\x2a\x44\x4b\xa0\x8f\xce\x56\x9f\x3e\x83\x23\x83\x23\x25\x58\x72\x01\x88\xde\x9d\x35\xef\xb6\x7f\x62\x0c\x21\xc8\x0a\x23\xf5\x8c\x52\xf8\xa7\xa1\x77\x01\xf6\xf5\x31\x8a\xe1\x69\x90\xde\x3f\x46\x43\x48\x71\xe1\xd3\x93\x7d\x1f\xfd\x9c\x0e\x07\x69\x04\x49\x84\xcd\xc4\xee\x75\xe6\x2a\x11\x76\xca\x35\xdf\xe3\x3d\x31\xc1\xa9\xb3\xe7\xe6\x9f\x36\xad\xe1\x87\x72\xae\xe8\x69\xb2\x68\xc3\x15\x1f\x2a\xea\xf1\x24\xbd\xa4\x7f\xc9\x7e\x14\x27\xd1\x3c\x85\x00\xe7\xf0\xcb\x60\xbc\x88\x92\xea\xdb\xbf\x18\x99\xfa\x6a\x31\xf0\xd1\xe8\x5f\x9e\xd0\xe6\x82\x9e\x0f\x28\xbd\x26\xe3\x59\x4d\x16\xe9\x74\x91\xb6\xcf\x9d\x4f\x3f\xba\x57\xbf\x1b\xd7\xba\xd7\xea\x62\x50\x2b\x8b\x86\xed\xe0\x9c\xa8\xa9\x01\x18\xf8\x2b\xf6\xb9\xcf\xc7\x61\x7f\x3f\x3b\x64\xa4\x6c\xf9\xb3\x83\xda\x07\xc0\xef\xbe\x66\x3f\x18\xd0\xbf\x7d\x14\xaa\x85\x75\x5a\xc1\xda\xe5\x61\x62\xb5\x54\xdb\x80\x92\x77\xf2\x7d\x00\xa0\x32\xae\x9b\x43\x06\x00\x00")
 
 func templatesSingletonMssql_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,11 +152,11 @@ func templatesSingletonMssql_upsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/singleton/mssql_upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4a, 0x50, 0x8e, 0x29, 0xf7, 0x61, 0x7e, 0xea, 0xd, 0xbf, 0x2, 0xf3, 0x59, 0xc, 0x2b, 0x7e, 0xbc, 0xf6, 0x6e, 0x16, 0xab, 0xd5, 0x9f, 0x3a, 0xbc, 0x5b, 0x1f, 0x52, 0xbf, 0xfe, 0xd5, 0x3}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0x28, 0x27, 0xa0, 0x15, 0xa5, 0xb5, 0x28, 0x43, 0x7, 0x7b, 0xc, 0xd0, 0xb4, 0x69, 0xcd, 0xad, 0x2, 0xf7, 0x1, 0x7d, 0x56, 0x95, 0x2f, 0x3c, 0xe7, 0xd1, 0x44, 0xf9, 0x2d, 0x5f, 0xe8}}
 	return a, nil
 }
 
-var _templates_testSingletonMssql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xed\x53\xdb\x36\x18\xff\x6c\xff\x15\x4f\x73\xd7\xd6\x66\x9e\x68\xd7\xdd\x3e\xd0\xcb\xf5\xf2\x62\x5a\xae\x24\x81\x38\x5b\xb7\xa3\x0c\x94\x58\x06\x1d\xb6\x64\x24\x19\x9a\x31\xfe\xf7\x9d\x24\xdb\xb1\xd3\x24\x85\x4f\xe3\x4b\xd0\xa3\xe7\xf5\xf7\xbc\xc9\x77\x58\x80\xb8\xfa\x36\x8a\xa2\xd3\xe3\x1b\xb2\x84\x2e\x08\x72\x45\xbe\xe5\x68\x54\x48\x35\xe0\x59\x4e\x53\xe2\x5d\x7a\x1f\x32\xff\xef\xde\xf1\x2c\x9c\xc2\xac\xd7\x3f\x0e\x01\xed\xf5\x86\xc3\xaf\xf2\xa7\xc1\x64\x1c\xcd\xa6\xbd\xa3\xf1\x0c\xd0\x1e\x1c\x4e\xa6\xe1\xd1\xc7\x31\x7c\x0e\xff\x42\x7b\x1f\xd0\xde\x57\xf6\x61\x1a\x1e\x86\xd3\x70\x3c\x08\x23\xb4\x77\xe9\xbb\xae\x5a\xe6\x04\x32\x29\x6f\xd3\x19\x91\x8a\x08\x90\x4a\x14\x0b\x05\x0f\xae\x13\xcf\x07\x9c\x31\xd0\x7f\x7b\xf2\x36\x45\xc3\xbe\xa6\x8d\x71\x46\x0c\x4d\x2a\x41\xd9\x95\xeb\x5c\x73\xa9\x00\x5a\xa4\x42\x12\xb1\x46\xca\xb1\x94\x6b\x24\x29\xd3\x8c\xc7\xa4\xc5\xc5\x45\xa5\x8b\x32\xe5\x3a\x8a\x48\x35\xec\x1b\x93\xb5\xd4\x0d\xcd\xa3\xd3\xe3\x41\x16\xc3\x9c\xf3\xd4\x7d\x74\xdd\xa4\x60\x0b\xa0\x8c\x2a\xcf\xb7\x7e\x8f\x30\x65\xd0\x85\x57\x8d\xb8\x1e\x1e\x6b\x4e\x2f\x83\xbd\xc6\x8d\x0f\x92\xa8\x22\xf7\x7c\x20\x42\x70\xa1\x35\xe8\x24\x10\x21\x2c\xc1\x75\x9d\x3b\x9a\x13\x81\x22\xa2\x86\x24\xc1\x45\xaa\xbc\x8e\x91\x47\x72\x71\x4d\x32\xdc\x09\xa0\x13\xcf\x79\xc7\xdf\xc1\x68\x43\xd5\x9c\x4a\x14\x64\x17\xab\x86\xa0\x13\xc0\xdb\x5f\xdf\xbd\xf3\x5d\xd7\xc9\x50\x09\x79\x17\xac\xc4\x47\xa2\x22\x03\x45\x25\x10\xcf\x19\xce\x8c\xca\x0c\x99\x5c\x6c\xe5\xd4\xb7\x96\xcf\x24\x68\x2b\x9f\xbe\xb5\x7c\x26\x6b\x5b\xf9\xf4\x6d\xc9\xa7\xf3\xd6\xe0\x3b\x62\xed\x78\x0c\x53\x95\xef\xad\xfa\x2a\x94\x0c\x77\x23\xf5\x5b\x05\x34\x4f\x33\xfc\x46\x6d\x34\x64\xfa\x9c\xa7\xb5\x89\x1b\x9a\xcb\xdb\x74\x91\xc5\x1d\x8d\xae\x4e\x72\x17\xee\x70\x8a\x51\x9f\x5c\x51\xf6\x07\x4e\x69\x8c\x15\xe5\xcc\xf3\x51\x79\x20\x9e\xeb\x38\x86\xc5\x1a\x1f\x73\x15\x66\xb9\x5a\x7a\x3b\xd1\x0b\xa0\x7d\x7c\x9e\x0e\x9b\xa9\x5a\x47\x79\xac\x74\x8c\xb9\xf2\xcc\x3f\xe1\x6d\x81\x53\xe9\x6d\x87\x3d\x80\x37\xb5\x12\x4b\x79\xae\x27\x15\xbc\xb5\x9a\x9a\xf0\x3c\x3d\x75\x6e\x6b\x45\x2b\x8a\xeb\xf8\x68\x70\x4d\x16\x37\x9e\xce\x09\x4d\x4c\xef\xbd\xe8\x02\xa3\xa9\xee\x46\x47\x10\x55\x08\xa6\xa9\xae\xf3\xe8\xba\xce\xfe\x3e\x0c\x04\xc1\x8a\x00\x06\x81\x59\xcc\x33\xfa\x0f\x89\x21\x9e\x83\x76\x0d\x19\x15\x29\x61\x5e\xb3\x88\x7c\xe8\x76\xe1\x8d\x51\xb7\x56\x5b\xb5\x06\x14\x29\x3c\x4f\x89\xbd\xf0\xaa\xc6\xf3\xad\x4d\x9a\xc0\x8b\x56\x81\x69\x4d\xa5\xab\x5d\xc8\x50\x2c\x78\x3e\x33\x6a\x3d\xff\xfd\x7a\x00\xad\x08\x9c\xc7\xb6\xe4\xc2\x84\xf2\x64\x59\xd7\x71\xac\x84\x76\xe2\xa0\x0b\xe4\x1b\x59\xa0\x01\xcf\x32\xcc\x62\xaf\x53\xd6\x76\x00\x9d\x9f\xa3\x4e\x00\x76\x22\xe8\xd3\xef\xe6\xa4\x8b\x51\x9f\x4e\xcc\x49\xf7\xaf\x3e\xc5\xe6\xd4\xc0\x4a\x1b\x49\x02\xe3\xc9\x41\x17\xb8\x44\x93\x9c\x30\xaf\x63\xe0\x91\x17\x76\xea\x21\x79\x9b\xea\xae\xdb\x90\xaf\x86\xcb\x5c\x48\xf4\x45\xe0\xdc\x23\x42\x1b\x4e\x30\x4d\x49\x0c\x8a\x03\xcf\x09\x83\xef\x14\x42\x42\x53\xd3\xcb\x36\xd0\x98\x24\x44\x80\x1e\xda\x7a\xb2\xc3\x05\x74\x21\x41\x83\x94\x4b\xe2\xf9\xf0\x68\xaa\xc5\x91\x2a\x2e\xfd\x7c\x35\x5f\x2a\x22\x51\xbf\x48\x12\x33\xef\x1b\x40\xa1\x48\xc5\x66\x25\x30\x72\x7f\xf8\x99\x2c\x87\x44\x2a\xc1\x97\x44\x78\x8d\x5d\x1b\x40\xe2\xaf\x0b\xd9\x24\x59\x1b\x6e\x33\x6f\x4d\x2e\x2c\xd4\xee\xc4\x6d\x45\x41\x6a\x59\xb0\x49\x83\x85\x4d\xe2\x2a\xfc\x0d\xc6\xbe\x60\xba\xd1\x56\x92\x29\x74\x22\x28\x53\x29\xd3\x46\xfc\x75\x9a\x8d\xa0\xec\x55\xcf\xf7\x9f\xe8\xdf\x3d\xa6\x0a\x12\x2e\x36\xbb\x68\xbc\x2c\xb5\x30\x9a\xee\x58\xb0\x32\x1d\xf1\x98\x78\x66\xfc\xdb\x45\xee\x97\xbf\xda\x7d\x79\x4f\xd5\xe2\x1a\xcc\xed\x83\xeb\x2c\xb0\x24\xe5\x9e\x3c\x58\x75\xbf\x25\x54\xb7\x09\x4e\x65\xfb\xda\x52\x5c\x5d\x33\x7a\x9d\x36\xaf\x62\x2a\x75\xa1\x75\xb4\xc3\x5b\x7d\x6c\xb7\xe1\xea\x2d\xa0\xab\xf2\xa0\x0b\x1a\xcc\x28\xd7\x68\x26\xde\xa5\xeb\x0c\xa6\x61\x6f\x16\xc2\xb0\x37\xeb\xf5\x7b\x51\x08\x2f\xe5\x7b\xd7\xf9\x38\x71\x1d\xfb\x28\x5b\xd1\xcf\xde\x9e\x4b\xd7\x89\xc2\x19\x4c\xc3\xde\xf0\x62\x30\x19\x8d\x8e\x66\xb3\x70\x78\x11\x8d\x7b\x27\xd1\xa7\xc9\x0c\x26\x63\x23\x7a\xb9\xde\x83\x95\xfb\x19\x12\x05\x1b\x64\xb1\x27\x6f\xd3\x00\x9e\xdf\xe1\xfe\xf6\x98\x9b\x43\x6b\x15\xf1\xfe\x3e\x44\x94\x2d\x08\x8c\x22\x88\x4e\x8f\xe1\x97\x37\x6f\x7f\x03\xaa\x60\x81\x19\xcc\x09\xc4\x9c\x11\xb8\xa7\xea\xda\x70\x0e\xa7\x93\x93\x55\xb8\x67\x70\x74\x08\xe1\x9f\x47\xd1\x2c\x82\x73\x78\x80\x18\x2b\x3c\xc7\x92\x5c\xe8\xc1\x0c\xff\xae\xce\x92\xe1\x5c\x5e\x73\x65\x2f\x1e\xe1\x0c\x02\x84\x10\x83\x73\x38\x7b\x7f\xbe\x0d\xf4\x5a\xb7\x17\x85\xc7\xe1\x60\x66\xc6\x3d\x1c\x4e\x27\x23\x90\x4b\x89\x2a\xe5\x12\x5c\xc7\xf9\xf2\x29\x9c\x86\x96\xa1\x0b\xaf\x5f\xca\xd7\xba\x64\xdb\xce\xbe\x94\x1b\x70\xff\x1f\xb2\xa0\x08\x16\x31\xbf\x67\xcd\x1c\xd0\x44\xef\x14\xfb\x00\x6f\xf4\x79\x45\xab\x86\xe0\x8f\x77\xd3\xc1\xf3\x97\xd3\x53\xbb\xba\x02\x44\x8f\xd6\xa0\x1a\x0d\x65\x5b\x07\x80\xc5\x95\x04\x84\x50\xd5\xee\x75\x68\x8b\x0d\x7b\xab\x14\xb6\x52\x08\x21\xdf\xb0\xd5\x53\xdb\xea\x90\x68\x4c\xee\xa7\x04\xc7\x44\x58\xa3\x7a\xfe\x4b\x15\xf3\x42\x6d\x1c\xff\x3b\x36\x43\xa9\x5c\x4b\x9a\xe9\xce\x0b\x55\x13\x5b\x23\xbf\x01\xa3\xbe\x9e\x16\x6c\x03\x82\xcd\x41\x5b\x0d\x4f\x51\x30\x46\xd9\xd5\x41\xa7\x46\xc6\x06\xe7\xbb\xdf\x0d\x66\x5e\xa8\xd6\x60\xfe\xc1\xdc\x5e\x7f\x0d\x3d\x25\x55\x0b\xce\x74\x79\x79\xe5\x77\x5c\x60\xb3\xe1\xef\xa8\xb4\xba\xec\xed\x55\x60\xf4\x1b\x7b\xed\x8f\x23\x67\xc5\x51\x02\x77\x9b\x96\xcf\x05\xe3\x41\x27\x80\x58\xd0\x3b\x22\x90\x59\xb3\xfd\x82\xa6\xf1\x69\x41\xc4\xb2\x0c\xa9\xea\x95\xea\x35\xb2\xde\x8b\xb6\xaf\xec\x17\x86\xfe\x2d\x5f\x8d\x1a\x89\xad\x0f\x45\x46\xd3\xe0\x3b\x7c\xda\x91\x3c\xba\xff\x05\x00\x00\xff\xff\x68\x86\x33\x94\x69\x0f\x00\x00")
+var _templates_testSingletonMssql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xdd\x53\xdb\xb8\x16\x7f\xb6\xfe\x8a\xd3\xcc\xb4\xb5\xb9\xbe\xa2\xbd\xbd\xb3\x0f\x74\x32\x9d\x7c\x98\x96\x29\x49\x20\xce\x6e\x77\x87\xb2\xa0\xc4\x32\x68\xb0\x25\x23\xc9\xd0\x2c\x9b\xff\x7d\x47\x92\x9d\x38\x21\xa1\xf0\xb4\x0f\x99\x8c\xce\x97\xce\xf9\x9d\x2f\xf9\x8e\x48\x90\x57\x3f\x06\x71\x7c\x7a\x7c\x43\xe7\xd0\x06\x49\xaf\xe8\x8f\x02\x0f\x4a\xa5\x7b\x22\x2f\x58\x46\xfd\x4b\xff\x53\x1e\xfc\xd9\x39\x9e\x44\x63\x98\x74\xba\xc7\x11\xe0\xbd\x4e\xbf\xff\x5d\xfd\xa7\x37\x1a\xc6\x93\x71\xe7\x68\x38\x01\xbc\x07\x87\xa3\x71\x74\xf4\x79\x08\x5f\xa3\x3f\xf0\xde\x27\xbc\xf7\x9d\x7f\x1a\x47\x87\xd1\x38\x1a\xf6\xa2\x18\xef\x5d\x06\x08\xe9\x79\x41\x21\x57\xea\x36\x9b\x50\xa5\xa9\x04\xa5\x65\x39\xd3\xf0\x80\xbc\x64\xda\x13\x9c\x03\x00\xc0\x9e\xba\xcd\x70\xbf\x8b\xbc\x64\x3a\x24\x39\xb5\x34\xa5\x25\xe3\x57\xc8\xbb\x16\x4a\x9b\x73\x83\x54\x2a\x2a\x37\x48\x05\x51\x6a\x83\xa4\x54\x96\x8b\x84\x36\x49\x85\x90\xb5\x2d\xc6\x35\xf2\x34\x55\xba\xdf\xb5\x57\x2e\xb5\x6e\x58\x11\x9f\x1e\xf7\xf2\x04\xa6\x42\x64\x68\x81\x50\x5a\xf2\x19\x30\xce\xb4\x1f\x38\xbf\x07\x84\x71\x68\xc3\x9b\x46\x5c\x0f\x8b\xa5\xa4\x9f\xc3\x5e\x83\x13\x80\xa2\xba\x2c\xfc\x00\xa8\x94\x42\x1a\x0b\x26\x09\x54\xda\x9f\x90\x08\x79\x77\xac\xa0\x12\xc7\x54\xf7\x69\x4a\xca\x4c\xfb\x2d\xab\x8f\xd5\xec\x9a\xe6\xa4\x15\x42\x2b\x99\x8a\x56\xf0\x84\xa0\x0b\xd5\x48\x6a\x59\xd2\xa7\x44\x0d\x04\xad\x10\xde\xff\xff\xc3\x87\x00\x21\x2f\xc7\x15\xe4\x6d\x70\x1a\x9f\xa9\x8e\x2d\x14\xb5\x42\x32\xe5\x24\xb7\x26\x73\x6c\x73\xb1\x53\xd2\x70\x9d\x9c\x4d\xd0\x4e\x39\xc3\x75\x72\x36\x6b\x3b\xe5\x0c\xb7\x92\x33\x79\x6b\xc8\x1d\xf1\xf5\x78\xac\x50\x9d\xef\x9d\xf6\x2a\x01\x67\xb2\x91\xfa\x9d\x0a\x46\xa6\x19\x7e\xa3\x36\x1a\x3a\x5d\x21\xb2\xe5\x15\x37\xac\x50\xb7\xd9\x2c\x4f\x5a\x06\x5d\x93\xe4\x36\xdc\x91\x8c\xe0\x2e\xbd\x62\xfc\x37\x92\xb1\x84\x68\x26\xb8\x1f\xe0\xea\x40\x7d\xe4\x79\x56\xc4\xe1\x3e\x14\x3a\xca\x0b\x3d\xf7\x77\x38\xe5\xd0\x0b\x61\xfd\xf8\x32\x1b\x2e\x53\x21\xac\x1f\x6b\x1b\x43\xa1\x7d\xeb\x50\x74\x5b\x92\x4c\xf9\xbb\x61\x0f\xe1\xdd\xd2\x88\x2d\xac\xe0\xa5\x9e\xd4\xf0\x86\xb0\x49\x78\x99\x9d\x65\x6e\x43\x78\x44\x41\x5e\x80\x7b\xd7\x74\x76\xe3\x9b\x9c\xb0\xd4\xf4\x1d\xbc\x6a\x03\x67\x99\xe9\x46\x4f\x52\x5d\x4a\x6e\xa8\xc8\x5b\x20\xe4\xed\xef\x43\x4f\x52\xa2\x29\x10\x90\x84\x27\x22\x67\x7f\xd1\x04\x92\x29\x18\x5f\xb1\x35\x91\x51\xee\x37\x8b\x28\x80\x76\x1b\xde\x59\x73\x1b\xb5\xb5\xb4\x80\x63\x4d\xa6\x19\x75\x0c\xbf\x6e\xbc\xc0\xdd\xc9\x52\x78\xb5\x56\x60\xc6\x52\xe5\x6a\x1b\x72\x9c\x48\x51\x98\x09\xda\xef\xfa\xc1\xc7\xcd\x00\xd6\x22\xf0\x16\xeb\x9a\x33\x1b\xca\xb3\x75\x91\xe7\x39\x0d\x53\xe5\x07\x6d\xa0\x3f\xe8\x0c\xf7\x44\x9e\x13\x9e\xf8\xad\xaa\xb6\x43\x68\xfd\x37\x6e\x85\xe0\x26\x82\x39\xfd\x6a\x4f\xa6\x36\xcd\xe9\xc4\x9e\x4c\xff\x9a\x53\x62\x4f\x0d\xac\x10\xf2\xbc\x34\x34\x57\xc2\x41\x1b\x84\xc2\xa3\x82\x72\xbf\x65\xe1\x51\x17\x6e\xea\x61\x75\x9b\xb5\x82\x55\x28\xdb\x5d\x16\x52\xe1\x6f\x92\x14\x3e\x95\x32\x84\x56\x4a\x58\x46\x13\xd0\x02\x44\x41\x39\x3c\x32\x08\x29\xcb\xec\x28\x73\x81\x26\x34\xa5\x12\xcc\xd0\x36\x93\x1d\x2e\xa0\x0d\x29\xee\x65\x42\x51\x3f\x80\x85\xad\x16\x4f\xe9\xa4\xf2\xf3\xcd\x74\xae\xa9\xc2\xdd\x32\x4d\xa9\x7c\x58\x34\x81\xc2\xb1\x4e\xec\x4a\xe0\xf4\xfe\xf0\x2b\x9d\xf7\xa9\xd2\x52\xcc\xa9\xf4\x1b\xbb\x36\x84\x34\xd8\x54\x32\xa6\xdb\xe0\xee\x40\xcd\xbc\x35\xa5\x88\xd4\x4f\x27\x6e\x27\x0a\x4a\x13\xa9\xc1\x25\x0d\x66\x2e\x89\xab\xf0\xb7\x5c\xf6\x8d\xb0\xad\x77\xa5\xb9\xc6\x27\x92\x71\x9d\x71\x73\x49\xb0\x49\x73\x11\x54\xbd\xea\x07\xc1\x33\xfd\xbb\x27\x4c\x43\x2a\xe4\x76\x17\xad\x97\x95\x15\xce\xb2\x27\x16\xac\xca\x06\x22\xa1\xbe\xe9\xf7\x6a\x91\x07\xd5\xbf\x71\x5f\xdd\x33\x3d\xbb\x06\xcb\x7d\x40\xde\x8c\x28\x5a\xed\xc9\x83\x55\xf7\x3b\x42\xcd\x4d\x49\xa6\xd6\xd9\x8e\x82\xbc\xc4\xad\xd3\x26\x2b\x61\xca\x14\x5a\x0b\x79\x4f\x3c\x02\xd6\xdb\x70\xf5\x16\x30\x55\x79\xd0\x06\x03\x70\x5c\x18\x34\x53\xff\x12\x79\xbd\x71\xd4\x99\x44\xd0\xef\x4c\x3a\xdd\x4e\x1c\xc1\x6b\xf5\x11\x79\x9f\x47\xc8\x73\x8f\xb2\x15\xfd\xec\xfd\xb9\x42\x5e\x1c\x4d\x60\x1c\x75\xfa\x17\xbd\xd1\x60\x70\x34\x99\x44\xfd\x8b\x78\xd8\x39\x89\xbf\x8c\x26\x30\x1a\x5a\xd5\xcb\xcd\x1e\xac\xdd\xcf\xb1\x2c\x79\x2f\x4f\x7c\x75\x9b\x85\xf0\xf2\x0e\x0f\x76\xc7\xdc\x1c\x5a\xab\x88\xf7\xf7\x21\x66\x7c\x46\x61\x10\x43\x7c\x7a\x0c\xff\x7b\xf7\xfe\x17\x60\x1a\x66\x84\xc3\x94\x42\x22\x38\x85\x7b\xa6\xaf\xad\x64\x7f\x3c\x3a\x59\x85\x7b\x06\x47\x87\x10\xfd\x7e\x14\x4f\x62\x38\x87\x07\x48\x88\x26\x53\xa2\xe8\x85\x19\xcc\xf0\xf7\xea\xac\x38\x29\xd4\xb5\xd0\x8e\xb1\x80\x33\x08\x31\xc6\x1c\xce\xe1\xec\xe3\xf9\x2e\xd0\x97\xb6\xfd\x38\x3a\x8e\x7a\x13\x3b\xee\xe1\x70\x3c\x1a\x80\x9a\x2b\x5c\x1b\x57\x80\x3c\xef\xdb\x97\x68\x1c\x39\x81\x36\xbc\x7d\xad\xde\x9a\x92\x5d\x77\xf6\xb5\xda\x82\xfb\xbf\x90\x05\x4d\x89\x4c\xc4\x3d\x6f\xe6\x80\xa5\x66\xa7\xb8\x07\x78\xa3\xcf\x6b\x5a\x3d\x04\x7f\xbe\x9b\x0e\x5e\xbe\x9c\x9e\xdb\xd5\x35\x20\x66\xb4\x86\xf5\x68\xa8\xda\x3a\x04\x22\xaf\x14\x60\x8c\xeb\x76\x5f\x86\x36\xdb\xb2\xb7\x2a\x65\xa7\x85\x31\x0e\x90\x37\x6b\x4c\x6d\x67\x43\xe1\x21\xbd\x1f\x53\x92\x50\xe9\x2e\x35\xf3\x5f\xe9\x44\x94\x7a\xeb\xf8\x7f\x62\x33\x54\xc6\x8d\xa6\x9d\xee\xa2\xd4\x4b\xe2\xda\xc8\x6f\xc0\x68\xd8\xe3\x92\x6f\x41\xb0\x39\x68\xeb\xe1\x29\x4b\xce\x19\xbf\x3a\x68\x2d\x91\x71\xc1\x05\x1b\xf2\xee\xf2\xb5\xc1\xfc\x93\xb9\xdd\x4c\xd7\x73\x53\x35\x13\xdc\x94\x97\x5f\x7d\xc7\xd9\xd5\x2e\x64\xf0\x44\xa5\x55\x56\x6b\x56\x68\xed\xdb\x6a\x5b\xff\x38\xf2\x56\x12\x15\x70\xb7\x59\xf5\x5c\xb0\x45\xde\x0a\x21\x91\xec\x8e\x4a\x6c\x3f\x69\xbb\x25\xcb\x92\xd3\x92\xca\x79\x15\x52\xdd\x2b\xf5\x6b\x64\xb3\x17\x5d\x5f\xb9\x2f\x0c\xf3\x5f\xbd\x1a\x83\x60\x99\x9a\xc7\x4e\x73\x96\x85\x8f\xf0\x59\x8f\x64\x81\xfe\x19\x00\x68\x86\x33\x94\x69\x0f\x00\x00")
 
 func templates_testSingletonMssql_main_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +176,7 @@ func templates_testSingletonMssql_main_testGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonMssql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xc1\x0a\xc2\x30\x10\x44\xef\xfd\x8a\xa5\xe4\xd0\x4a\x9b\x0f\x10\x3c\x78\xd4\x83\x88\xb4\x1f\x10\xed\xb6\x04\xe2\x5a\xba\x5b\x10\x42\xfe\x5d\xd2\x46\xe9\xc1\xdb\x0c\x6f\x32\x99\xed\x67\x7a\x40\x83\x2c\xed\xc8\x38\x49\x21\xb0\x13\x64\xb1\x34\xe8\xa6\x04\x9f\x01\x78\x5f\xc3\x64\x68\x40\x50\x96\x3a\x7c\x57\xa0\xc4\xdc\x1d\xc2\xfe\x00\xba\x89\x8a\x43\x48\x39\xdb\x27\xa8\x4f\x7c\x7e\x59\x5a\x30\xd4\x3f\x8e\x8e\xb7\x56\x19\x67\x0d\xc7\x22\xa5\x8f\x51\x22\xaf\x8d\xdf\x96\x8b\x79\xe2\x92\x16\x7d\x9b\xa9\xc8\xbd\x5f\x9f\xe8\x76\xbc\xba\x79\x32\x2e\x84\xbc\x82\x38\xf8\x0f\x59\x2f\x2a\x97\xbf\x90\xba\xed\x8c\xe4\x42\xf6\x09\x00\x00\xff\xff\x11\x5d\x4c\xce\xff\x00\x00\x00")
+var _templates_testSingletonMssql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x90\xc1\x4a\xc4\x40\x0c\x86\xef\x7d\x8a\xb0\xf4\xd0\xca\xee\x3c\x80\xe0\x41\x3c\xe9\xc1\x95\xa5\xeb\x3d\x6e\xb3\x4b\x60\x9a\x29\x93\x14\x94\x61\xde\x5d\xda\xad\xda\x45\xf1\x22\x78\x4b\x26\x7f\xfe\xc9\xff\x1d\x07\x39\x40\x43\x6a\xfb\x5e\x29\x5a\x65\x70\x65\xa4\xc6\x72\x72\x4d\x0d\xa9\x00\x48\x69\x03\x11\xe5\x44\x50\xb2\xb4\xf4\xba\x86\xd2\xf0\xc5\x13\x5c\xdf\x80\x6b\xc6\x4a\x73\x9e\x75\x7c\x84\x10\xe7\xb9\xbb\xd7\x87\xc0\x32\x29\xbe\x9e\x76\x84\xed\x56\xfc\x1b\x6c\x3e\x97\xc8\x2b\x2d\xda\x12\x3d\xa3\x8e\xee\xa5\xbb\x1d\x4b\x52\x77\x61\xf2\x88\x1d\x4d\x6a\x73\xbb\x41\xaa\x55\x4a\xe7\x15\xb7\xef\x9f\xfc\x10\xd1\xe7\xbc\x5a\xc3\x98\xe2\x87\xc9\x39\x66\x3d\xfd\x45\xd2\x2e\xcf\x98\xbb\x5c\x14\x0b\x28\x2d\x1a\x6d\x7b\xe3\x8e\xd5\xf8\xf0\x77\x3c\x28\xed\x47\x8e\x67\x8a\xca\x41\xee\x82\x1f\x3a\x81\x4a\x82\x7d\xc7\x54\xff\x23\x98\xcb\xa8\xbf\x23\x7a\x1f\x00\xac\xf5\xc2\xc3\x37\x02\x00\x00")
 
 func templates_testSingletonMssql_suites_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,11 +192,31 @@ func templates_testSingletonMssql_suites_testGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/singleton/mssql_suites_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x10, 0xc4, 0x71, 0xaf, 0xd9, 0x16, 0x41, 0x8b, 0x4b, 0xfc, 0xe8, 0xba, 0xfd, 0xfa, 0x4d, 0x2c, 0x1, 0xd1, 0x0, 0xe1, 0xb0, 0x78, 0xee, 0x7f, 0xd0, 0x65, 0xf3, 0xa1, 0x43, 0xba, 0x3c, 0xe7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe0, 0x4f, 0x39, 0x38, 0xf6, 0x70, 0xb0, 0x6f, 0xb8, 0x4e, 0xd2, 0x9e, 0x3a, 0xb6, 0xf8, 0x7e, 0x8, 0x98, 0xb5, 0xc7, 0x8d, 0x39, 0xf3, 0x25, 0xe2, 0x20, 0xb8, 0xc6, 0x4b, 0x10, 0x85, 0xa}}
+	return a, nil
+}
+
+var _templates_testUpdate_optimisticGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\x62\x62\xb4\x05\xb5\x70\x88\x3d\x14\x3d\xa4\xf0\x21\x89\x53\x60\x51\x6c\x1a\xac\x9d\xf6\xb8\x60\xc4\xa1\x43\x84\x26\x55\x72\x14\xdb\x15\xf8\xdf\x0b\xea\x23\x76\x1b\x6b\xdb\x02\x71\xb1\x07\x1b\x12\xf5\x66\xe6\xbd\x37\x43\x4a\x4d\x73\x0e\x5a\x01\x5f\x8a\x07\x83\xfc\x57\xf4\x41\x3b\x7b\xed\x4c\xbd\xb6\x70\x1e\x63\x9e\x9e\x7f\x23\x8c\x16\x01\x2e\x66\xc0\x2f\xd3\x15\x86\x0e\x3e\x44\xdd\x8a\x35\xee\xc1\xcf\x5d\x8e\x9f\x34\x1a\x99\x62\xba\x68\xde\xe7\x3c\x56\x28\xc6\x5c\xd5\xb6\x04\xc2\x40\x4d\xd3\xe3\xef\xab\x3b\x53\x7b\x61\x62\xbc\xaf\xa4\x20\xfc\xa5\x22\xbd\xd6\x81\x74\xc9\x08\xde\x25\xa8\xb6\x2b\xbe\x2c\xa0\xc9\x33\xe2\x77\xc2\x0b\x63\xd0\xb0\x22\xcf\x33\xad\xe0\x3d\xcc\x66\x60\xd0\xb2\x97\x7c\x73\xb7\xb1\x0b\x6d\x57\xb5\x11\x3e\xc6\x3b\xaf\xd7\xc2\xef\x7e\xc6\x5d\x47\x21\xb4\x79\x32\xe2\x8b\x27\x5d\xb1\x49\xfa\xaf\xb4\x5d\x01\x25\xb6\xb0\xd1\xf4\x08\xd6\x41\xd5\x45\xc1\x13\xee\xa0\xec\xe2\x26\x45\x9e\xc5\xb6\xe4\x17\xaa\x5d\x1a\xf3\x52\xe6\xcd\x79\x39\x6b\x76\xe3\xcc\xf2\x2c\x20\xb6\x7d\xf0\xc2\x4a\xb7\xd6\x7f\x20\xbf\xc5\xcd\x02\x51\xb2\x22\xcf\x9e\x85\x07\xf4\xed\xcf\xf9\x3c\x73\x09\xf8\xdd\x0b\xb7\xfb\x6a\xcf\xac\xe9\x54\x26\xf0\x41\xae\x05\xf9\xba\x24\x96\x6a\x4c\xc1\x4d\x61\x44\xd6\xfc\x6a\xb9\xab\x30\x4c\x81\x7c\x8d\xa3\xa8\x5e\xf2\x6f\x9a\x1e\xe7\xa8\x44\x6d\x88\x73\x5e\xfc\x98\xc8\xc1\xd9\x0c\xac\x36\xbd\x19\x37\xde\x3b\xaf\xd8\xe4\xde\xb6\xed\x21\xb7\x27\x04\x47\xc9\x43\x68\x79\x5e\xc0\xb7\x61\x32\x4d\xf9\x7a\x6f\x9a\x46\x2b\xb0\x8e\x80\xdf\xba\x6b\x67\x09\xb7\x14\x63\x49\xdb\x64\x43\xd9\xdd\xf3\x2b\x51\x3e\xad\xbc\xab\xad\x64\x45\xd3\xa0\x95\x31\xe6\x59\x07\xf9\x58\x07\x5a\x6e\x59\x9b\xe5\x30\xc3\x83\xd3\x86\x5f\xe1\x4a\xdb\x36\xc4\x04\x3c\x5c\x5b\x6e\x59\x49\xdb\x69\xd2\x33\x24\x2c\xf2\x4c\xa2\x42\x0f\x69\x1f\xb0\x02\x1a\xf8\x0c\x33\xa0\x2d\xff\xe4\x8c\x79\x10\xe5\x13\x2b\x20\xb2\xe2\xa0\x03\x8e\x7f\xb0\x01\x3d\xb1\x31\x09\xc9\x65\xb4\x32\xed\x4b\x48\xd5\xda\xfa\x1f\xac\x42\xcf\x8a\x51\x4f\xd9\xde\x9a\x40\xc2\x60\x12\xf9\xce\xe5\x27\xef\xfc\xab\xb1\x3f\x6d\xe3\x33\xc7\x9b\xe6\x2f\x07\x55\x8c\x30\x83\x56\xf2\xeb\x27\x9d\xfc\x43\x9f\x3f\xb9\x4d\xb8\x54\x0a\x4b\x42\x19\xe3\xe7\xde\xea\x18\x87\xd6\xbc\x3a\xb1\x4e\xd3\xa4\x44\xcb\xa3\x32\x58\x12\x9f\x23\x56\x37\xbf\xd7\xc2\xb0\x23\xda\xa6\x63\xd2\x86\xd3\xa5\xcb\x3b\xd9\x08\x4b\xd0\x23\xc0\xa3\xf2\x18\x1e\x51\x82\x50\x84\x1e\xea\x56\xd4\x70\xaa\x8c\xdb\x91\x8e\x14\xdf\xad\x80\xb6\xf4\xc3\xf7\x79\xd6\xdf\xfe\xcd\xa7\x8e\xd3\xdb\x78\xf5\x32\xa1\x67\x33\xb8\xf1\xfe\xda\xd9\xb2\xf6\x1e\x2d\x7d\x74\x52\x2b\x5d\x0a\x4a\x92\x0e\xc4\xaa\x5e\xed\x38\x58\x39\x0f\xa2\x33\x6e\xf0\x64\x0a\x2b\x97\x66\xe9\xf9\x70\x96\xfa\x37\xe8\x51\x2f\x5a\x5a\x83\x19\x67\x33\x78\x7f\xc4\x6f\xeb\x5a\x04\x88\x3e\x6a\xbc\xf0\x64\x3a\x18\xbb\xaf\x8c\xf6\x6b\x9c\xd0\xc9\x7e\x78\x7a\x05\x10\x1e\x5d\x6d\x64\x3f\x45\x20\x56\x42\xdb\x8b\x03\x1b\xff\xb3\x82\x39\x1a\x24\xfc\x97\xbc\x5b\x94\xb0\x12\xf8\xa5\x94\x0b\xa7\xa8\x8b\x0e\xc3\x17\xcc\xb5\xb0\xfb\xd5\x18\xbb\xf7\x54\x5f\xf0\x9f\xf7\xe1\x97\x76\x43\xdf\xb0\x13\x35\xe0\x64\x63\x2f\x5b\x7f\x64\x9a\xb7\xff\x73\xe8\x5f\x95\x1d\x1f\xf9\xf4\x69\x8a\x56\xc2\x79\x8c\xf9\x9f\x03\x00\x94\x6e\xd5\x57\xc5\x0a\x00\x00")
+
+func templates_testUpdate_optimisticGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testUpdate_optimisticGoTpl,
+		"templates_test/update_optimistic.go.tpl",
+	)
+}
+
+func templates_testUpdate_optimisticGoTpl() (*asset, error) {
+	bytes, err := templates_testUpdate_optimisticGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/update_optimistic.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x44, 0xf4, 0xbe, 0xe4, 0xa1, 0x15, 0x52, 0x62, 0x58, 0xf4, 0x58, 0x5a, 0x56, 0x96, 0xed, 0x22, 0x1, 0x5f, 0x5e, 0x40, 0xff, 0x8b, 0x2b, 0x19, 0xf, 0xc8, 0x3f, 0xb8, 0x7, 0x2a, 0x5e, 0x90}}
 	return a, nil
 }
 
-var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x6f\xdb\x30\x0c\x3d\x5b\xbf\x82\x0b\xb6\x41\x1e\x5c\x15\xbb\x76\xc8\x21\xfd\x38\x14\xc3\x82\xa0\x71\xce\x83\x6a\xd3\xa9\x10\x45\x32\x24\x7a\x49\x66\xe8\xbf\x0f\x92\xd3\x36\x6d\xda\xa1\x87\xed\xd0\x43\x62\x4b\x78\x7c\x8f\x7c\x24\xdd\xf7\x27\xf0\x51\x6a\x25\x3d\x9c\x8d\x41\x4c\xe2\x1b\x7a\x51\xca\x5b\x8d\x30\x3c\xc4\x54\xae\x31\x04\xd6\x74\xa6\x02\x42\x4f\x7d\x3f\x44\x88\x45\x3b\xd3\x9d\x93\x3a\x84\x45\xeb\xd1\x11\x27\xf8\x12\x01\xca\x2c\x45\x99\x43\xcf\x32\x12\x33\xe9\xa4\xd6\xa8\x79\xce\x58\xa6\x1a\xd0\x68\xf8\x03\xc1\xa5\xdd\x98\xb9\x32\xcb\x4e\x4b\x17\xc2\x44\xeb\x0b\xab\xbb\xb5\xf1\x39\x8c\xc7\x7f\x43\xce\x9c\x5a\x4b\xb7\xfb\x8e\xbb\x87\x80\x9e\x65\x19\x89\xf9\x4a\xb5\x7c\x14\xff\x5b\x65\x96\x40\xa9\x8c\x8d\xa2\x3b\xb0\x46\xef\xa0\x1d\xe2\x60\x85\x3b\xa8\x86\xc8\x51\xce\xb2\xc0\x58\xe6\x11\xeb\x68\x81\x93\xa6\xb6\x6b\xf5\x1b\xc5\x14\x37\x73\xc4\x9a\xe7\x2c\xfb\x25\x1d\xa0\x4b\x3f\xeb\x58\x76\x7a\x0a\x13\x22\x5c\xb7\x04\x74\x87\x70\x3d\x9d\x5f\xdd\x94\xe0\x55\x8d\x60\x1b\x90\x06\x16\xb3\x78\xc3\x32\x1b\x19\x0f\xec\x7a\xac\xa0\x0f\xc9\x8d\x48\x7a\xa8\x39\x27\xd7\x55\xc4\x63\x32\x05\x7c\xb6\x05\xbc\x62\xc0\xe5\x79\xb9\x6b\xd1\x17\x40\xae\xc3\xfc\x5b\xe2\xf9\x30\x06\xa3\xf4\xde\x88\xab\x98\x69\xc3\x47\x0b\x93\x2c\x20\xfb\x28\xf2\x72\x42\xe0\x93\xf4\x19\x7c\xf2\xa3\x22\xf2\xed\x7d\xe9\x7b\xd5\x80\xb1\x04\x62\x6a\x2f\xac\x21\xdc\x52\x08\x15\x6d\x63\x65\xd5\x70\x16\xe7\xb2\x5a\x2d\x9d\xed\x4c\xcd\xf3\xbe\x47\x53\x87\xc0\xb2\x01\xf2\xa3\xf3\x54\x6e\x79\x62\x39\x64\x38\xba\xb8\xb5\x4a\x8b\x73\x5c\x2a\x93\x38\xb4\xc7\xc3\xbb\x72\xcb\x2b\xda\x16\xb1\xc0\x7b\x85\x37\x81\x72\x96\xd5\xd8\xa0\x83\x38\xbc\x3c\x87\x1e\x7e\xc2\x18\x68\x2b\x6e\xac\xd6\xb7\xb2\x5a\xf1\x1c\x42\xec\xf0\x43\x2f\xac\xd8\xcf\xf2\x6b\x85\xc7\x9e\xa0\xa9\xe1\x24\x04\x88\xa7\xa4\x7f\x6d\x1a\x74\x3c\x7f\x7a\x7a\x5b\x5f\xba\x24\xf7\x72\x53\x8e\xba\x51\xd9\xce\x50\xba\x78\x36\x59\xf7\x8b\xc8\x73\x71\x11\x31\x6f\x4c\xff\xb1\xf2\xe3\x2c\xf9\xbd\x6c\x84\x24\xe1\x08\xfa\xfa\x04\x32\xda\x48\x43\x60\x0d\x82\xc3\xca\xba\xba\x80\xa5\xa5\xb3\x51\x31\xe0\xf7\x49\x3f\x5b\x97\xc5\xec\x72\x52\x5e\xbd\xb4\x2e\xff\x62\x21\x1a\xa9\x3d\xbe\x0a\x3b\xfa\x70\x08\x21\xfe\xeb\xfa\xbc\xbf\xb9\x7a\x27\x63\x15\xd8\x9f\x00\x00\x00\xff\xff\xf6\x71\x76\xb4\xbb\x06\x00\x00")
+var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x6f\xdb\x30\x0c\x3d\x5b\xbf\x82\x0d\xb6\x41\x1e\x5c\x15\xbb\x66\xc8\x21\xfd\x38\x14\xc3\x82\xa0\x71\xb0\xe3\xa0\xda\x74\x2a\x44\x91\x0c\x89\x5e\x92\x19\xfa\xef\x83\xec\x36\x4d\xbb\xb4\x0b\x86\xed\xd0\x43\x62\x4b\x20\x1f\x1f\x1f\x1f\xdd\xb6\xa7\xf0\x4e\x6a\x25\x3d\x0c\x47\x20\xc6\xf1\x0d\xbd\xc8\xe5\xad\x46\xe8\x1f\x62\x22\x57\x18\x02\xab\x1a\x53\x00\xa1\xa7\xb6\xed\x33\xc4\xbc\x9e\xea\xc6\x49\x1d\xc2\xbc\xf6\xe8\x88\x13\x7c\x8c\x01\xca\x2c\x44\x9e\x42\xcb\x12\x12\x53\xe9\xa4\xd6\xa8\x79\xca\x58\xa2\x2a\xd0\x68\xf8\x0e\xe0\xd2\xae\xcd\x4c\x99\x45\xa3\xa5\x0b\x61\xac\xf5\x85\xd5\xcd\xca\xf8\x14\x46\xa3\xd7\x22\xa7\x4e\xad\xa4\xdb\x7e\xc1\xed\x2e\xa1\x65\x49\x42\x62\xb6\x54\x35\x1f\xc4\xff\x5a\x99\x05\x50\xe4\x0f\x6b\x45\x77\x60\x8d\xde\x42\xdd\xe7\xc1\x12\xb7\x50\xf4\x99\x83\x94\x25\x81\xb1\xc4\x23\x96\x51\x02\x27\x4d\x69\x57\xea\x27\x8a\x09\xae\x67\x88\x25\x4f\x59\xf2\x43\x3a\x40\xd7\xfd\xac\x63\xc9\xd9\x19\x8c\x89\x70\x55\x13\xd0\x1d\xc2\xf5\x64\x76\x75\x93\x83\x57\x25\x82\xad\x40\x1a\x98\x4f\xe3\x0d\x4b\x6c\x44\xdc\xf5\x30\xaf\x1f\x3b\x68\x43\xa7\x46\x04\xdd\xab\x39\x23\xd7\x14\xc4\x23\x97\x0c\x3e\xd8\x0c\x5e\xe8\xff\xf2\x3c\xdf\xd6\xe8\x33\x20\xd7\x60\xfa\x39\xf2\x82\x93\x11\x18\xa5\xa3\xe8\x09\x89\x2b\xe7\xac\xab\xf8\x60\x6e\x3a\x05\xc8\x3e\xd6\x38\xcc\x07\x7c\x57\x7a\x08\xef\xfd\x20\x8b\x78\xf7\xb2\xb4\xad\xaa\xc0\x58\x02\x31\xb1\x17\xd6\x10\x6e\x28\x84\x82\x36\xb1\xb1\xa2\x3f\x8b\x73\x59\x2c\x17\xce\x36\xa6\xe4\x69\xdb\xa2\x29\x43\x60\x49\x1f\xf2\xb5\xf1\x94\x6f\x78\x87\xb2\x8f\xf0\xdb\xc5\xad\x55\x5a\x9c\xe3\x42\x99\x0e\x43\x7b\xdc\xbf\xcb\x37\xbc\xa0\x4d\x16\x1b\x7c\xa8\x70\x54\x50\xca\x92\x12\x2b\x74\x10\xbd\xcb\x53\x68\xe1\x3b\x8c\x80\x36\xe2\xc6\x6a\x7d\x2b\x8b\x25\x4f\x21\xc4\x01\x2b\x13\xfd\x1b\x55\x8f\x52\x0e\x47\x60\x45\x6f\xe9\x6f\x8a\xee\x6e\xd0\x37\x9a\xf8\x4b\x52\xc4\x29\xa1\x29\xe1\x34\x04\x88\xf5\x3b\x46\xd7\xa6\x42\xc7\xd3\xa7\xa7\x74\x37\xf3\x3f\x0c\xab\xe9\x6a\x1f\x9e\xd4\xb3\x11\x45\xc4\x93\x07\xfa\xfb\x80\x7c\xb0\x96\xa6\x37\xe8\x3d\x5c\x74\x01\xd6\xd6\x51\xb4\x68\x9f\xf2\xe0\xfe\xc2\x36\x86\x76\xcd\x1f\x58\x70\x9e\x8a\x8b\x18\x73\xa4\x0a\xaf\x75\xca\xf7\xa9\x77\x85\xa3\x77\x3f\x1d\xe0\x6e\x0d\x82\xc3\xc2\xba\x32\x83\x85\xa5\xe1\x20\xeb\xe3\xef\x49\x3f\x5b\xc3\xf9\xf4\x72\x9c\x5f\x1d\x5a\xc3\x7f\xb0\x68\x95\xd4\x1e\x33\x38\xf6\x7b\x24\x84\xf8\xaf\x6b\xf9\xd4\xaf\x6f\xcc\xae\x7f\xe1\xd6\xa6\x2e\x25\xe1\x01\xb7\xbe\x11\xb3\x06\xf6\x6b\x00\xee\x11\x6a\xb5\x69\x07\x00\x00")
 
 func templates_testUpsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +232,7 @@ func templates_testUpsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xea, 0xe3, 0xe8, 0x1e, 0xc3, 0xef, 0x74, 0x5d, 0xf, 0xf2, 0x30, 0xa1, 0x6, 0x82, 0x8f, 0x70, 0xe4, 0xb, 0xca, 0x51, 0x16, 0xa2, 0x3d, 0x8f, 0x40, 0x59, 0xec, 0xfe, 0x96, 0x39, 0x2, 0x36}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc7, 0xa0, 0x77, 0xf2, 0xf1, 0x1c, 0xd4, 0x70, 0x35, 0xa6, 0x2a, 0x53, 0xd5, 0xe7, 0xee, 0x95, 0xa9, 0xe1, 0x1b, 0xcb, 0x35, 0x99, 0xae, 0xd5, 0xec, 0x58, 0x33, 0x20, 0xee, 0x47, 0x9f, 0x42}}
 	return a, nil
 }
 
@@ -264,13 +327,19 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"templates/16_update_optimistic.go.tpl":             templates16_update_optimisticGoTpl,
 	"templates/17_upsert.go.tpl":                        templates17_upsertGoTpl,
+	"templates/singleton/mssql_optimistic.go.tpl":       templatesSingletonMssql_optimisticGoTpl,
 	"templates/singleton/mssql_upsert.go.tpl":           templatesSingletonMssql_upsertGoTpl,
 	"templates_test/singleton/mssql_main_test.go.tpl":   templates_testSingletonMssql_main_testGoTpl,
 	"templates_test/singleton/mssql_suites_test.go.tpl": templates_testSingletonMssql_suites_testGoTpl,
+	"templates_test/update_optimistic.go.tpl":           templates_testUpdate_optimisticGoTpl,
 	"templates_test/upsert.go.tpl":                      templates_testUpsertGoTpl,
 }
 
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
+
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
@@ -313,9 +382,11 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
-		"17_upsert.go.tpl": &bintree{templates17_upsertGoTpl, map[string]*bintree{}},
+		"16_update_optimistic.go.tpl": &bintree{templates16_update_optimisticGoTpl, map[string]*bintree{}},
+		"17_upsert.go.tpl":            &bintree{templates17_upsertGoTpl, map[string]*bintree{}},
 		"singleton": &bintree{nil, map[string]*bintree{
-			"mssql_upsert.go.tpl": &bintree{templatesSingletonMssql_upsertGoTpl, map[string]*bintree{}},
+			"mssql_optimistic.go.tpl": &bintree{templatesSingletonMssql_optimisticGoTpl, map[string]*bintree{}},
+			"mssql_upsert.go.tpl":     &bintree{templatesSingletonMssql_upsertGoTpl, map[string]*bintree{}},
 		}},
 	}},
 	"templates_test": &bintree{nil, map[string]*bintree{
@@ -323,7 +394,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"mssql_main_test.go.tpl":   &bintree{templates_testSingletonMssql_main_testGoTpl, map[string]*bintree{}},
 			"mssql_suites_test.go.tpl": &bintree{templates_testSingletonMssql_suites_testGoTpl, map[string]*bintree{}},
 		}},
-		"upsert.go.tpl": &bintree{templates_testUpsertGoTpl, map[string]*bintree{}},
+		"update_optimistic.go.tpl": &bintree{templates_testUpdate_optimisticGoTpl, map[string]*bintree{}},
+		"upsert.go.tpl":            &bintree{templates_testUpsertGoTpl, map[string]*bintree{}},
 	}},
 }}
 
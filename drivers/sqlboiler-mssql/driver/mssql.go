@@ -4,11 +4,15 @@ import (
 	"database/sql"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/url"
+	"os"
+	"regexp"
+	"strconv"
 	"strings"
 
-	// Side effect import go-mssqldb
-	_ "github.com/denisenkom/go-mssqldb"
+	// Also registers the mssql database/sql driver
+	mssql "github.com/denisenkom/go-mssqldb"
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 	"github.com/volatiletech/sqlboiler/v4/importers"
@@ -19,6 +23,14 @@ func init() {
 	drivers.RegisterFromInit("mssql", &MSSQLDriver{})
 }
 
+// defaultIdentityExpr is true for identity columns in the Columns query,
+// see drivers.ConfigIdentityColumnExpr
+const defaultIdentityExpr = "COLUMNPROPERTY(object_id($1 + '.' + $2), c.column_name, 'IsIdentity') = 1"
+
+// rgxIdentityExpr allows identifiers, literals, calls and comparisons but
+// nothing that could end the statement or start a comment
+var rgxIdentityExpr = regexp.MustCompile(`^[\w\s.,()\[\]'=<>!$@]+$`)
+
 //go:generate go-bindata -nometadata -pkg driver -prefix override override/...
 
 // Assemble is more useful for calling into the library so you don't
@@ -31,8 +43,33 @@ func Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, err error) {
 // MSSQLDriver holds the database connection string and a handle
 // to the database connection.
 type MSSQLDriver struct {
-	connStr string
-	conn    *sql.DB
+	connStr      string
+	conn         *sql.DB
+	char36AsUUID bool
+	bitAsInt     bool
+	bitDefaults  string
+
+	verifyColumnCount bool
+	warnings          io.Writer
+
+	identityExpr string
+
+	// sysKeys reads primary and foreign keys from the sys catalog views
+	// because information_schema lacks the views for them, see lacksKeyViews
+	sysKeys bool
+
+	// noCheckConstraints is true when the sys catalog lacks the
+	// check_constraints view, see CheckConstraintInfo
+	noCheckConstraints bool
+
+	// identitySeedQuery is the query that reads the seed and increment of an
+	// identity column, empty when none works on the engine. Assemble picks it
+	// before reading the tables concurrently, see findIdentitySeedQuery.
+	identitySeedQuery string
+
+	// openDB opens the connection, sql.Open when nil. Tests replace it
+	// to run Assemble against a fake database.
+	openDB func(driverName, dsn string) (*sql.DB, error)
 }
 
 // Templates that should be added/overridden
@@ -60,54 +97,206 @@ func (m *MSSQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 		}
 	}()
 
-	user := config.MustString(drivers.ConfigUser)
-	pass, _ := config.String(drivers.ConfigPass)
-	dbname := config.MustString(drivers.ConfigDBName)
-	host := config.MustString(drivers.ConfigHost)
-	port := config.DefaultInt(drivers.ConfigPort, 1433)
-	sslmode := config.DefaultString(drivers.ConfigSSLMode, "true")
-
 	schema := config.DefaultString(drivers.ConfigSchema, "dbo")
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
-
-	m.connStr = MSSQLBuildQueryString(user, pass, dbname, host, port, sslmode)
-	m.conn, err = sql.Open("mssql", m.connStr)
+	assembleOpts, err := drivers.AssembleOptionsFromConfig(config)
 	if err != nil {
-		return nil, errors.Wrap(err, "sqlboiler-mssql failed to connect to database")
+		return nil, err
+	}
+
+	m.char36AsUUID = config.DefaultBool(drivers.ConfigChar36AsUUID, false)
+	m.bitAsInt = config.DefaultBool(drivers.ConfigBitAsInt, false)
+	switch m.bitDefaults = config.DefaultString(drivers.ConfigBitDefaults, "numeric"); m.bitDefaults {
+	case "numeric", "bool":
+	default:
+		return nil, errors.Errorf("unknown bit defaults %q, must be numeric or bool", m.bitDefaults)
+	}
+
+	m.verifyColumnCount = config.DefaultBool(drivers.ConfigVerifyColumnCount, false)
+
+	if m.identityExpr, err = identityExpr(config); err != nil {
+		return nil, err
+	}
+
+	if err = m.open(config); err != nil {
+		return nil, err
 	}
 
 	defer func() {
-		if e := m.conn.Close(); e != nil {
+		if e := drivers.CloseConn(m.conn, err); e != nil {
 			dbinfo = nil
 			err = e
 		}
 	}()
 
+	if m.sysKeys, err = m.lacksKeyViews(); err != nil {
+		return nil, translateLockError(err)
+	}
+	if m.noCheckConstraints, err = m.lacksCheckConstraintView(); err != nil {
+		return nil, translateLockError(err)
+	}
+	m.identitySeedQuery = m.findIdentitySeedQuery(schema)
+
 	dbinfo = &drivers.DBInfo{
-		Schema: schema,
+		Schema:        schema,
+		DefaultSchema: "dbo",
+		SchemaQuery:   "SELECT table_name, column_name, is_nullable FROM information_schema.columns WHERE table_schema = $1",
 		Dialect: drivers.Dialect{
 			LQ: '[',
 			RQ: ']',
 
 			UseIndexPlaceholders: true,
-			UseSchema:            true,
+			UseSchema:            config.DefaultBool(drivers.ConfigUseSchema, true),
 			UseDefaultKeyword:    true,
+			MaxParams:            2100,
 
 			UseAutoColumns:          true,
 			UseTopClause:            true,
 			UseOutputClause:         true,
 			UseCaseWhenExistsClause: true,
+			UseCountBig:             true,
+			UseTableHints:           true,
+			UseWindowFunctions:      true,
 		},
 	}
-	dbinfo.Tables, err = drivers.Tables(m, schema, whitelist, blacklist)
+	dbinfo.Tables, err = drivers.TablesWithOptions(m, schema, whitelist, blacklist, assembleOpts)
 	if err != nil {
+		return nil, translateLockError(err)
+	}
+
+	drivers.ApplyConfig(config, dbinfo.Tables)
+	dbinfo.EngineVersion = drivers.EngineVersion(m)
+
+	enumTables, _ := config.StringSlice(drivers.ConfigEnumFromTable)
+	if dbinfo.Enums, err = drivers.EnumsFromTables(m, schema, dbinfo.Tables, enumTables); err != nil {
 		return nil, err
 	}
 
 	return dbinfo, err
 }
 
+// SelfTest connects and runs each introspection query on its own, see
+// drivers.SelfTest.
+func (m *MSSQLDriver) SelfTest(config drivers.Config) (report drivers.SelfTestReport, err error) {
+	defer func() {
+		if r := recover(); r != nil && err == nil {
+			report = nil
+			err = r.(error)
+		}
+	}()
+
+	schema := config.DefaultString(drivers.ConfigSchema, "dbo")
+	table, _ := config.String(drivers.ConfigSelfTestTable)
+
+	if m.identityExpr, err = identityExpr(config); err != nil {
+		return nil, err
+	}
+
+	if err = m.open(config); err != nil {
+		return nil, err
+	}
+	defer m.conn.Close()
+
+	if m.sysKeys, err = m.lacksKeyViews(); err != nil {
+		return nil, err
+	}
+	if m.noCheckConstraints, err = m.lacksCheckConstraintView(); err != nil {
+		return nil, err
+	}
+	m.identitySeedQuery = m.findIdentitySeedQuery(schema)
+
+	return drivers.SelfTest(m, schema, table), nil
+}
+
+// errNumSingleUser is the server error for a database another connection
+// holds in single user mode: "Database is already open and can only have
+// one user at a time."
+const errNumSingleUser = 924
+
+// translateLockError replaces the server error for a database locked by
+// another connection with one that says what to do about it
+func translateLockError(err error) error {
+	if e, ok := errors.Cause(err).(mssql.Error); ok && e.Number == errNumSingleUser {
+		return errors.Errorf("database is locked by another process; close other connections or set it back to MULTI_USER (%s)", e.Message)
+	}
+
+	return err
+}
+
+// identityExpr returns the configured identity detection expression or the
+// default. It's spliced into the Columns query so it's checked to be a plain
+// expression first.
+func identityExpr(config drivers.Config) (string, error) {
+	expr := config.DefaultString(drivers.ConfigIdentityColumnExpr, "")
+	if len(expr) == 0 {
+		return defaultIdentityExpr, nil
+	}
+
+	if !rgxIdentityExpr.MatchString(expr) || strings.Count(expr, "'")%2 != 0 {
+		return "", errors.Errorf("invalid %s, it may only hold identifiers, literals, calls and comparisons: %s", drivers.ConfigIdentityColumnExpr, expr)
+	}
+
+	return expr, nil
+}
+
+// open connects to the database described by the config, preferring
+// the introspection connection string when one is set
+func (m *MSSQLDriver) open(config drivers.Config) error {
+	if dsn, ok := config.String(drivers.ConfigIntrospectDSN); ok {
+		m.connStr = dsn
+	} else {
+		user := config.MustString(drivers.ConfigUser)
+		pass, _ := config.String(drivers.ConfigPass)
+		dbname := config.MustString(drivers.ConfigDBName)
+		host := config.MustString(drivers.ConfigHost)
+		port := config.DefaultInt(drivers.ConfigPort, 1433)
+		sslmode := config.DefaultString(drivers.ConfigSSLMode, "true")
+
+		m.connStr = MSSQLBuildQueryString(user, pass, dbname, host, port, sslmode)
+	}
+
+	openDB := m.openDB
+	if openDB == nil {
+		openDB = sql.Open
+	}
+
+	var err error
+	m.conn, err = openDB("mssql", m.connStr)
+	if err != nil {
+		return errors.Wrap(err, "sqlboiler-mssql failed to connect to database")
+	}
+
+	return nil
+}
+
+// lacksKeyViews is true when information_schema doesn't have the
+// referential_constraints or key_column_usage views, as in some reduced
+// builds, and the keys have to be read from the sys catalog views instead.
+func (m *MSSQLDriver) lacksKeyViews() (bool, error) {
+	query := `
+	SELECT OBJECT_ID('INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS'),
+		OBJECT_ID('INFORMATION_SCHEMA.KEY_COLUMN_USAGE');`
+
+	var constraints, usage sql.NullInt64
+	if err := m.conn.QueryRow(query).Scan(&constraints, &usage); err != nil {
+		return false, errors.Wrap(err, "unable to check for the information_schema key views")
+	}
+
+	return !constraints.Valid || !usage.Valid, nil
+}
+
+// lacksCheckConstraintView is true when the sys catalog doesn't have the
+// check_constraints view, as in some reduced editions
+func (m *MSSQLDriver) lacksCheckConstraintView() (bool, error) {
+	var view sql.NullInt64
+	if err := m.conn.QueryRow(`SELECT OBJECT_ID('sys.check_constraints');`).Scan(&view); err != nil {
+		return false, errors.Wrap(err, "unable to check for the check_constraints view")
+	}
+
+	return !view.Valid, nil
+}
+
 // MSSQLBuildQueryString builds a query string for MSSQL.
 func MSSQLBuildQueryString(user, pass, dbname, host string, port int, sslmode string) string {
 	query := url.Values{}
@@ -175,6 +364,9 @@ func (m *MSSQLDriver) TableNames(schema string, whitelist, blacklist []string) (
 		if err := rows.Scan(&name); err != nil {
 			return nil, err
 		}
+		if isReservedWord(name) {
+			m.warnf("warning: table %s is a reserved word, quote it in raw SQL: [%s]\n", name, name)
+		}
 		names = append(names, name)
 	}
 
@@ -188,10 +380,17 @@ func (m *MSSQLDriver) TableNames(schema string, whitelist, blacklist []string) (
 func (m *MSSQLDriver) Columns(schema, tableName string, whitelist, blacklist []string) ([]drivers.Column, error) {
 	var columns []drivers.Column
 	args := []interface{}{schema, tableName}
+
+	identity := m.identityExpr
+	if len(identity) == 0 {
+		identity = defaultIdentityExpr
+	}
+
 	query := `
 	SELECT column_name,
        CASE
          WHEN character_maximum_length IS NULL THEN data_type
+         WHEN character_maximum_length = -1 THEN data_type + '(max)'
          ELSE data_type + '(' + CAST(character_maximum_length AS VARCHAR) + ')'
        END AS full_type,
        data_type,
@@ -217,29 +416,36 @@ func (m *MSSQLDriver) Columns(schema, tableName string, whitelist, blacklist []s
                              AND   constraint_name = tc.constraint_name) = 1) THEN 1
          ELSE 0
        END AS is_unique,
-	   COLUMNPROPERTY(object_id($1 + '.' + $2), c.column_name, 'IsIdentity') as is_identity
+	   CASE
+         WHEN ` + identity + ` THEN 1
+         ELSE 0
+       END AS is_identity,
+	   datetime_precision,
+	   numeric_precision,
+	   numeric_scale
 	FROM information_schema.columns c
 	WHERE table_schema = $1 AND table_name = $2`
 
+	var filter string
 	if len(whitelist) > 0 {
 		cols := drivers.ColumnsFromList(whitelist, tableName)
 		if len(cols) > 0 {
-			query += fmt.Sprintf(" and c.column_name in (%s)", strmangle.Placeholders(true, len(cols), 3, 1))
+			filter += fmt.Sprintf(" and c.column_name in (%s)", strmangle.Placeholders(true, len(cols), len(args)+1, 1))
 			for _, w := range cols {
 				args = append(args, w)
 			}
 		}
 	} else if len(blacklist) > 0 {
-		cols := drivers.ColumnsFromList(blacklist, tableName)
+		cols := drivers.BlacklistColumnsFromList(blacklist, tableName)
 		if len(cols) > 0 {
-			query += fmt.Sprintf(" and c.column_name not in (%s)", strmangle.Placeholders(true, len(cols), 3, 1))
+			filter += fmt.Sprintf(" and c.column_name not in (%s)", strmangle.Placeholders(true, len(cols), len(args)+1, 1))
 			for _, w := range cols {
 				args = append(args, w)
 			}
 		}
 	}
 
-	query += ` ORDER BY ordinal_position;`
+	query += filter + ` ORDER BY ordinal_position;`
 
 	rows, err := m.conn.Query(query, args...)
 	if err != nil {
@@ -251,10 +457,15 @@ func (m *MSSQLDriver) Columns(schema, tableName string, whitelist, blacklist []s
 		var colName, colType, colFullType string
 		var nullable, unique, identity, auto bool
 		var defaultValue *string
-		if err := rows.Scan(&colName, &colFullType, &colType, &defaultValue, &nullable, &unique, &identity); err != nil {
+		var precision, numericPrecision, numericScale *int
+		if err := rows.Scan(&colName, &colFullType, &colType, &defaultValue, &nullable, &unique, &identity, &precision, &numericPrecision, &numericScale); err != nil {
 			return nil, errors.Wrapf(err, "unable to scan for table %s", tableName)
 		}
 
+		if isReservedWord(colName) {
+			m.warnf("warning: column %s.%s is a reserved word, quote it in raw SQL: [%s]\n", tableName, colName, colName)
+		}
+
 		auto = strings.EqualFold(colType, "timestamp") || strings.EqualFold(colType, "rowversion")
 
 		column := drivers.Column{
@@ -264,21 +475,209 @@ func (m *MSSQLDriver) Columns(schema, tableName string, whitelist, blacklist []s
 			Nullable:      nullable,
 			Unique:        unique,
 			AutoGenerated: auto,
+			AutoIncrement: identity,
+		}
+
+		if precision != nil {
+			column.Precision = *precision
+		}
+		// Integer and money types report a numeric precision too, only
+		// decimals are declared with one
+		if column.IsDecimal() && numericPrecision != nil {
+			column.Precision = *numericPrecision
+			if numericScale != nil {
+				column.Scale = *numericScale
+			}
 		}
 
-		if defaultValue != nil && *defaultValue != "NULL" {
-			column.Default = *defaultValue
-		} else if identity || auto {
+		if typ, nullType, ok := drivers.TypeMappingMismatch(m.TranslateColumnType, column); !ok {
+			m.warnf("warning: column %s.%s of type %s translates to %s but to %s when nullable\n", tableName, colName, colType, typ, nullType)
+		}
+
+		if defaultValue != nil {
+			column.Default, _ = parseDefault(*defaultValue)
+			if colType == "bit" {
+				column.Default = bitDefault(column.Default, m.bitDefaults == "bool")
+			}
+		}
+		if len(column.Default) == 0 && (identity || auto) {
 			column.Default = "auto"
 		}
 		columns = append(columns, column)
 	}
 
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if m.verifyColumnCount {
+		if err = m.verifyColumns(tableName, len(columns), filter, args); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range columns {
+		if !columns[i].AutoIncrement {
+			continue
+		}
+		columns[i].IdentitySeed, columns[i].IdentityStep, err = m.identitySeedStep(schema, tableName, columns[i].Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read the identity seed of %s.%s", tableName, columns[i].Name)
+		}
+	}
+
 	return columns, nil
 }
 
+// engineVersionQueries read the version of the engine: SERVERPROPERTY where
+// it's supported, @@VERSION otherwise.
+var engineVersionQueries = []string{
+	`SELECT CAST(SERVERPROPERTY('ProductVersion') AS nvarchar(128));`,
+	`SELECT @@VERSION;`,
+}
+
+// EngineVersion returns the version of the database engine, ex: 15.0.2000.5,
+// or the error of the last query tried when none of them works.
+func (m *MSSQLDriver) EngineVersion() (string, error) {
+	err := errors.New("engine version not reported")
+	for _, query := range engineVersionQueries {
+		var version sql.NullString
+		if e := m.conn.QueryRow(query).Scan(&version); e != nil {
+			err = e
+			continue
+		}
+		if version.Valid && len(version.String) != 0 {
+			return version.String, nil
+		}
+	}
+
+	return "", err
+}
+
+// identitySeedQueries read the seed and increment of an identity column:
+// SQL CE has them as columns of information_schema.columns, SQL Server in
+// the sys.identity_columns view.
+var identitySeedQueries = []string{
+	`SELECT autoinc_seed, autoinc_increment FROM information_schema.columns WHERE table_schema = $1 AND table_name = $2 AND column_name = $3;`,
+	`SELECT CAST(seed_value AS bigint), CAST(increment_value AS bigint) FROM sys.identity_columns WHERE object_id = OBJECT_ID($1 + '.' + $2) AND name = $3;`,
+}
+
+// findIdentitySeedQuery returns the first of identitySeedQueries that runs
+// on the engine, empty when none does
+func (m *MSSQLDriver) findIdentitySeedQuery(schema string) string {
+	for _, query := range identitySeedQueries {
+		var s, i sql.NullInt64
+		err := m.conn.QueryRow(query, schema, "", "").Scan(&s, &i)
+		if err == nil || err == sql.ErrNoRows {
+			return query
+		}
+	}
+
+	return ""
+}
+
+// identitySeedStep returns the seed and increment of the identity column,
+// 0 and 0 when the engine doesn't report them
+func (m *MSSQLDriver) identitySeedStep(schema, tableName, column string) (seed, step int64, err error) {
+	if len(m.identitySeedQuery) == 0 {
+		return 0, 0, nil
+	}
+
+	var s, i sql.NullInt64
+	err = m.conn.QueryRow(m.identitySeedQuery, schema, tableName, column).Scan(&s, &i)
+	if err == sql.ErrNoRows {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return s.Int64, i.Int64, nil
+}
+
+// parseDefault unwraps a column default as mssql reports it, ex: foo for
+// (N'foo') and 1 for ((1)). A default that calls a function, ex: (getdate()),
+// is generated by the server and returns "auto". An empty string is returned
+// quoted to tell it apart from no default, and ok is false for (NULL).
+func parseDefault(def string) (value string, ok bool) {
+	lit, quoted, ok := drivers.DefaultLiteral(def)
+	switch {
+	case !ok:
+		return "auto", true
+	case quoted && len(lit) == 0:
+		return "''", true
+	case !quoted && strings.EqualFold(lit, "NULL"):
+		return "", false
+	}
+
+	return lit, true
+}
+
+// bitDefault normalizes the default of a bit column as parseDefault returns
+// it, which keeps the default as it was written, ex: 1 for ((1)), true for
+// ('TRUE') or 2 for ((2)) that's stored as 1. It's 0 or 1, or false or true
+// with asBool, and anything that isn't a literal is returned as is.
+func bitDefault(def string, asBool bool) string {
+	var set bool
+	switch strings.ToLower(def) {
+	case "true":
+		set = true
+	case "false":
+	default:
+		n, err := strconv.ParseFloat(def, 64)
+		if err != nil {
+			return def
+		}
+		set = n != 0
+	}
+
+	switch {
+	case asBool && set:
+		return "true"
+	case asBool:
+		return "false"
+	case set:
+		return "1"
+	}
+	return "0"
+}
+
+// verifyColumns compares the number of columns read for a table against a
+// plain count from information_schema.columns with the same filters, and warns
+// when they differ since that points to a filter or catalog problem.
+func (m *MSSQLDriver) verifyColumns(tableName string, found int, filter string, args []interface{}) error {
+	query := `
+	SELECT COUNT(*)
+	FROM information_schema.columns c
+	WHERE table_schema = $1 AND table_name = $2` + filter + `;`
+
+	var count int
+	if err := m.conn.QueryRow(query, args...).Scan(&count); err != nil {
+		return errors.Wrapf(err, "unable to count columns for table %s", tableName)
+	}
+
+	if count != found {
+		m.warnf("warning: read %d columns for table %s but information_schema.columns has %d\n", found, tableName, count)
+	}
+
+	return nil
+}
+
+// warnf writes a warning to stderr, or the writer set for tests
+func (m *MSSQLDriver) warnf(format string, args ...interface{}) {
+	w := m.warnings
+	if w == nil {
+		w = os.Stderr
+	}
+	fmt.Fprintf(w, format, args...)
+}
+
 // PrimaryKeyInfo looks up the primary key for a table.
 func (m *MSSQLDriver) PrimaryKeyInfo(schema, tableName string) (*drivers.PrimaryKey, error) {
+	if m.sysKeys {
+		return m.sysPrimaryKeyInfo(schema, tableName)
+	}
+
 	pkey := &drivers.PrimaryKey{}
 	var err error
 
@@ -328,42 +727,117 @@ func (m *MSSQLDriver) PrimaryKeyInfo(schema, tableName string) (*drivers.Primary
 	return pkey, nil
 }
 
+// sysPrimaryKeyInfo is PrimaryKeyInfo reading the sys catalog views
+func (m *MSSQLDriver) sysPrimaryKeyInfo(schema, tableName string) (*drivers.PrimaryKey, error) {
+	query := `
+	SELECT kc.name, c.name
+	FROM sys.key_constraints kc
+	INNER JOIN sys.tables t ON kc.parent_object_id = t.object_id
+	INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+	INNER JOIN sys.index_columns ic ON ic.object_id = t.object_id AND ic.index_id = kc.unique_index_id
+	INNER JOIN sys.columns c ON c.object_id = t.object_id AND c.column_id = ic.column_id
+	WHERE kc.type = 'PK' AND t.name = ? AND s.name = ?
+	ORDER BY ic.key_ordinal;`
+
+	rows, err := m.conn.Query(query, tableName, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pkey *drivers.PrimaryKey
+	for rows.Next() {
+		var name, column string
+		if err = rows.Scan(&name, &column); err != nil {
+			return nil, err
+		}
+
+		if pkey == nil {
+			pkey = &drivers.PrimaryKey{Name: name}
+		}
+		pkey.Columns = append(pkey.Columns, column)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pkey, nil
+}
+
+// sysForeignKeyQuery is the ForeignKeyInfo query reading the sys catalog
+// views, the delete action is spelled like information_schema's delete_rule
+const sysForeignKeyQuery = `
+	SELECT fk.name ,
+		lc.name AS local_column ,
+		ft.name AS foreign_table ,
+		fc.name AS foreign_column ,
+		REPLACE(fk.delete_referential_action_desc, '_', ' ')
+	FROM sys.foreign_keys fk
+	INNER JOIN sys.tables t ON fk.parent_object_id = t.object_id
+	INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+	INNER JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+	INNER JOIN sys.columns lc ON lc.object_id = fkc.parent_object_id AND lc.column_id = fkc.parent_column_id
+	INNER JOIN sys.tables ft ON ft.object_id = fkc.referenced_object_id
+	INNER JOIN sys.columns fc ON fc.object_id = fkc.referenced_object_id AND fc.column_id = fkc.referenced_column_id
+	WHERE s.name = ?
+	  AND t.name = ?
+	ORDER BY fk.name, fkc.constraint_column_id
+	`
+
 // ForeignKeyInfo retrieves the foreign keys for a given table name.
 func (m *MSSQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.ForeignKey, error) {
 	var fkeys []drivers.ForeignKey
 
+	// The local and foreign columns of a composite key are paired up by
+	// their position, one row per pair
 	query := `
-	SELECT ccu.constraint_name ,
-		ccu.table_name AS local_table ,
-		ccu.column_name AS local_column ,
-		kcu.table_name AS foreign_table ,
-		kcu.column_name AS foreign_column
-	FROM information_schema.constraint_column_usage ccu
-	INNER JOIN information_schema.referential_constraints rc ON ccu.constraint_name = rc.constraint_name
-	INNER JOIN information_schema.key_column_usage kcu ON kcu.constraint_name = rc.unique_constraint_name
-	WHERE ccu.table_schema = ?
-	  AND ccu.constraint_schema = ?
-	  AND ccu.table_name = ?
-	ORDER BY ccu.constraint_name, local_table, local_column, foreign_table, foreign_column
+	SELECT kcu.constraint_name ,
+		kcu.column_name AS local_column ,
+		fkcu.table_name AS foreign_table ,
+		fkcu.column_name AS foreign_column ,
+		rc.delete_rule
+	FROM information_schema.referential_constraints rc
+	INNER JOIN information_schema.key_column_usage kcu ON kcu.constraint_schema = rc.constraint_schema AND kcu.constraint_name = rc.constraint_name
+	INNER JOIN information_schema.key_column_usage fkcu ON fkcu.constraint_schema = rc.unique_constraint_schema AND fkcu.constraint_name = rc.unique_constraint_name
+		AND fkcu.ordinal_position = kcu.ordinal_position
+	WHERE kcu.table_schema = ?
+	  AND kcu.table_name = ?
+	ORDER BY kcu.constraint_name, kcu.ordinal_position
 	`
+	if m.sysKeys {
+		query = sysForeignKeyQuery
+	}
 
 	var rows *sql.Rows
 	var err error
-	if rows, err = m.conn.Query(query, schema, schema, tableName); err != nil {
+	if rows, err = m.conn.Query(query, schema, tableName); err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
-		var fkey drivers.ForeignKey
-		var sourceTable string
-
-		fkey.Table = tableName
-		err = rows.Scan(&fkey.Name, &sourceTable, &fkey.Column, &fkey.ForeignTable, &fkey.ForeignColumn)
-		if err != nil {
+		var name, column, foreignTable, foreignColumn, onDelete string
+		if err = rows.Scan(&name, &column, &foreignTable, &foreignColumn, &onDelete); err != nil {
 			return nil, err
 		}
 
-		fkeys = append(fkeys, fkey)
+		if n := len(fkeys); n != 0 && fkeys[n-1].Name == name {
+			fkeys[n-1].Columns = append(fkeys[n-1].Columns, column)
+			fkeys[n-1].ForeignColumns = append(fkeys[n-1].ForeignColumns, foreignColumn)
+			continue
+		}
+
+		fkeys = append(fkeys, drivers.ForeignKey{
+			Table:          tableName,
+			Name:           name,
+			Column:         column,
+			ForeignTable:   foreignTable,
+			ForeignColumn:  foreignColumn,
+			Columns:        []string{column},
+			ForeignColumns: []string{foreignColumn},
+			OnDelete:       onDelete,
+		})
 	}
 
 	if err = rows.Err(); err != nil {
@@ -373,6 +847,181 @@ func (m *MSSQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreig
 	return fkeys, nil
 }
 
+// Triggers retrieves the names of the enabled triggers defined on a table.
+func (m *MSSQLDriver) Triggers(schema, tableName string) ([]string, error) {
+	var triggers []string
+
+	query := `
+	SELECT tr.name
+	FROM sys.triggers tr
+	INNER JOIN sys.tables t ON tr.parent_id = t.object_id
+	INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+	WHERE s.name = ? AND t.name = ? AND tr.is_disabled = 0
+	ORDER BY tr.name;`
+
+	rows, err := m.conn.Query(query, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var trigger string
+		if err := rows.Scan(&trigger); err != nil {
+			return nil, err
+		}
+
+		triggers = append(triggers, trigger)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return triggers, nil
+}
+
+// CheckConstraintInfo lists the enabled CHECK constraints of a table, or
+// none when the database has no check_constraints view.
+func (m *MSSQLDriver) CheckConstraintInfo(schema, tableName string) ([]drivers.CheckConstraint, error) {
+	if m.noCheckConstraints {
+		return nil, nil
+	}
+
+	var checks []drivers.CheckConstraint
+
+	query := `
+	SELECT cc.name, COALESCE(c.name, ''), cc.definition
+	FROM sys.check_constraints cc
+	INNER JOIN sys.tables t ON cc.parent_object_id = t.object_id
+	INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+	LEFT JOIN sys.columns c ON cc.parent_object_id = c.object_id AND cc.parent_column_id = c.column_id
+	WHERE s.name = ? AND t.name = ? AND cc.is_disabled = 0
+	ORDER BY cc.name;`
+
+	rows, err := m.conn.Query(query, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var check drivers.CheckConstraint
+		if err := rows.Scan(&check.Name, &check.Column, &check.Expression); err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, check)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// EnumValues reads the rows of a lookup table, see drivers.EnumConstructor
+func (m *MSSQLDriver) EnumValues(schema, tableName, keyColumn, nameColumn string) ([]drivers.EnumValue, error) {
+	query := fmt.Sprintf("SELECT %s, %s FROM %s.%s ORDER BY %s;",
+		quoteIdent(keyColumn), quoteIdent(nameColumn), quoteIdent(schema), quoteIdent(tableName), quoteIdent(keyColumn))
+
+	rows, err := m.conn.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []drivers.EnumValue
+	for rows.Next() {
+		var key string
+		var name sql.NullString
+		if err := rows.Scan(&key, &name); err != nil {
+			return nil, err
+		}
+
+		values = append(values, drivers.EnumValue{Name: name.String, Key: key})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// quoteIdent brackets a name for use in a query
+func quoteIdent(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
+
+// IndexInfo returns the indexes on a table with their key columns in order,
+// included (non-key) columns are left out.
+func (m *MSSQLDriver) IndexInfo(schema, tableName string) ([]drivers.Index, error) {
+	var indexes []drivers.Index
+
+	query := `
+	SELECT i.name, c.name, i.is_unique, COALESCE(i.filter_definition, '')
+	FROM sys.indexes i
+	INNER JOIN sys.index_columns ic ON i.object_id = ic.object_id AND i.index_id = ic.index_id
+	INNER JOIN sys.columns c ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+	INNER JOIN sys.tables t ON i.object_id = t.object_id
+	INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+	WHERE s.name = ? AND t.name = ? AND i.type > 0 AND ic.is_included_column = 0
+	ORDER BY i.name, ic.key_ordinal;`
+
+	rows, err := m.conn.Query(query, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var index drivers.Index
+		var column string
+		if err := rows.Scan(&index.Name, &column, &index.Unique, &index.Filter); err != nil {
+			return nil, err
+		}
+
+		if n := len(indexes); n != 0 && indexes[n-1].Name == index.Name {
+			indexes[n-1].Columns = append(indexes[n-1].Columns, column)
+			continue
+		}
+
+		index.Columns = []string{column}
+		indexes = append(indexes, index)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return indexes, nil
+}
+
+// IdentityInfo returns the identity column of a table along with its seed,
+// increment and current value, or nil if the table doesn't have one.
+func (m *MSSQLDriver) IdentityInfo(schema, tableName string) (*drivers.Identity, error) {
+	query := `
+	SELECT ic.name, CAST(ic.seed_value AS bigint), CAST(ic.increment_value AS bigint), CAST(ic.last_value AS bigint)
+	FROM sys.identity_columns ic
+	INNER JOIN sys.tables t ON ic.object_id = t.object_id
+	INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+	WHERE s.name = ? AND t.name = ?;`
+
+	var identity drivers.Identity
+	row := m.conn.QueryRow(query, schema, tableName)
+	err := row.Scan(&identity.Column, &identity.Seed, &identity.Increment, &identity.Current)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
 // TranslateColumnType converts postgres database types to Go types, for example
 // "varchar" to "string" and "bigint" to "int64". It returns this parsed data
 // as a Column object.
@@ -393,8 +1042,15 @@ func (m *MSSQLDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 			c.Type = "null.Float32"
 		case "float":
 			c.Type = "null.Float64"
-		case "boolean", "bool", "bit":
+		case "boolean", "bool":
 			c.Type = "null.Bool"
+		case "bit":
+			// some providers return bit as an integer, types.NullBit scans both
+			if m.bitAsInt {
+				c.Type = "types.NullBit"
+			} else {
+				c.Type = "null.Bool"
+			}
 		case "date", "datetime", "datetime2", "smalldatetime", "time":
 			c.Type = "null.Time"
 		case "binary", "varbinary":
@@ -406,6 +1062,14 @@ func (m *MSSQLDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 		case "uniqueidentifier":
 			c.Type = "null.String"
 			c.DBType = "uuid"
+		case "char", "nchar":
+			// map char(36) to a uuid if Char36AsUUID is true
+			if m.char36AsUUID && isChar36(c.FullDBType) {
+				c.Type = "uuid.NullUUID"
+				c.DBType = "uuid"
+			} else {
+				c.Type = "null.String"
+			}
 		case "numeric", "decimal", "dec":
 			c.Type = "types.NullDecimal"
 		default:
@@ -427,8 +1091,14 @@ func (m *MSSQLDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 			c.Type = "float32"
 		case "float":
 			c.Type = "float64"
-		case "boolean", "bool", "bit":
+		case "boolean", "bool":
 			c.Type = "bool"
+		case "bit":
+			if m.bitAsInt {
+				c.Type = "types.Bit"
+			} else {
+				c.Type = "bool"
+			}
 		case "date", "datetime", "datetime2", "smalldatetime", "time":
 			c.Type = "time.Time"
 		case "binary", "varbinary":
@@ -440,6 +1110,14 @@ func (m *MSSQLDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 		case "uniqueidentifier":
 			c.Type = "string"
 			c.DBType = "uuid"
+		case "char", "nchar":
+			// map char(36) to a uuid if Char36AsUUID is true
+			if m.char36AsUUID && isChar36(c.FullDBType) {
+				c.Type = "uuid.UUID"
+				c.DBType = "uuid"
+			} else {
+				c.Type = "string"
+			}
 		case "numeric", "decimal", "dec":
 			c.Type = "types.Decimal"
 		default:
@@ -447,16 +1125,22 @@ func (m *MSSQLDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 		}
 	}
 
+	// MS SQL has no unsigned integers so Columns never sets Unsigned,
+	// it's honored for parity with the drivers that do
+	if c.Unsigned {
+		c.Type = drivers.UnsignedType(c.Type)
+	}
+
 	return c
 }
 
+// isChar36 checks the full type captured by Columns, ex: char(36) or nchar(36)
+func isChar36(fullDBType string) bool {
+	return fullDBType == "char(36)" || fullDBType == "nchar(36)"
+}
+
 // Imports returns important imports for the driver
 func (MSSQLDriver) Imports() (col importers.Collection, err error) {
-	col.All = importers.Set{
-		Standard: importers.List{
-			`"strconv"`,
-		},
-	}
 	col.Singleton = importers.Map{
 		"mssql_upsert": {
 			Standard: importers.List{
@@ -468,6 +1152,11 @@ func (MSSQLDriver) Imports() (col importers.Collection, err error) {
 				`"github.com/volatiletech/sqlboiler/v4/drivers"`,
 			},
 		},
+		"mssql_optimistic": {
+			ThirdParty: importers.List{
+				`"github.com/friendsofgo/errors"`,
+			},
+		},
 	}
 	col.TestSingleton = importers.Map{
 		"mssql_suites_test": {
@@ -554,6 +1243,24 @@ func (MSSQLDriver) Imports() (col importers.Collection, err error) {
 		"types.NullDecimal": {
 			Standard: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
 		},
+		"types.Money": {
+			Standard: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
+		"types.Bit": {
+			Standard: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
+		"types.NullBit": {
+			Standard: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
+		"types.NullMoney": {
+			Standard: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
+		"uuid.UUID": {
+			ThirdParty: importers.List{`"github.com/gofrs/uuid"`},
+		},
+		"uuid.NullUUID": {
+			ThirdParty: importers.List{`"github.com/gofrs/uuid"`},
+		},
 	}
 	return col, err
 }
@@ -0,0 +1,128 @@
+{{- if and .Table.VersionColumn (not .Table.IsReadOnly) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- $versionCol := .Table.VersionColumn -}}
+{{- $versionField := $alias.Column $versionCol}}
+var (
+	{{$alias.DownSingular}}UpdateOptimisticCacheMut sync.RWMutex
+	{{$alias.DownSingular}}UpdateOptimisticCache = make(map[string]updateCache)
+)
+
+{{if .AddGlobal -}}
+// UpdateOptimisticG a single {{$alias.UpSingular}} record using the global executor.
+// See UpdateOptimistic for more documentation.
+func (o *{{$alias.UpSingular}}) UpdateOptimisticG({{if not .NoContext}}ctx context.Context, {{end -}} columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return o.UpdateOptimistic({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, columns)
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// UpdateOptimisticP uses an executor to update the {{$alias.UpSingular}}, and panics on error.
+// See UpdateOptimistic for more documentation.
+func (o *{{$alias.UpSingular}}) UpdateOptimisticP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end}}err := o.UpdateOptimistic({{if not .NoContext}}ctx, {{end -}} exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{end -}}
+}
+
+{{end -}}
+
+// UpdateOptimistic uses an executor to update the {{$alias.UpSingular}} only if
+// its {{$versionCol}} column still holds the value that was loaded, and refreshes
+// {{$versionField}} from the database afterwards.
+// Returns ErrConcurrentModification if the row was changed or deleted since it was loaded.
+// See Update for more documentation.
+func (o *{{$alias.UpSingular}}) UpdateOptimistic({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Update")
+
+	{{end -}}
+	{{- template "timestamp_update_helper" . -}}
+
+	var err error
+	{{if not .NoHooks -}}
+	if err = o.doBeforeUpdateHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	{{end -}}
+
+	key := makeCacheKey(columns, nil)
+	{{$alias.DownSingular}}UpdateOptimisticCacheMut.RLock()
+	cache, cached := {{$alias.DownSingular}}UpdateOptimisticCache[key]
+	{{$alias.DownSingular}}UpdateOptimisticCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}PrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, {{$alias.DownSingular}}ColumnsWithAuto)
+		{{if not .NoAutoTimestamps}}
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		{{end -}}
+		if len(wl) == 0 {
+			return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist")
+		}
+
+		where := append(append([]string{}, {{$alias.DownSingular}}PrimaryKeyColumns...), "{{$versionCol}}")
+
+		// The new version is captured into a table variable since a plain
+		// OUTPUT clause is rejected on tables with triggers
+		cache.query = fmt.Sprintf("DECLARE @version TABLE ([{{$versionCol}}] binary(8)); UPDATE {{$schemaTable}} SET %s OUTPUT INSERTED.[{{$versionCol}}] INTO @version WHERE %s; SELECT [{{$versionCol}}] FROM @version;",
+			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", 1, wl),
+			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", len(wl)+1, where),
+		)
+		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, where...))
+		if err != nil {
+			return {{if not .NoRowsAffected}}0, {{end -}} err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	{{else -}}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	{{end -}}
+
+	{{if .NoContext -}}
+	err = exec.QueryRow(cache.query, values...).Scan(&o.{{$versionField}})
+	{{else -}}
+	err = boil.QueryRowContext(ctx, exec, cache.query, values...).Scan(&o.{{$versionField}})
+	{{end -}}
+	if err == sql.ErrNoRows {
+		return {{if not .NoRowsAffected}}0, {{end -}} ErrConcurrentModification
+	}
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update {{.Table.Name}} row")
+	}
+
+	if !cached {
+		{{$alias.DownSingular}}UpdateOptimisticCacheMut.Lock()
+		{{$alias.DownSingular}}UpdateOptimisticCache[key] = cache
+		{{$alias.DownSingular}}UpdateOptimisticCacheMut.Unlock()
+	}
+
+	{{if not .NoHooks -}}
+	return {{if not .NoRowsAffected}}1, {{end -}} o.doAfterUpdateHooks({{if not .NoContext}}ctx, {{end -}} exec)
+	{{- else -}}
+	return {{if not .NoRowsAffected}}1, {{end -}} nil
+	{{- end}}
+}
+{{end -}}
@@ -1,3 +1,4 @@
+{{- if not .Table.IsReadOnly -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -31,15 +32,26 @@ func (o *{{$alias.UpSingular}}) UpsertP({{if .NoContext}}exec boil.Executor{{els
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) error {
+	_, err := o.UpsertWithResult({{if not .NoContext}}ctx, {{end -}} exec, updateColumns, insertColumns)
+	return err
+}
+
+// UpsertWithResult is Upsert that also reports which branch of the MERGE ran,
+// inserted is false when the row was updated or left alone.
+func (o *{{$alias.UpSingular}}) UpsertWithResult({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) (inserted bool, err error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Upsert")
+
+	{{end -}}
 	if o == nil {
-		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
+		return false, errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
 	}
 
 	{{- template "timestamp_upsert_helper" . }}
 
 	{{if not .NoHooks -}}
 	if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
-		return err
+		return false, err
 	}
 	{{- end}}
 
@@ -47,15 +59,9 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 
 	// Build cache key in-line uglily - mysql vs psql problems
 	buf := strmangle.GetBuffer()
-	buf.WriteString(strconv.Itoa(updateColumns.Kind))
-	for _, c := range updateColumns.Cols {
-		buf.WriteString(c)
-	}
+	buf.WriteString(makeCacheKey(updateColumns, nil))
 	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(insertColumns.Kind))
-	for _, c := range insertColumns.Cols {
-		buf.WriteString(c)
-	}
+	buf.WriteString(makeCacheKey(insertColumns, nil))
 	buf.WriteByte('.')
 	for _, c := range nzDefaults {
 		buf.WriteString(c)
@@ -67,8 +73,6 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	cache, cached := {{$alias.DownSingular}}UpsertCache[key]
 	{{$alias.DownSingular}}UpsertCacheMut.RUnlock()
 
-	var err error
-
 	if !cached {
 		insert, ret := insertColumns.InsertColumnSet(
 			{{$alias.DownSingular}}AllColumns,
@@ -83,7 +87,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 			}
 		}
 		if len(insert) == 0 {
-			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build insert column list")
+			return false, errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build insert column list")
 		}
 
 		ret = strmangle.SetMerge(ret, {{$alias.DownSingular}}ColumnsWithAuto)
@@ -96,10 +100,26 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		update = strmangle.SetComplement(update, {{$alias.DownSingular}}ColumnsWithAuto)
 
 		if !updateColumns.IsNone() && len(update) == 0 {
-			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
+			return false, errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
 		}
 
-		cache.query = buildUpsertQueryMSSQL(dialect, "{{$schemaTable}}", {{$alias.DownSingular}}PrimaryKeyColumns, update, insert, ret)
+		{{if .Table.HasTriggers -}}
+		// OUTPUT without INTO is rejected on tables with triggers, output the
+		// action and the inserted key into a table variable and select the
+		// returned columns by that key in the same batch instead, the key
+		// given for an identity is not the one the row got
+		cache.query = "DECLARE @upsert_action TABLE ([action] nvarchar(10)
+			{{- range $col := .Table.PKey.Columns}}{{$c := $.Table.GetColumn $col}}, [{{$col}}] {{or $c.FullDBType $c.DBType}}
+			{{- if and $c.IsDecimal $c.Precision}}({{$c.Precision}},{{$c.Scale}}){{end}}{{end}});\n" +
+			buildUpsertQueryMSSQL(dialect, "{{$schemaTable}}", {{$alias.DownSingular}}PrimaryKeyColumns, update, insert, {{$alias.DownSingular}}PrimaryKeyColumns, "@upsert_action")
+		selectCols := "[u].[action]"
+		if len(ret) != 0 {
+			selectCols += ", [t].[" + strings.Join(ret, "],[t].[") + "]"
+		}
+		cache.query += fmt.Sprintf("\nSELECT %s FROM @upsert_action [u] INNER JOIN {{$schemaTable}} [t] ON {{range $i, $col := .Table.PKey.Columns}}{{if $i}} AND {{end}}[t].[{{$col}}] = [u].[{{$col}}]{{end}};", selectCols)
+		{{else -}}
+		cache.query = buildUpsertQueryMSSQL(dialect, "{{$schemaTable}}", {{$alias.DownSingular}}PrimaryKeyColumns, update, insert, ret, "")
+		{{end -}}
 
 		whitelist := make([]string, len({{$alias.DownSingular}}PrimaryKeyColumns))
 		copy(whitelist, {{$alias.DownSingular}}PrimaryKeyColumns)
@@ -108,21 +128,23 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 
 		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, whitelist)
 		if err != nil {
-			return err
+			return false, err
 		}
 		if len(ret) != 0 {
 			cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
 			if err != nil {
-				return err
+				return false, err
 			}
 		}
 	}
 
 	value := reflect.Indirect(reflect.ValueOf(o))
 	vals := queries.ValuesFromMapping(value, cache.valueMapping)
-	var returns []interface{}
+	// The MERGE outputs its $action ahead of the returned columns
+	var action sql.NullString
+	returns := []interface{}{&action}
 	if len(cache.retMapping) != 0 {
-		returns = queries.PtrsFromMapping(value, cache.retMapping)
+		returns = append(returns, queries.PtrsFromMapping(value, cache.retMapping)...)
 	}
 
 	{{if .NoContext -}}
@@ -138,25 +160,18 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	}
 	{{end -}}
 
-	if len(cache.retMapping) != 0 {
-		{{if .NoContext -}}
-		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
-		{{else -}}
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		{{end -}}
-		if err == sql.ErrNoRows {
-			err = nil // MSSQL doesn't return anything when there's no update
-		}
-	} else {
-		{{if .NoContext -}}
-		_, err = exec.Exec(cache.query, vals...)
-		{{else -}}
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
-		{{end -}}
+	{{if .NoContext -}}
+	err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+	{{else -}}
+	err = boil.QueryRowContext(ctx, exec, cache.query, vals...).Scan(returns...)
+	{{end -}}
+	if err == sql.ErrNoRows && updateColumns.IsNone() {
+		err = nil // MSSQL doesn't return anything when there's no update
 	}
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to upsert {{.Table.Name}}")
+		return false, errors.Wrap(err, "{{.PkgName}}: unable to upsert {{.Table.Name}}")
 	}
+	inserted = action.String == "INSERT"
 
 	if !cached {
 		{{$alias.DownSingular}}UpsertCacheMut.Lock()
@@ -165,8 +180,9 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	}
 
 	{{if not .NoHooks -}}
-	return o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
+	return inserted, o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
 	{{- else -}}
-	return nil
+	return inserted, nil
 	{{- end}}
 }
+{{end -}}
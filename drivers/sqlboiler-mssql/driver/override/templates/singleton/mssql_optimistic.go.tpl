@@ -0,0 +1,3 @@
+// ErrConcurrentModification occurs during UpdateOptimistic when the row was
+// changed or deleted by someone else since it was loaded.
+var ErrConcurrentModification = errors.New("{{.PkgName}}: row was modified concurrently")
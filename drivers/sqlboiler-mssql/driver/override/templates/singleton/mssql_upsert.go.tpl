@@ -1,5 +1,7 @@
 // buildUpsertQueryMSSQL builds a SQL statement string using the upsertData provided.
-func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, update, insert []string, output []string) string {
+// The MERGE outputs its $action followed by the output columns, into the
+// outputInto table when it's set.
+func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, update, insert []string, output []string, outputInto string) string {
 	insert = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, insert)
 
 	buf := strmangle.GetBuffer()
@@ -34,11 +36,14 @@ func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, updat
 		strings.Join(insert, ", "),
 		strmangle.Placeholders(dia.UseIndexPlaceholders, len(insert), startIndex, 1))
 
+	fmt.Fprint(buf, "\nOUTPUT $action")
 	if len(output) > 0 {
-		fmt.Fprintf(buf, "\nOUTPUT INSERTED.[%s];", strings.Join(output, "],INSERTED.["))
-	} else {
-		fmt.Fprint(buf, ";")
+		fmt.Fprintf(buf, ", INSERTED.[%s]", strings.Join(output, "],INSERTED.["))
 	}
+	if len(outputInto) > 0 {
+		fmt.Fprintf(buf, " INTO %s", outputInto)
+	}
+	fmt.Fprint(buf, ";")
 
 	return buf.String()
 }
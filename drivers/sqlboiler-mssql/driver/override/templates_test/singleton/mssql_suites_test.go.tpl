@@ -1,9 +1,18 @@
 func TestUpsert(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table $table.Name}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Upsert)
   {{end -}}
   {{- end -}}
 }
+
+func TestUpdateOptimistic(t *testing.T) {
+  {{- range $index, $table := .Tables}}
+  {{- if and $table.VersionColumn (not $table.IsReadOnly) -}}
+  {{- $alias := $.Aliases.Table $table.Name}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}UpdateOptimistic)
+  {{end -}}
+  {{- end -}}
+}
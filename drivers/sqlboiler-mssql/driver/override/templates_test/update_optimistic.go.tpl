@@ -0,0 +1,71 @@
+{{- if .Table.VersionColumn -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $versionField := $alias.Column .Table.VersionColumn}}
+func test{{$alias.UpPlural}}UpdateOptimistic(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	stale := *o
+
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	o.{{$versionField}} = stale.{{$versionField}}
+
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.UpdateOptimistic({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if reflect.DeepEqual(o.{{$versionField}}, stale.{{$versionField}}) {
+		t.Error("want version refreshed after update")
+	}
+
+	{{if not .NoRowsAffected}}var rowsAff int64
+	rowsAff, {{end}}err = stale.UpdateOptimistic({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer())
+	if err != ErrConcurrentModification {
+		t.Errorf("want ErrConcurrentModification for a stale version, got: %v", err)
+	}
+	{{- if not .NoRowsAffected}}
+	if rowsAff != 0 {
+		t.Error("want no rows affected for a stale version, got:", rowsAff)
+	}
+	{{- end}}
+
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.UpdateOptimistic({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error("refreshed version should update again:", err)
+	}
+
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx{{if and .AddSoftDeletes .Table.CanSoftDelete}}, true{{end}}); err != nil {
+		t.Error(err)
+	}
+
+	{{if not .NoRowsAffected}}rowsAff, {{end}}err = o.UpdateOptimistic({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer())
+	if err != ErrConcurrentModification {
+		t.Errorf("want ErrConcurrentModification for a deleted row, got: %v", err)
+	}
+	{{- if not .NoRowsAffected}}
+	if rowsAff != 0 {
+		t.Error("want no rows affected for a deleted row, got:", rowsAff)
+	}
+	{{- end}}
+}
+{{end -}}
@@ -10,16 +10,20 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	var err error
 	// Attempt the INSERT side of an UPSERT
 	o := {{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, &o, {{$alias.DownSingular}}DBTypes, true); err != nil {
+	if err = randomizeStruct(seed, &o, {{$alias.DownSingular}}DBTypes, true); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
 	{{if not .NoContext}}ctx := context.Background(){{end}}
 	tx := MustTx({{if .NoContext}}{{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}}{{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
-	if err = o.Upsert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != nil {
+	inserted, err := o.UpsertWithResult({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer())
+	if err != nil {
 		t.Errorf("Unable to upsert {{$alias.UpSingular}}: %s", err)
 	}
+	if !inserted {
+		t.Error("want the upsert to report an insert")
+	}
 
 	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
 	if err != nil {
@@ -30,13 +34,17 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	}
 
 	// Attempt the UPDATE side of an UPSERT
-	if err = randomize.Struct(seed, &o, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+	if err = randomizeStruct(seed, &o, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	if err = o.Upsert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != nil {
+	inserted, err = o.UpsertWithResult({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer())
+	if err != nil {
 		t.Errorf("Unable to upsert {{$alias.UpSingular}}: %s", err)
 	}
+	if inserted {
+		t.Error("want the upsert to report an update")
+	}
 
 	count, err = {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
 	if err != nil {
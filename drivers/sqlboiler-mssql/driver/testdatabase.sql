@@ -1,5 +1,6 @@
 -- Don't forget to maintain order here, foreign keys!
 drop table if exists video_tags;
+drop table if exists video_comments;
 drop table if exists tags;
 drop table if exists videos;
 drop table if exists sponsors;
@@ -28,6 +29,14 @@ create table videos (
 	constraint FK_videos_sponsors foreign key (sponsor_id) references sponsors (id)
 );
 
+create table video_comments (
+	id int identity (1,1) primary key not null,
+
+	video_id int not null,
+
+	constraint FK_video_comments_videos foreign key (video_id) references videos (id) on delete cascade
+);
+
 create table tags (
 	id int identity (1,1) primary key not null
 );
@@ -146,3 +155,10 @@ create table type_monsters (
 	varchar100_null  varchar(100) null,
 	varchar100_nnull varchar(100) not null
 );
+GO
+
+create trigger tr_users_audit on users after insert as
+begin
+	set nocount on;
+end;
+GO
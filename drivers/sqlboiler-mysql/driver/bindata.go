@@ -1,10 +1,10 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// override/templates/17_upsert.go.tpl (7.279kB)
+// override/templates/17_upsert.go.tpl (7.313kB)
 // override/templates/singleton/mysql_upsert.go.tpl (1.13kB)
 // override/templates_test/singleton/mysql_main_test.go.tpl (5.223kB)
-// override/templates_test/singleton/mysql_suites_test.go.tpl (255B)
-// override/templates_test/upsert.go.tpl (1.848kB)
+// override/templates_test/singleton/mysql_suites_test.go.tpl (276B)
+// override/templates_test/upsert.go.tpl (1.846kB)
 
 package driver
 
@@ -24,7 +24,7 @@ import (
 func bindataRead(data []byte, name string) ([]byte, error) {
 	gz, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 
 	var buf bytes.Buffer
@@ -32,7 +32,7 @@ func bindataRead(data []byte, name string) ([]byte, error) {
 	clErr := gz.Close()
 
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 	if clErr != nil {
 		return nil, err
@@ -73,7 +73,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x6f\xdb\xc8\x11\x7f\x96\x3e\xc5\x9c\x90\xbb\x23\x0b\x86\x49\x81\xa2\x0f\x29\xfc\x10\xff\x49\xce\x8d\xed\xb3\xad\xb8\x06\x6a\x18\x01\x4d\x8e\xe4\x85\x57\xbb\xcc\x72\x69\x5b\x65\xf9\xdd\x8b\x99\x5d\x8a\xa4\x2c\xc9\x4a\x1a\x1f\xee\xc9\x22\x67\x76\xe6\xb7\xf3\x7f\xe8\xaa\x7a\x0d\xaf\x12\x29\x92\x02\xde\xed\x40\xfc\x9e\x7e\x61\x11\x7f\x4e\x6e\x24\x82\xfb\x13\x9f\x24\x33\xac\xeb\x21\xb3\x16\xe9\x2d\xce\x12\x47\xa6\x03\x2d\x07\xfc\x17\xe2\x71\x4b\xe5\x03\x62\x02\xf1\xfb\x2c\xfb\x28\xf5\x4d\x22\xe1\x75\x5d\x0f\xdf\xbc\x81\x8b\xbc\x40\x63\x3f\x42\x62\x2d\xce\x72\x5b\x40\xa2\x40\x28\x7a\x17\x41\xa2\x32\xc8\x34\xf2\xbb\x32\xcf\x12\x8b\xa0\x0d\x88\xa9\xd2\x06\x41\x2b\x48\xb5\x9a\x48\x91\xda\x78\x38\x29\x55\x0a\x81\x86\xbf\x54\x95\xc3\x1f\x5f\xe4\x63\xa1\xa6\xa5\x4c\x4c\x5d\x87\x8d\x96\x80\x41\x28\x6d\x21\x3e\xd1\x7b\x5a\x59\x7c\xb4\x75\x9d\xda\x47\x12\x45\x0f\xb1\x7f\x19\x41\x55\xa1\xca\x08\xa4\xd7\xbc\xa7\x65\x39\x53\x45\xe4\xc1\xf9\x47\xb8\xd1\x42\xc6\xfe\x21\x04\x34\x46\x1b\xa8\x86\x03\x83\xb6\x34\x0a\x74\xec\x14\x3b\xbd\x5d\x9d\x7c\xee\x23\xda\xfd\xdd\x20\xac\x2a\x94\x05\x32\x8e\x08\x1a\x82\xe7\xf4\x74\x95\xd5\x75\xb4\x11\x49\x38\xac\x87\xc3\x05\xe8\xa1\x33\x37\x19\xb0\x63\x72\xfa\x79\x9a\x28\x91\x2e\x19\xff\xf4\xff\xb3\x3e\xb0\xcc\x82\xde\xb1\x01\xb6\x76\xc7\xe9\x4b\xfb\xa3\x1a\x0e\xc4\x84\x40\x51\x74\xfe\x91\xce\xf8\x07\x2b\xfd\x69\x07\x94\x90\x84\x62\x90\x93\x89\x02\x96\x77\x69\x92\xfc\xc0\x98\x00\x8d\x09\xc3\xe1\xa0\x5e\xe5\xb8\x35\x9e\x5a\xe5\x28\x28\x0b\xa1\xa6\xf4\x8c\x8f\x98\x96\x56\x9b\x6f\x49\x9c\x8e\xe8\xfc\xfb\xbc\x78\xfa\xd4\x9e\x04\xc4\xd9\xee\xc0\x43\xea\x58\xf5\xa9\x6b\x5b\x76\xff\xaa\x73\xea\x79\x5b\x6f\xef\xf2\x15\x71\xd6\x8d\x2b\x82\xf1\x72\x6e\xbd\x4f\x0c\xcc\xe6\xe3\xb3\xa3\x95\xc6\xbc\x50\xe2\x6b\xd9\x68\x85\x1d\xb8\xba\x2e\xac\x11\x6a\x5a\x71\x9d\x35\x89\x9a\x22\xbc\x12\x11\xbc\x4a\xb5\xec\x54\xda\xe6\x00\x69\x18\x10\xa7\x98\x30\x4b\xec\xe4\xd1\xdb\x51\x55\xf1\x1b\x57\xb6\x47\x91\xe3\x6b\x60\xf9\xdf\x35\xa3\x5d\xc4\xc2\x4b\x44\xd9\x18\xb1\xe7\x29\xc8\x74\x5a\xce\x50\xd9\xc4\x0a\xad\x60\xa2\x0d\xdc\xea\x07\xb0\x1a\x72\xa3\x73\x34\x72\x0e\x65\x81\x7d\x77\xb0\xc6\x9e\x47\xb6\x0d\xd2\x3f\x57\x8c\x2e\xda\x84\x98\x80\x86\x9d\x36\x9c\x7c\xdb\x60\x7a\x11\x9f\xe0\x43\x30\xaa\xaa\xf8\xf4\x6e\xea\xbc\xf7\x0e\x94\x86\xaa\xea\x35\x62\x32\xd7\xbd\xc8\x30\x63\x13\x96\x7c\xdb\x11\xc7\x9f\xf3\x34\x39\x52\x92\x6b\x46\x56\xcc\xb0\xb0\xc9\x2c\xff\xe2\xb8\xbe\xdc\xa2\xcc\xd1\x8c\x20\x86\xda\x71\xb7\x39\xf2\x9b\xd6\x77\x3e\xac\xba\xd9\x94\xe9\x5d\x9c\x68\x83\xce\xa8\xcc\xb4\x75\x6a\x3d\x4d\x9e\xf6\xb6\x04\x77\xd0\xc6\xe2\x70\xa0\xfe\xb3\x8f\x93\xa4\x94\x96\x07\x91\xaf\x25\x1a\x81\x45\x7c\xa2\xd5\xbf\xd1\x68\x4f\x1a\x23\xb9\xd5\x3b\x7d\x5f\x3f\xa8\xd6\xed\xde\xd2\x97\xc2\xde\x7a\xe6\x08\x74\x48\x62\x5d\x62\x3c\x23\x75\xcb\x3c\x65\x99\x6c\x20\x89\x2a\x58\xc8\x0e\xc9\xa3\x6f\xd7\xf9\x33\x4d\x14\x19\xcb\xb9\x00\x1e\x84\xbd\x85\x04\x2c\x4f\x50\xf6\x36\xb1\xe0\xe9\x4d\xee\x50\x1e\x25\x50\xb2\x64\x48\x59\x6f\xe3\xdd\x37\x6f\x60\xb7\x14\x32\x83\x34\x49\x6f\x11\xee\x70\x0e\x42\xbd\x96\x42\x21\x94\x53\x29\xe4\x1c\x5e\xc3\x6c\x5e\x7c\x95\x70\x5f\x40\x4e\x7f\x73\xa3\x6f\x24\xce\x8a\xe1\xe0\xa6\x9c\x90\x09\x0a\x6b\x66\x89\x9a\x4a\xa4\x26\xb7\x5b\x4e\x26\x68\x82\x90\xa9\xf1\xa5\x11\x16\xc7\x5c\x84\x82\xc2\x9a\x54\xab\xfb\xf8\xd0\xea\x24\xe8\xc5\x79\xfc\x49\xa8\x8c\xca\x1d\x05\xdf\x97\x08\x52\x92\xea\xca\x55\x9f\x6f\x4f\xcb\x82\x4d\xb2\x2c\x3b\xe5\xdb\xb4\xaf\x77\xe7\x16\x83\x5f\xe3\x5f\x9f\x83\xd1\x2f\x03\xeb\x61\xf4\xf9\xbe\x07\xc6\x53\x99\x9d\xe8\xfc\x01\xb2\x9a\x90\xdc\x20\x8a\x7c\xfb\x6e\x07\x88\xea\x09\xe1\x70\xd0\x3a\xef\xb4\x6c\x9c\x77\x53\x4e\x42\x4e\xe5\x95\x69\xe1\xd2\x76\x8f\xc2\xe5\xb8\xb4\xf1\xf9\x91\x4e\xef\x48\x12\x07\x50\xe4\xe2\x28\x23\x45\xcf\x9f\xbf\xba\xc3\xf9\xf5\xd6\x8a\x2e\x94\x74\xaa\x86\x03\xea\x83\x54\x07\x38\x27\x5c\xf6\xfc\xe4\x15\x93\x01\x9a\xe1\xd3\xa0\x25\x20\x7d\xef\x1d\x76\x9e\x28\x4f\x87\x83\xc1\x3a\x04\xef\xa5\x6c\xb2\x74\x03\xd7\x8a\x3a\xb1\x1d\xb7\x2e\x6d\xf7\x40\x1b\x10\xf4\x18\x0e\x07\x03\xdf\x0f\xdf\xed\x2c\xe5\xc1\x45\xe7\xe9\x87\x5c\xe1\xd4\x88\x59\x62\xe6\x9f\x70\xde\x61\x26\x43\xb3\x65\xfb\xca\x0f\x8b\x13\xad\x30\x08\xe1\x97\x5f\xb8\x64\x39\x6a\xa7\x5e\x3d\xdf\x80\x4a\xe5\x4a\x95\x6e\x2a\xd8\x52\x3b\x8a\x20\xd5\xa5\xcc\xb8\x21\xdc\x70\x75\xf2\x96\x70\xb5\x0b\xa4\x28\xb8\x3d\x71\x05\x23\x75\xd0\xad\x42\x63\x1a\xb5\x67\xb9\x44\x1a\x0c\x02\x83\x36\x6a\xf3\x83\x0e\x71\xa0\xc4\x54\xb8\xe7\xb0\xe3\xe4\xbb\x50\x3b\xa3\x57\xc7\x54\xb6\x83\x4c\x24\x12\x53\x1b\x01\x4d\x3e\x45\x77\x05\x1d\x35\x4d\xba\xe9\xce\xad\x48\x83\x4e\x04\xec\xc0\x64\x66\xe3\x71\x6e\x84\xb2\x13\xf6\xce\x68\x7c\x70\x74\xb0\xf7\x19\x7e\x2e\xe0\xc3\xf9\xef\xc7\x74\xe1\xa3\xb3\xba\x5e\xba\x77\x55\xc5\xe7\x67\x75\x0d\x97\xbf\x1d\x9c\x1f\xc0\xcf\xc5\x88\x7d\xe6\x86\xb8\x22\xfe\xa7\x16\x2a\x68\xaf\x79\x98\xa1\xb2\x67\xa5\xb6\x38\x96\x22\xc5\x06\x72\x7c\x74\x16\x41\xf3\xfb\xfc\x8c\x93\x20\x8c\x60\x14\x8d\xc2\x46\x9a\x17\x70\x79\x8b\x06\xf7\x64\x52\x16\xc8\x0e\x22\x40\x23\xbe\x31\xa3\x18\x45\xf0\xb6\x6b\xb9\x45\x48\xb8\xcb\xde\x27\xb2\xc4\xe3\x24\xcf\x85\x9a\x46\x9c\x8c\x6d\x33\xdc\x15\x2a\xf3\xa4\x75\xcd\xf5\xf3\x3c\xc7\x68\x5d\x89\x58\x88\x6d\x2d\xec\x07\x88\x4e\xe3\xef\x75\x7e\xaa\x6f\x4d\x0f\xa5\x0b\x13\xa3\x8f\xc6\x85\x6f\x5e\x1a\x2c\xe9\x25\x85\x2b\xa0\xf6\xb1\x32\xd8\xda\xf5\x5f\x36\x23\x17\x72\x9c\xb0\xcb\x0e\x55\x26\x0c\xa6\x14\xb8\xee\xc5\xbf\x88\xe3\xf7\x49\xa0\xa9\x35\xdd\x27\xb2\x37\x76\x30\xb1\xf8\x60\xf4\xac\xb9\x02\x0b\xf4\x65\xb8\xe7\xa4\xd0\x95\x4d\x87\xa4\x80\xab\x6b\xa1\x2c\x9a\x49\x92\x62\x55\x2f\xe6\x8f\x65\x63\x75\x0c\xd9\x1c\x6c\x95\x9f\x5a\xb3\x5e\x75\x47\x46\x33\x47\xf6\x86\xe7\xc5\x5c\xc8\x53\xed\x3e\xde\x94\xd3\x63\x9d\x21\xab\xa2\xec\xf9\xc0\xd9\x23\x55\xd0\xd2\xb9\xa7\x99\x46\x01\x27\x70\xf8\x3c\x37\x99\x2c\xf4\xb3\x21\xcd\xe6\x7d\xc5\x87\x05\x33\x07\xa9\x7d\xe4\xb5\x6f\xf0\xc0\xc7\xb8\x51\x2e\x89\xa2\xab\x32\xdf\xb2\xce\x87\x2d\x70\x3d\xac\x42\xd3\xac\x75\x54\x96\xd3\x44\x1d\x25\x85\x75\xdd\xe9\x70\xbf\xbb\x9f\x2d\x51\xfc\x9e\xc6\x5b\xda\x2a\xd2\x6a\x4b\x1b\x2c\x78\x82\xf5\x63\x38\x0d\xd3\xbc\xb4\x04\x1d\xd4\x0e\x5e\x1c\xc7\x21\x4b\x69\xad\xb5\xee\xb0\xd7\x10\xf0\xa4\xbe\x41\x90\xbf\x68\x4f\xe6\x6a\x98\x5f\x9a\xf4\xfc\x36\x80\x4f\x8f\x7d\x3b\xb4\x66\x71\x58\x91\xc0\xfd\x8e\x46\x4b\x3a\x6d\xe8\xae\x56\x6e\xea\x6b\x34\xa9\x2d\xd5\xf8\x51\x37\x1d\x56\x3b\x90\xd2\x54\xd2\xdb\x7d\x10\xca\xfe\xfd\x6f\x3d\x70\x44\x74\x93\xfc\x71\x92\xc3\xd5\x75\xe9\x59\xe8\x7d\x53\xac\x79\x40\xed\x27\xf8\x86\x0c\x5f\x34\xee\xa9\xb6\x1a\x78\xde\xf2\xbb\xdb\xb3\x48\x1d\xca\xc6\xf6\x2e\x4a\xe2\x0e\x5b\x46\x83\xe1\x5a\x73\x1e\x18\x33\x9e\xab\xf4\x43\x22\x64\xa3\xe9\x55\xaa\x25\x7f\xf9\xe5\xb1\x2d\xc3\xc7\x26\x09\x4e\x3f\xe1\x7c\xb1\xf5\xbf\x6d\x5d\xb6\xf4\x2d\x83\x3f\xb3\xf1\x8c\xb0\x90\xd4\x63\xfd\x2c\xac\x74\xd3\xa9\xaf\xe5\x4b\xdc\xc4\xab\x63\x87\xc3\xf1\xd6\x35\xf0\x28\x9b\x6a\x19\x53\x1f\xa8\xeb\xc0\xdd\xda\xdd\xcc\xfb\x89\xab\xa4\x1f\x88\x56\x5a\xf8\xaf\x44\x5d\xa6\x5c\xbd\xbd\x26\xda\xe6\xc6\x72\x35\x6a\xcd\x52\xd7\xa3\xeb\xf5\xae\xea\x2d\xbf\x8b\x18\x79\xb1\x7e\xd7\x1d\xaa\x7e\x40\xca\x18\xb4\x46\xe0\x3d\x36\x7b\x2a\x77\x93\x62\x4d\x0a\x01\x5d\xb7\x17\xee\x9b\x7a\xe2\x36\xbd\x35\x6a\xb3\x2a\x7c\x81\x6e\xd5\xcc\x86\x5b\x34\xac\xee\xb5\x5c\x9d\xfa\xc3\x7a\xd7\x5a\x94\x0f\xcf\x60\xeb\x74\xb2\x15\x76\xeb\x94\x66\x16\x7f\xae\x1f\x82\xbe\xc2\xa7\x92\xe3\x71\x9a\xf0\x2c\x47\x43\x87\x53\xd5\xb5\xc1\x0a\x91\x2b\x2a\xfe\xb7\x8a\x6f\x9a\xc1\x0f\x08\xe7\x5c\xe7\x25\x7f\x32\xcb\xdc\x52\xb7\x39\x9e\xa9\xfc\x75\xd3\xf9\xdd\x93\x9d\x76\xbb\x25\xb9\x59\xc6\xb7\x60\xe7\xe5\x1b\x76\x9c\xa5\xb6\x56\xb0\x58\xc2\x07\x1b\xbe\xf6\x2d\xfe\x71\x95\xe9\xf7\x13\x8b\xe6\xbb\xbe\xf4\xf9\x72\xd6\x99\x41\x58\xa8\xa2\x66\xd1\xfd\xe2\xfc\xbf\x00\x00\x00\xff\xff\x44\x38\x45\x49\x6f\x1c\x00\x00")
+var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\xdb\x48\x0e\x7f\x96\x3e\x05\x6b\x74\xbb\xd2\x41\x55\x7b\xc0\xe1\x1e\x7a\xc8\x43\xf3\xa7\xdd\x5c\x93\x34\x89\x9b\x0b\x70\x41\x50\x28\x12\xe5\x0c\x32\x9e\x51\x47\xa3\xa4\x5e\x9d\xbe\xfb\x81\xf3\xc7\x92\x1d\xdb\x71\xb7\xed\x62\x9f\x6c\x69\x38\x24\xe7\x47\xf2\x47\x8e\xda\xf6\x25\xb0\x12\x84\xd4\x90\x7e\xca\x6e\x38\xa6\x87\xf5\x39\x66\xc5\x47\xc1\x67\xf0\xb2\xeb\x42\x12\x78\x9e\x71\x96\xd5\xf0\x66\x07\xd2\xb7\xf4\x0f\x6b\x2b\xeb\xb7\x9c\x64\x53\xf4\xa2\x75\x7e\x8b\xd3\xcc\xbc\x37\x1b\x7a\x09\xf8\x1f\xa4\xe3\x7e\xd5\x6c\x60\x25\xa4\x6f\x8b\xe2\x3d\x97\x37\x19\x37\xf6\x5e\xbd\x82\x8b\xaa\x46\xa5\xdf\x43\xa6\x35\x4e\x2b\x5d\x43\x26\x80\x09\x7a\x97\x40\x26\x0a\x28\x24\x9a\x77\x4d\x55\x64\x1a\x41\x2a\x60\x13\x21\x15\x82\x14\x90\x4b\x51\x72\x96\xeb\x34\x2c\x1b\x91\x43\x24\xe1\x6f\x6d\x6b\xfd\x4f\x2f\xaa\x31\x13\x93\x86\x67\xaa\xeb\x62\x6f\x25\x6a\x5b\x7f\xfe\x13\xb9\x27\x85\xc6\xaf\xba\xeb\x72\xfd\x95\x54\xd1\x43\xea\x5e\x26\xd0\xb6\x28\x0a\x72\xd2\x59\xde\x93\xbc\x99\x8a\x3a\x71\xce\xb9\x47\xb8\x91\x8c\xa7\xee\x21\x06\x54\x4a\x2a\x68\xc3\x40\xa1\x6e\x94\x00\x99\x5a\xc3\xd6\xee\xd0\xa6\xd9\xf7\x1e\xf5\xfe\x6e\x14\xb7\x2d\xf2\x1a\x8d\x1f\x09\xf8\x05\x27\xe9\xd6\x45\xd1\x75\xc9\x46\x4f\xe2\xb0\x0b\xc3\xb9\xd3\xf4\x97\x95\x06\xc0\x01\xe4\xf4\xf7\x34\x13\x2c\x5f\x02\xff\xf4\xfb\xd0\x07\xa3\xb3\xa6\x88\x18\x00\xb6\x0e\xc7\xe9\xcf\x8e\x47\x1b\x06\xac\xa4\xa8\x50\x76\xfe\x99\xc1\xf8\x97\x31\xfa\x6c\x07\x04\xe3\x94\x0f\x41\x45\x10\x45\xc6\xd0\xa5\xca\xaa\x03\xa5\x22\x54\x2a\x8e\xc3\xa0\x5b\x15\xb8\x35\x91\x5a\x15\x28\x68\x6a\x26\x26\xf4\x8c\x5f\x31\x6f\xb4\x54\xdf\x52\x38\x03\xd5\xd5\x1f\x8b\xe2\xe9\x63\x3c\xc9\x11\x8b\xdd\x81\x73\x69\x80\xea\xe3\xd0\xf6\xe2\xee\xd5\x60\xd7\xd3\x58\x6f\x1f\xf2\x15\x79\x36\xcc\x2b\x72\xe3\xe7\x85\xf5\x3e\x53\x30\x9d\x8d\xcf\x8e\x56\x82\x79\x21\xd8\x97\xc6\x5b\x85\x1d\xb8\xba\xae\xb5\x62\x62\xd2\x1a\x9e\x55\x99\x98\x20\x3c\x67\x09\x3c\xcf\x25\x1f\x30\xad\xdf\x40\x49\x12\x38\x76\x27\x91\xd4\xea\xa3\xb7\xa3\xb6\x35\x6f\x88\x94\xbb\x6e\x94\x58\x39\xef\x96\xfb\xdf\x19\x6f\xe7\xb9\xf0\x33\xb2\x6c\x8c\xb8\x10\x29\x28\x64\xde\x4c\x51\xe8\x4c\x33\x29\xa0\x94\x0a\x6e\xe5\x03\x68\x09\x95\x92\x15\x2a\x3e\x83\xa6\xc6\xc5\x70\x18\x8b\x0b\x11\xd9\x36\x49\xff\x5a\x39\x3a\x6f\x13\x2b\xd2\xd2\x64\x4b\x40\x3e\xec\x58\xf7\x2e\x99\xbe\x3d\x6b\x50\xcd\x3e\x56\x91\xc9\xd8\xd1\xca\x93\x8e\x12\x18\xd9\xb3\x8e\xe2\x30\x0c\xfa\xcc\x23\x06\x94\xb0\xd3\xe7\xac\xeb\x4d\xc6\x89\x3a\x3d\xc1\x87\x68\xd4\xb6\xe9\xe9\xdd\xc4\xa6\xc8\x1b\x10\x12\xda\x76\xa1\xdb\x53\x4c\xee\x59\x81\x85\x89\x53\xe3\xcd\x04\x9d\xb1\xf4\x12\x88\x93\x38\xc5\x7f\xa4\xd9\x14\x6b\x9d\x4d\xab\xcf\x56\xea\xf3\x2d\xf2\x0a\xd5\x08\x52\xa0\x2a\x58\x38\xf1\x6f\x52\xde\xb9\xdc\x1d\x96\x6c\x21\x77\xb1\x94\x0a\xed\x69\x8c\xd0\xd6\xf5\xfb\xb8\x42\xfb\xd3\x52\x4d\xfa\xe4\x37\xbe\x88\xdf\xf7\xb1\xcc\x1a\xae\xcd\xb4\xf3\xa5\x41\xc5\xb0\x4e\x4f\xa4\xf8\x2f\x2a\xe9\x96\xc6\xa8\xa3\x39\xde\xfb\xf2\x41\xf4\x88\xbb\x70\x52\x7c\x9c\x70\x02\x32\x0e\x03\xf1\xbb\xad\xbe\x27\xb4\x6e\x49\x06\x46\xa7\xe1\x34\x8e\x22\x9a\xeb\x8e\x29\xa2\xaf\xd7\xc5\x33\xcf\x04\x81\x65\x43\x00\x0f\x4c\xdf\x42\x06\x9a\x02\x0a\xfa\x36\xd3\xe0\xd6\x7d\x81\x12\xe7\x67\xd0\x18\xaf\x21\x37\xc7\xf2\xd1\x7d\xf5\x0a\x76\x1b\xc6\x0b\xc8\xb3\xfc\x16\xe1\x0e\x67\xc0\xc4\x4b\xce\x04\x42\x33\xe1\x8c\xe6\x46\x98\xce\xea\x2f\x1c\xee\x6b\xa8\xe8\xb7\x52\xf2\x86\xe3\xb4\x0e\x83\x9b\xa6\x24\x08\x6a\xad\xa6\x99\x98\x70\xa4\x16\xbb\xdb\x94\x25\xaa\x28\x36\xab\xe9\xa5\x62\x1a\xc7\x86\xe9\xa2\x69\x76\x87\x7b\x64\xe4\x03\xce\xa2\xa5\x62\x12\x8c\xc7\xc3\x2d\xbb\x33\x8d\xd1\xaf\xe9\xaf\x4f\xa9\x59\x28\xc2\x8d\x6a\x28\xaf\x3f\x27\x90\x93\xc3\x96\x6e\x07\xd9\x41\x28\x2f\xdb\xc9\x0d\x40\x5b\xeb\xf2\x29\xb1\x41\x15\x61\xfb\x66\x07\x68\xd5\x2d\xc4\x61\xd0\x83\x77\xda\x78\xf0\x6e\x9a\xd2\x96\xf8\xca\xb4\xb4\x65\x63\x90\x3c\x6e\x74\x7a\x7e\x24\xf3\x3b\xc2\xdb\x04\x30\xb1\x71\x2c\xe8\x98\x4f\xef\xbf\xba\xc3\xd9\xf5\xd6\x86\x2e\x04\xb7\xa6\xc2\x80\x9a\x1d\x0d\x40\x26\x27\x6d\xf6\x3e\x73\x86\x09\x00\x3f\x61\x2a\xd4\xe4\xc8\x42\x94\xd2\xc3\xc1\x13\x55\x5f\x18\x04\xeb\x3c\x78\xcb\xb9\x8f\xed\x06\xa9\x15\x75\xba\x9d\xb4\x6c\xf4\x70\x43\x9f\x10\x49\x18\x04\x71\x18\x04\xae\xe9\xbd\xd9\x59\x24\xff\xf4\x62\xf0\xf4\x43\x8e\x70\xaa\xd8\x34\x53\xb3\x0f\x38\x1b\x08\x13\xd0\x06\xd9\x45\xe3\x87\xf5\x89\x14\x18\xc5\xf0\xe2\x85\xa1\x0c\xbb\x3a\xe0\x8b\xa7\x1b\x40\x23\x2c\x55\x48\xcf\x20\x4b\xed\x20\x81\x5c\x36\xbc\x30\x3c\x7e\x63\xd8\xc1\x21\x61\xb9\x03\x38\xab\x4d\x7b\x30\xfd\x81\xcc\xc1\x90\x05\xc6\xa8\xf7\xe4\xb4\xe2\x48\xdd\x3f\x52\xa8\x93\xbe\x3e\x68\x93\x49\x94\x94\xe8\x78\x06\x54\x0e\x8c\x17\x36\xa7\x4d\x17\x3c\x26\xda\x8c\x0a\x96\x71\xcc\xb5\x6d\x86\x83\x5b\xa8\xe9\x83\xd6\x19\xdf\x82\x7b\x95\x0a\xf5\x99\xd3\x5a\x4e\x75\x3a\xae\x14\x13\xba\x8c\x08\x92\xd1\xf8\xe0\xe8\x60\xef\x13\xfc\x52\xc3\xbb\xf3\x8f\xc7\xd4\xff\x8e\xce\xba\x6e\xe9\xdc\x6d\x9b\x9e\x9f\x75\x1d\x5c\xfe\x76\x70\x7e\x00\xbf\xd4\x34\x4d\x05\x54\xa2\x4c\x4c\xea\xf4\xdf\x92\x89\xa8\x3f\xe6\x61\x81\x42\x9f\x35\x52\xe3\x98\xb3\x1c\xbd\xcb\xe9\xd1\x59\x02\xfe\xff\xf9\x99\x29\x82\x38\x81\x51\x32\x8a\xbd\x36\xa7\xe0\xf2\x16\x15\xee\xf1\xac\xa9\xd1\x04\x88\x1c\xa2\x2e\xef\xbc\x18\x25\xf0\x7a\x88\xdc\x3c\x25\xec\x61\xef\x33\xde\xe0\x71\x56\x55\x4c\x4c\x12\x6a\x7f\xd0\x37\xa3\x5d\x26\x0a\xb7\xb4\xae\xb9\x7d\x9a\x55\x98\xac\xa3\x88\xb9\xda\x1e\x61\x56\x2e\x37\xde\x41\x9a\x85\x01\x51\xa5\xef\x61\x74\x60\x78\x36\xcf\xc6\x79\x6c\x7e\xb6\xb3\x64\x37\x0c\x56\xba\xba\xe8\xab\x71\xb6\x23\x4e\x26\x26\xe3\x0d\x12\x49\x29\x2c\x4d\xf8\x0e\x45\xc1\x14\xe6\x3a\xf2\x2f\xfe\x43\x40\x7f\x2c\x23\x49\x1d\xea\x3e\xe3\x0b\x6d\xdf\x2c\xd6\xef\x94\x9c\xfa\x23\x18\x85\x09\x3c\x0e\x92\xd9\xad\x28\x1d\x1a\x25\x6a\xb8\xba\x66\x42\xa3\x2a\xb3\x1c\xdb\x6e\xde\xff\x97\xc1\x1a\x00\xe9\x37\xf6\xc6\x4f\xb5\x5a\x6f\x7a\xa0\xc3\xcf\x71\x0b\x13\xf2\x7c\x2e\x33\x43\xe8\x3e\xde\x34\x93\x63\x59\xa0\x31\x45\xd5\xf3\xce\x54\x0f\x17\x51\xbf\x6e\x7a\x9a\xf2\x06\xc8\x8b\x59\xfc\xb4\x34\x41\x16\xbb\xd9\x8c\x06\xf0\x45\xc3\x87\xb5\x11\xa6\xc9\x37\x36\xb6\x1f\xcc\x36\xc2\x78\x59\x15\x1d\xd5\xc8\x2d\xdb\x7c\xd8\xc2\xaf\x87\x55\xde\xb8\x09\x9a\xfe\x3f\xcf\x33\x71\x94\xd5\xda\x76\xa7\xc3\xfd\xe1\x25\x6c\x69\xc5\x5d\xc6\xcc\x55\x6c\xd5\xd2\x6a\xa4\x15\xd6\xd4\x68\xfc\x18\x4c\xd7\x93\x94\xee\x18\x2e\xe4\xc6\x6b\xeb\x5e\x9a\xa6\x04\xeb\x10\xad\xa5\xcd\xf3\x6b\x8d\xb3\x40\xa8\x24\xee\x7e\xbb\x41\x9d\xbf\x30\x0c\x35\xaf\x76\xf6\xb3\x2f\xd2\x6f\x73\x73\xbe\xed\xbb\x1d\xf4\x43\xfc\x8a\x62\xee\x4b\x59\xaa\xda\xdc\xca\xe9\x4a\x9e\xc0\x53\x3d\x8e\x26\xc0\x25\xbe\xef\xaf\x38\x6b\x83\x79\x9f\x29\xe0\xf4\x76\x1f\x98\xd0\xff\xfc\xc7\x82\x73\xb4\xd8\x98\xc6\x76\x9c\x55\x70\x75\xdd\x38\x11\x7a\xef\x89\x7b\x4f\xf2\xe5\x62\xdf\x50\xed\xf3\x26\x3e\x91\x5a\x82\x19\xf2\xdc\x3d\xea\x49\x4f\xad\x97\x3e\x02\x36\x63\xd2\x81\x58\x11\xc5\x1b\xe0\x3c\x50\x6a\x3c\x13\xf9\xbb\x8c\x71\x6f\x89\x3e\x2b\xd0\xc4\x40\xe9\xca\x44\x81\x5f\x7d\x41\x9c\x7e\xc0\xd9\xfc\x9a\xff\xba\x0f\xd9\xd2\xc7\x8b\xf7\xe8\xa6\x3c\x98\x6b\x5a\x10\xfd\xc4\x34\xb7\x93\xaa\xe3\xf5\x25\x69\x92\x95\xa9\xf5\xc3\xca\x76\x1d\x98\xb1\x96\xbe\x77\x50\x4f\xe8\xba\xc8\x9e\xda\x9e\xcc\xc5\xc9\x30\xe6\x8b\x17\xeb\x11\xfe\x3b\x8d\x4e\xcb\x2b\x57\xaf\xaf\x69\x6d\x73\x93\xb9\x72\x5f\x5b\x5c\xfa\x5c\xaf\x0f\xd5\x20\x4d\xc2\x60\x9e\x23\x3e\x3a\x9e\xc1\x7f\x58\xa3\xee\xc7\x84\x1f\x52\x32\x0a\xb5\x62\x78\x8f\xfe\xce\x68\xfa\x58\xbd\xa6\x84\x80\xd8\x74\x21\xdd\x37\xf5\xc7\x6d\xfa\x6c\xd2\x57\x55\x1c\x86\xab\x29\xea\x3b\x3a\x97\x9f\x13\xb7\x68\x5e\xc3\x63\x59\x5e\xfe\xd3\xfa\xd8\x5a\x2f\x1f\x9e\xf0\xcd\xb1\xe8\x1a\xdc\x06\xbc\x6e\x86\xe5\x73\xf9\xd0\x57\x89\x79\xf3\x58\x73\x3a\xce\x33\x33\xd7\xd1\xe4\x42\x2f\x16\x31\x18\x70\xbe\x57\xb9\x96\xf7\xbf\xd5\x88\x6f\x09\x3f\x20\xa9\x2b\x59\x35\xe6\x23\x56\x61\x2f\x7d\x9b\xb3\x9a\x48\x70\x58\xd4\x6f\x1e\xdd\x72\xb7\xbb\x36\xfb\xeb\xf9\x16\xe2\xe6\x3a\x0e\x3b\x16\xa9\xad\x0d\xcc\xaf\xe5\xc1\x86\xef\x6f\x0e\x2c\xfa\xf8\xf6\xb6\xd4\xa8\xfe\xd0\xb7\x37\x47\x6a\xf3\xb8\x3b\xa5\x82\xf1\x21\xdd\x75\x83\xaf\xe2\xff\x1f\x00\xfb\xfd\xe9\x25\x91\x1c\x00\x00")
 
 func templates17_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,11 +89,11 @@ func templates17_upsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/17_upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd6, 0xf8, 0xda, 0x41, 0xf4, 0xe4, 0x3a, 0xe8, 0xd5, 0x18, 0xa, 0xe0, 0x56, 0xf1, 0x42, 0xc4, 0x98, 0x12, 0x92, 0xdd, 0xf, 0x76, 0x2e, 0x45, 0xcf, 0xde, 0xec, 0x7f, 0xd5, 0x3b, 0xec, 0xf7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9, 0xf1, 0x4d, 0xfe, 0x87, 0x6b, 0x57, 0xc1, 0x84, 0x87, 0xdf, 0x7a, 0x9d, 0xe5, 0xcb, 0x7b, 0x28, 0x44, 0x9d, 0x54, 0x86, 0xed, 0x22, 0xdf, 0xec, 0x18, 0x50, 0xea, 0x13, 0xe, 0xad, 0x17}}
 	return a, nil
 }
 
-var _templatesSingletonMysql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\xcd\x8e\xd3\x30\x10\xc7\xcf\xf6\x53\x0c\x91\x56\x8d\x25\x2b\xcb\x5e\x91\x7a\xd8\xa5\x65\x15\x28\xfd\x2e\x08\x21\x0e\x6e\x3d\x6e\x2d\xa5\x4e\xf1\x47\xa1\x42\x7d\x77\xe4\x24\x6d\xb3\x4b\x41\x1c\xf6\x92\x8c\x3d\x33\x7f\xcf\x6f\x66\x6e\x6f\x61\x19\x74\x21\x17\x3b\x87\xd6\x4f\x02\xda\xc3\xc7\xc3\x6c\x32\xa8\x6f\x1d\x08\x88\x07\xe7\x85\xc7\x2d\x1a\x0f\xce\x5b\x6d\xd6\x10\x5c\xfc\xfa\x0d\x42\xa8\x12\x7b\xc2\x0b\xd8\xd9\x72\xaf\x25\xca\x8c\xaa\x60\x56\xd7\x75\x53\xa9\x05\x48\xab\xf7\x68\x5d\xd6\xd3\xa2\xc0\x95\xe7\xe0\xc5\xb2\xc0\xa1\xd8\x62\xa3\xcf\x21\xec\xa4\xf0\xc8\xe1\xc7\x46\x7b\x2c\xb4\xf3\xf0\xf5\x5b\xed\x63\xa7\x1a\x7e\x51\x72\xf1\x76\xe3\xed\x56\x98\x75\x81\x59\x2e\xd1\xf8\x49\x28\x3d\xce\x0a\xbd\xc2\xf8\x64\x36\x98\x70\x88\xff\xe9\xa4\xa5\xc9\x28\xb9\xbc\x7c\x5d\xe1\x8f\xe4\x73\x02\xa3\x94\x2c\x83\x82\x37\xed\xc4\x47\xf4\x0f\x41\x29\xb4\x29\xa3\x44\xa2\x42\xdb\x72\x8e\xc3\xc9\xb9\x0c\x2a\xa6\xef\x85\x85\x55\x59\x84\xad\x71\x0d\x14\x25\x5a\x41\x81\x26\xbd\xd4\x08\xaf\xba\xf0\x3a\xc2\x92\x53\x68\xb7\x09\x76\xd9\xfb\x52\xb7\x42\x39\x24\x3c\x61\x94\x1c\xe9\x59\xa6\x6e\x23\x83\xee\x49\x43\x6d\x7d\xf6\x6e\x67\xb5\xf1\x2a\xa5\x84\x44\x02\x1e\xff\x49\x3e\x9c\xf5\xa7\x73\xc8\x1f\x87\xa3\x69\x1f\xf2\xe1\x7c\x04\x37\x0e\xd2\x1b\xc7\xe0\xd3\xfd\x60\xd1\x9f\x55\x76\x52\x05\x9f\x7b\x50\x9d\x9a\xb2\x2a\xbb\x05\x5b\x88\x15\x6e\xca\x42\xa2\x75\x55\x13\x17\x0e\x73\x23\xf1\x67\xdb\xc1\x9f\xb1\x72\xb8\xe3\x70\xc7\xa2\x14\xa3\x84\x58\xf4\xc1\x1a\x58\x06\x95\xcd\x2a\xe2\xb4\xa1\x7b\x46\xd1\x40\x9c\x19\xfe\x52\x3c\x8c\x86\xd0\x5b\x8c\x07\xf9\xdb\xfb\x79\x1f\x3e\xf4\xbf\xc0\x62\xdc\x8b\x66\x45\xf5\x04\xaa\xc5\xf4\x62\x48\x71\xe2\xaa\xb4\xa0\x39\xec\xe3\xd6\x58\x61\xd6\xd8\x2c\x7a\x35\x1b\xad\x40\x5f\xa6\x1d\xa9\xb2\xcf\x56\x7b\x7c\x38\x78\x4c\x3b\xbc\x13\x5b\x72\xa4\x84\x7c\x8f\x8b\x29\x9f\x2e\xde\x3f\x36\x76\xcf\x68\x4b\xac\x69\x64\xad\x71\xcd\x93\x40\xb7\x69\x5a\x9a\xfc\x67\x66\x5d\x20\xeb\x34\xd3\xb9\x36\xb6\x23\xfd\x1d\x00\x00\xff\xff\x4c\x0d\x4e\x35\x6a\x04\x00\x00")
+var _templatesSingletonMysql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\x4d\x8f\xd3\x30\x10\x3d\xdb\xbf\x62\x88\xb4\x6a\x2c\x59\x59\xf6\xba\x52\x0f\xbb\xb4\xac\x02\xa5\xdf\x05\x21\xc4\xc1\xad\xc7\xad\xa5\xd4\x29\xfe\x28\x54\xab\xfe\x77\xe4\x24\x6d\xc3\x52\xa4\x3d\x70\x49\xc6\x9e\x99\xe7\x79\xef\xcd\xed\x2d\x2c\x83\x2e\xe4\x62\xe7\xd0\xfa\x49\x40\x7b\xf8\x74\x98\x4d\x06\xf5\xad\x03\x01\xf1\xe0\xbc\xf0\xb8\x45\xe3\xc1\x79\xab\xcd\x1a\x82\x8b\x5f\xbf\x41\x08\x55\x63\x4f\x78\x01\x3b\x5b\xee\xb5\x44\x99\x51\x15\xcc\xea\x3a\x6e\x2a\xb5\x00\x69\xf5\x1e\xad\xcb\x7a\x5a\x14\xb8\xf2\x1c\xbc\x58\x16\x38\x14\x5b\x6c\xf0\x39\x84\x9d\x14\x1e\x39\xfc\xdc\x68\x8f\x85\x76\x1e\xbe\x7d\xaf\x73\xec\x34\xc3\x33\x25\x97\x6c\x37\xde\x6e\x85\x59\x17\x98\xe5\x12\x8d\x9f\x84\xd2\xe3\xac\xd0\x2b\x8c\x4f\x66\x83\x09\x87\xf8\x9f\x4e\x5a\x98\x8c\x92\xcb\xcb\xd7\x11\xfe\x6a\x3e\x37\x30\x4a\xc9\x32\x28\xb8\x6f\x37\x3e\xa1\x7f\x0c\x4a\xa1\x4d\x19\x25\x12\x15\xda\x56\x72\x1c\x4e\xc9\x65\x50\xb1\x7d\x2f\x2c\xac\xca\x22\x6c\x8d\x6b\x48\x51\xa2\x15\x14\x68\xd2\xcb\x8c\xf0\xa6\x0b\x6f\xe1\x99\x12\x72\x2a\xed\x36\xc5\x2e\xfb\x50\xea\x56\x29\x87\x84\x27\x8c\x92\x23\x3d\xc3\xd4\x32\x32\xe8\x9e\x30\xd4\xd6\x67\xef\x77\x56\x1b\xaf\x52\x4a\x48\x64\xc0\xe3\x3f\xc9\x87\xb3\xfe\x74\x0e\xf9\xd3\x70\x34\xed\x43\x3e\x9c\x8f\xe0\xc6\x41\x7a\xe3\x18\x7c\x7e\x18\x2c\xfa\xb3\x2a\x4e\xaa\xe2\xb3\x06\xd5\xa9\x19\xab\x8a\x5b\x64\x0b\xb1\xc2\x4d\x59\x48\xb4\xae\x12\x71\xe1\x30\x37\x12\x7f\xb5\x13\xfc\x05\x57\x0e\x77\x1c\xee\x58\x84\x62\x94\x10\x8b\x3e\x58\x03\xcb\xa0\xb2\x59\x25\x4f\xda\xb0\x7b\xc1\xa2\x21\x71\xe6\xf0\x8f\xe1\x61\x34\x84\xde\x62\x3c\xc8\xdf\x3d\xcc\xfb\xf0\xb1\xff\x15\x16\xe3\x5e\x0c\x2b\x56\x7f\x90\x6a\x71\xfa\x6f\x94\xa2\xe3\xaa\xb4\xa0\x39\xec\xe3\xd6\x58\x61\xd6\xd8\x2c\x7a\xe5\xaf\x56\xa0\x2f\x6e\x47\x6b\xb2\x2f\x56\x7b\x7c\x3c\x78\x4c\x3b\xbc\x13\x25\x39\x52\x42\x7e\xc4\xc5\x94\x70\xff\xca\x8d\xdd\x33\xda\x02\x6b\x84\xac\x31\xae\x65\x12\xe8\x36\xa2\xa5\xc9\x2b\x3b\xeb\x01\x59\xa7\x71\xe7\x9a\x6d\x47\xfa\x7b\x00\x4c\x0d\x4e\x35\x6a\x04\x00\x00")
 
 func templatesSingletonMysql_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func templatesSingletonMysql_upsertGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonMysql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5b\x6f\xdc\x36\x16\x7e\x96\x7e\xc5\x89\x80\x74\xa5\x54\x66\x02\x14\xd8\x07\x07\x42\x10\x8f\xc7\x85\xd1\xc4\x97\x19\xef\x16\x8b\xba\x68\x69\x89\x63\x13\x96\x48\x99\xa4\x3c\x9e\x35\xfc\xdf\x17\x87\xd4\x85\x1a\x8f\xbc\xce\x6e\x1e\xfb\x94\x48\xfc\xce\xed\x3b\x47\x87\x9f\xe7\x9e\x2a\x50\xd7\x0f\x5f\x37\xcb\xf3\x2f\xb7\x6c\x03\x19\x28\x76\xcd\x1e\x6a\xf2\xb5\xd1\x66\x26\xab\x9a\x97\x2c\xfe\x33\xfe\x54\x25\x71\x9c\x5e\x8a\xe4\xd3\xa5\xfe\x71\x76\x7a\xb2\xbc\x58\x7c\x3e\x3e\xb9\x20\xef\x3e\x1d\x9d\x2e\xe6\xc7\x3f\x9f\xc0\x2f\xf3\x7f\x91\x77\x9f\x2e\x45\xf2\xe3\x9f\x49\x18\x9a\x4d\xcd\xa0\xda\xe8\xbb\xf2\x82\x69\xc3\x14\x68\xa3\x9a\xdc\xc0\x63\x18\x14\x57\x33\x29\x04\xbc\xd3\x77\x25\x39\x3c\x08\xf1\xc5\x09\xad\x18\x20\x84\x8b\xeb\x30\xb8\x91\xda\x00\x0c\xcf\x8d\x66\xca\x7f\xae\xa9\xd6\xfe\xb3\xd6\x65\x25\x0b\x36\x9c\x4b\x65\xed\xb9\x30\x61\x18\xc8\xda\x70\x29\x8e\x78\xd9\x03\xc2\xc0\x30\x6d\x0e\x0f\x6c\xd4\xde\xc9\x2d\xaf\x97\xe7\x5f\x66\x55\x01\x57\x52\x96\xe1\x53\x18\xae\x1a\x91\x03\x17\xdc\xc4\x89\xcb\xfb\x2b\xe5\x02\x32\xf8\xc1\xab\xeb\xf1\xa9\x47\xc6\x15\xbc\xf3\x4e\x12\xd0\xcc\x34\x75\x9c\x00\x53\x4a\x2a\xf4\x80\x5c\x33\xa5\xdc\x8b\x30\x0c\xee\x79\xcd\x14\x59\x32\x73\xc8\x56\xb4\x29\x4d\x1c\x59\x7b\xd2\x16\x14\xa5\x10\x19\xd5\xb0\x28\x99\x86\x62\xad\x51\x0a\x3f\xfd\xf4\xe1\xef\x49\x18\x06\x15\x69\xc9\xcc\xc0\x59\xfc\xcc\xcc\xd2\x56\xd8\x19\x14\x57\x82\x56\xd6\x65\x45\x2c\xd1\x93\x48\x3c\x75\x38\xdb\x80\x49\x1c\x9e\x3a\x9c\x6d\xcc\x24\x0e\x4f\x5b\x1c\x36\xc8\xc3\x1d\x8b\x71\x3d\x16\xd4\x75\x75\xd2\x5f\xc7\x92\x45\x7b\x1d\x9d\x34\x40\x8c\x5f\xbe\xd7\x72\xcf\xe6\x40\xca\xb2\x0f\x71\xcb\x6b\x7d\x57\xe6\x55\x11\x21\xbb\xd8\xbb\x0c\xee\x69\x49\xc9\x01\xbb\xe6\xe2\x9f\xb4\xe4\x05\xc5\xf1\x8a\x13\xd2\x3e\xb0\x38\x0c\x02\x0b\x71\xc1\x4f\xa4\x99\x57\xb5\xd9\xc4\x8e\xc6\x14\x46\xac\xa5\x93\x60\x64\xbf\x07\xbb\x56\xf4\xe0\x13\x69\x62\xfb\x9f\xf9\x5d\x43\x4b\x1d\x3b\x46\x53\xf8\xd0\x1b\x38\x1a\x5f\x70\xef\xc6\xa4\xc7\x77\xb4\x4c\x1b\xb4\x6c\xf7\x16\x3d\xfb\x69\x18\x24\x64\x76\xc3\xf2\xdb\x18\x39\xe2\x2b\x3b\xe2\x6f\x32\x10\xbc\xc4\xa1\x0f\x14\x33\x8d\x12\xf8\x36\x0c\x9e\xc2\x30\x78\xff\x1e\x66\x8a\x51\xc3\x80\x82\xa2\xa2\x90\x15\xff\x37\x2b\xa0\xb8\x02\xcc\x81\x58\x17\x25\x13\xb1\xdf\xd4\x04\xb2\x0c\x3e\x58\x77\x5b\xbd\xee\x3d\x90\xa5\xa1\x57\x25\x73\x07\x7d\x85\x89\x8b\xd9\x66\x95\x41\x45\x2a\x7a\xcb\x4e\xfb\x9d\x10\x27\x1f\xa7\xf3\x95\x4a\x93\x5f\x15\xad\x63\xa6\xb0\x71\xb9\x6c\xca\x42\xfc\xcd\x00\xba\x00\xb7\x57\x60\xc5\x4b\x3b\x4e\x6d\x94\x37\xa3\xb1\x42\x77\x5e\xe8\x42\xc9\xfa\xc2\x26\xbf\x23\xec\x88\xa7\xe0\x69\x6c\x99\x5b\xc2\x5e\x6d\x1b\x06\x41\xd1\x54\x35\xa6\xb0\x9f\x01\x7b\x60\x39\x99\xc9\xaa\xa2\xa2\x68\x27\x1b\x4f\xa3\x14\x53\x72\xeb\x44\x3b\x2e\x52\x88\xf6\xf6\x84\xdc\x2b\xa8\xa1\xee\xb8\x23\x31\x70\x19\x4c\x7b\x9c\xf2\x86\xae\xae\xa8\x66\xf6\xdc\x6b\x28\xe6\xa8\x52\x58\xa3\x3b\x2e\xc9\x19\xaf\x59\x9c\x0c\x79\x2f\x4d\x81\x35\xee\x67\xf0\xc3\xd5\xc6\x30\x4d\x0e\x9a\xd5\xca\xae\x5b\x2f\x95\x69\xd0\xe0\x88\x2c\x4d\x21\x1b\x5c\x37\xeb\xf1\x4b\x47\xed\x28\x5c\xe8\x3b\x47\x8c\x5d\xf7\x82\xad\x8f\x7e\x61\x9b\x43\xa6\x8d\x92\x1b\xa6\x62\xef\xba\x4c\x41\x25\xdb\x46\xce\xf1\x56\x92\xa1\xdf\xcf\x21\x0b\xaa\xcc\xcb\xed\xdc\x1a\xc1\x15\xe5\x25\x2b\xc0\x48\xd0\x68\x0b\x7d\x33\x21\x77\xdd\xc0\x51\x1c\x0f\x8f\x9f\xdb\x77\x09\xb7\x15\x6a\x57\x61\xbf\x52\xbe\x33\xd0\xaa\x32\xe4\x4c\x71\x61\x4a\x81\x11\x92\xed\x77\xa3\x6e\xb4\x3b\x28\x4e\x92\x57\xe6\xb8\xa6\xdc\xc0\x4a\xaa\x49\x56\xc2\x20\xf8\x03\x07\x81\xcc\x4a\xa9\x59\x9c\xc0\xfb\xf7\xf0\x79\x85\xea\xa4\xfb\x5a\xb8\x86\x42\x0a\x96\x42\x8e\x08\x30\x37\x0c\xd6\x8a\x1b\x06\x4c\x14\x20\x57\xf6\x45\xcd\x6b\x16\xee\x66\xf8\x7f\xad\x7b\x6b\x58\xfe\xcf\xca\xb7\x67\x01\x0b\x6f\x9d\x08\x5e\xbe\xa0\x57\x74\xf9\x55\x16\x2c\xf6\xc4\x54\xd2\xfe\x8b\x65\xe8\x35\x37\xf9\x0d\xd8\xd3\xc7\x30\xc8\xa9\x66\xad\x3e\xd9\x1f\xb6\x66\xb4\x98\x9f\xff\xe3\x78\x31\x3f\x8c\x3a\xc4\x8a\x96\x7a\x0c\x39\x3c\x5e\x7e\x3e\xf8\xe2\x41\xce\x16\xf3\xa3\xf9\x02\x8d\x7c\x58\x14\x06\xed\x3e\xf1\xde\x62\x74\xac\x68\xb2\x88\xf1\x0a\xf2\xd2\x6f\x1d\x20\xed\xcb\x1a\x79\x5f\xc5\xb8\x9e\x5a\xf8\x1e\xee\xf1\xec\xad\xb6\x6b\x6a\x90\x8c\xc9\x74\xa0\xed\x7b\x64\x90\x79\xa6\xaa\x53\x68\x17\x13\x97\x8d\xe1\x25\xb9\x60\x55\x6d\x61\x11\x8a\x3a\xe7\xbf\xbb\x39\x5e\xba\x31\x27\x3b\xee\x26\x66\xe7\x25\xa4\x2f\x66\x67\x18\xda\x12\x1f\x06\x7f\xa4\xed\x98\x4a\x8d\x1b\xc0\xb4\xda\xc2\x05\x96\x9a\x1c\x6b\xbc\xe5\x1f\xb8\x36\x76\x34\xdd\x9d\x65\x7d\x64\x80\xdd\x0d\x83\x27\x60\xa5\x66\xf0\x0d\x79\xda\x9b\x12\x84\x34\xb8\x37\x0c\x54\xbd\x96\xc4\x04\xb1\x03\x47\x75\x3b\xf9\x96\xab\xe8\xb7\xbc\xe4\x4c\x98\xdf\x11\x32\x1c\xaf\xda\x53\x34\xce\xde\xea\x4b\x61\x9b\xd3\x26\xff\x1c\x86\x9a\x27\x7b\x5b\xb4\x30\x7c\xda\x09\x43\xe1\x35\x78\xc3\xa7\xc4\x93\x1c\x28\x52\x13\xac\xd1\x89\x8d\x1d\x51\xa8\xd6\x6b\xa9\x8a\xc1\x85\x35\xc1\xd2\xd0\x8b\xfd\x3a\xf6\xf1\xca\xee\xbf\xa6\x4e\x2a\x25\x1f\xdd\xe9\x9b\x0c\xa2\x68\xc2\xbb\xd6\xe5\x1e\x82\x7a\xef\x68\xd7\xf9\x76\x5d\x19\x1b\xf6\x14\xd6\x4a\x1a\x99\xcb\x32\x33\x79\xfd\x12\xd3\xfd\x6e\xfc\x8b\xec\xef\x4b\xb6\xbf\x36\xf0\xd3\xa9\x6a\x62\x95\x68\x32\x6c\x5f\x7c\xd7\x5e\x3d\xd3\x7b\x65\x2c\xf5\x86\xad\x82\x8b\x1d\xbf\x6a\x7f\x7f\xb5\x5b\xa0\xd3\x58\xf0\x56\x7f\x7c\xa6\xb3\xfa\xcd\x49\x54\x23\x66\x55\x11\xeb\xbb\xb2\x53\xf1\xd1\x0b\x79\xf8\x62\xf5\xe5\x2c\x10\x39\xe4\x80\x6b\x02\xb7\x89\xfe\xae\xd9\x18\x46\x55\x21\xd7\xc2\xcf\x05\x27\x80\xb4\xbf\x26\x3c\xdf\x4a\xdd\x51\xcf\xf8\x7f\x95\xe8\xfb\xdf\xae\xd1\xbd\xab\x55\x6a\xb2\x60\x95\xbc\xc7\x21\x7c\xd5\x05\xd2\x11\x80\x32\x33\xed\xee\xec\xf6\xc2\x4a\x81\xaa\x6b\x0d\x84\x90\xee\x1e\xee\xab\xb6\x07\x19\xd0\xba\x66\xa2\x88\x7f\xfb\xdd\x01\x1e\xb7\xc5\xf7\x93\x73\x41\x08\xc1\x01\xcc\x77\xe8\xf6\x36\xa2\x87\x43\x58\x2f\x7b\x9d\x5f\x4d\x4e\xd8\x7a\xc1\x68\xc1\x94\xcb\x14\xbd\x69\x27\xa9\x77\x89\x73\x3d\xad\xdb\x73\x5f\x8c\x3b\x17\xfd\x4b\x77\x43\x39\xe3\xd0\xeb\x07\x1e\x2f\x1a\xf1\xbc\x15\xbe\x7a\xea\xae\x45\xd5\x08\xc1\xc5\xf5\x7e\xd4\xb3\xe9\x6a\x4b\xc6\x70\x17\xda\xd7\x58\x5b\xa7\x5b\x0a\x6c\xfb\xef\xd7\xd7\x48\xa9\x5c\x0a\x1c\xd5\xb8\xfd\x91\x2b\x75\xed\x4b\xa6\xa7\x76\x6b\x68\x53\xeb\xde\x86\x1b\xff\x68\x14\x0c\x88\x96\xb3\xbb\x92\x9c\xd6\x4c\x0c\x7f\x86\x15\x8a\xdf\x33\x45\xec\x9f\x28\x07\x0d\x2f\x8b\xf3\x86\xa9\x4d\x5b\x50\xf7\x2b\x84\xdb\xa4\xe3\xaf\xb3\x5b\xf8\xdd\x46\x4f\x61\x58\xa7\xbb\x74\xca\x40\x44\xfa\x8c\x9d\x71\x21\x4f\xe1\x7f\x02\x00\x00\xff\xff\x2f\xbf\x35\x64\x67\x14\x00\x00")
+var _templates_testSingletonMysql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5d\x6f\xdb\x38\x16\x7d\x96\x7e\xc5\xad\x80\xce\x4a\x1d\x85\x2d\x30\xc0\x3e\xa4\x10\x8a\xc6\x49\x06\xc1\xb4\x69\x6b\x67\x77\xb0\x98\x0e\x66\x68\x89\x4e\x89\x48\xa4\x42\x52\x71\xbd\x81\xff\xfb\xe2\x92\x94\x44\x3b\x56\x36\xb3\xc8\xe3\x3e\x14\x2d\xc9\xc3\x73\x3f\x7d\x79\xaa\x3b\xaa\x40\x5d\x7f\xff\xb8\x59\x7c\xf9\x70\xc3\x36\x50\x80\x62\xd7\xec\x7b\x4b\x3e\x76\xda\xcc\x64\xd3\xf2\x9a\xa5\x7f\xa6\xef\x9a\x2c\x4d\xf3\xaf\x22\x7b\xf7\x55\xff\x38\xfb\x74\xb9\xb8\x9a\xbf\xbf\xb8\xbc\x22\xaf\xde\x9d\x7f\x9a\x9f\x5d\xfc\x7c\x09\xbf\x9c\xfd\x8b\xbc\x7a\xf7\x55\x64\x3f\xfe\x99\xc5\xb1\xd9\xb4\x0c\x9a\x8d\xbe\xad\xaf\x98\x36\x4c\x81\x36\xaa\x2b\x0d\xdc\xc7\x51\xb5\x9c\x49\x21\xe0\x95\xbe\xad\xc9\xe9\x49\x1c\x47\xd5\xf2\x92\x36\x0c\x10\xc2\xc5\x75\x1c\x7d\x93\xda\x00\x8c\xeb\x4e\x33\x15\xae\x5b\xaa\x75\xb8\xd6\xba\x6e\x64\xc5\xc6\x73\xa9\xec\x7d\x2e\x4c\x1c\x47\xb2\x35\x5c\x8a\x73\x5e\x0f\x80\x38\x32\x4c\x9b\xd3\x93\x4b\xda\x0c\x7b\x91\xbe\xe1\xed\xe2\xcb\x87\x59\x53\xc1\x52\xca\x3a\xde\xc6\xf1\xaa\x13\x25\x70\xc1\x4d\x9a\x39\xbf\x3f\x52\x2e\xa0\x80\x1f\x82\xb8\xee\xb7\x03\x32\x6d\xe0\x55\x70\x92\x81\x66\xa6\x6b\xd3\x0c\x98\x52\x52\x21\x03\xe6\x9a\x29\xfb\x47\xaa\x38\x8e\xee\x78\xcb\x14\x59\x30\x73\xca\x56\xb4\xab\x4d\x9a\xd8\xfb\xc4\x07\x94\xe4\x90\x18\xd5\xb1\x24\x9b\x86\xb6\x52\x99\x24\x87\x9f\x7e\x7a\xf3\xf7\x2c\x8e\xa3\x86\xf8\x64\x16\xe0\x6e\xfc\xcc\xcc\xc2\xa6\xa5\xbf\x50\x2d\x05\x6d\x2c\x65\x43\x6c\xa2\x27\x91\x78\xea\x70\xb6\x00\x93\x38\x3c\x75\x38\x5b\x98\x49\x1c\x9e\x7a\x1c\x16\x28\xc0\x5d\x88\xdd\x78\x2c\xa8\xaf\xea\x24\x5f\x9f\x25\x8b\x0e\x2a\x3a\x79\x01\x31\x61\xf8\x41\xc9\x83\x3b\x27\x52\xd6\x83\x89\x1b\xde\xea\xdb\xba\x6c\xaa\x04\xb3\x8b\xb5\x2b\xe0\x8e\xd6\x94\x9c\xb0\x6b\x2e\xfe\x49\x6b\x5e\x51\x6c\xaf\x34\x23\x7e\xc1\xd2\x38\x8a\x2c\xc4\xe5\xfd\x52\x9a\xb3\xa6\x35\x9b\xd4\xa5\x31\x07\x4f\x8d\x8b\x24\xcb\x27\xc1\x98\xfd\x01\x8c\x8b\x00\x7c\x29\x4d\x6a\xff\x71\x76\xdb\xd1\x5a\xa7\x2e\xa3\x39\xbc\x19\x2e\xe0\x3a\xc9\x1e\xa1\x77\x6d\x92\xc3\x5e\x57\x4c\x5f\xf0\xd9\xce\x61\x3f\xfb\x79\x1c\x65\x64\xf6\x8d\x95\x37\x29\xe6\x88\xaf\xb0\xbd\xe1\x45\x01\x82\xd7\xd8\xf4\x91\x62\xa6\x53\x02\x77\xe3\x68\x1b\xc7\xd1\xeb\xd7\x30\x53\x8c\x1a\x06\x14\x14\x15\x95\x6c\xf8\xbf\x59\x05\xd5\x12\xb0\x34\xc4\x52\xd4\x4c\xa4\x61\x51\x33\x28\x0a\x78\x63\xe9\xf6\x6a\x3d\x30\x90\x85\xa1\xcb\x9a\xb9\x83\x21\xc2\xcc\xd9\xf4\x5e\x15\xd0\x90\x86\xde\xb0\x4f\xc3\x4c\x48\xb3\xb7\xd3\xfe\x4a\xa5\xc9\xaf\x8a\xb6\x29\x53\x2a\x87\xa4\x94\x5d\x5d\x89\xbf\x19\x40\x0a\x70\x73\x05\x56\xbc\x66\xc9\x68\xe5\xc5\x4e\x5b\x21\x5d\x60\xba\x52\xb2\xc5\x71\x78\x7a\x72\xc0\xec\x4e\x9e\xa2\xed\xee\xcd\xd2\x26\xec\xc9\x77\xe3\x28\xaa\xba\xa6\xc5\x61\x76\x5c\x00\xfb\xce\x4a\x32\x93\x4d\x43\x45\xe5\x3b\x1b\x4f\x93\x1c\x5d\x72\xe3\x44\xe3\x7c\x4c\xb3\x1c\x92\xa3\x23\x21\x8f\x2a\x6a\xa8\x3b\xee\x93\x18\x39\x0f\xa6\x19\xa7\xd8\x90\x6a\x49\x35\xb3\xe7\x41\x41\x63\xec\x8c\x1c\xd6\x70\x5c\x00\x97\xe4\x33\x6f\x59\x9a\x8d\x7e\x2f\x4c\x85\x31\x1e\x17\xf0\xc3\x72\x63\x98\x26\x27\xdd\x6a\xc5\xd4\xfd\x36\x74\x65\x1a\x34\x12\x91\x85\xa9\x64\x87\xe3\x66\xbd\xbb\x89\xf4\x05\xf8\x0d\xc7\x14\x87\xe4\x88\xb1\xe3\x5e\xb0\xf5\xf9\x2f\x6c\x73\xca\xb4\x51\x72\xc3\x54\x1a\x3c\x97\x39\xa8\x9d\xe4\x8c\xc4\xc3\xd6\x48\x3d\xd4\x73\xf4\x82\x2a\xf3\x78\x39\xf7\x5a\x70\x45\x79\xcd\x2a\x30\x12\xb4\xa1\xca\xc0\x50\x4c\x28\x5d\x7d\x93\x6c\xbf\x79\x42\xdf\x9e\xc5\xdc\x9e\xa9\x43\x81\xfd\x4a\xf9\x41\x43\xab\xc6\x90\xcf\x8a\x0b\x53\x0b\x0c\x28\xdb\xdf\xf3\xf7\x5d\xca\xfc\x0c\x4a\xb3\xec\x89\x3e\xae\x29\x37\xb0\x92\x6a\x32\x2b\x71\x14\xfd\x81\x8d\x40\x66\xb5\xd4\x2c\xcd\xe0\xf5\x6b\x78\xbf\x42\x75\xe2\x0d\x03\xd7\x50\x49\xc1\x72\x28\x11\x01\xe6\x1b\x83\xb5\xe2\x86\x01\x13\x15\xc8\x95\xdd\x68\x79\xcb\xe2\xc3\x19\xfe\x5f\xe3\x1e\x18\x9e\x25\xf2\xbd\xa8\x6d\xe0\x9e\x44\xf0\xfa\x11\xbd\xa2\xeb\x8f\xb2\x62\x69\x20\xa6\x32\xff\x37\x86\xa1\xd7\xdc\x94\xdf\xc0\x9e\xde\xc7\x51\x49\x35\xf3\xfa\xe4\x78\x9c\x9a\xc9\xfc\xec\xcb\x3f\x2e\xe6\x67\xa7\x49\x8f\x58\xd1\x5a\xef\x42\x4e\x2f\x16\xef\x4f\x3e\x04\x90\xcf\xf3\xb3\xf3\xb3\x39\x5e\x0a\x61\x49\x1c\xf9\x79\x12\xec\xa2\xf5\x38\x7a\x44\x74\xed\x8e\xa0\xc0\x7d\x4f\x80\x69\x5f\xb4\xd8\x6f\xab\x34\x39\x3a\xea\xe1\x47\x38\xc7\x8b\x97\xda\x8e\xa9\x51\x32\x66\xd3\x86\xf6\xdf\x91\x51\xe6\x99\xa6\xcd\xc1\x0f\x26\x2e\x3b\xc3\x6b\x72\xc5\x9a\xd6\xc2\x12\x14\x75\x8e\xbf\x7f\x39\xf8\x6a\xbf\x5f\x9e\x50\x71\xd7\x31\x07\x1f\x21\x7d\x35\xfb\x8c\xa6\x6d\xe2\xe3\xe8\x8f\xdc\xb7\xa9\xd4\xf8\x44\x1a\xaf\x2d\x9c\x61\xa9\xc9\x85\x46\x59\xf0\x9d\x6b\x83\x46\xac\xd2\xf5\x1c\x05\x60\x75\xe3\x68\x0b\xac\xd6\x0c\xfe\x82\x9f\xf6\xa5\x04\x21\x0d\x8e\x29\x03\xce\x62\xef\x20\x56\xe0\xbc\xf5\x9d\x6f\x73\x95\xfc\x56\xd6\x9c\x09\xf3\x7b\x92\x85\xc7\x2b\x7f\x8a\x97\x8b\x97\xfa\xab\xb0\xc5\xf1\xce\x3f\x84\xa1\xe6\x29\x5e\x56\x1e\x86\xab\x83\x30\x14\x5e\x23\x1b\xae\xb2\x40\x72\xa0\x48\xcd\x30\x46\x27\x36\x0e\x58\xa1\x5a\xaf\xa5\xaa\x46\x0a\x7b\x05\x43\x43\x16\xec\x4f\x4c\xbe\x15\x4c\xee\xd7\xd4\x4b\xa5\xec\xad\xfb\xed\xbc\x28\x20\x49\x26\xd8\xb5\xae\x8f\x10\x34\xb0\xcb\x0a\x5f\x5f\xc7\xed\xaa\xb2\x7b\x71\x48\x61\xab\xa4\x91\xa5\xac\x0b\x53\xb6\x8f\x65\x7a\x98\x8d\xff\x4f\xf6\xf3\x26\x3b\x1c\x1b\x50\x80\x69\x5a\x82\x42\xc7\x8a\x62\xff\x43\xc1\x3d\xff\xf4\x4c\xcf\x95\x5d\xa9\x37\x4e\x15\x1c\xec\xc7\xc5\xee\xfc\xf2\x53\xa0\xd7\x58\xf0\x52\xbf\x7d\xa0\xb3\xfa\x5f\x69\x43\x54\x27\x66\x4d\x95\xea\xdb\xba\x57\xf1\xc9\x23\xf3\x2d\x14\xab\x8f\x7b\x81\xc8\xd1\x07\x1c\x13\x38\x4d\xf4\xb3\x7a\x63\x18\x55\x95\x5c\x8b\xd0\x17\xec\x00\xe2\xbf\x26\x3c\x9c\x4a\xfd\xd1\x90\xf1\xff\x2a\xd1\x8f\xff\xba\x46\x0f\x9e\x56\xa9\xc9\x9c\x35\xf2\x8e\xa5\x4f\x7c\x40\xfa\x04\xa0\xcc\xcc\xfb\x37\xdb\x3f\x58\x39\x50\x75\xad\x81\x10\xd2\xbf\xc3\x43\xd4\xf6\xa0\x00\xda\xb6\x4c\x54\xe9\x6f\xbf\x3b\xc0\xfd\xbe\xf8\xde\x3a\x0a\x42\x08\x36\x60\x79\x40\xb7\x7b\x8b\x01\x2e\x2a\x03\xd9\xeb\x78\x35\xb9\x64\xeb\x39\xa3\x15\x53\xce\x53\x64\xd3\x4e\x52\x1f\x12\xe7\x7a\x5a\xb7\x7b\x72\xbc\x59\x80\xa3\x18\x36\xf1\x8e\xdd\xb4\x99\x1d\xeb\x81\xc7\xf3\x4e\x3c\x2c\x45\xa8\x9e\xfa\x67\x51\x75\x42\x70\x71\x7d\x9c\x0c\xd9\x74\xb1\x65\xbb\x70\x67\x3a\xd4\x58\x7b\xa7\x7b\x0a\x2c\xac\xf9\x53\xa5\x54\x29\x05\xb6\x6a\xea\x3f\x72\xd9\x27\x58\xaa\x6c\xba\x6b\xf7\x9a\x36\xb7\xf4\xb6\x63\x77\x3f\x1a\x45\x23\xc2\xe7\xec\xb6\x26\x9f\x5a\x26\xc6\xff\x86\x55\x8a\xdf\x31\x45\xec\x17\xbd\x93\x8e\xd7\xd5\x97\x8e\xa9\x8d\x0f\xa8\xff\x0a\xe1\x26\xe9\xee\xaf\xb3\x1f\xf8\xfd\x44\xcf\x61\x1c\xa7\x87\x74\xca\x98\x88\xfc\x41\x76\x76\x03\xd9\xc6\xff\x19\x00\x2f\xbf\x35\x64\x67\x14\x00\x00")
 
 func templates_testSingletonMysql_main_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +133,7 @@ func templates_testSingletonMysql_main_testGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonMysql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xc1\x0a\xc2\x30\x10\x44\xef\xfd\x8a\xa5\xe4\xd0\x4a\x9b\x0f\x10\x3c\x78\xd4\x83\x88\xb4\x1f\x10\xed\xb6\x04\xe2\x5a\xba\x5b\x10\x42\xfe\x5d\xd2\x46\xe9\xc1\xdb\x0c\x6f\x32\x99\xed\x67\x7a\x40\x83\x2c\xed\xc8\x38\x49\x21\xb0\x13\x64\xb1\x34\xe8\xa6\x04\x9f\x01\x78\x5f\xc3\x64\x68\x40\x50\x96\x3a\x7c\x57\xa0\xc4\xdc\x1d\xc2\xfe\x00\xba\x89\x8a\x43\x48\x39\xdb\x27\xa8\x4f\x7c\x7e\x59\x5a\x30\xd4\x3f\x8e\x8e\xb7\x56\x19\x67\x0d\xc7\x22\xa5\x8f\x51\x22\xaf\x8d\xdf\x96\x8b\x79\xe2\x92\x16\x7d\x9b\xa9\xc8\xbd\x5f\x9f\xe8\x76\xbc\xba\x79\x32\x2e\x84\xbc\x82\x38\xf8\x0f\x59\x2f\x2a\x97\xbf\x90\xba\xed\x8c\xe4\x42\xf6\x09\x00\x00\xff\xff\x11\x5d\x4c\xce\xff\x00\x00\x00")
+var _templates_testSingletonMysql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x4f\xcd\x8a\x83\x30\x10\xbe\xfb\x14\x83\xe4\xa0\x8b\xe6\x01\x16\xf6\xb0\xc7\xdd\x43\x5b\x44\x1f\x20\x6d\x46\x09\xa4\xa3\x64\x22\xb4\x84\xbc\x7b\x89\x4a\x6b\xa1\xb7\x6f\xe6\xfb\x99\xf9\xfa\x99\x2e\xd0\x22\xfb\x6e\x62\x74\xbe\xf0\xf0\xe5\x91\xbd\xa1\x41\xb6\x25\x84\x0c\x20\x84\x1a\x9c\xa2\x01\x41\x18\xd2\x78\xab\x40\x78\x75\xb6\x08\xdf\x3f\x20\xdb\x84\x38\xc6\x4d\x67\x7a\x18\xdd\xc6\xcb\x3f\xfe\x1f\x0d\x2d\x8a\xd7\xaa\x41\xa5\x8f\x64\xef\x50\x3f\x4d\x68\x19\x77\xa3\x50\xd6\x28\x4e\xe9\x42\xfe\x26\x88\x2c\xdf\x42\x0e\xea\x8a\x8b\xda\xcb\x66\xa6\x22\x0f\x61\xb5\xc8\x6e\x3a\xd9\xd9\x29\x1b\x63\x5e\x41\x6a\xf1\x81\x59\x6b\x96\xcb\x2d\x24\xbd\x7f\x83\x34\xd4\x31\x66\x31\x7b\x0c\x00\x0a\x7c\x0a\x7b\x14\x01\x00\x00")
 
 func templates_testSingletonMysql_suites_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,11 +149,11 @@ func templates_testSingletonMysql_suites_testGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/singleton/mysql_suites_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x10, 0xc4, 0x71, 0xaf, 0xd9, 0x16, 0x41, 0x8b, 0x4b, 0xfc, 0xe8, 0xba, 0xfd, 0xfa, 0x4d, 0x2c, 0x1, 0xd1, 0x0, 0xe1, 0xb0, 0x78, 0xee, 0x7f, 0xd0, 0x65, 0xf3, 0xa1, 0x43, 0xba, 0x3c, 0xe7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb8, 0xd5, 0xdd, 0x3d, 0xf2, 0xc9, 0x19, 0x38, 0xaa, 0x3c, 0xe9, 0x15, 0xb7, 0xe4, 0x2c, 0x39, 0x35, 0xc8, 0x8f, 0x99, 0x15, 0x97, 0xba, 0xe6, 0x9a, 0xfd, 0x6f, 0xac, 0xac, 0xc8, 0x6d, 0x8a}}
 	return a, nil
 }
 
-var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x6f\xdb\x38\x10\x3d\x8b\xbf\x62\xd6\xd8\x5d\x50\x0b\x85\xd9\x5e\x53\xf8\xe0\x7c\x1c\x82\xb6\x86\x1b\xcb\xe7\x82\x91\x46\x0e\x61\x9a\x54\xc9\x51\x6d\x57\xe0\x7f\x2f\x28\xd9\x8e\x13\x3b\x6d\x0e\xed\x21\x07\x7f\x70\xf0\x66\xde\x7b\x33\x1c\xb6\xed\x19\xfc\x2d\xb5\x92\x1e\x2e\x86\x20\x46\xf1\x1f\x7a\x91\xcb\x7b\x8d\xd0\xff\x88\xb1\x5c\x62\x08\xac\x6a\x4c\x01\x84\x9e\xda\xb6\xcf\x10\xb3\x7a\xa2\x1b\x27\x75\x08\xb3\xda\xa3\x23\x4e\xf0\x5f\x04\x28\x33\x17\x79\x0a\x2d\x4b\x48\x4c\xa4\x93\x5a\xa3\xe6\x29\x63\x89\xaa\x40\xa3\xe1\xfb\x02\xd7\x76\x65\xa6\xca\xcc\x1b\x2d\x5d\x08\x23\xad\xaf\xac\x6e\x96\xc6\xa7\x30\x1c\xfe\x0c\x39\x71\x6a\x29\xdd\xe6\x03\x6e\xf6\x09\x2d\x4b\x12\x12\xd3\x85\xaa\xf9\x20\x7e\xd7\xca\xcc\x81\x3a\x1b\x2b\x45\x0f\x60\x8d\xde\x40\xdd\xe7\xc1\x02\x37\x50\xf4\x99\x83\x94\x25\x61\xaf\x6c\xb9\x99\x7e\xfe\x78\xe0\xef\x91\x72\x66\xd4\xd7\x06\x0f\xf5\xfd\xff\x4b\x4e\x63\xa1\xe9\xd2\x76\x64\x40\x16\x0a\x6b\x2a\xad\x0a\x02\x6b\x7a\x6e\x96\x78\xc4\x32\xb6\xdf\x49\x53\xda\xa5\xfa\x8e\x62\x8c\xab\x29\x62\xc9\x53\x96\x7c\x93\x0e\xd0\x75\x1f\xeb\x58\x72\x7e\x0e\x23\x22\x5c\xd6\x04\xf4\x80\x70\x3b\x9e\xde\xdc\xe5\xe0\x55\x89\x60\x2b\x90\x06\x66\x93\x18\x61\x89\x8d\x15\x4f\x5a\x69\x7b\xbf\xb1\xe8\x21\xe7\x94\x5c\x53\x10\x8f\x62\x32\xf8\xd7\x66\xf0\x42\xf3\xaf\x2f\xf3\x4d\x8d\x3e\x83\x4a\x6a\x8f\xe9\xfb\xae\xd0\x5f\x43\x30\x4a\x6f\x3b\x72\x13\xa5\x56\x7c\x30\x33\x5d\x2f\xc8\x3e\xb2\x9c\x56\x04\xbe\xe3\xbe\x80\x7f\xfc\x20\x8b\xf5\xb6\x8d\x69\x5b\x55\x81\xb1\x04\x62\x6c\xaf\xac\x21\x5c\x53\x08\x05\xad\xa3\xb5\xa2\x3f\x8b\x4b\x59\x2c\xe6\xce\x36\xa6\xe4\x69\xdb\xa2\x29\x43\x60\x49\x0f\xf9\xd4\x78\xca\xd7\xbc\xab\x72\x58\xe1\x28\x70\x6f\x95\x16\x97\x38\x57\xa6\xab\xa1\x3d\x1e\xc6\xf2\x35\x2f\x68\x9d\x45\x83\x3b\x86\x57\x81\x52\x96\x94\x58\xa1\x83\xb8\x39\x3c\x85\x16\xbe\xc0\x10\x68\x2d\xee\xac\xd6\xf7\xb2\x58\xf0\x14\x42\x1c\xf1\x7e\x18\x56\x6c\x17\xe9\x25\xe3\x71\x28\x68\x4a\x38\x0b\x01\xe2\xa9\xe3\xbf\x35\x15\x3a\x9e\x3e\x3d\xbd\x6e\x2e\x4d\x47\x77\x7a\x28\x47\xd3\x28\x6c\x63\xa8\x0b\x3c\xbb\x5a\xbb\x57\x80\xa7\xe2\x2a\x62\x5e\x29\xff\xd1\xf9\xb1\x4a\xbe\xa3\x8d\x90\x8e\x38\x82\xde\x3d\x81\x0c\x56\xd2\xc4\x35\x42\x70\x58\x58\x57\x66\x30\xb7\x74\x31\xc8\x7a\xfc\x56\xf4\xb3\x7d\x99\x4d\xae\x47\xf9\xcd\xa9\x7d\xf9\x6d\x1b\xf1\x22\xec\xe8\xd5\x12\x42\xfc\xd1\xf5\x79\x7b\xf7\xea\x8d\x5c\xab\xc0\x7e\x04\x00\x00\xff\xff\x63\xfd\x7b\x9f\x38\x07\x00\x00")
+var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\xcd\x6e\xdb\x3c\x10\x3c\x4b\x4f\xb1\x9f\xf1\xb5\xa0\x0a\x85\x69\xaf\x29\x7c\x70\x7e\x0e\x41\x5b\xc3\x8d\xa5\x73\xc1\x48\x2b\x87\x30\x4d\xaa\xe4\xaa\xb6\x2b\xf0\xdd\x0b\x4a\xb6\xe3\xc4\x4e\xeb\x43\x7b\xc8\x41\x3f\x24\x66\x77\x66\x77\x39\x6c\xdb\x33\xf8\x5f\x28\x29\x1c\x5c\x0c\x81\x8f\xc2\x1f\x3a\x9e\x89\x7b\x85\xd0\x7f\xf8\x58\x2c\xd0\xfb\xb8\x6a\x74\x01\x84\x8e\xda\xb6\x8f\xe0\x79\x3d\x51\x8d\x15\xca\xfb\xbc\x76\x68\x89\x11\xbc\x0b\x00\xa9\x67\x3c\x4b\xa0\x8d\x23\xe2\x13\x61\x85\x52\xa8\x58\x12\xc7\x91\xac\x40\xa1\x66\xbb\x04\xd7\x66\xa9\xa7\x52\xcf\x1a\x25\xac\xf7\x23\xa5\xae\x8c\x6a\x16\xda\x25\x30\x1c\xfe\x0e\x39\xb1\x72\x21\xec\xfa\x13\xae\x77\x01\x6d\x1c\x45\xc4\xa7\x73\x59\xb3\x41\x78\xd7\x52\xcf\x80\x82\x7e\x58\x4a\x7a\x00\xa3\xd5\x1a\xea\x3e\x0e\xe6\xb8\x86\xa2\x8f\x1c\x24\x71\xe4\x77\xca\x16\xeb\xe9\xd7\xcf\x3b\xd2\xbc\x7e\xa4\xcc\xb5\xfc\xde\xe0\xbe\xbe\xf7\x7f\xe4\xd4\x06\x9a\x2e\x6c\x4b\x06\x64\xa0\x30\xba\x52\xb2\x20\x30\xba\xe7\x8e\x23\x87\x58\x86\xf6\x5b\xa1\x4b\xb3\x90\x3f\x91\x8f\x71\x39\x45\x2c\x59\x12\x47\x3f\x84\x05\xb4\xdd\x63\x6c\x1c\x9d\x9f\xc3\x88\x08\x17\x35\x01\x3d\x20\xdc\x8e\xa7\x37\x77\x19\x38\x59\x22\x98\x0a\x84\x86\x7c\x12\x76\xe2\xc8\x84\x8c\x47\x4b\x69\xfb\x7a\x43\xd2\x3d\xce\x29\xd9\xa6\x20\x16\xb4\xa4\xf0\xd6\xa4\xf0\x42\xef\xaf\x2f\xb3\x75\x8d\x2e\x85\x4a\x28\x87\xc9\xc7\x20\x0c\xfe\x1b\x82\x96\x6a\xd3\x90\x1b\x6b\x8d\xad\xd8\x20\xd7\x5d\xfb\xc9\x3c\x92\x1c\x17\x04\xae\xe3\xbe\x80\x37\x6e\x90\x86\x7c\x9b\xbe\xb4\xad\xac\x40\x1b\x02\x3e\x36\x57\x46\x13\xae\xc8\xfb\x82\x56\xa1\xb2\xa2\x5f\xf3\x4b\x51\xcc\x67\xd6\x34\xba\x64\x49\xdb\xa2\x2e\xbd\x8f\xa3\x1e\xf2\xa5\x71\x94\xad\x58\x97\x65\x3f\xc3\xc1\xc6\xbd\x91\x8a\x5f\xe2\x4c\xea\x2e\x87\x72\xb8\xbf\x97\xad\x58\x41\xab\x34\x14\xb8\x65\x38\x09\x94\xc4\x51\x89\x15\x5a\x08\xc6\x61\x09\xb4\xf0\x0d\x86\x40\x2b\x7e\x67\x94\xba\x17\xc5\x9c\x25\xe0\x59\xb2\x37\x0b\xc3\x37\x3e\x7a\xa9\xf0\x30\x14\xd4\x25\x9c\x79\x0f\x61\xd5\xf1\xdf\xea\x0a\x2d\x4b\x9e\xae\x4e\x9b\x4b\xd3\xd1\x1d\x1f\xca\xc1\x34\x0a\xd3\x68\xea\xc6\xf3\xec\x64\x6d\x2f\x01\x96\xf0\xab\x80\x39\x51\xfe\x63\xe5\x87\x2a\xd9\x96\x36\x40\x3a\xe2\x50\xca\x87\x27\x90\xc1\x52\xe8\xe0\x22\x04\x8b\x85\xb1\x65\x0a\x33\x43\x17\x83\xb4\xc7\x6f\x44\x3f\xb3\x4b\x3e\xb9\x1e\x65\x37\xc7\xec\xf2\xb7\x0c\x91\xc2\xa9\x77\x16\xe7\xfc\x9f\xba\xe7\xf5\x1d\xab\x57\x72\xaa\x7c\xfc\x6b\x00\x97\x19\xa8\xa3\x36\x07\x00\x00")
 
 func templates_testUpsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +169,7 @@ func templates_testUpsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1f, 0xec, 0xa5, 0xcb, 0xb0, 0x71, 0x0, 0x4c, 0x49, 0xa2, 0xc9, 0x7f, 0x22, 0x1e, 0x72, 0x79, 0x7e, 0xfb, 0x4c, 0xfc, 0xf6, 0xab, 0x29, 0xd3, 0xef, 0x4b, 0xb4, 0x26, 0x7d, 0xd4, 0x7b, 0xfc}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0x25, 0xfa, 0xc4, 0x8c, 0x8b, 0xa0, 0x49, 0x47, 0x23, 0x5b, 0x15, 0x30, 0xfb, 0xf8, 0x62, 0x53, 0x85, 0x18, 0xfd, 0xb, 0x1, 0x3c, 0xac, 0xb0, 0x1c, 0x9d, 0x74, 0x18, 0x82, 0x69, 0x6c}}
 	return a, nil
 }
 
@@ -271,6 +271,9 @@ var _bindata = map[string]func() (*asset, error){
 	"templates_test/upsert.go.tpl":                      templates_testUpsertGoTpl,
 }
 
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
+
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
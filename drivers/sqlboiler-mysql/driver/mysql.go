@@ -70,6 +70,10 @@ func (m *MySQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 	schema := dbname
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+	assembleOpts, err := drivers.AssembleOptionsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
 
 	tinyIntAsIntIntf, ok := config["tinyint_as_int"]
 	if ok {
@@ -85,27 +89,32 @@ func (m *MySQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 	}
 
 	defer func() {
-		if e := m.conn.Close(); e != nil {
+		if e := drivers.CloseConn(m.conn, err); e != nil {
 			dbinfo = nil
 			err = e
 		}
 	}()
 
 	dbinfo = &drivers.DBInfo{
+		SchemaQuery: "SELECT table_name, column_name, is_nullable FROM information_schema.columns WHERE table_schema = DATABASE()",
 		Dialect: drivers.Dialect{
 			LQ: '`',
 			RQ: '`',
 
 			UseLastInsertID: true,
 			UseSchema:       false,
+			MaxParams:       65535,
+			UseForUpdate:    true,
 		},
 	}
 
-	dbinfo.Tables, err = drivers.Tables(m, schema, whitelist, blacklist)
+	dbinfo.Tables, err = drivers.TablesWithOptions(m, schema, whitelist, blacklist, assembleOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	drivers.ApplyConfig(config, dbinfo.Tables)
+
 	return dbinfo, err
 }
 
@@ -356,7 +365,8 @@ func (m *MySQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreig
 // "varchar" to "string" and "bigint" to "int64". It returns this parsed data
 // as a Column object.
 func (m *MySQLDriver) TranslateColumnType(c drivers.Column) drivers.Column {
-	unsigned := strings.Contains(c.FullDBType, "unsigned")
+	unsigned := c.Unsigned || strings.Contains(c.FullDBType, "unsigned")
+	c.Unsigned = unsigned
 	if c.Nullable {
 		switch c.DBType {
 		case "tinyint":
@@ -468,12 +478,6 @@ func (m *MySQLDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 
 // Imports returns important imports for the driver
 func (MySQLDriver) Imports() (col importers.Collection, err error) {
-	col.All = importers.Set{
-		Standard: importers.List{
-			`"strconv"`,
-		},
-	}
-
 	col.Singleton = importers.Map{
 		"mysql_upsert": {
 			Standard: importers.List{
@@ -581,6 +585,12 @@ func (MySQLDriver) Imports() (col importers.Collection, err error) {
 		"types.NullDecimal": {
 			ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
 		},
+		"types.Money": {
+			ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
+		"types.NullMoney": {
+			ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
 	}
 	return col, err
 }
@@ -1,3 +1,4 @@
+{{- if not .Table.IsReadOnly -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -40,6 +41,10 @@ var mySQL{{$alias.UpSingular}}UniqueColumns = []string{
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Upsert")
+
+	{{end -}}
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
 	}
@@ -61,15 +66,9 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 
 	// Build cache key in-line uglily - mysql vs psql problems
 	buf := strmangle.GetBuffer()
-	buf.WriteString(strconv.Itoa(updateColumns.Kind))
-	for _, c := range updateColumns.Cols {
-		buf.WriteString(c)
-	}
+	buf.WriteString(makeCacheKey(updateColumns, nil))
 	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(insertColumns.Kind))
-	for _, c := range insertColumns.Cols {
-		buf.WriteString(c)
-	}
+	buf.WriteString(makeCacheKey(insertColumns, nil))
 	buf.WriteByte('.')
 	for _, c := range nzDefaults {
 		buf.WriteString(c)
@@ -148,13 +147,13 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 	result, err := exec.Exec(cache.query, vals...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
+	result, err := boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, vals...)
+	_, err = boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	{{- end}}
 	if err != nil {
@@ -208,7 +207,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	{{if .NoContext -}}
 	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
 	{{else -}}
-	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	err = boil.QueryRowContext(ctx, exec, cache.retQuery, nzUniqueCols...).Scan(returns...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
@@ -227,3 +226,4 @@ CacheNoHooks:
 	return nil
 	{{- end}}
 }
+{{end -}}
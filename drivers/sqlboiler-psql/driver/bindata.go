@@ -1,10 +1,10 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// override/templates/17_upsert.go.tpl (5.763kB)
+// override/templates/17_upsert.go.tpl (5.791kB)
 // override/templates/singleton/psql_upsert.go.tpl (1.317kB)
 // override/templates_test/singleton/psql_main_test.go.tpl (4.974kB)
-// override/templates_test/singleton/psql_suites_test.go.tpl (255B)
-// override/templates_test/upsert.go.tpl (1.746kB)
+// override/templates_test/singleton/psql_suites_test.go.tpl (276B)
+// override/templates_test/upsert.go.tpl (1.744kB)
 
 package driver
 
@@ -24,7 +24,7 @@ import (
 func bindataRead(data []byte, name string) ([]byte, error) {
 	gz, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 
 	var buf bytes.Buffer
@@ -32,7 +32,7 @@ func bindataRead(data []byte, name string) ([]byte, error) {
 	clErr := gz.Close()
 
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 	if clErr != nil {
 		return nil, err
@@ -73,7 +73,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x5f\x73\xe3\xb8\x0d\x7f\x96\x3e\x05\x2e\xd3\xb9\x48\x1d\x47\xe9\x73\x3a\x7e\xc8\x9f\xbd\x6d\xe6\xba\x39\x77\x73\xe9\xce\xf4\xe6\x26\x23\x4b\x90\xcd\x09\x4d\x6a\x29\x2a\x8e\xab\xea\xbb\x77\x00\x51\x96\x64\xcb\x89\x77\x7b\xd7\x6e\x9f\x62\x91\x20\xf0\x23\xf0\x03\x01\xa4\xaa\xce\xe0\x0f\xb1\x14\x71\x01\x17\x53\x88\x2e\xe9\x17\x16\xd1\xcf\xf1\x5c\x22\x34\x7f\xa2\xbb\x78\x85\x75\xed\xb3\x68\x91\x2c\x71\x15\x37\xdb\x74\xa0\x93\x80\x7f\x41\x74\xdf\xed\xf2\x01\x91\x41\x74\x99\xa6\xef\xa5\x9e\xc7\x12\xce\xea\xda\x3f\x3f\x87\x87\xbc\x40\x63\xdf\x43\x6c\x2d\xae\x72\x5b\x40\xac\x40\x28\x5a\x9b\x40\xac\x52\x48\x35\xf2\x5a\x99\xa7\xb1\x45\xd0\x06\xc4\x42\x69\x83\xa0\x15\x24\x5a\x65\x52\x24\x36\xf2\xb3\x52\x25\x10\x68\xf8\x63\x55\x35\xf8\xa3\x87\xfc\x5e\xa8\x45\x29\x63\x53\xd7\x61\x6b\x25\x60\x10\x4a\x5b\x88\xee\xf4\xb5\x56\x16\x5f\x6c\x5d\x27\xf6\x85\x54\xd1\x47\xe4\x16\x27\x50\x55\xa8\x52\x02\xe9\x2c\xff\xa4\xae\x9d\x35\x98\x6b\x2d\x27\x5b\xe3\xd7\x5a\x96\x2b\x55\xc0\x2f\xbf\x16\xd6\x08\xb5\x98\xb8\x03\x6e\x7d\xe2\x6e\xd3\x8a\xcd\xb5\x90\x91\xfb\x08\x01\x8d\xd1\x06\x2a\xdf\x33\x68\x4b\xa3\x40\x47\x0d\xd2\x06\x68\x1f\x24\x9f\x7b\x8f\xf6\xe6\x2a\x08\xab\x0a\x65\x81\x0c\x7c\x02\xed\x86\x93\x74\xfb\x2a\xad\xeb\xc9\x1e\xf4\x3d\xd4\xaf\x83\x0d\xfd\xda\xf7\xb7\x8e\xf0\x9b\x10\x52\x50\x7a\x61\xa4\x9f\xb3\x58\x89\x64\x27\xa0\xb3\xff\x2c\xa2\xc0\x3a\x0b\x5a\x63\x1f\x1d\x1d\xe2\xd9\x37\x17\xe3\xca\xf7\x44\x46\xb7\xa0\x14\xf9\xc6\x02\xfc\x67\xc6\xf5\xdd\x14\x94\x90\x04\xd4\xcb\xc9\xed\x01\x9b\xfc\x64\xe2\xfc\x9d\x31\x01\x1a\x13\x86\xbe\x57\x8f\x91\xe1\x40\xf4\xc7\x82\x0f\x65\x21\xd4\x82\xbe\xf1\x05\x93\xd2\x6a\xf3\x25\x09\xde\x53\x9d\x7f\x1d\x33\x66\xfb\x2e\x27\x20\x8d\x7b\xdf\x39\x48\x3d\xc7\xef\xd3\xa5\x13\x77\x4b\xbd\x53\xe3\xe1\xf8\x2f\xd1\x68\x84\xec\x7d\x72\x13\xee\xff\x29\x55\xb6\xc1\xfb\x3d\x68\x71\x8f\x38\xf0\x14\xa4\x3a\x29\x57\xa8\x6c\x6c\x85\x56\x90\x69\x03\x4b\xbd\x06\xab\x21\x37\x3a\x47\x23\x37\x50\x16\x38\xbc\x2b\x5b\x1c\x5c\xf7\x58\x56\xfd\x9f\x93\x6a\x5b\x7f\x44\x06\x1a\xa6\x5d\x70\x5d\x3d\xe2\xfd\x22\xba\xc3\x75\x70\x52\x55\xd1\xec\x69\xd1\x94\xff\x0b\x50\x1a\xaa\x6a\xd0\x12\x90\x7f\x9f\x45\x8a\x29\xfb\xbc\x64\xf7\x9c\x30\x1b\x7c\x8f\xba\x05\x8a\xbc\xa4\x58\x9e\x58\xb1\xc2\xc2\xc6\xab\xfc\xb1\x91\x7a\x5c\xa2\xcc\xd1\x9c\x40\x04\x75\x23\xdd\x91\xfa\x2f\x5a\x3f\x15\x4c\xa3\x01\xfd\x53\x7d\x85\x99\x36\xd8\x44\x81\x85\x8e\xce\x85\x7d\x2a\x77\xb7\x25\xb8\x8c\x96\x9d\xef\xfb\x9e\xfa\xe7\x0d\x66\x71\x29\x2d\xb7\x44\x9f\x4b\x34\x02\x8b\xe8\x4e\xab\x7f\xa0\xd1\x6e\xeb\x1e\x89\x07\x8e\x25\x37\x7a\xad\x3a\x9e\x38\x4f\x7f\x12\x76\xe9\x84\x27\xa0\x43\xdf\xf7\xce\xcf\xe1\xaa\x14\x32\x85\x24\x4e\x96\x08\x4f\xb8\x01\xa1\xce\xa4\x50\x08\xe5\x42\x0a\xb9\x81\x33\x58\x6d\x8a\xcf\x12\x9e\x0b\xc8\xe9\x6f\x6e\xf4\x5c\xe2\xaa\xf0\xbd\x79\x99\x11\x98\xc2\x9a\x55\xac\x16\x12\xa9\x3a\x5c\x95\x59\x86\x26\x08\xd9\x4d\x7b\x94\xa1\x4b\xce\xcb\x2c\xfa\x64\x84\xc5\xab\x8d\xc5\xe0\xd4\x9e\x52\x6c\x80\xa8\x39\xb6\x9d\xf1\xb6\xbf\xbb\x1c\xd1\x32\xc5\xf7\x71\x02\x09\x81\x30\xb1\x5a\xe0\x1e\x19\x07\x0a\xef\x99\x97\x41\x72\x58\xe1\xae\x68\x61\x4d\xa2\xd5\x73\x74\x6b\x75\x1c\x0c\xe8\x1c\xfd\x28\x54\x1a\x8e\x62\x18\xca\x5d\x6b\xf9\xdb\xc2\x18\x3e\x0f\x87\x61\x0c\xe5\xbe\x06\xc6\xbe\xce\x1e\x09\x5f\xd1\x45\x1c\xba\x98\x02\xed\xba\x8d\xd0\xf7\x3a\x92\xcc\xca\x96\x24\xf3\x32\x0b\x39\xcd\x46\x29\xdb\xa4\xd4\x35\xd1\xf2\x43\x69\xa3\x8f\x7f\xd5\xc9\x13\x69\x62\xa2\x4e\x1a\xbe\xa6\x64\xe8\xed\xf3\xbf\x3c\xe1\xe6\xd7\xa3\x0d\x3d\x28\xd9\x98\xf2\xbd\xe7\xd8\x70\x8e\xf2\xfb\xe3\x33\xa7\xbf\x73\x86\xc9\x01\x6d\x3b\x69\xd0\x12\x90\xa1\xcb\x6f\x7b\x5f\x94\x99\xbe\xe7\x1d\x42\x70\x29\x65\xfb\x4c\xbe\x22\x35\x92\xc3\xc7\x49\xeb\xd2\xf6\x0f\x74\x51\xa4\xcf\xd0\xf7\x3c\x57\xdc\x2e\xa6\x3b\xe4\x7d\xe8\x7d\xfd\x26\x57\x98\x19\xb1\x8a\xcd\xe6\x47\xdc\xf4\x84\xc9\xd1\xa3\xaf\xc5\xf7\xdf\x83\x44\xe5\x12\x2f\xa4\xb2\xf0\x27\x76\xfb\xdb\x55\xa1\x54\x3c\x0b\x5a\xed\xde\xff\xdd\x1a\x41\x65\xab\x94\x29\xbf\xd2\x73\x7e\xfe\x9c\x0b\x12\x86\x05\x52\x14\x5c\x33\xb8\x68\x78\xed\xab\x42\x0e\xda\x79\x61\x1a\xe4\x84\xb2\xdd\xe8\xe3\xdc\x1e\x9c\xc2\x2a\x7e\xc2\xa0\xab\x8d\x74\xe2\x58\x1f\x51\x7e\x93\xae\x7c\xb3\x35\x32\x39\x44\xfa\xfd\xc3\x7c\x09\xaf\xc9\x9a\x88\xea\xc6\x06\xa6\xcd\x9d\x1b\xde\xff\x8d\x96\x66\xba\xb0\x0b\x83\x45\x90\x8a\x58\x22\xe9\x3f\xa9\xaa\xfe\x58\x5d\xd7\x27\x63\xad\x9b\x41\xdb\x2e\x77\x9d\x40\x5b\xea\x39\xae\x8d\xdd\xe7\x58\x96\xf8\x21\xce\x73\xbe\x3c\x65\x54\x57\xc3\xae\x84\x4a\xdd\xd6\x21\x97\xfc\xbc\xc9\xf1\xe0\x95\xb7\x6a\x5b\xab\x5e\x5b\xa1\x7b\x95\x75\x50\x5a\xd9\x21\x2e\x6c\x06\x6d\x48\x82\x6d\xc4\x18\xae\x41\xfb\x7b\x83\x25\xbb\x64\x70\x04\xea\x10\x2b\x83\xad\x9b\xf6\x85\xdd\xc8\xcf\x31\x66\x14\xa6\xe8\x56\xa5\xc2\x60\x62\x83\x76\xe1\xef\x24\xf1\x53\x16\x68\x22\xcd\x73\x2c\x07\xdd\x02\x6f\x16\x3f\x18\xbd\x6a\xaf\xc0\x0a\xdd\x5b\x3a\x08\x52\xd8\xbc\x7d\x0d\x12\x6a\xea\x84\xb2\x68\xb2\x38\xc1\xaa\xe9\x80\x98\xf2\x3b\xce\xea\x39\xb2\x3d\xd8\x19\x9f\x59\x73\xd8\x74\x4f\x47\xdb\xa8\x0d\xda\xd9\x6d\xe3\xc5\x6d\xe3\x0d\xce\xcb\xc5\x07\x9d\x36\x0d\x43\xb6\xb2\xd1\x0f\xb9\x11\xca\x4a\x15\x74\xfb\x5c\x98\x4c\x6b\x80\x89\x1f\xbe\x2d\x4d\x2e\x0b\x5d\xf3\xc5\x2d\xc9\xc0\xf0\x6d\xc1\xc2\x41\x62\x5f\x78\x10\xf2\xd6\x7c\x8c\xab\xdd\x8e\x2a\xba\x2a\xcb\xed\xda\x5c\x1f\x81\x6b\x3d\x86\xa6\x9d\x62\x8e\xf0\xfe\xa8\xf7\xbc\x86\xc9\xd4\x79\x46\x9c\xf4\x1f\xf5\x3a\xe8\xa1\x68\xcc\x45\x51\x14\x46\xf7\x49\xcc\x99\x41\x21\xa4\x05\x56\xd9\xb9\x63\x4c\x93\x33\x15\x70\x9f\xfb\x25\x5a\xdd\xb5\xb6\x99\x30\x9d\x42\xf1\x59\x46\xef\x8c\xb9\xd3\x1f\xf5\xba\xe9\x34\x9c\x45\x4a\x91\xf3\x73\x68\x5f\x2b\x1e\xce\xd4\xa9\x75\x34\x85\x58\x6d\xec\x92\xa6\xb8\xf5\x12\x15\xd8\x25\x1a\x3c\x2d\x68\x42\x68\x5e\x28\x97\x47\x5d\xab\x39\xee\xa6\xc7\x36\xe7\xf9\x7e\x34\x06\x8d\x7b\x69\xd7\x29\xfb\xe7\xde\xf6\xc9\xd0\x05\xdd\x6c\x31\x3a\x13\x50\xad\xa3\x09\x97\xc6\x5b\x7e\xa0\xbf\xa4\xe2\xb5\x13\xd0\x4e\x07\x73\x5c\x4b\xd4\xb6\x5e\x47\x88\x73\xab\x05\xd3\xe6\xba\x47\x1b\xd8\xb6\x5c\xde\x2b\x73\xd7\xf6\x7f\x93\xa9\xbe\xcc\x2c\x9a\xaf\x9a\xb9\xdc\x54\xb5\x0d\x9b\x53\xaa\x84\xec\xcf\x5b\xb5\xff\xef\x00\x00\x00\xff\xff\xfb\x0c\xc0\xc0\x83\x16\x00\x00")
+var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xdf\x6f\xdb\xb6\x13\x7f\x96\xfe\x8a\xab\xf1\x45\x23\x7d\xa1\x28\x7b\xce\xe0\x87\xfc\x68\xbb\xa0\x6b\xe2\x25\xcd\x0a\xac\x28\x02\x59\x3a\xd9\x44\x68\x52\xa5\xa8\xb8\x9e\xa6\xff\x7d\x38\x8a\xb4\x24\xdb\x49\xdd\x6e\xdd\xba\x87\xa2\x16\x79\xe4\x7d\xee\x3e\xf7\x8b\xa9\xeb\x43\x60\x39\x08\xa9\x21\x7e\x9b\x4c\x39\xc6\x17\xe5\x35\x26\xd9\x95\xe0\x2b\x38\x6c\x1a\x9f\x04\xfe\x97\x70\x96\x94\x70\x3c\x86\xf8\x84\x7e\x61\xd9\xca\xba\x23\x97\xc9\x02\x9d\x68\x99\xce\x71\x91\x98\x75\x73\xa0\x93\x80\x3f\x20\xbe\xe9\x76\xcd\x01\x96\x43\x7c\x92\x65\xaf\xb8\x9c\x26\xdc\xe8\x3b\x3a\x82\xdb\xa2\x44\xa5\x5f\x41\xa2\x35\x2e\x0a\x5d\x42\x22\x80\x09\x5a\x8b\x20\x11\x19\x64\x12\xcd\x5a\x55\x64\x89\x46\x90\x0a\xd8\x4c\x48\x85\x20\x05\xa4\x52\xe4\x9c\xa5\x3a\xf6\xf3\x4a\xa4\x10\x48\xf8\x7f\x5d\xb7\xf8\xe3\xdb\xe2\x86\x89\x59\xc5\x13\xd5\x34\xa1\xd3\x12\xd4\xb5\xb3\xff\x52\x9e\x49\xa1\xf1\x93\x6e\x9a\x54\x7f\xa2\xab\xe8\x23\xb6\x8b\x11\xd4\x35\x8a\x8c\x40\x5a\xcd\x57\xe2\xcc\x6a\x83\xa9\x94\x3c\x5a\x2b\x3f\x93\xbc\x5a\x88\x12\xde\x7f\x28\xb5\x62\x62\x16\xd9\x03\x76\x3d\xb2\xd6\x38\xb1\xa9\x64\x3c\xb6\x1f\x21\xa0\x52\x52\x41\xed\x7b\x0a\x75\xa5\x04\xc8\xb8\x45\xda\x02\xed\x83\x34\xe7\x5e\xa1\x3e\x3f\x0d\xc2\xba\x46\x5e\xa2\x01\x1e\x81\xdb\xb0\x92\x76\x5f\x64\x4d\x13\x6d\x41\xdf\x42\xfd\x34\xd8\xd0\x6f\x7c\x7f\xed\x08\xfa\xc9\x72\x43\x4a\x8f\x46\xfa\x39\x49\x04\x4b\x37\x08\x9d\xfc\x35\x46\xc1\xdc\x59\x12\xcb\xc6\x47\x7b\x53\x3c\xf9\xee\x38\xae\x7d\x8f\xe5\xc4\x34\xa5\xc8\x77\x46\xf0\x8f\x06\xd7\xb3\x31\x08\xc6\x29\x0c\xbd\x82\xdc\x1e\x18\x2c\xef\x54\x52\xbc\x50\x2a\x40\xa5\xc2\xd0\xf7\x9a\x5d\xc1\xf0\x08\xfb\xbb\xc8\x87\xaa\x64\x62\x46\xdf\xf8\x09\xd3\x4a\x4b\xf5\x25\x09\xde\xbb\xba\xf8\xba\xc8\x98\x6c\xbb\x9c\x80\xb4\xee\x7d\x61\x21\xf5\x1c\xbf\x1d\x2e\x9d\xb8\x5d\xea\x9d\xda\x4d\xc7\x3f\x14\x46\x3b\x82\xbd\x1f\xdc\x84\xfb\x5f\x0d\x95\x35\x79\xdf\x22\x2c\x6e\x10\x07\x9e\x82\x4c\xa6\xd5\x02\x85\x4e\x34\x93\x02\x72\xa9\x60\x2e\x97\xa0\x25\x14\x4a\x16\xa8\xf8\x0a\xaa\x12\x87\xb6\x1a\x8d\x03\x73\xf7\x8d\xaa\xff\x78\x50\xad\xfb\xcf\x8e\x38\xa2\xc2\xe8\x7b\x04\x7a\xdc\x9e\x7a\xc7\xf4\xfc\x97\x0a\xd5\xea\xaa\x08\x4c\x88\x8d\x76\xba\x66\x14\xc1\xa8\x75\xce\x28\xf4\x7d\xaf\x8b\x03\x8a\x5f\x09\xe3\x2e\x82\x6c\xd3\x33\x20\xca\xf8\x12\x97\xc1\xa8\xae\xe3\xc9\xfd\x8c\x26\x88\xa6\x39\x06\x21\xa1\xae\x07\x73\x07\x91\xf8\xc0\x32\xcc\x0c\xb1\x95\x53\xe3\x35\x46\xd3\x21\x50\xd5\xe1\x14\x30\x23\xcd\x16\x58\xea\x64\x51\xdc\xb5\x52\x77\x73\xe4\x05\xaa\x11\xc4\xd0\x34\xfe\xd0\xe2\x9f\xa4\xbc\x2f\xd7\x18\xd7\x39\x96\xc9\x53\xcc\xa5\xc2\xd6\x1a\x23\xb4\x77\xc2\x6d\xe7\x4b\x67\x2d\x15\x53\x83\xd6\x30\xec\xfb\x9e\xf8\xfd\x1c\xf3\xa4\xe2\xda\xcc\x5d\x1f\x2b\x54\x0c\xcb\xf8\x52\x8a\xdf\x50\x49\xbb\x75\x83\x3a\x58\xfb\xfb\x5c\x2e\x45\xe7\x71\x4b\x27\xf1\x63\x85\x23\x90\xe4\xfb\xa3\x23\x38\xad\x18\xcf\x20\x4d\xd2\x39\xc2\x3d\xae\x80\x89\x43\xce\x04\x42\x35\xe3\x8c\xa6\x3e\x58\xac\xca\x8f\x1c\x1e\x4a\x28\xe8\xff\x42\xc9\x29\xc7\x45\xe9\x7b\xd3\x2a\x27\x30\xa5\x56\x8b\x44\xcc\x38\x52\x6f\x3a\xad\xf2\x1c\x55\x10\x1a\x37\x6d\xc5\x25\x19\x39\xad\xf2\xf8\x9d\x62\x1a\x4f\x57\x1a\x83\x03\x7d\x40\xdc\x00\xc5\xff\xae\xed\xdc\x6c\xfb\x9b\xcb\x31\x2d\x13\xbf\x77\x11\xa4\x04\x42\x25\x62\x86\x5b\x11\x3f\xb8\xf0\xc6\x54\xd4\x20\x7d\xfc\xc2\x4d\xd1\x45\x72\x8f\x67\xe4\x97\xd7\xb8\x0a\x36\x72\x46\x30\x1e\x86\x5f\x71\xcd\x20\xd7\x9e\xbc\x66\xdb\xbc\x5e\x10\x3c\x61\x19\x71\x78\x3c\x06\xda\xb5\x1b\xa1\xef\x75\x24\x4d\x2a\x47\xd2\xb4\xca\x29\x04\x1e\x09\x99\x36\xa4\x0d\xee\x37\x95\x8e\xaf\x7f\x96\xe9\x3d\xf1\x6a\x02\x25\x6a\xe3\x25\x23\x6c\x9f\x3f\xff\xfe\x1e\x57\x1f\xf6\x56\x74\x2b\x78\xab\xca\xf7\x1e\x12\x45\xd9\x40\xff\xa4\xf2\x4d\x4c\x3d\xb3\x8a\xc9\x01\x6e\x66\x54\xa8\x09\xc8\xc0\xb5\xf1\x45\xef\x8b\x32\xc3\xf7\xbc\xc7\x10\x9c\x70\xee\x08\x79\x42\x6a\x47\x0e\xed\x27\x2d\x2b\xdd\x3f\xd0\xb1\x18\xf9\x9e\x17\xfa\x9e\x67\x3b\xd8\xf1\x78\x58\x98\xe3\xdb\xde\xd7\xdf\x62\xc2\x44\xb1\x45\xa2\x56\xaf\x71\xd5\x13\x26\x47\xef\xcc\xd6\xe7\xcf\x81\xa3\xb0\x81\x1f\x52\x59\xfe\xc1\xa4\xe8\xe7\xab\x72\x25\xa8\x20\x53\x47\x6d\x2b\xeb\x66\x8d\xa6\xde\x54\xf1\xcc\x14\xd7\xa9\x29\x3f\xd6\x05\xa9\x81\x05\x9c\x95\xa6\x66\x9b\xa2\xed\xb9\xac\x26\x8e\x37\x32\xbc\x45\x4e\x28\xdd\x46\x1f\xa7\x5b\x83\x31\x50\x0e\x06\x5d\x03\xa4\x13\xfb\xfa\x88\xd2\x9c\xee\x2a\x56\x6b\x25\x11\xec\x7d\xd8\xf7\xa8\xda\x78\x26\x6a\x63\xaa\xdb\x2b\xa0\xdc\x64\x3c\x6b\x13\xcc\xb4\xcb\x89\x2c\xf5\x4c\x61\x19\x64\x2c\xe1\x48\x93\x17\x35\xce\xde\xdb\xb9\x69\x46\xdb\x6d\xde\x04\xbe\x5b\xee\xda\xbd\xeb\xe7\x86\xd7\x56\xef\x43\xc2\x2b\x7c\x93\x14\x85\x19\x29\x29\xa3\xba\x1e\x72\xca\x44\x66\xb7\x1e\x73\xc9\xdb\x55\x81\x8f\x9a\xbc\xbe\xd6\x69\xf5\x5c\x87\xec\x75\xb6\x41\x6b\xf3\x9a\x8e\x36\x85\x3a\x84\x67\x1d\x63\x06\xae\x42\xfd\xad\xc1\x92\x5e\xdf\xdb\x09\x75\x88\xd5\x80\x6d\xa8\xb0\x52\x39\xe2\x15\x52\x14\x2a\xcc\x89\xa6\xf8\x42\x64\x4c\x61\xaa\x03\xb7\xf0\x2b\x39\xfa\x2a\x0f\x24\x05\xcd\x43\xc2\x07\xdd\xda\x6c\x96\x2f\x95\x5c\x38\x13\xcc\x85\x11\x6c\x93\x64\x4e\x2b\xe2\xb7\x52\xe6\x39\xc0\x84\x46\x95\x27\x29\xd6\xed\x94\x44\xbe\xdb\x74\x56\xcf\x91\xee\x60\xa7\x7c\xa2\xd5\xe3\xaa\x7b\x77\xb8\x41\x69\x30\xb3\xae\x07\x1f\x33\xe5\x9d\xe3\xb4\x9a\xbd\x91\x19\x1a\x55\xf9\x42\xc7\x2f\x0b\xc5\x84\xe6\x22\xe8\xf6\x4d\x63\x52\x4e\x01\xa1\x58\x85\x9f\x97\x26\x97\x85\x76\xf8\x31\x23\xc1\x40\xf1\x45\x69\x84\x69\xb4\x34\xaf\x1d\x6f\x69\x8e\x91\x8f\x37\xaf\x22\x53\x8d\xdc\xa6\xce\xe5\x1e\xb8\x96\xbb\xd0\xb8\xa7\xca\x1e\xde\xdf\xe9\x3d\xaf\x4d\x3b\x1a\xfe\x63\x93\xf4\xd7\x72\x69\x2f\x31\x28\x5a\x75\x71\x1c\x87\xf1\x4d\x9a\x98\xcc\x20\xee\x69\xc1\xf7\x06\xee\xb0\x37\x19\x93\xdd\x4d\x56\x15\x99\x1c\xd9\xe7\xdc\x97\xdc\xed\xe6\x6f\x97\x0f\xe3\x31\x94\x1f\x79\xfc\x42\xa9\x4b\x79\x2d\x97\xa5\x31\xcb\xea\xa5\x44\x39\x3a\x02\x57\xb3\xcc\x3b\x4c\x1c\x68\x1b\xac\x90\x88\x95\x9e\xd3\x83\x6d\x39\x47\x01\x7a\x8e\x0a\x0f\x4a\x9a\xd3\xdb\x3a\x65\xb3\xa9\x1b\xf8\x76\x3b\xeb\xce\x65\x3e\xd9\x12\xd3\x8b\x67\xb7\xaf\x36\x5d\xb3\x3e\xb7\x7e\x5f\xed\xeb\x99\xa1\x23\x1a\x7f\x47\x69\xe8\x0a\x83\x54\xa5\x79\xd2\xd2\x9f\x3e\x22\xf8\xc2\xee\xe7\x5e\x23\x1b\xd3\xcc\x7e\xe3\x91\x1b\xc3\xf6\x10\x37\x63\x17\x8c\x5b\x73\xf7\x56\xb0\x1e\xbf\xbc\x27\xde\x40\xd6\x13\x32\xce\xe4\x49\xae\x51\x7d\xd5\xfb\xc7\xbe\x70\xd6\xe4\xd9\x4b\x05\xe3\xfd\xb7\x4f\xd3\xfb\x3b\xc1\x9f\x03\x00\x27\xe9\x39\x9d\x9f\x16\x00\x00")
 
 func templates17_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,11 +89,11 @@ func templates17_upsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/17_upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x39, 0x7b, 0xf4, 0xcd, 0x7e, 0x44, 0x8a, 0x0, 0x23, 0xfe, 0x2e, 0xcd, 0xf9, 0xb1, 0xc6, 0x80, 0x65, 0x26, 0x32, 0x80, 0xeb, 0x9b, 0x81, 0x96, 0x6d, 0xfd, 0x35, 0x68, 0x3, 0xfa, 0xdd, 0x87}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6a, 0xc9, 0x52, 0x1e, 0x8b, 0x69, 0x1f, 0x5e, 0xb3, 0xb, 0x8d, 0xee, 0x6e, 0x7f, 0xa1, 0xfd, 0xd4, 0x9c, 0xcb, 0xb2, 0x3a, 0x54, 0xce, 0x5f, 0x8d, 0xdc, 0xce, 0x8f, 0x8a, 0x23, 0x48, 0x15}}
 	return a, nil
 }
 
-var _templatesSingletonPsql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x5f\x6b\xdb\x3e\x14\x7d\x96\x3e\xc5\xad\xa0\xd4\x06\xe1\xfe\xfa\xfa\x83\x3c\xb4\xb1\xdb\x65\x04\xbb\x89\xed\x6d\x30\xf6\xe0\xd8\xd7\xa9\xc0\x91\x33\xfd\xc9\x56\xd6\x7c\xf7\x21\xc7\xae\xd3\xa6\xa3\x14\x82\x12\x74\xef\x39\x3a\xf7\xe8\x28\x97\x97\xb0\xb2\xa2\xa9\xf2\xad\x46\x65\x16\x16\xd5\xe3\x7d\xab\xcd\x5a\xa1\x3e\x14\x34\x14\x90\x2e\xe6\xa0\x4d\x61\x70\x83\xd2\x80\x36\x4a\xc8\x35\x58\xed\x56\xf3\x80\x60\x3b\x6c\x58\x98\x02\xb6\xaa\xdd\x89\x0a\xab\x80\xd6\x56\x96\xff\xa4\xf6\x2a\x51\x40\xa5\xc4\x0e\x95\x0e\x42\x51\x34\x58\x1a\x0e\xa6\x58\x35\x18\x17\x1b\xec\x8f\xe0\x60\xb7\x55\x61\x30\x91\xd3\x56\xd6\x8d\x28\x0d\xac\xda\xb6\xe1\xa0\xd0\x0c\x35\x0e\x65\x5f\xe3\xf0\xeb\x41\x18\x6c\x84\x36\xf0\xfd\xc7\x81\xc1\x1f\xc4\xfe\xa1\x64\xe8\x83\x89\xdb\xdc\x14\x72\xdd\x60\x30\xab\x50\x9a\x85\x6d\x0d\xa6\x8d\x28\xd1\xe9\x0a\xe6\x0b\x0e\xee\x7b\xb9\x18\xc9\x7d\x4a\x46\xf6\x8f\x10\x3c\xa3\x7c\x4a\x14\x7e\x0c\xab\xd0\xf8\x94\x92\x95\xad\xe1\xff\x63\xdc\x1d\x9a\x1b\x5b\xd7\xa8\x3c\x9f\x92\x0a\x6b\x54\x47\xc5\x7b\x3b\x14\x57\xb6\x76\xf0\xb2\x6d\xec\x46\x6a\x47\xc1\xc2\xe8\xf6\x3a\x9f\x67\xf0\xe5\x7a\x9e\x47\x29\xa3\x44\xd4\xd0\xa0\xf4\x46\x95\x70\x36\x81\xff\x9c\x5d\xcf\xb8\x09\xd4\x1b\x13\xa4\x5b\x25\xa4\xa9\x3d\xe6\x9d\x6b\xbf\xc7\x83\xfb\xcd\x38\x25\x84\x1c\x6c\xd6\xc1\xe7\x56\x1c\xb1\x71\x60\x1c\x98\x3f\x74\x0c\x0a\x9b\xa2\xc4\x87\xb6\xa9\x50\x75\x41\x08\x72\x8d\x33\x59\xe1\xef\xe3\x02\x7f\xa5\x8b\xc3\x15\x87\x2b\xdf\xa7\x64\x4f\x29\x71\x8a\x6e\x7b\x45\x94\x38\x87\xdc\x19\x6c\x16\xa7\xd1\x32\x83\x59\x9c\x25\x70\xae\xdd\x27\x89\x61\x9a\xc4\xb7\xf3\xd9\x34\x83\x4e\xe9\x73\xc6\xf8\x38\x22\xa7\xc4\x19\x25\x6a\x38\x3b\x09\xdc\xd3\x53\x27\xe4\xb0\xef\xc3\x64\x70\x67\x65\xeb\xe0\xab\x12\x06\xd3\x6e\x72\x8f\x85\x09\xc4\x49\xf6\x69\x16\xdf\x31\x27\x12\xb0\xd1\xf8\xb2\xf3\xe6\xd1\xa0\x77\xe1\x5d\xf8\x6f\xc0\x5f\xf8\x37\x26\xba\xb3\xef\xad\x7e\xe6\x43\x98\x40\x7e\x1f\x5e\x67\x11\xa4\x51\x06\xcc\x4d\x40\xea\x56\x81\xe0\xb0\x73\x97\xad\x0a\xb9\xc6\xfe\x95\x74\x42\xdc\x80\x62\xbc\xdf\x13\x65\xbc\x53\x46\xf6\x6e\xf9\xe9\x52\x59\xbd\x8c\xdd\x18\xd7\x93\xa4\xee\x3a\xe4\x6b\x91\x07\x92\x37\x4b\x0c\x26\x10\x7d\x9b\xce\xf3\x30\x0a\x03\xf6\x0e\x7a\x7f\xb8\xf4\x3e\xab\xee\x55\x8c\x53\x9c\x12\x2f\xa3\x2c\x5f\xc6\xb3\xf8\x0e\xd8\xbb\x4e\x77\x7f\x24\x83\xc9\xee\x0c\x85\xc6\x2a\x09\x0e\xd4\xf7\xfb\x74\x4f\xff\x06\x00\x00\xff\xff\x76\xcb\x6a\x7a\x25\x05\x00\x00")
+var _templatesSingletonPsql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\x5d\x6b\xe3\x3a\x10\x7d\x96\x7e\xc5\xd4\x50\x6a\x81\x70\x6f\x5f\x0b\x79\x68\x63\xb7\x37\x97\xe0\x34\xb1\x7d\x77\x61\xd9\x07\xc7\x1e\xa7\x02\x47\xce\xea\x23\xbb\xa5\xcd\x7f\x5f\xe4\x8f\xc6\xdd\x66\x29\x05\x23\x1b\xcd\x9c\xa3\xa3\x33\xc7\x97\x97\xb0\xb6\xa2\x2e\xb3\x9d\x46\x65\x96\x16\xd5\xd3\x43\xa3\xcd\x46\xa1\xee\x0a\x1a\x72\x48\x96\x73\xd0\x26\x37\xb8\x45\x69\x40\x1b\x25\xe4\x06\xac\x76\xab\x79\x44\xb0\x2d\x36\xcc\x4d\x0e\x3b\xd5\xec\x45\x89\x65\x40\x2b\x2b\x8b\xbf\x52\xfb\xa5\xc8\xa1\x54\x62\x8f\x4a\x07\xa1\xc8\x6b\x2c\x0c\x07\x93\xaf\x6b\x8c\xf3\x2d\xf6\x47\x70\xb0\xbb\x32\x37\xb8\x90\xd3\x46\x56\xb5\x28\x0c\xac\x9b\xa6\xe6\xa0\xd0\x0c\x35\x0e\x45\x5f\xe3\xf0\xf3\x51\x18\xac\x85\x36\xf0\xed\x7b\xc7\xc0\x06\xb1\xcf\x94\x0c\x7d\x30\x71\x9b\xdb\x5c\x6e\x6a\x0c\x66\x25\x4a\xb3\xb4\x8d\xc1\xa4\x16\x05\x3a\x5d\xc1\x7c\xc9\xc1\xbd\x57\xcb\x23\x39\xa3\xe4\xc8\xfe\x19\x82\x57\x14\xa3\x44\xe1\xe7\xb0\x0a\x0d\xa3\x94\xac\x6d\x05\xd7\x63\xdc\x3d\x9a\x5b\x5b\x55\xa8\x7c\x46\x49\x89\x15\xaa\x51\xf1\xc1\x0e\xc5\xb5\xad\x1c\xbc\x68\x6a\xbb\x95\xda\x51\x78\x61\x74\x77\x93\xcd\x53\xf8\xff\x66\x9e\x45\x89\x47\x89\xa8\xa0\x46\xe9\x1f\x55\xc2\xd9\x04\xfe\x81\x67\x4a\x5e\x71\x13\xa8\xb6\x26\x48\x76\x4a\x48\x53\xf9\x9e\x7f\xae\x59\x8f\x07\xf7\xed\x71\x4a\x08\xe9\x6c\xd6\xc1\x7f\x8d\x18\xb1\x71\xf0\x38\x78\x6c\xe8\x18\x14\xd6\x79\x81\x8f\x4d\x5d\xa2\xd2\xad\xe1\x99\xc6\x99\x2c\xf1\xd7\xb8\xc0\xff\xd0\xc5\xe1\x8a\xc3\x15\x63\x94\x1c\x28\x25\x4e\xd1\x5d\xaf\x88\x12\xe7\x90\x3b\xc3\x9b\xc5\x49\xb4\x4a\x61\x16\xa7\x0b\x38\xd7\xee\x59\xc4\x30\x5d\xc4\x77\xf3\xd9\x34\x85\x56\xe9\x6b\xc6\xf8\xf1\x8a\x9c\x12\x67\x94\xa8\xe0\xec\x5d\xe0\x5e\x5e\x5a\x21\xdd\x3e\x83\xc9\xe0\xce\xda\x56\xc1\x17\x25\x0c\x26\xed\xcd\x7d\x2f\x5c\x40\xbc\x48\xff\x9d\xc5\xf7\x9e\x13\x09\x58\x6b\x7c\xdb\x79\xfb\x64\xd0\xbf\xf0\x2f\xd8\x09\xf8\x1b\xff\x86\xd0\xf5\xf6\x9d\xea\xf7\x18\x84\x0b\xc8\x1e\xc2\x9b\x34\x82\x24\x4a\xc1\x73\x37\x20\x55\xa3\x40\x70\xd8\xbb\x61\xab\x5c\x6e\xb0\xff\x4b\x5a\x21\x6e\xd8\xe2\x38\xdf\x11\x69\xa7\x8c\xb7\xca\xc8\xc1\x2d\x3f\x5c\x2a\x4b\xb8\x3e\x1d\xd7\x77\x49\xdd\x33\x3a\xe6\xeb\x45\x76\x24\x27\x4b\x1e\x4c\x20\xfa\x3a\x9d\x67\x61\x14\x06\xde\x07\xe8\x43\x37\xf4\x3e\xab\x0a\xc7\x29\x7d\x4f\xbc\x8a\xd2\x6c\x15\xcf\xe2\x7b\xe7\xc9\x07\x4e\x2b\x1c\x99\xec\xce\x50\x68\xac\x92\xe0\x40\x89\x51\x42\x6e\x7c\x46\x0f\xf4\xf7\x00\x76\xcb\x6a\x7a\x25\x05\x00\x00")
 
 func templatesSingletonPsql_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func templatesSingletonPsql_upsertGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonPsql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x6d\x6f\xe3\xb8\x11\xfe\x2c\xfd\x8a\x39\x03\x39\x48\x5b\x87\x3e\xf4\xe5\x4b\x0e\xc6\x21\x71\x9c\x74\x71\xd9\x24\x6b\xbb\x3d\x14\xdd\xf6\x8e\x96\x46\x0e\x11\x89\x64\x48\x2a\x59\xf7\x90\xff\x5e\x0c\x29\xd9\xb2\x63\x25\xdb\x6e\x0b\xdc\xa7\x84\xe4\x33\xef\x0f\x87\x23\x3f\x72\x03\x66\xf5\xf9\xf6\xf2\xe2\x1e\xd7\x30\x06\x83\x2b\xfc\xac\xd9\x87\xda\xba\x89\xaa\xb4\x28\x31\xf9\x25\xf9\xa1\x4a\xff\x79\x7a\xb5\x98\xce\x60\x71\x7a\x76\x35\x05\xf6\xee\x93\xfc\x64\x7f\x77\x7a\x7e\x0e\x93\x9b\xeb\xf9\x62\x76\xfa\xfe\x7a\x01\xec\xdd\x0f\x70\x71\x33\x9b\xbe\xbf\xbc\x86\x1f\xa7\x7f\xa3\xf5\xf7\x9f\xe4\x2f\x69\x1c\xbb\xb5\x46\xd0\xab\x05\x5a\x87\x06\xac\x33\x75\xe6\xe0\xd7\x38\xca\x97\x13\x25\x25\xbc\xb3\x0f\x25\x3b\x3f\x8b\x69\xe3\x9a\x57\x08\x04\x11\x72\x15\x47\x77\xca\x3a\x80\xed\xba\xb6\x68\xba\x6b\xcd\xad\xed\xae\xad\x2d\x2b\x95\xe3\xf6\x5c\x19\x2f\x2f\xa4\x8b\xe3\x48\xaf\x6e\xb9\xb5\x17\xa2\xdc\x00\xe2\xc8\xa1\x75\xe7\x67\xde\xea\x46\xc9\xbd\xd0\xf3\x8f\x57\x93\x2a\x87\xa5\x52\x65\xfc\x1c\xc7\x45\x2d\x33\x10\x52\xb8\x24\x0d\x7e\x7f\xe0\x42\xc2\x18\xbe\x6d\x83\xfa\xf5\x99\x60\xa3\x11\x58\x74\xb5\x86\xbc\xae\xb4\x05\x77\x87\x90\x73\xc7\x97\xdc\x22\xd8\xec\x0e\x2b\x0e\x5c\xe6\x20\x2a\xf2\xcb\x82\x70\xe4\x98\x02\x0e\x0e\x69\x8b\x9b\x35\x18\x2e\x73\x55\x95\x6b\xd2\xb5\x42\x89\x86\x3b\xcc\x81\xbc\xec\xa8\x52\xe0\xee\xb8\xf3\xbb\x16\x32\x2e\x61\x89\x60\x6a\x09\x7c\xc5\x85\xb4\x8e\x14\xd7\x56\xc8\x15\x79\xb0\xab\xc8\x3e\x94\x4b\x25\x4a\x34\x70\x33\xfb\x00\x9a\x67\xf7\x7c\x85\x2c\xc4\x97\x68\x78\xd7\xc6\x93\x86\x40\x92\x14\xd0\x18\x65\x28\x68\x62\x0a\x1a\x13\x36\xe2\x38\x7a\x14\x1a\x0d\x9b\xa3\x3b\xc7\x82\xd7\xa5\x4b\x06\x9a\xea\x18\xe2\x1c\x0c\x61\xa0\xeb\x65\x29\xb2\x41\xda\x0b\xa5\x2c\x0c\x86\xf0\xa7\x3f\xfe\xe1\xf7\xfd\xa0\xa6\xa4\xa4\xd0\xe0\x43\x2d\x0c\x0e\x52\xaa\x25\x6b\xb8\x32\x86\x20\x78\x89\x6e\xee\x0b\xd8\xc8\xe5\x4b\xc9\x2b\xc2\x46\x9a\x79\x1a\xf5\x01\xe9\x30\xc0\x3c\xbb\xfa\x60\x74\x18\x60\x9e\x74\x7d\x30\x3a\x6c\x60\xc4\xbd\x0e\xec\xbd\xdc\x89\xdb\x63\x5a\xbe\xf6\x69\x6b\x83\xf7\xe0\x0e\x55\xfb\xf0\x04\xe9\x06\xde\xa1\x72\x47\xe4\x4c\xa9\xb2\x35\x70\x2f\xe8\x6f\x56\xe5\x3e\xab\x54\xdf\x31\x3c\xf2\x92\xb3\x33\x5c\x09\xf9\x57\x5e\x8a\x9c\x3b\xa1\x64\x92\xb2\x66\x81\x49\x1c\x45\x1e\x12\x4c\x5f\x2b\x37\xad\xb4\x5b\x27\x21\x81\x54\xf8\x6d\xbe\x86\xbd\x58\x4a\x7b\x8b\x0d\x25\xd8\x60\xaf\x95\x4b\xfc\x3f\xd3\x87\x9a\x97\x36\x09\xb9\x1c\xc2\x77\x2d\x3e\x24\xf0\x15\xe5\x81\x1b\x2d\xbc\xcd\x48\x3f\xbe\xc9\x73\x2b\xb0\x49\xfb\x30\x8e\x52\x36\xb9\xc3\xec\x3e\xa1\xf4\x88\xc2\xdf\x80\x6f\xc6\x20\x45\x49\x77\x22\x32\xe8\x6a\x23\x69\x37\x8e\x9e\xe3\x38\x1a\x8d\x40\x14\x20\x95\xbf\x9b\x74\x03\xcf\xcf\x80\x28\x81\xb9\x97\x2e\x51\x26\xdd\x42\xa6\x30\x1e\xc3\x77\x5e\xd3\x68\x04\x13\x83\xdc\x21\xf0\xa6\x09\x88\x7f\x61\x0e\xf9\x12\xc8\x79\x16\x47\xfb\x0c\xd8\x80\xd8\xdc\xf1\x65\x89\xe1\x60\x13\x7c\x1a\x1c\x6a\x5c\x1e\x83\x66\x15\xbf\xc7\xdb\xcb\xb6\x05\x26\xe9\xf7\x6f\x05\x23\x0a\xf8\x66\x87\x43\x04\xea\x28\xcc\x8d\xd2\x0b\xef\xd2\x01\x65\x3b\xda\xa2\xe7\x5d\xc9\xcc\x47\xfa\xc5\xb2\x71\x14\x51\x47\x25\x17\x4e\xc6\x80\x9f\x31\x63\x13\x55\x55\x5c\xe6\xc9\x40\xaf\x7e\xa6\x33\xea\x0f\xc7\xc7\xa1\xf9\x1c\x2b\x59\xae\x07\x43\xe8\xa4\xa2\x95\x67\x53\xf9\x08\x63\xe0\x5a\xa3\xcc\x13\x65\x69\x2d\x0c\xd1\x9b\xe0\x7a\x35\x95\x8f\x49\xca\x18\x23\x91\xe0\xe4\x61\xa3\xf6\xa1\xf4\x06\x3a\xa5\xec\x4a\x7c\xb9\x19\x4a\xfb\x10\x9e\xc8\x84\x50\xec\x56\x68\x4c\x3a\xee\xce\x5d\x4e\xa9\x39\x19\xc3\xb7\xcb\xb5\x43\xcb\xce\xea\xa2\xf0\xaf\x4d\xc7\x58\x3f\xa8\x13\xf7\xdc\xe5\xaa\xa6\x7e\xf4\xb4\xbb\x19\x2a\xb2\x63\x2e\xde\x89\x64\xee\x72\xff\xd4\x49\x7c\xba\xf8\x11\xd7\xe7\x68\x9d\x51\x6b\x34\xc9\x66\x6a\x18\x82\x49\xf7\x45\x82\xda\x3d\x17\xe3\x2e\x09\xb6\x3e\x70\xe3\x5e\xe7\x80\x32\x96\xfd\x64\xb8\x4e\xd0\x50\x7b\x29\xb8\x28\xe9\x4d\x54\x60\x49\x16\x1a\x06\x40\x16\xaa\x43\x9d\x6f\x97\x6f\x5d\xcf\xbe\xda\x98\x7d\x28\xf7\x2c\x1d\x8a\xea\x27\x2e\x0e\xda\x29\x2a\xc7\x6e\x8d\x90\xae\x94\x64\x20\xdd\xdf\xdb\x29\x44\xd3\xa7\x92\x34\xfd\x42\x17\x9f\xb8\x70\x50\x28\xd3\x93\x92\x38\x8a\x7e\x26\x06\xb0\x49\xa9\x2c\x26\x29\x8c\x46\x70\x5a\xd0\x48\xd6\xde\x2e\x61\x21\x57\x12\x87\x90\x11\xc2\x0f\x30\x4f\x46\x38\x04\x94\x39\xa8\xc2\x6f\x68\xa1\x31\x3e\x9c\xde\xff\x36\xea\x3d\x9e\x7c\x45\xdc\x2f\xab\xe3\xe3\x6e\x74\x48\xb1\x9d\xe6\x76\xa7\x1d\x53\xcb\x49\x95\x27\x96\xc8\x3e\x6c\x35\x34\x13\xe1\x10\xb8\x59\x59\x60\x8c\x85\x75\x67\x26\xca\x0e\x34\x87\x46\x38\x48\x85\x56\x92\xfd\x67\x1d\xa1\x79\x28\xbc\x33\x29\x25\x32\xbc\x10\x59\xe7\x36\x06\x4f\x2c\xbb\xc6\xa7\x19\xf2\x1c\x4d\x83\x0e\xe1\xda\x70\xd9\x0f\xb5\x0d\xdb\xdf\x51\xb2\x6e\x9b\x08\x2a\x36\x9b\xa1\xd2\x41\x78\xf3\xa8\x9c\x8c\x81\x8e\x67\xb5\x3c\x50\xf4\x6e\x7d\xdb\x52\x99\x5a\x4a\x21\x57\x27\x83\x4d\x8a\x43\x96\xd2\x3d\x7c\x30\xbe\x43\x83\xbd\xe3\x7d\x96\xec\x3f\x5d\x6f\x16\xbc\xc9\x38\xfc\xfd\x1f\x21\x95\xe4\x73\x23\xd4\x6e\xb5\x51\xcc\x35\xd9\x2d\x92\xc1\xed\xe5\x9f\x6f\xe6\x8b\xf1\x91\xf5\xad\x9f\x86\x16\x3f\x52\xec\x61\x6e\x6f\x66\x8b\xf1\x51\xee\x31\x34\xa8\x1c\xc2\xfc\x65\x3e\x9d\xb5\x7a\x68\x50\x3a\xa8\xe7\x74\x3e\xbf\x78\x7f\x35\x6d\x71\xdb\xaf\x17\x42\x3f\xf7\xc4\xb5\xff\xc8\x6f\xb9\xea\x2a\x3d\x6c\xcb\x26\x54\xed\x44\xc9\x16\x58\x69\x0f\x1b\xf8\x79\x7d\xd5\x0e\xaf\xaf\xcd\x39\xbd\x97\x30\x5c\x62\x50\x9a\xc6\x45\x28\x44\xe9\x67\x50\x2a\x06\x05\x76\xd1\x04\xe6\xbd\x18\x1c\xd9\x93\xa3\xfc\x44\x2b\xeb\x56\x06\xed\x49\x27\xa3\x6d\xd6\x36\x99\xe9\xcc\x4d\xe4\x5e\xe7\x3e\xbc\x54\xdb\x2a\xf2\x40\xb2\xdd\xc1\x94\x92\x40\xe9\x2b\xee\x1c\xf5\x3a\xd2\x8e\x93\xbf\x21\x97\xb6\x83\xc7\xff\xd1\xad\x2e\xe9\x60\x0c\xae\xd2\xcc\xcf\x98\xe9\xe6\xae\xd0\x56\xf3\x9a\xf4\x10\x72\x77\xd4\xdb\xd2\xb1\x51\xa0\x59\xd3\x7a\x3d\x05\x03\x38\x5f\xbe\x98\xad\x0e\xeb\xee\x0e\xa0\x6f\x68\x26\xa8\xd7\x3b\x38\x3e\x16\xc5\x31\x7e\x16\xd6\xd9\x43\x66\x46\x23\x70\xc8\x4d\xae\x9e\xa4\xef\xeb\xb5\x43\x0b\x59\x89\x5c\xd6\x1a\x1c\xb7\xf7\x16\x9e\xee\x50\xfa\xa7\x30\x7c\x80\x17\x42\x0a\x7b\xd7\x36\xb7\x43\x7e\xb6\x0a\xfb\x3f\xa7\x77\xc6\x6a\xff\xab\x48\x9b\xd6\x37\xa6\xf4\xa8\xc5\x83\x47\xfc\xcf\xa7\xf6\x4e\x33\x55\x96\xcd\xb0\x52\x8f\xf4\x8d\xd1\x69\x46\x7d\x75\x57\x92\xe2\x4d\x9a\x1f\x77\x86\x21\x50\xff\xf3\x89\x28\x36\x51\x1e\x08\xac\x3d\x1a\xfa\x78\xbc\x03\x7b\xb9\xda\x22\x9a\x67\xe9\xa1\x64\x37\x1a\x65\x32\x68\x3b\xca\x60\x08\xb9\x11\x8f\x68\xd8\xed\xfc\xe3\xd5\x59\x2d\xca\xfc\x63\x8d\x66\xdd\x3c\x19\xed\x97\x6a\xe0\xff\xcb\xeb\xb4\x7f\xd9\x9a\xef\xc1\xf4\xb5\xd6\x28\x45\x39\x7c\xf1\xfe\xec\xc6\xf2\x1c\xff\x3b\x00\x00\xff\xff\xa1\x67\x61\x83\x6e\x13\x00\x00")
+var _templates_testSingletonPsql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x6d\x6f\xe3\xb8\x11\xfe\x2c\xfd\x8a\x39\x01\x39\x48\x5b\x85\x3e\xf4\xe5\x4b\x0e\xc6\x21\x76\x9c\x74\x71\xd9\x24\x6b\xa7\x3d\x14\xdd\xf6\x8e\xb6\x46\x0e\x11\x89\x64\x48\x2a\x59\x77\x91\xff\x5e\x0c\x25\xd9\x92\x63\xe5\xd2\x6e\x0b\xdc\x87\xc5\x86\xe4\x33\xef\x0f\x87\x23\x3f\x72\x03\x66\xfd\xf9\xe6\xe2\xfc\x1e\x37\x30\x06\x83\x6b\xfc\xac\xd9\x87\xca\xba\xa9\x2a\xb5\x28\x30\xfe\x25\xfe\xa1\x4c\xfe\x79\x7a\x79\x3b\x9b\xc3\xed\xe9\xe4\x72\x06\xec\xdd\x27\xf9\xc9\xfe\xee\xf4\xec\x0c\xa6\xd7\x57\x8b\xdb\xf9\xe9\xfb\xab\x5b\x60\xef\x7e\x80\xf3\xeb\xf9\xec\xfd\xc5\x15\xfc\x38\xfb\x1b\xad\xbf\xff\x24\x7f\x49\xc2\xd0\x6d\x34\x82\x5e\xdf\xa2\x75\x68\xc0\x3a\x53\xad\x1c\x7c\x09\x83\x6c\x39\x55\x52\xc2\x3b\xfb\x50\xb0\xb3\x49\x48\x1b\x57\xbc\x44\x20\x88\x90\xeb\x30\xb8\x53\xd6\x01\xec\xd6\x95\x45\xd3\x5d\x6b\x6e\x6d\x77\x6d\x6d\x51\xaa\x0c\x77\xe7\xca\x78\x79\x21\x5d\x18\x06\x7a\x7d\xc3\xad\x3d\x17\xc5\x16\x10\x06\x0e\xad\x3b\x9b\x78\xab\xad\x90\xbd\x17\x7a\xf1\xf1\x72\x5a\x66\xb0\x54\xaa\x08\x9f\xc3\x30\xaf\xe4\x0a\x84\x14\x2e\x4e\x6a\xbf\x3f\x70\x21\x61\x0c\xdf\xb6\x41\x7d\x79\x26\xd8\x68\x04\x16\x5d\xa5\x21\xab\x4a\x6d\xc1\xdd\x21\x64\xdc\xf1\x25\xb7\x08\x76\x75\x87\x25\x07\x2e\x33\x10\xa5\x56\xc6\x59\x10\x0e\x84\x74\x0a\x38\x38\xa4\x2d\x6e\x36\x60\xb8\xcc\x54\x59\x6c\xc2\xd1\x08\xd6\x28\xd1\x70\x87\x19\x90\x97\x1d\x55\x0a\xdc\x1d\x77\x7e\xd7\xc2\x8a\x4b\x58\x22\x98\x4a\x02\x5f\x73\x21\xad\x23\xc5\x95\x15\x72\x4d\x1e\xf4\x15\xd9\x87\x62\xa9\x44\x81\x06\xae\xe7\x1f\x40\xf3\xd5\x3d\x5f\x23\xab\xe3\x8b\x35\xbc\x6b\xe3\x49\xea\x40\xe2\x04\xd0\x18\x65\x28\x68\x62\x0a\x1a\xff\x4f\x99\x30\x0c\x1e\x85\x46\xc3\x16\xe8\xce\x30\xe7\x55\xe1\xe2\x48\x53\x1d\xeb\x38\xa3\x14\x22\x5d\x2d\x0b\xb1\x8a\x92\x41\x28\x65\x21\x4a\xe1\x4f\x7f\xfc\xc3\xef\x87\x41\x4d\x49\x49\xa1\xc1\x87\x4a\x18\x8c\x12\xaa\x25\x6b\xb8\x32\x86\x5a\xfb\x05\xba\x85\x2f\x60\x23\x97\x2d\x25\x2f\x09\x1b\x68\xe6\x69\x34\x04\xa4\xc3\x1a\xe6\xd9\x35\x04\xa3\xc3\x1a\xe6\x49\x37\x04\xa3\xc3\x06\x46\xdc\xeb\xc0\xde\xcb\x5e\xdc\x1e\xd3\xf2\x75\x48\x5b\x1b\xbc\x07\x77\xa8\x3a\x84\x27\x48\x37\xf0\x0e\x95\x3b\x22\x13\xa5\x8a\xd6\xc0\xbd\xa0\xff\x57\x65\xe6\xb3\x4a\xf5\x1d\xc3\x23\x2f\x38\x9b\xe0\x5a\xc8\xbf\xf2\x42\x64\xdc\x09\x25\xe3\x84\x35\x0b\x8c\xc3\x20\xf0\x90\x3a\xdf\x57\xca\xcd\x4a\xed\x36\x71\x9d\xc0\x14\xba\xf9\x4a\x07\xb1\x94\xf6\x16\x4b\x7f\x77\xb0\x57\xca\xc5\xfe\x8f\xd9\x43\xc5\x0b\x1b\xd7\xb9\x4c\xe1\xbb\x16\x4f\xcb\x28\x79\x45\x79\xcd\x8d\x14\xfa\x54\x18\xc6\x37\x79\x4e\x61\x2f\xed\x69\x18\x24\x6c\x7a\x87\xab\xfb\x98\xd2\x23\x72\x62\x3f\x7c\x33\x06\x29\x0a\xba\x13\x81\x41\x57\x19\x49\xbb\x61\xf0\x1c\x86\xc1\x68\x04\x22\x07\xa9\xfc\xdd\xa4\x1b\x78\x36\x01\xa2\x04\x66\x5e\xba\x40\x19\x77\x0b\x99\xc0\x78\x0c\xdf\x79\x4d\xa3\x11\x4c\x0d\x72\x87\xc0\x9b\x26\x20\xfe\x85\x19\x64\x4b\x20\xe7\x59\x18\xec\x33\x60\x0b\x62\x0b\xc7\x97\x05\xd6\x1a\xb7\xc1\x27\xb5\x43\x8d\xcb\x63\xd0\xac\xe4\xf7\x78\x73\xd1\xb6\xc0\x38\xf9\xfe\xd7\x82\x11\x39\x7c\xd3\xe3\x10\x81\x3a\x0a\x33\xa3\x34\xb5\x8b\xb3\xc9\x01\x65\x3d\x6d\xc1\x73\x5f\x72\xe5\x23\x7d\xb3\x6c\x18\x04\xd4\x51\xa7\x65\x06\x27\x63\xc0\xcf\xb8\x62\x53\x55\x96\x5c\x66\x71\xa4\xd7\x3f\xd3\x19\xf5\x87\xe3\xe3\xba\xf9\x1c\x2b\x59\x6c\xa2\x14\x3a\xa9\x68\xe5\xd9\x4c\x3e\xc2\x18\xb8\xd6\x28\xb3\x58\x59\x5a\x0b\x43\xf4\x26\xb8\x5e\xcf\xe4\x63\x9c\x30\xc6\x92\x30\x08\x6a\x27\x0f\x1b\xb5\x0f\x85\x37\xd0\x29\x65\x57\xe2\xed\x66\x88\x43\x29\x3c\x51\x5c\x42\xb1\x1b\xa1\x31\xee\xb8\xbb\x70\x19\xa5\xe6\x64\x0c\xdf\x2e\x37\x0e\x2d\x9b\x54\x79\xee\x5f\x9b\x8e\xb1\x61\x50\x27\xee\x85\xcb\x54\x45\xfd\xe8\xa9\xbf\x49\xea\xc7\xd0\x6c\xd4\x9a\xc2\x5e\x24\x0b\x97\xf9\xa7\x4e\xe2\xd3\xf9\x8f\xb8\x39\x43\xeb\x8c\xda\xa0\x89\xb7\x53\x43\x0a\xa6\x97\xae\x9d\xda\xed\xd6\x4e\xf1\x96\x04\x3b\x1f\xb8\x71\xaf\x73\x40\x19\xcb\x7e\x32\x5c\xc7\x68\x4c\x0a\x51\xce\x45\x41\x6f\xa2\x02\xeb\xb8\x71\xd0\x30\x00\x56\x35\x25\xa2\x64\x9f\x6f\x5d\xcf\xbe\xda\x98\x7d\x28\xf6\x2c\x1d\x8a\xea\x27\x2e\x0e\xda\xc9\x4b\xc7\x6e\x8c\x90\xae\x90\x14\x4d\xb2\xbf\xd7\xc8\xd7\xf9\x6a\xfa\x54\x9c\x24\x6f\x74\xf1\x89\x0b\x07\xb9\x32\x03\x29\x09\x83\xe0\x67\x62\x00\x9b\x16\xca\x62\x9c\xc0\x68\x04\xa7\x39\x8d\x64\x8d\x59\x10\x16\x32\x25\x31\x85\x15\x21\x68\x7c\x80\x27\x23\x1c\x02\xca\x0c\x54\xee\x37\xb4\xd0\x18\x1e\x4e\xef\x7f\x1b\xf5\x56\xc3\x57\xc7\xfd\xb2\x3a\x3e\xee\x46\x87\x14\xbb\x69\xae\x3f\xed\x98\x4a\x4e\xcb\x2c\xb6\x44\xf6\xb4\xd5\xd0\x4c\x89\x29\x70\xb3\xb6\xc0\x18\xab\xd7\x9d\x99\x68\x75\xa0\x39\x34\xc2\xb5\x54\xdd\x4a\x56\xff\x59\x47\x68\x1e\x0a\xef\x4c\x42\x34\xad\x5f\x88\x55\xe7\x36\xd6\x9e\x58\x76\x85\x4f\x73\xe4\x19\x9a\xda\xf5\xa6\xe9\xdb\xfa\xb2\x1f\x6a\x1b\x76\xb8\xa3\x34\xfa\x49\x92\x0c\x90\x8a\xed\x26\xc9\xf8\x4d\xdf\xce\x9b\xd2\x9f\x8c\x81\x8e\xe7\x95\x3c\x50\xf4\x6e\x7d\xdb\x52\x99\x4a\x4a\x21\xd7\x27\xd1\x36\xc5\x75\x96\x92\x3d\x7c\x6d\xbc\x47\x83\xbd\xe3\x7d\x96\xec\x48\xf2\xc6\x82\x37\x19\x87\xbf\xff\xa3\x4e\x25\x7c\xd9\x0a\xb5\x5b\x6d\x14\x0b\x4d\x97\x33\x8f\xa3\x9b\x8b\x3f\x5f\x2f\x6e\xc7\x47\xd6\xb7\x7e\x1a\x5a\x92\xf4\x25\xe6\xe6\x7a\x7e\x3b\x3e\xca\x3c\x86\x06\x95\x43\x98\xbf\x2c\x66\xf3\x56\x0f\x0d\x4a\x07\xf5\x9c\x2e\x16\xe7\xef\x2f\x67\x2d\x6e\xf7\xf5\x42\xe8\xe7\x81\xb8\xf6\x1f\xf9\x1d\x57\x5d\xa9\xd3\xb6\x6c\x42\x55\x4e\x14\xec\x16\x4b\xed\x61\x11\x3d\x9f\x7a\xdd\x0e\xaf\x22\xdf\xaf\xe6\x1b\x2e\x61\x7d\x89\x41\x69\x1a\x17\x21\x17\x85\x1f\x5b\xa9\x18\x94\xc4\xf3\x26\x30\xef\x45\x74\x64\x4f\x8e\xb2\x13\xad\xac\x5b\x1b\xb4\x27\x4d\x84\x94\xd1\x36\x6b\xdb\xcc\x74\xe6\x26\x72\xaf\x73\x1f\x5e\xaa\x6d\x15\x79\x20\xe5\xa8\x63\xba\x90\xb1\x2b\x75\xf2\x8a\x3b\x47\x83\x8e\xb4\xe3\xe4\x6f\xc8\xa5\xdd\xe0\xf1\x7f\x74\xab\x4b\x3a\x18\x83\x2b\x35\x23\x8b\x71\xb2\xbd\x2b\xb4\xd5\xbc\x26\x03\x84\xec\x8f\x7a\x3b\x3a\x36\x0a\x34\x6b\x5a\xaf\xa7\x60\x0d\xce\x96\x2f\x66\xab\xc3\xba\xbb\x03\xe8\xaf\x68\x26\xa8\xd7\x1b\x1d\x1f\x8b\xfc\x18\x3f\x0b\xeb\xec\x21\x33\xa3\x11\x38\xe4\x26\x53\x4f\xd2\x0f\x7d\x95\x43\x0b\xab\x02\xb9\xac\x34\x38\x6e\xef\x2d\x3c\xdd\xa1\xf4\x4f\x21\x89\x5a\xc8\x85\x14\xf6\xae\x6d\x6e\x87\xfc\x6c\x15\x0e\x7f\x4e\xef\x5e\x53\x62\x1b\xfd\x2a\xd2\xa6\xf5\x45\x63\xed\xb7\xba\xa0\xc5\xd3\x88\x26\x8a\xff\xf9\xd4\xde\x69\xa6\xca\xb2\x39\x96\xea\x11\xe3\x5e\x33\x1a\xaa\xbb\x92\x32\x4e\x20\x6e\x7e\xdc\xf1\xad\x47\x19\xff\xf3\x89\xc8\xb7\x51\x1e\x08\xac\x3d\x4a\x7d\x3c\xbe\x9b\xef\xe5\x6a\x87\x68\x9e\xa5\x87\x82\x5d\x6b\x94\x71\xd4\x76\x94\x28\x85\xcc\x88\x47\x34\xec\x66\xf1\xf1\x72\x52\x89\x22\xfb\x58\xa1\xd9\x34\x4f\x46\xfb\xa5\x5a\x5f\x94\x3e\x09\x0e\x5d\xb6\xe6\x7b\x30\x79\xad\x35\x4a\x51\xa4\x2f\xde\x9f\x7e\x2c\xcf\xe1\xbf\x07\x00\xa1\x67\x61\x83\x6e\x13\x00\x00")
 
 func templates_testSingletonPsql_main_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +133,7 @@ func templates_testSingletonPsql_main_testGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonPsql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xc1\x0a\xc2\x30\x10\x44\xef\xfd\x8a\xa5\xe4\xd0\x4a\x9b\x0f\x10\x3c\x78\xd4\x83\x88\xb4\x1f\x10\xed\xb6\x04\xe2\x5a\xba\x5b\x10\x42\xfe\x5d\xd2\x46\xe9\xc1\xdb\x0c\x6f\x32\x99\xed\x67\x7a\x40\x83\x2c\xed\xc8\x38\x49\x21\xb0\x13\x64\xb1\x34\xe8\xa6\x04\x9f\x01\x78\x5f\xc3\x64\x68\x40\x50\x96\x3a\x7c\x57\xa0\xc4\xdc\x1d\xc2\xfe\x00\xba\x89\x8a\x43\x48\x39\xdb\x27\xa8\x4f\x7c\x7e\x59\x5a\x30\xd4\x3f\x8e\x8e\xb7\x56\x19\x67\x0d\xc7\x22\xa5\x8f\x51\x22\xaf\x8d\xdf\x96\x8b\x79\xe2\x92\x16\x7d\x9b\xa9\xc8\xbd\x5f\x9f\xe8\x76\xbc\xba\x79\x32\x2e\x84\xbc\x82\x38\xf8\x0f\x59\x2f\x2a\x97\xbf\x90\xba\xed\x8c\xe4\x42\xf6\x09\x00\x00\xff\xff\x11\x5d\x4c\xce\xff\x00\x00\x00")
+var _templates_testSingletonPsql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x4f\xcd\x8a\x83\x30\x10\xbe\xfb\x14\x83\xe4\xa0\x8b\xe6\x01\x16\xf6\xb0\xc7\xdd\x43\x5b\x44\x1f\x20\x6d\x46\x09\xa4\xa3\x64\x22\xb4\x84\xbc\x7b\x89\x4a\x6b\xa1\xb7\x6f\xe6\xfb\x99\xf9\xfa\x99\x2e\xd0\x22\xfb\x6e\x62\x74\xbe\xf0\xf0\xe5\x91\xbd\xa1\x41\xb6\x25\x84\x0c\x20\x84\x1a\x9c\xa2\x01\x41\x18\xd2\x78\xab\x40\x78\x75\xb6\x08\xdf\x3f\x20\xdb\x84\x38\xc6\x4d\x67\x7a\x18\xdd\xc6\xcb\x3f\xfe\x1f\x0d\x2d\x8a\xd7\xaa\x41\xa5\x8f\x64\xef\x50\x3f\x4d\x68\x19\x77\xa3\x50\xd6\x28\x4e\xe9\x42\xfe\x26\x88\x2c\xdf\x42\x0e\xea\x8a\x8b\xda\xcb\x66\xa6\x22\x0f\x61\xb5\xc8\x6e\x3a\xd9\xd9\x29\x1b\x63\x5e\x41\x6a\xf1\x81\x59\x6b\x96\xcb\x2d\x24\xbd\x7f\x83\x34\xd4\x31\x66\x31\x7b\x0c\x00\x0a\x7c\x0a\x7b\x14\x01\x00\x00")
 
 func templates_testSingletonPsql_suites_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,11 +149,11 @@ func templates_testSingletonPsql_suites_testGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/singleton/psql_suites_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x10, 0xc4, 0x71, 0xaf, 0xd9, 0x16, 0x41, 0x8b, 0x4b, 0xfc, 0xe8, 0xba, 0xfd, 0xfa, 0x4d, 0x2c, 0x1, 0xd1, 0x0, 0xe1, 0xb0, 0x78, 0xee, 0x7f, 0xd0, 0x65, 0xf3, 0xa1, 0x43, 0xba, 0x3c, 0xe7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb8, 0xd5, 0xdd, 0x3d, 0xf2, 0xc9, 0x19, 0x38, 0xaa, 0x3c, 0xe9, 0x15, 0xb7, 0xe4, 0x2c, 0x39, 0x35, 0xc8, 0x8f, 0x99, 0x15, 0x97, 0xba, 0xe6, 0x9a, 0xfd, 0x6f, 0xac, 0xac, 0xc8, 0x6d, 0x8a}}
 	return a, nil
 }
 
-var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\xcd\x6e\xdb\x3c\x10\x3c\x8b\x4f\xb1\x9f\xf1\xb5\xa0\x0a\x85\x41\xaf\x29\x7c\x70\x7e\x0e\x41\x51\xc3\x88\xe5\x73\xc1\x48\x2b\x87\x30\x4d\x0a\xe4\xaa\xb6\x2b\xf0\xdd\x0b\x52\x4e\xe2\xfc\x15\x46\xd1\xa2\xe8\xc1\x96\x48\xcc\xce\xec\xce\xee\xaa\xef\x4f\xe0\x7f\xa9\x95\xf4\x70\x36\x06\x31\x89\x6f\xe8\x45\x29\x6f\x35\xc2\xf0\x10\x53\xb9\xc6\x10\x58\xd3\x99\x0a\x08\x3d\xf5\xfd\x10\x21\x16\xed\x4c\x77\x4e\xea\x10\x16\xad\x47\x47\x9c\xe0\x43\x04\x28\xb3\x14\x65\x0e\x3d\xcb\x48\xcc\xa4\x93\x5a\xa3\xe6\x39\x63\x99\x6a\x40\xa3\xe1\x0f\x04\x97\x76\x63\xe6\xca\x2c\x3b\x2d\x5d\x08\x13\xad\x2f\xac\xee\xd6\xc6\xe7\x30\x1e\xff\x0c\x39\x73\x6a\x2d\xdd\xee\x33\xee\x1e\x02\x7a\x96\x65\x24\xe6\x2b\xd5\xf2\x51\xfc\x6f\x95\x59\x02\xa5\x32\x36\x8a\xee\xc0\x1a\xbd\x83\x76\x88\x83\x15\xee\xa0\x1a\x22\x47\x39\xcb\x02\x63\x99\x47\xac\xa3\x05\x4e\x9a\xda\xae\xd5\x77\x14\x53\xdc\xcc\x11\x6b\x9e\xb3\xec\x9b\x74\x80\x2e\xfd\xac\x63\xd9\xe9\x29\x4c\x88\x70\xdd\x12\xd0\x1d\xc2\xf5\x74\x7e\x75\x53\x82\x57\x35\x82\x6d\x40\x1a\x58\xcc\xe2\x0d\xcb\x6c\x64\x3c\xb0\xeb\xb1\x82\x3e\x24\x37\x22\xe9\xa1\xe6\x9c\x5c\x57\x11\x8f\xc9\x14\xf0\xde\x16\xf0\x86\x01\x97\xe7\xe5\xae\x45\x5f\x00\xb9\x0e\xf3\x4f\x89\xe7\xbf\x31\x18\xa5\xf7\x46\x5c\xc5\x4c\x1b\x3e\x5a\x98\x64\x01\xd9\x47\x91\xd7\x13\x02\x9f\xa4\xcf\xe0\x9d\x1f\x15\x91\x6f\xef\x4b\xdf\xab\x06\x8c\x25\x10\x53\x7b\x61\x0d\xe1\x96\x42\xa8\x68\x1b\x2b\xab\x86\xb3\x38\x97\xd5\x6a\xe9\x6c\x67\x6a\x9e\xf7\x3d\x9a\x3a\x04\x96\x0d\x90\x2f\x9d\xa7\x72\xcb\x13\xcb\x21\xc3\x8b\x8b\x5b\xab\xb4\x38\xc7\xa5\x32\x89\x43\x7b\x3c\xbc\x2b\xb7\xbc\xa2\x6d\x11\x0b\xbc\x57\x38\x0a\x94\xb3\xac\xc6\x06\x1d\xc4\xe1\xe5\x39\xf4\xf0\x15\xc6\x40\x5b\x71\x63\xb5\xbe\x95\xd5\x8a\xe7\x10\x62\x87\x1f\x7a\x61\xc5\x7e\x96\xdf\x2a\x3c\xf6\x04\x4d\x0d\x27\x21\x40\x3c\x35\x52\x7b\x4c\xa2\x05\xa4\x5c\xae\x4d\x83\x8e\xe7\x4f\x4f\xc7\xf5\xa8\x4b\xd2\xaf\x37\xe8\x45\x67\x2a\xdb\x19\x4a\x17\xcf\xa6\xec\x7e\x29\x79\x2e\x2e\x22\xe6\xc8\x52\x1e\x5d\x78\x99\x25\xbf\x97\x8d\x90\x24\x1c\x41\x1f\x9f\x40\x46\x1b\x69\x08\xac\x41\x70\x58\x59\x57\x17\xb0\xb4\x74\x36\x2a\x06\xfc\x3e\xe9\x67\xab\xb3\x98\x5d\x4e\xca\xab\xd7\x56\xe7\x77\x2c\xc7\xbe\x35\xc7\x7e\x44\x84\x10\x7f\x74\x95\x7e\x7d\xc6\xe2\x96\xff\xe5\x11\xfb\x47\x26\x2c\xb0\x1f\x01\x00\x00\xff\xff\x53\x0f\x25\xbd\xd2\x06\x00\x00")
+var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\xcd\x6e\xdb\x3c\x10\x3c\x8b\x4f\xb1\x9f\xf1\xb5\x20\x0b\x85\x41\xaf\x29\x7c\x70\x7e\x0e\x41\x51\xc3\x88\xe5\x73\xc1\x48\x2b\x87\x30\x4d\x0a\xe4\xaa\xb6\x2b\xf0\xdd\x0b\x4a\x4e\xe2\xfc\x15\x46\xd1\xa2\xe8\xc1\x96\x48\xcc\xee\xec\xec\xec\xaa\xeb\x4e\xe0\x7f\x65\xb4\x0a\x70\x36\x06\x39\x49\x6f\x18\x64\xa1\x6e\x0d\xc2\xf0\x90\x53\xb5\xc6\x18\x59\xdd\xda\x12\x08\x03\x75\xdd\x10\x21\x17\xcd\xcc\xb4\x5e\x99\x18\x17\x4d\x40\x4f\x9c\xe0\x43\x02\x68\xbb\x94\x85\x80\x8e\x65\x24\x67\xca\x2b\x63\xd0\x70\xc1\x58\xa6\x6b\x30\x68\xf9\x43\x82\x4b\xb7\xb1\x73\x6d\x97\xad\x51\x3e\xc6\x89\x31\x17\xce\xb4\x6b\x1b\x04\x8c\xc7\x3f\x43\xce\xbc\x5e\x2b\xbf\xfb\x8c\xbb\x87\x80\x8e\x65\x19\xc9\xf9\x4a\x37\x7c\x94\xfe\x1b\x6d\x97\x40\xa9\x7e\xd8\x68\xba\x03\x67\xcd\x0e\x9a\x21\x0e\x56\xb8\x83\x72\x88\x1c\x09\x96\x45\xc6\xb2\x80\x58\xa5\x16\x78\x65\x2b\xb7\xd6\xdf\x51\x4e\x71\x33\x47\xac\xb8\x60\xd9\x37\xe5\x01\x7d\xff\x73\x9e\x65\xa7\xa7\x30\x21\xc2\x75\x43\x40\x77\x08\xd7\xd3\xf9\xd5\x4d\x01\x41\x57\x08\xae\x06\x65\x61\x31\x4b\x37\x2c\x73\x29\xe3\x83\x86\x45\xf3\xa8\xa0\x8b\x7d\x37\x52\xd2\x03\xce\x39\xf9\xb6\x24\x9e\x6a\xc9\xe1\xbd\xcb\xe1\x0d\xfd\x97\xe7\xc5\xae\xc1\x90\x03\xf9\x16\xc5\xa7\x54\x17\xfc\x37\x06\xab\x4d\x6a\x7a\x46\xf2\xca\x7b\xe7\x6b\x3e\x5a\xd8\xbe\x03\xe4\x1e\x39\x5e\xaf\x07\x42\x4f\x7d\x06\xef\xc2\x28\x4f\xf9\xf6\x6d\xe9\x3a\x5d\x83\x75\x04\x72\xea\x2e\x9c\x25\xdc\x52\x8c\x25\x6d\x93\xb0\x72\x38\xcb\x73\x55\xae\x96\xde\xb5\xb6\xe2\xa2\xeb\xd0\x56\x31\xb2\x6c\x80\x7c\x69\x03\x15\x5b\xde\x67\x39\xcc\xf0\xe2\xe2\xd6\x69\x23\xcf\x71\xa9\x6d\x9f\xc3\x04\x3c\xbc\x2b\xb6\xbc\xa4\x6d\x9e\x04\xde\x33\x1c\x05\x12\x2c\xab\xb0\x46\x0f\x69\x76\xb9\x80\x0e\xbe\xc2\x18\x68\x2b\x6f\x9c\x31\xb7\xaa\x5c\x71\x01\x91\x8b\x03\x2b\x9c\xdc\x8f\xf2\x5b\xc2\x93\x27\x68\x2b\x38\x89\x11\xd2\xa9\x56\x26\x60\x4f\x9a\x43\x5f\xcb\xb5\xad\xd1\x73\xf1\xf4\x74\x9c\x47\x6d\x4f\xfd\xba\x41\x2f\x9c\x29\x5d\x6b\xa9\xb7\xea\xd9\x90\xdd\xef\x24\x17\xf2\x22\x61\x8e\x94\xf2\xd8\x85\x97\x55\xf2\x7b\xda\x04\xe9\x89\x93\x94\x8f\x4f\x20\xa3\x8d\xb2\x04\xce\x22\x78\x2c\x9d\xaf\x72\x58\x3a\x3a\x1b\xe5\x03\x7e\x5f\xf4\xb3\xcd\x59\xcc\x2e\x27\xc5\xd5\x6b\x9b\xf3\x1b\x76\x63\xef\xcc\xb1\x9f\x10\x29\xe5\x1f\xdd\xa4\x5f\x1f\xb1\xb4\xe4\x7f\x79\xc2\xfe\x91\x01\x8b\xec\xc7\x00\x18\xe3\xee\x62\xd0\x06\x00\x00")
 
 func templates_testUpsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +169,7 @@ func templates_testUpsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfb, 0xde, 0xdf, 0x7e, 0xe3, 0x82, 0x69, 0xda, 0x51, 0xad, 0xf5, 0x62, 0xf6, 0x3, 0xfb, 0x16, 0x16, 0xcf, 0x5a, 0xc7, 0x97, 0x5c, 0x53, 0x26, 0x8a, 0x91, 0x81, 0x86, 0x3b, 0x2c, 0x22, 0x58}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa, 0x16, 0x1f, 0x23, 0x45, 0xbf, 0xb2, 0x15, 0xd5, 0x22, 0xef, 0x3d, 0x3a, 0xe4, 0x32, 0x5a, 0xe6, 0x4f, 0x82, 0x6b, 0x46, 0xeb, 0x11, 0x5b, 0xe2, 0xec, 0xd2, 0xe7, 0xb7, 0x21, 0x4c, 0xd6}}
 	return a, nil
 }
 
@@ -271,6 +271,9 @@ var _bindata = map[string]func() (*asset, error){
 	"templates_test/upsert.go.tpl":                     templates_testUpsertGoTpl,
 }
 
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
+
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
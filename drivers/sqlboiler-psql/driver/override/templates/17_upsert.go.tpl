@@ -1,3 +1,4 @@
+{{- if not .Table.IsReadOnly -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -32,6 +33,10 @@ func (o *{{$alias.UpSingular}}) UpsertP({{if .NoContext}}exec boil.Executor{{els
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Upsert")
+
+	{{end -}}
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
 	}
@@ -58,15 +63,9 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		buf.WriteString(c)
 	}
 	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(updateColumns.Kind))
-	for _, c := range updateColumns.Cols {
-		buf.WriteString(c)
-	}
+	buf.WriteString(makeCacheKey(updateColumns, nil))
 	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(insertColumns.Kind))
-	for _, c := range insertColumns.Cols {
-		buf.WriteString(c)
-	}
+	buf.WriteString(makeCacheKey(insertColumns, nil))
 	buf.WriteByte('.')
 	for _, c := range nzDefaults {
 		buf.WriteString(c)
@@ -139,7 +138,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
 		{{else -}}
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		err = boil.QueryRowContext(ctx, exec, cache.query, vals...).Scan(returns...)
 		{{end -}}
 		if err == sql.ErrNoRows {
 			err = nil // Postgres doesn't return anything when there's no update
@@ -148,7 +147,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 		_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
+		_, err = boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	}
 	if err != nil {
@@ -167,3 +166,4 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	return nil
 	{{- end}}
 }
+{{end -}}
@@ -74,8 +74,12 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 	schema := config.DefaultString(drivers.ConfigSchema, "public")
 	whitelist, _ := config.StringSlice(drivers.ConfigWhitelist)
 	blacklist, _ := config.StringSlice(drivers.ConfigBlacklist)
+	assembleOpts, err := drivers.AssembleOptionsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
 
-	useSchema := schema != "public"
+	useSchema := config.DefaultBool(drivers.ConfigUseSchema, schema != "public")
 
 	p.connStr = PSQLBuildQueryString(user, pass, dbname, host, port, sslmode)
 	p.conn, err = sql.Open("postgres", p.connStr)
@@ -84,14 +88,16 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 	}
 
 	defer func() {
-		if e := p.conn.Close(); e != nil {
+		if e := drivers.CloseConn(p.conn, err); e != nil {
 			dbinfo = nil
 			err = e
 		}
 	}()
 
 	dbinfo = &drivers.DBInfo{
-		Schema: schema,
+		Schema:        schema,
+		DefaultSchema: "public",
+		SchemaQuery:   "SELECT table_name, column_name, is_nullable FROM information_schema.columns WHERE table_schema = $1",
 		Dialect: drivers.Dialect{
 			LQ: '"',
 			RQ: '"',
@@ -99,13 +105,20 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 			UseIndexPlaceholders: true,
 			UseSchema:            useSchema,
 			UseDefaultKeyword:    true,
+			MaxParams:            65535,
+
+			UseDistinctOn:      true,
+			UseWindowFunctions: true,
+			UseForUpdate:       true,
 		},
 	}
-	dbinfo.Tables, err = drivers.Tables(p, schema, whitelist, blacklist)
+	dbinfo.Tables, err = drivers.TablesWithOptions(p, schema, whitelist, blacklist, assembleOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	drivers.ApplyConfig(config, dbinfo.Tables)
+
 	return dbinfo, err
 }
 
@@ -604,11 +617,6 @@ func getArrayType(c drivers.Column) (string, string) {
 func (p PostgresDriver) Imports() (importers.Collection, error) {
 	var col importers.Collection
 
-	col.All = importers.Set{
-		Standard: importers.List{
-			`"strconv"`,
-		},
-	}
 	col.Singleton = importers.Map{
 		"psql_upsert": {
 			Standard: importers.List{
@@ -752,6 +760,12 @@ func (p PostgresDriver) Imports() (importers.Collection, error) {
 		"types.NullDecimal": {
 			ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
 		},
+		"types.Money": {
+			ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
+		"types.NullMoney": {
+			ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+		},
 		"pgeo.Circle": {
 			ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types/pgeo"`},
 		},
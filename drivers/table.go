@@ -1,6 +1,10 @@
 package drivers
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/volatiletech/strmangle"
+)
 
 // Table metadata from the database schema.
 type Table struct {
@@ -13,8 +17,47 @@ type Table struct {
 	PKey  *PrimaryKey  `json:"p_key"`
 	FKeys []ForeignKey `json:"f_keys"`
 
+	// PolymorphicKeys found by ConfigPolymorphicPattern, a foreign key
+	// on one of their id columns is dropped from FKeys.
+	PolymorphicKeys []PolymorphicKey `json:"polymorphic_keys"`
+
+	// Triggers defined on the table, only populated for drivers
+	// implementing TriggerConstructor.
+	Triggers []string `json:"triggers"`
+
+	// Indexes on the table, only populated for drivers
+	// implementing IndexConstructor.
+	Indexes []Index `json:"indexes"`
+
+	// CheckConstraints of the table, only populated for drivers
+	// implementing CheckConstraintConstructor.
+	CheckConstraints []CheckConstraint `json:"check_constraints"`
+
 	IsJoinTable bool `json:"is_join_table"`
 
+	// IsView is true for views, their models are read only: no Insert,
+	// Update, Upsert or Delete methods and no generated tests are emitted.
+	IsView bool `json:"is_view"`
+
+	// ReadOnly marks a base table to be generated like a view,
+	// see ConfigReadOnlyTables.
+	ReadOnly bool `json:"read_only"`
+
+	// EmbedStruct is embedded in the generated model when set,
+	// see ConfigEmbedStruct.
+	EmbedStruct string `json:"embed_struct"`
+
+	// VersionColumn is the rowversion column checked by optimistic
+	// updates, see ConfigOptimisticLocking.
+	VersionColumn string `json:"version_column"`
+
+	// SoftDeleteColumn is the nullable timestamp set in place of deleting
+	// rows, empty when the table can't soft delete, see ConfigSoftDeleteColumn.
+	SoftDeleteColumn string `json:"soft_delete_column"`
+	// NoSoftDelete is set along with an empty SoftDeleteColumn by ApplyConfig,
+	// drivers that don't call it leave both empty, see SoftDeleteColumnName.
+	NoSoftDelete bool `json:"no_soft_delete"`
+
 	ToOneRelationships  []ToOneRelationship  `json:"to_one_relationships"`
 	ToManyRelationships []ToManyRelationship `json:"to_many_relationships"`
 }
@@ -30,6 +73,12 @@ func GetTable(tables []Table, name string) (tbl Table) {
 	panic(fmt.Sprintf("could not find table name: %s", name))
 }
 
+// IsReadOnly returns true for views and read only tables, which only get
+// query code generated.
+func (t Table) IsReadOnly() bool {
+	return t.IsView || t.ReadOnly
+}
+
 // GetColumn by name. Panics if not found (for use in templates mostly).
 func (t Table) GetColumn(name string) (col Column) {
 	for _, c := range t.Columns {
@@ -65,11 +114,78 @@ func (t Table) CanLastInsertID() bool {
 	return true
 }
 
+// HasTriggers returns true if any triggers are defined on the table.
+func (t Table) HasTriggers() bool {
+	return len(t.Triggers) != 0
+}
+
+// CanSoftDelete returns true if the table has a soft delete column
 func (t Table) CanSoftDelete() bool {
+	return len(t.SoftDeleteColumnName()) != 0
+}
+
+// SoftDeleteColumnName returns SoftDeleteColumn, or for tables ApplyConfig
+// didn't see, a deleted_at null.Time column like before it was configurable.
+func (t Table) SoftDeleteColumnName() string {
+	if len(t.SoftDeleteColumn) != 0 || t.NoSoftDelete {
+		return t.SoftDeleteColumn
+	}
+
 	for _, column := range t.Columns {
 		if column.Name == "deleted_at" && column.Type == "null.Time" {
+			return column.Name
+		}
+	}
+	return ""
+}
+
+// UniqueKeys returns the column sets besides the primary key that identify
+// a single row: unique columns first, then the columns of unfiltered unique
+// indexes. Sets holding the whole primary key and duplicates are skipped.
+func (t Table) UniqueKeys() [][]string {
+	var keys [][]string
+	add := func(cols []string) {
+		if len(cols) == 0 || (t.PKey != nil && len(strmangle.SetComplement(t.PKey.Columns, cols)) == 0) {
+			return
+		}
+		for _, k := range keys {
+			if sameColumns(k, cols) {
+				return
+			}
+		}
+		keys = append(keys, cols)
+	}
+
+	for _, c := range t.Columns {
+		if c.Unique {
+			add([]string{c.Name})
+		}
+	}
+	for _, idx := range t.Indexes {
+		if idx.Unique && len(idx.Filter) == 0 {
+			add(idx.Columns)
+		}
+	}
+
+	return keys
+}
+
+// IsUniqueKey returns true if the columns include the primary key or
+// one of the unique keys, so their values identify at most one row.
+func (t Table) IsUniqueKey(cols []string) bool {
+	if t.PKey != nil && len(strmangle.SetComplement(t.PKey.Columns, cols)) == 0 {
+		return true
+	}
+	for _, k := range t.UniqueKeys() {
+		if len(strmangle.SetComplement(k, cols)) == 0 {
 			return true
 		}
 	}
+
 	return false
-}
\ No newline at end of file
+}
+
+// sameColumns returns true if a and b hold the same columns in any order
+func sameColumns(a, b []string) bool {
+	return len(a) == len(b) && len(strmangle.SetComplement(a, b)) == 0
+}
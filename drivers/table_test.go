@@ -1,6 +1,9 @@
 package drivers
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestGetTable(t *testing.T) {
 	t.Parallel()
@@ -148,3 +151,50 @@ func TestCanSoftDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestSoftDeleteColumnName(t *testing.T) {
+	t.Parallel()
+
+	columns := []Column{
+		{Name: "deleted_at", Type: "null.Time"},
+		{Name: "removed_at", Type: "null.Time"},
+	}
+
+	if got := (Table{Columns: columns}).SoftDeleteColumnName(); got != "deleted_at" {
+		t.Error("tables ApplyConfig didn't see should fall back to deleted_at, got:", got)
+	}
+	if got := (Table{Columns: columns, SoftDeleteColumn: "removed_at"}).SoftDeleteColumnName(); got != "removed_at" {
+		t.Error("want the configured column, got:", got)
+	}
+	if got := (Table{Columns: columns, NoSoftDelete: true}).SoftDeleteColumnName(); got != "" {
+		t.Error("tables without soft deletes should not fall back, got:", got)
+	}
+}
+
+func TestUniqueKeys(t *testing.T) {
+	t.Parallel()
+
+	table := Table{
+		Columns: []Column{
+			{Name: "id", Unique: true},
+			{Name: "email", Unique: true},
+			{Name: "airline_id"},
+			{Name: "code"},
+		},
+		PKey: &PrimaryKey{Columns: []string{"id"}},
+		Indexes: []Index{
+			{Name: "pk", Columns: []string{"id"}, Unique: true},
+			{Name: "uq_email", Columns: []string{"email"}, Unique: true},
+			{Name: "uq_code", Columns: []string{"airline_id", "code"}, Unique: true},
+			{Name: "uq_code_id", Columns: []string{"code", "id"}, Unique: true},
+			{Name: "uq_code_active", Columns: []string{"code"}, Unique: true, Filter: "([active]=(1))"},
+			{Name: "ix_code", Columns: []string{"code"}},
+		},
+	}
+
+	got := table.UniqueKeys()
+	want := [][]string{{"email"}, {"airline_id", "code"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
@@ -218,8 +218,16 @@ func NewDefaultImports() Collection {
 				`"github.com/volatiletech/sqlboiler/v4/queries/qm"`,
 			},
 		},
+		"boil_functions": {
+			ThirdParty: List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/volatiletech/sqlboiler/v4/boil"`,
+				`"github.com/volatiletech/sqlboiler/v4/queries"`,
+			},
+		},
 		"boil_types": {
 			Standard: List{
+				`"database/sql"`,
 				`"strconv"`,
 			},
 			ThirdParty: List{
@@ -233,6 +241,7 @@ func NewDefaultImports() Collection {
 	col.Test = Set{
 		Standard: List{
 			`"bytes"`,
+			`"errors"`,
 			`"reflect"`,
 			`"testing"`,
 		},
@@ -245,6 +254,11 @@ func NewDefaultImports() Collection {
 	}
 
 	col.TestSingleton = Map{
+		"boil_embeds_test": {
+			ThirdParty: List{
+				`"github.com/volatiletech/randomize"`,
+			},
+		},
 		"boil_main_test": {
 			Standard: List{
 				`"database/sql"`,
@@ -269,9 +283,12 @@ func NewDefaultImports() Collection {
 				`"io"`,
 				`"io/ioutil"`,
 				`"math/rand"`,
+				`"reflect"`,
 				`"regexp"`,
+				`"strings"`,
 			},
 			ThirdParty: List{
+				`"github.com/volatiletech/randomize"`,
 				`"github.com/volatiletech/sqlboiler/v4/boil"`,
 			},
 		},
@@ -299,6 +316,8 @@ func AddTypeImports(a Set, typeMap map[string]Set, columnTypes []string) Set {
 	copy(tmpImp.ThirdParty, a.ThirdParty)
 
 	for _, typ := range columnTypes {
+		// Pointer columns, ex: *time.Time, import what their type does
+		typ = strings.TrimPrefix(typ, "*")
 		for key, imp := range typeMap {
 			if typ == key {
 				tmpImp.Standard = append(tmpImp.Standard, imp.Standard...)
@@ -227,6 +227,14 @@ func TestAddTypeImports(t *testing.T) {
 	if !reflect.DeepEqual(res2, importsExpected) {
 		t.Errorf("Expected res2 to match importsExpected, got:\n\n%#v\n", res1)
 	}
+
+	res3 := AddTypeImports(imports1, imps.BasedOnType, []string{"*string", "*time.Time"})
+	if want := (List{`"errors"`, `"fmt"`, `"time"`}); !reflect.DeepEqual(res3.Standard, want) {
+		t.Errorf("Expected pointer types to import their type, got:\n\n%#v\n", res3)
+	}
+	if want := (List{`"github.com/volatiletech/sqlboiler/v4/boil"`}); !reflect.DeepEqual(res3.ThirdParty, want) {
+		t.Errorf("Expected no null import for pointer types, got:\n\n%#v\n", res3)
+	}
 }
 
 func TestMergeSet(t *testing.T) {
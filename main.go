@@ -93,6 +93,7 @@ func main() {
 	rootCmd.PersistentFlags().StringP("output", "o", "models", "The name of the folder to output to")
 	rootCmd.PersistentFlags().StringP("pkgname", "p", "models", "The name you wish to assign to your generated package")
 	rootCmd.PersistentFlags().StringSliceP("templates", "", nil, "A templates directory, overrides the bindata'd template folders in sqlboiler")
+	rootCmd.PersistentFlags().StringSliceP("template-dirs", "", nil, "A templates directory whose templates override the sqlboiler and driver templates of the same name")
 	rootCmd.PersistentFlags().StringSliceP("tag", "t", nil, "Struct tags to be included on your models in addition to json, yaml, toml")
 	rootCmd.PersistentFlags().StringSliceP("replace", "", nil, "Replace templates by directory: relpath/to_file.tpl:relpath/to_replacement.tpl")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "Debug mode prints stack traces on error")
@@ -106,11 +107,30 @@ func main() {
 	rootCmd.PersistentFlags().BoolP("add-global-variants", "", false, "Enable generation for global variants")
 	rootCmd.PersistentFlags().BoolP("add-panic-variants", "", false, "Enable generation for panic variants")
 	rootCmd.PersistentFlags().BoolP("add-soft-deletes", "", false, "Enable soft deletion by updating deleted_at timestamp")
+	rootCmd.PersistentFlags().BoolP("emit-name-constants", "", false, "Generate Table<Table> and Column<Table>_<Column> constants holding the raw names")
+	rootCmd.PersistentFlags().BoolP("generate-index-metadata", "", false, "Generate a <Model>Indexes variable describing each table's indexes")
+	rootCmd.PersistentFlags().BoolP("generate-interfaces", "", false, "Generate a <Model>Repository interface over each model's CRUD functions")
+	rootCmd.PersistentFlags().BoolP("generate-dtos", "", false, "Generate a <Model>DTO struct for each model with ToDTO and FromDTO methods")
+	rootCmd.PersistentFlags().StringP("dto-null-style", "", "pointer", "How --generate-dtos types null columns: pointer (*string) or null (null.String)")
+	rootCmd.PersistentFlags().BoolP("generate-changesets", "", false, "Generate an UpdateWithChangeset method returning the old and new values of the columns it updates")
+	rootCmd.PersistentFlags().BoolP("generate-delete-cascade", "", false, "Generate a DeleteCascade method deleting the rows referencing a row before it")
+	rootCmd.PersistentFlags().BoolP("generate-insert-ignore", "", false, "Generate InsertIgnore methods inserting a row unless its primary or unique key exists")
+	rootCmd.PersistentFlags().BoolP("generate-schema-version", "", false, "Generate a SchemaVersion hash of the schema and a VerifySchema drift check")
+	rootCmd.PersistentFlags().BoolP("generate-validate", "", false, "Generate a Validate method checking required columns and lengths before insert")
+	rootCmd.PersistentFlags().BoolP("generate-constructors", "", false, "Generate a New<Model> constructor taking the required columns of each model")
+	rootCmd.PersistentFlags().BoolP("json-methods", "", false, "Generate MarshalJSON/UnmarshalJSON methods for your models")
+	rootCmd.PersistentFlags().StringP("json-null-policy", "", "render", "How --json-methods writes null columns: render (as null) or omit")
+	rootCmd.PersistentFlags().StringP("nullable-style", "", "null", "Types of nullable columns: null (null.String) or pointers (*string)")
+	rootCmd.PersistentFlags().StringP("nullable-package", "", "volatiletech", "Package of the null types: volatiletech (null.String) or stdlib (sql.NullString)")
+	rootCmd.PersistentFlags().StringP("order-columns", "", "ordinal", "Order of generated struct fields: ordinal (as in the table) or alphabetical")
+	rootCmd.PersistentFlags().IntP("bulk-insert-batch-size", "", 0, "Rows per transaction for the generated InsertAll methods, 0 inserts everything at once")
 	rootCmd.PersistentFlags().BoolP("version", "", false, "Print the version")
 	rootCmd.PersistentFlags().BoolP("wipe", "", false, "Delete the output folder (rm -rf) before generation to ensure sanity")
+	rootCmd.PersistentFlags().BoolP("incremental", "", false, "Only write the output files whose content changed, leaving the others untouched")
 	rootCmd.PersistentFlags().StringP("struct-tag-casing", "", "snake", "Decides the casing for go structure tag names. camel, title or snake (default snake)")
 	rootCmd.PersistentFlags().StringP("relation-tag", "r", "-", "Relationship struct tag name")
 	rootCmd.PersistentFlags().StringSliceP("tag-ignore", "", nil, "List of column names that should have tags values set to '-' (ignored during parsing)")
+	rootCmd.PersistentFlags().StringSliceP("read-only-tables", "", nil, "Tables generated like views, without insert, update and delete")
 
 	// hide flags not recommended for use
 	rootCmd.PersistentFlags().MarkHidden("replace")
@@ -166,30 +186,58 @@ func preRun(cmd *cobra.Command, args []string) error {
 	drivers.RegisterBinary(driverName, driverPath)
 
 	cmdConfig = &boilingcore.Config{
-		DriverName:        driverName,
-		OutFolder:         viper.GetString("output"),
-		PkgName:           viper.GetString("pkgname"),
-		Debug:             viper.GetBool("debug"),
-		AddGlobal:         viper.GetBool("add-global-variants"),
-		AddPanic:          viper.GetBool("add-panic-variants"),
-		AddSoftDeletes:    viper.GetBool("add-soft-deletes"),
-		NoContext:         viper.GetBool("no-context"),
-		NoTests:           viper.GetBool("no-tests"),
-		NoHooks:           viper.GetBool("no-hooks"),
-		NoRowsAffected:    viper.GetBool("no-rows-affected"),
-		NoAutoTimestamps:  viper.GetBool("no-auto-timestamps"),
-		NoDriverTemplates: viper.GetBool("no-driver-templates"),
-		NoBackReferencing: viper.GetBool("no-back-referencing"),
-		Wipe:              viper.GetBool("wipe"),
-		StructTagCasing:   strings.ToLower(viper.GetString("struct-tag-casing")), // camel | snake | title
-		TagIgnore:         viper.GetStringSlice("tag-ignore"),
-		RelationTag:       viper.GetString("relation-tag"),
-		TemplateDirs:      viper.GetStringSlice("templates"),
-		Tags:              viper.GetStringSlice("tag"),
-		Replacements:      viper.GetStringSlice("replace"),
-		Aliases:           boilingcore.ConvertAliases(viper.Get("aliases")),
-		TypeReplaces:      boilingcore.ConvertTypeReplace(viper.Get("types")),
-		Version:           sqlBoilerVersion,
+		DriverName:            driverName,
+		OutFolder:             viper.GetString("output"),
+		PkgName:               viper.GetString("pkgname"),
+		Debug:                 viper.GetBool("debug"),
+		AddGlobal:             viper.GetBool("add-global-variants"),
+		AddPanic:              viper.GetBool("add-panic-variants"),
+		AddSoftDeletes:        viper.GetBool("add-soft-deletes"),
+		NoContext:             viper.GetBool("no-context"),
+		NoTests:               viper.GetBool("no-tests"),
+		NoHooks:               viper.GetBool("no-hooks"),
+		NoRowsAffected:        viper.GetBool("no-rows-affected"),
+		NoAutoTimestamps:      viper.GetBool("no-auto-timestamps"),
+		NoDriverTemplates:     viper.GetBool("no-driver-templates"),
+		NoBackReferencing:     viper.GetBool("no-back-referencing"),
+		EmitNameConstants:     viper.GetBool("emit-name-constants"),
+		GenerateIndexMetadata: viper.GetBool("generate-index-metadata"),
+		GenerateInterfaces:    viper.GetBool("generate-interfaces"),
+		GenerateDTOs:          viper.GetBool("generate-dtos"),
+		DTONullStyle:          strings.ToLower(viper.GetString("dto-null-style")), // pointer | null
+		GenerateValidate:      viper.GetBool("generate-validate"),
+		GenerateConstructors:  viper.GetBool("generate-constructors"),
+		GenerateChangesets:    viper.GetBool("generate-changesets"),
+		GenerateDeleteCascade: viper.GetBool("generate-delete-cascade"),
+		GenerateInsertIgnore:  viper.GetBool("generate-insert-ignore"),
+		GenerateSchemaVersion: viper.GetBool("generate-schema-version"),
+		JSONMethods:           viper.GetBool("json-methods"),
+		JSONNullPolicy:        strings.ToLower(viper.GetString("json-null-policy")), // render | omit
+		NullableStyle:         strings.ToLower(viper.GetString("nullable-style")),   // pointers | null
+		NullablePackage:       strings.ToLower(viper.GetString("nullable-package")), // stdlib | volatiletech
+		OrderColumns:          strings.ToLower(viper.GetString("order-columns")),    // alphabetical | ordinal
+		BulkInsertBatchSize:   viper.GetInt("bulk-insert-batch-size"),
+		Wipe:                  viper.GetBool("wipe"),
+		Incremental:           viper.GetBool("incremental"),
+		StructTagCasing:       strings.ToLower(viper.GetString("struct-tag-casing")), // camel | snake | title
+		TagIgnore:             viper.GetStringSlice("tag-ignore"),
+		ReadOnlyTables:        viper.GetStringSlice("read-only-tables"),
+		RelationTag:           viper.GetString("relation-tag"),
+		TemplateDirs:          viper.GetStringSlice("templates"),
+		OverrideTemplateDirs:  viper.GetStringSlice("template-dirs"),
+		Tags:                  viper.GetStringSlice("tag"),
+		Replacements:          viper.GetStringSlice("replace"),
+		Aliases:               boilingcore.ConvertAliases(viper.Get("aliases")),
+		TypeReplaces:          boilingcore.ConvertTypeReplace(viper.Get("types")),
+		Inflections:           viper.GetStringMapString("inflections"),
+		Initialisms:           boilingcore.ConvertInitialisms(viper.Get("initialisms")),
+		StructTagCases:        boilingcore.ConvertStructTagCases(viper.Get("struct-tag-cases")),
+		NameRewrites:          boilingcore.ConvertNameRewrites(viper.Get("name-rewrite")),
+		Embeds:                boilingcore.ConvertEmbeds(viper.Get("embed")),
+		Projections:           boilingcore.ConvertProjections(viper.Get("projection")),
+		Mixins:                boilingcore.ConvertMixins(viper.Get("mixin")),
+		Scanners:              boilingcore.ConvertScanners(viper.Get("scanners")),
+		Version:               sqlBoilerVersion,
 	}
 
 	if cmdConfig.Debug {
@@ -1,8 +1,14 @@
 package queries
 
 import (
+	"bytes"
+	"database/sql/driver"
 	"fmt"
 	"reflect"
+	"sort"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 // NonZeroDefaultSet returns the fields included in the
@@ -11,33 +17,130 @@ func NonZeroDefaultSet(defaults []string, obj interface{}) []string {
 	c := make([]string, 0, len(defaults))
 
 	val := reflect.Indirect(reflect.ValueOf(obj))
-	typ := val.Type()
-	nf := typ.NumField()
 
 	for _, def := range defaults {
-		found := false
-		for i := 0; i < nf; i++ {
-			field := typ.Field(i)
-			name, _ := getBoilTag(field)
+		fieldVal, ok := boilField(val, def)
+		if !ok {
+			panic(fmt.Sprintf("could not find field name %s in type %T", def, obj))
+		}
 
-			if name != def {
-				continue
-			}
+		zero := reflect.Zero(fieldVal.Type())
+		if !reflect.DeepEqual(zero.Interface(), fieldVal.Interface()) {
+			c = append(c, def)
+		}
+	}
 
-			found = true
-			fieldVal := val.Field(i)
+	return c
+}
 
-			zero := reflect.Zero(fieldVal.Type())
-			if !reflect.DeepEqual(zero.Interface(), fieldVal.Interface()) {
-				c = append(c, def)
+// boilField returns the field of the struct val whose boil tag is name,
+// looking inside structs embedded with boil:",inline" as well.
+func boilField(val reflect.Value, name string) (reflect.Value, bool) {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if isInline(field) {
+			if f, ok := boilField(val.Field(i), name); ok {
+				return f, true
 			}
-			break
+			continue
 		}
 
-		if !found {
-			panic(fmt.Sprintf("could not find field name %s in type %T", def, obj))
+		if tag, _ := getBoilTag(field); tag == name {
+			return val.Field(i), true
 		}
 	}
 
-	return c
+	return reflect.Value{}, false
+}
+
+// KeyChunkSize is how many keys of width columns a statement can bind out of
+// limit parameters when the rest of it binds extra ones, at least 1. A limit
+// of 0 or less means there's no limit, and KeyChunkSize returns 0.
+func KeyChunkSize(limit, extra, width int) int {
+	if limit <= 0 {
+		return 0
+	}
+	if width < 1 {
+		width = 1
+	}
+
+	size := (limit - extra) / width
+	if size < 1 {
+		return 1
+	}
+	return size
+}
+
+// UpdateColumnsSorted splits the columns of an UpdateAll into names and
+// args sorted by name, so the statement text is the same on every call and
+// the args line up with positional placeholders.
+func UpdateColumnsSorted(cols map[string]interface{}) ([]string, []interface{}) {
+	names := make([]string, 0, len(cols))
+	for name := range cols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = cols[name]
+	}
+
+	return names, args
+}
+
+// Changes compares the columns of two objects of the same type, ex: a row
+// as it is in the database and as it's about to be updated, and returns the
+// ones that differ. Values are compared as they're sent to the database, a
+// null.String and a *string are null alike.
+func Changes(before, after interface{}, columns []string) boil.Changeset {
+	beforeVal := reflect.Indirect(reflect.ValueOf(before))
+	afterVal := reflect.Indirect(reflect.ValueOf(after))
+
+	var changes boil.Changeset
+	for _, col := range columns {
+		oldVal, ok := boilField(beforeVal, col)
+		if !ok {
+			panic(fmt.Sprintf("could not find field name %s in type %T", col, before))
+		}
+		newVal, ok := boilField(afterVal, col)
+		if !ok {
+			panic(fmt.Sprintf("could not find field name %s in type %T", col, after))
+		}
+
+		oldIface, newIface := oldVal.Interface(), newVal.Interface()
+		if !valuesEqual(oldIface, newIface) {
+			changes = append(changes, boil.Change{Column: col, Old: oldIface, New: newIface})
+		}
+	}
+
+	return changes
+}
+
+// valuesEqual compares two column values by what they send to the database
+func valuesEqual(a, b interface{}) bool {
+	a, b = columnValue(a), columnValue(b)
+
+	switch at := a.(type) {
+	case []byte:
+		bt, ok := b.([]byte)
+		return ok && bytes.Equal(at, bt)
+	case time.Time:
+		bt, ok := b.(time.Time)
+		return ok && at.Equal(bt)
+	}
+
+	return reflect.DeepEqual(upgradeNumericTypes(a), upgradeNumericTypes(b))
+}
+
+// columnValue dereferences pointers and returns the value of valuers
+func columnValue(v interface{}) interface{} {
+	v = derefPointer(v)
+	if valuer, ok := v.(driver.Valuer); ok {
+		if val, err := valuer.Value(); err == nil {
+			return val
+		}
+	}
+	return v
 }
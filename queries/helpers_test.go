@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
 
 type testObj struct {
@@ -65,3 +66,117 @@ func TestNonZeroDefaultSet(t *testing.T) {
 		}
 	}
 }
+
+func TestNonZeroDefaultSetInline(t *testing.T) {
+	t.Parallel()
+
+	type Audit struct {
+		CreatedAt time.Time `boil:"created_at"`
+		UpdatedAt null.Time `boil:"updated_at"`
+	}
+	type Anything struct {
+		ID    int `boil:"id"`
+		Audit `boil:",inline"`
+	}
+
+	obj := Anything{ID: 5, Audit: Audit{CreatedAt: time.Now()}}
+	z := NonZeroDefaultSet([]string{"id", "created_at", "updated_at"}, obj)
+	if want := []string{"id", "created_at"}; !reflect.DeepEqual(want, z) {
+		t.Errorf("mismatch:\nWant: %#v\nGot:  %#v", want, z)
+	}
+}
+
+func TestKeyChunkSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Limit, Extra, Width int
+		Want                int
+	}{
+		{0, 1, 1, 0},
+		{-10, 0, 1, 0},
+		{100, 0, 1, 100},
+		{100, 1, 1, 99},
+		{100, 1, 2, 49},
+		{100, 0, 0, 100},
+		{3, 5, 1, 1},
+	}
+	for _, test := range tests {
+		if got := KeyChunkSize(test.Limit, test.Extra, test.Width); got != test.Want {
+			t.Errorf("%d, %d, %d: want %d, got: %d", test.Limit, test.Extra, test.Width, test.Want, got)
+		}
+	}
+
+	// sp_executesql on mssql takes fewer than 2100 parameters, whatever
+	// else the statement binds
+	mssql := drivers.Dialect{MaxParams: 2100}
+	for width := 1; width <= 3; width++ {
+		for extra := 0; extra <= 2; extra++ {
+			size := KeyChunkSize(mssql.KeyParams(), extra, width)
+			if params := size*width + extra; params >= 2100 {
+				t.Errorf("width %d extra %d: %d keys bind %d parameters", width, extra, size, params)
+			}
+		}
+	}
+
+	// A soft DeleteAllByPK binds the deletion time besides its keys, a chunk
+	// fills the parameters up to the limit and one more key goes over it
+	limit := mssql.KeyParams()
+	for width := 1; width <= 3; width++ {
+		size := KeyChunkSize(limit, 1, width)
+		if params := size*width + 1; params > limit {
+			t.Errorf("width %d: %d keys bind %d parameters, over %d", width, size, params, limit)
+		}
+		if params := (size+1)*width + 1; params <= limit {
+			t.Errorf("width %d: %d keys leave room for another one", width, size)
+		}
+	}
+}
+
+func TestUpdateColumnsSorted(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < 10; i++ {
+		names, args := UpdateColumnsSorted(map[string]interface{}{"c": 3, "a": 1, "d": 4, "b": 2})
+		if !reflect.DeepEqual(names, []string{"a", "b", "c", "d"}) {
+			t.Fatal("want sorted names, got:", names)
+		}
+		if !reflect.DeepEqual(args, []interface{}{1, 2, 3, 4}) {
+			t.Fatal("want args in the order of the names, got:", args)
+		}
+	}
+}
+
+func TestChanges(t *testing.T) {
+	t.Parallel()
+
+	type Anything struct {
+		ID        int         `boil:"id"`
+		Name      string      `boil:"name"`
+		Nick      null.String `boil:"nick"`
+		Email     *string     `boil:"email"`
+		Avatar    []byte      `boil:"avatar"`
+		UpdatedAt time.Time   `boil:"updated_at"`
+	}
+
+	now := time.Now()
+	email := "pat@example.com"
+	before := &Anything{ID: 1, Name: "pat", Avatar: []byte{1}, UpdatedAt: now}
+	after := &Anything{ID: 1, Name: "hat", Nick: null.StringFrom("p"), Avatar: []byte{1}, Email: &email, UpdatedAt: now.UTC()}
+
+	cols := []string{"id", "name", "nick", "email", "avatar", "updated_at"}
+	changes := Changes(before, after, cols)
+	if got := changes.Columns(); !reflect.DeepEqual(got, []string{"name", "nick", "email"}) {
+		t.Fatal("want exactly the modified columns, got:", got)
+	}
+	if c := changes[0]; c.Old != "pat" || c.New != "hat" {
+		t.Errorf("wrong values: %#v", c)
+	}
+	if c := changes[1]; c.Old != (null.String{}) || c.New != null.StringFrom("p") {
+		t.Errorf("wrong values: %#v", c)
+	}
+
+	if changes := Changes(after, after, cols); len(changes) != 0 {
+		t.Error("want no changes, got:", changes)
+	}
+}
@@ -193,6 +193,27 @@ func Distinct(clause string) QueryMod {
 	}
 }
 
+type distinctOnQueryMod struct {
+	columns []string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm distinctOnQueryMod) Apply(q *queries.Query) {
+	queries.SetDistinctOn(q, qm.columns)
+}
+
+// DistinctOn keeps only the first row of each group of rows with the same
+// values in the columns, the order by mods decide which row is first.
+// It's DISTINCT ON where the dialect has it, ex: postgres, and otherwise a
+// subquery numbering the rows of each group with ROW_NUMBER, whose rows are
+// ordered by the columns. Running the query on a dialect with neither
+// returns queries.ErrDistinctOnUnsupported.
+func DistinctOn(columns ...string) QueryMod {
+	return distinctOnQueryMod{
+		columns: columns,
+	}
+}
+
 type withQueryMod struct {
 	clause string
 	args   []interface{}
@@ -440,6 +461,65 @@ func (qm exprMod) Apply(q *queries.Query) {
 	queries.AppendWhereRightParen(q)
 }
 
+type whereExistsQueryMod struct {
+	sub *queries.Query
+	not bool
+}
+
+// Apply implements QueryMod.Apply.
+func (qm whereExistsQueryMod) Apply(q *queries.Query) {
+	queries.AppendWhereExists(q, qm.sub, qm.not)
+}
+
+// WhereExists adds an "EXISTS (subquery)" clause to your where statement, the
+// subquery usually correlates with the outer one through its own where, ex:
+// WhereExists(models.Jets(Where("jets.pilot_id = pilots.id")).Query).
+// The subquery's arguments are placed after those of the mods before it.
+func WhereExists(sub *queries.Query) QueryMod {
+	return whereExistsQueryMod{
+		sub: sub,
+	}
+}
+
+// WhereNotExists adds a "NOT EXISTS (subquery)" clause to your where
+// statement, see WhereExists.
+func WhereNotExists(sub *queries.Query) QueryMod {
+	return whereExistsQueryMod{
+		sub: sub,
+		not: true,
+	}
+}
+
+type unionQueryMod struct {
+	other *queries.Query
+	all   bool
+}
+
+// Apply implements QueryMod.Apply.
+func (qm unionQueryMod) Apply(q *queries.Query) {
+	queries.AppendUnion(q, qm.other, qm.all)
+}
+
+// Union adds the rows of another query to those of the query with UNION,
+// dropping the duplicates, ex: Union(models.ArchivedJets(Where("age > ?", 10)).Query).
+// The rows are bound to the model of the query the mod is applied to, so
+// other must select the same columns. The order by, limit and offset mods
+// of the query sort and limit the whole union, those of other are ignored.
+func Union(other *queries.Query) QueryMod {
+	return unionQueryMod{
+		other: other,
+	}
+}
+
+// UnionAll adds the rows of another query to those of the query with
+// UNION ALL, keeping the duplicates, see Union.
+func UnionAll(other *queries.Query) QueryMod {
+	return unionQueryMod{
+		other: other,
+		all:   true,
+	}
+}
+
 type groupByQueryMod struct {
 	clause string
 }
@@ -554,6 +634,41 @@ func For(clause string) QueryMod {
 	}
 }
 
+type lockForUpdateQueryMod struct{}
+
+// Apply implements QueryMod.Apply.
+func (qm lockForUpdateQueryMod) Apply(q *queries.Query) {
+	queries.SetLockForUpdate(q)
+}
+
+// LockForUpdate locks the selected rows until the end of the transaction,
+// so they can be read and then updated without another transaction changing
+// them in between. It's FOR UPDATE where the dialect has it, ex: postgres,
+// and the UPDLOCK table hint on dialects with table hints (mssql). Running
+// the query on a dialect with neither returns
+// queries.ErrLockForUpdateUnsupported.
+func LockForUpdate() QueryMod {
+	return lockForUpdateQueryMod{}
+}
+
+type tableHintQueryMod struct {
+	hint string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm tableHintQueryMod) Apply(q *queries.Query) {
+	queries.AppendTableHint(q, qm.hint)
+}
+
+// WithTableHint adds a table hint after the tables of the FROM clause,
+// ex: WithTableHint("NOLOCK"). Running the query on a dialect without
+// table hints returns queries.ErrTableHintsUnsupported.
+func WithTableHint(hint string) QueryMod {
+	return tableHintQueryMod{
+		hint: hint,
+	}
+}
+
 type commentQueryMod struct {
 	comment string
 }
@@ -2,7 +2,7 @@ package qmhelper
 
 import (
 	"fmt"
-	"reflect"
+	"strings"
 
 	"github.com/volatiletech/sqlboiler/v4/queries"
 )
@@ -29,7 +29,8 @@ func WhereNullEQ(name string, negated bool, value interface{}) WhereQueryMod {
 	if nullable, ok := value.(Nullable); ok {
 		isNull = nullable.IsZero()
 	} else {
-		isNull = reflect.ValueOf(value).IsNil()
+		// Valuers like sql.NullString are structs and can't be nil
+		isNull = queries.IsNil(value)
 	}
 
 	if isNull {
@@ -63,6 +64,28 @@ func WhereIsNotNull(name string) WhereQueryMod {
 	return WhereQueryMod{Clause: fmt.Sprintf("%s is not null", name)}
 }
 
+// WhereInTuples matches rows whose names columns equal any of the tuples,
+// ex: ((a = ? and b = ?) or (a = ? and b = ?)). It stands in for an IN on
+// a row value for composite keys, which not every database supports.
+func WhereInTuples(names []string, tuples [][]interface{}) WhereQueryMod {
+	if len(tuples) == 0 {
+		return WhereQueryMod{Clause: "1=0"}
+	}
+
+	group := "(" + strings.Join(names, " = ? and ") + " = ?)"
+	groups := make([]string, len(tuples))
+	args := make([]interface{}, 0, len(tuples)*len(names))
+	for i, tuple := range tuples {
+		groups[i] = group
+		args = append(args, tuple...)
+	}
+
+	return WhereQueryMod{
+		Clause: "(" + strings.Join(groups, " or ") + ")",
+		Args:   args,
+	}
+}
+
 type operator string
 
 // Supported operations
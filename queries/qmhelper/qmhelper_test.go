@@ -0,0 +1,53 @@
+package qmhelper
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+func TestWhereInTuples(t *testing.T) {
+	t.Parallel()
+
+	mod := WhereInTuples([]string{"region", "number"}, [][]interface{}{{"eu", 1}, {"us", 2}})
+	if want := "((region = ? and number = ?) or (region = ? and number = ?))"; mod.Clause != want {
+		t.Errorf("want clause %q, got: %q", want, mod.Clause)
+	}
+	if want := []interface{}{"eu", 1, "us", 2}; !reflect.DeepEqual(mod.Args, want) {
+		t.Errorf("want args %v, got: %v", want, mod.Args)
+	}
+
+	if mod = WhereInTuples([]string{"region", "number"}, nil); mod.Clause != "1=0" || len(mod.Args) != 0 {
+		t.Errorf("want no rows matched without tuples, got: %#v", mod)
+	}
+}
+
+func TestWhereNullChecks(t *testing.T) {
+	t.Parallel()
+
+	var nick *string
+	name := "Larry"
+	tests := []struct {
+		Mod    WhereQueryMod
+		Clause string
+		Args   []interface{}
+	}{
+		{WhereIsNull(`"pilots"."nick"`), `"pilots"."nick" is null`, nil},
+		{WhereIsNotNull(`"pilots"."nick"`), `"pilots"."nick" is not null`, nil},
+		// Equality with a null value must not become = NULL, which is never true
+		{WhereNullEQ(`"pilots"."nick"`, false, nick), `"pilots"."nick" is null`, nil},
+		{WhereNullEQ(`"pilots"."nick"`, true, nick), `"pilots"."nick" is not null`, nil},
+		{WhereNullEQ(`"pilots"."nick"`, false, &name), `"pilots"."nick" = ?`, []interface{}{&name}},
+		{WhereNullEQ(`"pilots"."nick"`, false, sql.NullString{}), `"pilots"."nick" is null`, nil},
+		{WhereNullEQ(`"pilots"."nick"`, false, sql.NullString{String: name, Valid: true}), `"pilots"."nick" = ?`, []interface{}{sql.NullString{String: name, Valid: true}}},
+	}
+
+	for i, test := range tests {
+		if test.Mod.Clause != test.Clause {
+			t.Errorf("%d) want clause %q, got: %q", i, test.Clause, test.Mod.Clause)
+		}
+		if !reflect.DeepEqual(test.Mod.Args, test.Args) {
+			t.Errorf("%d) want args %v, got: %v", i, test.Args, test.Mod.Args)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -43,8 +44,13 @@ type Query struct {
 	limit      int
 	offset     int
 	forlock    string
+	forUpdate  bool
 	distinct   string
+	distinctOn []string
 	comment    string
+	output     []string
+	tableHints []string
+	unions     []union
 }
 
 // Applicator exists only to allow
@@ -88,12 +94,37 @@ type rawSQL struct {
 	args []interface{}
 }
 
+type union struct {
+	all   bool
+	query *Query
+}
+
 type join struct {
 	kind   joinKind
 	clause string
 	args   []interface{}
 }
 
+// ErrTableHintsUnsupported is returned when a query with table hints is
+// run against a dialect without UseTableHints.
+var ErrTableHintsUnsupported = errors.New("sqlboiler: table hints are not supported by this dialect")
+
+// ErrDistinctOnUnsupported is returned when a query with qm.DistinctOn is run
+// against a dialect with neither DISTINCT ON nor window functions.
+var ErrDistinctOnUnsupported = errors.New("sqlboiler: distinct on is not supported by this dialect")
+
+// ErrPageOrderRequired is returned when paging a query without an order on a
+// dialect paging with OFFSET ... FETCH, which needs an ORDER BY.
+var ErrPageOrderRequired = errors.New("sqlboiler: paging requires at least one order by column on this dialect")
+
+// ErrLockForUpdateUnsupported is returned when a query with qm.LockForUpdate
+// is run against a dialect with neither UseForUpdate nor UseTableHints.
+var ErrLockForUpdateUnsupported = errors.New("sqlboiler: locking rows for update is not supported by this dialect")
+
+// ErrUnionColumnCount is returned when a query and a query unioned with it
+// both select columns by name, but not as many of them.
+var ErrUnionColumnCount = errors.New("sqlboiler: queries of a union must select the same number of columns")
+
 // Raw makes a raw query, usually for use with bind
 func Raw(query string, args ...interface{}) *Query {
 	return &Query{
@@ -111,6 +142,10 @@ func RawG(query string, args ...interface{}) *Query {
 
 // Exec executes a query that does not need a row returned
 func (q *Query) Exec(exec boil.Executor) (sql.Result, error) {
+	if err := q.checkDialect(); err != nil {
+		return nil, err
+	}
+
 	qs, args := BuildQuery(q)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
@@ -131,6 +166,10 @@ func (q *Query) QueryRow(exec boil.Executor) *sql.Row {
 
 // Query executes the query for the All finisher and returns multiple rows
 func (q *Query) Query(exec boil.Executor) (*sql.Rows, error) {
+	if err := q.checkDialect(); err != nil {
+		return nil, err
+	}
+
 	qs, args := BuildQuery(q)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
@@ -141,13 +180,17 @@ func (q *Query) Query(exec boil.Executor) (*sql.Rows, error) {
 
 // ExecContext executes a query that does not need a row returned
 func (q *Query) ExecContext(ctx context.Context, exec boil.ContextExecutor) (sql.Result, error) {
+	if err := q.checkDialect(); err != nil {
+		return nil, err
+	}
+
 	qs, args := BuildQuery(q)
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
 		fmt.Fprintln(writer, args)
 	}
-	return exec.ExecContext(ctx, qs, args...)
+	return boil.ExecContext(ctx, exec, qs, args...)
 }
 
 // QueryRowContext executes the query for the One finisher and returns a row
@@ -158,18 +201,44 @@ func (q *Query) QueryRowContext(ctx context.Context, exec boil.ContextExecutor)
 		fmt.Fprintln(writer, qs)
 		fmt.Fprintln(writer, args)
 	}
-	return exec.QueryRowContext(ctx, qs, args...)
+	return boil.QueryRowContext(ctx, exec, qs, args...)
 }
 
 // QueryContext executes the query for the All finisher and returns multiple rows
 func (q *Query) QueryContext(ctx context.Context, exec boil.ContextExecutor) (*sql.Rows, error) {
+	if err := q.checkDialect(); err != nil {
+		return nil, err
+	}
+
 	qs, args := BuildQuery(q)
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
 		fmt.Fprintln(writer, args)
 	}
-	return exec.QueryContext(ctx, qs, args...)
+	return boil.QueryContext(ctx, exec, qs, args...)
+}
+
+// checkDialect returns an error for mods the query's dialect can't build, and
+// for unions that can't be built at all. QueryRow can't return one, there the
+// unsupported parts are left out.
+func (q *Query) checkDialect() error {
+	if len(q.tableHints) != 0 && q.dialect != nil && !q.dialect.UseTableHints {
+		return ErrTableHintsUnsupported
+	}
+	if len(q.distinctOn) != 0 && q.dialect != nil && !q.dialect.UseDistinctOn && !q.dialect.UseWindowFunctions {
+		return ErrDistinctOnUnsupported
+	}
+	if q.forUpdate && q.dialect != nil && !q.dialect.UseForUpdate && !q.dialect.UseTableHints {
+		return ErrLockForUpdateUnsupported
+	}
+	for _, u := range q.unions {
+		if len(q.selectCols) != 0 && len(u.query.selectCols) != 0 && len(q.selectCols) != len(u.query.selectCols) {
+			return ErrUnionColumnCount
+		}
+	}
+
+	return nil
 }
 
 // ExecP executes a query that does not need a row returned
@@ -246,6 +315,12 @@ func SetDistinct(q *Query, distinct string) {
 	q.distinct = distinct
 }
 
+// SetDistinctOn on the query, the columns to keep the first row of each
+// group of.
+func SetDistinctOn(q *Query, columns []string) {
+	q.distinctOn = columns
+}
+
 // SetCount on the query.
 func SetCount(q *Query) {
 	q.count = true
@@ -266,16 +341,56 @@ func SetOffset(q *Query, offset int) {
 	q.offset = offset
 }
 
+// SetPage limits the query to page pageNum, counting from 1, of pageSize
+// rows ordered by the order clauses. Dialects using OFFSET ... FETCH need a
+// stable order to page through, so without one ErrPageOrderRequired is
+// returned for them.
+func SetPage(q *Query, pageNum, pageSize int, order []string) error {
+	if pageNum < 1 || pageSize < 1 {
+		return fmt.Errorf("sqlboiler: invalid page %d of size %d, both must be at least 1", pageNum, pageSize)
+	}
+	if len(order) == 0 && q.dialect != nil && q.dialect.UseTopClause {
+		return ErrPageOrderRequired
+	}
+
+	for _, o := range order {
+		AppendOrderBy(q, o)
+	}
+	q.limit = pageSize
+	q.offset = (pageNum - 1) * pageSize
+
+	return nil
+}
+
 // SetFor on the query.
 func SetFor(q *Query, clause string) {
 	q.forlock = clause
 }
 
+// SetLockForUpdate on the query, the selected rows are locked until the end
+// of the transaction the way the dialect does it: FOR UPDATE, or the UPDLOCK
+// table hint for dialects with UseTableHints. A For clause takes precedence.
+func SetLockForUpdate(q *Query) {
+	q.forUpdate = true
+}
+
 // SetComment on the query.
 func SetComment(q *Query, comment string) {
 	q.comment = comment
 }
 
+// SetOutput on the query, returns the given columns of the deleted or
+// updated rows with an OUTPUT clause. Only for dialects with UseOutputClause.
+func SetOutput(q *Query, columns ...string) {
+	q.output = columns
+}
+
+// AppendTableHint on the query, ex: NOLOCK. Only for dialects with
+// UseTableHints.
+func AppendTableHint(q *Query, hint string) {
+	q.tableHints = append(q.tableHints, hint)
+}
+
 // SetUpdate on the query.
 func SetUpdate(q *Query, cols map[string]interface{}) {
 	q.update = cols
@@ -326,6 +441,19 @@ func AppendWhere(q *Query, clause string, args ...interface{}) {
 	q.where = append(q.where, where{clause: clause, args: args})
 }
 
+// AppendWhereExists on the query, an EXISTS predicate of the subquery or a
+// NOT EXISTS one when not is set. The subquery is built when it's appended,
+// so it must be complete by then.
+func AppendWhereExists(q *Query, sub *Query, not bool) {
+	clause, args := buildSubquery(q, sub)
+	if not {
+		clause = "NOT EXISTS (" + clause + ")"
+	} else {
+		clause = "EXISTS (" + clause + ")"
+	}
+	q.where = append(q.where, where{clause: clause, args: args})
+}
+
 // AppendIn on the query.
 func AppendIn(q *Query, clause string, args ...interface{}) {
 	q.where = append(q.where, where{kind: whereKindIn, clause: clause, args: args})
@@ -396,3 +524,11 @@ func AppendOrderBy(q *Query, clause string, args ...interface{}) {
 func AppendWith(q *Query, clause string, args ...interface{}) {
 	q.withs = append(q.withs, argClause{clause: clause, args: args})
 }
+
+// AppendUnion on the query, the rows of other are added to its own with
+// UNION, or UNION ALL to keep the duplicates. Only the select, joins, where,
+// group by and having of other are used, the order by, limit, offset and for
+// mods of the query apply to the rows of the whole union.
+func AppendUnion(q *Query, other *Query, all bool) {
+	q.unions = append(q.unions, union{all: all, query: other})
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/volatiletech/sqlboiler/v4/drivers"
 	"github.com/volatiletech/strmangle"
 )
 
@@ -51,6 +52,160 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	writeComment(q, buf)
 	writeCTEs(q, buf, &args)
 
+	// Dialects without DISTINCT ON or window functions leave it out, running
+	// the query returns ErrDistinctOnUnsupported instead
+	distinctOn := len(q.distinctOn) != 0 && (q.dialect.UseDistinctOn || q.dialect.UseWindowFunctions)
+	switch {
+	case len(q.unions) != 0:
+		writeUnion(q, buf, &args)
+	case distinctOn && (q.count || !q.dialect.UseDistinctOn):
+		writeDistinctOnSubquery(q, buf, &args)
+	default:
+		writeSelect(q, buf, &args, "")
+		writeModifiers(q, buf, &args)
+	}
+
+	buf.WriteByte(';')
+	return buf, args
+}
+
+// writeDistinctOnSubquery selects from a subquery for qm.DistinctOn. Counting
+// counts the rows of the subquery. Without DISTINCT ON the subquery numbers
+// the rows of each group by the order by mods instead, and only the first
+// row of each is selected, ordered by the distinct on columns.
+func writeDistinctOnSubquery(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	inner, outer := *q, *q
+	inner.count = false
+	outer.groupBy, outer.having, outer.orderBy = nil, nil, nil
+
+	rowNumber := ""
+	if !q.dialect.UseDistinctOn {
+		inner.orderBy, inner.limit, inner.offset, inner.forlock = nil, 0, 0, ""
+		if !q.count {
+			for _, col := range q.distinctOn {
+				outer.orderBy = append(outer.orderBy, argClause{clause: distinctOnColumn(q.dialect, col)})
+			}
+		}
+	} else {
+		outer.limit, outer.offset, outer.forlock, outer.forUpdate = 0, 0, "", false
+	}
+
+	buf.WriteString("SELECT ")
+	if q.dialect.UseTopClause && outer.limit != 0 && outer.offset == 0 {
+		fmt.Fprintf(buf, " TOP (%d) ", outer.limit)
+	}
+	switch {
+	case q.count && q.dialect.UseCountBig:
+		buf.WriteString("COUNT_BIG(*)")
+	case q.count:
+		buf.WriteString("COUNT(*)")
+	default:
+		buf.WriteByte('*')
+	}
+	buf.WriteString(" FROM (")
+
+	if !q.dialect.UseDistinctOn {
+		over := strmangle.GetBuffer()
+		partition := strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.distinctOn)
+		fmt.Fprintf(over, "ROW_NUMBER() OVER (PARTITION BY %s", strings.Join(partition, ", "))
+		if len(q.orderBy) != 0 {
+			writeParameterizedModifiers(q, over, args, " ORDER BY ", ", ", q.orderBy)
+		} else {
+			over.WriteString(" ORDER BY (SELECT NULL)")
+		}
+		fmt.Fprintf(over, ") AS %s", strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, distinctOnRowNumber))
+		rowNumber = over.String()
+		strmangle.PutBuffer(over)
+	}
+
+	writeSelect(&inner, buf, args, rowNumber)
+	writeModifiers(&inner, buf, args)
+
+	fmt.Fprintf(buf, ") AS %s", strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, distinctOnAlias))
+	if !q.dialect.UseDistinctOn {
+		fmt.Fprintf(buf, " WHERE %s = 1", distinctOnColumn(q.dialect, distinctOnRowNumber))
+	}
+
+	writeModifiers(&outer, buf, args)
+}
+
+// writeUnion writes the query and the queries unioned with it, followed by
+// the order by, limit, offset and for of the query which apply to the whole
+// union. Counting counts the rows of the union as a subquery.
+func writeUnion(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	if q.count {
+		if q.dialect.UseCountBig {
+			buf.WriteString("SELECT COUNT_BIG(*) FROM (")
+		} else {
+			buf.WriteString("SELECT COUNT(*) FROM (")
+		}
+	}
+
+	members := make([]*Query, 0, len(q.unions)+1)
+	members = append(members, q)
+	for _, u := range q.unions {
+		members = append(members, u.query)
+	}
+	for i, m := range members {
+		if i > 0 {
+			if q.unions[i-1].all {
+				buf.WriteString(" UNION ALL ")
+			} else {
+				buf.WriteString(" UNION ")
+			}
+		}
+
+		member := *m
+		member.dialect = q.dialect
+		member.count = false
+		member.orderBy, member.limit, member.offset, member.forlock = nil, 0, 0, ""
+		writeSelect(&member, buf, args, "")
+		writeModifiers(&member, buf, args)
+	}
+
+	if q.count {
+		fmt.Fprintf(buf, ") AS %s", strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, unionAlias))
+		return
+	}
+
+	tail := Query{dialect: q.dialect, orderBy: q.orderBy, limit: q.limit, offset: q.offset, forlock: q.forlock}
+	if q.dialect.UseTopClause && q.limit != 0 && q.offset == 0 {
+		// TOP would only limit the first query, so the whole union is limited
+		// with OFFSET ... FETCH from the start instead
+		if len(q.orderBy) != 0 {
+			writeParameterizedModifiers(q, buf, args, " ORDER BY ", ", ", q.orderBy)
+		} else {
+			buf.WriteString(" ORDER BY (SELECT NULL)")
+		}
+		fmt.Fprintf(buf, " OFFSET 0 ROWS FETCH NEXT %d ROWS ONLY", q.limit)
+		tail.orderBy, tail.limit = nil, 0
+	}
+	writeModifiers(&tail, buf, args)
+}
+
+// unionAlias names the subquery counting the rows of a union
+const unionAlias = "union_count"
+
+// distinctOnAlias and distinctOnRowNumber name the subquery of qm.DistinctOn
+// and the column numbering the rows of each group
+const (
+	distinctOnAlias     = "distinct_on"
+	distinctOnRowNumber = "distinct_on_row"
+)
+
+// distinctOnColumn is the column of the distinct on subquery for col, which
+// may be qualified by the table it was selected from
+func distinctOnColumn(dialect *drivers.Dialect, col string) string {
+	if i := strings.LastIndexByte(col, '.'); i >= 0 {
+		col = col[i+1:]
+	}
+	col = strings.Trim(col, string([]rune{dialect.LQ, dialect.RQ}))
+	return strmangle.IdentQuote(dialect.LQ, dialect.RQ, distinctOnAlias+"."+col)
+}
+
+// writeSelect writes the SELECT of the query up to its WHERE clause, with
+// extraCol added to the selected columns when it isn't empty
+func writeSelect(q *Query, buf *bytes.Buffer, args *[]interface{}, extraCol string) {
 	buf.WriteString("SELECT ")
 
 	if q.dialect.UseTopClause {
@@ -59,7 +214,13 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 		}
 	}
 
-	if q.count {
+	if len(q.distinctOn) != 0 && q.dialect.UseDistinctOn {
+		fmt.Fprintf(buf, "DISTINCT ON (%s) ", strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.distinctOn), ", "))
+	}
+
+	if q.count && q.dialect.UseCountBig {
+		buf.WriteString("COUNT_BIG(")
+	} else if q.count {
 		buf.WriteString("COUNT(")
 	}
 
@@ -88,15 +249,31 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 		buf.WriteByte('*')
 	}
 
+	if len(extraCol) != 0 {
+		buf.WriteString(", ")
+		buf.WriteString(extraCol)
+	}
+
 	// close SQL COUNT function
 	if q.count {
 		buf.WriteByte(')')
 	}
 
-	fmt.Fprintf(buf, " FROM %s", strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
+	from := strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from)
+	tableHints := q.tableHints
+	if q.forUpdate && !q.dialect.UseForUpdate && !hasTableHint(tableHints, "UPDLOCK") {
+		tableHints = append(append([]string(nil), tableHints...), "UPDLOCK")
+	}
+	if len(tableHints) != 0 && q.dialect.UseTableHints {
+		hints := fmt.Sprintf(" WITH (%s)", strings.Join(tableHints, ", "))
+		for i := range from {
+			from[i] += hints
+		}
+	}
+	fmt.Fprintf(buf, " FROM %s", strings.Join(from, ", "))
 
 	if len(q.joins) > 0 {
-		argsLen := len(args)
+		argsLen := len(*args)
 		joinBuf := strmangle.GetBuffer()
 		for _, j := range q.joins {
 			switch j.kind {
@@ -111,7 +288,7 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 			default:
 				panic(fmt.Sprintf("Unsupported join of kind %v", j.kind))
 			}
-			args = append(args, j.args...)
+			*args = append(*args, j.args...)
 		}
 		var resp string
 		if q.dialect.UseIndexPlaceholders {
@@ -123,16 +300,11 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 		strmangle.PutBuffer(joinBuf)
 	}
 
-	where, whereArgs := whereClause(q, len(args)+1)
+	where, whereArgs := whereClause(q, len(*args)+1)
 	buf.WriteString(where)
 	if len(whereArgs) != 0 {
-		args = append(args, whereArgs...)
+		*args = append(*args, whereArgs...)
 	}
-
-	writeModifiers(q, buf, &args)
-
-	buf.WriteByte(';')
-	return buf, args
 }
 
 func buildDeleteQuery(q *Query) (*bytes.Buffer, []interface{}) {
@@ -144,6 +316,7 @@ func buildDeleteQuery(q *Query) (*bytes.Buffer, []interface{}) {
 
 	buf.WriteString("DELETE FROM ")
 	buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
+	writeOutput(q, buf, "DELETED")
 
 	where, whereArgs := whereClause(q, 1)
 	if len(whereArgs) != 0 {
@@ -188,6 +361,7 @@ func buildUpdateQuery(q *Query) (*bytes.Buffer, []interface{}) {
 		setSlice[index] = fmt.Sprintf("%s = %s", col, strmangle.Placeholders(q.dialect.UseIndexPlaceholders, 1, index+1, 1))
 	}
 	fmt.Fprintf(buf, " SET %s", strings.Join(setSlice, ", "))
+	writeOutput(q, buf, "INSERTED")
 
 	where, whereArgs := whereClause(q, len(args)+1)
 	if len(whereArgs) != 0 {
@@ -278,9 +452,21 @@ func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 
 	if len(q.forlock) != 0 {
 		fmt.Fprintf(buf, " FOR %s", q.forlock)
+	} else if q.forUpdate && q.dialect.UseForUpdate && !q.count {
+		buf.WriteString(" FOR UPDATE")
 	}
 }
 
+func hasTableHint(hints []string, hint string) bool {
+	for _, h := range hints {
+		if strings.EqualFold(h, hint) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func writeStars(q *Query) []string {
 	cols := make([]string, len(q.from))
 	for i, f := range q.from {
@@ -476,6 +662,30 @@ ManualParen:
 	return buf.String(), args
 }
 
+// buildSubquery builds sub to be embedded in a clause of q. It's built with
+// ? placeholders, which number on from the arguments before it once q is
+// built, and in sub's dialect or q's when it has none. Raw subqueries are
+// used as they are and must use ? placeholders too.
+func buildSubquery(q, sub *Query) (string, []interface{}) {
+	if len(sub.rawSQL.sql) != 0 {
+		return strings.TrimSuffix(strings.TrimSpace(sub.rawSQL.sql), ";"), sub.rawSQL.args
+	}
+
+	var dialect drivers.Dialect
+	if sub.dialect != nil {
+		dialect = *sub.dialect
+	} else if q.dialect != nil {
+		dialect = *q.dialect
+	}
+	dialect.UseIndexPlaceholders = false
+
+	// Build a copy, BuildQuery caches the query it builds on sub
+	subCopy := *sub
+	subCopy.dialect = &dialect
+	clause, args := BuildQuery(&subCopy)
+	return strings.TrimSuffix(clause, ";"), args
+}
+
 // convertInQuestionMarks finds the first unescaped occurrence of ? and swaps it
 // with a list of numbered placeholders, starting at startAt.
 // It uses groupAt to determine how many placeholders should be in each group,
@@ -589,6 +799,20 @@ func parseFromClause(toks []string) (alias, name string, ok bool) {
 	return alias, name, ok
 }
 
+// writeOutput writes an OUTPUT clause reading the columns from the given
+// pseudo table, ex: DELETED for a delete.
+func writeOutput(q *Query, buf *bytes.Buffer, table string) {
+	if len(q.output) == 0 {
+		return
+	}
+
+	cols := make([]string, len(q.output))
+	for i, c := range q.output {
+		cols[i] = table + "." + strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, c)
+	}
+	fmt.Fprintf(buf, " OUTPUT %s", strings.Join(cols, ", "))
+}
+
 func writeComment(q *Query, buf *bytes.Buffer) {
 	if len(q.comment) == 0 {
 		return
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -155,6 +156,329 @@ func TestBuildQuery(t *testing.T) {
 	}
 }
 
+func TestBuildQueryCountBig(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{from: []string{"t"}, count: true}
+	q.dialect = &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true}
+	if out, _ := BuildQuery(q); out != "SELECT COUNT(*) FROM [t];" {
+		t.Error("want COUNT, got:", out)
+	}
+
+	q = &Query{from: []string{"t"}, count: true}
+	q.dialect = &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseCountBig: true}
+	if out, _ := BuildQuery(q); out != "SELECT COUNT_BIG(*) FROM [t];" {
+		t.Error("want COUNT_BIG, got:", out)
+	}
+}
+
+func TestBuildQueryOutput(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseOutputClause: true}
+
+	q := &Query{from: []string{"t"}, delete: true, output: []string{"a", "b"}}
+	q.dialect = dialect
+	AppendWhere(q, "x = ?", 1)
+	if out, _ := BuildQuery(q); out != "DELETE FROM [t] OUTPUT DELETED.[a], DELETED.[b] WHERE (x = $1);" {
+		t.Error("want OUTPUT DELETED, got:", out)
+	}
+
+	q = &Query{from: []string{"t"}, update: map[string]interface{}{"deleted_at": 1}, output: []string{"a"}}
+	q.dialect = dialect
+	if out, _ := BuildQuery(q); out != "UPDATE [t] SET [deleted_at] = $1 OUTPUT INSERTED.[a];" {
+		t.Error("want OUTPUT INSERTED, got:", out)
+	}
+}
+
+func TestBuildQueryUpdatePositional(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < 10; i++ {
+		q := &Query{from: []string{"t"}, update: map[string]interface{}{"c": 3, "a": 1, "d": 4, "b": 2}}
+		q.dialect = &drivers.Dialect{LQ: '[', RQ: ']'}
+		AppendWhere(q, "id = ?", 5)
+
+		out, args := BuildQuery(q)
+		if out != "UPDATE [t] SET [a] = ?, [b] = ?, [c] = ?, [d] = ? WHERE (id = ?);" {
+			t.Fatal("want sorted columns, got:", out)
+		}
+		if !reflect.DeepEqual(args, []interface{}{1, 2, 3, 4, 5}) {
+			t.Fatal("want args lined up with the placeholders, got:", args)
+		}
+	}
+}
+
+func TestBuildQueryTableHint(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{from: []string{"orders"}}
+	q.dialect = &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTableHints: true}
+	AppendTableHint(q, "NOLOCK")
+	AppendWhere(q, "id = ?", 1)
+	if out, _ := BuildQuery(q); out != "SELECT * FROM [orders] WITH (NOLOCK) WHERE (id = $1);" {
+		t.Error("want the hint after the table, got:", out)
+	}
+
+	q = &Query{from: []string{"t"}}
+	q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	AppendTableHint(q, "NOLOCK")
+	if out, _ := BuildQuery(q); out != `SELECT * FROM "t";` {
+		t.Error("want no hint, got:", out)
+	}
+	if _, err := q.Query(nil); err != ErrTableHintsUnsupported {
+		t.Error("want unsupported error, got:", err)
+	}
+}
+
+func TestBuildQueryLockForUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Dialect *drivers.Dialect
+		Hints   []string
+		Want    string
+	}{
+		{&drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseForUpdate: true}, nil,
+			`SELECT * FROM "pilots" WHERE (id = $1) FOR UPDATE;`},
+		{&drivers.Dialect{LQ: '`', RQ: '`', UseForUpdate: true}, nil,
+			"SELECT * FROM `pilots` WHERE (id = ?) FOR UPDATE;"},
+		{&drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTableHints: true}, nil,
+			"SELECT * FROM [pilots] WITH (UPDLOCK) WHERE (id = $1);"},
+		{&drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTableHints: true}, []string{"ROWLOCK"},
+			"SELECT * FROM [pilots] WITH (ROWLOCK, UPDLOCK) WHERE (id = $1);"},
+		{&drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTableHints: true}, []string{"updlock"},
+			"SELECT * FROM [pilots] WITH (updlock) WHERE (id = $1);"},
+	}
+
+	for i, test := range tests {
+		q := &Query{dialect: test.Dialect, from: []string{"pilots"}}
+		for _, h := range test.Hints {
+			AppendTableHint(q, h)
+		}
+		AppendWhere(q, "id = ?", 1)
+		SetLockForUpdate(q)
+
+		if out, _ := BuildQuery(q); out != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, out)
+		}
+		if err := q.checkDialect(); err != nil {
+			t.Errorf("%d) want no error, got: %v", i, err)
+		}
+		if len(test.Hints) != 0 && len(q.tableHints) != len(test.Hints) {
+			t.Errorf("%d) want the query's hints untouched, got: %v", i, q.tableHints)
+		}
+	}
+
+	// An explicit For clause wins, counting doesn't lock
+	q := &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseForUpdate: true}, from: []string{"pilots"}}
+	SetLockForUpdate(q)
+	SetFor(q, "UPDATE NOWAIT")
+	if out, _ := BuildQuery(q); out != `SELECT * FROM "pilots" FOR UPDATE NOWAIT;` {
+		t.Error("want the for clause, got:", out)
+	}
+	q = &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseForUpdate: true}, from: []string{"pilots"}, count: true}
+	SetLockForUpdate(q)
+	if out, _ := BuildQuery(q); out != `SELECT COUNT(*) FROM "pilots";` {
+		t.Error("want no lock on a count, got:", out)
+	}
+
+	q = &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, from: []string{"pilots"}}
+	SetLockForUpdate(q)
+	if out, _ := BuildQuery(q); out != `SELECT * FROM "pilots";` {
+		t.Error("want no lock, got:", out)
+	}
+	if _, err := q.Query(nil); err != ErrLockForUpdateUnsupported {
+		t.Error("want unsupported error, got:", err)
+	}
+}
+
+func TestBuildQueryDistinctOn(t *testing.T) {
+	t.Parallel()
+
+	psql := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseDistinctOn: true, UseWindowFunctions: true}
+	mssql := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true, UseCountBig: true, UseWindowFunctions: true}
+
+	build := func(dialect *drivers.Dialect, count bool) (string, []interface{}) {
+		q := &Query{from: []string{"flights"}, dialect: dialect, count: count, limit: 10}
+		SetDistinctOn(q, []string{"flights.pilot_id"})
+		AppendWhere(q, "cancelled = ?", false)
+		AppendOrderBy(q, "flights.departed_at DESC")
+		AppendOrderBy(q, "abs(delay - ?)", 5)
+		return BuildQuery(q)
+	}
+
+	tests := []struct {
+		Dialect *drivers.Dialect
+		Count   bool
+		Want    string
+		Args    []interface{}
+	}{
+		{psql, false,
+			`SELECT DISTINCT ON ("flights"."pilot_id") * FROM "flights" WHERE (cancelled = $1) ORDER BY flights.departed_at DESC, abs(delay - $2) LIMIT 10;`,
+			[]interface{}{false, 5}},
+		{psql, true,
+			`SELECT COUNT(*) FROM (SELECT DISTINCT ON ("flights"."pilot_id") * FROM "flights" WHERE (cancelled = $1) ORDER BY flights.departed_at DESC, abs(delay - $2) LIMIT 10) AS "distinct_on";`,
+			[]interface{}{false, 5}},
+		{mssql, false,
+			"SELECT  TOP (10) * FROM (SELECT *, ROW_NUMBER() OVER (PARTITION BY [flights].[pilot_id] ORDER BY flights.departed_at DESC, abs(delay - $1)) AS [distinct_on_row] FROM [flights] WHERE (cancelled = $2)) AS [distinct_on] WHERE [distinct_on].[distinct_on_row] = 1 ORDER BY [distinct_on].[pilot_id];",
+			[]interface{}{5, false}},
+		{mssql, true,
+			"SELECT  TOP (10) COUNT_BIG(*) FROM (SELECT *, ROW_NUMBER() OVER (PARTITION BY [flights].[pilot_id] ORDER BY flights.departed_at DESC, abs(delay - $1)) AS [distinct_on_row] FROM [flights] WHERE (cancelled = $2)) AS [distinct_on] WHERE [distinct_on].[distinct_on_row] = 1;",
+			[]interface{}{5, false}},
+	}
+
+	for i, test := range tests {
+		out, args := build(test.Dialect, test.Count)
+		if out != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, out)
+		}
+		if !reflect.DeepEqual(args, test.Args) {
+			t.Errorf("%d) want args %v, got %v", i, test.Args, args)
+		}
+	}
+
+	q := &Query{from: []string{"flights"}, dialect: &drivers.Dialect{LQ: '`', RQ: '`'}}
+	SetDistinctOn(q, []string{"pilot_id"})
+	if out, _ := BuildQuery(q); out != "SELECT * FROM `flights`;" {
+		t.Error("want no distinct on, got:", out)
+	}
+	if _, err := q.Query(nil); err != ErrDistinctOnUnsupported {
+		t.Error("want unsupported error, got:", err)
+	}
+}
+
+func TestBuildQueryPage(t *testing.T) {
+	t.Parallel()
+
+	mssql := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}
+
+	q := &Query{from: []string{"t"}, dialect: mssql}
+	if err := SetPage(q, 2, 10, []string{"name", "id DESC"}); err != nil {
+		t.Fatal(err)
+	}
+	if out, _ := BuildQuery(q); out != "SELECT * FROM [t] ORDER BY name, id DESC OFFSET 10 ROWS FETCH NEXT 10 ROWS ONLY;" {
+		t.Error("want the second page, got:", out)
+	}
+
+	q = &Query{from: []string{"t"}, dialect: mssql}
+	if err := SetPage(q, 1, 10, []string{"name"}); err != nil {
+		t.Fatal(err)
+	}
+	if out, _ := BuildQuery(q); out != "SELECT  TOP (10) * FROM [t] ORDER BY name;" {
+		t.Error("want the first page, got:", out)
+	}
+
+	q = &Query{from: []string{"t"}, dialect: mssql}
+	if err := SetPage(q, 1, 10, nil); err != ErrPageOrderRequired {
+		t.Error("want the order required error, got:", err)
+	}
+	if err := SetPage(q, 0, 10, []string{"name"}); err == nil {
+		t.Error("want an error for page 0")
+	}
+
+	q = &Query{from: []string{"t"}, dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}}
+	if err := SetPage(q, 3, 5, nil); err != nil {
+		t.Fatal(err)
+	}
+	if out, _ := BuildQuery(q); out != `SELECT * FROM "t" LIMIT 5 OFFSET 10;` {
+		t.Error("want the third page without an order, got:", out)
+	}
+}
+
+func TestBuildQueryWhereExists(t *testing.T) {
+	t.Parallel()
+
+	mssql := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}
+
+	sub := &Query{dialect: mssql, selectCols: []string{"jets.id"}, from: []string{"jets"}}
+	AppendWhere(sub, "jets.pilot_id = pilots.id")
+	AppendWhere(sub, "jets.age > ?", 10)
+	AppendIn(sub, "jets.color IN ?", "red", "blue")
+
+	q := &Query{dialect: mssql, from: []string{"pilots"}}
+	AppendWhere(q, "pilots.name = ?", "Larry")
+	AppendWhereExists(q, sub, false)
+	AppendWhere(q, "pilots.rank < ?", 3)
+
+	out, args := BuildQuery(q)
+	// The outer query numbers the placeholders of the subquery with its own
+	want := "SELECT * FROM [pilots] WHERE (pilots.name = $1) AND " +
+		"(EXISTS (SELECT [jets].[id] FROM [jets] WHERE (jets.pilot_id = pilots.id) AND (jets.age > $2) AND ([jets].[color] IN ($3,$4)))) AND " +
+		"(pilots.rank < $5);"
+	if out != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, out)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"Larry", 10, "red", "blue", 3}) {
+		t.Errorf("wrong argument order: %v", args)
+	}
+
+	// The subquery can still be built on its own
+	if out, _ := BuildQuery(sub); !strings.Contains(out, "jets.age > $1") {
+		t.Error("want the subquery untouched, got:", out)
+	}
+
+	q = &Query{dialect: &drivers.Dialect{LQ: '`', RQ: '`'}, from: []string{"pilots"}}
+	AppendWhereExists(q, &Query{from: []string{"jets"}, where: []where{{clause: "jets.pilot_id = pilots.id"}}}, true)
+	if out, _ := BuildQuery(q); out != "SELECT * FROM `pilots` WHERE (NOT EXISTS (SELECT * FROM `jets` WHERE (jets.pilot_id = pilots.id)));" {
+		t.Error("want a not exists in the outer query's dialect, got:", out)
+	}
+}
+
+func TestBuildQueryUnion(t *testing.T) {
+	t.Parallel()
+
+	mssql := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true, UseCountBig: true}
+
+	build := func(count bool, limit int) (string, []interface{}) {
+		archived := &Query{dialect: mssql, selectCols: []string{"id", "name"}, from: []string{"archived_jets"}}
+		AppendWhere(archived, "age > ?", 10)
+		AppendIn(archived, "color IN ?", "red", "blue")
+
+		q := &Query{dialect: mssql, selectCols: []string{"id", "name"}, from: []string{"jets"}, count: count, limit: limit}
+		AppendWhere(q, "pilot_id = ?", 4)
+		AppendOrderBy(q, "name")
+		AppendUnion(q, archived, true)
+		return BuildQuery(q)
+	}
+
+	tests := []struct {
+		Count bool
+		Limit int
+		Want  string
+	}{
+		{false, 0,
+			"SELECT [id], [name] FROM [jets] WHERE (pilot_id = $1) UNION ALL SELECT [id], [name] FROM [archived_jets] WHERE (age > $2) AND ([color] IN ($3,$4)) ORDER BY name;"},
+		// TOP would only limit the first query
+		{false, 5,
+			"SELECT [id], [name] FROM [jets] WHERE (pilot_id = $1) UNION ALL SELECT [id], [name] FROM [archived_jets] WHERE (age > $2) AND ([color] IN ($3,$4)) ORDER BY name OFFSET 0 ROWS FETCH NEXT 5 ROWS ONLY;"},
+		{true, 0,
+			"SELECT COUNT_BIG(*) FROM (SELECT [id], [name] FROM [jets] WHERE (pilot_id = $1) UNION ALL SELECT [id], [name] FROM [archived_jets] WHERE (age > $2) AND ([color] IN ($3,$4))) AS [union_count];"},
+	}
+
+	for i, test := range tests {
+		out, args := build(test.Count, test.Limit)
+		if out != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, out)
+		}
+		if !reflect.DeepEqual(args, []interface{}{4, 10, "red", "blue"}) {
+			t.Errorf("%d) wrong argument order: %v", i, args)
+		}
+	}
+
+	q := &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, from: []string{"jets"}, limit: 3}
+	AppendUnion(q, &Query{from: []string{"archived_jets"}, limit: 7, orderBy: []argClause{{clause: "id"}}}, false)
+	if out, _ := BuildQuery(q); out != `SELECT * FROM "jets" UNION SELECT * FROM "archived_jets" LIMIT 3;` {
+		t.Error("want the limit of the union only, got:", out)
+	}
+
+	q = &Query{dialect: mssql, selectCols: []string{"id", "name"}, from: []string{"jets"}}
+	AppendUnion(q, &Query{selectCols: []string{"id"}, from: []string{"archived_jets"}}, false)
+	if _, err := q.Query(nil); err != ErrUnionColumnCount {
+		t.Error("want the column count error, got:", err)
+	}
+}
+
 func TestWriteStars(t *testing.T) {
 	t.Parallel()
 
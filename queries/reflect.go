@@ -76,6 +76,8 @@ func (q *Query) BindG(ctx context.Context, obj interface{}) error {
 //   - If the ",bind" option is specified on a struct field and that field
 //     is a struct itself, it will be recursed into to look for fields for
 //     binding.
+//   - An embedded struct tagged `boil:",inline"` has its fields bound as if
+//     they were fields of the outer struct, without a prefix.
 //
 // Example usage:
 //
@@ -157,6 +159,73 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 	return nil
 }
 
+// Stream executes the query and binds each row into a new value of the
+// struct type obj points to, calling fn with it before the next row is read,
+// so large results are never held in memory at once. obj itself is only used
+// for its type.
+//
+// The first error returned by fn stops iteration and is returned unwrapped.
+// If ctx is non-nil it is checked between rows and its error returned once
+// it's done. Eager loading is done for each row as it is bound.
+func (q *Query) Stream(ctx context.Context, exec boil.Executor, obj interface{}, fn func(obj interface{}) error) (err error) {
+	structType, _, bkind, err := bindChecks(obj)
+	if err != nil {
+		return err
+	}
+	if bkind != kindStruct {
+		return errors.Errorf("obj type should be *Type but was %q", reflect.TypeOf(obj).String())
+	}
+
+	var rows *sql.Rows
+	if ctx != nil {
+		rows, err = q.QueryContext(ctx, exec.(boil.ContextExecutor))
+	} else {
+		rows, err = q.Query(exec)
+	}
+	if err != nil {
+		return errors.Wrap(err, "stream failed to execute query")
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = errors.Wrap(closeErr, "failed to clean up rows in stream")
+		}
+	}()
+
+	mapping, err := bindMapping(rows, structType)
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		newStruct := reflect.New(structType)
+		if err = rows.Scan(PtrsFromMapping(reflect.Indirect(newStruct), mapping)...); err != nil {
+			return errors.Wrap(err, "failed to bind pointers to obj")
+		}
+
+		if len(q.load) != 0 {
+			if err = eagerLoad(ctx, exec, q.load, q.loadMods, newStruct.Interface(), kindStruct); err != nil {
+				return err
+			}
+		}
+
+		if err = fn(newStruct.Interface()); err != nil {
+			return err
+		}
+
+		if ctx != nil {
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "error from rows in stream")
+	}
+
+	return nil
+}
+
 // bindChecks resolves information about the bind target, and errors if it's not an object
 // we can bind to.
 func bindChecks(obj interface{}) (structType reflect.Type, sliceType reflect.Type, bkind bindKind, err error) {
@@ -213,9 +282,9 @@ func bindChecks(obj interface{}) (structType reflect.Type, sliceType reflect.Typ
 }
 
 func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, bkind bindKind) error {
-	cols, err := rows.Columns()
+	mapping, err := bindMapping(rows, structType)
 	if err != nil {
-		return errors.Wrap(err, "bind failed to get column names")
+		return err
 	}
 
 	var ptrSlice reflect.Value
@@ -224,11 +293,6 @@ func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, b
 		ptrSlice = reflect.Indirect(reflect.ValueOf(obj))
 	}
 
-	mapping, err := getMappingCache(structType).mapping(cols)
-	if err != nil {
-		return err
-	}
-
 	var oneStruct reflect.Value
 	if bkind == kindSliceStruct {
 		oneStruct = reflect.Indirect(reflect.New(structType))
@@ -275,6 +339,16 @@ Rows:
 	return nil
 }
 
+// bindMapping looks up where each of the rows' columns goes in structType
+func bindMapping(rows *sql.Rows, structType reflect.Type) ([]uint64, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, errors.Wrap(err, "bind failed to get column names")
+	}
+
+	return getMappingCache(structType).mapping(cols)
+}
+
 // BindMapping creates a mapping that helps look up the pointer for the
 // column given.
 func BindMapping(typ reflect.Type, mapping map[string]uint64, cols []string) ([]uint64, error) {
@@ -333,18 +407,18 @@ func ptrFromMapping(val reflect.Value, mapping uint64, addressOf bool) reflect.V
 		v := (mapping >> uint(i*8)) & sentinel
 
 		if v == sentinel {
-			if addressOf && val.Kind() != reflect.Ptr {
+			// Pointer fields are scanned into as **T and bound as *T, the
+			// driver treats a nil pointer as null
+			if addressOf {
 				return val.Addr()
-			} else if !addressOf && val.Kind() == reflect.Ptr {
-				return reflect.Indirect(val)
 			}
 			return val
 		}
 
-		val = val.Field(int(v))
 		if val.Kind() == reflect.Ptr {
 			val = reflect.Indirect(val)
 		}
+		val = val.Field(int(v))
 	}
 
 	panic("could not find pointer from mapping")
@@ -367,6 +441,11 @@ func makeStructMappingHelper(typ reflect.Type, prefix string, current uint64, de
 	for i := 0; i < n; i++ {
 		f := typ.Field(i)
 
+		if isInline(f) {
+			makeStructMappingHelper(f.Type, prefix, current|uint64(i)<<depth, depth+8, fieldMaps)
+			continue
+		}
+
 		tag, recurse := getBoilTag(f)
 		if len(tag) == 0 {
 			tag = unTitleCase(f.Name)
@@ -387,6 +466,12 @@ func makeStructMappingHelper(typ reflect.Type, prefix string, current uint64, de
 	}
 }
 
+// isInline is true for embedded structs tagged boil:",inline", their fields
+// are bound as if they were fields of the outer struct.
+func isInline(field reflect.StructField) bool {
+	return field.Anonymous && field.Tag.Get("boil") == ",inline"
+}
+
 func getBoilTag(field reflect.StructField) (name string, recurse bool) {
 	tag := field.Tag.Get("boil")
 
@@ -478,9 +563,10 @@ func (b *mappingCache) mapping(cols []string) ([]uint64, error) {
 // We're focused on basic types + []byte. Since we're really only interested in things
 // that are typically used for primary keys in a database.
 //
-// Choosing not to use the DefaultParameterConverter here because sqlboiler doesn't generate
-// pointer columns.
+// Pointers, ex: the columns generated with --nullable-style pointers, are
+// compared by the values they point to with nil being null.
 func Equal(a, b interface{}) bool {
+	a, b = derefPointer(a), derefPointer(b)
 	if (a == nil && b != nil) || (a != nil && b == nil) {
 		return false
 	}
@@ -533,9 +619,28 @@ func Equal(a, b interface{}) bool {
 	return false
 }
 
+// EqualTuple compares the values of two composite keys pairwise with Equal.
+func EqualTuple(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !Equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Assign assigns a value to another using reflection.
 // Dst must be a pointer.
 func Assign(dst, src interface{}) {
+	if assignPointer(dst, src) {
+		return
+	}
+
 	// Fast path for []byte since it's one of the
 	// most frequent other "ids" we'll be assigning.
 	if db, ok := dst.(*[]byte); ok {
@@ -584,6 +689,82 @@ func Assign(dst, src interface{}) {
 	}
 }
 
+// derefPointer returns the value a pointer points to, or nil for a nil
+// pointer. Valuers and other values are returned as they are.
+func derefPointer(i interface{}) interface{} {
+	if _, ok := i.(driver.Valuer); ok || i == nil {
+		return i
+	}
+
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Ptr {
+		return i
+	}
+	if v.IsNil() {
+		return nil
+	}
+
+	return v.Elem().Interface()
+}
+
+// assignPointer handles Assign for pointer columns: dst pointing to a
+// pointer, ex: **int64, or src being a pointer. It returns false when
+// neither is the case.
+func assignPointer(dst, src interface{}) bool {
+	dstVal := reflect.ValueOf(dst).Elem()
+	isDstPtr := dstVal.Kind() == reflect.Ptr
+	_, isSrcValuer := src.(driver.Valuer)
+	isSrcPtr := !isSrcValuer && src != nil && reflect.TypeOf(src).Kind() == reflect.Ptr
+	if !isDstPtr && !isSrcPtr {
+		return false
+	}
+
+	src = derefPointer(src)
+	if isSrcValuer {
+		val, err := src.(driver.Valuer).Value()
+		if err != nil {
+			panic(fmt.Sprintf("tried to call value on %T but got err: %+v", src, err))
+		}
+		src = val
+	}
+
+	if !isDstPtr {
+		if _, ok := dst.(sql.Scanner); ok {
+			Assign(dst, src)
+		} else {
+			convertValue(dstVal, src)
+		}
+		return true
+	}
+	if src == nil {
+		dstVal.Set(reflect.Zero(dstVal.Type()))
+		return true
+	}
+
+	elem := reflect.New(dstVal.Type().Elem())
+	convertValue(elem.Elem(), src)
+	dstVal.Set(elem)
+	return true
+}
+
+// convertValue sets dst to the primitive src converted to dst's type, []byte
+// is copied. A nil src sets the zero value.
+func convertValue(dst reflect.Value, src interface{}) {
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return
+	}
+	if b, ok := src.([]byte); ok {
+		src = append([]byte(nil), b...)
+	}
+
+	v := reflect.ValueOf(src)
+	if !v.Type().ConvertibleTo(dst.Type()) {
+		panic(fmt.Sprintf("tried to assign %T to %s", src, dst.Type()))
+	}
+	dst.Set(v.Convert(dst.Type()))
+}
+
 func upgradeNumericTypes(i interface{}) interface{} {
 	switch t := i.(type) {
 	case int:
@@ -686,6 +867,32 @@ func IsNil(val interface{}) bool {
 	return reflect.ValueOf(val).IsNil()
 }
 
+// HasNil returns true if any of the values is nil, ex: a composite key with
+// a null column, which can't match a row. Unlike IsNil it accepts primitives.
+func HasNil(vals ...interface{}) bool {
+	for _, v := range vals {
+		if v == nil {
+			return true
+		}
+
+		if valuer, ok := v.(driver.Valuer); ok {
+			if IsValuerNil(valuer) {
+				return true
+			}
+			continue
+		}
+
+		switch rv := reflect.ValueOf(v); rv.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+			if rv.IsNil() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // SetScanner attempts to set a scannable value on a scanner.
 func SetScanner(scanner sql.Scanner, val driver.Value) {
 	if err := scanner.Scan(val); err != nil {
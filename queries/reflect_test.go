@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -80,6 +81,46 @@ func TestBindStruct(t *testing.T) {
 	}
 }
 
+func TestBindStructPointers(t *testing.T) {
+	t.Parallel()
+
+	testResults := struct {
+		ID    int
+		Name  *string
+		Score *int64
+	}{}
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Error(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "name", "score"})
+	ret.AddRow(driver.Value(int64(35)), driver.Value("pat"), nil)
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+	err = query.Bind(nil, db, &testResults)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if name := testResults.Name; name == nil || *name != "pat" {
+		t.Error("wrong name:", name)
+	}
+	if score := testResults.Score; score != nil {
+		t.Error("score should be nil:", *score)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestBindSlice(t *testing.T) {
 	t.Parallel()
 
@@ -180,6 +221,98 @@ func TestBindPtrSlice(t *testing.T) {
 	}
 }
 
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	type streamStruct struct {
+		ID   int
+		Name string `boil:"test"`
+	}
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "test"})
+	for i := 1; i <= 5; i++ {
+		ret.AddRow(driver.Value(int64(i)), driver.Value(fmt.Sprintf("row%d", i)))
+	}
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret).RowsWillBeClosed()
+
+	stop := errors.New("stop")
+	var seen []*streamStruct
+	err = query.Stream(context.Background(), db, &streamStruct{}, func(obj interface{}) error {
+		o := obj.(*streamStruct)
+		seen = append(seen, o)
+		if o.ID == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Error("want the callback's error, got:", err)
+	}
+
+	if len(seen) != 3 {
+		t.Fatal("wrong number of rows streamed:", len(seen))
+	}
+	if seen[0] == seen[1] {
+		t.Error("rows should be bound into separate objects")
+	}
+	if id, name := seen[2].ID, seen[2].Name; id != 3 || name != "row3" {
+		t.Error("wrong row:", id, name)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStreamCancel(t *testing.T) {
+	t.Parallel()
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id"})
+	ret.AddRow(driver.Value(int64(1)))
+	ret.AddRow(driver.Value(int64(2)))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret).RowsWillBeClosed()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	count := 0
+	err = query.Stream(ctx, db, &struct{ ID int }{}, func(obj interface{}) error {
+		count++
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Error("want context.Canceled, got:", err)
+	}
+	if count != 1 {
+		t.Error("rows should stop once the context is cancelled, got:", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func testMakeMapping(byt ...byte) uint64 {
 	var x uint64
 	for i, b := range byt {
@@ -235,6 +368,51 @@ func TestMakeStructMapping(t *testing.T) {
 	}
 }
 
+func TestMakeStructMappingInline(t *testing.T) {
+	t.Parallel()
+
+	type Audit struct {
+		CreatedAt time.Time `boil:"created_at"`
+		UpdatedAt time.Time `boil:"updated_at"`
+	}
+	type Pilot struct {
+		ID    int    `boil:"id"`
+		Name  string `boil:"name"`
+		Audit `boil:",inline"`
+	}
+	type Jet struct {
+		ID    int `boil:"id"`
+		Audit `boil:",inline"`
+		Age   int `boil:"age"`
+	}
+
+	pilotMapping := MakeStructMapping(reflect.TypeOf(Pilot{}))
+	if got, want := pilotMapping["created_at"], testMakeMapping(2, 0); got != want {
+		t.Errorf("pilot created_at: want %x, got %x", want, got)
+	}
+	if got, want := pilotMapping["updated_at"], testMakeMapping(2, 1); got != want {
+		t.Errorf("pilot updated_at: want %x, got %x", want, got)
+	}
+	if _, ok := pilotMapping["audit.created_at"]; ok {
+		t.Error("want inline fields without a prefix")
+	}
+
+	jetMapping := MakeStructMapping(reflect.TypeOf(Jet{}))
+	mapping, err := BindMapping(reflect.TypeOf(Jet{}), jetMapping, []string{"id", "created_at", "updated_at", "age"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var jet Jet
+	ptrs := PtrsFromMapping(reflect.ValueOf(&jet).Elem(), mapping)
+	if ptrs[1] != &jet.CreatedAt || ptrs[2] != &jet.UpdatedAt {
+		t.Error("want the embedded fields as scan targets")
+	}
+	if ptrs[0] != &jet.ID || ptrs[3] != &jet.Age {
+		t.Error("want the outer fields as scan targets")
+	}
+}
+
 func TestPtrFromMapping(t *testing.T) {
 	t.Parallel()
 
@@ -258,7 +436,7 @@ func TestPtrFromMapping(t *testing.T) {
 		t.Error("flat int was wrong:", got)
 	}
 	v = ptrFromMapping(reflect.Indirect(reflect.ValueOf(val)), testMakeMapping(1), true)
-	if got := *v.Interface().(*int); got != 0 {
+	if got := **v.Interface().(**int); got != 0 {
 		t.Error("flat pointer was wrong:", got)
 	}
 	v = ptrFromMapping(reflect.Indirect(reflect.ValueOf(val)), testMakeMapping(2, 0), true)
@@ -266,7 +444,7 @@ func TestPtrFromMapping(t *testing.T) {
 		t.Error("nested int was wrong:", got)
 	}
 	v = ptrFromMapping(reflect.Indirect(reflect.ValueOf(val)), testMakeMapping(2, 1), true)
-	if got := *v.Interface().(*int); got != 0 {
+	if got := **v.Interface().(**int); got != 0 {
 		t.Error("nested pointer was wrong:", got)
 	}
 }
@@ -294,13 +472,13 @@ func TestValuesFromMapping(t *testing.T) {
 	if got := v[0].(int); got != 5 {
 		t.Error("flat int was wrong:", got)
 	}
-	if got := v[1].(int); got != 0 {
+	if got := *v[1].(*int); got != 0 {
 		t.Error("flat pointer was wrong:", got)
 	}
 	if got := v[2].(int); got != 6 {
 		t.Error("nested int was wrong:", got)
 	}
-	if got := v[3].(int); got != 0 {
+	if got := *v[3].(*int); got != 0 {
 		t.Error("nested pointer was wrong:", got)
 	}
 	if got := *v[4].(*interface{}); got != nil {
@@ -332,13 +510,13 @@ func TestPtrsFromMapping(t *testing.T) {
 	if got := *v[0].(*int); got != 5 {
 		t.Error("flat int was wrong:", got)
 	}
-	if got := *v[1].(*int); got != 0 {
+	if got := **v[1].(**int); got != 0 {
 		t.Error("flat pointer was wrong:", got)
 	}
 	if got := *v[2].(*int); got != 6 {
 		t.Error("nested int was wrong:", got)
 	}
-	if got := *v[3].(*int); got != 0 {
+	if got := **v[3].(**int); got != 0 {
 		t.Error("nested pointer was wrong:", got)
 	}
 }
@@ -592,6 +770,7 @@ func TestEqual(t *testing.T) {
 	t.Parallel()
 
 	now := time.Now()
+	var nilInt *int64
 
 	tests := []struct {
 		A    interface{}
@@ -607,6 +786,10 @@ func TestEqual(t *testing.T) {
 		{A: "hello", B: sql.NullString{Valid: false}, Want: false},
 		{A: now, B: now, Want: true},
 		{A: now, B: now.Add(time.Hour), Want: false},
+		{A: int64(5), B: &five, Want: true},
+		{A: &five, B: &five, Want: true},
+		{A: int64(6), B: &five, Want: false},
+		{A: int64(5), B: nilInt, Want: false},
 	}
 
 	for i, test := range tests {
@@ -728,6 +911,50 @@ func TestAssignNil(t *testing.T) {
 	}
 }
 
+func TestAssignPointer(t *testing.T) {
+	t.Parallel()
+
+	var dst *int64
+	Assign(&dst, 5)
+	if dst == nil || *dst != 5 {
+		t.Errorf("assignment did not occur: %v", dst)
+	}
+
+	Assign(&dst, sql.NullInt64{})
+	if dst != nil {
+		t.Errorf("should have assigned nil: %v", *dst)
+	}
+
+	src := "hello"
+	var sdst *string
+	Assign(&sdst, sql.NullString{String: src, Valid: true})
+	if sdst == nil || *sdst != src {
+		t.Errorf("assignment did not occur: %v", sdst)
+	}
+
+	var i int
+	Assign(&i, &five)
+	if i != 5 {
+		t.Errorf("assignment did not occur: %d", i)
+	}
+
+	var ns sql.NullString
+	Assign(&ns, &src)
+	if !ns.Valid || ns.String != src {
+		t.Errorf("assignment did not occur: %#v", ns)
+	}
+
+	b := []byte("hello")
+	var bdst *[]byte
+	Assign(&bdst, b)
+	b[0] = 'j'
+	if bdst == nil || string(*bdst) != "hello" {
+		t.Errorf("bytes were not copied: %v", bdst)
+	}
+}
+
+var five = int64(5)
+
 func TestAssignPanic(t *testing.T) {
 	t.Parallel()
 
@@ -817,6 +1044,36 @@ func TestIsValuerNil(t *testing.T) {
 	}
 }
 
+func TestEqualTuple(t *testing.T) {
+	t.Parallel()
+
+	a := []interface{}{"eu", int64(5)}
+	if !EqualTuple(a, []interface{}{sql.NullString{String: "eu", Valid: true}, 5}) {
+		t.Error("it should be equal")
+	}
+	if EqualTuple(a, []interface{}{"eu", 6}) {
+		t.Error("it should not be equal")
+	}
+	if EqualTuple(a, []interface{}{"eu"}) {
+		t.Error("tuples of different lengths should not be equal")
+	}
+}
+
+func TestHasNil(t *testing.T) {
+	t.Parallel()
+
+	var b []byte
+	if HasNil(5, "eu", sql.NullString{String: "x", Valid: true}) {
+		t.Error("it should not have a nil")
+	}
+	if !HasNil(5, sql.NullString{}) {
+		t.Error("the null string should be nil")
+	}
+	if !HasNil(b, 5) {
+		t.Error("the nil slice should be nil")
+	}
+}
+
 func TestSetScanner(t *testing.T) {
 	t.Parallel()
 
@@ -1,50 +1,77 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// templates/00_struct.go.tpl (7.222kB)
-// templates/01_types.go.tpl (2.472kB)
+// templates/00_struct.go.tpl (9.645kB)
+// templates/01_types.go.tpl (2.732kB)
 // templates/02_hooks.go.tpl (6.687kB)
-// templates/03_finishers.go.tpl (7.298kB)
-// templates/04_relationship_to_one.go.tpl (884B)
-// templates/05_relationship_one_to_one.go.tpl (919B)
-// templates/06_relationship_to_many.go.tpl (1.878kB)
-// templates/07_relationship_to_one_eager.go.tpl (4.398kB)
-// templates/08_relationship_one_to_one_eager.go.tpl (3.903kB)
-// templates/09_relationship_to_many_eager.go.tpl (6.494kB)
-// templates/10_relationship_to_one_setops.go.tpl (7.568kB)
-// templates/11_relationship_one_to_one_setops.go.tpl (7.106kB)
-// templates/12_relationship_to_many_setops.go.tpl (15.771kB)
-// templates/13_all.go.tpl (588B)
-// templates/14_find.go.tpl (2.985kB)
-// templates/15_insert.go.tpl (7.18kB)
-// templates/16_update.go.tpl (10.916kB)
-// templates/18_delete.go.tpl (12.968kB)
-// templates/19_reload.go.tpl (4.306kB)
-// templates/20_exists.go.tpl (3.177kB)
-// templates/21_auto_timestamps.go.tpl (2.845kB)
-// templates/singleton/boil_queries.go.tpl (769B)
-// templates/singleton/boil_table_names.go.tpl (196B)
-// templates/singleton/boil_types.go.tpl (3.028kB)
+// templates/03_finishers.go.tpl (8.612kB)
+// templates/04_relationship_to_one.go.tpl (1.05kB)
+// templates/05_relationship_one_to_one.go.tpl (1.084kB)
+// templates/06_relationship_to_many.go.tpl (2.478kB)
+// templates/07_relationship_to_one_eager.go.tpl (5.766kB)
+// templates/08_relationship_one_to_one_eager.go.tpl (5.273kB)
+// templates/09_relationship_to_many_eager.go.tpl (8.702kB)
+// templates/10_relationship_to_one_setops.go.tpl (10.546kB)
+// templates/11_relationship_one_to_one_setops.go.tpl (10.037kB)
+// templates/12_relationship_to_many_setops.go.tpl (21.575kB)
+// templates/13_all.go.tpl (1.577kB)
+// templates/14_find.go.tpl (6.7kB)
+// templates/15_insert.go.tpl (10.281kB)
+// templates/16_update.go.tpl (12.294kB)
+// templates/18_delete.go.tpl (19.479kB)
+// templates/19_reload.go.tpl (4.686kB)
+// templates/20_exists.go.tpl (3.793kB)
+// templates/21_auto_timestamps.go.tpl (3.526kB)
+// templates/22_validate_lengths.go.tpl (3.617kB)
+// templates/23_indexes.go.tpl (539B)
+// templates/24_json.go.tpl (1.115kB)
+// templates/25_repository.go.tpl (3.333kB)
+// templates/26_dto.go.tpl (1.742kB)
+// templates/27_changeset.go.tpl (1.685kB)
+// templates/28_projection.go.tpl (1.886kB)
+// templates/29_insert_ignore.go.tpl (4.102kB)
+// templates/30_column_map.go.tpl (1.26kB)
+// templates/31_mixins.go.tpl (538B)
+// templates/32_find_or_create.go.tpl (3.195kB)
+// templates/33_load_by_keys.go.tpl (1.69kB)
+// templates/34_delete_cascade.go.tpl (2.431kB)
+// templates/singleton/boil_embeds.go.tpl (754B)
+// templates/singleton/boil_functions.go.tpl (1.639kB)
+// templates/singleton/boil_lookup_enums.go.tpl (417B)
+// templates/singleton/boil_mixins.go.tpl (306B)
+// templates/singleton/boil_queries.go.tpl (2.103kB)
+// templates/singleton/boil_scanners.go.tpl (308B)
+// templates/singleton/boil_schema.go.tpl (3.983kB)
+// templates/singleton/boil_table_names.go.tpl (609B)
+// templates/singleton/boil_types.go.tpl (3.639kB)
 // templates_test/00_types.go.tpl (173B)
 // templates_test/all.go.tpl (211B)
-// templates_test/delete.go.tpl (7.608kB)
-// templates_test/exists.go.tpl (1.08kB)
-// templates_test/find.go.tpl (1.005kB)
-// templates_test/finishers.go.tpl (4.239kB)
-// templates_test/hooks.go.tpl (6.346kB)
-// templates_test/insert.go.tpl (1.692kB)
-// templates_test/relationship_one_to_one.go.tpl (2.676kB)
-// templates_test/relationship_one_to_one_setops.go.tpl (5.365kB)
-// templates_test/relationship_to_many.go.tpl (4.014kB)
-// templates_test/relationship_to_many_setops.go.tpl (10.967kB)
-// templates_test/relationship_to_one.go.tpl (2.739kB)
-// templates_test/relationship_to_one_setops.go.tpl (5.221kB)
-// templates_test/reload.go.tpl (1.561kB)
-// templates_test/select.go.tpl (868B)
+// templates_test/changeset.go.tpl (1.883kB)
+// templates_test/delete.go.tpl (9.006kB)
+// templates_test/dto.go.tpl (1.051kB)
+// templates_test/exists.go.tpl (1.079kB)
+// templates_test/find.go.tpl (1.004kB)
+// templates_test/find_or_create.go.tpl (1.485kB)
+// templates_test/finishers.go.tpl (5.953kB)
+// templates_test/hooks.go.tpl (6.345kB)
+// templates_test/insert.go.tpl (2.414kB)
+// templates_test/insert_ignore.go.tpl (1.435kB)
+// templates_test/load_by_keys.go.tpl (2.038kB)
+// templates_test/projection.go.tpl (1.024kB)
+// templates_test/relationship_one_to_one.go.tpl (3.021kB)
+// templates_test/relationship_one_to_one_setops.go.tpl (5.577kB)
+// templates_test/relationship_to_many.go.tpl (6.713kB)
+// templates_test/relationship_to_many_setops.go.tpl (11.175kB)
+// templates_test/relationship_to_one.go.tpl (3.088kB)
+// templates_test/relationship_to_one_setops.go.tpl (5.435kB)
+// templates_test/reload.go.tpl (1.559kB)
+// templates_test/select.go.tpl (867B)
 // templates_test/types.go.tpl (253B)
-// templates_test/update.go.tpl (4.117kB)
+// templates_test/update.go.tpl (4.113kB)
+// templates_test/validate_lengths.go.tpl (3.344kB)
+// templates_test/singleton/boil_embeds_test.go.tpl (563B)
 // templates_test/singleton/boil_main_test.go.tpl (2.078kB)
-// templates_test/singleton/boil_queries_test.go.tpl (975B)
-// templates_test/singleton/boil_suites_test.go.tpl (12.439kB)
+// templates_test/singleton/boil_queries_test.go.tpl (3.584kB)
+// templates_test/singleton/boil_suites_test.go.tpl (17.499kB)
 
 package templatebin
 
@@ -64,7 +91,7 @@ import (
 func bindataRead(data []byte, name string) ([]byte, error) {
 	gz, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 
 	var buf bytes.Buffer
@@ -72,7 +99,7 @@ func bindataRead(data []byte, name string) ([]byte, error) {
 	clErr := gz.Close()
 
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 	if clErr != nil {
 		return nil, err
@@ -113,7 +140,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templates00_structGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xdd\x6f\xdb\x36\x10\x7f\xb6\xfe\x8a\x83\x91\x0e\x76\xe0\x28\x7b\x0e\x50\x0c\x5d\x9a\x66\xd9\x5c\xb7\x49\xbc\xed\xa1\x28\x1a\x46\x3e\x2b\xec\x24\xd2\x25\xe9\xa6\x86\xca\xff\x7d\xe0\x87\x3e\x2d\x39\x76\x92\x36\xed\x93\x65\xf1\x3e\x7e\xf7\xe3\xf1\x78\xba\x2c\x3b\x80\x3d\x92\x50\x22\xe1\xe8\x39\x84\x2f\xcc\x13\xca\x70\x4a\xae\x13\x04\xf7\x13\x4e\x48\x8a\x70\xa0\x75\x60\x85\xb9\xa0\xf1\x07\x75\x9d\x7c\x60\xe6\xb5\x51\x6a\x48\x05\x87\x87\x90\x65\xce\x68\xf8\xf7\xe2\x92\xb2\x78\x99\x10\xa1\x35\x50\x09\x84\x01\xbf\xfe\x88\x91\x02\x81\x0b\x81\x12\x99\xa2\x2c\x06\x75\x83\x30\x23\x8a\x5c\x13\x89\xa0\xac\xbd\x40\xad\x16\xd8\x61\x48\x2a\xb1\x8c\x14\x64\x41\xcf\x40\x12\x84\xc5\x08\x7b\x11\x4f\x96\x29\xab\x20\x3a\xb6\x2f\xa4\x05\x65\x05\x8d\xc8\x8b\x3c\x56\x6f\xd7\x09\xe5\xda\x65\x14\xbd\x32\xd8\x88\x97\xc1\xb6\xcb\xd5\x10\x84\xc7\x3c\x4d\x91\x29\xf8\x0a\x72\x91\x50\x35\xa6\x0c\x2d\x08\xb0\xc4\x40\x08\x4e\x0d\xd9\x2c\xb7\x40\xe7\x40\x63\xc6\x05\x36\xe9\x6d\x00\xd8\x0b\xa7\x24\x3e\x73\x92\x5e\xb5\x88\x49\x6b\x70\xff\x0c\x84\xe9\x6a\x81\x5a\xc3\x55\x96\xc5\xc8\x50\x10\x85\x4e\x6b\x4a\x62\xe9\xac\x48\xad\xaf\x39\x4d\x8e\xfa\xa5\x92\x89\x49\xeb\x3e\x7c\x94\x9c\x1d\xf5\x0f\xfa\xa0\x78\x9a\xd8\x87\x15\x71\x0f\x57\x16\x77\x22\x11\xe8\x1c\xf0\x13\xec\x85\x97\x76\x27\xa6\x24\x3e\x26\xd2\x6c\x64\x5f\x51\x95\x60\x7f\x57\x74\x15\x5c\x50\x87\xb3\x19\x64\xfd\x3d\x7c\x05\xeb\xfe\x98\x48\xd4\xda\xd2\x5a\x2c\x2f\x93\xc4\x24\x85\xd6\x23\x9e\x52\x85\xe9\x42\xad\xec\x16\x18\x5b\x2e\xce\x4d\xb6\x72\x0a\x1e\xc5\xdf\x16\x2c\x46\x24\xc5\xe4\xe9\x58\xb4\xee\x1f\x89\xc5\x8a\xad\x4e\x16\xef\xe3\x6f\x0b\x16\xed\x09\x7f\x30\x8b\x5e\x67\x1b\x0a\xbd\xe8\xfd\x38\xf3\xca\x75\x92\x76\xb5\x58\xb2\xf2\x24\xb9\xf3\xd0\x7c\xe9\xca\x91\x9d\x19\x28\x6b\x6b\xe5\xf1\xc0\xa4\x8a\xbf\x1c\xce\xe4\x9f\x9c\x32\x77\xcf\x15\xcb\x86\x38\xf3\x7c\x01\xfb\xc5\xc5\xf3\x92\xdf\xb2\xf2\xea\xb9\xe8\xe4\x2c\xbc\xc0\x84\x28\xca\xd9\x94\xc4\x15\xd2\xea\xaf\x2b\xac\x35\x17\x0a\x3a\x9a\x0b\x05\x1f\x8d\x85\xab\xa0\x37\x86\x0e\x98\xe3\xad\x4a\xff\xc1\xdd\xb5\xde\x93\xa7\x83\xe0\x33\x11\xed\xb7\x71\x7e\xcd\x3e\xaf\x5d\xcb\xdf\xea\x52\xae\xe6\xb3\x54\x82\xb2\xb8\x86\xf3\x7b\xf9\x3e\x82\xf5\xcc\x1d\x35\x18\xcb\xb2\xc3\x7d\x38\xf5\x9b\x30\x83\xdb\x1b\x14\x08\x37\x98\x2c\x50\x48\x98\x73\x01\x24\x49\xc0\x74\x39\x12\x28\xab\xb7\x40\xfb\x87\x5a\x9b\x3e\xaa\xa1\x1d\x94\xcd\x46\x57\x48\x74\x0e\x03\xce\x22\x7c\xbb\x54\xb0\x17\xbe\xfc\x7d\x6a\xed\xdb\x03\x3f\xf4\x51\xe4\xbd\xcc\x42\x50\xa6\xe6\xd0\xb7\xa6\xff\xb0\xb8\x9e\xc9\x3e\x0c\x62\xfe\x0f\x11\x56\xa8\x50\xcb\x7b\x31\x66\x23\x2d\x36\x1a\xe6\x14\x93\x99\xdf\x07\xd0\xc1\x7c\xc9\x22\x18\xdc\x96\x92\x43\x38\x39\x1f\x7c\x81\x2c\xf3\x15\x67\x08\x9f\xd2\xf0\x7c\x89\x62\xf5\x9a\xcf\x20\x03\x81\x6a\x29\x18\x7c\x4a\x1d\x2d\xe1\xbf\x06\x8a\x3d\xea\x95\x33\x6e\x9e\x4e\xce\x07\xb7\xa1\xf5\x36\x82\x39\x49\x24\x8e\xe0\xcb\xd0\x55\x3a\xad\xcb\xa5\xc2\xd0\xc9\xb9\x17\x30\x35\xa1\x1d\xd9\xe4\x1b\x40\x53\x62\x79\x17\xb2\x49\x13\x5a\xdd\xa6\xdd\xc9\x16\xb4\x67\xd2\x48\x0c\xb6\x42\xe9\x65\xbd\xef\x61\x7b\xf8\x67\x72\xc2\xd5\x4e\x36\xbd\x78\xc5\x6c\x99\xee\x2d\x0e\xc6\xd3\x9d\xe9\x6d\xa1\x6b\x3c\x35\x6c\xb5\x87\x30\x9e\x9e\x3c\x8e\x8b\x93\x6e\x1f\xa7\x8f\x12\xc5\xe9\x86\x28\x4e\x1f\x27\x8a\xd3\x22\x0a\xf7\x3d\x21\xdf\x0a\x9a\x52\x45\x3f\xfb\x63\xdc\x99\x58\x93\x81\x4c\x68\x84\xf0\xee\x7d\x17\x86\x00\xe0\x33\x49\x96\x68\xcb\x64\x4a\xfe\xc3\xc1\xbb\xf7\x94\x29\x14\x73\x12\x61\xa6\x47\xf0\xeb\x08\x12\x64\xce\xce\x70\x18\x80\xad\x6e\x1f\x46\x4e\xcb\x28\xb9\x9a\xe5\xfc\x18\x73\x85\xc1\xe7\x40\x16\x0b\x64\xb3\x81\xfb\xef\x55\x8c\x09\x1d\x40\x19\xbb\xcf\x41\x36\x98\xa7\x2a\xbc\x74\x85\x6b\xd0\x7f\x26\xe1\x6c\x02\xbf\xf5\x47\x90\xe7\xa4\xd7\x97\x61\x18\x0e\x83\xf6\x53\xbf\x4d\xbc\xbd\x9d\xc2\xed\x6d\x8e\xb6\x77\x67\xb0\x3d\x1d\xf4\x1a\xa1\x4e\xb8\x6a\x89\x76\xf2\x66\xba\x31\x62\xa8\x9d\x49\xd7\xd2\xf8\x3f\xfe\x59\x6f\xba\xc9\xad\xe7\x27\xb8\xc7\x2b\x17\x50\x96\x95\xb7\x4f\xbd\x59\x7d\xa2\x6b\x7e\x2b\x6c\x99\xdd\x0b\xd7\x13\x54\xc7\x21\x5f\xcd\x57\x49\x74\x83\x29\x99\xba\xfb\x22\x5c\xfb\xf4\xd9\x0b\xcf\x97\x5c\xa1\x69\xfc\xf5\x7a\x03\xb1\xa9\x63\xad\x34\xac\x5d\x13\x97\x0b\x4c\x24\x50\xe9\x5b\x0f\xe1\xdb\x47\x79\x43\x17\x60\xa2\x90\x40\x04\x82\x54\x5c\xe0\x2c\xec\x4e\x0b\x6b\xa5\x2d\x2b\x3c\xb0\x57\x7f\xe1\xaa\xca\xb6\xc0\x35\xb6\x2f\xaa\xae\xeb\x64\xe7\xd2\xe1\x2b\x2e\x90\xc6\xac\xb5\xaf\x5b\xf3\x39\xe5\x6f\x18\x56\xad\x56\x01\xcc\xed\x04\xc9\xba\x6f\x4e\xb4\xbc\x93\x46\xdf\x5f\x87\xec\xd4\xb7\xc2\x3c\xe6\x11\x49\xb6\x45\xfc\x9a\xb0\x55\x17\xe4\x1a\x80\x02\x74\x53\xa3\x81\xdf\x81\x0a\xcb\xb4\xb0\x8f\x16\x93\xd9\x93\x1d\x21\xdb\x76\xd5\x41\x56\x3c\x25\x6c\xe5\xba\x50\xfd\xed\x37\xdc\x1d\x9c\xf5\xf7\xf5\x8e\xfa\x07\xcf\x81\x46\x10\xfe\xed\x9d\x21\xfc\x50\x49\xb1\x45\x0c\x5d\x59\x52\x1f\xfb\x36\x3f\x9a\x5b\x6b\x50\xbd\xfc\xd4\xc7\xbd\x4d\x03\x5b\x17\x9f\x07\xee\xfb\x7d\xca\xd5\x7e\x96\xe5\xf9\x52\x9b\x52\x77\x4d\x0a\xd6\x4d\x14\xd3\x82\x96\x33\x50\x4e\x0c\xda\x16\x8b\xa9\x41\xdb\x62\x31\x39\x68\x59\xbc\xfa\x89\x8e\xd6\xfd\x19\xf6\x06\xd6\xf9\xcd\x53\xbb\x85\xdd\x62\x69\x9d\xdb\x62\x69\x9d\xd9\x7c\xe9\x2e\x5e\x37\x9d\xf7\x07\x12\xfb\x5d\xae\x8d\x2c\xcb\xc7\x06\xcf\xe4\xa5\xe9\x70\xfb\xf0\x33\x6e\xcd\xc6\x32\x36\xc1\x5b\x37\x4b\x86\x48\x20\x51\xa6\x4f\x02\x86\xb7\xf5\x06\xca\x55\x24\xff\x89\xd1\x39\x2e\x1c\x96\xc6\x06\xc3\x0d\x53\xc5\xac\xf8\x02\xf8\xa5\x4b\x26\xbb\xa3\xca\x8e\xcb\x2a\x3b\xe6\x64\x06\x29\xaa\x1b\x3e\x73\x93\x26\x24\xd1\x4d\x1d\xfe\xb6\xa5\x77\xec\x03\xcd\xaa\x5f\x16\xff\x07\x00\x00\xff\xff\x87\xa0\x58\x05\x36\x1c\x00\x00")
+var _templates00_structGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\xdd\x6f\xdb\x38\x12\x7f\x8e\xff\x8a\x81\x90\x2e\xec\xc2\x51\xfa\x70\xb8\x07\x03\xc6\xa1\x6d\xd2\x5c\xee\x5c\xb7\x4d\xb2\xbb\x0f\xdd\xa2\x61\xa4\x91\xcd\x9e\x44\x3a\x24\xdd\xd4\x50\xf9\xbf\x1f\xf8\xa1\x4f\x4b\x8e\xbd\xed\x76\x77\x9f\x2c\x93\xc3\xe1\x6f\xbe\x87\x64\x9e\x9f\xc0\x31\x49\x29\x91\x30\x99\x42\xf8\xdc\x7c\xa1\x0c\x6f\xc8\x5d\x8a\xe0\x7e\xc2\x39\xc9\x10\x4e\xb4\x1e\x58\x62\x2e\xe8\xe2\xa3\xba\x4b\x3f\x32\x33\x3c\x99\x6e\x51\x0d\x4e\x4f\x21\xcf\x1d\xd3\xf0\xe7\xd5\x35\x65\x8b\x75\x4a\x84\xd6\x40\x25\x10\x06\xfc\xee\x13\x46\x0a\x04\xae\x04\x4a\x64\x8a\xb2\x05\xa8\x25\x42\x4c\x14\xb9\x23\x12\x41\xd9\x5d\x07\x6a\xb3\xc2\x1e\x46\x52\x89\x75\xa4\x20\x1f\x1c\x19\x48\x34\x29\x30\x9c\x67\x77\x18\x5f\xdb\x49\xad\xcd\x64\xd7\x38\xdc\xde\x71\x9a\x4e\x82\x93\xe0\x76\x60\x68\x90\xc5\x16\xb7\xe5\x25\x08\x5b\x20\x1c\xbb\x75\x96\x9d\x6c\x89\xac\x75\x9e\x07\xbf\xb1\xdf\x54\x60\xbe\xac\x72\x2a\x9e\x63\xca\x52\xca\x30\x80\x0d\xc9\x6a\x7f\x6f\xed\x2e\xc5\x1e\x34\xd9\x6b\x83\x62\x8b\x2e\x7c\x11\x4f\xd7\x19\xab\x69\xff\xa5\x1d\x90\x15\x21\x4d\x80\x71\x05\xc3\x63\x27\x7c\x8c\x71\x6b\x9b\x82\x89\x95\x60\x54\x2d\x34\xc3\xcf\x0b\x87\xf0\xca\x77\xdc\x1b\x2b\x6a\x0b\x2c\xdb\x88\x57\x1e\xd1\x4d\xd7\x80\x1e\xbe\xe4\x59\x86\x4c\xc1\x57\x70\xc4\xc5\xff\x13\xad\xe1\xf4\x34\xcf\x8d\x51\xb5\x86\x3c\x0f\xbd\x0e\xb4\xde\x32\x16\x4d\x4a\x76\x97\xf2\x0c\x23\x9a\x91\xb4\x31\xbb\x50\x25\xc1\x5b\x81\x11\x95\x94\x33\x78\xe6\xf7\x80\x6b\xc5\x05\xc6\x40\x24\xc4\x6e\xed\x30\xcf\xb7\xc8\xb5\x1e\x57\xa3\xd7\x11\x49\x51\xeb\xd1\x18\x24\x22\xfc\x42\x52\x1a\x13\x85\x33\x64\x0b\xb5\x94\xe1\x16\x3e\x4c\x25\xee\x0d\xe3\x81\xaa\x25\x74\x02\x80\x44\x90\x48\x51\xce\x48\x0a\x12\x23\xce\x62\x88\xe9\x82\x2a\x39\x86\x84\x32\x14\xf0\x99\xa4\x6b\x94\x40\x04\x82\xe0\x6b\x66\x6c\x7d\xb7\x69\xc4\x54\x1b\x1b\x4d\x80\x2e\x18\x17\xb8\xe5\x14\x4d\x63\x1a\x3f\x5d\x5c\x3a\x4a\xbf\xb4\xf4\x0f\xad\x6b\x70\x6f\x36\x2b\x1b\x06\x79\xbe\x40\x86\x82\x28\x74\xab\x6e\xc8\x42\x5a\x6f\x5f\x48\xad\x5d\x8c\xe4\x39\xca\x88\xac\xf0\x5a\x09\x13\xfa\x05\x07\xe3\x2c\x5a\x07\xf0\x49\x72\x66\x82\x13\x14\x37\x21\x74\x52\xc4\x92\x09\x57\x23\x44\x2a\x4b\x28\x27\x70\xac\xc8\x62\xee\xbd\x6e\x07\x57\x2b\x30\xde\xc3\x71\xe8\x92\xc0\x0d\x59\xbc\x24\xd2\xec\x1e\x58\x07\xb7\xa1\x5c\xf2\x9a\x56\x31\xb0\x15\x7f\xc7\x7c\xa5\xcc\x66\x41\xe0\xb9\x96\x1b\xad\xd3\xd4\x44\xa2\x19\xb6\x44\x53\x08\xc6\x3c\xa3\x0a\xb3\x95\xda\xb4\x03\x79\x5f\x25\xd6\xd4\x57\xca\x7a\x90\x1e\xf3\x5c\x59\x59\xd1\x64\x82\x9a\xd8\x46\xcb\xc1\xa8\xb1\xa8\x16\xf9\x5f\x1b\xca\x2c\x44\x32\xd6\x71\x46\xe9\xe3\x6a\x66\xf7\xe7\x5a\x58\xb6\x8f\xdb\x86\x1c\xc2\xad\xc4\x78\xdb\xf2\xf5\xee\xcf\x7a\xd9\xb8\x94\xff\xe1\x94\xd9\xef\x6a\xda\x04\xaf\xf9\xbe\x82\xa7\x65\x11\x3a\xe3\x0f\xac\x2a\x43\x57\xbd\x96\x0a\xaf\x30\x25\x26\x62\x6f\xc8\xa2\x32\x57\x6b\xb8\x32\xd1\xd6\x44\xa1\xe5\xad\x89\x0d\xe9\x9e\xb8\x1d\x1c\xcd\xa0\x07\xe6\x6c\xaf\xa8\x3c\x79\x3c\xf2\xbc\xf2\xf4\x60\xf0\x99\x88\xee\xca\x5c\x94\xa1\x69\xa3\x44\xef\x5d\xb4\x0e\xac\x3d\x25\xb9\x6b\x09\x28\x5b\x34\x70\xfe\xa8\xbd\x27\x90\xe7\x2b\x41\x99\x4a\x20\x78\x72\x1f\x34\xc8\xb5\x1e\xb7\x74\xd7\xd7\x1d\x3d\x4f\xd3\x02\xd3\x92\xa7\xb1\x04\xfc\x8c\x62\xe3\xab\x23\xf0\xc4\xac\x6a\xe4\x6a\xd3\x50\x31\xd7\x2c\x01\x17\x31\x8a\xb1\xe9\xbc\xf0\xcb\x04\x14\x07\xa9\x88\x50\x40\xc0\xf8\x5e\xf8\xeb\x92\x2a\x4c\xa9\x54\xc0\x05\xdc\x67\xe1\x35\xa6\xa6\x03\x4b\x04\xcf\xc2\x7e\x5b\xd6\x00\x4d\xe1\xfd\x07\xa7\xe0\x43\x74\xba\xbf\x4e\xf6\xe8\x6c\x7c\xfb\x49\x13\x20\x2c\x2e\xd9\x3d\x5f\x2b\x7e\xc9\x22\x81\xb6\x97\x28\x46\x2f\x63\xd3\x56\xaa\xcd\xb5\xc2\x55\xd1\xb6\xee\x67\xdd\x5d\xed\x6b\xc3\xe6\xe5\x16\x68\x3a\x08\x16\x1f\xb2\x44\xe1\xca\xd6\x6a\x53\xa0\x13\x2a\xa4\x72\x05\xdc\x58\xcf\xc8\x66\x86\x69\x29\x13\x4f\x6c\x21\xa7\x7e\x71\xe1\x0f\x55\xd1\x70\xb0\xc3\x41\xc4\x99\x54\x30\x1c\x1c\x1d\x80\xc4\x80\xa7\x4c\xfd\xf3\x1f\x30\xad\x71\xac\x4f\x6b\x7d\x10\x43\x23\xda\x0e\x86\xce\x1e\x23\x6b\x11\xd7\xd2\x55\x5f\x85\x79\xc3\x0b\x9f\xaa\x5e\x1a\x81\x4c\x12\xe1\x42\x16\x56\x14\x78\xbf\xa6\xa6\x69\x9b\x4c\x21\xa1\xa9\x42\xe1\xdd\xe3\xc5\xe6\xaa\x98\xea\x70\xc6\x62\xed\x19\x26\x36\xbc\xe5\xbd\x71\xed\x33\x4c\x28\xa3\x26\x89\xca\xf6\xa2\xa1\x53\x85\xd1\xad\xac\x76\x1d\x35\x98\xb9\xc9\xc9\xb4\xe4\x6c\x03\xde\x14\x26\x17\x2a\xaf\xc9\x0a\x86\xd6\x27\x5e\xf2\x54\x7a\xa7\x1b\x35\xa6\x4d\x3b\x42\xd9\xe2\xd5\x9a\x45\x32\x8c\x48\x86\xa9\x2d\xd5\xbd\x24\x02\x57\x29\x89\xf0\x0a\x25\x8a\xcf\xd6\x38\xc6\x69\xe6\xf8\xd0\x69\x22\x88\x04\x12\x65\x1a\xc3\x6e\xef\x74\x2d\x67\x23\xcd\xd4\x7b\x46\xc3\xda\x4b\x6e\x58\x58\x1f\x85\x84\x8b\xb1\xa5\x9a\xbf\xb9\x81\xf9\xcf\xb3\x99\x5f\x29\x2d\x33\xbe\x36\x39\x27\xc6\x84\xac\x53\x15\x82\xd7\xa6\x61\x64\x66\x81\x40\x4a\x15\x0a\x92\x16\x24\x3e\x4d\x99\x65\x96\x80\xaa\x70\x90\xac\x59\xd4\x2b\xd2\x30\xcf\x3f\x71\xca\xae\x53\x1a\xa1\x84\x00\x82\x9a\x25\x4a\x33\x98\x36\xca\x98\xc1\x50\x42\x30\x86\x40\xeb\x11\x3c\xed\xe4\xe7\xea\x53\x67\xd9\x7c\x73\xf7\xc9\xb8\xca\x4f\x9d\xeb\x72\x5d\xcb\x83\x74\x5c\xe4\x90\xc2\x1b\x9c\x23\x16\x95\xa2\x87\x7b\x98\xe7\xbb\x12\x91\x0d\x49\xca\x62\xfc\x52\x97\x91\x6e\x35\x32\x8f\xe6\xcd\x46\x9b\x7a\xc1\xcf\xbc\xea\xbf\x03\xba\x2d\xa6\x25\x38\xdf\xee\x9a\xff\x02\xd5\x5a\x30\xe8\xdf\x69\xd0\xca\x02\xa7\x4f\xa1\x48\x01\x31\x3c\x2c\x51\x20\x2c\x31\x5d\xa1\x90\xc6\xfb\x80\xa4\x29\x98\xab\x01\x09\xb4\xe9\xaf\xf0\xf4\x54\x6b\xe3\x6b\xad\xd5\xb5\xea\xd2\x8a\x72\xaf\x02\xdb\x0a\x0e\x39\x8b\xf0\xed\x5a\xc1\x71\x78\xf6\xc2\x79\x50\x68\x7e\x46\x5e\x4d\xc5\xd9\xb6\x28\x6a\x96\xf5\xbf\x2d\xae\x27\x32\x80\xe1\x82\xff\x42\x84\x25\x2a\x97\x15\x17\x18\xbe\x58\x17\x1d\x11\x24\x14\xd3\xd8\x87\x38\x68\xe7\xf0\xc3\x87\x8a\x72\x04\xe7\xef\x86\x5f\xcc\xd1\xd7\x70\x32\xff\xef\xb3\xf0\xdd\x1a\xc5\xe6\x35\x8f\x21\x07\xaf\xd1\xfb\xcc\xa9\x25\xfc\xd5\x40\xb1\x56\xae\x9d\x42\xcc\xd7\xf9\xbb\xe1\x43\x68\x77\x1b\x43\x42\x52\x89\x63\xf8\x32\x72\x47\x28\xad\xab\xa9\x92\xd1\xf9\x3b\x4f\x60\x52\x73\x37\xb2\xf9\x1f\x00\x4d\x89\xf5\x63\xc8\xe6\x6d\x68\x4d\x9e\x36\x2f\x77\xa0\xbd\x94\x86\x62\xb8\x17\x4a\x4f\xeb\xf7\x1e\x75\x8b\x7f\x29\xe7\x5c\x1d\xc4\x93\xab\x36\xdb\x2a\x7a\x3b\x36\x98\xdd\x1c\xac\xde\x0e\x75\xcd\x6e\x8c\xb6\xba\x45\x98\xdd\x9c\x7f\x9f\x2d\xce\xfb\xf7\xb8\xf8\x2e\x52\x5c\xec\x90\xe2\xe2\xfb\x48\x71\x51\x4a\x61\x1d\x8a\xca\xb7\x82\x66\x54\xd1\xcf\x3e\x8c\x7b\x1d\x6b\x3e\x94\xa6\x06\xc1\xfb\x0f\x7d\x18\x06\x50\xdc\xcb\x4c\xa6\x90\x91\xff\xe1\xf0\xfd\x07\xca\x14\x8a\x84\x44\x98\xeb\x31\x3c\x1b\x43\x8a\xcc\xf1\x19\x8d\x06\x60\xb3\xdb\xc7\xb1\x2f\xb4\x93\xa9\xcf\x59\x76\xde\xb2\x2b\x19\x4e\x81\xac\x56\xc8\xe2\xa1\xfb\xef\x97\x18\x16\x7a\x00\x95\xec\xde\x07\xd9\x30\xc9\x54\x78\xed\x12\xd7\x30\x78\x22\xe1\x72\x0e\xff\x0a\xc6\xe0\xd5\x31\xf2\xeb\x65\x18\x86\xa3\x41\xa7\xb8\xf3\x7d\xe4\x3d\x3a\x48\xdc\xa3\xdd\xd2\x1e\x3d\x2a\xec\x51\x55\x5b\x0a\x51\xe7\x5c\x75\x48\x6b\x3a\x95\x5d\x12\x43\x23\x26\x8f\x7c\x2d\x82\x93\x66\x77\xda\x7b\x4c\xb2\x4a\xfe\x13\x0e\xbc\xb5\x02\x94\xe7\x55\xf5\x29\x96\xb9\xb8\xa8\xf7\x0a\xfa\x47\x41\x9b\xec\x87\x2d\xb7\xde\x37\x01\x7b\x9d\x51\x7b\x43\xf8\x6a\xae\xe9\xa2\x25\x66\xc4\x0e\x6a\x1d\xee\xb8\xe9\xb2\xd4\xef\xd6\x5c\xa1\xd4\x3a\xd8\xfb\xb0\xfd\xc6\x9c\x97\x5f\x6c\xdc\xb9\x59\xc2\xfd\x1a\x05\x45\x09\x77\x1b\x20\x3b\x4f\xdc\xe5\x11\x7b\x17\xd7\xad\xd6\x69\xe8\xda\xb8\x96\xa6\x9f\x8d\x7c\x2f\x15\x9e\xa1\x8c\x86\xa3\x7e\x17\x2b\xd0\xfe\x78\x27\xb3\xfa\x79\xb1\x71\xa6\xfc\x93\x9c\xa9\x81\xe1\x47\x38\xcd\xee\xcb\xc1\xda\xdd\x60\x9f\x77\x5d\x61\x2a\xcd\x63\x97\x0d\x03\x10\xfe\xa6\x4e\x2e\xe9\x0a\x4c\x01\x71\x37\xf5\xd2\xbe\x3e\xec\xb8\x7f\xb1\x5c\xba\x4c\xee\x81\xbd\xfa\x2f\x6e\xea\x2a\x16\xb8\xa5\xe2\xe2\x92\xd0\x6e\xdd\xd4\x70\x41\x1d\xbe\xe2\x02\xe9\x82\x75\x5e\xa1\x6d\xed\x79\xc3\xdf\x30\xac\x73\xad\x03\x48\xdc\x5d\x94\x49\x17\xed\x87\x44\xbf\x49\xeb\x8a\xb5\x09\xd9\x2d\xdf\x0b\xf3\x8c\x47\x24\xdd\x17\xf1\x6b\xc2\x36\x7d\x90\x1b\x00\x4a\xd0\xed\x15\x2d\xfc\x0e\x54\x58\xb9\x85\xfd\xb4\x98\x8c\x4d\x0e\x84\x9c\xe7\xa7\x4f\x7d\xf1\x53\x3c\x23\x6c\xe3\xce\x31\x3a\xdf\x12\xe5\x7b\x1b\xdc\x45\xd1\xf6\x78\x30\x7e\x44\xa3\x7f\x25\x1f\x68\x09\xe1\x47\x83\xf1\xdf\xc9\x29\xf6\x90\xa1\xcf\x4b\x9a\x25\xae\x79\xb6\xbe\xea\xce\x41\xcd\xf4\xd3\x7c\x65\x6f\x33\xd8\x3b\xf9\x7c\xa3\xdd\x7f\x4f\xba\x32\x37\x3a\xde\x5f\xea\x69\xb3\xf7\x51\x66\x9b\x45\xf9\x30\xb3\x3d\x55\x7b\x9c\xe9\x9a\x2c\x1f\x68\xba\x26\x37\xa4\x7f\xf2\xf6\x11\xbf\xfc\x4b\xa5\xd7\xdf\xad\x61\xcf\x60\x5b\xbf\x7e\xa2\x4b\xbb\xe5\xd4\xb6\x6e\xcb\xa9\x0d\xe9\x9b\xba\xfd\x86\x78\xff\x46\xc5\xfe\x88\x0c\x51\x7f\x61\x92\xf6\xf6\x33\x80\xbf\xa3\x69\x76\xa6\xb1\x39\x3e\xb8\xe7\xf9\xda\xc5\x35\xc3\x87\x66\x03\xe5\x32\x92\x3f\xa4\xf6\xbe\xcc\x8e\x2a\x66\xc3\x11\xf4\x92\x41\x5e\x9e\x21\x7f\xea\xa3\xc9\x1f\xc9\xb2\xb3\x2a\xcb\xce\x38\x89\x21\x43\xb5\xe4\xb1\xbb\xab\x44\x12\x2d\x9b\xf0\xf7\x4d\xbd\x33\x2f\x68\x5e\x3f\x9b\xfe\x7f\x00\x9c\x70\xfe\xb8\xad\x25\x00\x00")
 
 func templates00_structGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -129,11 +156,11 @@ func templates00_structGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/00_struct.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x73, 0x2b, 0xdc, 0x47, 0x2d, 0x77, 0x3d, 0xe9, 0xea, 0x49, 0x65, 0xcc, 0x5, 0x13, 0xaa, 0x1b, 0x7f, 0x6c, 0xe5, 0x8a, 0x4, 0x17, 0x81, 0x97, 0x28, 0x2d, 0x2a, 0x64, 0x87, 0x10, 0xac, 0x85}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3f, 0x17, 0xf8, 0x10, 0xd9, 0xd2, 0xf0, 0xad, 0xf1, 0x32, 0x73, 0x32, 0x4c, 0x39, 0xd3, 0xe4, 0x18, 0x68, 0x87, 0x7, 0x40, 0x5a, 0xb8, 0xf9, 0x27, 0x34, 0xd4, 0x5a, 0x1c, 0x7, 0x92, 0x81}}
 	return a, nil
 }
 
-var _templates01_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x51\x6f\xdb\x36\x10\x7e\xb6\x7e\xc5\xc1\x18\x36\xbb\x70\xe4\xf7\x01\x7e\x48\x93\x15\xeb\x0a\x67\xdd\x9c\x20\x0f\x41\x30\x30\xd4\xc9\xe2\x42\x91\x0c\x79\x5c\x22\xa8\xfc\xef\x03\x29\x69\x76\x37\xcb\x71\xbb\xe6\x25\x47\xdd\x7d\xdf\x7d\x9f\x74\x3c\xb7\xad\x28\x21\xbf\x66\x0f\x12\xf3\xf7\xee\x17\x2d\x54\x8a\xe1\x2c\x84\xac\x6d\x51\xba\x21\x3c\x83\xef\x98\x14\xcc\xc1\x8f\x2b\xc8\xcf\x63\x84\xae\xc3\x0d\xf0\x2b\x56\x77\xc5\x7f\x31\x0b\xb3\x6c\xd2\xb6\x1d\x22\xbf\xd4\xcf\x6a\x23\xd4\xd6\x4b\x66\x43\x38\x97\xf2\x42\x4b\x5f\x2b\x07\x9f\xff\xad\xe0\xee\xde\x91\x15\x6a\xdb\xb6\xd3\x76\x1a\x42\xdb\xf6\xcc\x43\xfd\x27\xe0\x29\x8a\x9d\xe2\xa9\xab\x5e\x33\x03\xf9\x26\x85\xef\xbc\xe2\x2e\x7f\xf2\x9a\xf0\xd6\x32\x03\x9f\xe0\x4f\x2d\x14\x4c\x17\x90\xe8\xa6\x61\x1a\x42\x14\x16\x3d\x5f\x0a\x26\x91\x53\x7e\xe3\xf0\xdc\x93\x1e\x7a\x9c\x75\x15\x07\xa5\xf7\x35\xb7\x82\xaa\x08\x39\x49\x71\x29\x24\xa1\xed\xcf\x6f\x9b\x84\x23\xeb\xf1\x7f\x98\xf9\xdc\x0b\xaa\xe2\x54\xd1\xda\xd3\x25\x96\xcc\x4b\xfa\x1a\xe9\x03\xb4\x64\x71\x2a\xbe\x95\xfc\xd7\x34\x0f\x5d\x4f\x1c\x90\x11\xcd\xdf\xf4\x8d\x1f\x94\xfc\xd1\x8a\x9a\xd9\xe6\x03\x36\xfb\xd3\x3d\x2e\xf9\xe3\x07\x6c\xf6\x74\x7f\xdd\x28\xcf\xb3\x8c\x1a\x83\xf1\xb6\x2d\x97\xf0\x8f\xb2\x1b\xb3\xd3\xb5\x91\x82\x23\x08\x07\x4c\x41\x77\x81\x4b\x6d\x81\x81\x4b\xcf\x75\x09\x46\x0b\x45\x68\x1d\x90\x3e\xcc\x90\x27\xf2\xeb\x4a\x38\x70\x95\xf6\xb2\x80\x2d\x2a\xb4\x4c\xca\x06\x1e\x10\xbc\xc3\x02\xb4\x31\x3a\xfe\x27\x0d\x77\xf7\x63\x2c\x47\xf4\xdd\xdd\xbf\x39\x98\xed\x2f\xab\xd2\x04\xf9\x95\xfe\x59\xeb\xc7\xfe\x86\x8e\xd9\x8d\x25\xd1\x2d\x55\x08\x4e\x6c\x15\x23\x6f\x31\x59\xe6\xde\x91\xae\x0f\xa3\xa0\x8a\xb0\x1a\xa9\xd2\x85\x1b\x11\x9a\x98\x4b\xaf\xf8\xac\xdb\x1f\x57\xfa\x42\x2b\xc2\x17\x0a\xe1\x41\x0b\x99\xff\xf4\x82\xdc\x93\xb6\xdd\xd6\x0c\x81\x77\xd9\xbc\xaf\x5a\x40\xaa\xea\x4f\x7b\xc5\xaa\x08\x61\x01\x87\xed\xcf\x01\xad\xd5\x36\x2a\x3a\x83\x54\x99\x8d\x0e\xe0\x6f\x1e\x6d\x13\xe7\xc8\x73\x82\x36\x9b\x4c\xde\x3c\x79\xb4\x02\x5d\x9e\x32\xd9\x24\x8d\xcb\x72\x09\x17\x8c\x57\xdd\x2b\x11\xca\xa1\xa5\x05\x78\x53\x30\x42\x60\xaa\x00\x6f\xe2\xa3\x57\x56\xf8\x75\x9c\xb9\x15\x58\x2c\xd3\x06\x8d\xc7\x5f\xcb\xd9\xf7\x07\x2d\xb4\x61\x3e\xca\xb3\x66\xc6\x08\xb5\x85\x15\x0c\x52\xd7\xec\x11\x37\xc9\x42\x9f\x9b\x1d\x91\x30\x4e\xbc\xbb\x8c\x3d\xcd\x02\xfe\xd8\xeb\xf2\x56\xa8\xe2\x04\xfe\x05\x1c\xd7\x3d\x9a\xff\xcf\x2e\x18\x57\xfa\x3e\x7d\x82\xf4\x49\xd6\x9e\xc0\x35\x8a\xe7\xbf\xdf\xae\x3d\xe1\xcb\x29\x18\x58\x41\xcd\x1e\x71\x56\x33\x73\xd7\xad\x90\x7b\xb1\xcb\x8e\xb7\xbd\x49\x5f\xfc\xcb\xda\xee\x61\x0e\xb4\xf5\xbb\xec\xb1\xb6\x5f\xee\x76\x0f\xf3\x9a\xdb\x79\x36\x0c\xee\x72\x09\xef\xb4\xe5\x08\x24\x6a\x04\xc3\xf8\x23\xdb\x22\x14\x68\x50\x15\xa8\x78\xd3\x2d\x41\x4f\xba\x66\x84\x05\x74\xd6\x8a\x73\x5a\x5e\x58\xec\xa2\x3c\x9b\xc4\x91\x89\xf8\x7c\x83\x5c\xab\x62\x8f\xf5\xa9\xae\x50\x1a\xb4\xff\x66\x7c\xae\xd0\x22\x70\xc9\xbc\xc3\x7e\x4b\x92\xd0\x0a\x66\xcf\x95\xe0\x15\x14\x1a\x9d\xfa\x81\x12\x11\x93\xcf\xac\x71\x50\x31\x63\x50\xcd\xbb\x66\x03\x6d\x7e\x1b\x79\xb2\x79\xb6\xfb\x75\xff\x3b\x00\x00\xff\xff\x79\x00\x66\xe8\xa8\x09\x00\x00")
+var _templates01_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x5f\x6f\xdb\x36\x10\x7f\xb6\x3e\xc5\xc1\x18\x36\xbb\x70\xe8\xf7\x01\x7e\x70\x93\x15\xcb\x0a\x67\xdd\x9c\x20\x0f\x41\x30\x30\xd4\xc9\xe2\x42\x91\x0c\xff\x2c\x11\x54\x7e\xf7\x81\x94\x54\xbb\x83\xd4\x38\x69\x30\xbf\x98\xe4\xdd\xfd\xfe\x88\x47\xb2\x69\x78\x01\xe4\x92\xde\x09\x24\xe7\xf6\x37\xc5\x65\x1a\xc3\x49\x08\x59\xd3\xa0\xb0\xfd\xf0\x04\x7e\xa0\x82\x53\x0b\x3f\xaf\x80\xac\xe3\x08\x6d\x5b\xd7\x97\x5f\xd0\xaa\x4d\xfe\x87\x1a\x98\x65\x93\xa6\x69\x2b\xc8\x99\x7a\x94\x5b\x2e\x77\x5e\x50\x13\xc2\x5a\x88\x53\x25\x7c\x25\x2d\x7c\xfd\x5b\xc1\xcd\xad\x75\x86\xcb\x5d\xd3\x4c\x9b\x69\x08\x4d\xd3\x21\xf7\xf9\x9f\x81\xa5\x51\x64\x8a\xb3\x36\x7b\x43\x35\x90\x6d\x1a\x7e\xf0\x92\x59\xf2\xe0\x95\xc3\x6b\x43\x35\x7c\x86\xbf\x15\x97\x30\x5d\x40\x82\x9b\x86\x69\x08\x51\x58\xf4\x7c\xc6\xa9\x40\xe6\xc8\x95\xc5\xb5\x77\xaa\xe7\x88\x06\xc6\xa4\x77\x39\xd7\xdc\x95\xb1\xe4\x28\xc5\x05\x17\x0e\x4d\x37\x7f\x5f\xa7\x3a\x67\x3c\x7e\x87\x99\xaf\xbd\xa0\xcc\x8f\x15\xad\xbc\x3b\xc3\x82\x7a\xe1\x5e\x23\xbd\x2f\x2d\xa8\xb0\x6f\x27\xff\x39\xcd\x3d\x2b\xc0\xf7\x68\x7e\xd3\x2f\xbe\x3f\x31\xa3\xbc\x71\x9b\xcf\x25\x33\x58\xa1\xec\xd8\x5f\xd0\x58\xfb\xca\x57\x58\x1e\xa0\xfe\xbf\x5a\xed\x93\xe1\x15\x35\xf5\x47\xac\x7b\x75\xdf\xde\xb6\x4f\x1f\xb1\x3e\x30\xf2\xba\xe3\x3c\xcf\x32\x57\x6b\x8c\x37\xce\x72\x09\x5f\x94\x5d\xe9\xbd\xae\xad\xe0\x0c\x81\x5b\xa0\x12\x92\x6e\x28\x94\x01\x0a\x36\xad\xab\x02\xb4\xe2\xd2\xa1\xb1\xe0\xd4\x30\x02\x49\xe0\x97\x25\xb7\x60\x4b\xe5\x45\x0e\x3b\x94\x68\xa8\x10\x35\xdc\x21\x78\x8b\x39\x28\xad\x55\xfc\x77\x0a\x6e\x6e\xc7\x50\x06\xd7\x5b\x7d\x37\xb7\xef\x06\xa3\x5d\xcb\x49\xe5\x80\x5c\xa8\x5f\x95\xba\xef\x6e\xa9\x31\xbb\x31\x25\xba\x75\x25\x82\xe5\x3b\x49\x9d\x37\x98\x2c\x33\x6f\x9d\xaa\x86\xab\xa0\x8c\x65\x15\xba\x52\xe5\x76\x44\x68\x42\x2e\xbc\x64\xb3\x24\x89\x5c\xa8\x53\x25\x1d\x3e\xb9\x10\xee\x14\x17\xe4\x97\x27\x64\xde\x29\xd3\xbe\x1c\x21\xb0\x36\x4a\xba\xac\x05\xa4\xac\x6e\x76\x90\x2c\xf3\x10\x16\x30\x6c\x7f\x0e\x68\x8c\x32\x51\xd1\x09\xa4\xcc\x6c\xb4\x01\xff\xf0\x68\xea\x78\xae\x3d\x73\xd0\x64\x93\xc9\xbb\x07\x8f\x86\xa3\x25\x29\x92\x4d\x52\xbb\x2c\x97\x70\x4a\x59\xd9\x7e\x12\x2e\x2d\x1a\xb7\x00\xaf\x73\xea\x10\xa8\xcc\xc1\xeb\xb8\xf4\xcc\x33\x76\x19\x7b\x6e\x05\x06\x8b\xf4\x8a\xc4\xe9\xef\xc5\xec\xc7\x41\x0b\x4d\x98\x8f\xe2\x6c\xa8\xd6\x5c\xee\x60\x05\xbd\xd4\x0d\xbd\xc7\x6d\xb2\xd0\xc5\x66\x23\xa5\x91\x73\x7e\xc4\x61\xec\x60\x16\xf0\xd7\x01\xcb\x7b\x2e\xf3\x23\xf0\x17\x30\x12\xfc\x02\xfa\x2c\x7d\x77\xc0\xc7\x95\x9e\xa7\x2d\x48\x5b\xb2\xf1\x0e\x6c\x2d\x19\xf9\xf3\x7a\xe3\x1d\x3e\x1d\x53\x03\x2b\xa8\xe8\x3d\xce\x2a\xaa\x6f\xda\x2b\xe4\x96\xef\xa3\xe3\xb4\x57\x69\xc7\x5f\x46\x7b\x50\x33\x40\xeb\xf7\xd1\x6f\xd1\xbe\xdc\xed\x95\x3e\xda\xed\x3c\xeb\x1b\x77\xb9\x84\x0f\xca\x30\x04\xc7\x2b\x04\x4d\xd9\x3d\xdd\x21\xe4\xa8\x51\xe6\x28\x59\x9d\xda\x9f\x7a\xa7\x2a\xea\x30\x87\xd6\x5a\xbe\x76\xcb\x53\x83\x71\x65\xed\x48\x36\x89\x2d\x13\xeb\xc9\x16\x99\x92\xf9\x01\xea\x43\x55\xa2\xd0\x68\xfe\x8b\xf8\x58\xa2\x41\x60\x82\x7a\x8b\xdd\x2d\xe9\xb8\x92\x30\x7b\x2c\x39\x2b\x21\x57\x68\xe5\x4f\x2e\x01\x51\xf1\x48\x6b\x0b\x25\xd5\x1a\xe5\xbc\x25\xeb\x61\xc9\x75\xc4\xc9\xe6\x59\xd3\xa0\xcc\xe1\x24\x84\xec\xdf\x01\x00\x5c\xe0\xba\x73\xac\x0a\x00\x00")
 
 func templates01_typesGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,11 +176,11 @@ func templates01_typesGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/01_types.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xae, 0xf7, 0x2b, 0xbf, 0x37, 0x35, 0x4b, 0xfe, 0xf8, 0xa3, 0xc7, 0x2c, 0xa5, 0x9f, 0xff, 0xa5, 0x5d, 0xae, 0xef, 0x71, 0xaa, 0x51, 0x8c, 0x4e, 0x2f, 0x56, 0xfc, 0xe7, 0xc5, 0xa6, 0xd2, 0xa7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x78, 0x1c, 0x6f, 0x31, 0xae, 0x18, 0x46, 0x58, 0x13, 0x5c, 0x58, 0xef, 0x8, 0xcc, 0x1, 0xf2, 0x60, 0xb6, 0x5c, 0x1d, 0xc4, 0x6, 0xac, 0xf1, 0x90, 0xdf, 0xa6, 0xb0, 0x2f, 0x53, 0x62, 0xe6}}
 	return a, nil
 }
 
-var _templates02_hooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xc1\x4e\xdc\x30\x10\x3d\x6f\xbe\x62\x8a\x7a\xc8\x56\x4b\xb8\x53\x71\xd8\x96\x4a\xe5\x82\x2a\x51\x4e\x55\x55\x99\x64\x02\x16\xa9\x1d\x39\x4e\xd9\x2a\xf2\xbf\x57\xb6\x17\x62\x92\xec\xc6\x5e\xad\x14\xa4\xf4\x04\xac\x67\x9e\x9f\xdf\x9b\x71\x66\x43\xd3\x9c\x02\xcd\x81\x71\x09\xc9\x35\xff\xca\xf9\x63\x05\xa7\x4a\x45\xfa\xf3\xf7\xa4\xa0\xa4\x82\xf3\x0b\x48\xd6\xfa\x37\xac\x92\xef\xe4\xae\x40\xb0\x3f\x92\x6b\xf2\x1b\x95\x8a\xa2\x3f\x44\x40\xd3\xd8\xe8\xe4\x92\x3f\xb1\x1b\xca\xee\xeb\x82\x08\xa5\x3e\x61\xce\x05\x5e\xb1\x0a\x85\xb4\xe0\x3f\x7e\xbe\x84\xde\x96\x6d\xa0\x5e\x1c\x07\xba\x2d\x33\x22\xf1\x08\x40\x97\x58\xe0\x51\x80\x6e\x4b\xbf\xa3\xed\x43\x5a\xe7\x12\xc5\x11\x34\x32\x38\x37\x58\x60\x7a\x04\x9c\x23\x48\x6d\x70\x8e\xa0\xf4\x96\x8f\xa7\xd0\x67\x67\x90\xf1\x7e\xdd\xe1\x06\xd3\x5a\x62\x05\xa4\x28\xe0\xe4\xce\xac\x03\x35\x01\x27\xf0\xa0\x43\x92\x28\xaf\x59\x0a\x31\x87\x0f\x83\xf0\xcb\x21\xdc\xb8\x69\x68\xae\x5b\xe7\x33\x67\x12\x37\x52\x29\xbd\x11\xdc\x71\x5a\x24\x5f\xcc\x96\x5c\x34\x0d\x16\x15\x2a\x95\xca\x0d\xa4\x36\x2c\xd9\x86\xaf\xa0\x0d\xdf\x7e\xe4\x64\xb1\x4c\xef\x1a\xa3\x10\x80\x42\x70\xb1\x84\x26\x5a\x98\x0d\xb7\xfd\xba\x4d\x31\x1d\xbb\xa0\xb9\xc5\x31\xbc\xd6\x02\x6f\x1e\x69\x59\x62\x16\xa7\x72\x63\x12\x17\x02\x65\x2d\x18\x30\x5a\x44\x0b\x15\x69\x24\x64\x99\xcd\xcd\xb9\x80\x5f\x2b\xa3\x83\xee\x77\x41\xd8\x3d\xfa\xf7\xb4\x06\xa7\xb9\xe6\xa8\x93\x35\x48\x3c\xc0\xd2\x08\xb0\x82\x97\x5d\xcd\xd1\x57\xc0\x97\x1f\x4d\xe6\xbb\x0b\xcd\xcc\x60\x3d\x33\x45\x21\xa2\xc5\x42\x59\xb6\x0e\x7b\xf5\xca\x65\xb7\x52\x07\x5d\xb6\x01\xc1\x2e\x3b\xb8\xb3\x75\xd9\xd5\x76\x5a\x97\xdd\x7b\x64\xd0\x65\x1b\x10\xec\xb2\x83\x3b\x5b\x97\x5d\x6d\xa7\xee\xe5\x91\x1b\xdb\x06\x1c\xd0\xcb\xff\x6f\x6c\x57\xdb\xa9\x5c\xee\x8d\x3a\xaf\x4d\x26\x7a\x19\xae\x42\x9f\xca\x5d\xd4\x19\x5a\xdc\x13\x76\x52\x87\xdd\x21\x74\xc8\x61\xbb\x1e\xea\xb0\x83\x3a\x57\x87\x5d\x61\x27\x75\x78\xf7\xd0\x65\x1d\x0e\x9e\xb9\xba\xa8\x73\x75\xf8\x2d\x4c\x5c\xbd\x2f\x6e\x43\x0e\x07\xcf\x5b\x5d\xd4\xb9\x3a\xfc\x16\xa6\xad\xde\x57\xea\xe1\x1e\x3e\xe4\x39\x3c\xef\x51\xab\x27\xec\x34\x0e\xaf\xb3\x6c\xe7\x2b\x12\x10\x78\x4f\x2b\x89\xa2\x82\xbf\xbc\x16\xf6\x5c\xda\x5c\x49\x39\x03\x7d\x58\x5d\x03\x79\x2d\x6b\x81\xc0\x4b\x14\x44\x2f\x3c\x17\xc0\x3e\xe4\x58\x43\x7d\xe3\x94\xc9\x56\x6c\xf3\xe7\x6a\x97\x5c\x86\xcf\x4e\x3c\xe3\x4c\xf5\x44\x65\xfa\x00\x2d\xb4\x56\x21\x25\x15\xda\x3d\xba\x6f\x23\xce\xb5\x46\xde\x6f\x2e\x2e\x80\x94\x25\xb2\x2c\xf6\xcd\xd8\x7b\x92\xe5\x00\xb3\xf6\x3e\x1f\x67\xe6\xde\xfd\x7e\xcc\x9c\x8c\x60\x66\xed\x3d\x34\xce\xcc\xbd\xb3\xfc\x98\x39\x19\x07\x68\xe6\xef\xa6\xdb\x6b\xbe\x9a\x1d\xe2\x66\x67\xc0\xde\x47\xac\x37\x8b\x8f\xf1\xea\x26\x84\xd2\x6a\xa7\xc2\x51\x5a\xee\x00\xe9\x45\xcb\x49\x08\xa5\xe5\x57\xfa\xbd\xa9\xc7\x8b\xd6\x61\x85\xdf\x79\xfe\x8e\xd2\x0a\x29\xfb\x6e\x42\xb8\x5a\xde\xb5\x15\x52\xf3\xdd\x84\x31\x5a\x2a\xb2\xff\xfc\x31\xcf\xd7\xe8\x5f\x00\x00\x00\xff\xff\x7d\x83\x65\x8e\x1f\x1a\x00\x00")
+var _templates02_hooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x31\x6f\xdb\x3c\x10\x9d\xad\x5f\x71\x5f\xf0\x0d\x72\xe1\x28\x7b\x8a\x0c\x6e\x53\xa0\x59\x82\x02\x69\xa6\xa2\x28\x18\xe9\x94\x10\x51\x49\x81\xa2\x1a\x17\x02\xff\x7b\x41\xca\x36\x2f\xb6\x6c\x51\x86\x01\x0d\x9a\x92\x88\x77\x4f\x4f\xef\xdd\x91\x07\xa6\x69\x2e\x81\xe7\x20\xa4\x86\xe4\x5e\x7e\x95\xf2\xb5\x82\x4b\x63\x22\xfb\xfc\x7f\x56\x70\x56\xc1\xf5\x0d\x24\x4b\xfb\x1b\x56\xc9\x77\xf6\x54\x20\xb4\x3f\x92\x7b\xf6\x1b\x8d\x89\xa2\x3f\x4c\x41\xd3\xb4\xd1\xc9\xad\x7c\x13\x0f\x5c\x3c\xd7\x05\x53\xc6\x7c\xc2\x5c\x2a\xbc\x13\x15\x2a\xdd\x82\xff\xf8\xb9\x0d\x7d\x2c\x7d\xa0\x5d\xec\x07\x7a\x2c\x33\xa6\xf1\x0c\x40\xb7\x58\xe0\x59\x80\x1e\xcb\xb0\x4f\x3b\x86\xb4\xcc\x35\xaa\x33\x68\xe4\x70\x1e\xb0\xc0\xf4\x0c\x38\x67\x90\xda\xe1\x9c\x41\xe9\x35\x9f\x40\xa1\xaf\xae\x20\x93\xfb\x75\x87\x2b\x4c\x6b\x8d\x15\xb0\xa2\x80\x8b\x27\xb7\x0e\xdc\x89\x7e\x01\x2f\x56\xae\x24\xca\x6b\x91\x42\x2c\xe1\x43\x27\xfc\xbc\x0b\x37\x6e\x1a\x9e\xdb\xd6\xf9\x2c\x85\xc6\x95\x36\xc6\xbe\x08\x9e\x24\x2f\x92\x2f\xee\x95\x52\x35\x0d\x16\x15\x1a\x93\xea\x15\xa4\x6d\x58\xb2\x0e\x5f\x80\x0f\x5f\x3f\x22\x59\x22\x33\x66\x0e\x31\x2a\x05\xa8\x94\x54\x73\x68\xa2\x59\xd3\xf8\x7e\x5d\xa7\xb8\x8e\x9d\xf1\xbc\xc5\x71\xdf\xbb\x54\xf8\xf0\xca\xcb\x12\xb3\x38\xd5\x2b\x97\x38\x53\xa8\x6b\x25\x40\xf0\x22\x9a\x99\xc8\x22\xa1\xc8\xda\xdc\x5c\x2a\xf8\xb5\x70\x3a\xd8\x7e\x57\x4c\x3c\xe3\x21\x3b\xf6\xb5\xb5\xe0\x3c\xb7\x1c\x6d\xb2\x05\x89\x3b\x58\x3a\x01\x16\xb0\x7d\xab\xfb\xf4\x05\xc8\xf9\x47\x97\xf9\xdf\x8d\x65\xe6\x88\x6e\x98\xa2\x52\xd1\x6c\x66\x5a\xb6\x84\xbd\x79\xe7\x32\xad\xd4\x4e\x97\xdb\x80\xc1\x2e\x13\xdc\xc9\xba\x4c\xb5\x1d\xd7\x65\xba\x8f\x74\xba\xdc\x06\x0c\x76\x99\xe0\x4e\xd6\x65\xaa\xed\xd8\xbd\xec\x77\x95\x03\xbd\x7c\xd2\x8e\x4d\x70\x27\xdc\xcb\x5e\xdb\xb1\x5c\xde\x1b\x75\xde\x9b\xcc\xec\x32\xdc\x0d\x3d\x95\x77\x51\x27\x68\xf1\x9e\xb0\xa3\x3a\x4c\x87\xd0\x2e\x87\xdb\xf5\xa1\x0e\x13\xd4\xa9\x3a\x4c\x85\x1d\xd5\x61\x3a\x18\x74\x39\x3c\x78\xe6\xda\x45\x9d\xaa\xc3\x54\xd8\x51\x1d\xa6\x43\x41\x97\xc3\x83\xe7\xad\x5d\xd4\xa9\x3a\x4c\x85\x1d\xb9\x87\xfd\x71\xd1\xdd\xc3\xa7\x9c\xc3\x04\x75\xba\x3d\xec\x85\x1d\xc7\xe1\x65\x96\x75\xba\x64\x39\x81\xc2\x67\x5e\x69\x54\x15\xfc\x95\xb5\x72\x87\x30\xd8\xab\x0f\xcd\xa5\x00\x7b\x13\x60\xaf\x48\xf2\x5a\xd7\x0a\x41\x96\xa8\x98\x5d\xd8\x14\xc0\x31\xe4\xd8\x42\x7d\x93\x5c\x68\x2f\xb6\xfb\x73\x71\x48\x2e\x1b\x01\x07\xf1\x9c\x33\xd5\x1b\xd7\xe9\x0b\x78\x68\xab\x42\xca\x2a\x6c\xdf\xb1\x7b\x1b\x71\x6d\xef\x0f\x82\x6f\x2e\x6e\x80\x95\x25\x8a\x2c\x0e\xcd\x38\xfa\x25\xf3\x0e\x66\x7e\x3f\xef\x67\x46\xf7\xfe\x30\x66\x24\x63\x30\x33\xbf\x0f\xf5\x33\xa3\x7b\x56\x18\x33\x92\x71\x82\x66\x1b\xbd\x43\x34\xdb\x7a\x13\xac\xd9\x36\x63\x00\xb3\x9d\x01\xfb\x18\xb1\xbd\x59\xbc\x8f\xd7\x6e\xc2\x50\x5a\x7e\x2a\xec\xa5\x45\x07\xc8\x20\x5a\x24\x61\x28\x2d\x5f\x9c\xbd\xb4\x48\x1d\x87\xd1\x22\x09\x43\x69\xf9\xca\xec\xa5\x45\x8a\x38\x8c\x16\x49\x18\xae\xd6\xc6\xff\x00\xb5\xb6\xa5\x12\xaa\xd6\x36\xa1\x8f\x96\x89\xda\x7f\xfe\xa0\xc8\x8c\x89\xfe\x0d\x00\x7d\x83\x65\x8e\x1f\x1a\x00\x00")
 
 func templates02_hooksGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -173,7 +200,7 @@ func templates02_hooksGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates03_finishersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x98\x51\x6f\xdb\x36\x10\xc7\x9f\xad\x4f\x71\x0b\x86\x40\x1a\x54\xb5\x03\x86\x3d\x64\xf0\x00\x37\x0d\xb2\x87\x21\x75\xeb\x0d\x7b\x18\x86\x41\x96\x4f\x2e\x1b\x86\x8c\x49\x7a\xce\x20\xe8\xbb\x0f\x47\x4a\xb6\xac\xc8\xb1\x25\xcb\x0e\xf6\xd2\xda\x22\x75\xbc\xfb\xff\x4f\xf7\xb3\x92\x65\x6f\xe0\xdb\x98\xb3\x58\xc3\xd5\x10\xa2\x11\x7d\x42\x1d\xfd\x16\x4f\x39\x82\xfb\x2f\xba\x8b\x1f\x30\xcf\x3d\x2f\xcb\x58\x0a\xd1\x68\x36\xbb\xe5\x72\x1a\x73\x78\x93\xe7\xde\xdb\xb7\xf0\x51\xe0\x2d\x28\x34\x4b\x25\x34\xc4\xa0\x99\x98\x73\x84\x2c\x73\x61\xa3\x0f\x72\x25\x26\x4c\xcc\x97\x3c\x56\x79\x0e\x0a\x13\xa9\x66\x90\x2a\xf9\x00\xe6\x0b\xc2\x62\x89\xea\x5f\x58\xd2\x5d\xf6\xfb\xdc\xc5\xc6\x27\x4c\x96\x46\xaa\xc8\x4b\x97\x22\x01\x7f\xb1\x2b\xe0\x27\xba\x3f\xb0\x49\xf8\x36\x41\x21\x0d\x44\x77\xf2\x5a\x0a\x83\x4f\x26\xcf\x13\xf3\x04\x89\xfb\x12\x15\x17\xb3\x0c\xc5\x2c\xcf\x03\xf0\xbf\x5b\x47\xfd\xfd\x71\x13\x33\x04\x54\x4a\xaa\x00\x32\x6f\xe0\x0a\x83\x45\xf4\x51\xa0\x3b\xa0\x1a\x7c\x2a\x19\x8f\x6e\xd1\x7c\x78\xef\x07\x59\x86\x5c\xa3\x3d\x30\x84\x72\xa1\xd8\x59\xac\x8b\x19\x89\x16\x78\x56\xcc\xe2\x5b\xa1\x6b\x2c\x66\x55\x6d\xe9\xe3\x38\x16\x2c\xa9\xaa\x3c\x3e\x99\xcc\xa1\x3d\xff\x91\x0e\xd4\x20\x85\xab\xbf\x8d\xf6\xe3\xf6\xe2\x37\x6b\x4f\x9a\x4b\x6b\x00\x35\x64\xbf\xb2\x0f\x58\x6a\x03\x7f\x33\x04\xc1\x38\x9d\x34\xb0\x25\xfb\xf6\xb6\x3f\x54\xfc\x78\xa3\x94\x8f\x4a\x05\x81\x37\xc8\xbd\xb5\xf9\xb2\xc9\xb0\x26\x87\x8e\x35\xe8\x58\x1b\xc6\xcf\xa5\x22\x87\x9d\x2c\x37\x85\xd7\x15\xc1\xea\xde\x84\xb0\xd9\x5e\x5c\xaa\xdc\xf5\xe2\x33\x13\xec\x34\xae\xa1\x27\x42\x58\xab\x69\x4f\xec\xcf\x1a\xe7\xc3\x91\x36\xb4\x50\xfc\xf5\x04\xaf\x0e\x29\x49\x92\x5f\x36\x6e\xcb\x48\x2c\xaa\x8a\xa1\x8e\x26\x68\x7e\x65\x0f\xcc\xf8\x8b\xe8\x93\xeb\xb7\xef\x03\xcf\x1b\xac\x3d\x7b\xcf\xc4\xec\x79\x45\x82\xf1\x4a\x09\x45\x5e\x2e\xf3\x10\x64\xa3\x77\xee\x8a\x54\x3a\xba\x8e\x97\x1a\xad\x71\x30\x1c\x82\x5e\xf0\xe8\x46\xa9\x3b\xf9\x59\xae\xb4\xdd\x59\x1a\x29\x18\x0f\xb7\x97\xbd\xc1\x20\xf7\xb6\xd7\x8b\x98\xd4\x0e\x14\x32\x84\x8b\x2c\x8b\xc6\xf7\x73\x47\xa8\x2b\x48\x63\xc6\x71\x06\x46\x16\x83\x0d\x21\x06\x29\xca\xe9\x97\x4a\x05\x59\xb6\x05\xb5\x8b\xa2\x9b\xaa\x8d\xfa\x8b\x94\xf7\xda\x76\x53\x59\xd8\xd5\x10\x64\x34\x93\xa3\xd4\xa0\x9a\x20\xc7\xc4\xd8\x3d\x87\xb7\xf7\x4f\x75\x7d\xca\xee\xb5\x25\x51\x0a\x03\x02\xb1\x15\xb6\xd2\xdb\x21\xed\xf7\x76\x93\x77\xc4\x79\x85\xbc\x9c\x43\xf3\x44\x75\x3d\xae\x7b\x67\x2e\x1d\xdf\x81\xb9\x8d\x49\x4e\x38\x4b\xb0\x91\xbb\x23\xce\x5f\x93\xbb\x54\xe4\xf8\x04\x22\x1f\x35\xea\x6d\x52\xed\xa5\xdf\xad\x7c\x7d\x78\xf7\x29\xfa\x69\xa9\x3b\xe2\xbc\xbb\x3d\xc7\x9a\x70\x0e\xde\x76\x30\xed\xdc\xc4\x1d\x71\xde\xd9\x82\x16\x6a\x9f\x83\xb5\x87\x0d\xa7\x7f\x62\x05\x12\xfe\xfc\xab\x99\xcc\x47\x12\xf5\xb2\x19\xa9\xdd\x38\x18\x6b\xcd\xe6\xc2\xba\xe2\x06\x92\x42\xbd\xe4\x46\xd3\x5a\xb3\x4d\x9a\x8a\xdd\xcf\x45\x8e\xc2\xdf\xe1\x58\x9d\x93\x01\x95\xf1\xce\x16\x41\x08\xfe\x3b\x04\x39\xfd\x4a\xf2\xa8\x58\xcc\x11\xa4\xfb\x1d\x50\x61\xed\xf4\x6b\xbf\xb4\x7d\xc6\x5b\xf7\xcb\x22\xef\x0c\xde\x6b\xb9\x14\x66\x83\x5e\x6a\xe6\x84\x2e\x81\x4c\x0f\x78\x00\x98\xe8\x69\x02\xb9\x34\x3a\x20\x98\x09\xf3\xe3\x0f\x8d\xb4\xb5\x21\x5f\x93\xb7\xae\xa6\x71\x1f\xda\x9e\x08\xbe\x45\x86\xed\x65\xb7\xaa\x93\xda\x49\x65\x68\xf7\x2b\x78\xd7\xa1\x9e\x1c\xc4\x5a\x9b\x6b\x2f\xd6\x1c\x6f\xc0\x39\xd0\xbb\xcf\xb0\x93\x72\xb6\x6e\x49\xa9\x7f\x1f\xf2\xb7\x52\xfa\x1c\xd8\x7d\x36\x90\x88\xb0\xae\x38\xbb\xb4\xfd\x0e\xeb\xa8\xb0\x79\x89\x15\x8c\x07\x5b\x1b\x5c\xde\xc5\x7a\x50\x72\x6c\x53\x82\xa3\xd8\xda\x52\xbb\xcd\xfd\xfb\x59\xae\x7c\xeb\x5b\x34\x49\x62\xe1\x5f\xda\x1c\x02\x0a\x40\x05\xbe\x78\x5f\x11\xdb\xb7\x5d\xb0\x23\x46\x61\xe6\x6e\xbc\xbf\x6b\x03\x77\x27\x50\xed\x95\x16\x94\x5c\xe9\x8b\x5a\x2f\xd1\xc6\x7d\x50\xbb\x79\x62\xda\xe8\x5b\x48\xbe\x60\x72\xaf\x81\xa5\xb6\x5f\x94\x5c\x01\xda\x95\xb2\x83\x0c\x1d\x76\xd4\x03\x5c\x9c\xd4\x01\x5c\x53\x29\x79\x23\xb7\x5c\xc8\xd7\x04\x57\x51\xd4\xf8\x30\xfd\x4e\xc4\xa6\x32\x89\xf6\xd2\x92\xb2\xa4\x28\x56\x46\x5d\xcf\xa2\x76\x9d\x84\x78\x10\x9c\x5c\xb2\x07\xca\xdf\x83\xc8\xe7\x00\xd0\x3e\x53\x4e\x0a\xa0\xba\xec\x6b\x8d\x0f\x93\xb8\x9d\x9a\xe7\x80\x4c\x7d\x78\xf4\xca\x98\x7d\x7f\x63\xfd\xdf\x10\x28\x8d\xb9\xc6\x56\x14\xa2\x6e\xa0\x66\xa8\x83\xc8\x35\x45\x13\x8a\xe0\x67\x02\x9d\xc3\xd1\x7f\x01\x00\x00\xff\xff\xb2\x2e\xc3\xee\x82\x1c\x00\x00")
+var _templates03_finishersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x99\xdf\x6f\xdb\x36\x10\xc7\x9f\xad\xbf\xe2\x66\x0c\x85\x34\xa8\x4a\x07\x0c\x7b\xe8\x90\x01\x6e\x1b\x64\x0f\x43\xe3\x35\x1d\xfa\x30\x0c\x03\x2d\x9f\x62\x26\x0c\x19\x93\xf4\x92\x42\xd0\xff\x3e\x1c\x49\xdb\x8a\x22\x27\x96\x2d\x7b\xdb\x53\x62\x4b\x3a\xde\x7d\xee\xc7\xf7\xa2\x94\xe5\x6b\xf8\x96\x09\xce\x0c\xbc\x3d\x85\x6c\x44\xbf\xa1\xc9\x3e\xb3\x89\x40\xf0\x3f\xb2\x8f\xec\x16\xab\x2a\x8a\xca\x92\x17\x90\x8d\xa6\xd3\x73\xa1\x26\x4c\xc0\xeb\xaa\x8a\x4e\x4e\xe0\x42\xe2\x39\x68\xb4\x0b\x2d\x0d\x30\x30\x5c\x5e\x09\x84\xb2\xf4\x66\xb3\x0f\xea\x5e\x5e\x72\x79\xb5\x10\x4c\x57\x15\x68\xcc\x95\x9e\x42\xa1\xd5\x2d\xd8\x19\xc2\x7c\x81\xfa\x2b\x2c\xe8\x29\xf7\xf9\xca\xdb\xc6\x07\xcc\x17\x56\xe9\x2c\x2a\x16\x32\x87\x78\xbe\xc9\xe0\x6f\xf4\x7c\xe2\x9c\x88\x9d\x83\x52\x59\xc8\x3e\xaa\xf7\x4a\x5a\x7c\xb0\x55\x95\xdb\x07\xc8\xfd\x87\x2c\x7c\x59\x96\x28\xa7\x55\x95\x40\xfc\xdd\xca\xea\xef\x77\x6b\x9b\x29\xa0\xd6\x4a\x27\x50\x46\x03\x1f\x18\xcc\xb3\x0b\x89\xfe\x80\xba\xf1\x89\xe2\x22\x3b\x47\xfb\xe1\x5d\x9c\x94\x25\x0a\x83\xee\xc0\x14\x96\x17\xc2\x9d\xe1\xba\x9c\x12\xb4\x24\x72\x30\xc3\xa7\xc0\x95\xc9\x69\x9d\x2d\xfd\x3a\x66\x92\xe7\x75\xca\xe3\x83\x61\x4e\xdd\xf9\x77\x74\xa0\x01\x25\x7d\xfc\x5d\xd8\x8f\xbb\xc3\x6f\x67\x4f\xcc\x95\x4b\x00\x15\x64\xbf\xd8\x07\xbc\x70\x86\xbf\x39\x05\xc9\x05\x9d\x34\x70\x21\xc7\xee\xb1\x2f\x9a\xdd\x9d\x69\x1d\xa3\xd6\x49\x12\x0d\xaa\x68\x95\x7c\xd5\x96\xb0\xb6\x0c\xed\x9b\xa0\x7d\xd3\x30\x7e\x8a\x8a\x1a\xc9\x57\xe3\x59\xc8\x75\x0d\x58\x33\x37\x29\xac\x6f\x0f\x5f\xd5\x9e\x7a\xb6\x67\x92\x8d\x89\x6b\xa9\x89\x14\x56\x34\xdd\x89\xfd\xa5\xc6\xe7\x61\xcf\x34\x74\x20\xfe\xef\x01\xaf\x0f\xa9\x16\xce\x0e\xc7\x80\x8e\x3c\xf5\xde\x7c\xe1\x76\xe6\x2a\xe5\xe2\x2e\x76\x29\x18\xb6\x9a\x1d\xa6\x30\xbc\x90\x38\x4c\xa2\x68\xb0\xe6\x3a\x50\xd4\x8d\xaf\x5a\x9f\x28\xa9\x53\x88\x1b\x47\x93\x5d\xa2\xfd\x95\xdf\x72\x1b\xcf\x33\x77\x58\x0a\xdf\x93\xa5\x55\x55\xbc\xe3\x72\xfa\x94\x99\xe4\xa2\x06\x29\x44\xee\x8b\x31\x05\xd5\x5a\x1d\xbe\x95\x95\x36\xd9\x7b\xb6\x30\xe8\xba\x16\x4e\x4f\xc1\xcc\x45\x76\xa6\xf5\x47\xf5\x49\xdd\x1b\x77\xe7\xb2\x54\x24\x17\xe9\xe3\xcb\xd1\x60\x50\x45\x8f\xaf\x07\x9b\x54\x70\x64\x32\x85\x61\x59\x66\xe3\x9b\x2b\xaf\x81\x6f\xa1\x60\x5c\xe0\x14\xac\x0a\xa3\x13\x81\x81\x92\xa1\x6e\xa0\x50\x1a\xca\xf2\x91\x6c\x0e\x43\xbd\xd6\x53\xf4\x8b\x52\x37\xc6\x27\x28\x04\xf6\xf6\x14\x54\x36\x55\xa3\xc2\xa2\xbe\x44\x81\xb9\x75\xf7\x6c\xdf\x40\x3f\x35\xf9\x84\xa0\xfc\x28\x25\x17\x06\x24\xf5\x0e\x6c\xad\x7b\x52\xe2\x19\x6d\xd6\xf6\x91\x10\x35\x6d\x17\x02\x5a\x2b\x20\x74\x91\xd9\x5a\x6e\xb6\x6d\x30\x3a\x7e\x23\x83\x66\x2f\xad\x1b\xa6\xd5\xc9\x4b\xc1\x73\xac\x37\x4d\x60\x30\xcf\x46\x42\xf4\x26\x31\x3b\x28\x3b\x05\x39\x3e\x00\xe4\xbd\xc4\xc4\x39\xd5\x1d\x7d\xab\xe7\x8e\x7c\x53\x1e\xfa\x84\xde\x97\x78\xb4\xeb\xfa\x48\x88\xdd\xd3\xb3\x6f\x12\x8e\xa1\xe8\x3b\x24\x6d\x9b\x91\xd4\x5b\x5a\x7c\x8f\xec\x9c\x82\x0e\xb4\x8f\x00\x7b\xcb\xe1\xd4\xb3\xa2\x8f\x84\x68\x2a\xfa\xdf\x4c\x83\x82\x3f\xfe\x6c\xdf\x2e\xf6\xd4\xec\x57\xed\xa2\xbd\x9b\xd2\x32\x63\xf8\x95\x74\x79\x77\x09\x05\x8d\x66\x21\xac\xa1\x6b\xad\xce\x83\xa1\xe2\x7d\x59\x79\x05\xca\x78\x43\x4d\x34\x95\x38\xa1\x30\xde\x50\x3f\x0c\x48\xe4\xff\x4a\x41\x4d\xae\x09\x8f\x66\xf2\x0a\x41\xb9\x2b\xcb\x88\x49\xcd\x27\xd7\xfd\xea\xf9\x13\x45\xf7\xbb\x4b\xf5\xb2\xb4\x9f\x9c\xc0\xa5\xd5\xc8\x6e\x21\x67\x42\x18\x28\x24\xdc\x73\x3b\x03\x64\xf9\x6c\x03\xbf\xd6\xad\x18\x98\x01\x6e\x0d\x68\x75\x0f\xdc\x80\x46\x36\x4d\x69\x3e\x6a\x66\x67\xa8\xc1\xce\x98\x04\xa1\xd8\x34\xc8\xd1\xad\x4b\x18\x97\x56\xd1\x16\x4e\x09\x01\xc1\x6f\xd0\xb5\xf2\x54\xa1\xc9\xe8\xd9\xcf\x33\x84\x82\x6b\x63\x29\x5c\xa5\x43\x8f\xe3\x14\x26\x5f\xc9\x4f\x63\xd5\x9d\x71\xc3\x94\x5b\xd4\xcc\x72\x25\xdd\x3c\xe5\x66\x7d\x27\x79\x65\x5a\xb9\x3a\xef\x72\x26\x73\x14\x82\x9c\xa2\x8e\xf5\x16\xb9\x85\x09\xda\x7b\x44\x49\xd1\x98\x50\xbc\xdb\x0e\x0a\x4f\xf3\xe0\xb3\x22\x25\x02\xe4\xd1\xc6\xbf\xb8\xc2\xb8\x70\x3f\x0e\x30\x34\x7c\x9c\xcd\xb9\x11\xea\x2b\xec\xf7\xd9\x26\x18\xcf\x8f\x87\xd6\x33\xcb\x2a\xf5\xf1\x52\x6b\x71\x69\x51\x17\x2c\xc7\x72\x19\x28\x45\xe8\xff\x0e\x51\x93\xeb\x6c\x13\x94\x68\xb0\xa9\xe1\x0f\xb7\x6b\x2f\x99\xf8\xbe\xa4\xb6\x5c\x3d\x11\xad\xa7\x5e\x21\x63\x9a\x8a\x55\xf2\xcc\xbe\xfd\x5e\x2d\xa4\x5d\x6f\xdc\x54\xf9\x39\x7d\x05\xaa\xd8\x42\xf7\xb8\xec\x69\xf1\xf0\x6e\x6c\x24\xd2\xac\xe5\x90\xdd\x04\x62\x2e\xed\x8f\x3f\xd4\x75\x2c\xc4\x3e\xcf\x9c\xc9\xde\x36\xbe\x1d\xd6\x6c\xe7\xc0\xf9\xb8\x0f\xb6\x07\xda\xb9\x83\x87\xdd\xb1\x3b\xea\x44\x3b\xaf\xed\x6a\xfd\x02\x5f\x36\x4f\xd7\x5d\x2e\xdf\x6a\xc5\x76\xbe\x8e\xff\x1b\x65\x7f\x8c\x8d\xfb\xa5\x84\x6d\x33\x85\x7a\x4b\xc9\x92\x7f\x1f\xf8\x3b\x91\x3e\x02\xe8\xa7\x03\xa9\x67\x8d\x74\x81\xb4\xad\xd6\xbe\x7e\xdd\xf1\x8f\x5f\x8f\x79\xc9\x59\xbf\x1f\x93\x5c\x24\x8f\x6e\x70\x26\x97\xd7\x93\xe5\x02\xdb\x74\x77\x55\x36\xce\x51\x7f\xf3\x27\x75\x1f\x13\xc2\x24\xbb\xcc\x99\x8c\x5f\x39\x1f\x12\x32\x40\x10\x9f\x7d\x2e\xd8\xf6\x6f\x06\x37\xd8\x58\x86\xf7\xb4\xee\x42\x65\xbd\xe9\xb2\xd5\x3b\xc3\xcd\xb7\x65\x6e\x1d\x1b\x36\xea\x95\x6e\x7c\xe9\x45\xd5\xd9\x03\x37\xd6\x9c\x43\x3e\xc3\xfc\xc6\x00\x2f\x5c\x4d\xd2\xaa\x8a\xee\xca\xb2\x4a\x2d\xbd\x9a\xdb\x6b\x48\x84\x93\xba\x4f\xe9\x78\xa2\x94\x68\xd5\x46\x6f\xb2\xb7\x59\xbd\x83\x38\x86\xa0\xc6\xdb\xf1\x3b\x90\xfe\x2d\x9d\xe8\x8e\x96\xc8\x52\x73\x63\x6d\x9c\xf6\x0c\x75\xd7\x69\x8b\x5b\x09\xa0\x77\x76\x7c\xb4\xf2\x3d\x86\xc8\xbd\x94\x94\x83\x8a\x5c\x13\xfb\x8a\xf1\x76\x88\xbb\xd1\x3c\x02\xcc\x27\xc3\xa3\x67\x1d\xf3\x81\x1c\x54\xc8\x5e\xfa\x1f\xd1\xff\x46\xe6\x0a\x26\x0c\x76\x92\x3a\x2a\x39\x6a\xea\xa6\xda\xe1\x92\x7a\xbd\x74\x3d\xf0\x9f\xe1\x4d\x0a\x92\x8b\xa8\x8a\xfe\x19\x00\x6c\x3a\x2e\xcd\xa4\x21\x00\x00")
 
 func templates03_finishersGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -189,11 +216,11 @@ func templates03_finishersGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/03_finishers.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0x2f, 0x8d, 0x1f, 0x86, 0xfe, 0x9b, 0x99, 0x63, 0x53, 0x25, 0xe3, 0x1f, 0xc2, 0xe6, 0x1, 0x63, 0x2e, 0xba, 0x2e, 0x55, 0xf8, 0x6b, 0xeb, 0xe7, 0x7b, 0xbd, 0x46, 0x2b, 0x58, 0xd4, 0xf6}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcd, 0x2e, 0x5a, 0x44, 0x15, 0x27, 0xe2, 0x2, 0x4d, 0x5b, 0x99, 0x2a, 0xf8, 0xa0, 0xf, 0x17, 0xc3, 0xcd, 0xa0, 0x17, 0xc6, 0x85, 0x20, 0x22, 0x1b, 0x8, 0x52, 0x57, 0xf1, 0xce, 0xea, 0xce}}
 	return a, nil
 }
 
-var _templates04_relationship_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x93\x4f\x8b\xdb\x3c\x10\xc6\xcf\xf1\xa7\x18\x4c\x0e\xf6\x4b\x32\x7b\x5f\x08\x2f\x65\x97\xc0\xb6\x74\xe9\x36\x5d\x7a\x28\xa5\x68\xa3\x71\x2c\x2a\x4b\x8e\x24\x53\x82\xaa\xef\x5e\xf4\x27\x75\xd2\x2d\xf4\xe6\x89\x9e\xdf\x3c\x33\x8f\x22\xef\xd7\x20\x3a\xc0\x4f\xec\x45\x12\x3e\xd8\xb7\x5a\xa8\xf4\x0d\xeb\x10\xaa\x78\x4a\xd2\xe6\x62\x11\x2b\xc3\xd4\x81\x60\xd9\x7d\xa7\x13\xdc\x6e\xce\xdc\xf6\x1d\x9d\x6c\x16\x25\xd5\x52\xba\xd4\xe3\x76\x03\x4b\x7c\x23\x05\xb3\x64\xb3\x34\xa3\x38\x5b\x14\xa0\xfb\x07\xb0\xd5\x86\xc4\x41\xbd\xe2\x0c\xc9\x04\x65\x43\xfc\x48\x92\x39\xa1\x95\xed\xc5\x58\xc8\x47\x36\x5c\x11\x7b\xa6\x76\xba\x73\xf7\x24\xc9\x25\xc3\xe6\x40\xae\x58\x65\x4b\xfb\x17\xcf\x16\xef\xae\xb8\x10\xaa\x9b\x1b\xf0\x3e\x0e\x70\x16\x86\x00\xa3\x16\xca\x11\x07\xa7\xe1\xe5\x04\xae\x27\xe8\xf2\x19\xc4\x86\x55\x37\xa9\x3d\x34\x1a\xfe\xf3\xfe\x3c\xf1\xf3\xb8\x13\xea\x30\x49\x66\x42\x68\x5f\x35\x6c\x06\xcd\x2d\x20\xe2\x71\xc0\xa7\x89\xcc\xe9\xbd\xe6\x2d\x34\xde\x97\xc0\xf0\x5e\xff\x50\x73\x83\x24\x69\xc1\x57\x8b\x63\x11\xdb\xb8\xe1\x97\xaf\x17\xb8\xaf\x16\x8b\xe3\x80\x9f\x7b\x32\xd4\xd4\xb1\xd3\xc5\xae\x77\x5a\x4e\x83\x82\x9f\xb0\xc4\xa7\x49\x3b\xb2\x21\xc0\x06\xfe\xaf\x57\xa0\x71\x9e\xb9\xa8\x32\x99\x8b\x10\xda\x55\x4a\x58\x74\xc0\x14\x8f\xb7\xc8\xf9\x9c\x97\xfd\x33\xf7\x7c\x21\xc7\xa1\x27\x39\x92\xc9\xd3\x3c\xd8\xc7\x49\xca\xa6\xe6\x49\xc2\xbf\x31\x57\x97\xa6\x6b\x20\xc5\x23\x11\xaa\xcb\xd5\x36\xc0\xc6\x91\x14\x6f\x7e\xff\xb4\x82\x18\x18\x22\xb6\x67\x61\xdc\x7f\x8e\xeb\x79\xfc\x20\x27\xc3\x64\x08\x33\x93\xd4\x49\x2c\xc8\xe2\x8e\xdc\xd6\xe8\x21\x1f\xe7\xd0\x56\x50\x7b\x7f\xfd\x0f\x8c\x01\xed\xf6\x3d\x0d\x2c\xd5\x21\xd4\xd1\xd0\x90\x9b\x8c\x82\x84\x56\xe5\xf5\x28\x3e\xbf\xa4\xf2\xfd\x2b\x00\x00\xff\xff\xe8\x8f\x0c\x7b\x74\x03\x00\x00")
+var _templates04_relationship_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\x4d\x8b\xdb\x30\x10\x3d\xc7\xbf\x62\x08\x3e\xd8\x25\xd1\xde\x17\x42\x29\xbb\x04\xb6\xa5\x4b\xb7\xe9\xd2\x43\xe9\x41\x1b\x8d\x13\xb1\xb2\xe4\x48\x32\xad\x51\xf5\xdf\x8b\x3e\x1c\x3b\x4d\xa1\xf4\x26\x59\xef\xcd\x7b\xf3\x66\xec\xdc\x1a\x78\x03\xe4\x0b\x7d\x11\x48\x1e\xcc\x7b\xc5\x65\x3c\xc3\xda\xfb\x22\xbc\xa2\x30\xe9\xb2\x08\x37\x4d\xe5\x01\xa1\x6c\x5e\x71\x80\xdb\xcd\xc8\xdb\x7e\xc0\xc1\x24\x50\x44\x95\xc2\xc6\x1a\xb7\x1b\x28\xc9\x3b\xc1\xa9\x41\x93\xa0\x89\x9a\xcf\x33\x42\xf3\x0f\xc2\x56\x69\xe4\x07\x79\xc5\xd3\x28\x82\x8f\x2c\x48\x3e\xa3\xa0\x96\x2b\x69\x8e\xbc\xcb\xcc\x47\xda\x5e\x30\xf6\x54\xee\x54\x63\xef\x51\xa0\x8d\x82\xd5\x01\x6d\x96\x4a\x92\xe6\x2f\x9a\x35\xb9\xbb\xe0\x4d\xf5\xcc\xf9\xe3\x9d\x12\x7d\x2b\xff\xa3\xe4\x54\x2f\x51\xa3\x55\xef\x8b\x9b\x1b\x70\xae\xd4\x28\x46\xbc\xf7\xd0\x29\x2e\x2d\x32\xb0\x0a\x5e\x06\xb0\x47\x84\x26\xbd\xc1\x2b\x0e\xa4\x68\x7a\xb9\x87\x4a\xc1\x1b\xe7\xc6\x2c\x9e\xbb\x1d\x97\x87\x5e\x50\xed\x7d\x7d\x55\xb0\x6a\x15\x33\x40\x08\x39\xb5\xe4\xa9\x47\x3d\x7c\x54\xac\x86\xca\xb9\x3c\x0a\x72\xaf\x7e\xc8\xa9\x40\x84\xd4\xe0\x8a\xc5\x29\x83\x4d\x68\xf4\xdb\xf7\x19\xdd\xc5\x48\xf2\x86\xf0\x15\x94\x7b\x95\x86\x13\x5b\x4f\x2d\x8e\x5b\x72\x6a\xc9\xd7\x23\x6a\xac\x96\xce\x71\xc9\xf0\xe7\x65\x40\x23\xb8\xe4\xf0\x0b\x4a\xf2\xd4\x2b\x8b\xc6\x7b\xd8\xc0\xdb\xe5\x0a\x14\x99\xba\xcc\xa1\x07\x2d\xef\xeb\x55\xb4\x80\x92\x9d\x27\xce\x1b\xa0\x92\x85\xad\x62\x6c\xca\xdb\xfc\xb9\x07\xa3\xab\x23\x8a\x0e\x75\xf2\xf6\x60\x1e\x7b\x21\x82\xc3\xeb\x21\xcf\x5d\x2d\xb3\xec\x1a\x50\xb2\x50\xc7\x17\xf3\x98\x36\x40\xbb\x0e\x25\xab\xce\x9f\x56\x10\xc2\x27\x84\xd4\x23\x30\xc4\x34\x45\xff\xdc\x7d\x12\xbd\xa6\xc2\xfb\x89\x13\xd1\x11\xcc\xd1\x90\x1d\xda\xad\x56\x6d\x7a\x4e\x03\x58\xc1\xd2\xb9\x31\xbf\xb8\x60\x31\xba\xdd\xfe\x88\x2d\x8d\xf7\xe0\xb4\x28\x16\x1a\x6d\xaf\x25\x44\x6a\x91\xff\xf1\x1c\xd8\xfc\xfc\x7b\x00\x56\x64\x43\xca\x1a\x04\x00\x00")
 
 func templates04_relationship_to_oneGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,11 +236,11 @@ func templates04_relationship_to_oneGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/04_relationship_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2e, 0xb4, 0xd5, 0x6a, 0xe5, 0x1f, 0x32, 0x58, 0xf5, 0x93, 0xc7, 0x8a, 0x70, 0xed, 0xdc, 0xee, 0xc4, 0xc1, 0x1c, 0xc, 0xf2, 0x71, 0x21, 0xf2, 0x6c, 0xb3, 0xa4, 0x19, 0x46, 0xd9, 0x99, 0x82}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4, 0x3a, 0xe, 0xf7, 0x71, 0x92, 0xb6, 0x52, 0x19, 0xb2, 0x8d, 0x5d, 0xf1, 0xf3, 0x6d, 0x13, 0x59, 0x52, 0xdd, 0x39, 0x70, 0xb6, 0x18, 0xcf, 0x1b, 0x5f, 0xe6, 0xfd, 0x2a, 0x14, 0xb0, 0xa7}}
 	return a, nil
 }
 
-var _templates05_relationship_one_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\xd1\x6b\xdb\x30\x10\xc6\x9f\xe3\xbf\xe2\x30\x79\xb0\x47\x72\x7d\x2f\x84\x31\x5a\x0a\x1d\x5b\xb7\x2e\x2d\x7b\x18\x63\xa8\xd1\x39\x16\x93\x25\x47\x92\x19\x41\xd3\xff\x3e\x24\xd9\x71\xb2\x8e\xe5\x49\x8a\xbe\xdf\x7d\xa7\xef\x2c\xef\xd7\x20\x1a\xc0\x27\xf6\x22\x09\xef\xed\x7b\x2d\x54\x5a\xc3\x3a\x84\x22\x9e\x92\xb4\x79\xb3\x88\x3b\xc3\xd4\x9e\x60\x69\x48\xc2\xf5\x66\xc2\x9e\xf4\x27\x45\x5f\x48\x32\x27\xb4\xb2\xad\xe8\x6d\x06\x12\xb1\x94\x2e\xd5\xbb\xde\xc0\x12\xdf\x49\xc1\x2c\xd9\xcc\xa5\x32\x38\xbb\x8d\xfa\xe6\xff\xfa\x3b\x6d\x48\xec\xd5\x2b\xcc\x90\x4c\xea\x04\xe6\x1a\x78\xde\x53\xa6\x1f\x58\x77\x41\xed\x98\xda\xea\xc6\xdd\x92\x24\x97\x3c\xab\x3d\xb9\xd1\x2d\xbb\xda\xd7\xb6\x35\xde\x5c\x60\x21\x14\x57\x57\xe0\xfd\xa9\x07\xfc\xa0\x77\x4c\x86\x00\xbd\x16\xca\x11\x07\xa7\xe1\xe5\x08\xae\x25\x68\x72\x1d\xf8\x49\x47\x2c\x9a\x41\xed\xa0\xd2\xf0\xc6\xfb\x31\x26\x7c\xee\xb7\x42\xed\x07\xc9\x4c\x08\xf5\xbf\x6a\x56\x9d\xe6\x16\x10\xf1\xd0\xe1\xe3\x40\xe6\xf8\x51\xf3\x1a\x2a\xef\xa7\x4b\xdf\xea\x5f\x6a\xae\x91\x24\x35\xf8\x62\x71\x18\xc5\x29\xa1\x6f\xdf\xcf\x70\x5f\x2c\x16\x87\x0e\xbf\xb6\x64\xa8\x2a\xb3\xe7\x74\xe1\x1b\x2d\x87\x4e\xc1\x6f\x58\xe2\xe3\xa0\x1d\xd9\x10\x60\x03\x6f\xcb\x15\x68\x9c\xbb\x1e\x55\x09\xcc\xeb\x10\xea\x55\x01\xe3\xcf\x7b\xd1\x00\x53\x3c\x4e\x94\xf3\x39\x39\xfb\xf7\x00\xe2\x64\x26\xe8\xd0\xb5\x24\x7b\x32\xb9\xaf\x7b\xfb\x30\x48\x59\x95\x3c\x09\xf9\x0f\xe6\xca\x0b\x83\x35\x90\xe2\x71\xae\xa1\x38\xbf\xea\x06\x58\xdf\x93\xe2\xd5\xe9\xaf\x15\xc4\x00\x11\xb1\x9e\x84\x31\x8f\x39\xbe\xe7\xfe\xb3\x1c\x4c\x4a\xfa\xc4\x24\x75\x12\x0b\xb2\xb8\x25\x77\x67\x74\x97\x8f\x73\x88\x2b\x28\xbd\xbf\xfc\x34\x63\x62\xdb\x5d\x4b\x1d\x4b\xfb\x10\xca\x68\x68\xc8\x0d\x46\x41\x42\x8b\xf1\x81\x29\x3e\x3f\xb6\x71\xfd\x27\x00\x00\xff\xff\x9e\xcf\x1d\x38\x97\x03\x00\x00")
+var _templates05_relationship_one_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xcd\x6e\xdb\x3c\x10\x3c\x5b\x4f\xb1\x30\x74\x90\x3e\x28\x9b\x7b\x00\xe3\x43\x91\x20\x40\x8a\x36\x6d\xea\x04\x3d\x14\x3d\x30\xd6\xca\x26\x4a\x91\x32\x49\xa1\x35\x58\xbe\x7b\xc1\x1f\x5b\x72\x5d\x14\xf5\x89\xa4\x67\x66\x77\x67\x07\x72\xee\x0a\x78\x07\xf8\xcc\x5e\x05\xe1\x83\x79\xab\xb8\x8c\x67\xb8\xf2\xbe\x08\xff\x92\x30\xe9\xb2\x08\x37\xcd\xe4\x96\xa0\xd4\x24\xe0\x66\x75\xa4\x3d\xab\x0f\x92\x3e\x91\x60\x96\x2b\x69\x76\x7c\x30\x89\x10\x19\xa5\xb0\x51\xef\x66\x05\x25\xbe\x11\x9c\x19\x32\x89\x17\x65\xf2\x71\x86\xef\xfe\x8e\xbf\x57\x9a\xf8\x56\x5e\xd0\x34\x89\xa8\x1e\xfa\xca\x1a\x38\xef\x29\x22\xf0\x91\xf5\x67\xac\x0d\x93\x6b\xd5\xd9\x3b\x12\x64\x63\xcd\x6a\x4b\x36\x57\x4b\x55\xcd\x65\xd9\x1a\x6f\xcf\x68\x93\x9c\x39\x3d\xde\x2a\x31\xf6\xf2\xdf\x15\x27\xb9\xc4\x8c\x8d\x7a\x5f\x5c\x5f\x83\x73\xa7\xe1\xf0\x9d\xda\x30\xe1\x3d\x0c\x8a\x4b\x4b\x2d\x58\x05\xaf\x07\xb0\x3b\x82\x2e\xf9\x02\xdf\xe8\x80\x45\x37\xca\x0d\x54\x0a\xfe\x73\x2e\xfb\x8f\x2f\xc3\x9a\xcb\xed\x28\x98\xf6\xbe\xfe\x93\x66\xd5\xab\xd6\x00\x22\xee\x7b\x7c\x1a\x49\x1f\xde\xab\xb6\x86\xca\xb9\xa3\x9b\x77\xea\xbb\x9c\x34\x22\xa4\x06\x57\x2c\xf6\x19\x6c\xc2\xb4\x5f\xbe\xce\xe8\x2e\xfa\x92\x33\xc3\x1b\x28\x37\x2a\xe6\x26\xcc\x83\x69\xce\x63\x54\xf6\x3d\x7e\xde\x91\xa6\x6a\xe9\x1c\x97\x2d\xfd\x38\x33\xe9\x88\x2d\x39\xfc\x84\x12\x9f\x46\x65\xc9\x78\x0f\x2b\xf8\x7f\xd9\x80\xc2\x69\xcc\xec\x7b\xa8\xe4\x7d\xdd\xc4\x06\x48\xb6\xb1\x0a\xe4\x9f\x73\xbc\x03\x26\xdb\x10\xb0\xb6\x9d\x9c\x37\xbf\xe7\x61\x4e\xda\xf7\x3b\x12\x03\xe9\xd4\xe6\x83\x79\x1c\x85\xa8\x96\xce\x5d\xee\x7c\xde\xe1\xb2\x6e\x4e\x0a\x21\x70\x24\xdb\x90\x16\x5f\xcc\x6d\x5b\x01\x1b\x06\x92\x6d\x75\x7a\x6a\x20\x2c\x03\x11\xeb\x23\x30\xd8\x36\xad\xe2\x65\xf8\x28\x46\x1d\xb7\x76\xe2\x44\x74\x04\x73\x32\xb8\x26\x7b\xaf\x55\x9f\x24\xd3\x42\x1a\x58\x3a\x77\x16\xbb\x68\xe6\x7a\xb3\xa3\x9e\xc5\x7b\xe8\xb7\x28\x16\x9a\xec\xa8\x25\x44\x6a\x91\xbf\x02\xd9\xc2\xf9\xf9\xd7\x00\xa4\x2f\x6c\x6a\x3c\x04\x00\x00")
 
 func templates05_relationship_one_to_oneGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -229,11 +256,11 @@ func templates05_relationship_one_to_oneGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/05_relationship_one_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5a, 0x4f, 0x4a, 0xdc, 0x18, 0xd9, 0xbb, 0xcf, 0xb9, 0xb8, 0xb6, 0xab, 0x45, 0xe, 0xef, 0xc5, 0x6e, 0xb6, 0xc5, 0xd5, 0xf9, 0xd2, 0x9e, 0x2f, 0xbb, 0x4c, 0xf5, 0xc3, 0x74, 0x5e, 0x5e, 0xe3}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x62, 0x7a, 0xe1, 0xa9, 0xaa, 0xc3, 0x7c, 0x25, 0x87, 0x43, 0x65, 0xc0, 0x69, 0xd7, 0xf1, 0x6d, 0xab, 0x45, 0xbd, 0x14, 0x4c, 0xb8, 0xd2, 0x3c, 0x88, 0xab, 0x75, 0xfb, 0xbc, 0x2c, 0x49, 0xb8}}
 	return a, nil
 }
 
-var _templates06_relationship_to_manyGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x5d\x6f\xd3\x30\x14\x7d\x6e\x7e\xc5\x25\xaa\x44\x53\x75\x2e\xcf\x93\x22\x84\x36\x0d\x0d\xd8\xc4\xe8\x26\x1e\xa6\x09\x99\xe4\xa6\xb5\xe4\xd8\xad\xed\x6c\x4c\xc1\xff\x1d\xd9\x71\xf3\xd1\x66\x03\xde\x62\xe7\x9c\x73\x8f\xaf\xcf\x4d\xea\xfa\x04\x58\x01\xe4\x96\xfe\xe4\x48\x2e\xf5\x27\xc9\x84\x7f\x86\x13\x6b\x23\xf7\x16\xb9\x6e\x16\x13\xb7\x52\x54\xac\x11\xa6\x0a\x39\x9c\xa6\x7b\xda\xad\xbc\xa2\xe2\xf9\x1b\x72\x6a\x98\x14\x7a\xc3\xb6\xba\x61\x78\xca\x94\x1b\x2f\x78\x9a\xc2\x94\x7c\xe0\x8c\x6a\xd4\x0d\xd1\xeb\x90\xae\x5c\xc0\x17\xaf\xe3\x2f\xa4\x42\xb6\x16\x47\x34\x85\xdc\xa3\x87\xc4\x43\x67\x23\x1a\x7e\xe7\x9a\x96\xe1\xa9\x6b\x41\xbb\xfc\x22\x33\xca\x2f\x3e\xe3\xb3\x47\xf5\x6a\xea\x6c\x83\x25\x1d\xa8\xb9\xb6\x0c\x36\x7e\xc3\x94\xac\x3c\xee\xc8\x72\x46\xc5\x4a\x16\xe6\x1c\x39\x1a\xcf\x9c\xad\xd1\x84\xda\xcd\x91\xf5\x50\x2c\x21\x67\x03\x8a\xb5\xd1\x72\x09\x75\xdd\x1e\x9e\x78\xab\xd6\x82\x42\xa3\x18\x3e\xa2\x06\xca\x39\x98\x0d\x42\x5d\x1f\xfa\xd2\x4c\xac\x2b\x4e\x95\xb5\x6f\xb5\x13\x69\x1a\x4f\xee\xb6\x5f\x79\xa5\xbc\xca\x13\x33\x1b\xa0\x02\xf0\x17\x66\x95\x91\x2a\x0a\x79\x11\xd2\xc0\x0c\x77\x70\x50\x17\x0e\x25\x12\x6b\xe1\x91\xd1\xe0\x70\x5f\xff\x4c\xf2\xaa\x14\xd6\x42\xe6\x1f\x7c\xca\x44\x6e\x2d\x89\x8a\x4a\x64\x30\x93\x30\xaf\xeb\x10\x1b\x72\xb7\x5d\xb5\x36\x93\xb1\xa3\xce\x4a\x99\x6b\x20\x84\xec\x4a\x72\x53\xa1\x7a\xbe\x92\x79\xd2\x3b\xce\xb9\x7c\x12\x9d\x84\x47\x40\x1d\x4d\x1e\xa9\x82\x5d\x80\x6b\xb8\x7f\xe8\xb1\xa3\x09\x2b\x80\xa3\xf0\xca\x09\xbc\x49\xe1\x9d\x63\x4c\x3a\x78\x0a\x74\xbb\x45\x91\xcf\xda\xad\x05\x38\x30\x21\x24\x89\x26\x36\xf2\x17\xcc\x8a\x10\x70\x39\x9c\xaa\xd7\x75\x3c\x35\x04\xab\xe3\xb9\x4c\x0f\xc3\x39\x1e\xab\x5d\x49\x2e\x85\x40\xe5\x70\xb3\xf8\x58\xc8\x5a\x90\x02\xda\xfd\x7e\x20\xac\x25\x63\xd7\xe4\x0b\xdd\x54\xd2\xa0\xb6\x16\x52\x18\xd3\xdc\x13\xdd\xd6\xcb\xe4\x38\x59\x34\x0e\xbf\x6f\x50\xe1\xb8\xbb\xbe\x92\xbf\xdf\x11\x9d\xf4\x7d\xbc\x00\x49\xba\x88\x04\x8c\x27\xee\xb3\xe5\x6a\x25\xbe\x97\xdd\x07\xec\x6f\x7d\x1f\xb1\xf6\xbf\x0d\xfa\x67\x6f\x4d\x3e\xa8\xc8\xdd\xb7\x2a\xcf\xbb\x99\xd6\x87\x9f\x85\xfd\xc5\x6e\x90\x6f\x51\x35\x0e\x2f\xf5\x75\xc5\xf9\x6b\x3e\xe3\xdc\xb3\xf3\x1f\xd4\xc4\x23\xb7\xd0\xce\x5c\xdb\x25\xbf\x08\x3d\x72\x71\x1b\xfb\x1e\x74\xed\x6a\x82\xee\x96\x0c\x35\x59\xa1\xb9\x50\xb2\x6c\x5e\x37\x63\xb4\x80\x97\xcc\xc5\x49\xd4\x0e\xd8\x5e\xe0\x23\x9a\x15\x72\xcc\x4c\x5f\x22\x49\x20\xed\x8f\x5e\xa8\x74\x0c\x5c\xc0\xfd\x83\x36\x8a\x89\x75\xfd\x62\x47\xe6\xb1\x0d\x93\xa9\xd0\x54\x4a\x34\xb3\x1f\xd9\x28\xf2\x67\x77\x5d\x76\x3d\x59\xce\xc3\x2f\x4e\x0d\xfe\x66\xf3\x65\xf7\x3f\x1c\x80\x59\x01\xac\xf7\xd3\x0c\xb8\x3f\x01\x00\x00\xff\xff\xd0\x14\xc8\x5e\x56\x07\x00\x00")
+var _templates06_relationship_to_manyGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4b\x6f\xe3\x36\x10\x3e\x5b\xbf\x62\x2a\x18\xa8\x64\x28\x74\x0f\x45\x0f\x01\x8c\xa2\xf0\x36\x45\xda\x6e\xd0\xad\xb3\xe8\x61\xb1\x07\x46\x1a\xdb\x2c\x28\xd2\x21\xa9\xac\x03\x85\xff\xbd\xe0\xc3\x7a\xc4\xca\x03\xbd\x51\xa3\xf9\xbe\x99\xf9\x34\x33\x62\xdb\x5e\x00\xdb\x02\xb9\xa5\x77\x1c\xc9\xb5\xfe\x5d\x32\xe1\xcf\x70\x61\x6d\xe2\xde\x22\xd7\xe1\x61\xe6\x9e\x14\x15\x3b\x84\xb9\x42\x0e\x97\xab\x13\xec\x56\x7e\xa4\xe2\xf1\x6f\xe4\xd4\x30\x29\xf4\x9e\x1d\x74\x40\x78\xc8\x9c\x1b\x4f\x78\xb9\x82\x39\xf9\x85\x33\xaa\x51\x07\xa0\xe7\x89\xc7\x81\xff\xf6\x75\xff\x2b\xa9\x90\xed\xc4\x19\x4c\x21\xf7\xec\x63\xe0\xf3\xcc\x26\x38\xbc\xe5\x86\xd6\xf1\xd4\x4b\xd0\x3d\xfe\x29\x4b\xca\xaf\xfe\xc0\x47\xef\x35\x88\xa9\xcb\x3d\xd6\x74\xc4\xe6\x64\x19\x19\x9e\x60\x4e\x36\xde\xef\x2c\xe5\x92\x8a\x8d\xdc\x9a\x0f\xc8\xd1\xf8\x82\xb3\x1d\x9a\x18\x3b\x94\xac\xc7\x64\x39\x59\x8f\x20\x83\x4c\x3a\xe3\x5a\xf2\xa6\x16\xef\x63\xeb\xa9\x02\xca\xd7\x67\x6d\xb2\x5c\x42\xdb\x76\x8a\x12\x5f\xbf\xb5\xa0\xd0\x28\x86\x0f\xa8\x81\x72\x0e\x66\x8f\xd0\xb6\x23\x46\x78\x02\xcd\xc4\xae\xe1\x54\x59\xfb\xbd\x76\x24\xe1\x6b\x92\xcf\x87\xbf\x78\xa3\x28\xb7\x16\xbe\x31\xb3\x07\x2a\x00\x8f\x58\x36\x46\xaa\x24\x36\xa1\x90\x06\x32\xbc\xef\xbf\x64\x88\x0b\xcf\x29\x72\x6b\xe1\x81\xd1\x98\xe1\x29\x7e\x28\x40\xc3\x13\xfc\x2b\x99\x80\xb4\x80\xd4\x5a\x28\xbd\xb5\x6d\xd9\xd6\xd3\x92\x6b\xbd\x96\xf5\x41\x6a\x66\xd0\x5a\xdd\xb6\x28\x2a\x6b\x5d\x7c\x7f\x20\xc9\xb6\x11\x25\x64\x12\x16\x6d\x1b\xfb\x96\x7c\x3e\x6c\xba\x92\xf2\x29\x59\xb2\x5a\x56\x1a\x08\x21\xf7\x35\xf9\xd4\xa0\x7a\xfc\x28\xab\x7c\x50\xfa\x07\xf9\x4d\xf4\x14\xde\x03\xda\x64\xf6\x40\x15\xdc\x47\x77\x0d\x5f\xbe\x0e\xd0\xc9\x8c\x6d\x81\xa3\xf0\xcc\x39\x7c\xb7\x82\x1f\x1c\x62\xd6\xbb\xaf\x80\x1e\x0e\x28\xaa\xac\x33\x15\xe0\x9c\x09\x21\x79\x32\xb3\x89\x6f\x8b\x53\xd1\xb7\x72\x3c\xd6\xaf\xf3\x78\x68\xec\xec\x1e\x77\xb9\xea\xc7\xe1\xb5\xbe\xbe\xaf\xc9\xb5\x10\xa8\x9c\x5f\x96\x9e\x13\x59\x0b\x52\x40\x67\x1f\x36\x8f\xb5\x64\xea\x93\xfa\x40\x9f\x1a\x69\x50\x5b\x0b\x2b\x98\xe2\x3c\x01\x9d\xe9\x65\x70\x9a\x17\x4e\xc4\x9a\xfc\xb3\x47\x85\x59\xfa\x16\x93\x6f\xbf\x09\x9e\xd5\xcf\x69\x01\x92\xf4\x2d\x12\x7d\x7c\xee\xe1\x6c\xad\x8b\x95\x7b\x2d\xfb\x0d\xfa\xb6\xee\x71\xbf\xb2\x02\xe6\xa5\xe4\x9d\xea\xa7\xe6\xee\x34\x7e\x5e\x41\x2c\xba\x2f\x82\x89\x0a\x8f\x30\x35\x20\x73\xf6\xbe\x62\x4a\xc9\x43\x15\xae\x04\x51\xc5\xd8\x7e\x94\xa8\xa8\xdc\x6e\xae\xaa\x7e\x7f\xe8\xe7\xdb\xec\x94\xea\x1e\xf9\x01\x55\x90\xfc\x5a\xdf\x34\x9c\xbf\x96\xf6\xf9\x1e\x1b\xe6\x9a\xc6\x74\xe2\xb8\x76\x02\xbb\xd9\x4d\xa2\xbc\x4e\xb3\xa9\xb5\xd3\x2b\x1d\x66\xc4\x3d\x32\xd4\x64\x83\xe6\x4a\xc9\x3a\xbc\x0e\x13\x58\xc0\x4b\x19\xa6\x79\xd2\xcd\xe6\x89\xe0\x37\x34\x1b\xe4\x58\x9a\x21\x45\x9e\xc3\x6a\x38\xb5\x31\xd2\xb9\x63\x01\x5f\xbe\x6a\xa3\x98\xd8\xb5\x2f\xca\xb2\x48\x6d\x1c\x6a\x85\xa6\x51\x22\xac\x8d\xc4\x26\x6e\x4f\xaf\x65\x23\xcc\xd4\xb2\x2e\xdd\x0b\xfd\xbf\xb7\x34\x13\x1e\x5a\x51\x43\xef\xa8\x46\xbf\xb5\x65\x63\x80\x4b\x5a\x31\xb1\x73\x2f\xeb\x77\xec\xcb\x97\xd2\xcb\x7c\x23\xcd\xc9\x8d\x5c\x4b\x61\xf0\x68\xac\x75\xff\x03\xb8\x93\x8c\x93\x5f\xe3\x9f\x21\xcc\x8e\xb5\xa5\x39\x42\x19\xdc\x48\x74\x2f\xa0\x77\x8f\xa6\x01\xca\x35\x44\x01\x93\x7b\x39\x63\xc2\xfc\xf4\x63\x01\xa8\x94\x54\x39\xb4\x9d\xa8\x92\x4c\xa5\x59\xc7\x86\x21\xbe\x90\x90\xb5\xfb\x51\x8d\x32\x2f\xcd\xb1\x80\x18\xd6\xa5\x95\xbb\x6f\xd3\x4d\x8d\xeb\xd7\xe5\x22\x5e\x9d\xd4\xe8\x96\xb4\x58\xf6\xf7\xac\x91\x33\xdb\x02\x1b\x5c\xc6\x16\x4b\xb8\xb0\x36\xf9\x6f\x00\x26\x21\xc8\x69\xae\x09\x00\x00")
 
 func templates06_relationship_to_manyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -249,11 +276,11 @@ func templates06_relationship_to_manyGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/06_relationship_to_many.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7b, 0x5, 0x47, 0x5b, 0x4a, 0xef, 0x67, 0xe7, 0xc9, 0xa5, 0x6c, 0x57, 0x7d, 0xd6, 0x74, 0x67, 0x81, 0xa6, 0x4, 0x5e, 0x6b, 0xb8, 0xd0, 0x85, 0x62, 0x6c, 0xb1, 0x20, 0x59, 0x3b, 0x55, 0xdf}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0xbf, 0xf2, 0x85, 0x5b, 0xd9, 0xba, 0xf1, 0xca, 0xaf, 0x1d, 0x4d, 0x5d, 0xb1, 0x16, 0x92, 0x8a, 0x96, 0x12, 0xf8, 0xb5, 0x60, 0x5e, 0x1, 0x31, 0x90, 0x35, 0x83, 0x89, 0x32, 0xe7, 0x9f}}
 	return a, nil
 }
 
-var _templates07_relationship_to_one_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\xdf\x6f\xd4\x38\x10\x7e\x4e\xfe\x8a\xa1\xea\xa1\x04\xa5\x29\xbc\x72\x5a\x9d\xa0\x80\x8e\x3b\xd4\xbb\x6b\x41\x3c\x20\x44\xbd\xc9\x64\xd7\xd4\x6b\x6f\x6d\x07\x5a\x45\xf9\xdf\x4f\x63\x3b\xbf\xf6\x57\x81\xbe\x35\xde\xf9\x66\x3e\xcf\x7c\x9e\x19\xb5\x69\x4e\x80\x57\x90\xbf\x67\x73\x81\xf9\x5b\xf3\x97\xe2\xd2\xfd\x0d\x27\x6d\x1b\xd3\xaf\x28\x8c\xff\x88\xe8\x4b\x33\xb9\x40\x38\xae\xae\xf1\x0e\x9e\xcf\x3a\xdc\x9b\xbf\xf1\xce\x78\x23\x67\x75\x2c\xac\xf3\xf1\x7c\x06\xc7\xf9\x0b\xc1\x99\x41\xe3\x4d\x3d\x34\x1f\x42\x04\x40\x75\x0f\xe0\x8d\xd2\xc8\x17\x72\x0b\xa7\x51\x38\x90\x0f\x98\x5f\xa0\x60\x96\x2b\x69\x96\x7c\x1d\x90\xe7\x6c\x35\x41\x30\xbd\x20\xc4\x5a\x73\x69\x2b\x38\x5a\xb1\xbb\x39\xfe\x66\x8e\x7a\x17\x1f\xd6\x97\x5c\x2e\x6a\xc1\xf4\x18\x55\xa8\x49\x9c\x33\x25\xea\x95\x0c\x11\xc2\xc7\xf8\x36\x9d\x79\xb5\xc3\x3c\x5c\x65\x1b\x55\x1b\x34\xff\x6a\xbe\xe2\x96\x7f\x43\x43\xf8\x8d\x93\x63\x9f\x12\x33\x49\xe2\x84\xc3\x8e\x64\xdd\x13\xb4\x60\xf2\x52\x55\xf6\x15\x0a\xb4\x2e\xff\xc9\x02\x6d\x40\x4e\xc3\x8d\xbd\xa6\xf9\xd9\x04\xd7\xb6\xf1\xe9\x29\xbc\x53\xac\x6c\x1a\xaa\x49\x67\xdc\xb6\xc0\x84\x50\xdf\x0d\x30\x09\xc8\x16\xa8\x41\x28\x75\x5d\xaf\x41\x55\xf0\x8d\x89\x1a\x4d\x06\x05\x2b\x96\x58\x02\x97\x56\x81\x5d\x22\x79\x12\x8a\x95\x58\x82\xb1\xba\x2e\xac\x21\x63\xbb\x44\x50\xf3\xaf\x58\x58\x93\xc3\xfb\x25\x37\xc0\x0d\x54\x4a\x93\xe3\xf3\x93\x67\xa0\x47\x95\xcf\xe3\xaa\x96\x05\x24\x4d\xd3\xd5\xeb\x95\xfa\x2e\xbb\xb2\xb6\xed\xbb\x74\x27\xd5\xa4\x69\x78\x05\xc7\xf9\xb9\x3a\x53\xd2\xe2\xad\x6d\x5b\x84\xb9\xe2\x22\x7f\x7d\x8b\x45\x6d\x95\x6e\x1a\x7a\x0d\x6d\x5b\xd8\x5b\x28\xbc\x4d\x1e\x6c\x33\x08\xb6\xe1\x7b\x04\x91\x65\xdb\x66\x60\x3a\x55\xcd\x95\x12\x19\x34\x0d\x29\xb1\x6d\xe9\xda\xa8\x2b\x56\x60\xd3\x66\xb0\x52\xa5\x81\x9b\x1a\x35\x47\x93\xbf\x58\xaf\x05\x2f\x98\x55\x3a\x05\xd4\x5a\x69\x68\xe2\xe8\x1b\xd3\x60\x04\x2f\x10\x3e\x7d\x7e\x32\x5c\x70\x50\x2d\x95\x96\x8c\x7c\xb2\x60\x9f\x4d\x1c\xf1\x6a\xe0\xd4\xc4\x51\x14\x00\xb3\x9e\x5a\x9e\xec\x01\xa7\x71\xd4\xfa\xbe\x40\x38\xcf\x66\xe6\x22\x75\xb8\xbd\xdc\x08\x1a\xc7\x11\xd3\x0b\x27\xf0\x15\xbb\xc6\xe4\xd3\xe7\x49\x0e\x9e\x66\xf0\x2c\xdd\xa6\xc7\xab\x70\xa5\xfc\x02\x66\x33\x90\x5c\xb8\xe3\x68\x38\x84\xc7\xfb\x0a\x7e\xd1\x90\xde\xbd\xe6\xa9\xc4\x1b\xef\xca\x3f\x07\xc7\x69\x06\x6c\xbd\x46\x59\x26\xf4\x95\x75\x11\x9b\x86\x1a\x80\x63\x1f\x79\x0d\x04\x0c\xaf\xe0\x51\x57\xae\xb7\xe6\x9c\x8b\x64\x13\xe1\x49\xfe\xa0\x6f\xcf\xd0\x09\x66\x92\xe2\x7f\x6a\x8b\xfa\x79\x1c\x45\x24\xf8\x2f\x0e\x4a\xd9\xf3\xcd\xd8\xe7\xdf\x85\xf1\x39\xda\x48\x50\x14\x8e\xee\x4b\x8f\x2b\x4c\x1f\x82\x0d\x01\x1c\x79\xef\xea\x40\xfa\x5c\x74\x46\x91\x29\x5e\x77\xab\x1e\x37\x4a\x9a\xb3\xec\xb2\xf6\xfa\xa6\x66\x22\x61\xd9\x04\x95\x0e\x30\x59\xf6\xa8\x88\x9e\x1c\x97\x35\x82\xcb\x87\x3b\x1b\x11\x3f\xc4\x6d\x4f\xfe\x27\xc9\xdf\x20\xb9\xb3\xb4\x5b\x0c\x7f\xc8\x71\x1b\x47\xa3\xba\xd2\x67\x78\x7f\x02\xa5\xc3\xa4\x94\xb6\xa7\xce\xa5\x46\x5b\x6b\x49\xd5\xf3\x56\x44\xc1\x8d\xda\x73\xfc\xfe\x1f\xfd\x9d\xc4\x11\x00\xc0\xcd\x2a\x7f\xa3\xd5\x2a\xb9\x0a\x4d\xeb\x15\x67\x82\xe4\xf4\xc1\xe0\x65\xb1\xc4\x15\x6b\xdb\xa6\x39\xce\xbb\xbf\xf3\x10\xbe\x69\x26\x7d\xbc\x6d\xaf\xd2\xac\x77\xf8\x71\x89\x1a\xdf\xca\x07\xfb\xcc\x87\x13\x3f\x70\x5c\x9b\x83\x3f\xae\x32\xa7\xa5\x3c\xcf\xbb\xa0\x2e\x10\x93\x25\x4d\xfd\xb2\x1c\x06\x8a\xd9\x1c\x4c\xae\x28\x9e\xe6\x12\xc5\x1a\x75\x20\x6b\xce\x6b\x21\x1e\x4e\xb8\x74\x51\xca\x2f\xcc\x5e\x0d\xd4\x4e\xc0\x57\x8c\xbe\x7c\x43\x72\xed\xf9\xd1\xf0\xb6\xe8\xdb\xb5\xe9\xbb\xc4\xd5\x29\x74\xb7\xcd\x39\xe2\xd9\x6b\x34\xb5\xb0\x26\xa3\x5e\x4e\x05\x75\x88\xdc\xd7\x14\xd3\x78\x22\xbf\x03\xb6\xc1\x67\x52\xd8\xdb\x0c\x02\xae\x7b\x24\xbc\x72\x80\x11\xc3\x20\x27\x37\x3e\x4c\xfe\x51\xb3\x75\x82\x5a\x67\x70\x54\x31\x2e\xb0\x04\xab\xfa\xb1\xcc\x4a\xea\xfc\xd5\x76\xcf\x3e\x0a\xd7\xa2\xa9\xe2\x89\x5d\x8e\x06\xd0\x0e\x40\x4f\x64\xd6\x3f\xf3\x97\x5c\x96\x49\x7f\xab\xc7\x23\x37\xe9\xef\xbf\xc0\x79\xce\x65\x39\x22\x4e\xab\x82\xef\x82\x07\x2f\xd0\xb3\x0a\x44\xf2\x33\xa1\x0c\x26\xbf\xc4\xa0\x20\x68\xe7\x88\x16\x94\x51\x1a\xa9\x85\x6e\x69\xcc\x93\xd8\xe6\xf0\x5a\xeb\x9f\x61\xe0\x17\x01\x55\x14\xb5\xd6\x58\x42\x59\x6b\x2e\x17\xc0\x2d\x6a\xb7\xfe\x4c\x99\x60\x39\xec\x45\x87\x58\x05\xc9\x4a\x65\x9d\x6c\xff\x54\xea\xda\xf4\x8a\xa2\x26\xb5\x6f\x72\xbc\xa8\x2c\xea\x4b\xa4\x47\xe7\x40\x29\xdd\xc1\x37\xb2\x5d\xa3\x6a\xac\x9e\x6e\x60\x05\x85\x53\xd3\x2c\xd5\xa6\xbf\x5d\x2b\xd9\x68\x09\xcb\x00\xc3\x93\xde\xce\xe0\x38\x87\x5d\x13\x76\x9d\x37\x1a\x5e\x76\x7f\xbf\xb1\x1e\xf7\xf7\xe2\xcd\x95\xa4\xf2\xa9\x74\xf7\x1b\x1c\x7c\x7a\xfa\x39\x1e\xd6\x92\x7c\x6b\x21\x9e\x41\xc0\x75\xeb\x48\x97\xf6\x97\xac\xb8\xbe\xc0\x0a\x35\xca\x82\x8a\xda\xaf\x18\xc1\x7e\x63\xae\x8f\x4e\xdd\x68\xaf\x0e\x6f\x3e\xa1\x2b\xb9\x55\xfe\x83\xe4\x37\x75\x37\xe9\x7a\x3f\x1d\xd5\x77\xaa\x60\xc2\x11\xf5\x97\xd8\x9a\x8d\x07\x10\x61\x10\xee\xb3\xe8\xb6\x9e\x34\xde\x98\xee\xe3\xbf\x37\xd3\x1e\x94\x24\xc8\xc5\xae\xb5\x27\xfc\x3e\xae\xc6\x6e\xb5\x1d\xda\x10\x48\x08\x14\x60\xd8\x5c\x66\x7d\xa1\xe8\xac\x1a\xad\x33\x5b\x8b\xc2\x74\x99\x99\xfa\xc9\xb6\xbd\xa4\xbd\x9b\xd1\x76\xe3\x51\xf7\xe8\xe5\x87\x14\x73\x40\x33\x3f\xa5\x9a\xa0\x9b\xfd\xca\x39\xa8\x04\x77\x9f\x0e\x3f\xd9\xfe\x1e\x22\x1f\xe7\x35\xed\xdd\x8e\xf2\x37\xfd\x9a\x6b\x64\xd7\x93\x67\x1f\x8f\x75\xd5\xc6\xbd\x79\xd3\x9c\x3e\x09\x82\x79\x72\xda\x86\x1f\xc2\xf1\x57\xc5\x25\xf8\xff\x4c\xb8\xdf\xfe\x0f\x00\x00\xff\xff\x09\x42\x75\xe0\x2e\x11\x00\x00")
+var _templates07_relationship_to_one_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x51\x6f\xe3\x36\x12\x7e\x96\x7e\xc5\xd4\xf0\xed\xd9\x81\x56\xd9\xbe\x6e\x61\x1c\xb6\xe9\x2e\xba\x77\x8b\xdc\x35\xd9\xa2\x0f\x41\x70\x60\xa4\x91\xcd\x86\x26\x1d\x92\xda\x6c\xa0\xe3\x7f\x3f\x0c\x45\x49\x94\x65\x3b\x69\xaf\xb8\x3e\x04\x08\xe5\x99\xe1\x37\x1f\xbf\x19\x0e\x9b\xe6\x35\xf0\x0a\xf2\xcf\xec\x4e\x60\xfe\xd1\xfc\x5d\x71\xe9\xff\x87\xd7\xce\xa5\xf4\x2b\x0a\xd3\x2e\x12\x5a\x69\x26\xd7\x08\xf3\xea\x1e\x9f\xe0\xed\xaa\xf3\xfb\xf0\x0f\x7c\x32\xad\x91\xb7\x9a\x0b\xeb\x63\xbc\x5d\xc1\x3c\x7f\x27\x38\x33\x68\x5a\xd3\xd6\x35\xfc\x1f\x39\x54\xcf\x38\x7c\x50\x1a\xf9\x5a\x4e\xfc\x34\x0a\xc2\x11\x36\xcc\xaf\x50\x30\xcb\x95\x34\x1b\xbe\x0b\x9e\x97\x6c\x3b\xf2\x60\x7a\x4d\x1e\x3b\xcd\xa5\xad\x60\xb6\x65\x4f\x77\xf8\x17\x33\xeb\x43\xfc\xbc\xbb\xe6\x72\x5d\x0b\xa6\x63\xaf\x42\x8d\xf6\xb9\x50\xa2\xde\xca\xb0\x43\x58\x44\xd6\x55\x67\x5e\x1d\x30\x0f\xa9\x4c\xbd\x6a\x83\xe6\x5f\x9a\x6f\xb9\xe5\x5f\xd0\xd0\x76\x7b\x5f\xe6\x2d\x25\x26\x04\x8a\xf9\x39\xb4\xc3\x01\xfe\xa6\x9b\x16\x4c\x5e\xab\xca\xfe\x80\x02\xad\xe7\x7f\xb1\x46\x1b\x3c\xc7\xdb\xc5\x51\x97\xf9\xc5\xc8\x6f\x88\x67\xfa\x8f\x61\xaf\x97\x87\x1c\xe2\xb5\xae\xfe\xe4\x9c\x4b\xcf\xcf\xe1\x93\x62\x65\xd3\xcc\x35\x8a\xce\xc7\x39\x60\x42\xa8\x47\x03\x4c\x02\xb2\x35\x6a\x10\x4a\xdd\xd7\x3b\x50\x15\x7c\x61\xa2\x46\x93\x41\xc1\x8a\x0d\x96\xc0\xa5\x55\x60\x37\x48\x91\x84\x62\x25\x96\x60\xac\xae\x0b\x6b\xc8\xd8\x6e\x10\xd4\xdd\xaf\x58\x58\x93\xc3\xe7\x0d\x37\xc0\x0d\x54\x4a\x53\xe0\xcb\xd7\xdf\x82\x8e\x34\x95\xa7\x55\x2d\x0b\x58\x34\x4d\xa7\x84\x1f\xd4\xa3\xec\x04\xe3\xdc\xa7\xe5\x41\xa8\x8b\xa6\xe1\x15\xcc\xf3\x4b\x75\xa1\xa4\xc5\xaf\xd6\x39\x84\x3b\xc5\x45\xfe\xfe\x2b\x16\xb5\x55\xba\x69\xa8\xce\x9c\x2b\xec\x57\x28\x5a\x9b\x3c\xd8\x66\x10\x6c\xc3\x3a\x72\x91\xa5\x73\x19\x98\x4e\xaf\x77\x4a\x89\x0c\x9a\x66\xce\xf4\xda\x39\x4a\x1b\x75\xc5\x0a\x6c\x5c\x06\x5b\x55\x1a\x78\xa8\x51\x73\x34\xf9\xbb\xdd\x4e\xf0\x82\x59\xa5\x97\x80\x5a\x2b\x0d\x4d\x9a\x7c\x61\x1a\x8c\xe0\x05\xc2\xcd\xed\x59\xd3\x4c\xeb\x81\x0e\x99\x8c\x5a\xb2\xe0\x98\x4d\x9a\xf0\x6a\xc0\xd4\xa4\x49\x12\x1c\x56\x3d\xb4\x7c\x71\xc4\x79\x99\x26\x0e\x88\x09\x02\x94\xb4\x68\x56\x70\x16\xf9\x1d\xc5\x46\xae\x69\x9a\xb4\x4c\xfb\x32\xf8\x68\x2e\xd4\x76\xa7\x0c\xb7\xa1\x01\x30\xbd\xf6\x65\xb5\x65\xf7\xb8\xb8\xb9\xbd\xb9\x1d\x31\xf4\x26\x83\x6f\x97\x53\xf0\xbc\x0a\x09\xe7\x57\xb0\x5a\x81\xe4\xc2\x63\x0b\x49\xd1\x47\x78\x75\x4c\x0e\x57\x0d\xd5\x05\xfd\x9d\x9f\xc3\x3b\xa0\xa6\xf9\xc8\xed\x06\x18\xc8\x5a\x08\x28\xbc\xcc\xa1\x60\xf2\xaf\x16\xb6\xcc\x16\xf4\x8b\x56\x8f\xed\xae\xa1\xc5\x8e\x50\x36\x10\x35\x61\x9e\xc1\xbc\xa0\x7c\xe2\x16\x60\x9c\x6b\x29\xe0\x24\x8d\xa0\x91\x80\x75\x80\x19\x6a\x73\x5e\x90\x35\xca\x92\xf8\x01\xf7\x1d\x7c\xd3\x29\xe4\x47\x66\x2e\xb9\x58\x50\xdc\x3c\x5f\xb6\x19\x7b\xfa\x56\xc0\x76\x3b\x94\xe5\x82\x56\x19\xa5\xb4\x6c\x53\x8c\xce\xed\x9f\xb5\x45\xfd\x36\x4d\x12\xaa\xa2\x7f\x67\xc4\x1f\xc1\x6c\x61\xb7\x87\xea\x03\xb6\xd4\xee\xf1\x9a\x84\x4f\xcf\xb1\xea\x4f\x3b\x49\xfe\x58\x96\x9e\xa5\x28\xc0\x3e\x45\x53\x42\xf5\xcb\x65\x8d\xb4\xf0\x48\x03\x0d\x6c\x20\x81\xc8\x0b\xd6\x51\xb4\xf7\x0f\x35\x13\x9f\xeb\x9d\xc0\x05\x6b\xa9\x0d\x36\x7d\x48\xf0\xd4\xfa\x6f\x11\x07\xcf\x1c\x0c\x15\xc5\x70\x8b\xef\x15\xc1\xff\xaf\x04\x5a\x59\xee\x5d\x69\xbe\x2e\x0f\x2a\x2b\x04\x6f\x9a\x79\xa1\x84\x73\xa4\xb2\x38\x0d\xaa\x90\x5e\xad\x1f\xfd\x29\xec\x7b\x1c\x57\xed\x81\xd8\x04\x23\xe8\xe0\x4f\xd3\xf2\x49\x9d\x9c\xa0\xcf\x9f\x10\xa3\x2a\x0a\x12\xf6\x59\xf5\x7e\x11\x69\x53\xb9\x91\xd2\x62\xaf\x4e\x72\xbd\xe6\x5f\x22\xc0\x53\xd8\x8e\xf0\x3f\x6c\x98\x4e\x40\x1e\x3c\xda\x09\xc2\x17\x05\x76\x21\x3a\xdd\x94\x71\x39\xb4\x6b\x5e\x81\x40\xe9\x05\xb7\x24\xfa\xde\xf8\xd0\x1a\x6d\xad\x25\x9d\x22\x59\xa7\x09\x41\xf1\x4d\xe6\x12\x1f\x7f\xa2\xff\x17\x69\x02\x00\xf0\xb0\xcd\x3f\x68\xb5\x5d\xcc\x9a\xa6\xbb\xea\xfd\xc0\x04\xff\x81\x79\x7e\x5d\x6c\x70\xcb\xfc\xda\xb9\xd9\x32\x6b\x5d\x4e\xdd\x4e\xf4\xfb\xc3\x76\x83\x62\x87\x3a\xff\x65\x83\x1a\x3f\x4a\xdf\x0c\xcc\xe2\xe6\xd6\x58\xcd\xe5\xfa\x54\x63\x0b\x08\x4e\xf4\xb7\x59\xd3\x4c\xc7\xae\x29\x58\x4f\xb4\xff\xfc\x53\xad\x2c\x1a\xe7\x66\x51\x03\xcc\xc0\xb3\xd5\xe7\x33\x9c\x5b\xa0\x24\x20\x7f\x01\x2b\xf9\x60\x11\x9a\x6d\xbc\x29\x70\x09\x7f\x9b\xb5\xdb\x51\x73\x1d\x76\xec\x74\xd9\x13\xca\x64\x49\xcf\x86\xb2\x1c\x26\x48\xb3\x3f\xd9\x1e\xa3\xd8\x5c\xd6\x42\xbc\x0c\xec\x74\xb6\x1d\x91\x34\x20\x7c\x0d\x9e\xef\x94\x56\xed\x44\xe1\xa7\xaf\x6f\x86\xce\x40\x6b\x3f\x85\x3d\x2d\xbc\xba\x46\xc3\xcb\x30\x26\xb6\x79\x6a\x34\xb5\xb0\x26\xa3\x51\x8d\xce\xdb\x7b\xe4\xad\x12\x71\x39\xee\xee\x27\x6c\x43\xcc\x45\x61\xbf\x66\x10\xfc\x3a\x2a\x79\xe5\x1d\x22\x84\xa1\x08\xfc\x74\x68\xf2\x5f\x34\xdb\x2d\x50\xeb\x0c\x66\x15\xe3\x02\x4b\xb0\xaa\x9f\xba\x59\x49\x83\x5d\x35\x1d\xc9\x66\x21\x2d\x1a\x1a\x5b\x60\xd7\xd1\x7c\x79\xc0\xa1\x07\xb2\xea\x9b\xd4\xf7\x5c\x96\x8b\x3e\xab\x57\x51\x98\xe5\x77\xbf\x03\xf3\x1d\x97\x65\x04\x9c\x5e\x02\x1e\xd2\xe9\x04\x7a\x54\x01\x48\x7e\x21\x94\xc1\xc5\xef\x42\x50\x90\x6b\xa0\xc3\xbf\x3f\x22\x1a\xe9\x02\xd8\x53\x62\x07\x62\x8a\xe1\xbd\xd6\xbf\x05\x81\xff\x02\xaa\x28\x6a\xad\xb1\x84\xb2\xa6\x86\x02\xdc\xa2\xf6\xaf\x9b\x31\x12\x2c\x87\x67\xcf\x29\x54\x41\xb2\x52\x59\xff\xba\xf9\x51\xa9\xfb\xd0\xf8\x43\x6b\x3d\x76\xef\xbd\xab\x2c\xea\x6b\x14\x58\x58\xef\xb4\x24\x16\xdb\xf6\x7b\xe8\xa2\x8d\xd5\xd3\x5d\xb7\x41\xe1\xd4\xf2\x4b\xb5\x1f\xef\xd0\x8b\x2b\x7a\x63\x65\x80\xa1\x29\x4e\x19\x8c\x39\xec\xae\x90\xee\xde\xe8\x2a\xbb\xcf\x2f\xd6\xe3\xf1\x1b\x64\x7f\xa0\xaa\xda\x03\xf6\xf9\x0d\x01\x6e\xde\xdc\xf6\x8f\xa5\xfc\x2a\x9f\xbc\x77\x57\x10\xfc\xd2\x64\x4c\xfb\xf7\xac\xb8\xbf\xc2\x0a\x35\xca\x82\x0e\xb5\x1f\x90\x82\xfd\xde\x54\x12\x7d\x85\x57\x83\xf0\x8f\xcd\x6d\xa1\x2b\xf9\x9b\xe3\x67\xc9\x1f\xea\xd0\x6a\xba\x2c\x06\xa8\x9f\x54\xc1\x68\xf0\x58\x85\x01\x6b\x72\xb3\x9f\xf0\x08\xd7\xf8\x31\x8b\x6e\x66\xeb\xa6\x85\xae\x71\x8d\xfe\xdf\xa7\x3d\x28\x49\x50\x88\x43\x43\x5b\xf8\x3d\xec\x79\x42\x6d\xa7\xae\xed\x63\x13\xfc\x1f\xf1\x22\xf1\xc8\x9f\x7d\x93\x64\x2f\x7f\xfd\x3c\x3f\x24\x74\x07\x30\xe8\xe2\xf0\xa6\x61\x0a\x0b\xc7\x7b\x7c\xfc\xa3\x3a\xe9\xb2\xf0\xe3\x1b\x49\x31\xde\x24\x9a\x55\x47\x5a\x99\x4e\xaa\xe3\x38\xd9\x34\xca\x80\xa9\x97\x44\x92\xb4\x5e\xcf\x94\xd3\x8b\x0a\xea\x44\x49\xfd\xa6\xa2\x0a\x65\x75\xbc\xb0\x4e\x16\x8a\xcf\xa7\xf3\x8f\xf9\xfa\x9f\xaa\xcb\x47\x5d\xf6\x61\x23\xfe\xc6\xab\x3b\x8d\xec\x7e\xd4\x15\xd3\xb8\xdb\xb9\xb4\x37\x6f\x9a\xf3\xb3\xa0\xc2\xb3\x73\x17\x7e\x08\x9f\x7f\x55\x5c\x82\x65\x77\x02\xe1\xec\xdc\xb9\xf4\xbf\x03\x00\xe3\x1f\x50\xed\x86\x16\x00\x00")
 
 func templates07_relationship_to_one_eagerGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -269,11 +296,11 @@ func templates07_relationship_to_one_eagerGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/07_relationship_to_one_eager.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2a, 0x53, 0xe0, 0xf0, 0x74, 0x43, 0xf4, 0x73, 0x9d, 0x23, 0x81, 0x73, 0x66, 0x2e, 0xb5, 0x45, 0xea, 0x31, 0x3d, 0xe3, 0x1a, 0x77, 0x80, 0x7d, 0x60, 0x23, 0xa, 0xd4, 0x14, 0x6c, 0x62, 0xd2}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc3, 0x81, 0x51, 0x50, 0x52, 0x27, 0x87, 0x88, 0x52, 0x85, 0x1e, 0x2e, 0x49, 0x3a, 0x6d, 0x58, 0xa1, 0xd6, 0x29, 0xe7, 0x46, 0xc8, 0x5a, 0xcb, 0x7c, 0x77, 0x79, 0xe0, 0xec, 0x9f, 0xbc, 0xb7}}
 	return a, nil
 }
 
-var _templates08_relationship_one_to_one_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x6b\x6f\xd4\x3a\x13\xfe\x9c\xfc\x8a\x79\xab\xbe\x28\x8b\x52\x17\xbe\x72\xb4\x3a\x82\x02\x3a\x1c\xa1\x72\x4e\x0b\xe2\x03\x42\xd4\x9b\x4c\x76\x4d\xbd\xf6\xd6\x76\xa0\x28\xca\x7f\x3f\x1a\xdb\xb9\xed\x8d\xab\x84\xb4\x71\xe6\x99\x79\xe6\xe2\x67\xd2\xa6\x39\x03\x51\x01\x7b\xcb\x17\x12\xd9\x2b\xfb\xb7\x16\xca\xff\x86\xb3\xb6\x4d\xe9\x2d\x4a\x1b\x1e\x12\x7a\x32\x5c\x2d\x11\x4e\x0d\x4a\x78\x32\xef\x60\x6f\xf5\x1b\x85\x57\x28\xb9\x13\x5a\xd9\x95\xd8\xd8\x00\xf0\x88\x53\xe9\xbc\xbf\x27\x73\x38\x65\x4f\xa5\xe0\x16\x6d\xc0\x79\x37\x6c\x88\x16\xed\xab\xe3\xf6\x2f\xb5\x41\xb1\x54\x3b\x30\x83\xd2\x5b\x7b\x60\xf0\xc1\xc6\x9c\x02\xfa\x92\xaf\x27\xa8\x42\xfb\x44\x22\x49\x76\xa1\x65\xbd\x56\xc1\x34\xfe\x1e\x33\xeb\xac\xab\x5d\xeb\x48\x6b\x17\x54\x5b\xb4\xff\x18\xb1\x16\x4e\x7c\x41\xcf\x6e\xeb\xe4\x34\x64\x67\xc7\xe5\x18\x13\xd8\xcd\xfa\x78\x40\x6e\x96\x14\x65\x63\x84\x72\x15\x9c\xac\xf9\xb7\x05\xfe\xdf\x9e\xf4\x39\xbe\xdb\x5c\x0b\xb5\xac\x25\x37\x93\x42\x70\x75\xad\x2b\xf7\x1c\x25\x3a\x5f\xfc\x6c\x89\x2e\x86\x9b\x10\x1c\x33\x99\xb1\x8b\x09\xac\x6d\xd3\xf3\x73\x78\xad\x79\xd9\x34\x7d\x43\xd8\x6b\x5d\x70\xd9\xb6\xc0\xa5\xd4\x5f\x2d\x70\x05\xc8\x97\x68\x40\x6a\x7d\x5b\x6f\x40\x57\xf0\x85\xcb\x1a\x6d\x0e\x05\x2f\x56\x58\x82\x50\x4e\x83\x5b\x21\x39\x93\x9a\x97\x58\x82\x75\xa6\x2e\x9c\x25\x63\xb7\x42\xd0\x8b\xcf\x58\x38\xcb\xe0\xed\x4a\x58\x10\x16\x2a\x6d\x80\xc3\xe3\xb3\xc7\x60\x46\x3d\x67\x69\x55\xab\x02\xb2\xa6\xe9\x92\x7f\xae\xbf\xaa\x2e\xfd\xb6\x7d\x3d\x3b\x44\x36\x6b\x1a\x51\xc1\x29\xbb\xd4\x17\x5a\x39\xbc\x77\x6d\x8b\xb0\xd0\x42\xb2\x17\xf7\x58\xd4\x4e\x9b\xa6\xa1\x9b\xd1\xb6\x85\xbb\x87\x22\xd8\xb0\x68\x9b\x43\xb4\x8d\xcf\x23\x88\x2a\xdb\x36\x07\xdb\x35\x60\xa1\xb5\xcc\xa1\x69\xa8\x69\x6d\x4b\x89\xa3\xa9\x78\x81\x4d\x9b\xc3\x5a\x97\x16\xee\x6a\x34\x02\x2d\x7b\xba\xd9\x48\x51\x70\xa7\xcd\x0c\xd0\x18\x6d\xa0\x49\x93\x2f\xdc\x80\x95\xa2\x40\xf8\xf0\xf1\xe1\x90\xe3\xd0\x60\x6a\x2f\x19\x85\x72\xc1\x21\x9b\x34\x11\xd5\xc0\xa9\x49\x93\x24\x02\xe6\x3d\x35\x96\x1d\x00\xcf\xd2\xa4\x0d\x1a\x41\xb8\xc0\x66\xee\x23\x75\xb8\x83\xdc\x08\x9a\xa6\x09\x37\x4b\x7f\x2d\xd6\xfc\x16\xb3\x0f\x1f\x27\x35\x78\x94\xc3\xe3\xd9\x2e\x3d\x51\xc5\x94\xd8\x15\xcc\xe7\xa0\x84\xf4\xc7\xc9\x70\x08\x0f\x0e\xf5\xfc\xaa\xa1\x99\xa7\xff\x3e\xf0\x1c\xf8\x66\x83\xaa\xcc\xe8\x29\xef\xdc\x36\x0d\x29\xc3\x76\x76\x6f\x6a\x87\xe6\x49\x9a\x24\x34\x6d\x9f\xbc\x31\x11\x0f\x9a\x18\x52\xf7\x34\x02\xbd\x2d\x6e\x49\x3c\xfa\x1e\x33\x5f\x93\x3e\x04\x1f\x02\x78\xba\xc1\x55\x18\xce\x2d\x1d\x09\x97\xd9\x47\xe7\x14\x99\xe2\x75\x79\xf4\xb8\x41\xcd\x83\x65\x37\x5f\x2f\xee\x6a\x2e\x33\x9e\x4f\x50\xb3\x01\xa6\xca\x1e\x95\xd0\xb4\x0b\x55\x23\xf8\x7a\xf8\xb3\x11\xf1\x03\x55\x1d\x97\x94\xcc\xe3\xd4\x49\x54\xde\x66\x46\x8c\x1f\xf9\x78\x06\x5d\x6d\x14\x15\x2e\x58\x11\xc5\x6f\x54\x86\x4b\xfc\xfa\x2f\xfd\xce\xd2\x04\x00\xe0\x6e\xcd\x5e\x1a\xbd\xce\x6e\xe2\x55\x7d\x2e\xb8\xa4\xde\xbd\xb3\x78\x5d\xac\x70\xcd\xdb\xb6\x69\x4e\x59\xf7\x9b\xc5\xdb\xd7\x34\x13\x09\x6b\xdb\x9b\x59\x9e\x42\xfc\x77\xb7\x66\xef\x57\x68\xf0\x95\xfa\x6d\xb7\x6c\x38\x09\x1a\xed\xef\x37\xfc\x79\x93\xfb\x4e\x32\xc6\x66\x79\x48\xc4\x07\xe2\xaa\xa4\x7d\x57\x96\x83\x9c\xda\x6d\x55\xf6\x1d\x08\x34\x57\x28\x37\x68\x22\x59\x7b\x59\x4b\xf9\xfb\x84\x4b\x1f\xa5\xfc\xc4\xdd\xcd\x40\xed\x0c\xbc\xb5\xaf\x50\xb8\x89\x5e\x97\xfe\x37\x4c\x36\x3d\x7b\x7d\xfa\x96\xf9\x56\xc5\x6b\xbd\x2d\xa0\x81\xbd\x41\x5b\x4b\x67\x73\x12\x31\xea\xa9\x47\xb0\xd0\x56\x9c\xa5\x93\x09\x3d\x62\x1b\x7d\x66\x85\xbb\xcf\x21\xe2\xba\x11\x15\x95\x07\x8c\x18\xc6\x89\xf2\xba\x69\xd9\x7b\xc3\x37\x19\x1a\x93\xc3\x49\xc5\x85\xc4\x12\x9c\xee\x37\x12\x2f\x49\xf2\xaa\x5d\xb1\x3a\x89\x69\x91\x9c\x06\x62\xd7\x23\xe5\xdd\x03\xe8\x89\xcc\xfb\x4b\xf6\x4c\xa8\x32\xeb\xb3\x7a\x30\x72\x33\xfb\xe3\x17\x38\x2f\x84\x2a\x47\xc4\x69\x4b\x06\x0d\x3a\x9a\x40\xcf\x2a\x12\x61\x17\x52\x5b\xcc\x7e\x89\x41\x41\xd0\xce\x11\xed\xe6\x51\x19\x49\xc0\x76\x66\x2c\x90\xd8\xe5\xf0\xc2\x98\x9f\x61\x10\x36\xa0\x2e\x8a\xda\x18\x2c\xa1\xac\x8d\x50\x4b\x10\x0e\x8d\x5f\xfd\x53\x26\x58\x0e\xdf\x04\xc7\x58\xc5\x91\x55\xda\xf9\xb1\xfd\x4b\xeb\x5b\xdb\x4f\x14\xe9\xd4\x21\xdd\x7e\x5a\x39\x34\xd7\x48\x97\xce\x83\x66\x94\x43\xd0\xb2\x7d\x8b\x62\x3c\x3d\xdd\xba\x88\x13\x4e\x3a\x59\xea\x6d\x7f\xfb\xbe\x45\x46\x5f\x1f\x39\x60\xbc\xd2\xbb\x15\x1c\xd7\x30\x8d\x3a\xed\xc5\x37\x19\x6e\x76\x9f\xdf\x78\x1e\x0f\xcb\xf1\xf6\x2e\xae\x42\x29\x7d\x7e\x83\x83\x0f\x8f\x3e\xa6\xc3\x3e\x66\xfb\x3e\x07\xe7\x10\xa1\x69\x32\xad\xfc\x33\x5e\xdc\x5e\x61\x85\x06\x55\x41\x7d\x0d\x8b\x47\x54\x9d\xfd\xd6\x62\x1d\x9d\xfa\xdd\x5a\x1d\xdf\xfa\xbd\xf9\x84\x54\x1c\x08\x4f\x2b\xb0\x4e\x27\x7b\x8f\x32\x8f\xcd\x94\xc4\x7f\xdf\xde\x8f\xef\xc7\x05\xd9\xdf\xf0\x63\xeb\x9b\x7a\x41\x01\x86\xd5\x3d\xef\x0b\x45\x67\xd5\x68\x9f\x4f\xd7\xf9\xce\x36\x9f\xfa\xc9\x77\xbd\xcc\x7a\x37\xa3\xf5\x1e\x50\xdf\x6f\xd9\x0f\x35\xed\x48\xdb\x7e\xaa\x71\xf1\x0b\xe3\x07\x9a\xe7\xe9\xef\xf9\x6a\x59\x18\xe4\xb7\x93\x2b\x90\x8e\x47\x9b\xfe\xd6\x8d\xe6\x4d\x73\xfe\x30\x76\xee\xe1\x79\x1b\x5f\xc4\xe3\xcf\x5a\x28\x08\x7f\x9f\xfa\x77\xff\x05\x00\x00\xff\xff\xb2\xd4\x14\x18\x3f\x0f\x00\x00")
+var _templates08_relationship_one_to_one_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\xdf\x6f\xe3\x36\x12\x7e\x96\xfe\x8a\xa9\xe1\xdb\x93\x03\x45\xd9\x7d\x4d\x61\x1c\xd2\x74\x8b\xee\x61\x91\x5e\x93\x1c\xfa\x10\x04\x07\x5a\x1a\xd9\x6c\x68\xd2\x21\xa9\x4d\x02\x9d\xfe\xf7\x62\x28\x4a\xa2\xfc\x2b\xe9\x62\xb1\x01\x02\x58\xd4\xcc\xf0\x9b\x6f\x66\xc8\x4f\x75\x7d\x0a\xbc\x84\xec\x96\x2d\x04\x66\x9f\xcc\xbf\x15\x97\xee\x37\x9c\x36\x4d\x4c\x6f\x51\x98\xf6\x21\xa2\x27\xcd\xe4\x12\x61\xaa\x51\xc0\xf9\xbc\x73\xbb\x55\xbf\x49\xbc\x46\xc1\x2c\x57\xd2\xac\xf8\xc6\xb4\x0e\xce\x63\x2a\xac\x8b\x77\x3e\x87\x69\x76\x21\x38\x33\x68\x5a\x3f\x17\xc6\xff\x0c\xec\xcb\xe3\xf6\xbf\x28\x8d\x7c\x29\x77\xdc\x34\x0a\x17\x9d\x70\xf9\x18\x59\x88\xc9\x59\x64\x57\x6c\x3d\xf2\xca\x95\x4b\xc4\x83\xcc\x2e\x95\xa8\xd6\xb2\x35\xf5\xbf\x03\xe3\xb2\xb3\x2e\x77\xad\x3d\xac\x5d\xa7\xca\xa0\xf9\x8f\xe6\x6b\x6e\xf9\x17\x34\xb4\xd9\xd6\xca\xb4\xcd\xce\x84\x74\x84\x00\x76\xb3\x3e\xbe\x21\xd3\x4b\xda\x65\xa3\xb9\xb4\x25\x4c\xd6\xec\x65\x81\xff\x30\x93\x3e\xc7\xff\x6e\x6e\xb8\x5c\x56\x82\xe9\xd0\x2b\x67\xf2\x46\x95\xf6\x67\x14\x68\x1d\xf9\xc9\x12\xad\xdf\x6e\x04\x30\x44\x32\xcb\x2e\x47\x6e\x43\x38\xd3\x2f\x7a\x80\x6f\x8e\x38\x84\x6b\x3d\x5d\xc5\x9a\x26\x3e\x3b\x83\xcf\x8a\x15\x75\xdd\x57\x3a\xfb\xac\x72\x26\x9a\x06\x98\x10\xea\xc9\x00\x93\x80\x6c\x89\x1a\x84\x52\x0f\xd5\x06\x54\x09\x5f\x98\xa8\xd0\xa4\x90\xb3\x7c\x85\x05\x70\x69\x15\xd8\x15\x52\x30\xa1\x58\x81\x05\x18\xab\xab\xdc\x1a\x32\xb6\x2b\x04\xb5\xf8\x13\x73\x6b\x32\xb8\x5d\x71\x03\xdc\x40\xa9\x34\x30\xf8\x70\xfa\x01\x74\xd0\x4c\x59\x5c\x56\x32\x87\xa4\xae\x3b\x56\x7f\x56\x4f\xb2\xe3\xb5\x69\x3e\xcf\x0e\x81\x4d\xea\x9a\x97\x30\xcd\xae\xd4\xa5\x92\x16\x9f\x6d\xd3\x20\x2c\x14\x17\xd9\xc7\x67\xcc\x2b\xab\x74\x5d\xd3\xc8\x35\x4d\x6e\x9f\x21\x6f\x6d\x32\x6f\x9b\x82\xb7\xf5\xcf\x81\x8b\x2c\x9a\x26\x05\xd3\x55\x76\xa1\x94\x48\xa1\xae\xa7\x4c\x2f\x9b\x86\x12\x47\x5d\xb2\x1c\xeb\x26\x85\xb5\x2a\x0c\x3c\x56\xa8\x39\x9a\xec\x62\xb3\x11\x3c\x67\x56\xe9\x19\xa0\xd6\x4a\x43\x1d\x47\x5f\x98\x06\x23\x78\x8e\x70\x77\x7f\x52\xd7\xbb\x9d\x43\x85\x26\xa3\x96\x2e\x38\x64\x13\x47\xbc\x1c\x30\xd5\x71\x14\x79\x87\x79\x0f\x2d\x4b\x0e\x38\xcf\xe2\xa8\x01\x62\x82\x00\x45\x2d\x9a\x39\x9c\x04\x7e\x07\xb1\x91\x6b\x1c\x47\x2d\xd3\x34\x2b\x9f\xcc\xa5\x5a\x6f\x94\xe1\xd6\x0f\x3f\xd3\x4b\x37\x8a\x6b\xf6\x80\xc9\xdd\xfd\xdd\xfd\x88\xa0\xf7\x29\x7c\x98\xed\x62\xe7\xa5\xcf\x37\xbb\x86\xf9\x1c\x24\x17\x0e\x9a\xcf\x89\x16\xe1\xdd\xa1\x86\xb8\xae\x69\x34\xe8\xff\xec\x0c\x2e\xe0\x01\x5f\xe0\x89\xdb\x15\x30\x90\x95\x10\x90\xbb\x56\x87\x9c\xc9\x7f\x5a\x58\x33\x9b\xd3\x1b\xad\x9e\xda\x5d\xc9\xfa\x7c\x0e\x23\x94\x35\x04\xc7\x31\x4f\x61\x9a\x53\x3e\xc1\xb9\x61\x9a\xa6\x25\x80\x53\x63\xf8\x0e\xf1\x50\x07\x94\x7e\x3a\xa7\x39\x59\xa3\x2c\x88\x1e\x68\x7e\x84\x1f\xba\xfe\xf8\x95\x99\x2b\x2e\x92\x07\x7c\xc9\xb2\x6c\xd6\x26\xec\xd8\x9b\x03\xdb\x6c\x50\x16\x09\x3d\xa5\x94\xd1\xac\xcd\x30\xa8\xda\x6f\x95\x45\x7d\x1e\x47\x11\x4d\xd1\xff\x52\xa2\x8f\x50\xb6\xa8\xdb\x92\xba\x80\x2d\xb3\x5b\xb4\x46\x7e\xe9\x35\x52\x5d\xad\xa3\xe8\x9b\x92\xf4\x2a\x43\x1e\xf5\x31\x96\x22\x1a\x5e\x2e\x2b\xa4\x07\x07\xd4\xb3\xc0\x06\x0e\x88\x3b\x6f\x1d\x44\xfb\xf8\x58\x31\x71\x5b\x6d\x04\x26\xac\x65\xd6\xdb\xf4\x21\xc1\x31\xeb\xd6\x02\x0a\x5e\xa9\x0b\x4d\xc4\x70\x9b\x6f\x8d\xc0\xf7\x1b\x80\x7d\x28\x7d\x84\xba\x9e\xe6\x4a\x6c\xcf\xfe\xf7\xec\xa2\xa3\x25\x6a\x5b\x65\xeb\xfa\x76\xe7\x49\x5b\x3f\x46\x3b\xfb\xee\x71\x79\xf4\x7e\x03\xed\x7b\x2a\x4d\x45\x0e\xbd\xba\x6a\xf7\xed\xf6\xb5\xb5\x1f\x05\x0d\x9b\x80\xba\xdc\x95\x57\xa0\x74\xf3\x3b\x23\xe4\xef\xdd\xbe\x1a\x6d\xa5\x25\x15\x97\xac\xe3\x88\xa0\xba\xc9\xba\xc2\xa7\xdf\xe9\x77\x12\x47\x00\x00\x8f\xeb\xec\x17\xad\xd6\xc9\xa4\xae\x47\x37\x38\xfc\x1f\xa6\xd9\x4d\xbe\xc2\x35\x73\xcf\x4d\x33\x99\xa5\x31\xf8\xbf\xc3\x67\x72\x67\xf1\xb8\x5e\xa1\xd8\xa0\xce\xfe\x58\xa1\xc6\x4f\xd2\xcd\x81\x49\xee\xee\x8d\xd5\x5c\x2e\x8f\x8c\xb4\x47\x71\x64\xb2\x27\x75\xbd\xa3\x38\x76\xf1\xd2\xf0\xe7\x6e\xf9\xf7\x4a\x59\x34\x4d\x33\x09\x26\x3f\x05\x47\x58\x98\x52\x5f\xdd\x6e\xe9\x71\xdd\xa1\x7f\x03\x3d\xd9\x60\xe1\xcf\x9a\x70\x6b\xe0\x12\xfe\x35\x69\x37\xa5\xb3\x65\xb4\x6f\xd7\x1e\x3d\xb3\x4c\x16\xa4\x9a\x8b\x62\x50\x51\x66\x5b\xdb\xf5\x1e\x5b\x4c\x9b\xab\x4a\x88\xb7\xe1\xdd\x95\x77\x23\xb6\x66\x69\x07\xe9\x14\x1c\xef\x0e\x73\x7b\xa3\x3a\xf1\xf1\xc3\x30\xa0\xf4\xec\x44\xc8\x4b\xe2\x3a\x6d\x74\x77\x0f\x2a\xa9\xcd\x53\xa3\xa9\x84\x35\x29\x29\x15\x2a\xbb\xf3\xc8\xda\xae\xc4\xd9\xf8\x7c\x3b\x62\xeb\x63\x26\xb9\x7d\x4e\xc1\xfb\x75\x54\xf2\xd2\x39\x04\x08\xfd\x40\x38\x71\x64\xb2\x3f\x34\xdb\x24\xa8\x75\x0a\x93\x92\x71\x81\x05\x58\xd5\xcb\x4e\x56\x90\xae\x29\x77\x15\xc9\xc4\xa7\x45\x9a\xa9\x05\x76\x13\xc8\xab\x3d\x0e\x3d\x90\x79\x7f\x56\xfc\xc4\x65\x91\xf4\x59\xbd\x0b\xc2\xcc\x7e\xfc\x0a\xcc\x0b\x2e\x8b\x00\x38\x49\x61\x07\xe9\x78\x02\x3d\x2a\x0f\x24\xbb\x14\xca\x60\xf2\x55\x08\x72\x72\xf5\x74\x38\x01\x1e\xd0\x48\xe7\xf0\x56\x27\x76\x20\x76\x31\x7c\xd4\xfa\xef\x20\x70\x2b\xa0\xf2\xbc\xd2\x1a\x0b\x28\x2a\x3a\x57\x80\x5b\xd4\x4e\xdf\x8f\x91\x60\x31\x08\xff\x63\xa8\x7c\xcb\x4a\x65\x9d\xb8\xff\x55\xa9\x07\x7f\x37\xf8\x63\xf6\xd0\xf5\x73\x51\x5a\xd4\x37\x28\x30\xb7\xce\x69\x46\x2c\xb6\x47\xf1\xbe\xfb\x2e\xec\x9e\xee\xd6\xf3\x1d\x4e\xc7\x7d\xa1\xb6\xe3\xed\xfb\xe0\x08\x3e\x31\x52\x40\x7f\x38\xee\x32\x18\x72\xd8\x89\x98\xee\x0e\xe9\x26\xbb\xcf\x2f\xec\xc7\xc3\xb7\xc9\xb6\xa4\x28\xdb\x02\xbb\xfc\x86\x00\x77\xef\xef\xfb\x6f\x85\xec\x3a\xdb\xf7\xcd\x37\x07\xef\x1a\x47\x63\xe6\x7f\x62\xf9\xc3\x35\x96\xa8\x51\xe6\x54\x57\x57\x03\x02\xe9\xed\xb7\xf4\x41\xb0\x0a\xef\x86\xde\x3f\x24\x5e\x7a\xf3\x11\x28\xdf\x10\x0e\x56\x2b\x65\xe2\xd1\xf5\x4d\x99\xfb\x62\x0a\xc2\xbf\x4f\xbe\xf8\xf7\x7e\x83\x23\x05\x3f\xf2\x59\x73\x48\x45\x7e\x03\x51\xec\x70\xbf\x2a\x8b\xd3\x37\xeb\xef\xd7\x2f\xeb\x8e\xea\xa1\x28\xfb\xf7\xf4\x72\xc9\x9f\xfd\x87\x15\x1a\xf5\x69\x97\x44\xab\xce\xe6\x7d\x13\xd1\x5a\x19\x48\xb6\xf0\x22\xd9\x23\xd8\xc6\x71\xd2\xdd\x28\x03\xa6\xae\x05\xa2\x28\x6a\xbd\x5e\x6f\xe7\x37\x35\xf4\x91\x96\xfe\x5b\x4d\xed\x45\xe4\x1b\x1a\xdb\xc1\xdf\x23\x4c\x17\x1a\xd9\xc3\xe8\x78\x88\xc3\xb1\x6f\xe2\xde\xbc\xae\xcf\x4e\x7c\x37\x9c\x9c\x35\xfe\x85\x5f\xfe\x53\x71\x09\x96\x2d\x04\xc2\xc9\x59\xd3\xc4\x7f\x0d\x00\x14\xdd\xbf\xd2\x99\x14\x00\x00")
 
 func templates08_relationship_one_to_one_eagerGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -289,11 +316,11 @@ func templates08_relationship_one_to_one_eagerGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/08_relationship_one_to_one_eager.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0x94, 0xae, 0xda, 0x7c, 0x7, 0x5d, 0x7a, 0xfa, 0xb0, 0x54, 0x8d, 0xb0, 0x3a, 0x4, 0x2d, 0x55, 0x33, 0x65, 0xcf, 0xbe, 0xec, 0x66, 0xde, 0x16, 0xd2, 0xee, 0x3b, 0xe7, 0xf2, 0x28, 0x75}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfc, 0x22, 0x8e, 0x12, 0xe4, 0xba, 0xc5, 0xcd, 0xe, 0xe8, 0xff, 0x0, 0xe7, 0xd5, 0x72, 0xd4, 0xa7, 0xdb, 0x59, 0x93, 0x7b, 0x7, 0x40, 0x46, 0x6a, 0x15, 0xe5, 0xb4, 0xe4, 0x3e, 0x77, 0x4d}}
 	return a, nil
 }
 
-var _templates09_relationship_to_many_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x5f\x6f\xdc\xb8\x11\x7f\x96\x3e\xc5\x74\xe1\x06\xab\x40\x56\x72\xaf\x29\x16\x45\xce\x49\xda\xb4\x89\xdb\xb3\x7d\xb8\x87\x20\xb8\xd0\xd2\xc8\x66\xcc\x25\x37\x24\x95\xd8\x90\xf5\xdd\x8b\x21\xa9\xbf\x2b\x6d\xec\xbb\xbc\xf5\xc1\xc0\x4a\x3b\xc3\xf9\xcd\x6f\x86\xf3\x67\x5d\xd7\xc7\xc0\x4b\xc8\x2e\xd8\xa5\xc0\xec\xad\xf9\x97\xe2\xd2\x7d\x86\xe3\xa6\x89\xe9\x5b\x14\xc6\x3f\x44\xf4\xa4\x99\xbc\x42\x38\xd2\x28\xe0\xc5\xa6\x55\xbb\x50\xef\x99\xbc\x3b\x43\xc1\x2c\x57\xd2\x5c\xf3\x9d\xf1\x1a\x4e\xe5\x48\x58\x77\xe0\x8b\x0d\x1c\x65\x2f\x05\x67\x06\x8d\x57\x74\xe7\x64\xbd\xb9\x20\x5f\x1e\x96\x7f\xa3\x34\xf2\x2b\xb9\xa7\xa6\x51\x38\xe9\xb1\xe2\x14\xd9\xcc\x19\xee\xcd\x29\xdb\x86\x4f\x3d\x05\xdd\xe3\x3b\x95\x33\xf1\xe6\xdf\x78\xe7\xa4\x06\x36\x73\xe5\x78\x08\x2e\x66\x27\x4a\x54\x5b\xe9\xf5\xc2\xe7\xa1\x5f\xad\x74\xb9\x2f\x1d\x00\xed\x2b\x55\x06\xcd\x7f\x35\xdf\x72\xcb\xbf\xa2\xf3\x6d\xf2\xe6\xc8\x73\x63\x86\x64\x0e\x01\x2c\xf8\xbb\x68\x90\xe9\x2b\xb2\xb2\xd3\x5c\xda\x12\x56\x5b\x76\x77\x89\x7f\x35\xab\xce\xc7\x5f\x77\xe7\x5c\x5e\x55\x82\xe9\xa1\x96\xc9\xaf\x71\xcb\x46\x66\xc8\xd3\x3d\xdb\xf7\x70\x94\x9d\x3b\xd9\xbd\xf8\xe5\x4c\x9e\xab\xd2\xbe\x42\x81\xd6\x69\xaf\xaf\xd0\x06\xc4\x23\x1f\x87\x07\x26\xd9\xc9\x48\xad\x69\xe2\x67\xcf\xe0\x9d\x62\x45\x5d\x77\x19\x91\xb9\xf8\x35\x0d\x30\x21\xd4\x37\x03\x4c\x02\xb2\x2b\xd4\x20\x94\xba\xa9\x76\xa0\x4a\xf8\xca\x44\x85\x26\x85\x9c\xe5\xd7\x58\x00\x97\x56\x81\xbd\x46\x3a\x4c\x28\x56\x60\x01\xc6\xea\x2a\xb7\x86\x84\xed\x35\x82\xba\xfc\x8c\xb9\x35\x19\x5c\x5c\x73\x03\xdc\x40\xa9\x34\x30\xf8\xe9\xf8\x3d\x28\x0d\xa7\xc7\xef\x41\x0f\xb2\x2e\x8b\xcb\x4a\xe6\xb0\xae\xeb\x96\xc6\x57\xea\x9b\x6c\x89\x6c\x9a\x77\xc9\x12\xe6\x75\x5d\xf3\x12\x8e\xb2\x53\x75\xa2\xa4\xc5\x5b\xdb\x34\x08\x97\x8a\x8b\xec\xf5\x2d\xe6\x95\x55\xba\xae\xe9\x8a\x36\x4d\x6e\x6f\x21\xf7\x32\x59\x90\x4d\x21\xc8\x86\xe7\x81\x8a\x2c\x9a\x26\x05\xd3\x86\xf2\x52\x29\x91\x42\x5d\x53\xf8\x9b\x86\xfc\x47\x5d\xb2\x1c\xeb\x26\x85\xad\x2a\x0c\x7c\xa9\x50\x73\x34\xd9\xcb\xdd\x4e\xf0\x9c\x59\xa5\x13\x40\xad\x95\x86\x3a\x8e\xbe\x32\x0d\x46\xf0\x1c\xe1\xc3\xc7\xa7\xbd\x8f\x7d\xaa\x50\x94\x49\xc8\xb3\x06\x4b\x32\x71\xc4\xcb\x1e\x53\x1d\x47\x51\x50\xd8\x74\xd0\xb2\xf5\x82\x72\x12\x47\x8d\x2f\x56\xa4\xe7\xd1\x6c\x9c\xa5\x56\x6f\x11\x1b\xa9\xc6\x71\xc4\xf4\x95\xbb\x60\x5b\x76\x83\xeb\x0f\x1f\x47\x1c\x3c\x4f\xe1\xa7\x64\x1f\x1e\x2f\x83\x4b\xd9\x19\x6c\x36\x20\xb9\x70\xaf\xa3\xfe\x25\x3c\x59\x8a\xf9\x59\x4d\xa9\x4f\x7f\xce\xf0\x06\xd8\x6e\x87\xb2\x58\xd3\x53\xda\x1e\x5b\xd7\xed\x3d\xbe\x07\xcb\xad\xc0\x13\x46\xb1\x1e\x3b\xfb\x9f\xca\xa2\x7e\x11\x47\x11\xe5\xe0\xef\x4e\x97\xfc\xf0\xb5\xda\x33\xe1\x50\x79\xb4\x13\xa8\x51\x78\xf5\x3d\xa0\x8e\xa2\xce\x04\xeb\x0d\x38\xf4\xfe\x28\x9f\xab\x93\x02\xe5\xaf\xb8\xb3\xce\xc8\x32\xd9\xab\x6b\x2a\x9d\x4d\xd3\xe9\xf5\x5d\xc6\x4b\xb6\xe9\xf6\xfa\x4b\xc5\xc4\x9a\xa5\x23\xad\xa4\x57\x93\x45\xa7\x15\x51\xf2\x73\x59\x21\x38\x3e\xdc\xbb\x01\xf0\x05\x92\x0f\x30\x4c\xda\x21\x27\x05\x4a\xa7\x92\x90\x03\xcf\x9d\x79\x8d\xb6\xd2\x92\x78\xf4\x52\xde\xf9\xec\x42\x8d\x5b\x68\x34\x2a\x90\xfd\x77\xd4\x3d\xfb\xa7\xf9\xb2\x18\xda\xc6\xa4\xa8\xee\x57\xc5\x99\x96\x48\xfc\xdd\x91\xf4\x29\x7e\xfb\x85\x3e\xaf\xe3\x28\xfa\xb2\xcd\xce\x51\x60\x6e\xd7\xab\xba\x1e\x9d\x1b\x28\x30\x70\x0f\xb9\xfb\x44\x8d\x8e\x9e\x76\x1a\x4b\x7e\x7b\x6e\x35\x97\x57\xe7\x2e\x93\xd6\xae\x33\xcc\x56\xfc\x55\xb6\x4a\xe0\x1e\x3e\x2b\x2e\x61\x95\xc2\x8a\x2a\x4c\x5d\xf3\x02\x9e\x3b\x07\x7f\xa9\x94\x45\xd3\x34\x44\x79\xd7\x55\x3b\xee\xfb\xef\x57\x49\xea\xc1\xbe\xd1\x6a\xeb\xa0\xee\xdb\x1a\x48\xbd\x95\x12\x35\x9d\x37\x10\xed\x98\xa5\xa2\x6f\xe6\x40\x80\x92\xb0\x70\x32\xe1\x1b\xb7\xc7\x91\xe2\xe6\x90\x4f\xcb\x7a\x1d\xde\xdf\xae\x51\xe3\x5b\x87\xf6\xd1\xd4\x00\x97\xf0\xf7\x55\xea\xee\x5c\x96\x65\xee\x48\x97\x77\x4c\x16\x34\xf4\x14\x45\xdf\x0b\xcd\xb4\xa5\xfa\xa4\xfa\xb2\xbd\x46\xb1\x43\x1d\x70\x98\xd3\x4a\x88\x45\x92\xb3\xba\x5e\x15\x4e\xbb\xf8\x9d\xd9\xd5\x8c\x43\x6e\x44\xa4\x66\x12\x47\x49\x3c\xbe\xc9\x73\x39\x08\x00\xd0\x46\xf6\x53\x68\x6d\xaf\x38\xa3\xa4\xcc\x7e\x35\xe8\xef\x40\xd3\xd4\x75\x7b\x1f\x1c\x06\x67\xa0\x8f\x4a\x00\xf7\x89\x00\x84\x03\x5b\x52\xff\xec\x99\x7b\xb1\x0f\x9c\x7f\x1a\x71\x4e\x46\x1f\x47\xbb\x87\x39\xc3\xfc\x9f\x06\xdc\x87\xe7\x53\x0f\xad\x8d\x09\x3d\x85\xb0\xb4\x95\x92\x97\xbe\xa1\xff\xa5\xef\x01\xf4\xec\x1a\xfb\xdd\xda\xc5\x2c\xf4\xc3\xe9\xe4\xe1\xf5\x35\x9a\x4a\x58\x93\x52\xf7\xa7\xe0\x3a\x8d\xcc\xc7\x17\x93\x78\x94\x01\x07\x64\xc3\x99\xeb\xdc\xde\xa6\x10\xf4\x06\x10\x49\x61\x80\x30\x14\x5b\x37\x70\x98\xec\x37\xcd\x76\x6b\xd4\x3a\x85\x55\xc9\xb8\xc0\x02\xac\xea\x26\x3a\x56\xc0\x1e\x49\xab\xe0\x11\x8d\x20\x1e\xd3\xf9\x60\x5a\x29\xe7\xa6\x95\x85\x6a\xfe\xc7\x2b\xb2\xd3\xfc\x3c\xac\xff\x33\x6a\x73\xd6\x44\xa8\x05\x6e\xa0\xee\x0e\xc8\xfe\x81\x36\x14\x88\x69\xc5\x68\x87\x2d\xa7\x48\xdf\x9d\x28\x61\xe0\xc3\x47\xea\xef\x41\x2a\xbb\xb8\xdb\x21\xc9\x51\x3b\x0f\x51\xca\x4e\x29\x1e\xbe\xb7\x2a\xe9\x10\x4a\xfc\xb6\x9e\x27\x88\x92\x6a\x6a\x03\x66\x0c\xc4\x51\x44\xa1\xdc\x74\x46\xce\x73\x26\xd7\x0f\xed\x3e\xc6\xf5\x9d\xf7\x6c\x07\x6b\x46\x43\xb1\x73\x24\xc0\x49\x66\xbb\xd3\xea\x89\x92\x98\xad\xa6\x5d\xe8\xc9\x10\x67\xe2\x07\xb7\x49\x8a\x3d\x24\xc7\x4c\x3e\x58\x1d\xdc\x56\x10\xdc\x72\xd3\xff\x6c\xda\xb9\x11\x24\x58\xeb\x59\x78\xad\xf5\x3a\xf9\xdb\x1f\x81\xb0\x13\x78\xc9\x99\x3c\xbe\xe4\xb2\x18\x43\x09\x83\xde\x02\x08\x77\x89\xfa\xcc\xef\x06\xa1\xc1\xcb\x14\x94\xa4\x9b\x18\x8d\x13\xa7\x13\x1d\xbd\x4e\x61\x42\x69\x48\xd7\xfe\xf2\x77\x4e\xb7\xb3\xdc\xcf\xbc\xb3\x67\x52\x78\x32\xb0\xbc\x4f\xc5\x03\x98\x78\x14\x03\x2d\x3a\x57\x15\xe3\xfd\x80\x9c\x08\x65\x70\x26\x24\x0f\xc0\x91\x93\x6a\x97\x08\x7c\x98\x20\x7e\xc8\x98\x87\xf3\xc0\x9c\x58\x04\xe0\x97\x2f\x95\xe7\x95\xd6\x58\x40\x51\xd1\x25\x00\x6e\x51\xbb\xad\x93\xf6\xd4\x49\xa6\x86\x75\x74\x39\x57\xbb\xa2\x2f\x95\x75\x85\xff\x9f\x4a\xdd\x98\x2e\x9a\x34\x04\xf7\xd5\x60\xbc\x23\xbc\x2c\x2d\x6a\x3f\x5c\x3a\xa5\x84\x7c\xf0\x83\xf2\xdc\x52\x32\x4c\xc5\x76\x35\x09\x3d\x82\x66\xf2\x42\x4d\xcf\x9b\x5b\x83\x07\x8b\x6f\x0a\xd8\xf5\x8f\x99\x7b\x35\x60\x31\x0e\x5b\x41\xd3\xba\xdb\x8d\x2f\xf3\xab\x67\x76\x96\xcd\xfd\x92\xb0\x19\xba\xd0\x0e\x61\x2d\x6d\x3f\xb3\xfc\xe6\x0c\x4b\xd4\x28\x73\x0a\x8a\x1f\xbc\x02\x0f\xa1\xf0\x1d\xe6\x22\x08\x4d\x57\xb5\xc1\x6b\xb7\xae\xcd\x86\xa2\x5b\xd7\xa2\x03\x3b\x49\x77\xd2\xc8\xbb\x90\x12\xce\xbf\x70\xe9\xbf\x23\xd8\x2e\xaa\x49\xbc\xb7\xc6\x7d\xdf\x84\x57\x8d\x27\x9b\x5c\x33\x9e\x57\xf6\x16\xad\x05\x9f\x88\x5e\xfe\x00\x7a\x47\x1d\xeb\xc5\x66\xdc\x25\x3f\xf0\x8f\x7d\xa4\xdc\x37\xb3\x8b\xf4\xa1\x3d\x97\x2e\x0a\x29\xf6\x3b\xee\x66\x33\x69\x93\xfb\x5c\xed\x6d\xbc\xe3\x23\x26\xc5\xb6\x3b\x61\xb0\xfd\x7a\x85\xa5\x64\x1d\x56\xf0\x79\xa1\x8e\xb9\xa4\xdf\xe4\x0f\xe7\xf3\xa1\x44\x7d\x54\xa6\xfa\x54\xfd\x81\x29\xe9\xdc\x4c\x66\x7e\x22\xb8\xd4\xc8\x6e\x46\x15\x60\x14\x87\x87\xde\xd0\x1f\x9f\x1f\xad\x4b\xc4\xd4\xe0\x87\x91\x47\x26\xc9\xde\x29\xff\xb7\x99\xe2\xe0\x3f\x38\x01\xc2\x50\x30\x28\x34\x4d\x1c\x77\x8a\x75\xfd\xec\x69\x08\xb1\x55\x5b\x26\xef\xe0\xe9\xb3\xf6\x7f\x23\x03\x09\x5e\xc2\xf0\xdf\x27\x4e\xe6\x7f\x01\x00\x00\xff\xff\x50\x60\x52\xab\x5e\x19\x00\x00")
+var _templates09_relationship_to_many_eagerGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\x51\x73\xdc\xb6\xf1\x7f\x26\x3f\xc5\xe6\x46\x7f\xfd\x79\x2e\x45\xd9\xaf\x72\x2f\x1d\x47\xb1\x1b\xd7\xb6\x9a\x58\xea\xe4\x41\xa3\xf1\x40\x24\x4e\x42\x84\x03\x4e\x00\x68\xf9\x4a\xf1\xbb\x77\x16\x04\x41\x90\x47\x9e\xce\xae\x27\x2f\x7d\x68\x47\x07\xee\x2e\x16\xbf\xfd\x2d\x76\xb1\x71\x55\x1d\x01\x5b\x42\x76\x41\xae\x39\xcd\xde\xea\x7f\x48\x26\xec\xdf\x70\x54\xd7\x31\x7e\xa5\x5c\x37\x3f\x22\xfc\xa5\x88\xb8\xa1\x70\xa0\x28\x87\x93\x45\xab\x76\x21\x3f\x10\xb1\xf9\x48\x39\x31\x4c\x0a\x7d\xcb\xd6\xba\xd1\xb0\x2a\x07\xdc\x58\x83\x27\x0b\x38\xc8\x5e\x71\x46\x34\xd5\x8d\xa2\xb5\xe3\xfe\x0c\xe4\x97\xbb\xe5\xdf\x48\x45\xd9\x8d\xd8\x52\x53\x94\x5b\xeb\x7d\xc5\xa1\x67\x23\x36\xec\xca\x19\x59\xb9\xbf\x3a\x08\xfc\xcf\xf7\x32\x27\xfc\xcd\x3b\xba\xb1\x52\xc1\x9e\xb9\xb4\x38\xb8\x23\x66\xa7\x92\x97\x2b\xd1\x98\x71\x7f\x07\xc2\xcb\x56\x7a\xb9\x2d\xed\x1c\xda\x56\x2a\x35\xd5\xbf\x2a\xb6\x62\x86\x7d\xa6\x1a\x37\x1b\xac\x1c\x34\xd8\xe8\x10\xcc\xd0\x81\x89\xf3\x4e\x6e\x48\xd4\x0d\xee\xb2\x56\x4c\x98\x25\xcc\x56\x64\x73\x4d\xff\x4f\xcf\xfc\x19\xff\xb5\x3e\x67\xe2\xa6\xe4\x44\x85\x5a\x3a\xbf\xa5\x2b\xd2\xdb\xe6\x64\xd1\xdb\xa9\xd9\xfb\x11\x0e\xb2\x73\x2b\xbb\x15\xbf\x9c\x88\x73\xb9\x34\x3f\x53\x4e\x8d\x8d\x7e\x72\x43\x8d\xf3\xb8\x77\xc6\xd0\xe0\x3c\x3b\xed\xa9\x05\x1e\xf9\x45\x77\xc6\xbd\x2d\x76\xe6\x1a\x4d\x1b\xf4\xba\x8e\x8f\x8f\xe1\xbd\x24\x45\x55\x79\xaa\x65\x96\x18\x75\x0d\x84\x73\xf9\xa0\x81\x08\xa0\xe4\x86\x2a\xe0\x52\xde\x95\x6b\x90\x4b\xf8\x4c\x78\x49\x75\x0a\x39\xc9\x6f\x69\x01\x4c\x18\x09\xe6\x96\xa2\x31\x2e\x49\x41\x0b\xd0\x46\x95\xb9\xd1\x28\x6c\x6e\x29\xc8\xeb\x3f\x68\x6e\x74\x06\x17\xb7\x4c\x03\xd3\xb0\x94\x0a\x08\xbc\x38\xfa\x00\x52\xc1\xd9\xd1\x07\x50\x01\x9d\xb3\x78\x59\x8a\x1c\x92\xaa\x6a\xe3\xf3\xb3\x7c\x10\x6d\x84\xea\xfa\xfd\x7c\xca\xe7\xa4\xaa\xd8\x12\x0e\xb2\x33\x79\x2a\x85\xa1\x5f\x4c\x5d\x53\xb8\x96\x8c\x67\xaf\xbf\xd0\xbc\x34\x52\x55\x15\xe6\x7e\x5d\xe7\xe6\x0b\xe4\x8d\x4c\xe6\x64\x53\x70\xb2\xee\x77\xa0\x22\x8a\xba\x4e\x41\xb7\x1c\xb9\x96\x92\xa7\x50\x55\x07\x44\xdd\xd4\x35\x9e\x9f\xaa\x25\xc9\x69\x55\xa7\xb0\x92\x85\x86\xfb\x92\x2a\x46\x75\xf6\x6a\xbd\xe6\x2c\x27\x46\xaa\x39\x50\xa5\xa4\x82\x2a\x8e\x3e\x13\x05\x9a\xb3\x9c\xc2\xe5\xd5\xb3\xaa\xda\xe6\x20\xc6\x1b\x85\x1a\xd4\x60\x4a\x26\x8e\xd8\xb2\xf3\xa9\x8a\xa3\xc8\x29\x2c\xbc\x6b\x59\x32\xa1\x3c\x8f\xa3\x1a\x10\x09\x74\x28\x6a\xbc\x59\xc0\xb3\x40\x6f\xd2\x37\x54\x8d\xe3\xa8\x41\x1a\x73\xe1\xad\x3e\x95\xab\xb5\xd4\xcc\x38\xea\x13\x75\x63\x93\x7a\x45\xee\x68\x72\x79\x75\x79\xd5\x03\xe8\x79\x0a\x2f\xe6\xdb\xbe\xb3\xa5\x3b\x6f\xf6\x11\x16\x0b\x10\x8c\x5b\xd7\xdc\x99\x70\x11\x0e\xa7\x08\xf1\xb1\xc2\x0c\xc1\xff\x1d\x1f\xc3\x2b\xb8\xa3\x1b\x78\x60\xe6\x16\x08\x88\x92\x73\xc8\x2d\xe3\x21\x27\xe2\xff\x0d\xac\x88\xc9\xf1\x8b\x92\x0f\xcd\xae\x28\x7d\xb2\x80\x9e\x97\x15\x04\x75\x81\xa5\x70\x90\xfb\xcc\x6f\xd2\x47\xd7\x75\x03\x00\x43\x62\x38\x86\x38\x57\x3b\x2f\x5d\x92\x1e\xe4\x28\x4d\x45\x81\xf0\x40\xfd\x12\x7e\x68\xf9\xf1\x0b\xd1\x67\x8c\x27\x77\x74\x93\x65\xd9\xbc\x39\xb0\x45\x6f\x01\x64\xbd\xa6\xa2\x48\xf0\x57\x8a\x27\x9a\x37\x27\x0c\xa2\xf6\xcf\xd2\x50\x75\x12\x47\x11\x26\xd3\xa7\x14\xe1\x43\x2f\x1b\xaf\x9b\x90\x5a\x83\x0d\xb2\x03\x58\x23\xb7\xf4\x14\xa8\x36\xd6\x51\xf4\x5d\x41\x7a\x12\x21\xe7\xf5\x2e\x94\x22\x4c\x5e\x26\x4a\x8a\x3f\xac\xa3\x0e\x05\xd2\x61\x80\xd8\x39\xe9\xc0\xda\xeb\xfb\x92\xf0\x8b\x72\xcd\x69\x42\x1a\x64\x9d\x8c\x37\x09\x16\x59\xbb\x16\x40\xf0\x44\x5c\x30\x23\xba\xb6\x62\x90\x02\x7f\x5e\x02\x8c\x79\xe9\x2c\x54\x55\x0b\xf7\x23\x18\x66\x38\x3d\x25\x9a\x0e\xaf\x82\x3f\x93\x54\x3b\x23\xd6\x30\x67\xd0\x17\xd8\xeb\xa5\x09\x27\xc1\x9d\x1d\x99\x72\xc9\xeb\xda\xeb\x75\x51\x18\x09\x3c\xc6\x3c\xd4\x6a\x83\xef\xd9\xf7\xad\x54\x68\x8c\x4e\x21\xdc\x51\x04\x73\xc0\x06\x9f\x53\x61\xb3\x7b\x8e\x07\x79\x6e\xdd\x50\xd4\x94\x4a\x60\xe8\x83\x3b\x36\xbb\x90\xfd\x06\xb6\x69\x05\xfe\xf0\x6b\x27\x0b\xd8\x6e\x01\xb2\x31\x1d\x8e\x55\xf2\xd4\x35\x6c\xde\x40\xf6\x77\x6a\x9c\xdb\x5d\x63\xe8\x16\x8e\xda\x5a\x64\x55\xf1\xeb\xa9\xe4\x1a\x2e\xaf\xaa\xca\x5b\xcb\x2e\x36\x6b\x5a\xb7\xa7\xeb\x54\x14\xd5\x25\x37\xe7\x41\xa5\x5b\x6e\x57\x93\x38\x8e\x8e\x8f\xe1\x1d\xdd\x68\x20\x8a\x82\x5e\x73\x66\xb0\xa0\x4a\xc8\x6f\x4b\x71\xa7\x41\xdb\xd6\x02\xde\x9e\x41\xce\x49\xa9\x69\x0a\x84\x4b\x71\xd3\x5c\xf0\xd8\x74\xa0\xfe\x9a\x28\xb2\xa2\x86\x2a\x6d\x85\x31\xe2\x9b\xa6\x12\x5f\x33\x51\xa4\xa0\x0d\xd9\x68\x28\x45\x41\x95\x15\xf0\xf2\xc0\x91\x5b\x8d\xbf\x2b\x59\xfc\x8a\xeb\x1a\xf7\xb7\x21\xb2\x16\x7e\x68\x08\x7e\x78\x68\x9b\x8e\x53\xf4\xea\x9c\xfd\x9b\xc2\x8f\x2e\x68\x2b\x59\xfc\x66\xf7\x3b\x59\xc0\x19\x7d\xb0\x7f\x27\x78\x5b\xa3\xb6\xed\x00\x36\x49\x2b\x83\xcb\x9f\x6c\x8f\xf0\xca\x5d\x10\x2d\x39\x7f\x2a\x19\x6f\x64\x7a\xc2\x9d\x4f\x0b\x4b\x18\xa7\x89\x39\x1b\x47\xb9\xf7\x25\x30\xf4\x8e\x6e\xbc\x8f\x49\xcf\xe3\xb4\x3b\x61\x0a\xa3\xe5\x1b\xef\x6b\x4e\xc7\xba\x77\x5b\xef\x30\xb1\xea\xfa\x85\x23\x71\x53\xc3\x3b\x1f\x3c\x8b\x83\xa5\x8e\xe4\xe8\x70\x6c\x2f\x15\x6d\x88\x32\xc8\xc0\xe7\x2f\x31\x2e\xca\xc0\x5f\x3b\xb1\x76\xe9\x2f\x8b\xc0\x32\xa6\x06\x32\xeb\x64\xd1\x7e\xed\x3e\x36\x35\x1c\xbf\xfe\xd8\x59\xb1\x6e\x44\xb8\xb8\xe8\x16\xe3\x36\x7b\x27\xb2\x2a\x6a\x7b\x6c\xdb\xc9\x77\x1f\xf1\x49\xd8\xfd\x1a\xef\xf5\x9d\xea\x32\x68\xb9\x27\xd2\x32\xec\xca\x9d\xf2\xfd\x16\x7b\xd0\xfb\xfb\x55\x76\x4e\x39\xcd\x4d\x32\xab\x2a\x57\x64\x43\xfb\xee\xa6\xb1\x81\x19\x79\xa9\xd4\x75\x56\x55\x99\xed\xf2\xd1\xe5\xdf\x4a\x69\xa8\x0e\xca\x70\x55\xb1\x02\x9e\xf7\xbe\xa1\xc2\x30\xff\xc3\xef\xb3\x79\xea\x1c\x7b\xa3\xe4\x2a\x99\x4d\xec\xdb\x89\xbd\x15\x82\x2a\xb4\x18\xc8\x7a\x24\xf1\x81\xa1\x61\xc4\x0d\x90\x02\x26\x4c\xa3\x87\x6e\x65\xc4\x3f\x58\xc0\x8e\x53\x4d\xeb\x75\x0e\xff\x7e\x4b\x15\x7d\x2b\x92\xd9\x0e\x3b\x53\xe8\x00\x13\xf0\xb7\x59\x0a\xc8\xb5\x4b\x4b\xd3\x13\x2a\x8a\x2b\xec\x58\x52\xcf\x3a\x22\x0a\x7c\xff\x17\x45\xf7\x1c\xd3\xc3\x47\xa2\x63\xd4\xfd\xea\x96\xf2\x35\x55\xce\x29\x7d\x56\x72\x3e\x89\x39\x76\x54\xda\x9b\x98\x3e\x23\xb2\xd4\x55\xa0\x68\x1e\x0f\xab\xe5\x28\x11\x01\x00\x82\x90\xef\x7c\xf9\xb6\xfb\xa0\xce\x8e\xf7\x81\xfd\x3e\x38\x9f\xb0\x1d\x99\x4e\x2e\xaf\xb4\x51\x4c\xdc\xec\x68\x2e\xb7\x2f\xa6\x61\x8f\x89\x30\x05\x92\x13\xbe\x22\x68\x79\x1f\x25\x5f\xc1\xa0\xde\x8a\x64\x70\xb2\x00\x33\x07\x4f\xc0\x9c\xa7\x77\x6d\x25\xbe\x9e\x45\x6e\x7b\xdf\xa9\x78\xa0\xf7\x27\xd6\x08\xf6\x9e\x5b\x4f\xbb\xbe\x0f\xcb\x70\x87\x31\xa2\x79\xaf\x07\x95\xd5\x5e\xd6\x41\xb1\xbc\x6f\x8b\x1f\x56\x8c\x68\xf8\xa2\x77\x36\x9a\xe6\x42\xa7\xf8\xac\x6e\xcb\xdf\x26\x6b\x58\x4b\xe7\xf1\x80\xd9\x3b\xa4\x9d\xd9\x24\x37\x5f\x52\x68\x35\x43\x57\x71\x83\xd0\x53\xd7\xa4\xd9\xe7\xbc\xce\x7e\x57\x64\x9d\x50\xa5\x52\x98\x2d\x09\xe3\xb4\x00\x23\xfd\xbc\x84\x14\x30\x00\x15\x31\xea\x9d\x6c\xa4\x0e\xfd\x17\xa5\xe4\x5b\x3a\xc3\x6f\x6c\x0d\xad\x2a\xd6\x73\x87\x6d\x76\x86\x28\xba\xd2\x2b\x85\x75\x5a\xd0\x87\x64\xbc\xed\x43\x9c\xb7\xfa\x4a\x18\x69\x2a\x51\x0e\x23\xb0\xf0\xfb\x9c\xe7\x44\x58\xab\x23\xc5\x10\x1e\xdd\x63\x1f\x0b\x9f\x86\x47\x9c\x43\x31\x71\xf3\x81\xac\x21\x21\x38\x28\xb2\xdd\xab\x73\x68\x0e\x8f\xb0\x56\x74\xc9\xbe\x9c\x5b\xa9\xa6\x53\x9d\x1d\x4a\x41\xb3\x19\x3c\x02\x36\xc8\x30\x4b\x61\x86\x65\xf3\x30\x74\x74\x1e\x47\xa3\xd4\xd8\x87\x1b\x3a\x0f\x06\x6a\x76\x56\xe6\x0e\x66\x67\x62\xe3\x74\x89\xda\x17\x71\x1f\x89\xd7\x4a\x25\xf3\x97\xdf\xe4\xc5\x9a\xd3\x6b\x46\xc4\x11\xb6\xc7\x7d\x6f\xdc\x1b\x6f\xca\x0f\xfc\xff\xb0\xb1\xf7\xaf\xa0\x60\x31\x05\x29\x6c\x26\x45\x21\x68\xc1\x8b\xa9\xb7\x9c\xf6\x38\xe0\xde\x4a\x96\x94\x41\x02\xfb\xb3\xfb\x7e\x99\xf9\x3d\x75\x0a\x87\xc1\xee\x23\x88\xec\x01\xc8\xd7\x01\xe1\x3d\xc4\x6a\x13\xc7\x23\xb1\x39\xe5\x52\xd3\xe4\xdb\x7c\xc9\x51\xb7\xb5\x84\x7d\x45\xe7\x57\xd3\x1c\x4d\xb9\xb4\x2f\x43\x26\x7d\xb0\xc4\x05\x99\xe7\xa5\x52\xb4\x80\xa2\xc4\xbc\x00\x66\xa8\xb2\xc3\x59\x1c\xe7\xf6\x30\xf2\x53\xdb\x1d\xe4\xad\x83\xb7\xac\x90\xc6\x4e\x67\x7f\x91\xf2\xce\xbd\xe6\xdd\x4b\xb8\xbb\x26\xfa\x03\x83\x57\x4b\x43\x55\xd3\x08\x5b\xa5\x39\x06\xb6\x79\x78\x8d\x4d\x28\x02\x1e\xf8\x39\x85\xbb\xf3\xf1\x81\x5e\xc8\xa1\xbd\xb1\x89\x71\x30\x23\x4e\x81\xfa\x72\x30\x02\x64\x80\x64\x9b\xa6\xfe\xb8\xbe\x00\x0e\x07\x3d\xed\x40\x27\x1b\x1b\xba\xb7\xb1\xb3\x47\x88\xa3\x3e\x6c\x3f\x91\xfc\xee\x23\x5d\x52\x45\x45\x8e\x81\x39\xf2\x97\xf0\xa7\x14\x5c\xc5\xd8\x8d\x85\x13\x1a\xce\x6d\x82\x65\x38\x9c\x0a\x85\x9f\xdd\xec\x7a\x43\x79\x4b\xbd\xd3\x39\x5a\xd4\x75\x77\x07\x3c\x21\xd8\x4e\xad\xb6\xbb\xd4\x3d\xb6\x68\x54\x87\x6d\x47\x3d\xa8\xed\x7b\x4e\x5b\x10\x5e\xb6\x07\xbc\xe1\x2d\x86\x41\x08\x7f\xeb\x4b\x76\xd5\x45\xca\x7e\xe9\x0c\xb9\x8b\x26\x7e\x62\xe8\x85\x89\x82\x8a\xdd\xc0\x6b\xd1\xdf\x04\xaa\x6d\xac\xb6\xc6\x5f\x7d\x13\x83\xbb\x17\xaa\x21\x66\xee\x58\x93\x64\x0d\x2f\xf4\x71\x21\x8f\xdc\xdc\xbd\x93\x9f\xe4\xf3\x2e\xa2\x7e\x15\x53\x1b\xaa\x7e\x47\x4a\x5a\x2c\xda\x73\x84\x20\x5d\x2b\x4a\xee\x7a\x37\x40\x2f\x0e\xfb\x66\xe8\x9e\xfc\x18\x7f\x54\x6d\xc5\xda\xbe\xa8\x92\xef\x30\xb2\xf7\x9c\x71\x23\xdd\xf1\xa1\x7d\xba\xf7\x7f\x1d\x70\x88\xee\xd8\xb1\x8d\x44\x17\xe0\xf1\x3d\xe7\x3d\xce\x7f\x65\xee\x84\x9b\x04\x13\xe4\xaf\x4c\xa0\x2d\x2b\xff\xb3\x59\x64\xdd\xdf\x3b\x39\x5c\xe7\x14\x5c\xc2\x75\x1c\x7b\xc5\xaa\x3a\x7e\xe6\xc8\x63\xe4\x8a\x88\x0d\x3c\x3b\x6e\xff\xed\x46\x20\xc1\x96\x10\xfe\xf3\x8e\x67\xc7\x75\x1d\xff\x67\x00\xc0\x2d\x41\x23\xfe\x21\x00\x00")
 
 func templates09_relationship_to_many_eagerGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -309,11 +336,11 @@ func templates09_relationship_to_many_eagerGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/09_relationship_to_many_eager.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcf, 0x7, 0x17, 0x5e, 0xb5, 0x86, 0xc5, 0x49, 0xa5, 0x3f, 0xca, 0x49, 0x89, 0xee, 0x47, 0x28, 0x14, 0x31, 0x40, 0x2d, 0x2e, 0xaf, 0xad, 0x6c, 0xe9, 0x5d, 0x7a, 0x8, 0x88, 0x26, 0xb4, 0xd5}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1c, 0xcd, 0xd3, 0x6d, 0xf2, 0x10, 0xbd, 0x8b, 0x2f, 0xf7, 0x5, 0x44, 0x38, 0x3d, 0x6d, 0x4f, 0x3a, 0x40, 0x93, 0x40, 0x1c, 0xc1, 0xca, 0xe3, 0x9b, 0x8f, 0xaa, 0x1d, 0x67, 0xdd, 0x6e, 0x6e}}
 	return a, nil
 }
 
-var _templates10_relationship_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5d\x73\xdb\xba\x11\x7d\xa6\x7e\xc5\x46\xe3\xa4\x64\x46\x61\x9a\x3e\xba\x75\x67\xdc\xf8\xa3\x6e\xee\x4d\x75\x2d\x7b\xfc\x90\xf1\xdc\x81\xc8\xa5\x8c\x06\x02\x14\x00\x8c\xed\xa1\xf1\xdf\x3b\x00\x41\x8a\x34\x49\x7f\xc7\xa3\x37\x91\xc0\x62\x0f\x76\x0f\x16\x67\xa9\xa2\xf8\x00\x34\x83\xf8\x84\xcc\x19\xc6\x47\xea\x3f\x82\x72\xf7\x1b\x3e\x18\x33\xb2\xa3\xc8\x54\xf9\x10\xd8\x27\x49\xf8\x02\x61\x2b\xfb\x8e\xd7\xb0\xbd\x53\xd9\x1d\x7c\xc1\x6b\x55\x4e\x72\xb3\xb6\x98\x76\x6b\x6c\xef\xc0\x56\xbc\xcb\x28\x51\xa8\xca\xa9\xa5\x69\xbc\x76\xe1\x0d\xb2\x7b\x0c\x0e\x84\x44\xba\xe0\x1d\x3b\x89\xcc\x19\x95\x0e\xe3\x63\x64\x44\x53\xc1\xd5\x05\x5d\x79\xcb\xaf\x64\xd9\xb2\x20\x72\x61\x2d\x56\x92\x72\x9d\xc1\x78\x49\xae\xe7\xf8\x56\x8d\xeb\x25\x4e\x57\x33\xca\x17\x39\x23\xb2\x69\x95\x88\x96\x9f\xcf\x82\xe5\x4b\xee\x3d\xf8\x87\xe6\x6e\xaa\xe9\x59\xcf\x74\xbf\x95\xae\x55\xae\x50\x4d\x25\x5d\x52\x4d\x7f\xa2\xb2\xf6\xb7\xde\x6c\x95\x21\x51\xad\x20\xb6\x30\xf4\x04\xeb\x1e\xa7\x2a\xb9\xc0\x25\x39\xa9\xa3\xdf\x58\xf9\x06\xb6\xe2\x59\x63\xd8\x11\x82\x66\x36\x43\x69\x7a\xc8\xc4\x9c\x30\xb7\xd2\xc7\x8f\x30\x43\x5d\x14\x36\x19\x95\x23\x63\x0e\x41\x64\xa0\x2f\x10\x8a\xa2\x8a\xda\x9e\xb8\xe4\x55\x70\x8d\x01\x2d\xdc\xb8\xb4\x39\xc3\x14\xa8\xc6\x65\xec\x17\x53\x20\xe2\xe3\xf8\xf6\x92\xd6\xc2\xcf\x76\x13\x77\xd3\x54\x81\x68\xbe\xad\x6d\x7e\x13\x09\x61\xc6\xb8\x69\xa7\x0a\x95\xf3\xb4\x28\x31\xa7\x44\x93\x39\x51\x08\x17\x84\xa7\x0c\xe3\x51\x96\xf3\x04\x42\x01\xef\xd7\x50\xd7\x2c\x30\x26\xea\xdd\x5e\xe8\x62\xc1\x85\x86\xad\xf8\xab\xf8\x2c\xb8\xc6\x2b\x6d\x4c\xa2\xaf\x20\x29\x1f\x62\xff\x72\x02\x45\x81\x3c\xb5\xb1\x02\xca\x15\x4a\x0d\x73\x21\xd8\xa4\xde\xb9\xf5\x9b\xf5\xf9\x45\x29\x85\x84\x62\x14\x48\xd4\xb9\xe4\x20\xe2\x1e\x24\xa1\x4f\x4a\x03\xc4\x5c\x50\x16\x1f\xa2\xde\xfb\x57\x18\x15\x85\x3d\xc1\x0e\xd8\x04\xaa\x01\x3f\xd3\x8f\xf3\xd4\x98\x89\x87\x56\xa3\x8a\x46\x66\x34\xaa\x81\x8f\x1a\xa9\x9f\x12\x4e\x93\x3b\x32\x3f\xdd\x98\xcc\x3b\xa4\x0a\x04\x2f\x23\xf9\xb4\x4c\x4f\x7b\x02\x8c\x57\x98\x94\xc1\xdc\xbf\xc2\x24\xd7\x42\x36\xc2\xdc\xcd\xff\x7a\xba\x7f\xd5\xb0\x6a\x06\xff\xa1\xbc\x28\x46\x01\xcd\xec\x9e\xec\x91\xbd\x83\x14\x7d\xec\x6c\xb2\xd1\xe2\xea\x26\xfe\xef\x6e\xe5\x37\x3b\xc0\x29\xb3\xae\x82\x95\x0d\x63\xe8\xf0\x9f\x49\xb2\xda\x97\x32\x44\x29\xa3\x68\x14\x98\x3e\x92\x10\x9e\xb6\x6a\xc4\x83\x48\x73\xb8\x39\xac\xb9\xa7\x5e\xb8\xfd\xad\x5e\x82\x59\x87\xd3\xd7\x28\x22\x0f\x25\xcb\xcb\x57\x90\x67\x10\xa9\x9f\x24\x9b\x41\x91\xa7\xa4\x7a\xf3\x6a\x48\x7d\xb7\xfc\x24\xd2\xe5\xc9\xbd\x70\x5c\xf1\x0b\xd9\x8c\x79\xe6\xec\xd4\xe1\x38\x72\x63\x8f\x29\x2f\x0e\xf3\x11\xcf\x50\x86\x51\x97\x12\xd5\xd5\xe6\xbc\x2b\x47\x0b\xcb\x89\x09\x8c\x33\x42\x19\xa6\x36\x15\x1e\x0f\xe5\x5a\x40\x56\x46\x14\xdc\x96\xc6\xd1\x28\x08\x8c\x65\xcf\x28\xc8\x57\x29\xd1\xf8\x47\x8e\xd2\x29\xd3\x6c\xa9\xe3\x59\x29\xf2\xc2\x51\x10\x8c\x4f\xa7\x7b\xbb\x27\xfb\x96\x39\x0d\xc5\x63\x0c\xcc\xf6\x4f\xe0\xad\x82\xb3\x7f\xef\x1f\xef\xc3\x5b\x35\x9e\x8c\x82\x40\x69\xb9\x24\x7c\xc1\xd0\x1e\x96\x29\x91\x64\x69\x45\xa4\x0a\xc7\x45\xb1\x15\xff\xf6\x87\x31\xe3\x09\xb8\xdf\xc7\xe5\x6f\x9f\xdb\x3d\x4a\x18\x26\x3a\x3e\x55\x78\xc4\x53\xbc\x9a\x32\x92\xe0\x85\x60\x29\x4a\x65\xcc\xa7\x2a\xbb\x7f\xad\x13\xf6\xed\x5c\x69\x49\xf9\xa2\x28\xc6\xc5\xd8\x98\x71\x51\x78\x1d\xe7\x7e\x8f\xcd\xd8\x98\xa8\x8d\xe7\xec\x02\x25\x7e\x66\x24\x57\xf8\x3c\x34\x7f\xeb\xa2\x19\x3a\x54\x56\x80\x12\x79\xfd\x05\xaf\x4b\x70\xca\x62\x8a\x2c\x6f\x58\x5e\xca\xd4\x6f\xe7\x94\x6b\x94\x19\x49\xb0\x30\x45\xc5\x14\x4b\xbc\x44\x30\xbb\xb4\xb0\x0f\xbe\x57\x98\x7e\xa9\xe5\xaa\x82\x1b\x28\x23\xf0\x3b\x59\x41\x48\xac\xee\xff\x2c\x98\xaa\x64\x76\x04\x37\xf0\x3f\x41\x39\x8c\xed\x12\x63\x63\x7c\x50\x46\xb6\x17\x69\x1f\xa7\x52\xd2\xd2\xac\x64\xdb\x1e\xce\xf3\xc5\xef\x22\x45\x47\x31\x4b\x85\x03\x47\x05\xc6\xc3\xf5\xf8\x99\xa4\x1a\xe5\x04\x1a\xc4\x89\xee\x9f\x5d\xee\xda\x55\xac\xa0\x8c\x61\xdb\xf5\x91\x72\xd3\xc3\x44\x5f\xb9\xd2\x1b\x5c\x3a\x43\x1b\xa6\xdb\x8b\x1d\x48\xb1\x74\xf3\x6e\x7b\xbd\x7c\x00\xb2\xcb\x7e\x3c\x55\xfd\x1c\x0e\xd0\x9f\x13\x7f\xa2\xed\xe9\x74\xa5\x27\x6c\xf8\xa9\x16\x8c\xe3\xb8\x7b\x56\x1f\x70\x54\xcb\xa5\x80\xd9\x5a\xb9\x3e\xa3\xbe\x79\x6c\x45\xab\x8b\xc3\x23\x0d\x5d\x15\x79\x35\x4c\x96\xfc\x75\xbc\x6e\x35\x5c\x0e\xab\x23\xaf\x23\x72\xa3\x0c\xd6\xe4\x6e\xb3\xe0\x47\x8e\x92\xa2\x8a\x77\x95\xa2\x0b\x1e\xbe\x5b\xdb\x4e\xba\xa6\x51\x3b\x63\x34\xb3\x37\x14\xec\xac\xf7\xe6\x1e\xe1\xdd\xd0\xc1\x3c\x76\xf5\xf3\xf6\x4d\xb3\x5d\x39\x9a\xf8\xda\x58\x86\xdd\xaf\xd7\xbd\x00\xeb\x3d\x95\x65\xd4\xc5\x21\x3e\xe5\xf4\x47\xbe\xce\x55\x7d\x17\x36\xd1\x35\x5e\x3a\x8c\xd9\xbd\x18\xfd\x0d\xba\x0d\xa2\x8b\x6d\xe8\xba\x85\x1d\x10\x7d\x87\xed\xa5\x21\xf5\xdf\xe5\x33\x46\x13\xf4\xe5\x59\xf8\xea\xf3\x28\xec\x64\xb5\x42\x9e\x86\x43\x33\x26\x20\xba\x54\xf4\x94\xe6\x94\x59\x51\x54\xf6\xe9\x36\x29\x5f\x73\xc6\xca\x5b\x6b\xb0\x0f\x3f\xc6\xa5\xf8\x89\xdd\x56\x5c\x36\xbe\x8b\xdc\x2f\x88\x38\x65\xf1\x7a\x35\xab\x87\x32\x29\x96\x40\x18\x83\x15\x51\xca\x0a\x2b\xde\xd2\x58\xea\x2f\x2d\x0f\xca\x56\xf5\x3c\xd1\x10\xfe\x77\x65\xdf\x11\x16\xbd\x50\x23\x3e\xb0\xbf\xa7\xc9\xe8\x27\xb4\xdf\xfd\xfe\x5f\x4a\x3f\x3f\xae\xf3\xee\xc7\x32\xdd\x90\x5c\x3f\xbe\xf5\x1e\xd8\xcf\x6b\x28\xe7\xc7\xf6\x50\x77\xd1\xe0\x61\xa2\xf8\xf5\x7a\xed\x81\x23\xb3\x29\x3c\xf9\x35\xcd\xf6\xd0\xa6\x7f\x51\xa1\x78\x04\x3d\x5e\xae\x4a\x3c\xaf\xbb\xee\xc7\xb8\x09\xa4\x78\x62\x72\x37\xa2\x4e\x0c\x34\xd5\x6b\x61\x38\x43\x3d\x4b\x08\xe7\x28\xdb\xe2\x90\x53\x16\xdd\x21\xda\x5b\xb4\x3d\x16\x97\x6a\x37\xcb\x30\xd1\x98\x1a\xf3\x67\xab\xb8\x38\x45\x2d\xe2\x53\x27\x79\xc3\x46\x03\x7e\x76\x41\x35\x32\xaa\x74\xd8\x6a\x33\xbb\x1d\xf9\x2d\x9d\xf5\x44\xcf\x8e\xc5\x4f\x74\xef\x59\xfa\x2c\x6d\x5f\xcb\xe9\x37\x2d\x39\xdd\x27\x7f\xad\xce\x0a\x5a\xa2\xb2\x92\x94\x37\x37\x43\x32\xb3\x16\x68\x03\x9a\xf9\x0e\x45\xe8\xac\x9a\x41\xce\x84\x04\x3a\x01\x49\x6d\xf1\x28\xff\x5f\x1b\x34\x2f\x9c\x1c\x1c\xea\x54\xca\x3d\xd7\xdd\xca\x9b\x1d\x90\x74\xfd\x58\xda\xae\xfd\xda\xd9\x15\x2d\xf7\x7f\xe4\x84\x85\xad\x6e\x65\x6d\x19\x55\xa6\x75\x62\x02\x7b\x76\x28\xcf\xd1\x49\xe1\x51\x10\x30\x6e\xc1\x33\xe4\x83\x4a\x37\x2a\x1d\x32\x0e\xff\x84\x4f\xf0\xee\x1d\x50\xf8\x07\x30\xfe\xe1\x53\xf5\x15\xa8\xdf\xec\x1b\x3d\x6f\x74\x5d\x9d\x51\xbb\xc0\x79\xa9\xc7\xef\x8a\xf9\xa0\xfd\x76\xb5\xc0\x5c\x22\xf9\xde\x69\xa2\x5b\x4a\xbc\x1e\x28\x8a\x8f\xef\xad\x20\xf7\x9f\xa2\xbe\xe3\x35\x70\x2f\xcd\xe1\xfd\xc7\xea\x6f\xd3\xc6\xdc\x32\xa9\xbd\x43\xee\xf3\x86\x5e\x9b\xfe\x3f\x00\x00\xff\xff\x8f\x1c\x2f\xa7\x90\x1d\x00\x00")
+var _templates10_relationship_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\xeb\x73\xdb\xb8\x11\xff\x4c\xfe\x15\x7b\x1a\x25\xa5\x5c\x85\x6e\xfa\xd1\xad\x3b\x93\x26\x8e\xcf\xcd\x5d\x4e\xe7\xc7\xe4\xc3\x4d\xe6\x06\x26\x97\x32\x1a\x08\x50\x00\x32\xb6\x87\xe1\xff\xde\x59\x10\x7c\x49\xa4\x1e\xb1\x93\x26\x1f\x12\xf3\xb1\xd8\xe7\x0f\x8b\xdd\x15\xf3\xfc\x19\xf0\x04\x94\x86\xf0\x92\x5d\x0b\x0c\xcf\xcc\x7f\x14\x97\xf6\xba\x79\x74\x8e\x2c\xfe\x4d\x8a\x7b\x78\x56\x14\x3e\x2d\x41\x61\xd0\xde\x78\x74\xa7\x99\x9c\x23\x8c\x93\x0f\x78\x0f\x47\xc7\xd5\xb2\xd7\x6f\xf0\xde\x34\x44\x87\x07\x60\x30\x55\x4b\x03\x8a\x38\xdd\x6a\x9e\x22\xa4\xca\x5e\x58\x69\xf6\x7f\x03\x07\x87\xcd\x1a\x9e\x80\x54\x29\x04\xe3\xb0\xd6\xc0\x4a\x09\x5f\x2b\x8d\x7c\x5e\xaa\x39\x29\xe9\xad\x26\x63\x61\xb9\x90\x16\xe3\xf0\x85\xe0\xcc\xa0\x29\xd5\x29\xd5\x73\xd7\xad\x05\xc9\x96\x05\x6d\x49\x6d\x41\x1a\x85\x95\x52\x0a\x0c\xcf\x51\xb0\x94\x2b\x69\x6e\xf8\xd2\xad\x7c\xcb\x16\x9d\x15\x4c\xcf\x69\xc5\x52\x73\x99\x26\x30\x5a\xb0\xfb\x6b\x7c\x62\x46\x35\x8b\xab\xe5\x05\x97\xf3\x4c\x30\xdd\x5e\x15\xa9\x8e\x9c\x97\x4a\x64\x0b\xe9\x24\xb8\x9b\x16\x75\x52\x91\x27\x3d\xe4\xce\x94\xf5\x55\x99\x41\x33\xd3\x7c\xc1\x53\xfe\x09\x0d\x89\x5b\x79\x32\x2e\x5d\x62\x1c\xa3\xb6\x7f\xfa\x24\xf4\xf8\x6f\x5d\xa8\x89\x6e\x70\xc1\x2e\x6b\xef\xb7\x38\x7f\x86\x71\x78\xd1\x7a\x6d\x41\xc7\x13\x8a\x50\x1c\x9f\x0a\x75\xcd\x84\xe5\x74\x78\x08\x17\x98\xe6\xf9\x58\xa3\xa8\x04\x15\xc5\x29\xa8\x04\xd2\x1b\x84\x3c\xaf\xbc\xf6\x4a\xdd\xca\xca\xb9\x45\x41\xa8\xa3\xf7\x9a\x62\x86\x31\xf0\x14\x17\xa1\x63\x66\x40\x85\xe7\xe1\x2a\x4b\x5a\xe1\xa8\x2d\xe1\x8b\x38\x36\xa0\xda\x4f\xeb\x35\xbf\xa8\x88\x89\xa2\xb0\x64\x57\x06\x8d\xd5\x64\x5e\xea\x1c\xb3\x94\x5d\x33\x83\x70\xc3\x64\x2c\x30\xf4\x93\x4c\x46\x10\x28\x38\xc8\xf3\x75\x14\x14\xc5\xa4\xd7\xbc\x20\xcf\xdd\xbe\x18\x87\x6f\xd5\x4b\x25\x53\xbc\x4b\x8b\x22\x4a\xef\x20\x2a\x6f\x42\xf7\x70\x0a\x79\x8e\x32\x26\x5f\x01\x97\x06\x75\x0a\xd7\x4a\x89\x69\xa5\xb5\x95\x9b\xf4\xc9\x45\xad\x95\x86\xdc\xf7\x34\xa6\x99\x96\xa0\xc2\x1e\x4d\x02\x17\x94\x96\x12\xd7\x8a\x8b\xf0\x14\xd3\x57\xff\x0e\x26\x79\x4e\x59\xc2\x2a\x36\x85\xea\x85\xa3\x74\xef\x65\x5c\x14\x53\xa7\x5a\xad\xd5\xc4\x2f\x7c\xbf\x56\xdc\x6f\x85\x7e\xc6\x24\x8f\x36\x44\x7e\xf6\xdd\x44\xde\x6a\x4a\x99\xae\xf4\xe4\x97\x45\x7a\xd6\xe3\x60\xbc\xc3\xa8\x74\xe6\xc9\x1d\x46\x59\xaa\x74\xcb\xcd\xeb\xf1\x6f\xc8\xdd\xa3\xd6\xaa\xb6\xf3\x77\xc5\x45\xee\x7b\x3c\x21\x9b\x28\x49\x6c\x00\x45\x1f\x3a\xdb\x68\x24\xbd\xd6\x03\xff\x0f\xcb\xf9\xa7\x63\x90\x5c\x10\xf8\xbc\x25\xb9\x31\xb0\xe6\xbe\xd3\x6c\x79\xa2\x75\x80\x5a\x4f\x26\xbe\x57\xf4\x81\x84\xc9\xb8\x93\x23\x76\x02\xcd\xe9\xec\x47\xc9\x17\xd6\xbe\xe5\x63\x20\xeb\x74\x36\x1c\xa6\xc7\x4b\x22\xbb\x82\xe5\xf1\x33\xc8\x03\x80\xd4\x0f\x92\xef\x03\x22\xae\x24\x2a\x0f\xd6\x33\xf3\x52\x2d\x96\xca\xf0\x14\x4b\x6c\x9f\x7c\x42\x7d\x0f\x51\x79\xd0\x3a\x7d\xa9\x22\xe3\x86\x0a\x2f\xe0\x12\x94\x44\x48\x35\x93\x86\x45\x54\xab\xc0\x2d\x4f\x6f\x2c\x99\x8b\xab\x4a\x2a\xe1\x53\x62\x48\x70\x73\xf7\xb0\xc8\x4c\x0a\x37\xec\x13\x02\x13\xc2\x71\x5f\xc0\x52\x2d\x33\x67\x02\xe9\x66\xa3\xf1\x45\x80\xfc\xfe\x32\x5d\x7d\x02\xe6\x79\x9f\xcb\x6d\x42\xa9\x4e\x47\xab\xe5\x99\xbc\xbc\xeb\xdf\x54\xee\xc2\x29\xb2\x4f\x82\x24\x57\x06\x8d\x65\xbb\xb0\xaf\xcc\x6e\x59\xd0\x1c\xe2\x66\xc8\xf3\x5f\x96\xaf\x7d\xaf\xb0\x87\xf5\xd6\x88\x0f\xca\xed\xc8\x6c\x0c\xfd\x7f\x47\xdc\x99\xed\x7b\x9f\x98\xa6\xe7\xf4\x4f\x69\x9b\xce\x1c\x4f\x72\xab\x4b\x6e\xc7\x15\xeb\xf0\xcc\xbe\xdb\xc7\xa3\x0e\x39\x09\xea\x60\xb2\x9e\xb5\xaa\xc0\x59\xe9\xc6\x66\x2e\x3a\xff\xa6\x30\x4a\x18\x17\x18\x53\xb6\x70\xfa\x70\x99\x2a\x48\xca\xa0\x82\xc5\xf3\x68\xe2\x7b\x5e\x41\x27\xe5\x66\x0c\xf3\x04\x3e\x66\xa8\x39\x9a\xf0\x67\x66\xde\x72\x11\xe4\xb9\x6b\xe8\xf8\x14\xc6\x51\x53\x9c\xbb\xd8\x95\xa5\xbc\x29\x8a\x92\x2b\xa7\xbd\xe5\x5c\x5e\x39\xa2\x71\x71\xd5\x1e\x44\x44\x6e\x69\x26\x6d\x48\x3a\xcb\xde\xe2\x6d\x30\xca\xf3\x71\x38\xfb\x30\xa7\xb6\xa9\x28\x8e\x20\x93\x64\x05\x99\x28\xb8\xfc\x40\x87\x73\xa7\x43\x30\x75\xe4\x88\x24\xcf\x3b\x2a\xae\x13\x4d\x81\xad\xa4\xc6\xad\x4b\xaa\xe4\x29\x33\x21\xc8\x99\xe4\xc8\x48\x09\xdb\x1f\xfd\xf1\xde\xa4\x9a\xcb\x79\x0e\xad\xfe\x77\xc5\x5d\x1b\xfc\x44\xb6\x46\x45\x31\x6a\xe0\x50\xf8\x5e\xb6\x8c\x59\x8a\xbf\x67\x94\xc5\x8f\x8e\x21\x59\xa4\xe1\x45\xd9\x2c\x06\xbe\xe7\x8d\xae\x66\xaf\x5e\x5c\x9e\x90\x1f\x5a\x9d\x53\x51\xc0\xc5\xc9\x25\x3c\x31\xf0\xee\xe7\x93\xf3\x13\x78\x62\x46\x53\xdf\xf3\x4c\xaa\x17\x4c\xce\x05\xd2\xa1\x3b\x63\x9a\x2d\xc8\xab\xa6\xf4\xf1\x2f\xbf\x17\xc5\x68\x0a\xf6\xfa\xbc\xbc\x76\x7b\xf1\x15\x67\x02\xa3\x34\xbc\x32\x78\x26\x63\xbc\x9b\x09\x16\xe1\x8d\x12\x31\x6a\x53\x14\xcf\xab\xdd\xf8\x37\x67\xc5\x94\x3c\x6a\x26\x5d\x81\xef\x6e\x50\xe3\x4b\xc1\x32\x83\x0f\x13\x27\x50\x06\x96\xff\x5f\x7b\x04\x37\x89\xa6\x7b\x0e\x53\xcf\xca\xf4\xfd\x1b\xbc\x77\xde\x27\xed\x26\xb4\x8f\x45\x86\x2e\x72\x5c\xa6\xa8\x13\x16\x61\x5e\x74\xc2\xb7\x01\xe9\x1b\x71\x5d\x47\x55\x51\x4d\xe7\x86\x1f\xb3\x37\x0d\x02\x08\x55\x16\x2d\xbf\xb2\x25\x04\x8c\x86\x0c\x2f\x09\x47\xce\x84\x09\x7c\x86\xff\x2a\x2e\x61\x34\x05\x15\x8e\x68\xa3\x8c\x8a\x51\x35\x02\x69\x26\x2d\x3f\x20\x3e\xea\x6d\x92\x8f\xf2\x91\xc5\xbb\xf3\x89\xbd\xb6\x56\x7e\x2d\xf8\xfc\xfd\xeb\x81\xa6\x8d\x86\x48\x09\x62\xfd\x75\x42\x4f\x6a\xfb\xbe\xb7\x7a\x52\xd6\x79\xdb\x9e\x1e\xaf\xf0\x3a\x9b\xff\xaa\x62\xb4\x79\x95\xb2\xc6\x6b\x8b\x0a\x21\x83\xe6\xfd\x3b\x9a\xb6\xe9\x29\xb4\x30\x34\xd9\x4e\x5d\x3a\xc0\xa6\x3e\xaf\x74\x67\x57\xf4\x99\xb1\xe4\x41\x94\xde\x4d\xac\x74\x9a\xe5\xa1\x2d\xf7\x57\x99\xbd\xd6\x6a\x61\xe9\x56\xa5\xde\xee\xa0\xd9\x6d\xbf\x3e\x55\xc9\x3e\xec\xa0\x3f\xa7\xee\x84\xa6\xd3\xd6\xd6\x91\x41\x4b\x4e\xc5\x30\x0c\xc3\xf5\xb3\x77\x87\xa3\xb7\x64\x05\x82\xca\xf3\xe6\xcc\x5d\xdb\xb7\x6d\x3d\xea\xea\xc6\x69\x4a\x2e\x99\xba\x5a\xe0\x9b\x69\xd6\x81\x55\x7f\x51\x30\x54\x02\x54\xb0\xae\x27\xb4\xe3\xc4\xa6\x4d\x4e\x1b\xb0\x37\x79\xc2\x98\x57\xd4\x3c\xd9\x6d\xae\x18\xf5\x4f\x13\xcb\xc9\x8f\xa7\xc2\x66\x1f\xb7\xb2\x30\x1c\xc3\x70\x96\x4e\xa2\x62\x05\xc2\x55\xc9\xf3\xc2\x18\x3e\x97\xc1\xd3\x01\xae\xd3\x2d\x4c\x27\x9d\x6a\xb1\x7b\x49\x00\xa0\x8d\xbb\x62\x73\x63\x84\xcd\x1d\x2b\x7a\xdb\x67\x3b\xa8\xea\xf2\xce\xda\xd2\x8e\x42\x76\xa7\xaa\xf0\x1c\x8e\x1b\xf4\xd8\x5b\x78\x3a\x94\x0b\xcf\x89\xc6\x5b\x2d\xd6\x8f\x2a\x1d\xa7\xae\xa6\x2c\xe1\xed\xf8\xad\xf7\xb6\xb5\x4d\xad\xf2\x33\xbc\x92\xfc\x63\xe6\x6c\xe2\x75\xa7\xd9\xd5\xae\xf5\x10\x9e\x36\x2e\xdf\xa0\xa3\x6b\x8e\x8f\x40\xad\xeb\x36\xd4\x49\xc3\x31\x28\xdf\x5b\x71\xf3\x57\x50\xa9\xbf\x1d\xba\x10\x3c\x42\x77\x22\x2a\x97\xf0\xf7\xd2\x9d\x2d\x97\x28\xe3\x60\x88\x62\x0a\x6a\x7d\xb3\xbb\xa4\x21\xb9\xa0\x86\xcd\xab\x7e\x5d\x09\xdf\x66\x42\x90\x3d\x1b\x46\xec\xe7\xb8\x50\x9f\x70\x35\xc6\xa7\xa0\x5b\x3f\x79\x6c\x9f\x75\x48\x2e\xc2\x86\x1b\x4d\xc3\x12\xad\x16\x76\x9a\xb0\x64\xc6\xd0\x58\x4d\x56\x01\xb0\x13\x36\xf3\x97\x8e\x04\x43\x07\x69\x16\xa5\x10\xfc\xb6\xa4\xe1\x05\x13\x93\x47\x9a\xb1\x0f\xd8\x37\xdc\xc6\xad\x0f\x1f\xea\x2d\xb7\x47\x97\xe9\x22\xa2\xc2\x7e\xf9\x8f\x35\x1a\xdb\x6f\xa8\xde\xaf\xcb\xec\x3b\x89\xf5\xfe\x53\xf5\x01\x7b\xbe\xc5\xf0\x61\x2b\x12\x56\xc6\xa3\xfd\xaa\xee\x33\x4c\x70\x12\x1f\x32\xfd\xdc\x71\x8c\xde\xaf\xeb\xe9\xec\xc7\xc8\x09\x5f\x38\x47\x1f\x32\xfa\x2b\x25\x8a\x3d\xe0\xf1\x78\x59\xe2\x01\xd0\x19\x84\xc5\xf7\x00\x0a\x77\xdc\x6d\x9c\x9c\x4b\x77\x14\xf6\x8f\xd0\x23\x81\x4c\x63\x0c\xd7\xf7\xf6\xa9\xa1\x1f\xf7\xcb\x8a\x7d\xaf\xd9\xf7\xce\x61\xfc\xf6\xf9\xa8\x3e\x99\xba\x43\xcf\xcd\xd3\xc3\x3c\x5f\x9f\x9e\xac\x34\x09\x34\x34\x35\x33\x65\x3b\xe8\xcb\xfb\x25\xd2\xe7\x1c\xb6\xa6\x2f\xc1\xe8\x6a\xed\x49\x68\xdf\x6d\x29\xf1\xa9\x7e\xa9\xaa\x36\x9e\x0c\x71\xaa\x8a\x9a\x6e\xfd\x7c\x81\xe9\x45\xc4\xa4\x44\xbd\xa1\xdc\x97\x5c\x6c\xa8\xe9\x9f\xc1\xd8\xe0\x92\x2c\x1d\x8d\xea\xaa\xb1\xb3\xfd\xcf\xd5\xad\x79\x91\x24\x18\xa5\x18\x17\xc5\x9f\x9d\x24\x6d\x3b\x51\x15\x5e\x59\xd4\xec\x93\xd8\x6d\x5c\xdf\xdd\xf0\x14\x05\x37\x69\xb0\xc1\xe7\x6e\xd8\xb8\xc5\x2f\x44\x45\x86\xb4\x07\x91\x74\x0f\xc7\x34\x83\x1a\xd5\xa3\x5a\xf7\x67\x7d\x32\xdd\xae\x9b\x77\x0b\xb0\x53\x70\x2d\xca\x55\x0f\xd4\x0e\xec\xe6\xa8\xb9\xce\xa7\x27\x50\xdd\x2d\xf4\xd0\xf8\xf4\xba\xbe\x33\xc1\xda\xec\x97\x2f\x97\xdc\x9a\x09\xec\x2d\xbe\xf1\x86\x63\xfe\xb0\x81\x41\xdd\x41\x36\x42\x86\x3a\x3e\x1b\xc1\x4e\x1f\x55\x75\x51\x9f\x3f\x0f\x75\x56\x75\x4f\x32\xd0\x26\xd6\xcb\x56\x5a\x9c\x3e\xc0\x24\x4a\x03\x9f\x82\xe6\xb4\x3d\xcb\xa4\x34\xb8\x9c\xa4\x6f\xca\x6a\xd6\x08\x4e\x25\x94\xb2\x26\x6f\xe9\xe6\x89\xbc\x85\xe6\x9f\x8e\x41\xf3\xe6\xb6\xc5\xa0\xa6\xae\xd0\x7d\xf2\x31\x63\x22\x68\xe3\xba\xb5\x72\x52\x2d\xad\x23\xe9\x51\xde\xe7\x32\x43\xdb\x2e\xfa\x9e\x27\x24\x59\x4b\xa3\xf2\x21\x5b\x69\xcc\xc7\x13\x10\x12\xfe\x05\xcf\xe1\xe9\x53\xe0\xf0\x4f\x10\xf2\xd9\xf3\xea\x17\xa6\xfe\x65\x7f\xf0\xf7\xad\xc9\xc4\xda\x5b\x62\xf0\xde\x2a\xb1\xb1\x53\x1d\x5c\x7f\x54\x31\xb8\xd6\xc8\x3e\xf8\x5e\x07\xb1\x2b\xdd\x6a\xfd\x22\xcf\x0f\x0f\x28\x06\xee\x67\xae\x0f\xd8\x3a\xb3\x0f\x0e\xab\x2f\x13\x2b\x5a\xfb\xc5\xa1\xdb\x7f\x1a\x59\x5c\x7e\x76\xe8\xbe\x2e\xec\x50\x1e\x1e\x38\xbc\xac\x33\x39\x3c\x28\xe7\xb3\x29\xbb\x16\x08\x07\x87\x45\xe1\xff\x6f\x00\x08\x9a\x4f\x30\x32\x29\x00\x00")
 
 func templates10_relationship_to_one_setopsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -329,11 +356,11 @@ func templates10_relationship_to_one_setopsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/10_relationship_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe6, 0x22, 0x3f, 0x2d, 0x39, 0x38, 0xd8, 0x6e, 0x19, 0x86, 0xcf, 0x30, 0x2, 0x34, 0xed, 0x89, 0x62, 0x16, 0x47, 0x21, 0x0, 0x8a, 0xe0, 0xe1, 0x37, 0x4, 0x6a, 0x51, 0x33, 0x42, 0x14, 0x82}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5e, 0x55, 0x2e, 0xc7, 0xb9, 0x8, 0xa6, 0x7e, 0x53, 0x96, 0x5c, 0xa3, 0x73, 0xe1, 0xb8, 0x98, 0x81, 0xab, 0x6e, 0x63, 0xdd, 0x56, 0xa9, 0xb8, 0xe, 0x97, 0x5d, 0x82, 0xfc, 0x7d, 0xe2, 0x76}}
 	return a, nil
 }
 
-var _templates11_relationship_one_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x4b\x73\xdb\x38\x12\x3e\x8b\xbf\x02\x51\x29\x59\x2a\xa5\xd0\xbb\x7b\xf4\x96\x0f\x5e\xdb\xf1\x78\x26\x0f\x45\xb6\xcb\x87\x54\x2a\x05\x93\x4d\x19\x33\x10\xa0\x01\x20\x3f\x8a\xc6\x7f\x9f\x42\x13\x7c\x99\xa4\x2d\x39\x4e\x4a\x37\x12\xec\x6e\x7c\xe8\xfe\xd0\xf8\x20\x65\xd9\x3b\xc2\x52\x12\x9d\xd1\x4b\x0e\xd1\x89\xfe\x5d\x32\x81\xcf\xe4\x9d\xb5\x81\xfb\x0a\x5c\xe7\x2f\x03\xf7\xa6\xa8\x98\x03\x19\x29\xe0\x64\x77\xaf\x70\x3b\x93\x9f\x05\xcc\x80\x53\xc3\xa4\xd0\x57\x6c\xa9\x73\x07\xf4\x18\x71\x83\xf1\x76\xf7\xc8\x28\xda\xe7\x8c\x6a\xd0\xb9\x1f\x86\x89\xaa\xd9\xbc\x7d\xfa\xb8\xfd\x7b\xa9\x80\xcd\x45\xcb\x4d\x01\x47\x6b\x74\xcc\x63\x44\x75\x4c\xb9\xf7\x27\xba\x68\x78\xc5\x12\x17\xe2\x41\x46\x07\x92\xaf\x16\x22\x37\xf5\xcf\x75\x64\x85\x75\xda\xb6\xf6\xb0\xda\x4e\x2b\x0d\x7a\xaa\xd8\x82\x19\x76\x0d\x88\xee\xc1\xc8\x28\x5f\x9d\xae\xa7\xa3\x0e\xa0\xbd\xea\xc7\x27\xd4\xf1\x15\x2c\x68\xc3\xc1\x61\x6e\x45\xb9\x27\xa3\xe8\x14\x6d\xdb\x25\xc8\xed\xa6\x7f\xc0\xdd\x81\xe4\x08\x3a\x9c\x83\xf1\xb3\x17\x78\x1b\xe1\xc6\x91\xb3\xf6\x98\x35\x41\xf2\xb0\xd4\x95\x30\x49\x8e\xb9\xbc\xa4\x1c\x27\xd8\xd9\x21\xa7\x60\xb2\xac\x2c\x57\xf4\x41\xc6\x94\x5b\x7b\x4c\x64\x4a\xcc\x15\x90\x2c\x2b\x8a\x71\x28\x6f\xc4\x29\x13\xf3\x15\xa7\xca\x5a\x62\x24\x7e\x57\xae\xa6\x90\x10\x66\x60\x11\xf9\x78\x9a\xc8\x68\x16\x75\x44\x75\x4e\xde\x01\x6d\xf7\x93\x44\x13\x59\x1f\x6d\xba\xf9\x15\x59\x8b\xd6\xe7\x1a\x34\xce\x39\xcf\x17\x90\x50\x43\x2f\xa9\x06\x72\x45\x45\xc2\x21\x0a\xd2\x95\x88\x49\x28\xc9\xdb\x0a\xf4\xf9\xb2\x82\x3c\xee\x5b\x6b\x88\xb9\x11\xd2\x90\x51\xf4\x49\x1e\x48\x61\xe0\xd6\x58\x1b\x9b\x5b\x12\xe7\x2f\x91\x1f\x9c\x90\x2c\x03\x91\xb8\xdc\x11\x26\x34\x28\x43\x2e\xa5\xe4\x93\x32\x0d\x6e\xea\xb4\x6b\x6a\x50\x4a\x2a\x92\x05\x03\x05\x66\xa5\x04\x91\x51\x37\x98\xd0\xd7\xa9\x86\xe3\x52\x32\x1e\x1d\x83\x39\xfc\x7f\x38\xce\x32\xd7\x00\x10\xdb\x84\x14\x1f\xbc\xa5\xff\x2e\x12\x6b\x27\x1e\x5d\x09\x6c\x1c\xd8\x20\x28\xb1\x07\x35\x36\x4c\xa9\x60\xf1\xe3\x64\x98\x6e\x21\x19\x10\xb6\x26\x52\xe4\x99\x7d\x76\xf1\xa7\x1d\x09\x87\x5b\x88\xf3\xe4\x1e\xdd\x42\xbc\x32\x52\xd5\xd2\xde\xa6\x44\x65\xee\x87\x6a\x5e\xf5\x62\xac\x4b\x95\x2c\x18\xb0\xd4\x2d\xcb\x6d\xf4\xc7\x79\xd2\xc5\xd9\x3a\x47\x1d\xb4\x36\x17\xfe\x87\xc1\x5f\xed\x11\xc1\xb8\x9b\x6d\xb0\x74\xc9\x0c\x71\x09\x17\x8a\x2e\x8f\x94\x0a\x41\xa9\xf1\x38\x18\xd8\x2e\xde\x50\x91\x34\x3a\xc9\xba\x3c\x3a\xde\x46\x22\x3d\xd1\x55\x70\xb1\xcb\x17\x22\xdb\xf1\xf4\x57\xb4\x9a\x0d\xf8\xf3\xf2\x7d\xe6\x07\xb8\xd5\xcb\x9b\x6d\x63\xcd\x33\xab\xbf\x7d\x9d\xa6\x3c\x94\xae\xa9\xc2\xba\xe1\x40\x80\xfc\xf1\x91\x32\x14\x21\x0e\xf7\x03\x9d\x94\xeb\x93\x22\x57\x6e\x86\x58\xba\xb4\x3a\xca\x65\xd9\x08\x5f\xd0\xb7\x52\xac\x83\xbf\x57\xa0\x18\xe8\x68\x5f\x6b\x36\x17\xe1\x9b\x96\xf7\xa4\xe6\x3c\xf6\xf2\x07\x57\x16\x04\x83\x82\xd4\x7b\x65\x81\x4e\x10\xe2\x26\x9d\x10\x73\x77\x22\x52\x50\xe1\xb8\x4d\xd5\xe2\x6c\xc6\x2c\x68\xa4\xab\xe3\xea\x84\x0c\x53\xca\x38\x24\x8e\x1c\x3e\x2d\x4c\x18\x49\xbc\x2e\x23\x98\xda\xa1\xc3\x6b\x83\x81\xcd\x25\xba\x8b\xb7\x5a\x26\xd4\xc0\x97\x15\xa8\x3b\xb7\x15\xd3\x85\x89\x4e\x97\x8a\x09\x93\x86\x6e\xb6\xe1\xf9\xf4\x70\xff\xec\xc8\xd1\xba\x2d\x12\xad\x25\xa7\x47\x67\xe4\xb5\x26\x17\xbf\x1d\xcd\x8e\xc8\x6b\x3d\x9c\x38\x27\x6d\xd4\x82\x8a\x39\x07\xb7\xaf\xa7\x54\xd1\x85\xd3\xd0\x3a\x1c\x66\xd9\x28\xfa\xf0\xc5\xda\xe1\x84\xe0\xf3\x2c\x7f\xf6\x9c\x3b\x64\x94\x43\x6c\xa2\x73\x0d\x27\x22\x81\xdb\x29\xa7\x31\x5c\x49\x9e\x80\xd2\xd6\xfe\xa7\x60\xdd\xbf\x4b\x22\x7d\xfd\xa6\x8d\x62\x62\x9e\x65\xc3\x6c\x68\xed\x30\xcb\x9a\x12\x17\x87\x86\x76\x68\xed\xf8\x01\xae\x8b\x2b\x50\x70\xc0\xe9\x4a\xc3\x8f\xa1\xfa\x6f\x1b\x55\x45\xe4\x66\x07\x70\xbc\xa4\xea\x2e\x17\xc8\x4e\xf1\x22\x28\x57\x91\x6b\xca\x57\xb9\xce\xff\xfa\x8d\x09\x03\x2a\xa5\x31\x64\x36\xab\x78\x56\xee\x13\xa4\xe1\x03\xa9\x7d\x4f\xf2\x34\x7c\xa4\x4b\x12\x52\xb7\x9b\x71\xd8\xa3\x18\x93\x7b\xf2\xa7\x64\x82\x0c\xab\x20\x43\x6b\x7d\x62\x82\x72\xeb\x54\xbc\xf4\x1b\x81\xa5\x39\x15\x0f\xe1\x72\x35\xff\x28\x93\x9c\x2f\x03\xc7\x90\xf7\xc8\x10\x2e\xc2\xca\xe0\x42\x31\x03\x6a\x42\x6a\x7c\x1a\xaf\x61\x9e\x2f\xdd\xd3\xb2\xb9\x11\x8b\xf9\x4f\x34\x7a\x84\xb1\xb9\xc5\x73\x63\x70\x83\xbe\x2e\x5d\x0f\xe3\xbd\x57\x72\x81\x76\xad\x99\x6f\xd6\x81\x77\xd3\x07\xaa\xe8\xfe\x8f\xe5\xea\xfb\xc4\xef\x7c\xb7\x8b\xb1\x55\x86\xb5\xc9\x8a\xa0\x51\x14\xb5\xf7\xf4\xc3\x65\xb7\x43\xf9\xd9\x42\x6c\x18\x1b\x84\xf5\xc0\xd7\x6b\x1b\x79\xdc\xce\x8e\xb1\x25\x0d\xd6\x21\xc1\xc6\x2f\xa3\x19\xd9\xab\x56\x8a\xaf\xe4\x4d\xdf\xd9\x3b\x43\xe2\x76\x9c\x76\xbb\xc5\x8e\x98\xb4\xfa\x62\xdf\x89\x5c\x76\x76\x04\xe3\xb0\x94\x47\x71\x1d\x51\x6d\x10\x71\x75\x76\x84\x36\xae\xf2\x00\xdf\x25\xb2\x8d\xe9\x89\x43\xdf\x65\xdd\xa1\x2a\x6a\x2d\x18\x0f\x7c\xe9\xf2\x5f\x6c\x1a\xcd\xf1\xd3\x8a\xf3\xbc\x89\xf7\x5e\xbb\x67\xb0\x90\xd7\xd0\x79\xf3\x56\xb5\x9f\x49\xd6\x92\x31\x82\xf1\xa8\x8a\xe9\x54\x4c\xaa\xe4\x82\x50\xce\xc9\x92\x6a\xed\x14\x91\x68\x88\x23\xfd\xaf\xc6\x24\xda\x35\xb9\x55\x6c\x48\xf8\x79\xe9\xc6\x28\x1f\xbf\xd0\x85\xbb\x7f\x95\xcf\x13\xc2\xcf\xb8\x66\xf7\x42\x78\x29\x05\xbc\xd9\x0d\xbb\x17\xce\x74\x7b\xea\xbe\xf9\xdd\xba\x7f\x55\xbf\x42\xf4\x6e\x7a\x23\x7a\x82\x12\xeb\x49\xc9\x5f\x77\x99\xee\xdf\x44\x5b\xc4\x99\x9f\x73\x8d\x7e\x64\xe9\x3f\xa9\x81\x6c\x46\x95\x97\xeb\x1e\x3f\x76\x6f\xee\x85\xb9\x25\x04\x79\x7e\xa1\xb7\xa2\x7f\xf4\xde\x93\x0b\xbd\x75\x0a\xe6\x34\xa6\x42\x80\xea\xd4\x5c\x82\xf1\x31\xf2\xaa\xc1\xd9\x99\xbc\xd1\xfb\x69\x0a\xb1\x81\xc4\xda\xef\x8d\x16\xd3\xb8\xe7\x9e\xa3\x78\xdc\xf8\x9e\x7b\x71\xc5\x0c\x70\xa6\x4d\xd8\x75\x7b\xeb\xb8\xff\xae\xaf\x63\xb9\x2b\x4e\xa5\x62\x6d\xa5\x1c\x5f\x35\x94\x63\x8f\xcc\x73\xea\xc9\x89\xa9\x4a\xe1\x15\xfa\xee\xfe\xbe\x4f\xf3\x95\xb2\x0b\x67\x7b\x5a\xae\xa1\x69\x43\xad\x95\x49\xca\xb2\x9d\xb7\x4e\xb4\x79\x35\xfe\x17\xdc\x11\xe1\x15\x1b\x79\xbb\x53\xfc\xd1\x56\xb3\xcd\xff\x66\xeb\xfc\x84\xd7\x3f\x53\xb9\xfe\x13\x00\x00\xff\xff\xf5\x22\x99\x12\xc2\x1b\x00\x00")
+var _templates11_relationship_one_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4b\x73\xe3\xb8\x11\x3e\x93\xbf\x02\x51\x69\x27\x94\xa3\xa1\x93\x1c\x27\xe5\x83\x63\x7b\xbc\x4e\x76\x3d\x5a\x3f\xca\x87\x54\x6a\x0b\x26\x9b\x32\x32\x10\xa0\x00\x94\x1f\x45\xf3\xbf\xa7\xba\x09\xbe\x44\x52\x96\x66\x3c\x1b\x4f\x25\x17\x9b\x8f\x06\xf0\x75\xf7\x87\x46\x77\x8b\x59\xf6\x9e\x89\x84\x69\xc3\xc2\x2b\x7e\x2b\x21\x3c\xb3\x7f\xd3\x42\xd1\x75\xfd\xe8\x02\x78\xfc\x49\xc9\x27\xf6\x3e\xcf\x7d\x1c\x02\xd2\x02\xdd\x78\x78\x67\xb8\x9a\x03\x1b\x1b\x90\xec\xc3\x41\x39\xea\x4a\x7f\x52\x70\x01\x92\xa7\x42\x2b\x7b\x27\x96\xb6\x1e\xb0\xbf\xc7\x2c\xa4\x7a\x69\x99\xc6\x59\x1f\x8c\x48\x81\xa5\x9a\x2e\x68\x65\xfa\x6b\xd9\xde\x7e\x3d\x46\x24\x4c\xe9\x94\x05\xe3\xb0\x42\x83\x2b\x86\x1f\xb5\x01\x31\x2f\x10\x4f\x0a\x71\x02\x35\x96\x34\x09\x22\x1a\x87\x87\x52\x70\x0b\xb6\x80\x46\x48\xdd\x65\x43\x3e\xd9\x2c\xdf\x5c\xa7\xb9\x8c\x01\x49\xb3\xd3\x42\xc5\x1c\x61\x53\x6d\x92\x08\xcf\xf9\xa2\x35\x2a\xd2\x64\x2b\x07\x32\x3c\xd2\x72\xb5\x50\x85\xa8\xbb\x6e\x08\x27\xa5\x74\xd2\x95\x76\xb0\xba\x83\x56\x16\xec\xcc\x88\x85\x48\xc5\x3d\x58\x5c\x6c\xed\xc9\xb8\xd0\xce\x36\xcd\xd1\x04\xd0\xd5\x7a\xf3\x82\x36\xba\x83\x05\x6f\x0d\xf8\x70\xd0\x1a\x53\xcc\xf2\xcc\xc6\xe1\x25\xc9\x76\x5d\x50\x0c\x9e\xfd\x1d\x9e\x8e\xb4\x24\xd0\xc1\x1c\x52\xb7\x7a\x89\xb7\x35\xdd\x24\x44\x69\x87\xd9\x32\xe2\xa7\x48\xd0\x85\x71\x7c\x2a\xf5\x2d\x97\x84\x71\x7f\x9f\x5d\x42\x9a\x65\x95\xbb\xc2\x9f\x74\xc4\x65\x9e\x9f\x32\x9d\xb0\xf4\x0e\x58\x96\x95\xce\x38\xd6\x0f\xea\x52\xa8\xf9\x4a\x72\x93\xe7\xc8\x4b\x7c\x6f\xd0\xa7\x10\x33\x91\xc2\x22\x74\xf3\x59\xa6\xc3\x8b\xb0\x67\x56\x1c\xe4\x06\x90\xec\x61\x1c\x5b\xa6\x9b\x4f\xdb\xc3\x9c\x46\x79\x4e\xd2\xd7\x16\x2c\x61\x9a\x17\x0a\xc4\x3c\xe5\xb7\xdc\x02\xbb\xe3\x2a\x96\x10\xfa\xc9\x4a\x45\x2c\xd0\x6c\xaf\x06\x7d\xbd\xac\x21\x4f\x86\x74\x0d\xb2\xcc\x6d\xa3\x71\x78\xae\x8f\xb4\x4a\xe1\x31\xcd\xf3\x28\x7d\x64\x51\x71\x13\xba\x87\x53\x96\x65\xa0\x62\xb4\x1d\x13\xca\x82\x49\xd9\xad\xd6\x72\x5a\xe2\xa7\xa5\x93\xbe\xa5\xc1\x18\x6d\x58\xe6\x7b\x06\xd2\x95\x51\x4c\x87\xfd\x60\x02\xe7\xa7\x06\x8e\x5b\x2d\x64\x78\x0a\xe9\xf1\x5f\x83\x49\x96\x61\x8c\x21\x6c\x53\x56\xbe\x70\x92\xee\xbd\x8a\xf3\x7c\xea\xd0\x55\xc0\x26\x7e\xee\xfb\x15\x76\xbf\xc1\x86\x19\x57\x22\xda\x4c\x86\xd9\x1b\x24\x03\xc1\xc6\x40\x59\x58\xf6\x8b\x9d\x3f\xeb\x31\x38\x3c\x42\x54\x18\xf7\xe4\x11\xa2\x55\xaa\x4d\xc3\xec\x5d\x4a\xd4\xe2\xee\x51\x63\x54\xd3\x19\xdb\x52\x25\xf3\x3d\x91\xa0\x5a\xb8\xd1\x37\xf3\xa4\x8f\xb3\x4d\x8e\x22\xb4\x2e\x17\xfe\x42\x93\xff\xee\x80\x29\x21\x91\x92\xde\x12\x8d\x19\x90\xc6\x37\x86\x2f\x4f\x8c\x09\xc0\x98\xc9\xc4\xf7\xf2\x3e\xde\x70\x15\xb7\x22\xc9\xb6\x3c\x3a\x9d\x7d\x7f\x51\x85\x94\x5d\xbe\x12\xd9\x4e\x67\xc3\x6e\x7b\xbd\x50\xb3\x03\x7f\x5e\x3f\xce\x7c\x05\xb7\x06\x79\xf3\xd6\x58\xe3\xf2\x2e\x9c\x32\x3c\xb3\x47\x7a\xb1\xd4\x56\xa4\x50\x50\xff\xe4\x1e\xcc\x13\x8b\xe8\xe0\x2d\x81\x7f\x86\x27\x26\x2c\x26\x77\xec\xf6\x89\xc8\x66\x31\xf1\x71\x6e\xd5\x86\xad\x96\x31\x4f\x61\x4a\x64\xd3\x6c\xb1\xb2\x29\x4e\x75\xc7\xef\x81\x71\x29\xdd\x34\x0b\xb6\xd4\xcb\x95\x43\x8c\x18\xc8\x05\x5f\xca\xc5\xb7\x17\xf7\xaa\x23\xf2\x9e\x1b\x62\x11\x3d\xf0\xbd\x61\x73\x13\xd1\xff\xbd\x02\x23\xc0\x86\x3f\x72\x7b\x2e\x64\x90\x65\x2e\xef\x16\x53\x36\x8e\xaa\x5c\xcb\x65\x42\x79\x5e\xa8\x2d\x10\x9e\xc3\xa9\xc3\xda\x72\x65\x92\x17\xa1\x20\xbd\xa5\xfd\x54\x9e\xda\x84\xc8\x86\xe7\xf0\x10\x8c\xb2\x6c\x1c\xce\x3e\xcf\x31\x85\xcd\xf3\x0f\x6c\xa5\x70\x02\xa4\xa2\x14\xea\x33\x46\xb8\x9e\x14\xcf\x56\xea\xa2\xa0\x93\xe9\xbe\x9c\x32\xbe\xc6\xa0\x41\xd1\x92\x5b\x6a\x25\xe5\x88\x36\x95\x57\x53\x83\xec\xe3\x9c\x90\xf9\xde\x06\x4b\x7a\xcd\x8a\xa5\x6d\x39\xa7\x42\x65\x40\x27\x3b\x96\x24\x22\x54\x0c\x8f\x2d\x13\x93\x71\x9d\x90\x48\xb6\x4a\xaf\x65\xd4\x93\x10\x8f\x23\x4a\xa2\xcb\xdd\x58\xb3\xa6\xe1\x23\x76\xc0\xfa\xbc\x27\xa3\x0a\x41\x71\x6e\xfb\x9e\x57\xd2\xe4\xd0\x5a\x31\x57\xc1\xbb\x8d\xf3\x4e\x07\xa7\x9d\x94\xf3\xaa\xb8\x5e\xa3\x79\x8d\x25\x20\xda\xb8\xad\x77\x47\x95\x48\xcb\x1a\x3e\xdd\xec\x02\x98\x06\x38\x90\x74\xdd\x86\xe5\x7b\xe5\x09\x70\x50\x45\xb3\x33\xa2\xc1\x2e\x69\x03\x45\x82\x33\x95\x80\x09\x26\xdd\xb8\xbe\xb6\x25\x30\xb6\x63\xd2\x30\x65\xa3\x84\x0b\x09\x31\x12\xdc\x51\x4f\xa8\x54\x33\x57\xc4\x30\xb2\x35\x32\x15\xa9\x9a\x93\xba\xb4\xc3\xb2\xac\x87\x99\x94\x4f\x78\x5e\xe4\xaa\x9e\x7f\xfc\xd3\xa6\x46\xa8\x79\xc6\xb6\x66\x6b\x77\xbb\xe3\xc6\x8d\xe8\x5f\xa9\x2e\x5a\xbb\x88\xc0\xbf\xac\x30\x78\x7f\x38\x60\xc9\x22\x0d\x2f\x97\x46\xa8\x34\x09\x7c\xcf\xf3\x46\xd7\xb3\xe3\xc3\xab\x13\xdc\xd6\xdd\x82\x2e\xcf\xd9\xe5\xc9\x15\xfb\xc1\xb2\x9b\x1f\x4f\x2e\x4e\xd8\x0f\x76\x34\xc5\x41\x36\x35\x0b\xae\xe6\x12\xf0\x0c\x9e\x71\xc3\x17\x18\x2c\x6c\x80\x08\xc2\x9f\x7e\xc9\xf3\xd1\x94\xd1\xf5\x45\x71\xed\x22\xf2\xb1\xe0\x12\xa2\x34\xbc\xb6\x70\x86\xbb\x6b\x26\x79\x04\x77\x5a\xc6\x60\x6c\x9e\xff\xa9\x8c\xc9\x7f\x74\xfa\x4c\x31\x54\xd8\xc9\xda\x8a\x37\x77\x60\xe0\x48\xf2\x95\x85\xaf\x5b\x4f\x82\x0a\x68\x81\x3f\xf4\xac\x5c\x6f\x9e\xf6\x89\x8c\xc4\xe7\xe6\xa9\x28\x58\xb1\x02\x25\x78\xe8\xf4\x7b\x2e\x57\xe0\x9c\x29\x54\x0a\x26\xe1\x11\x64\x79\xcb\xa3\xb5\x37\x2b\x37\xf6\x6d\xc7\xa8\xe1\xd4\xe6\xe6\x58\xab\x96\x9f\x59\x41\x9a\x9f\xf9\x92\x05\x1c\x8f\x40\x7a\xec\x80\x4f\xd8\x33\xfb\x97\x16\x8a\x8d\xaa\x13\x2a\x1c\x61\xf0\x1f\xe5\xa3\xf6\xa6\x7e\x9f\x7f\xef\x3c\xa9\xb6\x4f\x36\xca\x46\xb4\x03\xda\xdb\x85\x1e\x91\xde\xdf\x8c\x4d\x7f\xfe\x96\x1c\xaa\xc3\xe1\x94\x7d\x63\x42\x94\x61\x76\x3d\x89\x72\x2c\x11\x49\x91\x18\x1d\xc3\xed\x6a\xfe\xb3\x8e\x8b\x10\xe7\x61\x54\xf9\x48\x6c\x91\x2a\xa8\x05\x6e\xb0\xd1\x67\xa6\x2e\x0b\x24\x6e\x4d\xb6\x10\x2f\xcc\xe0\x22\xa9\x97\x65\x0d\x96\x96\xeb\x9f\x59\x5a\x20\x88\xd2\xc7\x09\x41\xc0\x56\x22\x50\x61\xb0\x3e\xdf\x47\xa3\x17\x24\xd7\x59\xf9\x61\x1b\x78\x0f\x43\xa0\xca\xec\x7e\x93\xad\x7e\x9d\xba\xc3\x0a\x0f\x1e\x4a\x3e\x83\xc6\x62\xe5\xa4\x61\x18\x76\x8f\xa1\x75\xb5\xab\xa9\xaa\x3c\xd6\xad\x86\xba\x4d\xdd\xc9\xb6\xc3\xe4\x0e\xfe\x76\xe7\x5d\x31\x6f\xef\x51\xf7\xff\xec\xeb\x7f\x26\xfb\x42\x6f\x53\xe6\xad\xc3\x0b\x76\x50\xb3\x89\x6e\xd9\xbb\x5a\xb3\x76\xbc\xbb\x40\x19\xaf\xa7\x52\xfb\x50\xc6\xa1\x69\x27\x69\x1a\xaa\x6d\xab\xb4\x0f\x0b\x02\xc2\xe2\xee\xdb\x88\x1a\x0f\xd9\xbb\xda\x15\x2f\xe1\x72\x44\xc1\xaa\x47\x77\x31\xbd\x50\x3e\x23\x69\x10\x55\xb9\x9f\x94\x90\x7e\x73\x7b\x94\x92\x85\xdb\xcf\x57\x52\x22\x3d\x36\x34\xb0\x2f\x60\xa1\xef\xa1\xc7\x0a\xa7\xcc\x34\x7e\x70\xd8\xaa\x21\xa0\x84\x0c\xeb\x39\xb1\x1f\x90\x18\xbd\xa0\x1a\x7c\xc9\xad\xc5\x8e\x94\x2a\x4d\x4b\xcd\x29\xfb\xfb\xd6\x22\x16\x8f\x96\x55\x94\xb2\xe0\xd3\x12\x7f\xe9\xe0\x72\xf2\x4a\xad\xeb\x61\x2d\xbf\xac\xa5\xb4\x7d\x35\xee\xfc\xa4\xc3\x41\x08\xaf\xd5\x4b\xda\xad\x57\x3d\x08\x67\xf6\x76\xfc\xbe\x7b\x97\x7a\x58\xab\xdf\xa2\x61\xf3\x22\x2b\xd6\x7a\x8b\x83\x68\x77\xa9\x33\xdd\xa2\x5f\xd3\x3a\xdc\xb2\x2d\x3d\x08\xf7\x74\xf6\xdd\xc4\x8a\x2f\x6c\x48\x6f\x50\xfd\x1b\x05\x90\xdd\xa8\xf2\x7a\xd1\xe3\x2b\x68\xb4\x89\x22\x6f\x84\x20\xc3\x49\x64\xd5\x7b\x56\xee\xcc\xec\x6f\x42\x47\x12\xb8\x81\xb8\xd5\x88\x2e\xf2\xd6\x9d\x9a\xca\xbb\xb8\xf3\xb7\x0f\x53\x03\xad\x64\xdf\xd5\x20\x6b\xc6\xab\xbf\xa6\x18\x27\x94\xfd\x9e\xa9\x44\x63\x7a\xdd\xfd\x91\xbd\x9b\x28\xbb\xb1\x9d\xd6\x45\x2b\x8d\xa9\x3e\xf2\xc0\x7e\xac\x9d\x69\xaa\x5a\xaf\x9e\x96\xc0\x82\xc6\x92\x05\xb3\x5d\x1a\x3c\x09\xe9\x3d\x4d\xbf\x31\x5b\x66\x14\x30\xfd\xb2\x12\x12\xc9\xa6\x39\xcb\x7c\xaa\xc0\x53\x26\xb9\x97\x90\x5e\x46\x5c\x29\x30\x2f\x66\xe6\x4a\xc8\x89\xdf\x2c\x8c\x5a\x97\xef\xd9\xd8\xc2\x12\x6d\x30\x1a\x15\x4b\x88\x84\xb5\xc2\xcb\x85\x7e\xb0\x87\x49\x02\x51\x0a\x71\x9e\xff\xda\x3a\x0d\x5a\xfd\xca\x6b\xe2\xe4\x2e\xe7\x08\x51\xe6\xe6\x4e\xa4\x20\x85\x4d\x83\x17\x7d\xe2\x9a\x83\x5b\x58\x0b\x25\x51\xb1\x66\xf3\x10\xef\xd9\x01\xb6\x8d\x46\xd5\x6f\x05\xee\x5f\xb7\x5b\xda\xac\x52\xfb\x48\xd0\xf3\x39\x47\x13\xb0\xfb\xa4\xa3\x01\xae\xa3\xcd\x10\x5d\xca\x4a\xa7\x49\x91\xad\x7c\xef\x8a\x9c\x8e\xbf\xff\x7b\x0e\xed\xeb\x57\xf5\x9a\xba\x84\xba\x43\xc9\x2e\x31\x6c\xd7\x05\xbb\x2b\x9a\xb0\x62\xab\x67\xde\x50\x6d\x91\x75\xf3\x66\xa1\x55\x96\x59\xcf\xcf\x43\xa5\x57\x55\xfd\x50\x89\x56\x09\xb5\x56\x70\xea\xd6\x6b\x34\x86\xe5\xf5\xc9\x95\x65\xfb\x7b\x58\xf6\xba\xc6\xc3\x67\x68\x1c\x02\x7b\xfb\xe5\xc7\x69\xa5\x2c\x7d\x68\xe6\x9c\x60\x80\xc7\xc5\xd7\x66\xee\xa3\xb2\x96\xe4\xfe\x9e\x6b\x4a\x74\x27\xd9\xdf\x2b\xba\x64\x29\xbf\x95\xc0\xf6\xf6\xf3\xdc\xff\xcf\x00\x75\xfb\x93\xdf\x35\x27\x00\x00")
 
 func templates11_relationship_one_to_one_setopsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -349,11 +376,11 @@ func templates11_relationship_one_to_one_setopsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/11_relationship_one_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7a, 0x26, 0xad, 0xa2, 0x41, 0x10, 0x32, 0x6f, 0xa5, 0x73, 0x3d, 0xd1, 0x9c, 0xc0, 0xe9, 0xde, 0xde, 0x8d, 0x5b, 0x8c, 0xba, 0xb7, 0x98, 0xaf, 0xc, 0x53, 0x2a, 0xa, 0xf, 0x56, 0xaf, 0xc7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe0, 0xcd, 0x9c, 0xb4, 0x7c, 0xdc, 0x7f, 0xa6, 0x7, 0x9c, 0xf5, 0xbb, 0x20, 0xf2, 0x37, 0xb8, 0x88, 0xf6, 0x42, 0xe1, 0x99, 0x76, 0xd6, 0x4, 0x38, 0xb5, 0x13, 0xd7, 0x18, 0xe5, 0x18, 0x41}}
 	return a, nil
 }
 
-var _templates12_relationship_to_many_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xdd\x72\xd4\x3a\x12\xbe\x9e\x79\x8a\x66\x6a\x60\x3d\x94\x31\x0b\x97\xec\x66\x29\x16\x42\x96\x3d\x07\x6a\x48\xa0\xb8\xa0\x28\x4a\xb1\xdb\x13\x1d\x34\xd2\x20\x79\x48\x52\xc6\xef\x7e\x4a\xb2\xfc\x2f\x7b\x7e\x02\x24\x14\xdc\xc5\xb6\xd4\xea\x6e\x7d\xdd\xea\xfe\x46\x49\xd3\x7b\x40\x63\x08\xde\x90\x53\x86\xc1\x0b\xf5\x7f\x41\xb9\xf9\x1b\xee\x65\xd9\x58\x7f\x45\xa6\xf2\x87\x91\x7e\x9a\x26\xe6\xe3\xa3\x03\x3b\xa5\xfa\x22\x09\x5f\x20\x4c\x25\xb2\xea\x6b\xf0\x46\xbc\x24\xfc\xf2\x18\x19\x49\xa8\xe0\xea\x8c\xae\x54\x3e\x23\x17\xc6\x4a\x69\xd3\xe0\x09\xa3\x44\xa1\xb2\x62\xb5\x9c\xfa\x0a\xf9\xf8\x78\x78\xfc\x73\x21\x91\x2e\x78\x67\x9a\x44\x66\x46\x37\x27\xb6\x35\x73\xc8\x30\x6f\x5e\x91\xa5\xfd\xab\x72\x4e\xf9\xf8\xa7\x08\x09\x7b\xfe\x07\x5e\x9a\x51\xb5\x35\x43\x61\xfc\x60\x4d\x0c\x9e\x0a\xb6\x5e\xf2\x7c\x9e\xfd\xbb\x6e\x57\x31\x3a\xee\x8e\xb6\x0a\x75\x27\xad\x15\xaa\xb9\xa4\x4b\x9a\xd0\x2f\x68\x6c\x6b\xbd\x99\xe6\xbe\x51\x75\x67\xd6\x15\xe8\xb1\xb7\x77\x41\x15\x9e\xe1\x92\x34\x26\x68\x9d\x3b\x52\xbe\xc2\x34\x38\x31\x63\xf3\xe7\x9a\x9d\xf9\xb0\xf9\x1f\x78\xf9\x54\x30\xa3\xb3\xb7\xc0\xc4\x2e\xde\x50\xb7\x2e\x71\x16\xe8\x19\x56\x6d\x05\x06\x98\x34\xd6\x5b\x19\x45\x47\x4c\x9c\x12\x66\xd4\xbc\x7f\x1f\x9e\x44\x51\x9a\x96\xfb\x1d\x98\xdd\xc9\xb2\x23\x20\x51\xa4\x20\x39\x43\x58\xd0\x2f\xc8\x41\xea\x6d\xc7\x08\xc4\xe9\x5f\x18\x26\x0a\x12\x61\x3e\xe2\x05\x55\x09\xe5\x8b\xfc\x7b\x01\x58\x2d\x57\xc4\x66\x40\x9a\xe6\xf8\xcf\x31\xf1\x15\x14\xe5\x8b\x35\x23\x32\xcb\x7c\x10\x2b\x3d\x83\x30\x76\x09\x94\x2b\x94\x46\x50\x72\x86\x4b\x20\x0a\x38\x9e\x83\xc4\x50\xc8\x48\x05\x46\xcf\xd5\x0a\x79\xa4\x4a\x45\x12\x01\x22\x38\x0e\x1c\xba\x9b\xe1\x27\x98\x94\x63\x5b\xc3\xac\x9f\xb2\x0c\xc8\x6a\x25\xc5\x4a\x52\x92\x20\xbb\x34\xd3\xde\x2a\xb4\x56\xe7\x4e\x8a\x48\x42\x4e\x89\x42\x38\x23\x3c\x62\x18\x8c\xe3\x35\x0f\xc1\x13\x70\x37\x4d\x0b\xa0\xbe\x5d\x9d\x94\x46\xcd\xfa\xfc\xe9\x19\xff\x73\x91\xc0\x34\x78\x25\x9e\x0a\x9e\xe0\x45\x92\x65\x61\x72\x01\x61\xfe\x10\xd8\x97\x3e\xa4\x29\xf2\x48\xef\x8f\x75\x0b\x9c\x0a\xc1\xfc\xd2\xf2\x20\x08\xf4\xea\xb1\x6b\x75\x94\x52\x48\x48\xc7\x23\x89\xc9\x5a\x72\x10\x81\x5b\x1f\xcf\xc2\xa1\xa6\xca\xa9\xa0\x2c\x38\xc2\xe4\xd9\x7f\xbd\x59\x9a\xea\x1c\x66\xd4\xf3\xa1\xf8\x60\x47\xda\xef\x3c\xd2\x5b\x98\x2b\x58\xea\x16\x04\xc1\x6c\x9c\x8d\xc7\xa5\x05\xe3\x1a\xee\xe6\x84\xd3\x70\x18\x76\xf3\x5f\x14\x76\xc6\x35\x0a\x04\xcf\x37\x70\x6f\x98\xcd\x1d\xfb\x8a\x17\x18\xe6\x7b\x78\x78\x81\xe1\x3a\x11\xb2\xb6\xbb\x5d\xf0\x55\xc3\xed\xab\xda\xac\xfa\x9e\xef\x00\xca\x74\x3c\xa2\xb1\xb6\x4c\x67\xaf\x61\x44\xba\x02\xa4\x1e\x10\x5a\x3b\x27\xea\xfe\x65\xe4\xdf\x3a\x00\x4e\x99\x5e\x70\xb4\xd2\x2e\xf5\x8c\x21\xef\x24\x59\x1d\x4a\xe9\xa1\x94\xb3\xd9\x78\x94\xb9\x10\x4a\x78\xd4\xc8\x8e\xdb\x22\xf6\xe8\x57\x85\xec\x86\x4c\x69\x1c\xba\xfa\x46\xb0\x3e\x9a\xff\xa0\xf4\xb9\x03\x52\xbf\x4b\xee\xbc\x02\x8a\x7b\x11\xfa\x2b\xe2\x73\x4f\x9c\xdd\xc8\xec\x59\x1e\xe9\x5f\x88\x34\xf0\x30\x2f\xc6\xa3\x58\x48\xf8\x68\x44\x68\xb0\xe6\xbd\x44\x21\x4f\x43\x87\xc6\xc5\x5a\xfa\x69\x54\x06\x50\xf0\x46\x34\x5b\x96\x51\xf1\xb5\x5d\x1f\xdb\x8f\xba\xb6\xd4\xca\x85\x42\xa3\x49\xc7\x45\x9a\x4e\xcd\x83\x9d\x5a\xf5\x3b\xa3\xd1\xe7\x35\x4a\x8a\x2a\x78\xa2\x14\x5d\x70\xef\x4e\x63\xb2\x5f\x9b\x3b\x2b\x26\x5b\x00\x37\x1e\xf4\x93\x0d\xc4\x03\x6d\x54\xf0\xc2\x58\xb2\xcb\x19\x61\x36\xe1\x05\x8f\x51\x7a\xb3\x6e\x5c\x8d\x8a\x02\xc9\x38\x53\x99\xe0\xd2\x91\xe5\xc3\x24\x26\x94\xe5\xa8\xb4\xee\xa3\x3c\x11\x60\xeb\x70\x30\x3b\x34\x31\xca\x6b\x9d\x33\xa7\x5b\xb5\x12\xda\x27\x66\xa5\xf5\x2a\x22\x09\xbe\x5e\xa3\xbc\xd4\x1b\x15\x2f\x93\xe0\x64\x25\x29\x4f\x62\xcf\x28\x32\x79\x3b\x7f\xf6\xe4\xcd\xa1\x8e\xaf\x6e\xbb\x90\x65\x70\x72\xf8\x06\x6e\x2b\x78\xf7\xbf\xc3\xe3\x43\xb8\xad\x26\xbe\x99\xa5\x12\xb9\x24\x7c\xc1\x30\x38\xc1\x64\x4e\x24\x59\xea\xb0\x54\xde\x24\x4d\xa7\xc1\x9f\xaf\xb3\x6c\xe2\x83\xf9\xfb\x38\xff\xdb\x22\xfb\x19\x25\x0c\xc3\x24\x78\xab\xf0\x05\x8f\xf0\x62\xce\x48\x88\x67\x82\x45\x28\x55\x96\x3d\x28\xb0\xfd\xcf\x12\xae\xef\x3f\xa8\x44\x52\xbe\x48\xd3\x49\x3a\xc9\xb2\x49\x9a\x36\xbb\x1d\xf3\x6a\x92\x4d\xb2\x6c\xd6\x56\xec\xdd\x19\x4a\x7c\xca\xc8\x5a\xe1\xd5\xd4\x7a\xd8\x55\xab\x0a\x96\x67\xe2\x9c\x57\xe1\xa2\xd1\x4b\xe4\x65\xde\x2d\xe9\xd6\x27\xd7\xca\xec\xd7\x17\xc2\xd6\x79\xd7\xf7\xfe\x03\xe5\x09\xca\x98\x84\x98\x66\x69\x85\x49\x13\x4d\x06\xae\xad\xae\xeb\x2b\xe4\x5e\x78\x49\x56\xe0\x11\x9d\x32\xcc\x6b\xab\xc3\x0c\xbe\xc2\x5f\x82\x72\x98\xe4\x02\x26\x59\x66\x7d\x32\x2e\x23\xaf\x06\xd8\x02\xee\x34\xce\x41\xfa\x0c\x4f\xd7\x8b\x97\x22\xb2\x78\x19\x69\x84\x3c\x37\x08\x61\xdc\xab\x46\xbc\x93\x34\x41\xe9\x43\x0d\x4f\xb3\x6d\xc6\xe7\x66\x97\x88\x6d\xc5\x6b\xa1\xc4\x0b\x65\x26\x79\x61\x72\x61\x8e\xc1\xd1\xb9\x99\xae\xbd\xd5\x16\xf9\x5c\x8a\xa5\x19\xd7\x5d\xfd\x7c\x2b\x1d\xcf\xfb\x35\xab\xc5\xff\x80\xdb\x3e\xfa\x36\x35\xe8\x50\x37\x89\xd9\xab\xad\x58\x08\x76\x1e\xa8\x5d\xf3\xbb\xc2\xec\x82\x9e\xc9\x2b\xbb\x08\xae\x52\xd9\x36\xe9\x25\x97\xec\xce\x2c\xe3\x2b\xe5\xe4\xab\xa4\xe4\xba\x19\x59\xed\x41\xeb\x64\x34\xea\x9e\x1f\x9b\x4e\xa2\xcf\x45\xee\x9b\xd4\x33\x6a\x9a\xd6\xd8\xa1\x16\x01\x92\x65\xe0\xd9\xef\xe6\x68\xb6\xcc\x8a\x1e\xf5\x7a\x2d\x12\x54\x79\x0e\x30\x03\x9a\xe4\x4b\x7d\xc8\xcc\xee\xd7\x76\x59\xc6\x9b\x3e\xf0\x61\xfa\xb0\xac\xdf\xbc\xc7\x3e\x3c\x2e\xaa\xb5\xc9\xb8\x3f\x7f\xe4\x99\xd1\x95\x45\xcc\xa3\xd9\xcc\x1e\x30\xf7\xa4\x80\x0d\x11\xfd\xb9\x8c\xab\xad\x43\x3f\xe7\x93\x6a\xa8\xb8\x6a\xdc\x3b\x03\xba\x47\xb1\x6e\xbc\x5b\x75\xaa\x68\xef\xf1\x8f\x23\xd0\x3f\xb7\x23\xb1\x6d\xd9\x86\x78\xee\x99\x5f\x62\xde\x96\x1d\xf5\xc8\xde\xb1\x54\x30\x47\x41\x15\xcd\x26\x76\x1a\xd6\xd2\x58\x17\xbc\x70\x50\x2d\x61\x1e\xe1\x4e\x55\xad\x36\x4f\xb5\x63\x9b\x60\x3a\x05\xeb\xa3\x22\xce\x7c\xbb\x50\x55\x77\xf4\x94\xd4\x70\xa0\x6b\x65\xe4\x91\xd7\x33\xa0\xc9\xe5\x5c\x25\xec\x69\x6c\x02\xa1\x61\xe8\xc8\xbe\x31\xb6\x3a\x4f\xf0\xdc\xd6\x91\xb3\xcc\x7f\x04\xee\x7a\xfe\x84\xd1\x10\x8b\x38\xb4\x47\xaf\x5f\x96\x68\xb5\x5a\xcc\xac\xde\xd3\x42\x94\x8e\x19\x18\xe4\x83\x68\x6c\x69\x89\xba\xeb\xf0\x85\xd8\xc3\x44\xe1\x04\xa4\x05\x38\xa7\x6c\x5c\x1c\x3d\xe6\x37\x0e\x4f\x48\x68\x96\x7b\xaf\xd6\x8c\x19\x04\xd4\xe1\x30\x1b\xa0\x97\x4f\x30\x71\xd2\xcb\x12\x97\x42\x9f\x67\x84\x31\x58\x49\xfc\x42\xc5\x5a\xb1\xcb\xd2\x67\x34\xc1\xa5\xb2\x9d\xa7\x16\xd3\xdf\x7c\x82\xc4\x15\x23\x61\xd9\x70\x86\x62\xb9\x62\xa8\xdb\x40\x38\xa7\xc9\x99\x69\x5d\x57\x44\x29\x8c\xb4\x1c\xca\x9b\x4b\xec\xd8\xba\x9a\x5e\xb4\x1d\x38\xa5\x7f\xff\xa1\x4f\x1a\x47\xff\x1d\x6a\x01\x94\x2f\x2c\x73\x72\x6c\x14\xc6\xae\xa0\x62\x82\xd1\xbb\x88\xc2\x6d\x5b\xe0\x2d\x17\xbf\x3a\xc1\xdd\xb3\xa3\xd7\x46\x70\xbb\xf5\xb9\x76\x82\xdb\xad\xd6\xfc\x37\xf0\xaf\x09\xf8\xbb\x53\xec\x3d\x3b\x78\x23\x49\xa2\x16\x71\x39\x14\x13\x37\x98\x62\xef\x49\x2d\xbf\x83\xe6\x86\x9e\x16\x7b\x92\xfc\x7d\xdb\x7c\x3d\x24\xff\x0f\x3f\x3f\xae\x46\xf2\xbb\xd5\xfd\x1d\x21\xd7\x11\x21\x7b\x22\xfd\x46\x9e\x20\x65\x61\xd5\xd3\xed\x55\x24\x4e\x84\x1a\x0f\x10\x4b\xb1\xdc\x44\xe2\x9c\x9f\xa1\x44\xd8\xc0\xe4\xc0\xc1\x76\x04\xcd\xb4\xa4\xa7\x1f\x57\xac\xcc\xb6\xa4\x4c\xab\x5f\xab\xac\xb1\x2c\x5c\xc5\x6b\xf7\xd9\xa2\x30\x81\x36\xfb\xdd\xb6\x83\xaf\x19\xab\x8c\x1e\x1c\xfa\xa3\x4c\x2e\x88\x0d\x37\xc3\xe2\x26\xa0\xb6\xe5\x9f\xb6\xa5\x9f\x5a\xce\xbf\x22\xf7\x34\x4c\x3d\x0d\x33\x4f\xad\xc6\xd7\xed\x14\xcb\x20\x3d\xda\x40\x3b\xd5\x4d\x72\x4c\xd9\xc4\x3a\xd5\xf6\xa6\xcb\x39\x6d\x41\x39\xe5\x19\xbf\xf9\x73\x2d\x9c\x62\x2c\x24\x6a\xac\x4e\x86\xc9\x9b\x7c\xb6\x23\x39\x69\x4f\x3b\x13\xa2\x21\x59\x3c\x51\x66\x93\x59\x49\x64\xdd\x6a\x10\x59\x3d\xac\x13\xa7\xcc\x05\x84\xd6\x7c\x17\x8f\xd2\x27\x33\xad\x93\xdb\x27\x98\x9c\x84\x84\x73\x94\x1d\x82\x9b\x53\x36\xb3\x5c\x7f\x97\x83\x19\xe9\xdc\x4a\xf9\x1a\x2b\xde\x7d\x98\x41\x31\x76\xe4\x23\xb7\x32\xb6\x4d\xb2\x7c\xa7\xfb\x2f\xe3\x46\x27\xda\xe4\x60\x8e\xfb\xf6\xfa\xa8\x85\x1e\x93\xd2\x8b\x7b\x01\xf9\x21\x0f\x94\x07\x95\x0c\xd5\x2a\x28\xcc\x04\xb7\x13\xbc\xb5\xae\x1d\x57\xc2\x64\x28\x53\x45\x10\x49\x95\xe0\x7e\x0e\x5c\x5d\x4c\x0a\x54\xc6\xe0\x4f\x88\x2b\x10\x32\x42\x39\xdb\xf6\x74\xfe\x46\x5c\x46\xbf\x67\xf6\xab\x45\xf7\x63\x30\x7a\xb5\xf8\x56\x45\xe8\xce\xe4\x45\xaf\x46\xf3\x9f\x1b\x31\xbb\x93\x00\xfd\x9e\xf8\x11\x55\xdc\x1e\xed\xcc\x06\x30\x6d\x97\x60\x7e\x68\xd7\xdf\x1f\x84\x3f\x39\xda\xbe\x4f\xf7\x3c\xe0\xae\xef\x97\xb4\x76\x03\xd9\x37\xcd\x58\x57\x6b\x97\x7b\x35\xfd\x89\xa1\xb5\x3f\x44\x6e\x4a\xce\xea\xbb\xd3\xb6\xa9\xf7\x6c\x5d\x9e\xba\x41\xad\xa8\x09\xe6\x8d\x37\x12\x80\x72\xf0\x6e\xab\x99\xb9\xc4\x55\xdd\x94\xaa\x0b\xf7\xa2\x81\x95\x7d\x60\xc8\xbd\xa2\x00\xf7\xe1\xa1\x0f\x0f\x66\xfe\x78\x34\xdb\xa9\x2b\xdc\xf4\x63\xa5\x15\x55\xfe\x20\x9a\x3f\xb7\xae\x35\xd4\xdb\x8b\xdf\x6d\xe5\x0e\x6d\xe5\xee\x5d\xe5\x4d\x6b\x2a\x77\xfa\xe5\x7b\xfb\x06\x6d\xe8\x86\x6a\xd9\xbb\xdd\x6a\xff\x7e\xbe\xb1\x51\xeb\x5c\xef\x68\x1c\x92\xc7\xe2\x5c\x3d\x89\x63\x0c\x13\x8c\xb2\xec\x63\xa3\x14\x2a\x6f\x9f\xbe\x35\x1c\xd1\xce\xb7\x4f\xdf\x9d\xd1\x04\x19\x55\x89\xe7\xba\x36\xe9\xba\x95\x5a\xed\x90\xf3\x77\xfa\xef\xd9\xcb\x37\x91\xd3\xba\x9f\x52\xbb\x20\x60\xa2\x7e\xd3\xa6\xeb\xef\xd4\x07\x49\xb7\xec\xe2\xf3\xed\xd5\xae\x90\xb4\xb7\x2f\x67\x5c\x4b\xd3\x09\xb0\x47\x56\xd1\xe5\x33\x0e\xff\x81\x07\x70\xe7\x0e\x50\xf8\x37\x30\x7e\xef\x81\x95\xd9\x33\xef\x3d\xfd\x60\xae\xd0\xb9\x3f\xea\xf9\x1f\xca\x3b\x8a\xfd\x3d\x7f\xdf\xfc\x47\xa5\x80\x53\x89\xe4\x53\xb1\xb1\x8e\x5b\x17\x3d\xfb\x6b\x8e\xfb\xbd\xf7\xb8\xaf\x4a\xa8\x0e\xea\xf7\x1f\x86\xca\xbe\xbd\x6e\xb6\xd4\x36\x2f\x73\xc3\x61\x28\x76\xd3\x4d\xb7\x1f\x0d\x40\x8b\x03\x2d\x47\x4d\xf5\xe8\xbe\xe5\x79\xab\xc8\x43\x87\x9f\xd7\x84\x79\x8d\xdb\x7a\xd5\xe4\x99\xeb\x2a\xe7\x10\x12\x07\xcc\xd8\x88\xc6\x81\xb9\x39\x22\x87\x06\x34\x51\x39\x9c\x09\x87\xe4\xf4\xa0\x33\x6b\xde\xce\x4f\xd3\x7b\x70\xff\x2e\xd0\x18\xea\xe0\xbc\x7b\xbf\xba\xb9\x7f\x0f\x3a\x43\x79\x71\x57\xa8\xb8\xe1\xfa\x09\x2f\xcb\x39\xdd\x19\x35\x68\x95\x27\x8f\x1d\xed\x14\x5f\xff\xcf\x6b\x3b\xee\xef\x00\x00\x00\xff\xff\xf2\x75\xc7\x5f\x9b\x3d\x00\x00")
+var _templates12_relationship_to_many_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x5f\x73\xdb\xb6\xb2\x7f\x96\x3e\xc5\x56\xa3\xe6\x52\xb9\x0a\x7d\xd3\x47\xdf\xeb\xdb\xc9\x49\x1c\xd7\xa7\x6d\x8e\x6a\x3b\x93\x87\x4e\xa6\x03\x53\xa0\x8d\x06\x22\x14\x80\xf2\x9f\x61\xf8\xdd\xcf\x2c\x08\x92\x20\x05\x50\x94\x6c\xd7\xce\x69\x1e\x32\x91\x48\x60\xb1\x58\xfc\x76\xb1\xf8\x2d\xe4\x2c\x7b\x01\x2c\x06\x21\x21\x3c\x23\xe7\x9c\x86\xc7\xea\x9f\x82\x25\xfa\x73\xfd\xe8\x84\x92\xf9\xbf\x12\x7e\x0b\x2f\xf2\x7c\x88\x5d\x28\x57\x54\x7f\x19\xe0\xb7\x71\xaa\x9b\xef\x1f\x98\x1e\xf5\x1b\x49\x92\x0b\x0a\x63\x49\x79\xfd\x36\x3c\x13\xbf\x92\xe4\xf6\x84\x72\x92\x32\x91\xa8\x4b\xb6\x54\x75\x8f\xbd\xe7\xa0\x68\x2a\x96\x0a\x04\x0e\x78\xce\x92\x39\x28\x96\x5c\x70\x0a\x91\xe0\xab\x45\x02\x9f\xe8\xad\x82\xf4\x52\x8a\xd5\xc5\x25\xfc\x29\x58\x02\x7a\x78\x35\x05\x92\xcc\x8b\x5e\xd7\x92\xa5\x14\x52\xa1\x3f\xe0\x4b\xd3\x04\x9e\xef\xd5\x23\xb1\x58\x77\x08\x12\x91\x42\x80\x9f\x50\xcd\xf0\x58\xbd\x16\x8b\xa5\x50\x28\x40\x3f\x38\x13\x95\x41\x26\x13\xd3\x7a\x1c\x56\x16\xd1\x6d\xde\x0a\x49\xd9\x85\xb1\x9a\x7e\x62\xf7\xd1\x23\x16\x86\xe2\x95\xa5\xc6\xe1\x2b\xce\x88\xa2\xca\x98\x4c\xf7\xb2\xac\x57\xb4\x8f\xbb\xdb\x37\xc6\xb5\xba\x49\xca\xb5\xf4\x66\xc7\xb6\xd5\x1d\x32\xf4\x93\x77\x64\xd1\x9e\x45\xfd\xf5\x17\x11\x11\xfe\xf6\x67\x7a\xab\x5b\x59\x63\x46\x42\xaf\xb1\x99\x62\xf8\xba\x58\x2c\xdd\xcf\x7c\xb6\x1a\xc7\x65\xeb\x78\xbd\xb5\x51\x68\xbd\xd3\x4a\x51\x35\x93\x6c\xc1\x52\x76\x45\x15\x0e\xd6\x7a\x32\x2e\x6c\xa3\xcc\xc2\xd5\x8a\x3b\xc4\x5b\xd3\xf2\x0e\xa8\xa2\x4b\xba\x20\x8d\x0e\xfb\x07\x8d\x3e\x85\x94\x2f\x30\x0e\x4f\x75\xdb\xe2\x7b\x2d\x21\xd6\x0f\x8e\x93\x58\xa0\xba\x17\x34\x35\xc3\x36\x14\x6d\xc8\xb2\x86\x8f\x8b\xe7\xb3\x9f\xe9\xed\x6b\xc1\xf5\x84\x2d\x81\x21\x3e\x37\x33\x53\xa0\xfd\x92\xc5\x08\x93\xf9\xfc\x88\x8b\x73\xc2\xb5\xe9\xf6\xf6\xe0\xd5\x7c\x9e\x65\x15\x24\x42\xbd\x80\x79\x7e\x04\x64\x3e\x47\x3f\xa2\x70\xc1\xae\x68\x02\x12\xfd\x91\xce\x41\x9c\xff\x49\xa3\x54\xa1\xf7\xe0\x4b\x7a\xc3\x54\xca\x92\x0b\x90\x16\x72\xd4\x70\x6f\x0f\x44\xac\x7b\x67\x59\xe1\xfe\xa1\x06\xc4\x17\xed\xac\x2b\x4e\x64\x9e\x4f\x41\x2c\x11\x6b\x84\xf3\x5b\x60\x89\xa2\x52\x0b\x4a\x2f\xe9\x02\x88\x82\x84\x5e\x83\xa4\x91\x90\x73\x15\xa2\xbc\x57\xcb\x25\x4d\xe6\xaa\x52\x24\x15\x20\xc2\x93\xd0\xa1\xbb\x6e\x7e\x4a\xd3\xaa\x6d\xab\x99\x31\x68\x9e\x03\x59\x2e\xa5\x58\x4a\x46\x52\xca\x6f\x75\xb7\xf7\x8a\x9a\x59\x17\x46\x9a\x93\x94\x9c\x13\x45\xe1\x92\x24\x73\x4e\xc3\x61\xbc\x4a\x22\x08\x04\x3c\xcf\xb2\x12\xcb\xef\x97\xa7\xd5\xa4\x26\x3e\x7b\x06\x59\xc6\x62\xc0\xf0\x30\x0e\xdf\x89\xd7\x22\x49\xe9\x4d\x9a\xe7\x51\x7a\x03\x51\xf1\x25\x34\x0f\xa7\x90\x65\x34\x99\xe3\xfa\x18\xb3\xc0\xb9\x10\x7c\x5a\xcd\x3c\x0c\x43\x1c\x3d\x76\x8d\x4e\xa5\x14\x12\xb2\xe1\x40\xd2\x74\x25\x13\x10\xa1\x5b\x9f\xc0\xc0\xc1\x52\xe5\x5c\x30\x1e\x1e\xd1\xf4\xcd\x3f\x82\x49\x96\x61\x08\xd7\xea\x4d\xa1\x7c\x61\x5a\x9a\xf7\xc9\x1c\x97\xb0\x50\xb0\xd2\x2d\x0c\xc3\xc9\x30\x1f\x0e\xab\x19\x0c\x2d\xdc\xcd\x48\xc2\xa2\x6e\xd8\xcd\xfe\xa6\xb0\xd3\xa6\xc1\x3d\xad\x58\xc0\x9d\x61\x36\x73\xac\x2b\xbd\xa1\x51\xb1\x86\x87\x37\x34\x5a\xa5\x42\x5a\xab\xbb\x0e\xbe\xba\xb9\x79\x64\xf5\xb2\xd7\x7c\x0b\x50\x66\xc3\x01\x8b\x71\x66\x18\xa3\xba\x11\xe9\x72\x10\xdb\x21\x50\x3b\x27\xea\xfe\x57\xcb\xff\xee\x00\x12\xc6\x11\xff\x83\x25\x9a\x34\xd0\xf3\xfe\x20\xc9\xf2\x50\xca\x80\x4a\x39\x99\x0c\x07\xb9\x0b\xa1\x7a\x87\xb7\xa2\x63\x5f\xc4\x1e\xcd\xbe\x45\x4a\x57\xa4\xd4\xc9\xd3\xf2\x9e\x60\x7d\x34\xf3\xa3\xe3\x5e\xc3\xe7\x16\x48\x7d\x90\xd8\x79\x07\x14\x7b\x11\xfa\x77\xc4\xa7\x49\xe0\xdb\x29\x7b\xe1\xc8\x87\x57\x54\xde\x96\xc7\x05\x33\xb9\x4f\xf4\x16\x98\xc2\xb3\x05\xb0\x04\x44\x42\x21\x95\x24\x51\x24\xc2\x79\x99\xa3\x03\x2c\x56\x2a\x85\x4b\x72\x45\x81\x70\x0e\x22\x46\x61\xda\x09\x97\x62\xb9\xd2\x46\x2d\x46\xd6\xeb\xbb\x2b\xd6\x9f\x64\x04\xaf\xd2\x8a\x2c\x73\x98\x55\x47\x48\x74\x9c\xcf\x2b\x2a\x19\x55\xe1\x4f\x44\xbd\x63\x3c\xc8\x32\x73\xc0\x63\x53\x18\x47\x55\x72\x6c\x92\xd2\x3c\x2f\x84\x31\xd4\xc8\xa8\x26\xc2\xda\x58\x65\x56\x1e\x61\x43\xfd\x76\xa2\x5d\xc2\x64\x36\x5a\x25\x15\xbe\xa3\xd7\xc1\x28\xcb\xc6\xe1\xec\xd3\x05\x06\xce\x3c\xdf\x87\x55\x82\x02\x10\x57\x9c\x25\x9f\x30\xb0\x3a\x72\xf2\x1a\xbe\xd8\xd0\xb4\x59\x7f\x39\x05\xd2\x82\x8a\xb7\x69\x09\xa2\x64\xc5\xf9\x48\x7b\x68\x95\x86\xe9\xc5\x3b\x4e\xce\x6e\xdc\x61\xcc\x7c\x30\xd3\xdc\x66\x27\x44\x90\x05\xf5\x82\xf7\x11\x5f\xa2\xc1\x5a\xd5\x3a\x5b\x24\x1d\x98\xdc\x79\x6f\x1e\x0e\x72\x9d\x15\x6e\xf4\x88\xae\xd1\x1b\x23\xd7\x33\x7e\x02\x1e\x61\xe6\x3f\x1c\x5c\x11\x89\x36\xc5\x7f\x42\x0e\x07\xb1\x90\xf0\x87\x96\x86\xd0\x2f\x5c\xa1\x14\x8d\x46\x67\x71\x39\x2c\x7e\x1b\x54\x36\xb6\x39\x85\xc2\xb5\x06\x83\x2e\xc7\xd3\x6f\x9d\x9e\x66\x20\x5f\x9e\x02\xeb\xd6\x2f\x60\xcc\xb5\x43\xb2\x64\x4e\x6f\x1a\x6e\x09\x63\x66\x35\x64\x71\xaf\x53\x34\x8f\x1c\x67\xd5\x71\x54\x0a\xc2\x57\xb5\x21\x2d\xc7\x86\x03\x70\xb9\x3c\x8f\x2a\x05\x6a\x2a\x69\x30\x28\xc3\xcb\x2b\xa5\xd8\x45\x12\x3c\xf3\x8a\x9d\x7a\xa5\x4e\x4a\xb1\xe5\x9a\xb9\xbe\xe1\x90\x18\x99\x5a\x33\x6f\x4d\x26\x12\xbc\x9e\x80\xfe\x62\xf7\xdf\xa0\xb2\x6e\x6f\xd4\xd4\x9f\x1d\x8a\xd5\x5f\xf0\x9d\xc9\x4b\x0e\x10\x4f\xe1\xb1\xc6\xcd\x36\x6e\x69\x22\x50\x4c\x65\x30\x59\x4f\x33\xda\x51\x15\x73\x0d\x4c\x97\xa7\x30\x8a\x09\xe3\xc5\x26\x6d\xc0\xca\x92\x54\x80\x21\x1f\x0a\xe2\x0c\x83\xdd\x60\x80\x3a\xe7\x4e\x10\xe7\x39\xe8\x65\xcc\x86\x9b\x80\x1c\x19\x22\xe3\xf7\x8f\x2a\x95\x2c\xb9\xc8\xc0\xa2\x09\xbb\xb0\xed\xda\x4c\x70\x5b\x88\xf4\x7f\xa5\x29\x50\xc5\xc1\x6a\x39\x27\x29\xfd\x6d\x85\x49\xc0\xfe\x01\xc4\x8b\x34\x3c\x5d\x4a\x96\xa4\x71\x80\xaf\x07\xa3\xf7\xb3\x37\xaf\xce\x0e\x71\xdb\x58\x67\x78\xf2\x1c\x4e\x0f\xcf\xe0\x7b\x05\x1f\x7e\x3a\x3c\x39\x84\xef\xd5\x68\xaa\x7b\xa9\x54\x2e\x08\xf2\x90\xe1\x29\x4d\x67\x44\x92\x05\xee\x46\x2a\x40\x25\xc2\x5f\x7e\xcb\xf3\xd1\x14\xf4\xe7\x93\xe2\xb3\x89\x69\x6f\x18\xe1\x34\x4a\xc3\xf7\x8a\x1e\xa3\x2b\xce\x38\x89\xe8\xa5\xe0\x73\x2a\x55\x9e\xbf\x2c\xa3\xda\xff\x54\x81\x0a\x2d\x34\x69\x0f\xf9\xe1\x92\x4a\xfa\x9a\x93\x95\xa2\x77\x1b\x90\xd3\x24\xd0\x23\xfc\xb7\x63\xe8\xda\xd5\xde\x88\xeb\xa4\x0e\x86\xe8\x22\x44\xde\x16\x3c\x14\x2e\x46\xa1\x9f\x06\xc5\x15\xe1\x2b\x6a\x96\x94\x25\x29\x95\x31\x89\x68\x96\x37\xd6\xb5\x5e\xd3\x6a\x31\x5d\xee\x1b\x59\x4b\x5b\xba\x51\x8b\x02\xfb\x02\x05\x6c\x7e\x25\x4b\x08\x08\xee\x21\xfa\xb1\x51\x7b\x02\x5f\x0a\x42\x78\xa4\x83\x72\x38\x42\xd0\x8c\xf2\x51\xe9\x6d\x36\x6f\xfd\xb5\xc3\xa4\x72\xa0\x6c\x94\x8d\xb4\x0f\x34\x1d\x46\x3f\xd2\x73\x7f\x38\x30\xfd\xf0\xb0\x10\xaa\x63\xe7\x14\x1e\x0a\x0f\xa8\xf5\xb0\x8e\x5a\x75\x94\x2d\x51\xc2\xe2\x22\x0d\x79\x43\xcf\x57\x17\xbf\x8a\x39\x35\xe1\x14\xa3\xca\x5b\x0d\x17\x9e\x04\x75\x8b\x0f\x58\x79\x90\x53\xb0\xc0\x35\xe9\xd3\xbe\xb0\x41\x15\x66\x5b\x9b\x4c\xa9\xc4\xb1\xd2\x9d\x82\x28\xbd\x99\x68\x3d\xb0\xbe\x41\xf5\x59\xb6\x2d\xf2\xad\x14\x0b\xdd\x6e\x7d\xf4\xeb\x5e\x3a\x5e\xfb\x35\xb3\x36\xad\x0e\xb3\xfd\x31\x35\xfb\x19\xee\x4f\xfa\x60\x13\x58\x23\x96\x82\x9d\x87\xe2\xf5\xe9\x57\xc2\xaa\x9c\xd0\x0c\x88\x73\x9c\x9a\x2d\x70\x1b\xf1\x66\x0e\x3d\x77\xc6\x42\xb2\x7b\x53\xdc\xb8\xe7\x6d\x95\xbb\xf5\x4d\xdd\x1e\x3b\x73\xeb\x97\x05\x6d\x9b\xb8\x35\x16\xa6\xfd\xe5\x2e\x59\xdb\x5d\x92\x36\x5b\x8f\xdc\xfa\x82\x4b\xaf\x97\x61\x3d\x9f\xdf\x74\x32\xf8\x5c\xee\x3a\x23\x3b\xe7\xca\x32\xab\xe2\xd6\x2a\x2a\xe5\x39\x04\xe6\xbd\x3e\xb3\x19\xd3\x61\xab\xdf\x56\x22\xa5\x0a\xa3\xa4\x69\xd0\x40\x56\xa3\xc9\xc4\xb8\x45\xbf\xf8\x1e\x8c\x5f\x4e\x61\xfc\x43\x45\x78\x05\x3f\x4e\xe1\xc7\x92\xde\x1a\x0d\xfd\x91\xbb\xd8\x93\x5c\xf1\x5b\x5b\x15\x0d\xe7\x0b\xb8\x9e\x78\xdb\x08\x4d\xed\x58\x37\x85\xcf\x55\x10\xeb\x1d\x67\xf3\x61\x0b\x15\x77\x0d\xb2\xce\xe8\xe9\x51\xec\xda\xa7\x8e\xc1\x96\xdf\x3e\x8e\xa8\xfa\xb9\x1d\xf0\xda\x33\xeb\x15\x3c\x3d\x52\x4a\xb0\x97\xc7\x13\x3b\x8c\x6e\x79\xa4\xa8\x6b\xf5\x23\x33\xe3\xdc\x3e\x5d\x6b\x66\x56\x84\x27\x70\x50\x0f\xa1\xbf\xc2\xb3\x3a\x0c\x35\xb3\x8a\x13\x13\xcd\xd7\x18\x85\xfd\xd2\xdb\xa6\x66\xa0\xfa\x7c\xe2\x61\x22\xe1\x00\x29\x46\x9a\xcc\x03\x4f\x83\x36\xe1\xb1\xbb\xf3\xb3\x18\xdf\x35\x27\x3a\x30\x4f\xe0\x59\x1d\x36\x5d\x73\x6d\x4c\xd6\xf8\x39\x32\x62\x6e\xc2\xe5\x94\xb3\x88\x96\xde\x68\x52\x9f\x69\xb9\x93\xdb\x67\x36\x3d\x7a\xe8\x94\x5d\x1b\xa6\xa3\xd1\x14\x44\x63\x49\xb9\x7a\x44\x5b\x88\x1d\xa6\x28\x9c\x80\x34\x00\x4f\x18\x1f\x96\xfb\xbc\xa6\x9d\x03\xbc\x2f\x63\xba\x17\x81\xf8\xdd\x8a\x73\x73\x67\xa6\x86\xc3\xa4\xa3\x2a\x7f\x4a\x53\x07\xc8\x8e\x40\xd2\x85\xc0\xbd\x1c\x09\xe8\xa5\xa4\x57\x4c\xac\x14\xbf\xad\x6c\xc6\x52\xba\x50\x86\xa8\x44\x76\xda\xcf\xd9\x83\xa4\x4b\x4e\xa2\x8a\xa7\x8f\xc4\x62\xc9\x29\xb2\xe7\x70\xcd\xd2\x4b\xcd\x74\x2e\x89\x52\x74\x8e\x72\x58\x5d\x36\xd0\x43\x6c\xc9\xf8\x6b\x0a\x5f\xf8\xec\xfb\x5f\x0a\x1c\x73\x05\x12\x61\x49\x0b\xef\xf6\x14\x05\xa7\x13\xad\x30\x5d\x17\x54\x76\xd0\x7a\x1b\x35\xeb\x61\xcb\x07\x77\x1b\xfc\xee\xf7\x02\x3c\x2b\xea\xe7\x70\xd6\x89\xfd\x0a\x7b\x77\xa0\x2b\x0d\x66\x45\xe8\xd6\xe7\x01\x6b\x5b\xfd\xee\x05\xb8\xd5\x9a\x7d\x03\xfe\x23\x01\x7f\xfb\x9b\x09\x9e\x15\xfc\x1a\x6e\x26\xb8\x55\xbf\x63\xf5\xe3\x0e\x35\xdd\x9e\x37\x13\xdc\x6a\x1f\xcd\xbe\xed\x16\x4f\x73\xb7\xd8\xf1\x6e\x84\x6f\x99\x1f\xe7\x6e\x84\x5b\x9b\x07\xdc\x3f\xee\xe0\x47\x5e\x1f\xf9\xe6\x21\x8f\xe1\x21\x26\x4d\x6e\x13\x62\x45\x02\x70\x76\x49\xfd\x2b\x41\x24\x85\x88\x53\x22\xd1\x70\x09\xda\x07\x14\x59\xb4\x2e\x6b\xa4\x97\x94\x49\x14\x95\x94\x59\x37\x16\xe6\x23\xc3\x91\xe1\x15\x8f\x54\xe8\x77\x5b\xdd\xd6\xe8\x8d\xf7\x27\x55\x9b\xf6\xf2\x8e\x5f\xdb\xc5\x04\xd5\x61\xfe\x9d\xb7\xe6\xde\x17\x13\xba\x46\x6f\x8c\x5c\xcf\xf8\x09\x2c\xbe\x99\xbf\x97\x89\xa8\x69\xc6\x39\xc5\x58\x05\xb1\x14\x8b\x4d\x34\xe3\x35\x96\x87\x60\x03\xd7\x08\x07\xfd\x28\xc4\x71\x55\xba\xfa\xd1\xcc\x78\x34\xec\x4d\x1b\xd6\x5c\x82\x17\xe5\xe8\xdf\x63\x45\x97\x28\x6c\x34\x6a\xcd\xba\x55\xd2\x1b\x19\x16\xbf\x2e\x95\xf9\x4c\x80\x21\x24\xcb\xd6\xaa\x97\x4d\xc6\xde\x54\xa4\x03\xfb\x4e\xfe\x11\x4d\x2b\xb2\x7b\x12\x96\xa4\x00\xb6\x44\x25\xf5\xff\x51\xdb\x8e\x18\xa8\x8a\xf7\x70\x00\x58\x86\xab\xae\x43\x99\xff\xcc\x8a\x98\x12\xe3\x03\xd4\xf1\xba\xca\x8b\xf7\x5c\x9f\xc7\x62\xe4\x64\xd8\xab\x6a\xdc\x1c\xad\x63\x98\xae\x3b\x65\xe5\xad\x00\x9b\x93\xaa\xbd\x62\x4b\x40\x34\xa7\xec\x5a\xc7\xda\x79\x3a\x9b\xfe\x55\xae\xd3\x08\x0f\x56\x18\xab\xee\xf3\x39\xa8\xf6\x06\x4f\xdd\x26\xbc\x2d\x42\xbb\x2f\xd1\xde\x32\xfe\x1d\x59\x76\x27\x8b\xee\xd6\x69\x8d\x63\x6f\x91\x7b\x6e\xa3\x18\xae\x7c\x7f\x03\xc1\x6e\x4f\xa9\xee\x52\xed\x0b\xfd\xf8\x75\x6b\x85\x58\xdc\x4e\x7e\x7b\x90\xeb\x45\x6e\xdb\xbc\xcf\x0b\xe7\x14\xab\x94\x88\xd8\x51\x37\x4d\x5d\xf4\x76\xec\x7a\x58\x3a\xb6\x1f\x1b\x28\x1b\x3a\x39\x10\xd5\x36\x35\xa9\x28\x7b\x4b\x6f\x5f\xb2\xa9\x5b\xb8\xe0\xd0\xea\xef\x62\x8c\x7d\x32\x0d\xfd\xdf\xa7\xf6\xea\x8b\x5b\x75\x43\xbc\xad\xa7\x66\x42\x7b\xd5\xd9\xed\x92\x76\x45\x76\xfd\xde\xf4\x2c\x4b\x5b\x6b\x01\xa8\x9c\x72\x55\x8d\xe9\xb7\x5b\xb4\x8b\x94\xa7\x34\x3d\x8d\x48\x92\x50\xd9\x59\x57\x4d\x18\xef\x57\x42\xdd\x7e\xc2\x6b\x56\x73\x1b\xa0\xac\xbc\x36\x27\xdd\x63\x36\xa6\x46\xb8\x79\x06\xce\x6a\xc1\x00\x93\x2e\x96\xac\x68\x5d\x8e\xef\xe6\xfa\x0b\xfd\xf2\x61\x5f\xb0\x9a\xf1\x2d\x6a\xb5\xeb\x62\xf7\xee\xb9\x6a\x3e\xf4\x56\x0b\x4e\x7c\xbe\x7a\xd4\xf2\x7e\x9d\xe0\x95\x17\xff\x8b\xe3\x28\xb0\xc4\x1c\x07\x51\x86\x6a\x1d\xb8\x74\x07\xb7\x11\x02\x2c\xb4\xc3\xb2\xf0\x08\xcd\x08\x11\xc9\x14\xde\x96\xd7\xa1\x83\x70\x98\x0b\xaa\xf4\x99\xe2\x13\xa5\x4b\x10\x72\x4e\xe5\xa4\xef\x39\xf2\x9e\x58\x77\xbf\x65\xfc\xcb\xd1\xc5\x9a\x6c\x95\x81\x57\x80\xf0\x6a\x71\x5f\x74\xc9\x1a\x4e\x9a\x14\xe2\x3a\x65\xe8\xd5\x68\xf6\x75\x23\x66\x7b\xba\xda\x6f\x89\xbf\xe2\x78\xd7\x07\x4f\x2d\xe2\xcd\xab\xf0\x36\x01\xe6\x7e\x78\xb5\x9e\xfc\xb4\x57\xe3\xa3\xd9\x7f\x74\x7c\xda\x91\xe7\xed\x30\xd7\xc3\x05\xad\xed\x40\x76\xaf\x11\xeb\x6e\xc4\xae\x57\xd3\xaf\x18\x5a\x1b\x09\x52\x27\xb3\x29\x62\xa0\xfa\xa7\x6d\xf6\x3c\xda\x94\x69\xeb\xa7\x6d\x5b\x51\xa0\xdb\x80\xe2\x51\x22\xa5\xc5\x7d\x79\x93\xfe\xaf\x8d\xf8\xf4\x9e\xc3\xb6\x51\x6a\x17\xce\x73\xc3\xc0\x8d\x41\xeb\xf9\x3e\xce\x6a\xfb\x7e\x82\xb5\x89\xf8\x6c\x53\x80\x4f\x87\x07\xd5\x7b\x87\x91\x6e\x22\x83\x4b\x3e\x4b\x20\xf8\x5e\x4d\x5a\xd4\x9f\x2d\x3c\x98\x77\x8c\x3c\x05\xfc\xc5\x87\x31\xf6\x64\x0a\x3f\x4c\xe1\xe5\x06\x0e\xce\x41\x25\x6d\xba\xc5\x65\x44\x55\x37\xc5\x8a\xef\xad\x5b\x9f\x36\x1b\x61\x61\xab\xe2\x01\xbe\x71\x51\x1e\x2e\x6a\x7b\x2a\xea\x69\x32\x51\x0d\x4d\x37\x41\xaa\x8b\xd5\xe9\x4b\xea\xec\xcc\xe9\x78\x69\x96\x92\x18\xd8\x89\xd1\xd9\x8d\xd0\xb1\x96\xa6\xfd\xb9\x32\xe6\xfd\x91\x39\x6e\x2e\xa7\x31\x56\x7f\x26\xc7\xaf\xba\xd9\xd7\xd6\x03\x77\x4d\xf1\x58\x00\xec\xc9\xe7\x38\x06\xf1\x02\x68\xbd\x6a\x84\x23\x37\x36\xdc\x13\x71\xad\x5e\xc5\x31\x8d\x52\x3a\xcf\xf3\x3f\x1a\x7b\x6e\xf5\x3b\xcd\xf7\xba\x82\xb0\xcd\x4e\xad\x3d\xf3\xc3\x25\x4b\x29\x67\x2a\x0d\x36\x62\xd9\x54\x3c\xb6\x2a\x33\xd5\x85\x97\x8e\xb2\x92\xe3\x57\xa2\x8d\x75\x7e\x1c\x7b\xb8\x7e\xc8\xe6\xd6\xb4\x5a\x69\x2b\x40\x39\x6f\xf1\x3e\x24\xff\x6d\xe9\x61\xa8\xec\x83\xb5\xc0\x69\xa8\xc4\xcd\x31\x0f\xdf\xb3\x29\x48\xd6\x93\xf9\x2e\x7c\x05\x43\xb5\x64\x5e\x2e\x94\x27\x28\x0d\xb3\x00\x8f\xac\x89\x11\xc5\x13\xf8\x7f\x78\x09\xcf\x9e\x01\x83\xff\x03\x9e\xbc\x78\x69\x64\x7a\xfa\xfd\xce\x3e\xe2\xc5\x69\xcf\x4b\xec\xff\xb1\xbc\x87\xdd\xc1\xb3\xfa\xfa\xef\x57\x02\xce\x25\x25\x9f\xca\x85\x75\xdc\xc9\xf6\xe4\x80\x98\xa6\xc3\xce\x6b\xec\xcb\x9a\xeb\xc4\xf5\xf7\x8f\xde\xbc\x35\xdb\xbc\xd4\x4e\x26\xdb\x5a\xbc\xdc\x0d\x87\xae\x40\x68\x15\x43\xdc\xbf\x90\xd2\x00\x2d\xb3\xba\x02\x35\x55\x92\x57\xf6\xb6\xfc\x1f\x31\xf1\x5d\x19\xec\x0f\x3f\xaf\x08\x0f\xea\xee\x53\xbb\xf3\xa4\xea\x5d\xfa\xc2\x06\x24\x76\x4c\x63\x23\x1a\x3b\xfa\x16\x88\xec\x6a\xd0\x44\x65\x47\xcb\x0d\x72\x3c\xe8\x6c\xfd\xb9\x01\xf3\xf7\x2c\x59\x0c\x36\x38\xcb\xbf\x41\x59\xfd\x02\xb5\xd9\xb4\x3a\xf9\x97\x3f\x36\xc4\xbb\x4d\xf6\xdf\xad\x5c\xef\x21\x52\x4d\x51\x14\x29\x52\xfd\x2b\x1b\xfc\xab\x9e\x92\x12\xf3\x37\x31\xfd\x22\x2c\x74\x56\xb9\x9b\x69\xed\x1c\xcf\xfe\x13\xa1\xcf\xf7\xe0\x45\x9e\x0f\xff\x3d\x00\x28\x1f\x8e\x6d\x47\x54\x00\x00")
 
 func templates12_relationship_to_many_setopsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -369,11 +396,11 @@ func templates12_relationship_to_many_setopsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/12_relationship_to_many_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfc, 0x49, 0x80, 0x81, 0x75, 0xd3, 0x2d, 0xa4, 0xcc, 0x2a, 0xc5, 0xb0, 0xde, 0x45, 0xa5, 0xd8, 0xa3, 0xdd, 0x5d, 0xf8, 0xbb, 0x54, 0x27, 0x26, 0xf7, 0x22, 0xb5, 0x90, 0xba, 0x1f, 0x25, 0x63}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x46, 0xf9, 0xc, 0x79, 0xc0, 0x2, 0x40, 0xf1, 0xc3, 0xcd, 0x82, 0xe2, 0x31, 0x95, 0xdc, 0x1, 0x75, 0x51, 0x93, 0x3b, 0x37, 0x95, 0xfa, 0x40, 0x62, 0xac, 0x27, 0x3, 0xdf, 0xad, 0x4d, 0x48}}
 	return a, nil
 }
 
-var _templates13_allGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x4b\x6b\xe3\x30\x10\xc7\xcf\xd1\xa7\x18\x4c\x0e\x31\x6c\x26\xf7\x85\x1c\xc2\x86\x85\x1e\x1a\x1a\xd2\xd2\x63\x51\xad\x49\x6c\x90\x25\x47\x8f\xa6\x45\x99\xef\x5e\x2c\xb7\x38\x7d\x50\xe8\xc9\x83\xe7\xf7\x7f\x68\x52\x9a\xc3\x54\xea\x46\x7a\xf8\xbb\x04\x5c\xf5\x13\x79\xbc\x95\x8f\x9a\x60\xf8\xe0\x46\xb6\xc4\x2c\x32\xea\xab\x9a\x5a\x39\xac\x7b\xc1\x48\xc0\x19\x70\x37\x6e\xdf\x05\x95\x34\x3b\xbb\x0f\x6b\xd2\x14\x2e\x25\xff\x3e\xfc\x67\x16\x8b\x05\xa4\x34\x54\xc1\xbb\xee\x46\x47\x27\x35\x33\x38\x0a\xae\xa1\x27\xf2\x20\xb5\x86\x50\x13\x38\xaa\xac\x53\x1e\xa2\x6f\xcc\x01\xa4\x01\x7a\xa6\x2a\x06\xeb\x50\xec\xa3\xa9\xbe\x73\x99\xb5\x56\x79\x40\xc4\x63\x8b\xdb\x48\xee\xe5\xda\xaa\x72\x04\xd7\xf6\x64\x76\x8d\x39\x44\x2d\x1d\x73\x06\x20\x09\x00\x80\x94\x9a\x3d\x48\xa3\x00\x57\x4a\x8d\x7d\xfd\xe7\x77\xcd\x99\x33\x9f\x73\x96\x20\xbb\x8e\x8c\xca\xa9\x7f\xe0\xd8\xe2\x7f\x67\xdb\x59\x91\xd2\xe5\xf9\x98\x8b\xb2\x5f\xd6\xa4\x3b\x72\x78\x5f\x93\xa3\x2b\xbf\x89\x5a\x7f\x25\x31\xa5\x42\xe5\x24\xf5\x20\x43\x01\x67\x98\xe2\x36\xda\x40\xbe\x77\x29\xdf\xaa\x92\xf6\x43\x93\xc9\x2f\x6b\x94\x62\x92\x12\x19\x35\x88\x1d\x85\xe8\xcc\x8f\xd7\x49\x1b\x3a\xe5\x21\x7b\x23\x62\xc9\x82\xc5\x6b\x00\x00\x00\xff\xff\x4f\x7f\x93\x06\x4c\x02\x00\x00")
+var _templates13_allGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\x4d\x6f\x1a\x31\x10\x3d\xb3\xbf\x62\x8a\x72\x60\x25\x67\xd2\x4a\x55\x0f\xa9\x72\x40\xa4\x95\x7a\x28\x4a\x44\xa2\x1c\xaa\x1e\xcc\xee\x00\x56\xbd\x36\xf8\xa3\x90\xba\xfe\xef\x95\xbd\x1b\x16\x12\x1a\xa9\x87\x5e\x60\x6d\xbf\x99\xf7\x66\xe6\x4d\x08\xe7\x70\xc6\xa5\xe0\x16\x2e\xaf\x00\xc7\xe9\x8b\x2c\xde\xf1\xb9\x24\x68\xff\x70\xca\x1b\x8a\xb1\xc8\x50\x5b\xad\xa8\xe1\xf9\x3e\x07\xf4\x08\xf8\x0d\x38\xeb\x5f\x9f\x02\x2a\xae\x66\x7a\xe1\xae\x49\x92\x3b\x0c\x99\x1c\xdd\xc7\x58\x5c\x5c\x40\x08\xad\x14\xbc\x5f\xdf\x48\x6f\xb8\x8c\x11\x0c\x39\x23\xe8\x27\x59\xe0\x52\x82\x5b\x11\x18\xaa\xb4\xa9\x2d\x78\x2b\xd4\x12\xb8\x02\xda\x51\xe5\x9d\x36\x58\x2c\xbc\xaa\x4e\x65\x19\x35\xba\xb6\x80\x88\x9b\x06\x6f\x3d\x99\xc7\xaf\xba\x2e\x7b\xe0\xb5\xde\xaa\x99\x50\x4b\x2f\xb9\x89\x31\x03\x20\x14\x00\x00\x21\x88\x05\x70\x55\x03\x8e\xeb\xba\xd7\x6b\x9f\xd7\x75\x1e\x63\xc6\x67\x9e\x2b\xe0\xeb\x35\xa9\x3a\xb3\x32\xd8\x34\xf8\xd9\xe8\x66\x34\x0c\xe1\xb0\x7d\x31\x0e\xcb\xf4\xb8\x22\xb9\x26\x83\x0f\x2b\x32\xf4\xc5\x4e\xbd\x94\x2f\x91\x18\x42\xd7\xb6\x9e\x73\xa2\xa5\x6f\x54\xd7\xf9\x33\xbc\xf5\xda\x91\x4d\x49\xcb\x4e\x39\x49\xdb\x0a\x1b\xfc\xa3\xaa\xb2\x18\x84\x40\xaa\x6e\x83\x0d\x39\x6f\xd4\xab\xcd\x0a\x53\xda\xe6\x8f\x5c\x31\x22\x96\xb1\x88\xc5\xb3\x89\xf6\x31\x37\x7c\x49\x07\x73\x5d\xa7\x63\xfa\x99\xfa\x86\x41\xa5\xbd\x72\x69\xb0\x0b\xa3\x1b\x78\xc7\x40\x2f\xf2\xe3\x4c\xfc\x22\xd8\xb7\x21\x95\x9d\xcc\xa1\xb7\x36\xd1\x68\x53\x93\xa1\x1a\xe6\x8f\xd9\x21\xf9\x08\x95\xe4\xde\x92\x65\xc0\xa5\x56\x4b\xd8\x0a\xb7\xca\xaf\xca\x37\x73\x32\x29\xaf\xd1\x5b\x0b\x0d\x77\xd5\x2a\x11\x26\xed\xa0\x55\xf6\x59\x62\xb4\x98\x1d\x2c\x16\x80\xd7\x82\x4b\xaa\x1c\xde\x5b\xba\xd3\xeb\x49\x4e\xdc\x5a\x76\xec\x40\x12\xb7\x0e\xb4\x3a\xe6\x05\x61\xc1\xd0\xc6\x0b\x43\x75\x9b\x88\x54\x1d\xe3\x0b\x8b\x1e\xb7\x65\x94\x0d\x87\x53\x3d\xd1\xca\xd1\xce\xc5\x98\xcc\x0d\x73\x2d\x24\x7e\xea\x6c\xde\x4e\x36\xc6\xca\xed\xa0\x6a\x61\xd8\xc1\x19\xf4\xf0\xee\xea\x20\x2a\xf1\xb3\xbe\xd3\xfb\xae\x0a\xe5\x58\xa7\xfd\xdb\x77\xeb\x8c\x50\x4b\x06\x27\x37\x66\x74\x52\xf8\x4c\x8a\x8a\x18\x08\xe5\x3e\xbc\x67\x40\xc6\x68\x53\x42\x28\x06\x9b\xb4\xee\x7f\xdb\xc6\xe4\x92\x62\x20\x16\x09\x9f\x70\x1b\x4f\x46\x90\xc5\x19\xb9\xdc\x88\x4d\x4b\x7b\x42\x6f\xa7\xb5\xfc\x98\x43\xdf\x5c\x81\x12\x32\xd1\x3d\x39\x55\x09\xc9\xe0\x6d\x27\xc4\xe2\x83\xe1\xeb\x11\x19\xc3\x60\x18\x02\xde\xfc\x58\xb6\xde\xb9\x04\xaf\x92\x95\xc0\xe9\x9c\xf9\xb9\xb7\x86\x65\x31\x88\x45\x31\x70\xda\x71\xc9\x9e\x54\xbe\x56\x0d\x4e\x92\x75\xdb\x09\x2a\xed\x8e\xa6\x58\xb9\x1d\x83\xfd\x52\xe5\x29\xf5\xd5\xbf\x5a\x42\xab\x22\x29\xdc\x8b\xd8\xe0\x58\xca\xff\xc3\xd3\xdd\xb7\x74\x5d\xe9\x4a\xc8\x22\x16\x7f\x06\x00\xf3\x90\xd4\x27\x29\x06\x00\x00")
 
 func templates13_allGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -389,11 +416,11 @@ func templates13_allGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/13_all.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0x53, 0x40, 0x98, 0x66, 0x0, 0xe0, 0x3e, 0x56, 0xe2, 0xb7, 0xcf, 0xc9, 0x50, 0xad, 0x3a, 0xcd, 0xb, 0x54, 0x38, 0x3c, 0xf4, 0xc3, 0x6b, 0xe4, 0x39, 0x6f, 0x3e, 0xb3, 0xec, 0x8e, 0xc4}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc0, 0x3, 0xd1, 0x95, 0x33, 0xc4, 0xe9, 0xf, 0x9e, 0x87, 0xb7, 0x4a, 0x44, 0x8a, 0xc0, 0x50, 0xb2, 0x88, 0xdd, 0x4a, 0x30, 0xcd, 0x27, 0xdb, 0x10, 0xf0, 0xfb, 0xe9, 0x5b, 0xca, 0xfa, 0xe1}}
 	return a, nil
 }
 
-var _templates14_findGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x5d\x6f\xdb\x36\x14\x7d\x96\x7e\xc5\x9d\xd0\x0d\x52\xa1\xb2\xdd\x6b\x81\x0c\x48\xed\xd4\xc8\xd6\x65\xfe\x68\xb1\xc7\x81\x96\xae\x1d\xa6\x34\xa9\x90\x54\x1d\x83\xe1\x7f\x1f\x48\xc9\xb2\xbc\xca\x71\x3b\x74\x43\x9f\x24\x92\x87\x97\x87\xe7\x5c\x5e\xd2\xda\x17\xf0\x8c\x72\x46\x35\xbc\xbe\x00\x72\xe9\xff\x50\x93\xf7\x74\xc9\x11\x9a\x0f\xb9\xa1\x1b\x84\x17\xce\xc5\x01\x5c\x48\x3e\xc6\x55\x80\xeb\x7b\x3e\x0a\x2d\x26\x98\x61\x52\xe8\xfd\x8c\x91\xe4\xf5\xe6\xd0\x9c\xfe\x86\xbb\xae\xaf\x0b\x54\x7d\xf4\x81\x43\xa0\x7d\x50\xd2\xf4\x3c\x82\x36\x8a\x89\xf5\xef\xb4\x82\x34\x90\x1b\x49\xae\x5b\x9e\xd9\xd1\x30\x59\x84\xdf\xb7\xb5\x28\x34\x29\xe8\x06\xf9\x88\x6a\x3c\x0d\x51\x58\x71\x5a\xe0\x1c\x35\xaa\x4f\x58\xf6\xd9\x5c\xaa\x75\x20\x73\x27\x99\x58\x70\x56\xa0\x86\x04\x92\x03\xcf\x8e\xe4\xfb\x5d\x15\x48\x7a\x20\x24\x39\x24\x3d\x71\xa8\x58\xc8\x95\x19\x23\x47\x83\x41\xd1\x56\x90\xa3\xfe\x80\x66\x2b\x20\x97\x65\x39\xe1\x72\x49\x79\x88\xf0\xf2\x25\xbc\x65\xa2\xb4\xb6\xd9\x28\xf9\x50\x2d\x98\x58\xd7\x9c\x2a\xe7\x26\xa0\xd0\x28\x86\x9f\x50\x03\x05\xcd\xc4\x9a\x23\x28\x2c\xa4\x2a\x61\xb9\x83\xeb\x31\x89\x57\xb5\x28\x9e\x08\x90\x86\x25\x85\x34\x40\x6e\xe4\x48\x0a\x83\x0f\xc6\xb9\xc2\x3c\x40\xd1\x34\x48\xdb\x99\x83\xb5\x28\x82\x34\x60\x6d\x2b\x8c\x73\x39\x68\xe4\x58\x98\x60\x05\x21\xa4\x11\x38\x83\xf4\xf9\xe0\x7a\x39\xa0\x52\x52\x65\x60\xe3\x48\xa1\xa9\x95\x38\xcd\xad\xa1\xd6\xa7\xb5\x94\x8c\x93\x09\x9a\xf1\x9b\x34\xb3\x16\xb9\xc6\x40\x35\x87\xfd\x40\x8b\x6c\xc7\x45\xe9\x17\x0c\x64\xf7\x19\xd4\x99\x73\xcc\x9c\x10\x92\xc5\x2e\x8e\xbb\x2d\xc6\x07\x2b\xa6\x54\xb0\xe2\xac\x13\xd3\x73\x4e\xc0\x96\x99\x5b\xa0\x02\xf0\x01\x8b\xda\x48\x95\x03\x15\x25\x54\x3e\xba\x06\x29\x1a\x61\xce\xf9\x35\xfd\x5c\x14\x1f\xaf\x11\xe0\xaa\x8d\xdc\x93\xe6\x73\x17\x0f\xf0\xb6\xab\x37\xab\x27\xd8\xd3\xee\x0e\x9b\xdb\x9a\x2a\x97\x77\xc1\x66\x9f\xe8\x67\xcc\x1d\xc8\xbb\x7e\x9e\x79\xae\x5f\x61\x60\xc4\x56\x61\xdd\x1f\x2e\x40\x30\xee\xd9\x44\x41\xde\x34\x6c\xf7\x4f\x45\xab\x2b\xa5\x52\x54\x2a\xcb\xe2\xc8\xc5\x5d\x06\x36\x9c\x87\xfc\xf7\x0e\xf5\x8e\xe3\x97\xa7\xc3\xe4\x6c\x3e\xfc\x2b\xfb\x27\xd3\xff\xea\xbc\x7e\x2b\x47\xff\xbf\xe3\xfa\x4d\xdd\x7e\xca\xcb\xaf\x3e\xd9\xc4\x87\xbb\x5e\xf5\x95\x66\x1a\x70\x53\x99\x5d\x58\x05\xb6\x8c\x73\x68\xe9\x50\xce\xa1\x68\x2e\xc1\x73\xee\x7f\x1f\x67\xff\x0b\x2a\x7b\x07\x18\xcb\xad\x38\x40\xfe\x58\xde\xf9\x0c\xfa\x69\x70\xbe\xf5\x16\x69\xe4\x1e\x91\x3c\x4f\x82\xbd\x1c\x45\x7a\x20\x91\xc1\x2f\xf0\x2a\xf8\xec\x61\x17\xed\x5d\xae\xc9\xaf\x92\x89\x54\x1b\xb5\xa1\xde\x1a\x72\x5d\xa2\x30\xb3\x5a\x1a\x0c\xd7\x75\x5a\x32\xea\x23\x90\x77\xb3\x1c\xf6\xff\xf3\x59\x7f\x77\x59\x0e\x49\x9e\x84\x2c\x89\xee\x6b\x54\x3b\xcf\x61\xb5\x31\x64\x51\x29\x26\xcc\x2a\x8d\xa3\x28\x69\xe0\xf0\xa3\x86\x95\x92\x1b\xb0\xb6\xff\x0c\x7a\x04\xb2\x28\x6e\x71\x43\x43\x9f\x73\xb0\xbd\x45\x85\xd0\xf8\x35\x6e\x17\xfd\xa0\xf1\x5a\x94\xf8\x30\xf5\x4f\x8d\x5b\xc9\x4b\x54\xda\x39\x6b\x03\x76\xc4\x69\xad\x11\xc8\xbb\x19\x90\xf9\x0c\x7e\x1e\x7a\x24\x79\x70\xe3\xee\xf0\xa4\x57\x27\x27\x79\x73\x8f\x0a\xda\xe1\xd9\xa1\xff\xf1\x3c\x71\x2e\x80\xac\x4d\xca\xd0\x2e\xff\xa2\x26\x81\x47\x78\x46\x82\xa6\xda\x39\x9f\xcc\xa2\xe6\xbc\x8d\x9b\x04\x29\xf3\x38\xca\xe2\x38\xba\xf7\xd2\x79\x0d\x19\x6a\x32\xa7\xdb\x34\xe8\x79\xfa\x54\xfb\x39\x6d\x61\xb9\x27\x6f\x98\x28\x4f\xd6\xb7\xfd\xd6\x05\xe3\x5d\xb6\x76\xf7\xc3\x89\x6c\x1b\x2c\x12\x4d\x8f\x54\x9a\x8c\xbc\x7a\xa1\x42\xc0\x45\x78\xb6\x92\x2b\xa5\x6e\xe4\x5c\x6e\x75\x40\xee\x2b\x86\x60\x3c\x3f\x1e\x8e\x23\x9f\x2b\x47\xe3\x6d\x4c\x5f\x77\x7c\xc8\x1c\x12\x6b\xc9\xf4\xe3\xda\x6f\xda\xb9\xd7\x50\x8b\xf0\x78\x36\xb2\x4d\xbc\x81\x34\x72\x2e\x39\x2e\x55\xa7\x77\x96\xfb\x45\x63\x17\xff\x1d\x00\x00\xff\xff\xa8\x25\xe6\x7a\xa9\x0b\x00\x00")
+var _templates14_findGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5f\x6f\xdb\x38\x12\x7f\xb6\x3e\xc5\x9c\xa0\x1c\xac\x42\xe5\x76\x5f\x0b\xf8\x80\x34\x69\x83\x5c\xf7\xba\x4e\xb2\x45\x9f\x19\x69\xe4\xb0\xa1\x29\x85\xa4\x92\x18\xaa\xbe\xfb\x61\x28\x4a\x96\x1b\xcb\x4a\xff\xec\x62\xef\x9e\x6c\x49\xe4\x70\x7e\xf3\x9b\x7f\x9c\xba\x7e\x09\x11\x97\x82\x1b\x78\xbd\x00\x76\x4c\xff\xd0\xb0\x3f\xf8\xb5\x44\x68\x7f\xd8\x07\xbe\x46\x78\xd9\x34\x81\x5b\x9c\x16\xf2\x14\x73\xb7\xdc\xdc\xc9\x13\xf7\x24\x94\xb0\xa2\x50\xa6\xdb\x71\x52\xc8\x6a\xbd\x7d\x5c\xbe\xc7\x4d\xff\xae\x17\x54\xde\x92\x60\x27\xa8\x13\xea\x8e\x32\xf0\x05\x8c\xd5\x42\xad\xfe\xc3\x4b\x98\x3b\xe5\x4e\x0a\x69\xbc\x9e\xf1\xce\x67\x76\xe5\xfe\xbe\xab\x54\x6a\x58\xca\xd7\x28\x4f\xb8\xc1\xf1\x25\x1a\x4b\xc9\x53\xbc\x44\x83\xfa\x1e\xb3\x2d\xac\xf2\xf6\x58\xaf\x9c\x32\x9f\x0b\xa1\xae\xa4\x48\xd1\x40\x08\xe1\x56\xcf\x5e\xc9\x3f\x36\xa5\x53\x92\x16\x42\x98\x40\x38\x30\x0e\x57\x57\x45\x6e\x4f\x51\xa2\x45\x12\xd6\x19\x64\xe7\x7d\xbf\x3c\x17\x2a\xfb\x74\x83\xda\x2d\x7d\xa0\x3f\x27\x92\x57\x06\x81\xfd\x76\x01\xec\xf2\x02\x5e\x1d\x34\xa1\xc8\x81\x9d\x0a\x2e\x31\xb5\xec\xa3\xc1\x73\x95\xe1\xe3\x92\xe0\xdd\x14\x32\x43\x6d\x9a\xa6\xae\x07\x67\xec\x3f\xe2\xd7\x7d\x47\xd0\x4e\x54\x5b\xf3\x88\x1c\xb8\xca\x80\x1d\x67\xd9\x16\x87\xf9\x0a\xef\x93\xe3\x4a\x2d\x94\xcd\x21\x3c\x32\x6e\xf7\x91\x01\x61\x40\x55\x52\x86\x43\xe8\x73\x7f\xfe\x56\x50\xeb\x2a\x64\x75\xf8\x02\xec\xa2\x2a\x2c\x9a\xd8\xab\xe4\xa0\x13\xf0\xe3\x2c\x3b\x93\xc5\x35\x97\x4e\xc9\x5f\x7e\x81\x77\x42\x65\x75\xdd\x3a\x09\xfb\x58\x5e\x09\xb5\xaa\x24\xd7\x4d\x73\x06\x1a\xad\x16\x78\x8f\x06\x38\x18\xa1\x56\x12\x41\x63\x5a\xe8\x0c\xae\x37\x70\x7e\xca\x82\xbc\x52\xe9\x01\x01\xf3\xba\x16\x39\xa8\xc2\x02\xfb\x50\x9c\x14\xca\xe2\xa3\x6d\x9a\xd4\x3e\x42\xda\x3e\x30\xff\x32\x81\xde\x6e\x50\xd7\xde\xa9\x9a\x26\x01\x83\xc4\x92\x73\x63\xc6\x58\xeb\xde\x31\xcc\x5f\xec\x3d\x2f\x01\xd4\xba\xd0\x31\xd4\xc1\x4c\xa3\xad\xb4\x1a\xd7\xad\x55\x6d\xa8\xd6\x75\x21\x24\x3b\x43\x7b\xfa\x66\x1e\xd7\x35\x4a\x83\x4e\xd5\x04\xba\x0f\x7e\xa5\xff\xae\x32\xd2\xcf\x29\xdb\x45\x5f\xef\xd8\xbb\x9a\x33\xc6\xe2\xa0\x09\x82\xad\x6b\x6c\xa9\x58\x72\x25\xd2\x49\x26\x96\x53\x4c\xc0\x83\xb0\x37\xc0\x15\xe0\x23\xa6\x95\x2d\x74\xe2\x3c\xa7\x24\xe9\x06\x0a\xd5\x1a\x66\x8a\xaf\xe5\x53\xa3\x90\xbc\xd6\x00\x6f\xbd\xe4\x81\x69\x9e\xb2\xb8\x5d\xee\x5f\x0d\x76\x0d\x0c\x76\x98\xdd\xfd\xe4\x7a\x52\x8b\xeb\xcf\x8e\x66\x8a\xfc\x51\x20\xa3\x7e\x37\xf4\x33\xd2\xf5\x1b\x08\x9c\x89\xdc\x9d\xfb\x8f\x05\x28\x21\x49\x9b\x99\x33\xef\xdc\x59\xe7\x93\xe6\xe5\x5b\xad\xe7\xa8\x75\x1c\x07\xb3\x26\xe8\x3d\xb0\xd5\x79\x1f\xff\x5d\x66\xf0\xe1\xf8\x7c\x77\x38\x9b\xf4\x87\xef\xa2\xff\x6c\x39\x6a\xb7\x1f\x8c\xd7\x9f\xc5\xe8\x5f\x17\xae\x3f\x9f\xed\x61\xb5\xb8\x2c\x1e\xce\x4f\x3b\x9a\x7b\xbc\xa7\xc5\x83\xda\x22\x26\x9a\x2e\x2a\xd4\x1b\x4a\xfe\xf6\x06\xe1\xce\x3d\x8c\x5a\x08\x74\xa5\x0c\x55\x2a\x05\xaa\x80\xd4\x95\x02\x43\xf2\xb9\x46\x8f\x0e\xb3\x04\xae\x2b\x21\x2d\x14\x2a\x45\xca\x23\x29\x3a\xd1\x96\x54\xa3\x73\x6e\x71\x83\xce\x85\xb6\x5e\x55\x3c\x88\xcc\x8b\x63\xc1\x3d\xd7\xd3\xfa\x2e\x20\x6c\xcf\x83\x17\x90\xeb\x62\x0d\x75\xed\xc1\x77\xa5\xe9\x2a\xbd\xc1\x35\x77\xef\x9a\x86\x74\xd6\x08\xc3\x1a\xd8\x34\xe1\xd0\x80\x87\x62\xe1\x9b\x33\x23\x23\x9b\x9c\xe7\x03\xc2\x09\x38\xae\x4b\xbb\x71\xa7\xc0\x83\x90\x12\x3c\x9d\x5c\xca\xce\x94\x53\xd1\xf3\xf7\xc8\x9d\xcf\xa8\x8c\x7b\x62\xdc\xb9\xe2\x8c\xb4\x5a\xb4\x0a\x7f\x12\xf6\xc6\x91\xf9\x7b\x39\x77\x41\x15\xee\x15\x4b\xa9\x92\x6c\x16\xc6\x41\x30\xdb\xf2\x35\x1b\x71\x91\xdf\xaf\x3f\x53\xda\xfe\xe7\x5e\x59\x35\xe5\xcc\x03\x91\x32\x6b\xfd\xff\xf5\x62\xd2\x01\x5d\xf0\x4a\x54\xf3\xad\x89\x62\xf8\x17\xbc\x72\x51\x6c\x50\x92\x0e\xad\xbd\x0c\xfb\x77\x21\xd4\xdc\x58\xbd\xe6\xe4\xed\xec\x3c\x43\x65\x5d\xe3\xe4\x3a\xd9\x79\xe6\xbb\xc4\xdf\x2e\x12\xe8\xfe\x5f\x5e\x0c\x8d\x1f\x27\x10\x26\x61\x1c\xcc\xbc\x7e\x0b\xc8\xd7\x96\x5d\xb5\x2d\xdc\xbc\x0b\x84\x23\xf3\xfd\x91\xe0\x4e\x73\x59\x66\x46\x2d\x25\x79\x50\x6b\x10\x0f\x25\x7c\x11\x4e\x22\xfe\x33\x00\x0f\x29\x19\x62\x0e\x66\xb3\x9f\x06\x3b\x09\x66\xb1\x87\x4d\xed\x43\x10\xcc\xee\xe8\x38\x32\xb5\x40\xc3\x2e\xf9\xc3\x9c\xfe\x6f\xc6\x33\x3b\x79\xa6\x2f\x2e\x77\xec\x8d\x50\xd9\x68\x8d\xeb\x82\x53\x09\xd9\x47\x5c\xdf\x23\x8c\xb8\xf3\xde\x42\xd1\x96\x8e\x42\x1b\x76\x42\x97\x12\xd7\x13\xc0\x62\x01\xe6\x4e\xb2\xb7\x5a\x7f\x28\x2e\x8b\x07\xe3\x56\x76\x55\x43\x09\x99\xec\x7e\x0e\x66\xc4\xf7\xce\x77\x2f\x93\x6a\x0f\x89\x4c\x20\xac\x6b\xb6\xbc\x5d\x51\x52\x6d\x9a\xd7\x50\x29\x32\x27\xd8\xc2\x93\xb5\xc7\xf4\x4d\x13\xee\x96\xab\x71\x64\x09\xc1\x09\xba\xe2\xb5\xb2\x30\x97\xa8\xf6\x5d\x79\x62\xf8\xb5\xbf\xee\x44\xe5\xed\x3b\x81\x32\xfb\x8e\xcb\xe9\xd7\xc5\x70\x98\x1a\x96\x5a\xac\xb9\xde\xbc\xc7\x0d\xd0\x0d\xad\x2d\x87\x65\xfb\x12\x6e\x71\xd3\xa5\x68\x28\x72\xe0\x5f\x23\xa6\x0a\x96\x50\xce\xcf\x0b\xed\x36\xbe\xd9\x2c\xdf\xc3\x3d\xd7\x82\x2b\xeb\xb6\x50\xe2\x48\xe0\xed\xa3\x30\xb6\xbd\x70\xb5\xb7\x29\x16\xd8\x4d\x89\x93\x1a\x19\xab\xab\xd4\x12\x9d\x75\xad\xb9\x5a\x21\x44\x22\x81\x28\x77\x26\xe8\xed\xd1\xa5\xc5\x9c\xda\x9e\x5a\xd0\x8d\xf3\xeb\x8b\x71\x24\x9a\x66\x98\x45\x9b\x80\xb4\x6e\x2f\xe1\xbe\x1e\xb5\xc8\x09\x31\x37\x1e\xf4\xe2\x9e\xcb\x0a\xa1\xe4\x42\x1b\xea\xbf\x5f\x3b\x9c\xb2\x58\xad\x84\x5a\xf9\x9a\x35\x2f\x6f\xa7\x60\xc4\xfe\xa0\x79\xec\x69\xf2\xcd\x19\x79\xdf\x4e\x42\xdb\x01\x99\x3e\xe5\x99\xae\x9b\x22\x77\x60\x7c\x93\x48\x6f\xa2\xb4\x69\x16\x47\xf7\xfe\x39\x4c\x60\x47\xcc\xae\xad\xf6\x49\x28\x6f\x19\xf5\x07\xfd\x5d\x36\xf6\xd6\x19\xad\xc6\x8e\xe4\x83\xad\x81\xb0\x66\xe8\x43\x94\x6e\x70\x5c\xde\x54\xf5\xa7\xf3\xfe\x82\x0e\x60\x9a\xc8\x1f\x68\x0c\x3c\xdd\xa3\x18\x47\x33\xe7\xbe\x5b\x95\xa7\x77\x4b\xaa\xa3\x90\xf5\x9c\x52\xce\x98\xba\x23\x7b\x19\x29\xf5\x68\xdb\x99\xd0\x47\x25\xee\x2a\x7c\x8f\x9b\xc1\x4c\xec\xe0\x70\x2d\xf2\x1b\x7d\xc2\xf2\x02\xfb\xbd\xea\xc7\xa7\x69\xd1\x33\xc6\x69\xd1\xf3\xe6\x69\x7c\x64\x9a\xa6\x9e\x3d\x4b\x23\x47\xa5\xd4\xd7\x41\x7a\x06\x92\xf6\x26\x74\xac\xb2\x10\xbe\xf4\xd3\x27\xf2\x83\x23\xf3\x66\x73\x64\x42\x78\xe2\x0d\x83\xcb\x4b\x77\xde\x54\x2f\x4e\x01\x57\x39\xee\xc8\x87\xbd\x62\x83\x4a\xfd\x4d\x9d\xba\xb0\x13\x7d\xfa\x8e\x62\xad\xe7\x46\x7f\x6a\x68\x52\xc8\xfc\xb4\xd6\x3c\xfa\x1b\xf6\xe6\xff\x4b\xed\x66\xb4\xdb\x6f\x46\x23\x0d\x27\x95\x99\xc9\x49\xf0\x70\xf8\x1b\xd1\xf4\x37\x6a\xc7\xbf\x2e\xb6\x5c\x45\x72\xa3\xc2\xb1\x85\xaf\x06\x0b\xa9\x94\xf5\xa3\x9f\x68\x6a\x2a\xec\x56\x6d\xb1\x8c\xcc\x79\x23\x3f\xe8\x6d\x9a\x6e\x4a\xec\x0f\x1a\x74\xd0\x07\xdb\x66\xc5\xd7\xbb\xa9\xe4\x60\xd3\x1c\xfd\x1f\x74\xcd\xd1\x73\xda\xe6\xe8\x87\xfb\x66\x54\x19\xbc\x6c\x9a\xe0\xbf\x03\x00\xd0\x03\xc1\x2b\x2c\x1a\x00\x00")
 
 func templates14_findGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -409,11 +436,11 @@ func templates14_findGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/14_find.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf5, 0x24, 0xa2, 0x29, 0x23, 0xa5, 0x98, 0xc7, 0x1d, 0x82, 0xb8, 0xd1, 0x6a, 0x72, 0xff, 0xc0, 0x13, 0x86, 0x11, 0x85, 0xad, 0xa, 0xab, 0xe, 0x6f, 0x7d, 0x95, 0x49, 0x89, 0xc3, 0x71, 0x60}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x58, 0x90, 0x63, 0xe1, 0xb2, 0x72, 0x64, 0xe, 0x43, 0x6b, 0xf6, 0x19, 0x85, 0xae, 0xa8, 0xc7, 0x45, 0x2a, 0xf2, 0x3f, 0xd3, 0x8f, 0x19, 0x1c, 0xa5, 0xe3, 0xae, 0x5c, 0x7c, 0xed, 0x42, 0x93}}
 	return a, nil
 }
 
-var _templates15_insertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5f\x6f\xdb\x38\x12\x7f\x96\x3e\xc5\xac\xb1\x5e\x48\x07\xad\xb6\x05\x0e\xf7\xd0\x43\x1e\xd2\xd8\xc9\xe6\x9a\x26\xae\xed\x6c\x80\x2b\x8a\x82\x91\x46\x09\x11\x9a\xf4\x51\x54\x1c\x9f\x56\xdf\xfd\x40\x52\xb2\xfe\x58\x76\xdc\x36\xb9\x7d\x6a\x2d\x0e\xe7\xcf\xef\x37\x9c\x19\x32\x79\xfe\x2b\xfc\x4c\x18\x25\x29\xbc\x3b\x82\xf0\x58\xff\x0f\xd3\x70\x4e\x6e\x19\x82\xfd\x27\xbc\x24\x0b\x2c\x0a\xd7\x88\xa6\xd1\x3d\x2e\x88\x5d\xd6\x1b\x6a\x09\xf8\x13\xc2\x59\xbd\x6a\x36\xd0\x04\xc2\xe3\x38\x3e\x63\xe2\x96\x30\xf8\xb5\x28\xdc\xdf\x7e\x83\x73\x9e\xa2\x54\x67\x40\x20\xa5\xfc\x8e\x21\x48\x8c\x84\x8c\x43\x98\x21\x96\x8b\x90\x08\x09\xab\x7b\xaa\x90\xd1\x54\xc1\x2d\xde\x93\x47\x2a\x24\xc4\x98\x46\x92\x2e\x15\x15\x3c\x74\x93\x8c\x47\xe0\x09\xf8\x5b\x9e\xdb\x08\xc2\xeb\xe5\x8c\xf2\xbb\x8c\x11\x59\x14\x7e\x65\xc7\x33\x6e\x70\xa1\x20\xbc\x14\x27\x82\x2b\x7c\x52\x45\x11\xa9\x27\x88\xec\x8f\xb0\xfc\x18\x40\x9e\x23\x8f\xb5\x9b\x10\x09\x96\x2d\x78\x0a\xb7\x82\xb2\xf0\xc4\xfe\xf0\x01\xa5\x14\x12\x72\xd7\x91\xa8\x32\xc9\x41\x84\xd6\x86\x35\xd1\x54\x6f\xf6\x9d\xa1\x1a\xbd\xf7\xfc\x3c\x47\x96\xa2\x31\x19\x40\xb5\x50\x4a\x96\xeb\x3c\x2e\x8a\xa0\x32\xea\xbb\x85\xeb\x6e\x5c\x71\x6b\x18\x27\x84\xd3\xa8\x8d\xe2\xa4\x8b\x22\x64\xfa\x27\x10\x0e\xf8\x84\x51\xa6\x84\x0c\x80\xf0\x18\x96\x7a\x6f\x0a\x82\xdb\x20\x9a\x60\x6b\x6d\x2f\x87\xf7\x64\x1b\x0c\xed\x89\x0d\x7c\x5c\xfa\xd4\x80\x64\x9b\x85\x5a\xbc\xfc\xd4\xd8\xd5\x02\xaa\xc3\x4e\xee\x3a\x34\xd1\xe1\xe9\xc4\x6c\x53\xd3\xc3\x7e\x93\x6d\x6d\xb1\x86\xff\x9f\x46\xc7\x4f\x47\xc0\x29\xd3\x4a\x1d\x83\x9d\x67\x8c\xdd\x48\xb2\x1c\x4b\xe9\xa1\x94\xbe\xef\x3a\x45\x1f\x55\x1a\xee\x46\xd6\xef\x60\xee\x6c\x8b\xba\x67\x89\x6a\xb3\xa4\x55\xfd\xd0\xc1\x98\xbc\xe0\xc9\xd8\x83\xfd\x8b\x1d\x8b\x1f\xe0\x65\x83\xfa\xf3\xc7\x25\xd4\xc2\x1a\xf3\x66\x80\x65\x40\xf6\xd7\x0c\x15\xc4\x22\xca\x16\xc8\x15\xd1\x88\x83\x12\x90\xf1\x18\x65\xaa\x34\x83\xd6\x5f\x30\x27\x89\xf2\x04\x25\xf2\x08\x0d\x77\xd4\x68\x49\x0f\x65\xe8\x2f\x3b\x49\x9b\x3a\x47\x13\x10\x70\x54\x23\x5e\xd6\x3d\xb3\x9e\x86\x97\xb8\xf2\x06\x79\x1e\x4e\x1e\xee\x6c\x8b\x78\x07\x5c\x40\x9e\xb7\xda\x06\x2c\xa5\x78\xa4\x31\xc6\x0d\x04\xa8\xe0\x03\xc3\x92\xeb\x3c\x12\x69\x68\x35\x2a\x5d\x47\xf7\x18\x85\x8b\x25\x23\x0a\x61\xa0\xe8\x02\x53\x45\x16\xcb\xaf\x76\xdf\xd7\x7b\x64\x4b\x94\x03\x08\x41\xb3\xea\x34\xf3\xf7\x77\x21\x1e\x52\xc3\x76\x2b\x13\x63\xf1\x1e\x13\x21\xd1\x22\x6a\x84\x0e\x2e\x09\xdb\x19\x57\xc7\xaf\xbd\x37\xde\x1a\x20\x5d\xd7\xe1\xff\x1d\x61\x42\x32\xa6\x4c\x23\xfd\x4f\x86\x92\x62\x1a\x5e\x0a\xfe\x6f\x94\xa2\x5c\x9a\xa1\xe6\xb4\x64\x7c\x24\x56\xbc\xe6\xbc\xc4\xfe\x86\xaa\xfb\x52\x38\x00\xe1\xbb\xae\xf3\x80\x6b\xad\x70\x41\x1e\xf0\x84\x44\xf7\xf8\x01\xd7\x5e\xc9\x5a\x00\xb5\x51\x5f\x7b\xd3\xab\xb9\x4c\x5d\xbd\xf7\x63\xa6\xc2\xe9\x85\x88\x1e\x3c\xdf\x75\x22\xfd\x25\x00\xf3\x4f\xac\x4d\x3c\xbf\xff\xf3\x03\xae\xbf\x1c\x6c\xe8\x9a\x33\x6b\xca\x30\xf2\x53\x69\x48\xc3\xb8\x62\x01\x58\x28\xcb\xb0\xb5\xf9\xa8\xff\xa8\x79\xae\xe3\xec\xb2\x78\xcc\x58\xa9\x20\xd8\x23\xd5\x03\xed\x61\xd2\x22\x53\xcd\x0d\x35\xd8\xfa\xa7\x0e\xcb\x62\x18\x3e\x12\x96\xe1\x47\xb2\x5c\x52\x7e\x17\x98\x94\xa9\x13\xe0\x3d\xe5\x71\xb9\xb4\x8b\xfa\xf9\x7a\x89\xc1\x2e\xf4\x37\x6a\x57\xcc\x77\x9d\x2a\xb5\x1b\x29\xd9\xca\x49\x9d\x94\xa5\x53\x12\xd5\x6b\xbb\xd4\xa2\xf0\x50\xef\x68\x02\x0c\xb9\xb7\x62\xbe\x96\x7b\x63\xa5\xac\xcb\xda\xc1\x35\x1c\x41\xb2\x50\xe1\x6c\x29\x29\x57\x89\x37\x38\xbf\x9c\x8d\xa7\x73\x38\xbf\x9c\x5f\x69\x87\xd2\xe6\x84\x09\x5e\x9e\x87\x17\x9f\x8a\x62\x98\xe6\x79\x38\xfd\xa4\x4b\xe7\x70\x98\xfe\x71\x7c\x71\x3d\x9e\x81\x37\x4c\xfd\xe1\x30\x1d\x04\x90\x2a\x49\xf9\x5d\x1a\xfe\x4b\x50\x6d\x39\x80\x41\x29\x1e\x94\xfb\x07\xbe\x11\x5a\x10\xdd\x1a\xc2\x09\x23\x11\xde\x0b\xa6\x2b\xba\x17\x53\xc2\x30\x52\xe1\x75\x8a\xe7\x3c\xc6\xa7\xe6\x62\x50\x85\x12\xc0\xdb\x00\xde\xea\xce\xe3\x14\xa0\x0b\xb2\x0d\xcb\xd6\xef\x51\xad\xa1\x4c\xa0\x0f\xb8\x5e\xe9\xe6\x63\xaa\x55\x37\xfa\xfd\x11\x0f\xd3\xd1\xf8\xf4\xf8\xfa\x62\x0e\x36\xca\x61\x3a\xb0\x96\x8c\xd5\xef\x50\xe8\xf9\x50\xe1\xe5\x0f\xd3\x5a\x1d\xaf\xdc\xd3\xc5\xcd\x94\x69\xa3\xef\x2a\x53\xcb\x4c\x05\xf6\xc7\xd4\x90\xab\x1b\xa8\x45\xd8\xad\xf9\xed\x26\x61\x93\xed\x2d\x58\x2e\x48\xaa\xec\xb1\x3f\x1f\xb5\x63\x90\xa8\x3e\xf5\x65\xc5\x6c\x7c\x31\x3e\x99\x43\x97\x7e\x38\x9d\x5e\x7d\xdc\x8e\xf1\xe6\xf7\xf1\x74\x0c\xdb\xa9\xd0\x4a\xe0\xe7\xb2\xe2\xe6\x1e\x25\x9e\x30\x92\xa5\x68\xba\x9e\x91\xa8\x37\x0d\xf4\x79\xe9\xc4\xb5\x95\x30\x45\xf1\xb6\x6a\xd8\x6f\x36\x3d\x78\xc7\x31\x9b\x48\xba\x20\x72\xfd\x01\xd7\xd5\x09\xf3\xb7\x99\xde\xc6\xd2\x12\x64\xfd\xac\xa4\x1a\xcc\x75\x81\xbc\xba\x9e\x4f\xae\x75\x6e\xe8\x14\x19\x8f\xc2\x2d\x44\x0f\xc5\xac\xab\x61\x60\xdd\xed\xf8\xdb\x49\x9b\x8e\x33\x30\x1d\xcf\xaf\xa7\x97\xe7\x97\x67\x5b\xcc\x7e\x33\x75\x1b\xeb\x9b\x44\xde\xce\xea\xdd\x65\xa7\xb1\x12\xec\x4b\xfc\xcd\x14\xc3\x32\x73\x1f\x96\x98\x18\x22\xce\x79\x4c\x25\x46\xca\xab\x3e\xfc\xa1\x25\xae\x12\x4f\x68\xc7\x1e\x09\x6b\x4d\x09\x66\x31\x3d\x95\x62\x51\xd5\x65\xa3\xb0\x6c\xce\xad\xfe\xe2\x57\x63\x4f\x3d\xbb\x6c\x86\x1e\x33\xc4\x8d\xf0\x36\xbb\xfb\x28\x62\x5b\x82\x74\x4c\xa7\x26\x26\xc6\xbd\x7a\xfd\x46\x52\x85\xb2\xd2\x6f\x42\xf2\x9f\x97\xd6\x6e\xfb\xe5\xe0\x53\x93\x5a\x19\x3e\x4f\x8d\xb0\x17\xa9\x27\xdf\x76\x79\xb3\x4d\xc7\xd9\x55\xa5\x23\x35\x72\x5d\x9b\xab\x03\xfc\x5a\xf5\x79\x53\x0d\xfa\x87\xd4\x16\xf3\x8e\x11\x11\xde\x5a\xa9\xdf\x32\x4e\x3a\x2b\xe5\x1e\x9a\x6c\x6f\xb2\xa9\xd4\x4b\x87\xc4\xd4\xcc\x70\xe5\x20\xaa\xc7\x49\x33\xb3\xb7\x33\x4b\xc7\x10\x86\xa1\xef\xb6\xcf\xc9\xae\xcd\xa5\x05\xcf\xcc\xaa\x7b\x14\x55\x59\xde\x3a\x7b\xbd\x6e\x7e\xad\x86\x83\x6f\x73\x70\x7b\xdb\xb7\xbb\x56\x8d\xce\x3d\x53\x43\xfb\x9a\xa1\xef\x76\xfa\x62\x67\x4f\x79\xe3\xb2\x91\x71\xf3\x0c\xa5\x44\x79\xad\x00\xca\xd5\xd6\xfd\xa3\xba\x68\xec\x61\x50\x37\x37\xa6\xbf\x8e\xb4\x86\x7f\xfc\xbd\xe5\x9d\x5e\xa4\x31\x72\x45\x13\x8a\xf2\x44\xb0\x14\x3e\x7f\xa1\x5c\xa1\x4c\x48\x84\x79\x61\xa7\xdb\xfe\x96\x77\x54\xb5\xbc\x3b\xa1\x04\x98\xc9\xb8\xbc\xa8\x3c\xeb\x93\xf5\xa7\x82\xd9\x26\x44\xd8\x10\x8b\xf5\x08\xbf\x13\xb9\xb1\x94\xb3\x35\x8f\x4e\x09\x65\x95\xa5\x9f\x23\xc1\xcc\x33\xdd\xbb\x23\xa0\xba\x2f\x55\xf9\x3e\xf9\x80\xeb\xea\xea\x07\x6f\x9a\x47\x44\xb0\xc6\xb1\x30\x97\x73\x73\xb1\xdd\x68\x6a\x89\xce\xa9\x62\xf6\x1e\xb1\x59\xff\x13\x94\xfe\x78\x42\x74\xbf\x73\x1d\x11\x5a\x2f\xac\x64\x51\x80\xb9\x72\x44\x82\x85\x7a\xdc\x2c\x0a\xcf\xc6\x6c\xe3\x2a\xf9\x30\x43\xc3\x2f\xbf\xec\xc6\xf7\xad\x5e\xed\xae\x7c\x7e\xf3\x45\xaf\xed\x9f\x5f\x3f\x0f\x6a\x50\x8a\x62\xf0\x65\x37\x51\xad\x7b\x5e\x27\x17\x8e\xda\xd9\x90\x9b\x2c\x97\x84\xdf\x61\x2f\xbe\xf6\x28\x1a\x24\xac\x6b\x25\xa6\x61\x51\x04\xed\x03\x52\xbc\x42\xa1\xaf\xa6\xa9\x03\x6a\x7d\x3b\x4c\x7b\x7e\xff\x6f\x85\x7f\xa7\x9f\xab\x67\xbd\xeb\x34\x82\x0e\x76\x8d\xa2\x65\x0c\x4c\xc5\xca\x6b\x9b\xec\xd3\x1d\xce\x22\xc2\xbd\xaa\x59\x4f\x94\xdc\xdd\xaa\x1b\xd9\x69\xbd\x6a\x02\xd6\x63\xbd\xa7\x6c\xbe\xa2\x27\x55\x6e\xbd\x40\xc5\x5d\x8a\x65\x66\x1e\x69\x62\x7b\xa5\x01\x63\x3c\x35\x8f\x3c\xbd\x15\xd8\xa9\x06\xdf\x3d\xf5\x72\x73\x45\xe8\x25\x6f\x0f\x7b\x9d\x56\xf3\x23\x30\xb5\x9b\xe6\x61\x94\xbd\xb0\xf9\x4d\x09\xa8\xaf\x92\xfd\x80\x7c\x67\xf7\x7e\x81\xf6\x5d\xb6\xbd\x57\xef\xdb\x4e\x79\x4b\xaa\xfe\x0a\xb2\x6f\xb0\x6b\x96\xed\x77\x6e\x7b\xc0\x68\xbe\x3e\x1d\xf6\x7c\x55\x3d\x93\x1d\x20\x6e\x9e\xc5\xe0\xc8\xe2\x76\xb0\x81\xcd\xf3\x98\xb3\xe7\x29\x73\xf3\x87\xa6\x58\x1c\x27\x0a\xe5\x77\x3d\x63\x96\x0d\xac\x31\x5e\x1a\xa5\x5c\x0f\x07\x35\x4c\x85\xfb\xbf\x00\x00\x00\xff\xff\x55\x63\x97\xe6\x0c\x1c\x00\x00")
+var _templates15_insertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5f\x73\xdb\xb6\xb2\x7f\x16\x3f\xc5\x56\x53\x67\xc8\x7b\x19\x36\x99\xb9\x73\x1f\xda\xf1\x83\x63\x2b\xae\x6f\x12\xdb\xb1\xe4\x66\xee\xc9\x64\x32\x30\xb9\xb2\x71\x02\x01\x3a\x00\x64\x45\x65\xf9\xdd\xcf\x2c\x08\xfe\x93\x28\xc9\x4e\xdc\xf6\x3c\x25\x26\xb0\xd8\x3f\xbf\xdf\x2e\x16\x80\xf2\xfc\x39\xf0\x29\x48\x65\x21\x99\xb0\x1b\x81\xc9\x99\xb9\x42\x96\x5d\x48\xb1\x82\xe7\x45\x11\xd0\x84\x1f\x99\xe0\xcc\xc0\xcf\x87\x90\x1c\xd1\xff\xd0\x94\x73\x2b\x91\x73\x36\xc3\x6a\xaa\x49\xef\x70\xc6\xdc\x77\x27\xd0\xcc\x80\x3f\x20\x19\x37\xa3\x4e\x80\x4f\x21\x39\xca\xb2\x53\xa1\x6e\x98\x70\xfa\x7e\xfa\x09\xce\xa4\x41\x6d\x4f\x81\x81\xe1\xf2\x56\x20\x68\x4c\x95\xce\x12\x18\x23\xfa\x41\x98\x2a\x0d\xcb\x3b\x6e\x51\x70\x63\xe1\x06\xef\xd8\x3d\x57\x1a\x32\x34\xa9\xe6\x73\xcb\x95\x4c\x82\xe9\x42\xa6\x10\x2a\xf8\xaf\x3c\x2f\x3d\x48\xae\xe7\x63\x2e\x6f\x17\x82\xe9\xa2\x88\x2a\x3d\x61\x9e\x57\x11\x38\x57\xc7\x4a\x5a\xfc\x6a\x8b\x22\xb5\x5f\x21\x2d\xff\x48\xfc\xc7\x18\xf2\x1c\x65\x46\x66\x42\xaa\xc4\x62\x26\x0d\xdc\x28\x2e\x92\xe3\xf2\x8f\x08\x50\x6b\xa5\x21\x0f\x06\x1a\xed\x42\x4b\x50\x49\xa9\xa3\x54\xd1\x5e\xde\xc9\x9d\xa2\x3d\x79\x15\x46\x79\x8e\xc2\xa0\x53\x19\x43\x35\xe0\x67\xfa\x71\x99\x15\x45\x5c\x29\x8d\x82\x22\x08\x6a\x53\x82\x26\x8c\x97\x4c\xf2\xb4\x1b\xc5\xcb\xf5\x28\xc2\x82\x82\x0a\x4c\x02\x7e\xc5\x74\x61\x95\x8e\x81\xc9\x0c\xe6\x24\x6b\x40\xc9\xd2\x89\x76\xb0\x69\xb5\xa7\x8b\xf7\xe5\x66\x30\xc8\x92\xd2\xf1\x91\xb7\xa9\x15\x92\x4d\x14\x9a\xe9\xfe\x53\x4b\xaa\x13\xa8\x35\x74\xf2\x60\xc0\xa7\xe4\x1e\x11\xb3\x0b\x4d\x0f\xfa\x6d\xb4\x49\x63\x13\xfe\x5f\xdc\x1a\x3f\x1c\x82\xe4\x82\xc0\x1e\xb8\xd8\x85\x4e\xd9\x07\xcd\xe6\x23\xad\x43\xd4\x3a\x8a\x82\x41\xd1\x07\x15\x85\xbb\xc5\xfa\x2d\xc8\x9d\x6e\x40\xb7\x17\xa8\x2e\x4a\x04\xdb\x77\x25\xc6\xe5\xd6\xd8\x3c\x3e\x33\x76\xc4\xfe\xc9\xd2\xe2\x3b\x70\xa9\xa3\xbe\x3f\x5d\x12\x8a\x2b\x25\x47\xdb\x41\xef\x50\x49\xb5\x31\x5a\xc8\x54\xba\x98\xa1\xb4\x8c\x22\x0e\x56\xc1\x42\x66\xa8\x8d\x25\x04\xcb\x08\x01\x61\x04\x5c\x4e\x51\xa3\x4c\xd1\x61\xc7\xdd\x2a\xe6\xa1\x08\xfd\x6d\x99\x54\xd7\xb9\x1e\x82\xb8\xfa\x33\x20\x75\x87\xa5\xd4\x07\x6e\xef\xde\x2f\x50\xaf\x2e\xe6\xa1\xc3\x72\xd8\xeb\xd4\x30\x86\x61\xe9\xd6\x30\x0a\x82\x41\x03\x0e\x11\x47\xc1\x61\x03\xab\x2f\xae\xce\x08\x93\x9c\xe3\x32\x1c\xe6\x79\x72\xf9\xe5\x96\x76\x99\xa2\xf8\x19\xa4\x82\x3c\xef\xec\x4d\x30\xd7\xea\x9e\x67\x98\xb5\xc2\xcc\x95\x1c\x3a\x2a\x04\x83\x7b\xa6\x1d\x77\xdc\x92\xa4\xfb\x39\x58\x9c\xcd\x05\xb3\x08\x43\xcb\x67\x68\x2c\x9b\xcd\x3f\x97\xf0\x7c\xbe\x43\x31\x47\x3d\x84\x04\x8a\x22\xe8\xc6\xe0\x57\xa5\xbe\x98\xda\xea\x9a\xee\x99\x7a\x85\x53\xa5\xb1\xf4\xcf\x4d\x7a\x70\xdd\xd9\x2c\x37\x8d\xff\x64\xbd\xb3\xd6\xa1\x15\x04\x03\xf9\xfb\x09\x4e\xd9\x42\x58\xb7\x5b\xff\x6b\x81\x9a\xa3\x49\xce\x95\xfc\x07\x6a\xe5\x87\xc6\x68\xc3\x1a\x81\x13\xb5\x94\x0d\x06\x1e\x60\x42\xcc\x4f\x8e\x41\x45\xe4\xa2\xeb\x12\x7c\x48\xfd\x2c\xf8\x03\xa6\x5c\x58\xd4\xfe\xef\x57\xab\xa3\x85\x55\x67\x32\xd5\x48\xcc\x07\xab\x17\xd4\x15\x0c\x28\xb7\x32\x94\x96\xdb\x55\x4d\x27\xa6\x11\x04\x4e\x2d\x65\x86\xbd\x43\xc8\x98\x65\x37\xcc\x20\xe0\x3d\x4a\x58\xde\xa1\x04\x83\xb6\xe3\xcf\x21\x18\xab\x67\x8c\xea\x61\x32\x46\x7b\xac\x66\x73\xe1\x14\x85\xcd\xa4\x18\xf6\x3b\xd6\x31\x32\xea\x86\xef\x0b\xae\x28\x6e\x33\xf6\x05\x8f\x59\x7a\x87\x6f\x70\x15\x7a\x93\x63\x68\xd4\x38\xa9\x5e\x3d\xbe\x0c\x90\xec\xbb\x85\x4d\xae\xde\xaa\xf4\x4b\x18\x05\x83\x94\xbe\xc4\xe0\xfe\xc9\x48\xc5\x7e\xf9\x8f\x5f\x70\xf5\xe9\xc1\x8a\xae\xa5\x28\x55\x39\xe2\xfd\xe0\x15\x11\x5b\x96\x22\x86\x92\x31\x3e\x08\xa4\x3e\xed\x2f\x5b\x61\x30\x18\x6c\xd3\x78\x24\x84\x5f\x20\xde\x31\xab\x87\x41\x0f\x9b\xad\x16\xb6\x2d\xd0\xc2\x34\x18\x0c\xc8\xad\x32\x86\xc9\x3d\x13\x0b\x7c\xc7\xe6\x73\x2e\x6f\x63\xca\x01\x68\x78\xfe\x8a\xcb\xcc\x0f\x6d\x63\xf8\x64\x35\xc7\xad\x2c\xa9\x97\x5d\x8a\x28\x18\x54\x19\xdc\xca\xbc\x4e\xea\x0d\x8a\xda\x28\x8d\xf6\xcf\x36\xa9\x03\xe1\x43\xad\xe3\x53\x10\x28\xc3\xa5\x88\x68\xde\x8b\xd2\x87\x32\x8e\x14\xb3\x15\x1c\xc2\x74\x66\x93\xf1\x5c\x73\x69\xa7\xe1\xf0\xec\x7c\x3c\xba\x9a\xc0\xd9\xf9\xe4\x82\x62\xd4\xea\xe5\x8b\x02\xc2\x3c\x4f\xde\xbe\x2f\x8a\x03\x93\xe7\xc9\xd5\x7b\xda\x86\x0e\x0e\xcc\x6f\x47\x6f\xaf\x47\x63\x08\x0f\x4c\x74\x70\x60\x86\x31\x65\x29\x97\xb7\x26\xf9\x3f\xc5\x49\x73\x0c\x43\x3f\x3d\xf6\xf2\xc3\x28\x6e\xa5\xf2\xa5\x60\x29\xde\x29\x41\xbb\x63\x98\x71\x26\x30\xb5\xc9\xb5\xc1\x33\x99\xe1\xd7\xf6\x60\x5c\xb9\x12\xc3\xcb\x18\x5e\x52\x77\x35\x28\x80\x36\xb7\xd2\x2d\x57\x4f\x93\x93\x66\x05\x4f\xa0\x37\xb8\x5a\x2a\xed\xb7\x92\x75\xef\x77\x7b\x7c\x60\x4e\x46\xaf\x8f\xae\xdf\x4e\xa0\xf4\xf2\xc0\x0c\x4b\x4d\x4e\xeb\x37\x2c\x18\x46\x7e\x25\x08\xa3\x03\xd3\x2c\x57\xed\x74\x6e\x33\x72\xbb\x91\x33\xf0\x62\x61\xe7\x0b\x1b\x3b\x32\xad\xae\x1c\xb8\xd4\xbb\x97\x11\x0e\x1a\x7c\xd7\x49\xd8\x46\x7b\x23\x2c\x6f\x99\xb1\x65\xda\x9f\x9d\x74\x83\xa2\xd1\xbe\xef\x63\xc5\x78\xf4\x76\x74\x3c\x81\x75\xf8\xe1\xf5\xd5\xc5\xbb\x4d\x1f\x3f\xfc\x3a\xba\x1a\xc1\x26\x15\x3a\x04\xde\xc7\x8a\x0f\x77\xa8\xf1\x58\xb0\x85\x41\xb7\xb9\xbb\x19\x8d\xd0\x30\x86\x0d\xbf\x36\x08\x53\x14\x2f\xab\xe6\xe7\x45\xdd\xcf\x6c\x49\xb3\x4b\xcd\x67\x4c\xaf\xde\xe0\xaa\xca\xb0\x68\x13\xe9\x41\xd3\xbd\xb7\xf4\x96\x20\x95\xb6\x56\xc7\xdd\x5f\x99\x99\x68\x7e\x7b\x8b\xda\x37\x03\x03\xda\x05\x2f\xae\x27\x97\xd7\x13\x58\x96\xd5\xae\xa4\x08\x37\xa0\xf1\x9f\x98\x5a\xcc\xa8\xa5\xb7\x44\x14\xe3\xa6\x80\xf5\x2b\xc4\x60\x90\x38\x0d\xf6\x0e\xfd\x4a\x65\x2c\xb1\x6a\x25\x0d\x70\x49\xa3\x60\xd8\x0c\xe1\x86\xd9\xf4\x8e\x7a\x1c\x8b\x2c\x23\x81\x35\xfa\xac\xa1\xfb\x0b\xfc\x65\xf8\xe6\x79\xab\x89\x60\xb2\xcd\xc4\xa2\xa8\x60\xce\x73\x4e\x48\x56\xf3\x2e\xdf\xe0\xaa\x6a\x3c\xe1\x05\x0d\xbb\x65\xe1\x10\xc6\xc7\x17\x97\xa3\xcf\x67\x27\xa3\xf3\xc9\xd9\xe4\xff\xc3\x68\x58\xa1\xfd\x18\x1a\xf9\x9a\xf2\xdf\x2f\x1f\x41\x0d\x4f\xa6\xc8\x73\x82\x94\x02\x9f\x6e\x27\x85\x67\x40\x2b\xa5\xd7\x33\xcc\x33\xa3\xac\x1d\xa3\x93\x64\x03\x8a\x87\x06\x7b\x7d\x85\x61\xd4\xb1\xb2\x6d\xc9\x56\x42\xc0\xd5\x68\x72\x7d\x75\x7e\x76\x7e\xba\x41\x89\x47\x63\x5e\x6b\xaf\x2b\xdc\x66\xb9\xeb\x16\xd0\xb6\x29\xad\x91\x78\x57\x45\xac\xbb\x78\xb1\x40\xea\x6e\x34\x4e\x1d\x10\x67\x32\xe3\x1a\x53\x1b\x56\x1f\x7e\xa3\xe6\xe1\x62\x1a\x2a\x0a\xcb\x3d\x13\x9d\x2e\xd9\x0d\x9a\xd7\x5a\xcd\x7c\x19\x0d\x5d\xaf\x11\xc3\x66\xe3\xd1\xb4\xc4\x8f\xad\x06\x61\xeb\xa6\x6d\x2d\x05\x22\x7f\x6a\xd8\x53\xd1\x9d\xd9\x87\xc0\xe6\x73\x94\x19\x99\x68\x88\xba\x9a\xc9\x5b\xec\xcd\x19\x3a\x93\xab\xa4\x26\x77\xf9\x19\x92\xa2\x68\x1d\x34\xa2\x8d\x83\xc4\xda\xc9\xb2\x3e\xd2\xb8\x13\xdd\x09\xde\x2c\x6e\xdf\xa9\x0c\x9d\x41\x84\xd8\x6b\xc7\x64\x21\xc3\x66\xfc\x83\xe6\x16\x75\x15\x3d\x87\x5e\xb4\x7f\x36\xf9\x53\x59\xd3\x50\xb6\x52\x7c\x66\xdc\x64\x3a\x46\x46\x4e\xf7\xd2\x89\x11\x8a\xeb\x4b\x11\x8e\x6e\xde\xba\xce\xe5\x03\xec\x5a\xf6\x59\xe3\x59\x5b\xc5\xa6\x05\x7a\x1b\x45\x37\xc7\xb1\xe3\xc7\xb4\x8b\x6f\xeb\x3a\x74\x0d\xf9\x4a\x86\x4f\x37\x85\xdc\x50\x3f\x1c\x1a\x0d\xb5\xcb\xd5\xad\x0a\x9d\xfd\x13\x3a\xc0\x77\xf3\x86\x7c\x48\x92\x24\x0a\xba\x55\x60\x4d\xb8\xbe\x33\xf0\x1a\x28\x74\x71\x75\xef\xb5\x7d\xb9\x2a\x93\xdb\x2b\xf7\x1b\xfb\xb9\xea\x8c\x1f\x67\x66\x2d\xf6\xdd\x06\x56\xdc\xee\x69\x9c\x9b\xbe\x59\x69\xe3\xae\x8a\xe8\xfe\x2e\x86\xb5\x6b\x85\x85\x24\xf0\xe8\xc8\x5a\x5e\x04\x00\x97\x76\xe3\xa6\xa1\xba\x52\xd8\x81\xe6\x3d\xd3\x20\xe8\xeb\x09\xad\xf0\xbf\xff\xd3\xb1\x8e\x06\xb9\x3b\x2e\x4f\xb9\x3b\x5a\x1b\xf8\xf8\x89\x4b\x8b\x7a\xca\x52\xcc\x8b\x60\x47\x8d\x38\xac\x6a\xc4\xad\xb2\x0a\xdc\x09\xd6\x5f\x49\xec\xb5\xa9\xb4\xa7\x0a\x76\x49\x8e\xa4\x35\x2d\x0b\xa3\x1d\x91\x1b\x69\x3d\x5e\xc9\xf4\x35\xe3\xa2\xd2\xf4\x63\xaa\x04\xdd\xc7\x10\x33\x77\x6c\xe8\x0d\x3a\x24\xd0\x4a\x91\x53\xf4\xc7\x52\xa8\x57\xea\x4c\x9d\x70\x2b\xca\xa3\x74\x3d\xfe\x07\x58\xfa\x78\xcc\xa8\x09\x08\x06\xae\xe8\xd5\x33\x8b\x02\xdc\xa9\x3b\x55\x22\xa1\x13\x57\x51\x84\xa5\xcf\xa5\x5f\x1e\x0f\x57\x65\x9f\x3d\xdb\x1e\xdf\x97\xf0\xec\x19\xac\x8f\x7c\x7c\xf1\x89\xc6\xb6\x34\x10\xd5\xa4\x61\x13\x94\xa2\x18\x7e\xda\x0e\x54\x8b\x0e\xc1\x60\x8d\x0b\x87\x5d\x36\xd0\x1a\x7b\x8a\x7f\x30\x18\xf4\x97\xff\x6e\x82\xd4\xfc\x78\xc2\xa2\x5f\x1d\x28\x1e\x50\xf7\xbb\x6e\x96\x05\xe6\x2f\xdb\x04\xb6\xda\xb9\xdc\x6b\x9d\x0f\xdf\x96\xd8\xb5\x2a\x9e\x3b\x59\x5d\xa9\x65\x43\x2b\xf7\xa5\x6f\xed\x64\x9c\x32\x19\x56\x6d\xc9\xa5\xd5\xdb\x9b\x92\x16\x3b\x49\xb2\x1b\xb0\x56\xe1\xac\xb4\x6f\x2d\x9e\x7f\xa2\x3d\x15\xc3\x9e\xa0\xee\xce\xd5\x7c\xe1\x2e\x65\xb3\xf2\x6c\x4f\xc5\x7e\x81\xc6\x5d\xea\xf6\xd6\x61\x1f\x8f\xa2\xd8\x51\x35\x7f\xa8\xaa\x66\x2f\x84\x3b\x30\x5c\xdb\x70\xbe\x27\x4c\x1d\xdc\x1e\x05\xdc\x13\x1b\x51\x81\xd5\xba\x59\xe9\x0f\xcb\x37\xee\xe7\x4f\xb6\xa1\x17\xc1\x93\x30\x6a\xef\x4e\x3e\xf0\xa7\xbd\x20\xd8\xdf\xf6\xb5\x0b\xf9\xcf\x41\x6b\x53\x5f\xbb\x92\x7d\xd8\x9d\x6e\x75\x77\xfc\x80\xe9\xee\xae\x18\x0e\x4b\x4a\x3c\x58\x41\x7d\x67\x3c\xd8\xf1\x8c\xe1\x23\xaa\x92\x4c\x1d\x4d\x2d\xea\x6f\x7a\xc2\xf0\x5b\x5a\xcd\x02\xbf\xa8\xe4\xa2\xbd\xd9\x15\xc1\xee\x5f\x04\x1c\x09\x71\xea\x5b\x2f\x03\x4c\x08\xd0\x6a\xd9\x5c\x7d\x08\x9e\x22\x5d\x95\x54\x8f\xa1\x47\x42\x34\xef\x68\xbd\x2f\x4e\x63\x12\xa9\xde\xd2\x68\xf1\x27\x7b\xef\x8c\x41\xcd\xad\x81\x24\x49\x1c\xcb\x6b\x0d\x17\xee\x97\x09\x26\x82\x90\xcb\xb2\x71\x57\x3a\xea\xf9\xbd\xc0\x91\x10\x4f\xfb\x36\x5a\x1a\xe4\xf2\xb1\xe7\x41\x7a\xcb\x0b\xf4\x91\x10\x97\xfb\xe2\xbd\xe7\x45\xfa\xf1\x20\xfc\x5d\x3f\x0f\xd8\x0b\x19\x97\x96\x90\x92\xf1\xfa\x2b\x76\x0d\xd6\x03\x32\xa1\x0f\x90\x9e\x32\xb6\xeb\xe1\xba\xc9\x9e\x6d\x6f\xd8\x47\x42\xec\x86\xad\xbc\x5f\x2c\x27\xc7\x8d\xd0\x2b\xba\x38\x1c\xf3\xdf\xd1\x49\xd0\x7a\xa1\xd2\x4e\xaa\x19\x51\x53\x67\x78\x04\x73\xd4\x60\x35\x93\x86\xa5\x14\x20\x7a\xa0\x28\x7f\x59\xa2\x24\xc2\x5c\xe3\x9c\x69\xcc\xc0\x58\x66\xdd\x43\x1b\xad\x46\x22\xae\xa6\x3b\xfd\x5c\x02\x6b\xaf\x90\xc0\x85\x27\x10\x70\xeb\x9f\x3b\x8c\xd3\x2e\x17\xb3\x1b\xd4\xa0\xa6\xb5\x5d\x4c\x68\x64\x19\x3d\x27\xce\x66\xdc\x5a\xcc\xca\xc4\x6f\x21\xe7\x2c\x46\xf3\x48\xf2\xfd\xa7\x72\x6f\xbd\x5c\xfc\x29\xcf\xee\x47\x42\xac\xbf\xbc\xd3\x61\x54\xcd\x2d\xf4\x9a\x55\xb7\x54\x25\x21\xea\x36\x8a\x04\x0e\x9d\x47\x1f\x5f\x7c\x22\xc6\x0e\x0c\x31\x87\x32\x66\x6e\x93\x9a\x4a\x4e\xdc\x8d\xd4\xc7\xd6\xf2\xaf\x1e\x42\xee\x38\x9e\xf8\x64\xd8\x84\x3e\x24\xe8\xca\x0b\x5e\x45\x2f\x4e\xfc\x77\x74\x39\x9e\x10\xfc\x02\x27\x6d\xee\xd2\x36\x11\x6e\x42\x1d\x03\xa7\xce\xa0\xf5\x7b\x87\xba\x50\x7f\xe4\x9f\xbc\xba\xb0\x93\xd7\xd4\x33\x6c\xdb\xee\x36\x6d\xec\x69\x7d\xbe\xc5\xde\x35\xae\x3d\xcc\xec\x76\xbb\xb5\x69\xbb\xdf\x90\xf3\x1c\x65\x06\xcf\x8b\x22\xf8\xf7\x00\xb6\x4e\x4a\x5c\x29\x28\x00\x00")
 
 func templates15_insertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -429,11 +456,11 @@ func templates15_insertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/15_insert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x13, 0xa3, 0x7e, 0x3e, 0xd8, 0xda, 0x3e, 0xd6, 0x78, 0x13, 0xdd, 0xd4, 0xbb, 0xc9, 0x46, 0xec, 0x92, 0x91, 0x6f, 0xad, 0xb9, 0x91, 0x6a, 0x8, 0x6d, 0x64, 0x60, 0xf8, 0x24, 0xb7, 0xc2, 0x23}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0x41, 0x9e, 0x9c, 0x29, 0x98, 0x8f, 0x8b, 0x43, 0xb7, 0x46, 0xa5, 0x4f, 0xca, 0x5b, 0xe7, 0x4b, 0x46, 0xfb, 0x31, 0xf6, 0xe9, 0xcb, 0xa5, 0x16, 0xda, 0x8a, 0x4d, 0x94, 0x78, 0xd0, 0xa1}}
 	return a, nil
 }
 
-var _templates16_updateGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x5b\x6f\xdb\x38\x16\x7e\xb6\x7f\xc5\x19\x63\x07\x90\xb6\xae\xda\x05\x16\xfb\xd0\x45\x1e\xdc\x36\x93\x29\xa6\xed\x3a\xb7\xcd\x43\x51\x04\x8c\x74\x64\x73\x42\x93\x0e\x49\xd5\x31\xbc\xfa\xef\x8b\x43\x52\xb6\x7c\x51\x62\x3b\xb7\x99\x79\x4a\x2c\x1d\x9d\xeb\xc7\xc3\x8f\x87\xb3\xd9\x6b\xf8\x1b\x13\x9c\x19\x78\x77\x00\x49\x8f\xfe\x43\x93\x9c\xb1\x2b\x81\xe0\xff\x24\x5f\xd9\x08\xe1\x75\x59\xb6\x9d\xb0\x49\x87\x38\x62\x5e\x80\x3e\xa9\xc9\xfc\x0f\x92\xd3\xc5\x5b\xf7\x01\xcf\x21\xe9\x65\xd9\x91\x50\x57\x4c\x38\x25\x6f\xde\xc0\xf9\x38\x63\x16\x8f\x80\x81\xe1\x72\x20\x10\x66\x33\xef\x43\x72\x3e\x3e\xe5\x72\x50\x08\xa6\xcb\x12\x34\xa6\x4a\x67\x50\x90\x10\xd8\x21\xc2\xc0\x6b\xc1\x5b\x4c\x0b\xab\x74\x42\xba\x4e\x11\x83\x3e\xc8\x95\x86\x91\xd2\x08\x99\x4a\x8b\x11\x4a\xcb\x2c\x57\x32\x69\xe7\x85\x4c\x21\x52\xf0\xf7\x8d\x66\xe2\xca\x9d\xc8\x79\x2b\x95\x85\xe4\xab\xfa\xa0\xa4\xc5\x5b\x5b\x96\xa9\xbd\x85\xd4\xff\x48\xc2\xc3\x2e\xcc\x66\x28\x33\x8a\x06\x52\x25\x8a\x91\x34\x70\xa5\xb8\x48\x3e\xf8\x1f\x31\xf8\xb8\xbf\xaa\x13\x35\x31\xbd\x3c\xc7\xd4\x62\x56\x96\xa8\xb5\xd2\xb3\x19\x0a\x83\x65\x19\x71\x69\xff\xf5\xcf\x2e\xb8\x87\xf1\x42\xe1\xac\xdd\xd2\x68\x0b\x2d\x41\x25\xde\xb1\xa8\xd2\x36\xf7\xc9\x19\x3b\x42\xfb\xf1\x7d\x14\x57\xfa\x52\x7b\xdb\x85\xea\x45\x90\x0c\xef\x65\x56\x96\xdd\xca\xd3\xb8\x5d\xb6\xdb\x73\x73\xed\x45\x89\xfa\x4c\xf2\x74\xb9\x42\x7d\x28\x0c\x1a\x60\x72\x9e\x72\xb0\x0a\x0a\x9f\x6d\x2a\xc8\xc6\x84\x76\x81\xc9\x0c\xc6\xa4\xce\x80\x92\x3e\xc2\xc7\xad\x55\x7f\x3d\x27\xe4\xa1\x8f\xff\x30\xf8\x5a\xcb\xcc\x7a\x05\x17\xe2\xe1\x51\xed\xab\xa5\x7c\x6d\xaa\x6c\xc0\xc8\x72\x75\x5d\x3d\x97\xea\xd8\x2c\xab\xfd\xaf\x00\x24\x87\x0c\x5a\x4b\xcb\x15\xdf\x80\xc4\x3a\xf2\x28\x82\x5a\x55\x5b\x3c\xa7\x4c\xc3\x4f\x07\x20\xb9\x20\xf3\x2d\x57\x82\xc8\xf9\x7f\xa1\xd9\xf8\x50\xeb\x08\xb5\x8e\xe3\x76\xab\x24\xe7\x5e\x43\x93\x7b\xed\x39\x06\x83\xa3\x24\x5e\x21\x66\x03\x7c\xa8\xde\xb5\x55\xde\x80\xa6\xa3\xfe\x83\x17\x3c\xf4\x9f\x12\x55\x47\xfd\xc7\x6e\x01\xcf\x03\x94\xc7\x6b\x0d\x2f\x04\xa2\x39\x44\xf6\xea\x37\x73\x10\xd4\x0b\x10\x12\xe4\x7f\x9d\xa2\x5d\x46\x84\x53\x2b\x33\xd4\xc6\x12\x76\x7d\xfc\x20\xb8\xb1\xc0\x65\x8e\x1a\x65\xea\xc1\xe4\x6d\x9b\xa4\xe6\x62\xa6\xd0\xb8\x88\x59\x61\xd5\x88\x59\x9e\x32\x21\xa6\x75\x2f\x03\x8c\xb9\x84\x94\x19\x04\x95\x43\x86\x39\x2b\x84\x85\x1f\x4c\x14\x68\x12\x38\x37\x08\xc9\x09\x0a\xc5\xb2\x28\x26\x67\x34\xe6\x1a\xcd\xb0\xf6\xb9\xd9\x16\xb5\x2f\xdb\x0a\xf7\xde\xe4\x08\x3a\x16\x47\x63\x41\x59\xeb\x58\x3e\x42\x63\xd9\x68\x7c\xe9\xf3\x78\x39\x44\x31\x46\xdd\x81\xc4\x23\xa4\xf5\x83\x69\x87\x4c\xa7\x69\x79\xc5\xfc\xaa\xd4\xb5\x71\x62\x15\x7c\x69\x81\x64\xea\x3d\xe6\x4a\xa3\x4f\x92\x93\xd9\xba\xad\xc6\xff\x5e\x5d\x05\x01\xc9\xcd\x0b\xf5\xed\x92\x0e\xad\xc3\xf2\x98\x43\xbc\x75\x8d\x53\x5a\xb9\x23\x76\x8d\x1f\x58\x3a\xc4\xdf\x70\x1a\x85\xbc\x76\xc9\x4c\x4c\xe2\xa1\xcc\x1f\xd5\x44\x2e\x0a\x1d\x90\x4c\x1f\x7d\x29\x6c\x72\xf2\x59\xa5\xd7\x51\xdc\x6e\xa5\xf4\xa4\x0b\xee\x4f\x46\xba\xef\xff\xfe\xdb\x35\x4e\xbf\x6f\x6d\xe8\x5c\x0a\x6f\xca\x25\xf6\xa7\x60\x88\xd2\x31\x11\x64\x2f\xdd\xbc\xd4\xa2\x76\xab\xd5\x64\xa2\x27\x44\xc0\x4f\xf7\x0e\xa9\xbe\xe6\x23\xa6\xa7\xbf\xe1\xb4\x26\x1c\xb7\x5b\xbe\xec\xc9\x47\xce\x04\xa6\x36\x39\x37\xd8\x2b\xac\x0a\x32\x1e\x01\xe4\xda\x01\x18\xab\x47\x8c\x36\x9a\xe4\x94\x3a\xde\x68\x2c\x90\xd6\x7e\x34\x11\xdd\xa6\x2c\x05\x2d\x17\xdc\x0e\x49\xa9\xb7\xe6\xf0\x5f\xd9\x0d\x75\xa7\xb7\x67\x15\x5c\x8d\x7b\xed\xb2\x13\x92\xf1\xc9\x5c\x0c\xb9\x45\xea\x25\x51\xec\x92\x75\xbf\x4b\xdf\xbe\x1b\xab\xb9\x1c\xcc\x3a\xa9\x46\x66\x31\xbb\x64\xb6\x53\x92\x0b\x65\xe5\x46\x88\x8e\xe7\x20\x50\x46\x13\x11\xc3\xc1\x01\xbc\xf5\xfa\x77\x06\xa7\xd2\x26\xf9\x8a\x93\xa8\x33\x9b\x25\xfd\xeb\x01\x71\xf7\xb2\x7c\x07\x85\x74\xa4\x7e\xd1\x72\x67\xb3\x1a\xbb\xf7\x8d\xa0\x10\x99\xb3\x71\x55\x70\x91\xc1\xa4\x0a\xb5\xe3\x9d\x6d\xb7\x3c\x2a\x93\x9b\x02\xf5\x14\x0e\x20\x1f\xd9\xe4\x74\xac\xb9\xb4\x79\xd4\x39\xef\x7f\xec\x9d\x1d\x52\x01\x4c\xfd\x94\x00\xa7\x87\x67\xf0\xb3\x81\x8b\x5f\x0f\x4f\x0e\xe1\x67\xd3\x71\xd0\x58\xca\x57\x9f\x69\x36\x22\x27\x8c\xf3\xf9\xf3\x71\x59\x76\xba\x40\xff\x9e\xf8\x7f\xd7\x80\xf1\x49\x66\x78\xdb\x17\x2c\xc5\xa1\x12\xd4\xe8\xcb\xf2\x1f\x55\x57\x7a\x3b\x6f\x6c\x13\x11\xaf\x18\xbb\x18\xa2\xc6\x0f\x82\x15\x06\x1f\x60\x2a\xd4\xe8\xd5\x06\x93\xdb\x42\x3e\xae\x30\xef\x13\xea\x76\x8e\x2f\x6c\x3c\xe6\x72\xd0\x0d\x4d\x8e\x92\xcc\xd1\x24\xef\xb9\xcc\xc2\xab\xa8\x41\xfd\xd9\x74\x8c\x8d\xb6\xe7\x6a\xd9\x78\x8c\x32\xbb\x6b\x95\xac\xb9\x99\x24\x09\x71\x81\x0d\xc4\x61\x9f\x9e\x49\x78\xf7\xdd\x9e\xf6\x49\xea\x31\x55\x8c\xff\x75\x4f\x7e\xd1\x6a\x54\x45\xaa\x31\x77\x15\xf8\x24\x33\xae\x31\xb5\xf3\x07\x4e\xf4\x3f\x79\xa4\xe2\x38\x74\xc7\xa5\xec\x51\x3b\x5b\xd9\x32\xe7\x9b\x87\xdb\xe0\x3e\xe2\x55\x31\xf8\xa2\x32\x74\x61\x10\x82\x7f\x71\x08\x16\x32\x5a\xbc\xbf\xd0\xdc\xa2\xae\xf4\x3b\xb8\xc7\xf7\x4b\xfb\xb8\x2a\xee\x44\xd0\x58\x36\xfd\xc9\x38\xf1\x28\xb5\xb7\xbe\x77\x4c\xdc\x87\x94\x88\x55\x65\x94\x0a\x27\xb7\x6a\x75\xb2\x85\x67\x93\xcd\xfe\xcc\x37\xab\x4d\x5b\x7b\xe8\x40\x1b\x53\x77\x59\x41\x92\x76\x4f\xc7\x3a\xa2\x9a\xf9\xca\x0e\x61\xc5\xf7\xb3\x79\xe0\xeb\x1f\x06\xbd\x91\xdb\x96\xef\x54\x52\x35\xc5\xba\x3e\xa2\x09\x1a\x0d\x71\x2d\x73\x23\x92\x13\xf7\x6f\x93\xd7\x5e\x70\x5f\xd7\x1b\xbe\xde\xcb\xff\xea\xdf\xf5\x55\xb4\x57\x6f\x27\xa2\x4e\x2c\xdd\xf7\xad\x1d\x3a\x3c\x51\xf5\x4e\xec\xd7\x60\x93\xc9\x10\x7d\x75\x30\x09\x27\x12\x9f\x8d\xa4\x2e\x18\x6d\x3c\x4f\x84\x80\xde\x76\xef\x75\x36\x67\x5c\x60\x46\xce\x0e\xd0\xba\x43\x04\xb0\xca\x87\xab\x39\xe1\x26\x96\xbe\x12\xc5\x22\x82\x05\xa2\x57\x08\xcc\x76\x0c\xa8\x62\x5a\x5b\x88\x3b\x66\x05\x07\xbe\xe2\x5b\x1b\x98\x33\xac\xb5\x8c\xd7\x48\xed\xbd\x10\x58\x3e\x24\x3a\x20\x10\xff\xed\xe5\x16\xf5\x5e\xf4\xd7\xb3\xf3\x3a\xd4\x77\xf7\x40\x72\x11\xd4\x38\x0e\x75\xcf\xa4\xa9\x27\x44\xbf\x3a\x6c\x01\x13\xc2\x97\x7b\xc2\xed\x10\x46\xcc\xa6\x43\x2e\x07\xd5\x29\x4d\x12\x0d\x68\x98\x31\xf9\x13\xd3\x4d\xd3\xee\x75\xec\x9a\x61\xcd\xe4\xf3\x9c\x9d\x0c\x7c\x79\x9a\x79\x40\xb5\xe6\xdd\x46\x99\xcc\xc3\xda\x6d\x7c\xf4\x72\xc7\xfe\xbb\xc7\xc3\x3d\x21\x8e\x1a\x20\x41\xa7\x64\x33\xc6\x94\xe7\x1c\xe7\xa7\xf7\xd0\x5e\x77\xc5\xc0\xde\x63\xdf\x45\x55\x1f\x3a\xe8\x5d\x2b\xdd\x63\x0c\x74\xd6\x06\xbd\x4b\x99\x7d\x86\xc4\x3e\xf7\xda\xda\xbb\x0a\x15\xc5\x3c\x45\x1b\x26\x2a\x37\xc9\xb1\xdf\xb2\x7d\x1e\xf7\xe6\x43\x6e\x59\x3a\x55\x9e\x58\x84\xe6\xba\x89\x01\xad\x88\x2e\xb1\x88\xda\x67\x9b\x38\xcf\xfd\xe4\x66\x1b\x3f\xee\x90\xdf\xc2\x99\x17\x23\x30\x84\xdf\x3b\x29\xc0\x0b\x93\x98\x85\xc3\x1a\xad\xe6\xf8\x03\x57\x98\xcc\x96\xfc\x65\xdf\x2d\xb8\x7c\xd2\x2e\xab\x36\x4f\x64\x4f\x05\x4f\xf1\x8f\xd5\x63\xd5\xb3\xf4\xd8\x1d\x6e\x43\x28\x2d\x4d\x94\xe7\x8e\xcc\xdf\x49\x7c\xb6\x2d\xc7\xde\xf7\x1c\xcf\xc2\x64\x9e\xaa\x54\x2f\x79\x43\xb6\x3b\xed\x7d\x62\x0c\xfc\x95\xa8\xef\x1a\x60\xfe\xf8\xd4\xf7\x61\x5c\xac\x1b\xae\x48\x6b\x17\x65\x3b\x96\xff\x4f\x43\xce\x84\xa4\x0a\xbb\xf7\x91\x40\x19\xa9\xd8\xd7\x4c\xc8\xc5\x00\x7c\x37\x8a\xe1\x0e\xa6\x61\x24\x40\x1a\x1d\x0e\xf6\x55\x76\xc7\x30\x7d\xc1\x4f\x34\xde\x14\x5c\x53\x81\x2d\x08\x64\xc6\x82\x92\x58\x55\x94\xe9\x81\xbb\x97\xac\x36\xfd\x54\x09\x37\xe8\xae\x2e\x8b\xa2\xea\x72\xa0\xbb\xf0\x36\x6e\xb7\x98\x1e\xd4\x45\xb8\xb4\xa8\x73\x96\xe2\xac\x5c\x92\x6b\xb7\x38\x49\xbd\x6d\xb7\x88\x67\xd0\xd1\x39\xcc\xa1\x1c\xdd\x61\x72\x80\xbe\x4e\x14\x79\x65\xf9\x1b\xff\x0e\x07\x4e\xb6\xdd\x72\x76\xfc\x03\xf7\x59\xbb\xd5\xe2\xaf\x5e\x79\x4f\xdf\xbc\x81\x9e\x1b\x18\xbb\x20\x55\xee\x10\x3b\xf6\x03\x62\xb8\xc6\x69\x00\xab\x63\x38\xc8\xd2\x61\x88\xd8\xbb\x72\xd9\x05\x75\xf5\xfb\xc2\x0b\xe5\x97\xde\x35\x4e\x7b\x21\xb2\x87\x4c\x7e\xaf\x7e\x8f\xe3\x2d\x66\xd8\xf3\x89\xb0\x8f\x13\x0e\xaa\x09\x38\xfd\xea\x42\xe5\x8d\x1f\xd9\x51\xc8\xe6\xc6\x5d\x7c\xed\x7d\xab\xf1\x2c\x97\x1a\x55\x1d\xe3\x65\x8b\xb5\x9b\x8d\x13\x1c\xbb\x6b\xa6\xc8\x23\x2b\xca\x82\x89\xcf\xc7\x71\x17\x56\x9e\x9d\x1c\xc7\xdb\xdf\x79\xcc\x4d\x3f\xe4\xe6\xc3\x03\x58\xc5\xf1\xe3\x4e\xea\xcd\x8d\xd8\x62\x42\xcf\x6a\xf5\x7e\xf2\x11\xfd\x26\x97\x26\x0d\x8e\x3c\x74\x36\xff\xae\x3e\xe1\x36\x37\xa2\x6e\xa1\xe1\x40\xba\x79\xa6\xbd\xe1\xdb\xbd\x4f\xa5\xdb\x79\xd4\xf4\xd1\x0e\x6e\xbd\xe8\xf9\x94\xcb\x26\xec\x83\xa1\x7d\xf9\xcf\x73\x58\x0d\xd1\xd4\x62\x6b\x08\xac\xb3\x8e\xdb\xfd\x4f\xb0\xff\x0f\x00\x00\xff\xff\x9e\x99\xbb\x40\xa4\x2a\x00\x00")
+var _templates16_updateGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5a\x5f\x6f\xe3\x36\x12\x7f\xb6\x3e\xc5\xac\x71\x2d\xa4\x3b\x55\xd9\x03\x0e\xf7\xd0\x43\x1e\xdc\xdd\x34\x0d\xba\x7f\xbc\xf1\xe6\xf2\x50\x14\x0b\x46\x1a\xdb\x6a\x68\xd2\x21\xa9\x78\x0d\x57\xdf\xfd\x30\x14\x29\x4b\xb6\x9c\x38\xce\x9f\x2d\xee\x69\x1d\x89\x9a\x19\xce\xfc\x66\xf8\x9b\xe1\xae\x56\x3f\x40\x3e\x06\x21\x0d\x24\x9f\xd9\x15\xc7\xe4\x4c\x9f\x23\xcb\x3e\x0a\xbe\x84\x1f\xca\x32\xa0\x05\x7f\x63\x3c\x67\x1a\x7e\x3c\x86\x64\x40\xbf\x50\x57\x6b\xfd\x27\x1f\xd8\x0c\xd7\x8b\x75\x3a\xc5\x19\xb3\x6f\xec\x27\x8d\x35\x7f\x42\x32\x5a\xbf\xb5\x1f\xe4\x63\x48\x06\x59\x76\xca\xe5\x15\xe3\x56\xc8\xd1\x11\x5c\xcc\x33\x66\xf0\x14\x18\xe8\x5c\x4c\x38\xc2\x6a\x55\xd9\x90\x5c\xcc\x47\xb9\x98\x14\x9c\xa9\xb2\x04\x85\xa9\x54\x19\x14\xb4\x08\xcc\x14\x61\x52\x49\xc1\xaf\x98\x16\x46\xaa\x24\x38\x3a\x82\x11\xa2\x93\x07\x63\xa9\x60\x26\x15\x42\x26\xd3\x62\x86\xc2\x30\x93\x4b\x91\x04\xe3\x42\xa4\x10\x4a\xf8\x7b\xa7\x9a\xc8\x9b\x13\xae\x56\xde\x55\x1f\xe4\x1b\x29\x0c\x7e\x35\x65\x99\x9a\xaf\x90\x56\x7f\x24\xee\x61\x0c\xab\x15\x8a\x8c\x76\x03\xa9\xe4\xc5\x4c\x68\xb8\x92\x39\x4f\xde\x54\x7f\x44\x60\x25\x25\x1f\xe4\xb9\x5c\xe8\xc1\x78\x8c\xa9\xc1\xac\x2c\x51\x29\xa9\x56\x2b\xe4\x1a\xcb\x32\xcc\x85\xf9\xf7\xbf\x62\xb0\x0f\xa3\xb5\xc0\x55\xd0\x53\x68\x0a\x25\x40\x26\x95\x61\xa1\x97\x56\xdb\x64\x95\x9d\xa2\x79\xfb\x53\x18\x79\x79\xa9\xf9\x1a\x83\x7f\xe1\x56\xba\xf7\x22\x2b\xcb\xd8\x5b\x1a\x05\x65\x10\xd4\xea\x82\x75\x88\x86\x4c\xe4\x69\x3b\x42\x43\x28\x34\x6a\x60\xa2\x76\x39\x18\x09\x85\xb5\xca\x06\xa4\xd3\xa1\x31\x30\x91\xc1\x9c\xc4\x69\x90\xa2\xda\xe1\xd3\xc6\x6a\xb8\xed\x13\xb2\xb0\xda\xff\x89\xb3\xb5\xe1\x99\xed\x08\xae\x97\xbb\x47\x8d\xaf\x5a\xfe\xea\x8a\xac\xc3\x48\x3b\xba\x36\x9e\xad\x38\xee\x5e\xab\xaa\x2f\x1d\x90\x2c\x32\x28\x97\xda\x11\x77\x5f\x3a\xfb\x5c\x84\xd7\x0a\x68\x07\x8d\xa8\xf6\xf2\x31\x79\x1a\x5e\x1d\x83\xc8\x39\xac\x82\x5e\xcf\x86\x20\xb4\xf6\x5f\x2a\x36\x3f\x51\x2a\x44\xa5\xa2\x28\xe8\x95\x41\xaf\x59\x19\x36\xcd\x0b\x6a\x0c\x3a\x43\x83\x5e\xad\xb7\x0b\x3e\x14\xef\x46\x96\xef\x40\xd3\xe9\xf0\xd1\x09\x0f\xc3\xe7\x44\xd5\xe9\x70\xa7\xe3\x0f\x2c\x01\x2f\x03\x94\xa7\x2b\x0d\xdf\x08\x44\x35\x44\x0e\xaa\x37\x35\x08\x9a\x01\x70\x0e\xaa\xf2\x76\x84\xa6\x8d\x08\x5b\xc6\x44\x86\x4a\x1b\xc2\x6e\x15\x41\xe0\xb9\x36\x90\x8b\x31\x2a\x14\x69\x55\xa2\xaa\x5a\xa7\x93\x35\x8a\x21\x93\xa8\xed\x8e\x59\x61\xe4\x8c\x99\x3c\x65\x9c\x2f\x9b\x56\x3a\x18\xe7\x02\x52\xa6\x11\xe4\x18\x32\x1c\xb3\x82\x1b\xb8\x65\xbc\x40\x9d\xc0\x85\x46\x48\xce\x91\x4b\x96\x85\x11\x19\xa3\x70\xac\x50\x4f\x1b\x9f\xeb\x7d\x51\xfb\x6d\x4b\xe1\xc1\x87\x5c\x13\xf3\x4e\xaf\x45\x46\x8f\x6c\x3c\xae\x54\x5d\xe6\x66\xfa\xa9\x40\xb5\xfc\x38\x0f\x2d\x88\xfb\x9d\x9e\xe8\xc7\xd0\xaf\x7c\xd1\x8f\x82\x26\xca\x6c\x91\x33\x38\x9b\x73\x0a\x4d\xdf\xe4\x33\xd4\x86\xcd\xe6\x5f\xaa\x60\x7d\x99\x22\x9f\xa3\xea\x43\x62\x35\x07\xbd\x5b\xa6\x6c\x0d\xb5\xe6\xb6\x4d\xfc\x45\xca\x6b\x6d\x97\xf9\x1c\xa1\x2c\xcc\xe4\x4f\x38\x96\x0a\x2b\xed\x76\xcd\xde\xb5\x3b\xfa\xcf\x66\xaa\xb9\x74\x59\xad\x76\xa5\xd4\xeb\x96\x0c\xa5\x5c\x0e\xba\x27\x41\xd0\xbb\xc6\x25\x95\x87\x19\xbb\xc6\x37\x2c\x9d\xe2\xaf\xb8\x0c\x5d\xf0\x62\xca\xe8\x28\xe8\xd5\x1e\x7c\x2b\x17\x62\xed\x43\x97\x2e\xf4\xd1\xfb\xc2\x24\xe7\xef\x64\x7a\x1d\x46\x41\x2f\xa5\x27\x31\xd8\x7f\x32\x92\x7d\xff\xf7\xbf\x5d\xe3\xf2\xf7\xbd\x15\x5d\x08\x5e\xa9\xb2\x8e\x7d\xe5\x14\x91\x3b\x16\x9c\xf4\xa5\xdd\xf9\x1c\x06\xbd\xde\x2e\x15\x03\xce\x1d\x48\xe3\x3b\x56\x0d\x55\x3e\x63\x6a\xf9\x2b\x2e\x1b\x8b\xa3\x80\xd6\x13\x23\x7a\x9b\x33\x8e\xa9\x49\x2e\x34\x0e\x0a\x23\xdd\x1a\x8a\x5e\x65\xda\x31\x68\xa3\x66\x8c\xe8\x6b\x32\x42\xf3\x46\xce\xe6\x1c\xe9\xc8\x09\x17\x3c\xde\xe5\x25\x27\x85\x70\x4d\x42\x2b\x6d\x36\xc9\xbc\x5e\x17\x77\x7a\xfb\xd9\xc3\x55\x5b\x9d\xd6\x3b\xce\x19\x67\xfa\x72\x9a\x1b\xa4\x82\x15\x46\xb6\x4c\xdf\x6f\xd2\x6f\xbf\x6b\xa3\x72\x31\x59\xf5\x53\x85\xcc\x60\xf6\x85\x99\x7e\x49\x26\x94\xde\x0c\xb7\xbb\x7c\x0c\x1c\x45\xb8\xe0\x11\x1c\x1f\xc3\xeb\x4a\xfe\x83\xc1\x29\x95\x4e\x3e\xe0\x22\xec\xaf\x56\xc9\xf0\x7a\x42\x0d\x42\x59\xfe\x08\x85\xa0\xde\xa0\x51\xd7\x57\xab\x46\x9b\x51\x11\xaf\x82\x67\x36\x01\xae\x8a\x9c\x67\xb0\xf0\x5b\xed\x57\xc6\x06\xbd\x0a\x95\xc9\x0d\x95\x06\x38\x86\xf1\xcc\x24\xa3\xb9\xca\x85\x19\x87\xfd\x8b\xe1\xdb\xc1\xe7\x13\x0a\x40\xa3\x51\x29\x4b\x18\x9d\x7c\x86\xef\x34\x5c\xfe\x72\x72\x7e\x02\xdf\xe9\xbe\x85\x46\xcb\x5f\x43\xa6\xd8\x8c\xcc\xd4\xd6\xe6\x77\x9f\xaa\xca\xb2\x5a\x25\xe7\xd5\xcf\x2d\x60\x9c\x89\x0c\xbf\x0e\x39\x4b\x71\x2a\x39\x9d\x26\x65\xf9\x4f\x5f\xfa\x5e\xd7\xd5\x73\xc1\xa3\x0d\x65\x97\x53\x54\xf8\x86\xb3\x42\xe3\x23\x54\xb9\x18\xfd\xa3\x43\xe5\xbe\x90\x8f\x3c\xe6\x2b\x87\xda\xe3\xe9\x3d\x9b\xcf\x73\x31\x89\x5d\x91\x23\x27\xe7\xa8\x93\x9f\x72\x91\xb9\x57\xe1\x0e\xf1\x9f\x97\x73\xdc\xa9\xbb\x16\xcb\xe6\x73\x14\xd9\x5d\x59\xb2\x65\x66\x92\x24\xc4\x5a\x3b\xd8\xc9\x21\x35\x93\x8a\x26\xa1\xc8\xee\xd6\xb6\xbd\x7e\x8f\xff\xb5\x4f\x7e\x56\x72\xe6\x77\xaa\x70\x6c\x23\x70\x26\xb2\x5c\x61\x6a\xea\x07\x76\xe9\xc7\x71\x28\xa3\x28\x86\x6d\xef\x51\x39\xdb\x38\x97\xeb\xc3\xc3\x1e\x6d\x6f\xf1\xaa\x98\xbc\x97\x19\xda\x6d\x10\x82\x7f\xb6\x08\xe6\x22\x5c\xbf\xbf\x54\xb9\x41\xe5\xe5\x93\x95\xcb\xe8\xfe\xd5\xd6\x0e\xed\x09\x1a\xa1\xb1\xad\xfa\x4c\xdb\xe5\x74\xa2\x46\x56\xfb\xc2\x7e\x48\x8e\xd8\x14\x46\xae\xb0\xeb\x36\xb5\x2e\xf6\xb0\x6c\xd1\x6d\x4f\x7d\x58\x75\xf1\x07\x57\x81\x3a\x5d\xf7\xc5\x43\x92\xf8\x4d\x42\x24\x25\x6c\xa8\xf7\x7a\x08\x2b\x41\xaf\xb5\xf1\xfa\xc3\x9a\x13\x39\xb9\xb4\xb5\xd8\x37\x52\x77\x89\xf2\xa5\xb1\x29\xf5\x96\x29\x50\xa8\x89\xd6\xe9\x1b\x9e\x9c\xdb\x9f\xbb\x6c\xaf\x16\x1e\xba\x81\xf6\xd7\x4f\xb1\x0b\x91\xb5\xb8\xcc\x63\x48\x08\xd5\x79\xea\x0c\xa8\xb7\x8c\xe1\x81\xd5\x1e\x94\x5c\x50\x59\xaf\xf1\xd0\xa1\xd2\xf9\xc0\x77\x42\xae\x05\xaa\x7c\x92\x34\x17\x86\xd1\x1d\x1b\x7a\x1d\xdf\x6b\xec\x98\xe5\x1c\x33\x3a\x9a\x26\x68\xc8\x32\x0d\xcc\xdb\x70\x55\x33\x7c\x6a\x0b\x36\x76\xb1\xde\x81\x77\xec\x16\x99\xd9\x8f\x0d\x79\xd6\xb5\xc7\x72\xcb\xb2\xe0\xb8\xaa\x0d\x7b\x2b\xa8\xd9\xd6\x96\xc7\x1b\x04\xf7\x5e\x08\xb4\xbb\x52\x8a\x8f\xe5\xc2\x83\xb1\x41\x75\x10\x15\x26\xd7\xfd\x00\x4d\xc0\x3f\xdc\x02\x91\x73\x27\xc6\xf2\xa9\x66\x5b\xe9\x8e\x91\xbb\xba\x4b\x49\x03\x51\xea\xbe\x04\x9b\xa1\xef\x08\x35\xf5\x6e\xbb\xe7\x5c\xa4\x80\x23\xbb\xf5\xd3\x0a\x69\xa6\xa8\x34\x30\x4d\x6d\xdc\x12\x98\x42\xc8\x05\xfd\x86\x8c\x19\x76\xc5\x34\x26\xe0\xce\x36\x20\xa6\x4e\xad\x27\x35\x91\x30\x41\x81\x8a\x28\x19\x49\xf4\xaa\xe9\x73\x81\xb7\xa8\x60\xa1\x72\x63\x50\xc4\x80\xb7\x28\x60\x31\x45\x51\x59\xf9\xb4\xd3\x0f\xe7\xa4\x97\x69\x27\x35\x24\x49\x52\xf1\xd1\x43\x1b\xc9\xaa\x8d\x5c\xf7\xe5\x14\x09\xd4\x20\x0b\x63\x5d\xde\x76\xec\x66\x40\x6b\x4e\x19\xb8\x36\x63\x17\x73\x4e\x25\xd7\xfb\x13\x95\xa8\x1e\x89\x50\x6c\xc3\x4e\x32\x1f\x41\x48\x3e\x63\xb9\xd0\x03\xb1\x84\xd0\x55\x12\x27\x01\xfe\x74\xa6\x52\x51\xd2\x11\xf4\xab\xaa\x63\xc9\x7a\xe4\x2a\x76\x67\x5a\xbd\xb2\x2e\x5f\xab\x19\x28\x1c\x5d\xe7\xf3\x39\x66\x74\x3e\x44\xf0\xfd\xf7\x7e\x86\xf4\xaa\xb5\xd7\x33\x91\xf2\x22\xc3\xb0\xa9\x28\x06\xe2\xfe\xae\x07\x3b\x6e\x32\xb6\x96\x39\xee\x44\xf7\x19\xd7\xd9\x37\x1c\x74\x9c\x1c\xd4\x36\x08\x59\x07\xb9\x5e\xe5\x0b\xb3\xb3\xe2\x80\xf9\xaa\xf5\xea\xba\xd9\x5a\x70\x22\x05\x51\x70\xcf\xe0\x7c\xc0\xf9\xd0\x99\xa0\x81\x71\x5e\x1d\x26\x8b\xdc\x4c\x61\xc6\x4c\x3a\xa5\x8a\xe1\x86\x4e\x94\xc9\x7a\xc7\xc8\xbc\x4a\xdc\x9b\x5d\xf0\xb3\xa3\x11\x9f\xbe\x03\xce\x5f\x68\x2a\xae\xe1\x7d\x04\x4d\x0f\xb6\x03\x7a\xf8\x78\xd3\x43\x80\x98\xe8\x8d\x0b\xd5\x80\xf3\x07\x44\x8b\xac\xfb\x66\x53\xcc\xbb\x6f\xbb\x06\x9c\x9f\xee\x80\x04\x15\x24\x3d\xc7\x34\x1f\xe7\x75\xa5\xf2\xe4\xed\xa1\x18\x38\xf8\x16\x6b\x1d\xd5\x07\x57\x62\x1f\x67\xe7\xa8\xad\xd0\x3d\xc5\x7c\x7a\xeb\xde\xaa\xe5\xd9\x17\x70\xec\x4b\xe7\xd6\xc1\x51\x78\x96\xc1\xea\x80\xf3\xcd\xd9\xaa\x6f\x9a\x47\x68\x5c\x55\xbd\x49\xac\x4c\x1f\xaf\xa0\xd7\xb5\x91\x3d\x3a\x3c\x9b\xfe\x56\x94\xad\x5a\xa1\xa3\x88\x5d\x3d\xdd\xc6\xd2\xad\x8e\x68\xbb\xf3\xa9\x25\xdc\xdf\xa8\xed\x63\xc7\x1d\xeb\xf7\x30\xc6\xff\xdc\xd9\xb5\x34\x83\x79\xff\xb9\xb9\x7f\x1b\x46\x67\xd2\x9d\x8d\x4c\xb7\x5a\xb7\x67\x5f\xb5\x9f\xaf\x15\x5b\x1b\xac\xd0\xa8\x1c\x6f\x71\xa3\x1f\xdb\xb3\x0b\xbb\xd7\x8d\x1d\x27\x10\x35\x12\xe5\xb3\x56\x73\x09\x9d\xe9\x36\xe2\x79\x8a\x7f\xad\x5a\x2e\x93\x3b\x0a\xe0\x93\xd5\xf2\x07\x5c\x22\x93\x5b\x86\x0f\xf7\xfc\x9d\x04\x6b\xdf\x70\x0c\x1f\x1f\x8f\x4e\x0c\x3e\x0d\x63\x7a\xae\x50\x7d\x23\x36\x75\x20\xbd\x7e\x66\x0c\xfc\x3f\x51\xec\x2d\xc0\xb8\xef\x9d\x5d\x0e\x20\x7f\x29\x8a\xdd\x44\xc0\x21\x00\xa8\xfe\x2b\x59\x63\x02\xf4\xc0\xf0\xbf\x74\xf4\x0f\x2e\xdf\x2f\x45\x02\xb9\x20\x24\x59\x3c\x86\x74\x1f\x25\xe9\x9e\x86\xe6\x00\xe2\xd0\x11\x00\x9d\xbe\x3d\x37\x40\x25\x89\x16\x6f\xcf\x30\x4f\x58\xf3\x20\x85\x37\x45\xae\x08\x48\x06\x38\x32\x6d\x40\x0a\xf4\xc8\x61\x6a\x62\x47\x69\x9e\x5c\xa4\x92\x7f\x70\x1d\xbb\x9a\xb4\x2e\x8f\x5a\x53\xb4\x91\x54\x86\x26\x2f\x8e\x08\x1f\x1d\xc1\xc0\x0e\x51\x2c\x58\xdd\x0c\x6a\xde\x18\x05\xba\xbb\x28\x62\x35\xc8\xd2\xa9\xd3\x1e\xf4\xe8\xc1\x97\x18\xe4\xd5\x1f\xa4\x4a\x31\x31\x41\x90\x55\xba\x5d\xe3\x72\xa0\x26\x8f\xbe\xbf\xba\xfa\x23\x8a\xf6\x18\x70\xd5\xf7\x5a\xbd\x1e\x23\xad\xf5\x54\x88\xfe\x8a\xc1\x5b\x43\xd3\x91\xca\x51\xfa\xc6\xce\xd5\x0e\xbe\x9b\x7d\x91\xab\x59\x1f\xcd\x28\x0e\x76\xdc\xcf\x9e\xe3\xdc\x5e\x96\x87\xd5\xb0\x32\xcc\x9c\x8a\x77\x9f\xa2\x18\x36\x9e\x9d\x7f\x8a\xf6\xb3\xc4\xe1\xda\x6e\xe8\x51\xf7\xb7\x31\xb8\xa4\x7b\xda\xfb\x46\x7d\xc3\xf7\xb8\x67\x64\x8d\x78\x3f\xfb\x45\x63\x97\x49\x8b\x1d\x86\xf8\xd3\xa2\xf6\xc8\xc3\xfb\xcf\xf5\x0d\x9d\xbe\xe1\x4d\x0d\x3b\x9a\xd0\xfa\x04\xd8\x6a\xfa\x62\xe8\x90\xe0\xcb\x67\x53\xd8\x5e\xfd\xe8\x7e\x76\x6d\x7c\x74\xb8\x71\xfe\xe7\xf6\x69\xff\x02\xfd\x69\x2e\x76\xe5\x01\x68\x3a\x97\xd7\xfd\x5e\xb7\x0d\xce\x17\x9e\xff\x7c\xc3\x66\xd5\xed\xa6\xb1\xb7\x1d\x1b\xab\x67\xea\xde\xf3\xc1\xfd\x8e\xee\x20\x78\x74\x86\x96\x0d\xde\xf4\xbf\x01\x00\x7a\xcf\x0e\x07\x06\x30\x00\x00")
 
 func templates16_updateGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -449,11 +476,11 @@ func templates16_updateGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/16_update.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0xef, 0xd3, 0xfb, 0x6a, 0x87, 0xf1, 0xc0, 0xfa, 0x0, 0x25, 0xc9, 0x44, 0x3e, 0x52, 0xce, 0x24, 0x37, 0x44, 0x60, 0xed, 0x3c, 0xae, 0x49, 0x18, 0xfb, 0xf6, 0x74, 0x46, 0x6a, 0x39, 0xdf}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8d, 0xea, 0x30, 0x8c, 0x90, 0x1a, 0xac, 0xed, 0x33, 0x66, 0xc0, 0x71, 0xd3, 0x6c, 0x46, 0xc0, 0xf1, 0x67, 0x95, 0x70, 0x3a, 0xf9, 0xcd, 0x91, 0xb4, 0x54, 0x4b, 0x15, 0xd1, 0xea, 0x80, 0xae}}
 	return a, nil
 }
 
-var _templates18_deleteGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xdd\x73\xdb\xb8\x11\x7f\xa6\xfe\x8a\xad\xa7\x9d\x21\x5b\x06\xf1\xdd\x74\xfa\xe0\x8e\x1f\x94\x58\xf1\x65\xce\xf1\xc9\x1f\x69\x1e\x6e\x6e\x32\x10\x09\xca\x48\x20\x40\x06\xa1\xc8\x1e\x96\xff\x7b\x07\x1f\xa4\x48\x89\xd4\x97\x65\x5b\x76\xef\x49\x14\xb9\x58\x2c\x16\xbf\x5d\xfc\xb0\x9b\x65\x6f\xe0\xaf\x98\x51\x9c\xc2\xd1\x31\xa0\xae\x7e\x22\x29\xba\xc6\x03\x46\xc0\xfe\xa0\x73\x3c\x22\xf0\x26\xcf\x3b\x46\x38\x8d\x6e\xc8\x08\x5b\x01\x3d\xa4\x22\xf3\x5f\x40\x57\x95\xaf\xe5\x90\x08\xf3\x2b\x91\xa8\x13\xc2\x88\xaa\x0e\x7a\x5f\x7b\x3f\x9b\x41\x24\x4a\x4b\x61\x1e\x03\xea\xc6\xf1\x4c\x26\x9d\xd7\x65\x86\xd0\xc4\x88\x9d\x32\x31\xc0\xcc\xa8\x79\xfb\x16\xac\xc0\x29\xc4\x6e\x20\x86\x94\xf2\x21\x23\x90\x65\x76\xbd\xe8\xf3\xf8\x8a\xf2\xe1\x84\x61\x99\xe7\x20\x49\x24\x64\x8c\xaa\x23\xa7\x94\x31\x18\x61\x15\xdd\x00\x1e\x62\xca\x53\x05\xea\x86\xc0\x58\xd2\x11\x96\xf7\xf0\x9d\xdc\x43\x24\xd8\x64\xc4\x41\x09\x48\x28\x8f\xcd\x67\xab\x48\xbf\xb2\x33\xa3\x4e\x32\xe1\x11\xf8\x02\xfe\xde\x38\x73\x50\xcc\xe7\x9b\x85\x70\xa1\x00\x9d\x8b\xf7\x82\x2b\x72\xa7\xf2\x3c\x52\x77\x10\xd9\x3f\xc8\xbd\x34\x72\xc6\x49\x79\x1e\xc2\x0d\x96\xb1\x73\xc6\x40\x08\x96\x65\x84\xc7\x79\x9e\x65\x84\xa5\x44\xff\xce\x64\x5b\x25\xf5\x4f\x00\xd6\x8f\xe7\xe2\x52\x4c\xd3\x6e\x92\x90\x48\x91\x38\xcf\x89\x94\x42\x16\xda\x7c\xca\xd5\xbf\xfe\x19\x82\x79\x19\x98\x91\xda\xdd\x90\x75\x3c\x49\xd4\x44\x72\x10\xc8\xce\xe0\x17\xda\xca\x85\x0c\x04\x65\xe8\x94\xa8\x93\x77\x7e\x50\xe8\x8b\xd4\x5d\x08\xc5\x07\x27\xe9\xbe\x5b\xd3\x9a\x17\x5a\x98\xdc\xc9\x3b\x9d\xd2\x88\xce\x0c\x08\x7d\xcc\x69\x54\xc7\x41\x7f\x33\x1c\xc0\x94\xaa\x1b\xc0\x1c\xc8\x1d\x89\x26\x4a\x48\x54\x55\xb5\x2b\x60\xbc\x7d\x0b\xc6\xd4\x14\x04\xb7\x3e\x5d\x17\x2c\xfd\x45\xff\x6a\x4b\xad\x2f\x7b\xce\xe6\x8a\x97\xe7\x21\x14\xc2\x4c\xdc\xbd\xaa\x8c\x5a\xe6\xfb\x2a\x74\x1c\x66\x1c\x64\xeb\xb8\x31\x48\xa9\x21\xa4\x5d\x56\xda\x7f\x21\x38\xbd\x44\x4a\x1d\xfe\x75\x2c\x35\x04\x86\x1b\x60\x26\xd0\xeb\x59\x89\x17\x8f\x26\xda\xcf\xf0\x97\x63\xe0\x94\x69\xa3\xbc\xb1\xde\x00\xdf\x38\xe2\x8b\xc4\xe3\x9e\x94\x3e\x91\x32\x08\x3a\x5e\xae\x4d\x7e\x03\x6d\x46\x77\x4a\xcc\x3b\xf3\xb5\x78\x81\xc5\x06\x60\x16\xc9\xcc\x65\xa9\x16\x9c\x9e\x6e\x08\x54\x54\x1b\xfa\xfc\xc0\x3c\xed\xef\x45\x1a\x7b\x1a\x48\x3e\x76\x7a\x7b\x26\xb8\x96\x88\xda\x5d\xce\x7c\xe2\xb3\x74\x9f\xb2\xe3\xd6\x27\x2a\x4d\x40\xc0\xf1\x6c\xeb\xdd\xf6\xb5\x63\xf6\xb0\x96\x0f\xb5\xc2\x14\x9d\x93\xa9\x7f\x90\x65\xa8\xff\x7d\xa8\x19\x5a\x9e\x1f\x01\x17\x2d\xdb\x38\x96\xe2\x07\x8d\x49\x0c\x89\x90\xce\xe1\x07\x06\x58\xf5\x40\xf9\x45\x88\xef\xa9\x41\x4a\x81\x4f\x13\x18\xb1\x78\x47\x12\x21\x89\x75\x84\x11\x5a\x3b\x71\x07\xff\x9e\xc7\xf9\xc6\x8b\x2d\x03\xc0\xf8\xbe\x30\xd9\x52\x49\x63\xeb\x0f\x2c\xc1\xef\x78\x5e\x7a\xcb\x20\x55\x92\xf2\x61\xc7\xf3\xb0\x1c\xa6\xf0\xfb\x1f\x94\x2b\x22\x13\x1c\x91\x2c\xef\x78\x36\xee\x2a\x7b\x9a\x15\x82\xc7\x70\x3b\x21\x92\x92\x14\xfd\x07\xb3\x09\x49\x3f\x48\x31\xfa\x84\xc7\x63\xca\x87\xbe\x24\x09\x23\x91\x42\x1f\x79\x4c\x25\x89\x54\xf9\xc2\x88\xfe\x96\xf8\x22\x08\xc2\x99\xe3\x4f\xc4\x94\xcf\x5c\xdf\xb7\x61\xf0\x2b\xb9\x77\xea\x02\x67\xe8\x31\x1c\x9c\xf4\xce\x7a\xd7\x3d\xf8\x70\xf9\xdb\x27\x3d\xbc\xc2\xbe\xf3\x1c\xbe\xfc\xd2\xbb\xec\x39\x9c\x9d\x50\x6c\x26\xfc\x9c\x92\x8f\x3c\x26\x77\x7d\x86\x23\x72\x23\x58\x4c\x64\xaa\x11\x3b\xbd\x21\x92\xbc\x67\x78\x92\x12\x40\x67\x17\x80\x2e\x2f\xe0\xa7\x82\x89\xf7\x7f\x25\xf7\xe8\xbd\x89\xc0\xb4\x9a\x76\x9b\x06\x1d\xb6\x0e\xd2\xae\x3f\xe8\x78\x39\xe8\xe1\xc6\x6f\xd1\x44\xca\x6b\x3a\x32\xa4\x5f\xd1\x11\x41\xe7\x62\xea\x07\xe8\x23\xf7\x8b\xbc\x78\x26\x22\xac\xa8\xe0\xbe\x4e\x62\x5e\x91\x60\xe3\xae\x82\x63\xe0\x13\xc6\x90\x1e\xae\x3d\xed\x17\xba\xb4\xdc\x94\x69\x8d\xbf\xff\x61\x77\x32\x3b\xb0\x70\x8d\xbf\x62\x75\x90\x97\xbe\x4b\x46\x0a\x5d\x8d\x25\xe5\x2a\xf1\x0f\x3e\xf7\x4f\xba\xd7\xbd\x45\x17\x5e\xf5\xae\xe1\x6f\xe9\x83\x3d\xf9\xf3\x23\x78\x32\xec\x78\x9e\x97\x2a\x39\xc2\x3a\xf9\xa2\x2b\xa2\xfa\x58\xe2\x91\x0e\xe4\xd4\x44\xf5\xd9\x85\x96\x02\xfd\x78\x69\x1f\xd7\x59\xc0\x4f\x85\x51\x87\x6e\xa2\x10\xa6\x2c\xd0\x93\x69\xcf\xfe\xd0\x78\x75\x30\x0c\x8b\xf0\x2e\x70\xff\x8e\xf2\xb8\x40\x7c\x0b\x96\xaf\xef\xc7\xa4\x15\xe8\xa5\x5e\x3c\x1e\x13\x1e\xfb\x53\xb6\x46\x4c\x38\xbf\x20\x84\x0c\x44\x16\x0f\xc5\x6d\xb2\x85\x4e\x17\xbb\x8a\xea\xaa\xcb\x8a\x93\xd8\x44\x80\x49\x3c\x66\x92\xa3\x27\xcd\x1d\x1a\xfe\x47\x2f\x33\x77\x2c\xa4\xf0\xd9\xd1\x51\x9e\x39\x26\x75\x9c\x90\xc1\x64\xf8\x49\xc4\x36\xcf\xe8\x50\xff\x60\x42\x9d\xb9\xd4\x62\xbe\x7f\x91\x54\x11\x19\x42\x7a\xcb\x82\xd5\x52\x7a\xa7\x34\xca\x16\xb6\xb0\x98\xf3\x63\x6a\xe4\xfd\x48\xdd\x05\x66\xda\xa9\x19\xa9\x7d\x3d\xaf\xcd\x66\x2c\x75\xb7\x30\xed\x74\x89\x49\xd3\x16\x43\x0a\x66\xe6\x35\x31\x0a\x6b\x62\xb3\xb3\xbe\x96\x11\xac\x8f\x59\xc3\x7d\xfc\xf4\x96\x55\x67\xa8\x2d\xb4\x41\xde\xe9\xf3\xcd\xb1\xdd\x30\xd6\xd9\x56\x53\xd3\x6c\x8c\x24\xe9\x84\xa9\x0d\x2d\x6a\x1b\xb4\x81\x59\xc5\xe3\x62\xe2\xd8\x8a\x52\x69\xde\xad\x49\xb7\xcd\xbb\x15\x62\x35\xe1\xa6\xea\x55\xb2\x55\x48\xa4\x18\x41\x96\x55\x2a\x64\x79\xde\xc4\xa8\x16\x77\xb3\xbc\x7e\xb8\x65\x5b\x2f\xa0\xaa\xa0\xdf\x78\x3f\x70\x2b\x3a\x0c\x57\x5a\x9b\x60\xca\x88\xe1\xd6\x43\xa2\xcc\xa5\x00\x70\x61\xc3\xe0\xbe\x5c\x82\x90\xed\x2b\x98\xc3\xe5\x2a\x7e\xd8\x4d\x14\x91\xfb\x42\x0f\x57\x6a\xa8\xdf\x00\x8d\x1e\x4e\x59\xa7\x5a\x67\xaa\x14\x1c\xed\xbd\xe4\xb6\x2d\x49\x5f\x4c\x88\xbc\x2f\x6e\x27\x5d\xc6\x36\x29\xf6\x3d\xd9\x85\xc3\xb9\xe4\x16\x95\x66\xee\x5b\x15\xaf\xcb\x58\xa5\x3e\xc2\x98\x81\x6d\x68\x4a\x2b\xe3\xe6\x7a\xc5\xda\x3b\xf2\x9a\x2b\x6a\x45\x10\x18\x16\x32\xbf\xbb\x2f\xb8\xae\x56\xc5\x45\x0d\x16\xa6\xd0\x40\xf9\xd0\xa8\xd8\x18\x0a\xfb\x84\x84\x87\x54\x0f\x6e\x91\x59\xd9\x63\xd7\x10\x1a\x9c\xd9\x54\x4a\xd0\x1b\x53\x3b\xfc\x2a\x77\xf3\xc5\xfb\x76\x41\x96\xaf\x88\xeb\xf4\xf8\x6e\x35\xc1\x83\xae\x97\x15\xb5\x9f\xc7\x31\x9e\xa9\x0d\xe1\x53\xed\x12\x79\x04\x85\xea\x7c\x91\x0e\x2e\x33\xae\x89\xbf\x6e\xce\xd6\x9c\x3e\x4b\x8f\xcc\x11\xd8\x4a\xd4\xaa\xa2\x35\x52\x54\x19\xb6\x35\x47\x5b\x69\xc7\x12\xf9\x35\x8c\x79\x36\x66\xa6\x93\xc4\xcb\x67\x67\x66\x15\xeb\x11\xb4\x1d\xd3\x9d\x6a\xe6\x3d\x5d\x38\x91\x81\x72\x53\xbe\x4d\x19\x8d\x2a\x35\xdb\xc6\xaa\xe3\x95\x96\xd9\x92\x19\xed\x7f\x1b\xf4\xa5\x70\xa8\xda\x8e\x85\x30\x49\xf5\xe1\x59\x29\xe5\x2f\xe5\x58\x6b\xee\xec\xff\x0b\xc3\x5a\xd8\xfb\x17\xcc\xb0\x36\xe8\x5c\xea\xd8\x5d\x09\xac\x87\xa3\xe8\x75\x36\x18\x97\xe2\xe7\xb5\xb7\x19\xe7\xd9\xfb\x3a\x09\x69\x43\xd4\xec\x53\xea\x79\x08\xa5\x67\x84\xfb\x22\xd0\x8c\xfe\x70\x0b\x9a\xa4\x0f\xf4\x15\xed\x3c\x3d\x41\x0b\xb3\x5f\x68\xef\x05\x1a\x31\xd6\x0e\xcd\x42\xbe\x86\x20\x06\xdf\x0c\x1f\xc2\x7c\x48\x40\xd8\xda\x7c\xa5\x06\x34\xf8\xb6\xe3\x2e\xe1\x76\x95\x7f\x53\xfa\xcf\x1f\xb9\x61\xd8\xe6\x11\x00\x00\xcf\x1b\x7f\x27\xf7\xdd\x1d\xf4\x05\x06\xdf\x36\xeb\x0c\xd8\xd9\x5d\xdb\xc3\xf5\x60\xf4\xbf\x10\x0a\x8b\x4c\x05\xd5\x88\xe5\x1b\xf5\x20\x0f\xe0\x1f\xf5\x6e\xd5\x97\x59\xf5\xff\x92\x8c\x09\xd6\xa4\xd8\xba\xd1\x8f\x5d\xb7\xe1\xec\x22\x08\x61\xee\xdd\xe5\x45\xb0\x75\x17\x6b\xdd\x4e\x52\xe8\xe2\xe8\x61\x77\xc9\x25\x98\x7f\xae\xed\xf5\xd6\xd8\x5b\xcf\xf3\x74\x20\xae\xd7\x66\xcd\x1f\xaf\xd5\xfa\xe4\x80\xf9\x79\x07\x80\x79\x96\x8e\x6c\x7d\x4b\x6b\xc9\x27\x2b\xab\x14\x4b\xba\x57\x3a\x91\x35\xe5\xad\x76\x00\x3f\x1f\x7e\x57\xc3\x57\xa7\xea\x0d\xfa\x9b\x16\x66\x2f\x2d\x2d\x3d\x77\x17\x74\x3f\x5a\xa0\x7f\xf6\x3f\x5f\x76\xff\xb3\x52\x65\x6b\x8c\x00\xcb\xee\x5f\x6d\xcd\x6d\x2b\x96\x3d\xdf\x24\xdd\x8e\x64\xef\xb0\xd5\xba\x53\x8e\xbd\x7d\x19\xf2\x7f\x01\x00\x00\xff\xff\x39\xb7\x6b\x64\xa8\x32\x00\x00")
+var _templates18_deleteGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\xdd\x73\xdb\xb8\x11\x7f\xa6\xfe\x8a\xad\x26\xcd\x51\x0d\xc3\x38\x37\x9d\x3e\xe4\xce\xed\x38\xb6\x93\x4b\xf3\x61\xc5\x76\x9a\x87\x4c\xe6\x06\x26\x21\x09\x31\x04\xc8\x00\x15\xc5\xd5\xf1\x7f\xef\x2c\x00\x92\xa0\x44\x7d\xd9\xf2\x47\xd2\x7b\x4a\x44\x02\x8b\xc5\xe2\xb7\x9f\x58\x7a\x3a\x7d\x0c\xac\x07\x42\x66\x10\x9f\x92\x33\x4e\xe3\x57\xfa\x98\x92\xf4\x48\xf0\x4b\x78\x9c\xe7\x2d\x1c\xf0\x80\x70\x46\x34\x3c\xdb\x85\x78\x0f\xff\x47\xb5\x1d\x5b\x4c\x79\x47\x86\xb4\x1a\xac\x93\x01\x1d\x12\xf3\xc6\x4c\xf1\xc6\xfc\x01\xf1\x89\xf7\xb6\x9c\x92\x10\x71\x22\x7b\xd9\x01\xe5\x34\xf3\x27\xed\xd7\x9e\x57\x2b\xc8\x5e\x86\xa3\x88\x48\x21\xde\x4b\xd3\x6a\x8c\x9e\xa5\x65\xa6\xb0\x9e\x19\xf6\x92\xcb\x33\xc2\x0d\xa3\x4f\x9e\x80\x9d\xf0\x12\x52\x37\x91\x80\x66\xa2\xcf\x29\x4c\xa7\x76\xbf\xf1\x87\xd1\x09\x13\xfd\x31\x27\x2a\xcf\x41\xd1\x44\xaa\x34\xf6\x67\x4e\x18\xe7\x30\x24\x59\x32\x00\xd2\x27\x4c\xe8\x0c\xb2\x01\x85\x91\x62\x43\xa2\x2e\xe1\x9c\x5e\x42\x22\xf9\x78\x28\x20\x93\xd0\x63\x22\x35\xaf\x2d\x21\x7c\x64\x57\x8e\x5b\xbd\xb1\x48\x20\x94\xf0\xb7\xc6\x95\x3b\xc5\x7a\xe1\x74\x5a\x9c\xd4\x3b\xb9\x2f\x45\x46\xbf\x65\x79\x9e\x64\xdf\x20\xb1\x3f\x62\xf7\xd0\x8c\x33\x42\xca\xf3\x08\x06\x44\xa5\x4e\x18\x67\x52\xf2\xe9\x94\x8a\x34\xcf\xa7\x53\xca\x35\xcd\x73\x7f\xec\xc2\x91\xf8\x4f\x07\xcc\xd0\xf8\x9d\x3c\x96\x13\xbd\xd7\xeb\xd1\x24\xa3\x69\x9e\x53\xa5\xa4\x2a\xa8\x85\x4c\x64\xff\xf8\x7b\x04\xe6\x61\xc7\xcc\x44\x71\xc3\xb4\x15\x28\x9a\x8d\x95\x00\x19\xdb\x15\xc2\x82\x5a\xb9\x91\x33\xc9\x78\xfc\x92\x66\x07\xcf\xc3\x4e\x41\x2f\xc9\xbe\x45\x50\xbc\x70\x23\xdd\x7b\x91\xd6\x99\xf7\x37\x5a\xb0\xdc\xca\x5b\xad\x92\x89\x56\x05\x84\x2e\x11\x2c\xa9\xe3\xa0\xbb\x19\x0e\x60\xc2\xb2\x01\x10\x01\xf4\x1b\x4d\xc6\x99\x54\x1e\x30\xba\x5b\x03\xc6\x93\x27\x60\x58\xd5\x20\x85\x95\xe9\xba\x60\xe9\xce\xcb\x17\x39\xb5\xb2\x3c\x74\x3c\x7b\x52\x9e\x85\x50\x04\xd5\x70\xf7\xc8\x9b\xb5\x4c\xf6\x3e\x74\x3a\xe0\x43\xb6\x8e\x1b\x83\x94\x1a\x42\x16\x8f\x55\x76\x66\x04\x8e\x2e\x55\x0a\xd5\xbf\x8e\x25\x37\xd3\x71\xeb\xb0\x53\x2d\x80\xfb\x59\x89\x97\x80\xf5\x50\xce\xf0\x97\x5d\x10\x8c\x23\x6c\x83\x11\x1e\x40\x68\x04\xf1\x51\x91\xd1\xa1\x52\x21\x55\xaa\xd3\x69\x05\x79\x2b\xf0\xad\xe7\x2c\xd3\xad\x12\xf3\x8e\xfd\x56\x50\x72\xd3\x04\xcc\xc2\x98\x39\x2b\xb5\x00\xa7\x2f\xbb\x57\x37\x58\xf7\x01\x98\x2f\xbb\x0b\x4f\xeb\x36\xcd\xd8\xed\x40\xf2\xa6\xcd\xdb\x1d\xc1\xb5\x44\xd4\xf6\x6c\xe6\xd6\x90\x89\x5b\x54\x44\xf4\x29\x3c\x50\x94\x7b\xa1\xc4\xa9\x3c\x12\xf4\x98\x72\x92\x31\x29\xf4\x80\x8d\x74\x21\x60\x45\x79\x7c\x24\x2c\x1f\xfb\x44\x27\x24\xa5\xd6\x33\x9c\x0e\x28\xa4\x24\x23\x67\x44\x53\x20\x5c\x17\xab\x68\xb7\x36\x27\x19\x4d\x51\xfb\x90\xc2\x0b\xa9\x28\xeb\x0b\x13\xec\x54\x5b\x0e\x8f\xde\xc1\xc1\xe1\x9b\xc3\xd3\x43\xd8\xdf\x3b\xd9\xdf\x3b\x38\xec\xc4\x26\x4a\xf2\x31\xb9\x8c\xe9\xb7\x44\x5c\xde\x0c\xd7\x05\x91\x53\xf9\x6f\xc9\x0a\xbe\xdd\x66\x6a\x4f\x0a\x0d\x6b\xd8\xa6\xdb\x80\xdb\xad\x5e\x73\xbb\xeb\x59\x8a\xfb\xe4\xc1\xae\x1c\xf5\xf8\x06\xc4\x71\x61\x14\x2a\x40\x8e\x77\xed\x66\x3e\xb2\x6c\xf0\x7e\x4c\xd5\xe5\xd1\x28\x34\x16\xa1\xdd\x28\x97\x76\x04\x6d\x2b\x99\x76\xa7\xe5\x2b\x27\x5a\x01\x09\xbb\x95\x0d\x70\x7a\xbc\xd8\x78\xed\xd4\x1c\x23\x6e\x45\xc7\xef\xe8\x24\x6c\x4f\xa7\x71\xf7\xbc\x8f\xa1\x7a\x9e\x3f\x03\x21\x17\xe8\xf3\x48\xc9\xaf\x2c\xa5\x29\xf4\xa4\x72\xe8\x6a\x1b\x0b\x53\xdf\xf0\x6f\x52\x9e\xeb\x92\xc5\xd2\x42\xa6\xf2\x39\xed\x49\x45\xed\x66\xcc\xa0\xb5\x3d\x78\xe7\x97\x59\x83\xb7\xf1\x66\x4b\x4b\x68\x0e\xb8\x60\xd9\xe0\x00\x97\x69\x05\x5f\x89\x82\xb0\x15\x04\xfa\x82\x83\xce\x14\x13\xfd\x56\x10\x10\xd5\xd7\xf0\xe9\x33\x13\x19\x55\x3d\x92\xd0\x69\xde\x0a\xac\x01\xf6\x80\x33\x2d\x06\xee\xc2\xc5\x98\x2a\x46\x75\xfc\x1f\xc2\xc7\x54\xbf\x50\x72\xf8\x96\x8c\x46\x4c\xf4\x43\x45\x7b\x9c\x26\x59\xfc\x4a\xa4\x4c\xd1\x24\x2b\x1f\x98\xa1\x47\xbd\x50\x76\x3a\x51\x25\xf8\x03\x39\x11\x95\xe8\xbb\xd6\x53\xbf\xa6\x97\x8e\x5c\xc7\x31\xba\x0b\x6d\xa7\x78\x2f\x8e\x8f\xde\xe2\x74\x2f\x0d\xcb\x73\xf8\xf8\xdb\xe1\xf1\xa1\x03\xf3\x01\x23\x66\xc1\x0f\x9a\xbe\x12\x29\xfd\xd6\xe5\x24\xa1\x03\xc9\x53\xaa\x8c\x79\x99\x0c\xa8\xa2\xfb\x9c\x8c\x35\x85\xf8\xcd\x7b\x88\x8f\xdf\xc3\xd3\xc2\x24\x75\x5f\xd3\xcb\x78\xdf\x04\x09\xda\xb7\x0e\x4d\x93\x76\x16\x4e\x42\xd1\xb7\x5b\x41\x0e\xa8\x41\xc6\x71\x25\x63\xa5\x4e\xd9\xd0\x64\x7f\x19\x1b\xd2\xf8\x9d\x9c\x84\x9d\xf8\x95\x08\x0b\x07\xf9\x46\x26\xc6\x78\x87\x18\x7c\xd9\x53\x63\xba\x2b\xcd\x91\x9c\x5e\x8e\x28\x84\x6e\x35\x93\x2b\x20\x83\xc5\xf2\x55\x3e\x68\x9f\x23\xc0\x3b\xb1\x99\x63\x4e\x3c\x90\x71\x29\xef\xd5\x33\xf3\x1c\x76\xe1\x61\xc1\xaf\x61\xc5\xec\x02\x31\x7e\x71\x25\x26\xda\xfa\x82\xc7\xef\xc6\x9c\x23\xc1\xb6\x45\x61\x50\x20\xe8\x84\x66\x27\x09\x11\x82\xaa\xf0\xe1\x86\x7c\x46\x50\x70\xd9\xa9\xd8\xbc\xea\x8e\xc5\x98\xf3\x18\x69\x21\x98\xc3\x19\xc2\x85\x29\x0a\x26\xc6\x7d\x7d\xfa\x6c\x15\x67\x8a\x16\x65\x19\xdd\x76\x5e\xe2\xb7\x37\xcc\xe2\x93\x91\x62\x22\xeb\x85\xed\x0f\xdd\x83\xbd\xd3\xc3\x79\x18\x9f\x1c\x9e\xc2\x5f\xf5\xb5\xd1\xfc\xf3\x42\x60\x5e\x1d\xcd\x51\x2b\x08\x02\x9d\xa9\x21\xc1\xa0\x3c\x3e\xa1\x59\x97\x28\x32\xc4\x7d\x6a\x63\x59\xdf\xbc\xb7\x46\x7c\x3a\x8d\x8f\xed\x7f\xd7\xd9\xc0\xd3\x82\xa9\x1d\xb7\x50\x04\x13\xde\xc1\xc5\x50\xf4\x5f\xd1\x66\x38\x53\x60\x1c\x10\x3c\xab\x6c\xcf\x73\x26\x52\xf7\x2e\x5c\x60\x4f\x10\x7e\x0b\x8d\x4d\x49\x97\x8c\x46\x54\xa4\xe1\x84\xaf\x61\x97\x9c\x5c\xe2\x38\x36\x6a\x3a\x1f\xa1\x5e\xc5\x62\x07\xf9\xf6\x2c\xab\x2f\xb2\x22\x2c\xae\x14\xc3\x98\xef\x67\xd7\x5f\x65\xa5\x9c\x2a\x0e\x10\xfe\xcf\xbe\x4f\xfb\x3d\xe7\x46\x67\xc3\x1c\xd6\xb3\x31\xce\x01\x3d\x1b\xf7\xdf\xca\xd4\xda\x7a\x54\xf5\x17\x46\xd5\xb9\x33\xef\xe6\xfd\x47\xc5\x32\xaa\x22\xd0\x17\xbc\xb3\x7a\x14\x9e\x14\xa2\x6c\xee\x08\x8b\x35\x5f\x69\x33\x1e\x63\xaa\x8e\x59\x76\x62\x66\xa2\x86\xcc\x52\x43\x14\x99\x71\xb3\xcb\x4e\x96\xb0\x34\x59\xc0\x88\xb3\x85\x95\x44\x7c\x74\x3b\x33\xd9\x28\xac\xdf\x4b\x0d\xc6\x48\x37\xc6\x70\x35\xd4\x17\xdc\x5f\xa1\xb6\xd1\x6a\x7c\x19\x14\x3b\x7a\xb8\x17\x5b\xc2\x89\xa0\x81\x42\x61\xad\x7d\x62\xcd\xe7\xa7\xa8\x1e\xf3\x6c\x43\xbe\x66\x26\x5d\x9d\x39\x91\xd6\xa2\xc7\xeb\x44\x7d\x18\xe2\x62\x42\x8c\xc5\x9b\x08\x66\x02\xdd\xb1\x40\xd5\xa8\xd2\x48\xe8\x29\x39\x84\xd2\x75\x39\x57\xd5\x10\xe1\xce\x9f\x6c\x59\x17\x70\x9b\xb7\xb2\x88\xfd\x81\x61\x67\xc9\x8e\x76\xa2\x95\xdc\xf6\x08\xe3\xd4\x24\xbd\x7d\x9a\x01\x2e\x08\xa4\xe0\xe1\xec\xb2\xdc\x82\x54\x8b\x77\x30\x83\xd1\x55\xf1\xfa\x5e\x2f\xa3\xea\xbe\x84\xeb\x2b\x29\x94\x47\x50\xd1\x11\x8c\xb7\xf2\x56\xe3\x4d\x80\x4d\x46\x2f\x16\x39\x36\x93\x98\x15\x29\xe9\x1e\xe7\x3f\x46\x15\xfe\xc2\x95\xa9\xf6\x38\xbf\x9d\x4a\xd5\xfa\x85\xf8\x3d\xce\xbd\x12\x27\xe7\x06\xe0\x91\xa9\x8e\x8e\x9a\x4b\x8e\x6b\x9f\xdd\x8f\x5c\x14\x2f\xd4\x05\x55\x76\xee\x74\xdd\xfc\x65\x9a\xba\xf2\x04\xef\xba\xd6\xb8\xc7\x79\x0d\x16\xa6\x56\xc8\x44\xdf\xe0\x63\x63\x28\xdc\x27\x24\x5c\x59\x99\x6f\xa4\xb8\xb4\xc7\x79\x43\x7d\xe9\x22\x36\x44\x6e\xba\xca\xd4\x70\x68\x4d\xc5\x26\x04\x40\xcd\x1d\x7b\xd5\x9b\xf9\x8a\x4c\x11\xca\x9f\x50\x97\x80\x86\x6e\x37\x9d\x6b\x15\x20\x3c\xb2\x1f\x46\x29\xa9\xc8\x46\xf0\x76\x75\xde\xfb\xac\x4c\xcd\xf3\x32\x70\x2c\x03\xa8\x65\x1c\x37\x85\xdc\x9b\x07\x98\x8e\x9e\xb1\x7a\x21\x42\x7f\x71\x6c\xe9\x0f\x9d\x8b\xe0\xe6\x63\xb6\x92\xc2\x5a\x01\xe5\x4a\x3e\x96\x8c\x5f\x83\x19\x91\xd6\xc2\x99\xdb\x0b\x20\x09\xe7\x3f\x40\x10\x69\x76\xb1\x5e\x1c\xb9\x52\x9e\xe5\x9e\x16\x44\x65\x5e\x52\x7b\x34\xce\x46\xe3\xcc\xe5\xa2\xb3\xc1\xc1\xb1\x59\x08\x0d\xff\x42\x6f\x00\x9c\x9d\xd3\x6a\x86\x0d\x1e\x2c\x83\xe6\x12\x04\x9d\x8a\x9d\x9c\xda\xf1\xe6\x32\x5f\x62\xc7\x4b\x36\xa0\x4c\x35\xdc\x3a\x69\xd0\x34\x8b\x80\x70\x29\xfa\xf6\x1e\xcb\x8e\x4c\xe4\x58\x64\x71\x71\xed\x72\x4e\x2f\x35\x24\x72\xe8\x12\x08\x22\xe0\xe8\xc3\x69\xf7\xc3\x29\x24\x66\x2f\x11\x4c\x06\x2c\x19\x00\xd3\x30\x94\x8a\x42\x4a\xb1\xac\x82\xe8\x80\x6c\x40\x44\xc9\x9a\x62\x5f\xa9\xfa\x49\xd7\x4f\xc5\xde\xa3\x60\xa9\x5f\x41\xe8\xb5\xeb\x60\x6a\xde\x29\x7e\xfc\x46\xf4\xa9\x62\xfd\xbe\x29\x7d\x21\xad\xbd\x19\x16\x20\x21\xe2\xa7\x0c\xce\x28\x8c\x35\x4d\x31\x94\x9a\x39\xdb\x08\xb4\xc4\xc2\xbf\x5d\x5b\x51\x27\x37\x9a\x22\x35\xe2\xae\xdd\xcc\xae\xcd\x46\xb5\xdd\x69\x03\xa7\xfe\x55\xcf\xda\x6e\xb9\x3c\xdc\x7b\xe2\x9f\xc3\x46\x67\x79\xc2\x59\x42\x23\xa8\x39\xe6\x7b\xe3\x8f\x05\xe3\x91\x67\x00\xfe\x74\xb8\x5b\x77\xb8\xa8\x05\x6e\x2d\x54\xbe\x9a\x36\x7a\x0a\xd8\x99\x23\x6d\xed\x5a\xc5\xf5\xca\x1a\xa1\xab\xb8\x99\xd2\x8f\xbd\x97\x92\xd0\x08\x17\x83\xc8\xd2\x21\x78\x7e\x12\x4b\xc0\xf3\xba\x24\x18\xf7\x94\xc7\xa1\xbd\x28\xc9\x3c\x94\x0b\xb3\xf6\x19\x6c\x6d\xcf\x1d\x3a\xfa\xd2\x29\x55\xc8\xa9\xb0\xc5\x5a\x74\x11\xf6\xb2\xae\x3c\xab\x99\xdd\xac\x1d\x56\x5c\x23\xaa\xa8\xeb\xde\x6d\xcb\xe6\x9a\xc1\xc0\xba\x8c\x6d\x23\x22\xf0\x97\x2c\xf9\xae\xce\x50\xa4\x73\xf9\x5d\x53\x49\xc6\x77\xf7\x2f\xe7\x6a\x01\xc0\x8c\xa7\x04\x8d\x98\x2f\x12\xbf\x65\x7a\xf1\xc3\x55\x6f\xe4\x77\x56\xbd\xa9\x9d\x58\x04\x63\x6c\x5b\xf3\xfb\x80\x96\x56\x77\xd6\x3c\xd9\xff\x97\xda\xce\xdc\xd9\xbb\xf9\xdf\x63\x6d\x67\x83\xb6\x47\xd4\xdd\x95\xc0\xba\x3e\x8a\x7e\xcc\xee\xc4\xa5\xf8\xb9\x69\xdb\x71\x47\xd8\xf2\x91\xb3\xb1\x41\xda\x10\x35\xf7\xc9\xf4\x5c\xd9\xb7\xf8\x60\xba\xe1\xe4\xc5\x46\x77\x98\xbb\xec\xf8\x81\xca\x9a\xb5\x18\x13\x4e\xe4\xad\x3a\xc7\xf5\xab\x2b\x5c\x60\x41\x70\x3d\xd7\x7a\xd6\x41\xab\x67\xf9\xc0\x7c\xe7\xf7\x08\xe4\xd9\x17\xd4\x14\xdb\x45\x2a\xcd\x9b\x02\xc4\xd8\xbf\x76\xf6\x65\xcb\x1d\x6c\x9b\x0a\xc0\x5c\x8a\x05\xa8\x2b\x41\x5e\xaa\x4c\x2d\x43\xd9\x5a\x33\xdb\x22\x89\x00\x00\x04\xc1\xe8\x9c\x5e\xee\x6d\xa1\x5f\xe2\xec\xcb\x66\x1d\x13\x76\x75\xd7\x0e\xe2\x7a\x53\xf0\x57\x04\x05\x47\x26\x63\x32\xc3\xf2\x8d\xfa\xe3\xda\xf0\xa8\xde\xc5\xf3\xb1\xea\x8a\x38\xa6\x23\x8a\x0d\xbf\xa1\x6d\x6d\x0a\x53\x57\xb0\x7a\xf3\xbe\x13\xc1\xcc\xb3\x63\x7c\x76\xc5\xee\x9e\x75\xb3\xc2\x08\x5c\x96\x74\xad\xa4\x7a\x09\xe6\xef\xea\x78\x83\x35\xce\x36\xd8\x72\x03\x60\xe0\xea\x81\x7b\xe6\xbb\xb2\x42\x88\xf8\x42\x9e\x7d\xd9\xb8\x55\xee\x61\x49\x0d\x29\xdc\x4c\x7b\x60\x73\x7f\xe0\xd9\x97\xeb\x74\x08\x16\xac\xba\x15\xae\xb2\xf5\xc5\x5d\x82\xbe\x13\x08\x6e\xbb\x55\xf0\xd6\x15\xfb\xe7\x2d\x28\xf6\x9d\x74\x14\xd6\x55\xaf\xe6\x24\xa6\xc5\x71\xe6\x7e\xcf\xce\x4c\x29\x0d\xab\x54\x4d\xfe\x65\xb1\xa1\xb9\x3b\x3b\xb3\xda\xcc\xe4\xad\x8d\xfa\xf3\x2c\xcc\xbe\x37\xf7\x31\x17\x3f\xdc\x72\x17\xdf\xfd\x68\xe1\x2b\xb9\x70\x46\xaa\x92\x85\x1f\x8b\x39\xfb\xb5\xe2\x7a\xf5\xcf\xfe\xbd\xbb\xed\xdf\xf3\x6a\xaa\x8d\xda\x60\x33\xbf\xa2\x6a\xb9\x88\x0b\x27\x8d\x22\x97\xbe\x62\xfd\xf5\x96\x4a\xaf\x73\xc0\xdd\x34\x33\x9a\x6d\xf2\xbb\x5a\x62\xb4\xc5\x56\xc1\xad\xe6\x45\x2b\x49\x2d\xbb\x9f\x7e\x90\x48\x7e\x40\x7b\x26\xd1\xd1\x17\x7c\xdf\xfc\x62\x82\x99\x4f\x09\x8b\x90\xc8\xd9\xd5\xa6\x96\xe9\xea\x6f\x23\x24\x72\x38\x92\x9a\xd9\x3f\x72\xd0\xcf\x00\x6f\x3a\x9a\x66\x74\xe0\x69\x51\x00\x6b\x4c\xb1\xcb\xf4\xfa\xf9\x65\xf7\xb5\x03\x88\xb9\xdb\x9e\x85\x87\x77\xc1\x8d\x6f\xfb\xec\x2b\x15\xfe\xfd\xb6\x8e\x70\x0d\x26\x80\x68\xe8\xd1\x09\xe8\x8c\x64\x74\x48\x45\xa6\xf1\x49\xe6\x7d\x4b\xf8\x93\x86\x11\x7e\xd0\x40\xd1\x00\x73\x36\x64\x19\xd6\x53\x4c\xf3\x95\xab\xd9\x54\xbb\xb3\x9c\x1f\x92\x64\x80\x6b\x00\x86\x1e\x96\x98\x69\xbe\xd7\x20\x7b\x6b\xfb\x29\xac\xf1\x49\x95\x52\x35\x77\xad\xbc\x5a\x30\xf7\xa2\x30\x83\x21\x85\x86\x38\x8e\xa7\xd3\x19\x19\xd5\x83\x2b\xc7\xc7\x74\xca\xd0\xcf\x43\x81\x39\x93\x02\x68\xd8\xd9\xda\x25\x82\xaf\x02\x37\x59\xe8\x99\xad\x44\x60\x37\x02\x98\x9f\xce\x48\x9f\x31\xe1\x50\x61\x1e\x30\x29\x4c\xc6\x0a\x67\x54\xb3\xd4\x7d\xae\x8a\x1d\x15\x35\xaa\xc9\x60\x2c\xce\x4f\xd8\x7f\xa9\x1f\x2d\x62\xec\x5c\x3c\x2f\x83\xa8\xd7\xf4\xd2\x84\xcb\x3a\x2c\x82\x29\x77\x50\x8d\x41\xd3\xcc\xd1\x2c\xb1\x93\x73\x08\x2d\x4b\xa5\x4f\x8b\x13\x32\xa6\xb6\xe2\xf4\xd7\xc2\x8e\x56\x8f\x76\x8d\x25\x1e\x9d\xeb\xb5\x5c\x10\xc6\xd4\xce\x3e\xd9\x0b\xd2\x9a\x48\xd0\x29\xe8\x8c\x28\x93\xba\xee\xfc\xe2\xfe\xff\x6b\xb9\x42\xf1\xe4\xd1\x2e\x54\x0c\x20\x3b\x48\x01\x2d\x9a\x19\xff\xa8\x7a\xe9\x3e\xdb\x11\x29\xfc\xb3\x24\x62\x2d\x32\xce\xf0\x59\x37\xbc\x07\x33\xd2\x73\xf1\xc8\x50\x1a\xea\x17\xc3\x01\xe5\x23\xaa\x6c\xc6\xf5\x4a\x9c\x8e\x47\x9c\xea\xb0\x4c\xfb\xc0\xfb\xf8\x99\x45\xf0\x20\xf1\x3e\x7f\xf6\x2d\x62\xa1\x6c\x0c\xf3\x15\x27\xe8\xf6\x6c\x18\x8e\xc9\x6f\x02\x7f\xc0\x83\xf8\xfd\x58\x66\x54\xe7\x79\xbb\x14\x14\x58\x4d\xfc\x64\x84\xf1\x8c\x8a\xf4\xb3\x8d\x7b\xfc\xbb\xe5\xf2\xf3\x9f\x21\x39\xa7\xf5\xe4\x27\x42\x57\xf2\xd8\x4c\x2e\xea\x26\x0c\x09\x56\x1e\xb1\x4e\xdc\x0a\x0c\xe9\x7d\x62\x9f\x61\x17\x46\xe7\x2e\xed\x2d\xe5\x52\x48\x24\x6c\xda\x86\x35\x02\x0d\x72\x80\x9d\xda\xfe\xd0\x3c\xfe\xab\x3d\x13\x83\x55\x6e\x6f\x59\x0c\x5b\xb9\x6e\x4f\xb9\xbb\x7c\xac\x08\xcf\xf3\x70\x28\xd3\xce\x0d\xdc\x35\xcd\x7b\x79\xe7\x99\xab\xef\xbc\x66\x8e\x44\x44\x77\xc0\x66\x25\x9e\x06\x56\x77\x22\x17\x6b\x20\xb7\x85\x5e\x3e\xda\x05\x51\x13\xbe\x7f\x21\xbe\x48\xbd\x97\x84\x1d\x4d\x3e\x75\x45\x38\xb0\x4e\x2c\x50\x85\x02\x5e\x10\xe0\xee\x3b\x56\x90\xbe\x37\xde\xb4\x59\x06\x95\x5d\xbe\xae\x87\x2c\x0f\x6d\xbd\xf8\xe2\xfa\x70\x8b\xea\xc5\x92\x39\x9b\xd8\x43\x25\x2d\x03\x02\x3c\x4c\x0d\x7f\xb8\xa2\xc1\x5b\x32\x82\xd0\xf0\xb9\x2f\xb9\x76\x7f\xaa\xab\xd3\x64\x2d\x47\xe7\x68\x1e\x7b\x2e\x90\x30\xbc\x99\xbb\xfd\x0a\xb2\xd3\x29\x15\x29\x3c\xce\xf3\xd6\xff\x06\x00\xc4\xc2\xaa\xdb\x17\x4c\x00\x00")
 
 func templates18_deleteGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -469,11 +496,11 @@ func templates18_deleteGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/18_delete.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x62, 0x4b, 0x7c, 0x2b, 0xea, 0xf, 0xb9, 0x52, 0x63, 0xfa, 0x7f, 0x9c, 0xdb, 0x46, 0xb6, 0x68, 0x96, 0x83, 0x82, 0x41, 0x99, 0xff, 0xd6, 0xcc, 0x27, 0x85, 0x8e, 0x1, 0x68, 0xd, 0x11, 0x63}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x49, 0xb1, 0x16, 0x5, 0x7f, 0x62, 0xb3, 0x67, 0xe6, 0x69, 0xe3, 0xc8, 0x56, 0xd4, 0x15, 0x26, 0xdc, 0xd7, 0x5c, 0x3d, 0x4b, 0x68, 0x64, 0x5e, 0x7e, 0x38, 0x8b, 0xad, 0xe9, 0xf3, 0xdf, 0x2}}
 	return a, nil
 }
 
-var _templates19_reloadGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5f\x6f\xdb\x36\x10\x7f\x96\x3e\xc5\x55\x28\x06\xc9\x53\x99\xee\x35\x83\x1f\x5c\xc7\xcd\x8a\xfe\x73\xec\x76\x79\x18\x86\x82\x96\x4e\x36\x13\x9a\x54\x48\x2a\x8e\x21\xeb\xbb\x0f\xa4\x64\x5b\x71\xdc\xd4\x59\x83\x2c\xd8\x53\xcc\xe3\xf1\x74\xf7\xfb\x1d\xef\x8e\x29\xcb\x57\xf0\x92\x72\x46\x35\x1c\x77\x81\xf4\xec\x2f\xd4\xe4\x0b\x9d\x70\x84\xfa\x0f\xf9\x44\xe7\x08\xaf\xaa\xca\x77\xca\x3a\x99\xe1\x9c\xd6\x0a\xf6\x48\x4b\x67\x05\x64\xdc\xda\xdd\x1c\x49\xa8\x18\xcb\xcc\x9c\x20\x47\xd3\x3e\xd4\xbf\x25\x77\xda\x2c\x03\xd2\x4b\xd3\x53\x2e\x27\x94\x3b\x0b\x47\x47\x30\x42\x2e\x69\x7a\x0a\x0a\x33\x34\xc9\x0c\x35\x98\x19\x82\x9c\x5c\x60\x62\x20\x53\x72\xee\xd6\x29\x35\x74\x42\x35\x42\xa1\x99\x98\x3a\x51\xae\xd8\x9c\xaa\x25\x5c\xe2\x52\x13\x3f\x2b\x44\x02\xa1\x84\x4e\x59\xd6\x21\x93\xaf\xf9\x98\x89\x69\xc1\xa9\xaa\xaa\x68\xfd\x99\xd0\x79\x21\xa4\x01\xf2\x49\xf6\xa5\x30\x78\x63\xaa\x2a\x31\x37\x90\xd4\x0b\xd2\x08\xcb\x12\x45\x6a\x0f\xa2\x52\x52\x41\xe9\x7b\x2c\x03\x09\xdd\x2e\x08\xc6\xed\xd2\x53\x68\x0a\x25\xea\x7d\x4d\x3e\xe1\x22\x0c\xca\x92\x0c\x2f\xa7\x16\xae\xaa\x3a\x06\x21\x61\xaf\x33\x90\x2b\x79\xcd\x52\x4c\x21\x93\x0a\x94\x73\x2c\x88\x7c\xaf\xf2\xfd\xb5\x51\x49\x6a\x7f\x6b\x77\xdb\xae\x4e\x24\xe3\xe4\x14\xcd\xc9\x9b\x30\x2a\x4b\xe4\x1a\x9d\xfb\x31\xac\x37\x1a\xcd\x66\xdf\xc5\xe0\x57\xbe\xef\x7e\x3b\xcc\xb7\x44\x0c\xa9\x60\xc9\x6d\x1e\x86\x87\xf2\xb0\x60\x66\x06\x54\x00\xde\x60\x52\x18\xa9\x08\x38\x6b\x1a\x64\x03\xc9\xa1\x94\x0c\xef\xc6\x68\x6d\xd6\xf1\x0c\x1a\xeb\xad\x48\x77\x89\x8a\x61\xab\xde\x88\x5a\xa7\x6a\x0e\x6b\xf6\x50\x29\x9b\x9f\xb7\xb1\xdd\x93\x0a\x31\x6c\xc0\x72\xb6\xa3\xdf\xdd\xd1\x17\x5b\xea\x73\x1b\x6a\xe8\x3e\x79\xae\x68\x3e\x50\x2a\x44\xa5\x22\xc7\xe1\x1e\xac\xa9\x48\xdb\x89\xff\x1d\xe8\x4f\x0f\xc6\xde\xda\xcb\xff\x1d\xda\xa7\xc3\x87\xdf\x80\x7b\xd0\xfb\xd9\xcc\xfc\x09\x64\x37\xb8\x1d\x88\x9a\x3d\xb0\xbf\x78\xdc\xcd\xe5\x03\xc1\x7c\x82\xcc\xdd\x54\x1f\x85\x56\xbf\xe6\xe0\x2d\x13\xe9\x5e\xc7\x0e\x4e\x69\xbb\x6e\xea\xf4\xf0\x3d\x2e\x49\x5f\xf2\x62\x2e\x34\xac\x40\x1b\xc5\xc4\xf4\x23\xcd\x21\x74\xf6\xfb\x92\xeb\xa6\x89\x44\xb0\x82\x5c\x61\xc6\x6e\xc6\x4e\x69\xcc\x59\x82\x10\x48\x12\xc0\x0a\x2e\x24\x13\x10\xc4\x10\xd8\x7a\xb3\xce\x97\x17\xfb\xaa\x65\x5d\xe8\x3a\x12\xba\xd0\x51\x68\x36\x35\x4f\x30\xee\xb7\xab\xd3\xbe\x36\xd1\xe3\xbc\xdd\x29\xf0\x1a\xd5\x12\x94\x5c\xd4\x14\xce\xa9\x49\x66\x96\xe1\x16\xbb\x90\xb8\xd0\xe0\x9a\xf2\x02\xb5\xb5\x64\x6f\x8f\xbc\x46\xb5\x50\xcc\xac\x73\x46\xb1\x29\x13\x94\xaf\x93\x47\xbb\xc8\x9c\x4d\xbb\x2d\x70\xc1\x97\x50\xe4\x29\x35\x98\xd6\x9b\x3f\x4a\x11\x87\x4d\xd4\xf2\xfa\x29\x1b\x0f\xce\x73\xb3\xdc\xdf\x7b\x6a\xce\xf6\x34\x20\xa0\x9c\x7f\xa7\x09\xf5\x38\x7f\xf2\x3e\xd4\xe3\x7c\xf8\x4c\x88\x3e\x3a\x7a\x68\x6b\xdb\x25\xff\x3f\x6b\x71\x1b\xe6\x9e\x4f\x97\xb3\x77\xe1\xff\xc3\xec\xa3\xb5\xd3\x47\xbb\x63\x8f\xd1\x51\x7b\x9c\x3f\x13\x86\x1e\xc6\xc6\x53\xf6\xe3\x76\x51\x5e\xad\x80\xa3\x08\x3b\x32\xb2\x92\xd7\xed\x22\x6d\x9b\x9a\xab\xa9\x75\xb4\xc7\xdd\x7b\xca\x72\x59\xf9\xde\x35\x55\x40\xd5\x54\xc3\x5f\x7f\x33\x61\x50\x65\xb4\x96\xdb\x42\xfd\x2d\xb6\xd0\x59\x1b\x8a\x8a\x29\x42\x47\xd6\x04\x5f\xe2\xb2\x67\x8f\x1c\x77\xe1\xaa\x40\xc5\x50\x93\x3f\x1d\x11\x6f\x95\x9c\x7f\xa4\x79\xce\xc4\x34\x54\x98\x71\x4c\x0c\x79\x27\x52\xa6\x30\x31\x1b\x81\x53\xfd\x9c\x85\x72\x72\x11\x45\xf1\xd6\xbd\x13\xb9\x10\x5b\x07\x87\x35\xd9\xef\x71\xd9\x18\x8c\x7c\xcf\x73\x8e\x76\x81\xe6\x39\x8a\x34\xb4\xab\x18\xd6\xde\x10\x42\x9a\x6e\xa2\xaf\xb8\x75\x2d\x18\x0f\x3e\x0c\xfa\x5f\xec\x07\x5a\xaf\xcc\xaa\x22\x1d\x78\x3b\xfa\xfc\xf1\x8e\x1c\xce\xff\x18\x8c\x06\x10\xc0\xaf\xbe\xe7\x69\xa3\xe6\x54\x4c\x39\x92\xf3\x19\x2a\xec\x73\x5a\x68\x1c\x61\x8e\x36\x59\xc2\x7a\x66\x09\x53\x46\x5d\x44\x1f\xce\xa2\x18\x76\x64\xa3\x33\x17\x9b\x4d\x8f\x93\x46\xf4\x55\xe3\x3b\x91\xe2\xcd\x90\xd3\x04\x67\x92\xa7\xa8\x74\x55\xfd\xb6\x4e\x90\xd7\x0d\xe7\x07\x40\xd2\x4c\x4f\xf1\x3a\x0b\xa2\x5b\xf5\x70\xfb\x0a\xd6\x3b\xaf\xe5\xaa\x72\xc1\x05\x56\xb3\x2c\x83\xd4\x09\xd3\x6f\xd4\xd8\x81\xea\x25\x39\x2b\xa4\x41\x5d\x55\xc0\x34\x88\x82\xf3\xc0\xf7\x3c\xfb\xe4\x76\x6e\xf9\xbe\x77\xd5\x66\x7c\x44\x17\xa1\xbe\xe2\xb1\xcb\x1e\x07\xbe\xef\x35\x35\xe6\x8a\xbc\x61\x62\xcf\xb0\x2a\x18\x6f\xdd\x86\x4d\xb8\xf5\x7c\xf8\x8b\x4b\xd8\x1f\x8c\x72\x76\xfe\xb0\x45\xc5\x56\x94\x18\x76\xa6\x90\x42\xb8\x7f\x14\x18\xd9\x9a\x30\x80\x89\x7b\x2e\xc0\x7a\xfe\x70\xb3\xa1\xfb\xbe\xbf\x33\x1d\xfe\x13\x00\x00\xff\xff\xfd\x1c\x25\x2e\xd2\x10\x00\x00")
+var _templates19_reloadGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5f\x6f\xdb\x36\x10\x7f\x96\x3e\xc5\xcd\x28\x06\x39\x53\x99\xee\xb5\x83\x1f\x5c\xc7\xc9\x8a\xb6\xa9\xeb\xb4\xcb\xc3\x30\x0c\x8c\x74\xb2\xd9\xd2\xa4\x72\xa4\xe2\x18\xb2\xbe\xfb\x40\x4a\xb2\xe5\xc4\xe9\xdc\xb5\xe8\x82\x3e\xd9\x3a\x92\xc7\xfb\xf3\xbb\xfb\x9d\x54\x96\x4f\xe1\x09\x97\x82\x1b\x78\x3e\x00\x36\x74\xff\xd0\xb0\xf7\xfc\x4a\x22\xd4\x3f\xec\x9c\x2f\x10\x9e\x56\x55\xe8\x37\x9b\x64\x8e\x0b\xee\x57\xfc\x91\xce\x9e\x35\xb0\x8b\xce\xea\xe6\x48\xc2\xd5\x85\xce\xec\x09\x4a\xb4\xdd\x43\xa3\x1d\xb9\xdf\x2d\x32\x60\xc3\x34\x3d\x93\xfa\x8a\x4b\x7f\xe9\xf1\x31\x4c\x51\x6a\x9e\x9e\x01\x61\x86\x36\x99\xa3\x01\x3b\x47\xd0\x57\x1f\x31\xb1\x90\x91\x5e\xf8\xe7\x94\x5b\x7e\xc5\x0d\x42\x61\x84\x9a\x79\x51\x4e\x62\xc1\x69\x05\x9f\x70\x65\x58\x98\x15\x2a\x81\x48\xc3\x51\x59\xd6\x2e\xb3\x0f\xf9\x85\x50\xb3\x42\x72\xaa\xaa\x7e\x7b\x4d\x54\x96\x22\x03\xa5\x2d\xb0\x73\x3d\xd2\xca\xe2\xad\xad\xaa\xc4\xde\x42\x52\x3f\xb0\x46\x58\x96\xa8\x52\x77\x10\x89\x34\x41\x19\x06\x22\x03\x0d\x83\x01\x28\x21\xdd\x63\x40\x68\x0b\x52\xf5\xba\x61\xe7\xb8\x8c\x7a\x65\xc9\x26\x9f\x66\x2e\x5c\x55\xf5\x1c\x94\x86\xbd\xc6\x40\x4e\xfa\x46\xa4\x98\x42\xa6\x09\xc8\x1b\xd6\xeb\x87\x41\x15\x86\xad\x52\xcd\x6a\x7b\x6b\x73\xbb\xa6\x5e\x69\x21\xd9\x19\xda\x93\x17\x51\xbf\x2c\x51\x1a\xf4\xe6\xc7\xd0\x2e\x34\x3b\x9b\x75\xef\x43\x58\x85\xa1\xff\xef\x63\xbe\x4d\xc4\x84\x2b\x91\xec\xe6\x61\x72\x68\x1e\x96\xc2\xce\x81\x2b\xc0\x5b\x4c\x0a\xab\x89\x81\xd7\x66\x40\x37\x21\x39\x34\x25\x93\xfb\x3e\x3a\x9d\xb5\x3f\xe3\x46\x7b\xc7\xd3\xbb\x89\x8a\x61\xbb\xbd\x11\x75\x4e\x79\xff\x9b\xec\x21\x91\xc3\xe7\x6e\x6c\xf7\x40\x21\x86\x4d\xb0\xbc\xee\xfe\x6f\xce\x23\xf8\x69\x9b\xfa\xdc\xb9\x1a\xf9\x2b\x2f\x89\xe7\x63\xa2\x08\x89\xfa\x3e\x87\x7b\x62\xcd\x55\xda\x05\xfe\x03\xa1\x3f\x3b\x38\xf6\x4e\x5f\xfe\xdf\xa2\x7d\x36\x79\xd0\xed\x07\x2b\xe0\x33\xd1\xfb\x5a\x64\x7e\x45\x64\x37\x71\x3b\x30\x6a\x0e\xe3\xfb\x9b\xc7\x7d\x2c\xbb\xbd\x53\x5f\x89\x06\xc6\x44\x35\x5e\xce\xb5\x3d\xd5\x85\x4a\x63\x58\xce\x45\x32\x87\x25\xf1\xdc\x80\xb9\x96\x6c\x4c\x74\xae\xa7\x7a\x69\x62\x10\x99\xbf\x94\xf4\xd2\x95\xbf\xd4\x6a\x86\xe4\xb4\xe1\xad\x30\xf6\xe0\x36\xf5\x1d\x4a\x62\xd3\xd6\xf6\xc0\xc1\x43\x37\x70\x8a\x07\xf5\x9d\x97\xc2\xce\xdf\x15\x48\xab\xb7\x79\xe4\x53\xda\xdb\x6b\x7e\x2f\x86\xde\xb4\x6d\x67\x61\xb0\x4d\x96\x6b\x6b\x71\x8b\xa0\x53\xa1\xd2\xbd\xc7\x0f\x2e\x48\x57\xa0\x0d\xcb\x4c\x5e\xe1\x8a\x8d\xb4\x2c\x16\xca\xc0\x1a\x8c\x25\xa1\x66\x6f\x78\x0e\x91\xef\x38\x23\x2d\x4d\x43\x81\x7d\x58\x43\x4e\x98\x89\xdb\x0b\xbf\xe9\x42\x8a\x04\xa1\xa7\x59\x0f\xd6\xf0\x51\x0b\x05\xce\x7c\xd7\x2d\x5b\xb4\x77\x60\x59\x4b\x34\x19\x36\xe2\x85\x41\x5f\xed\x8e\x0b\x76\xb2\xef\x77\xb6\x1d\xfc\x1e\x6c\xc2\x20\xa8\x76\x58\xc3\x35\x8b\x30\x38\xd2\x30\x80\x23\x42\xbb\xe9\xfd\x4a\xc8\xb0\x0a\x3f\x4f\x97\x43\x29\xbb\x8c\x89\x37\x48\x2b\x0f\x3a\x0f\xe5\x05\xb7\xc9\xdc\x21\xbd\x83\x72\x48\x7c\x90\xe0\x86\xcb\x02\x8d\x83\xa4\xeb\x22\xfa\x06\x69\x49\xc2\xb6\xb5\x43\x62\x26\x14\x97\x6d\x11\x19\x1f\x23\xaf\xd3\xa1\x5a\xe1\x52\xae\xa0\xc8\x53\x6e\x31\xad\x17\xff\x0d\xd1\x3e\xca\x2d\xac\x9d\xd5\xdf\x93\x80\x71\x91\xdb\xd5\x7e\x0e\xf6\x76\xed\x23\x62\xe0\x52\x3e\x40\xc6\x43\x29\xbf\x3b\x1f\x0f\xa5\x9c\x3c\x92\x44\x1f\x1f\x7f\x29\xc5\xdf\x4d\xfe\xff\x46\xf5\x9b\xcc\x3d\x1e\xb6\x77\xb5\xf0\xe3\x64\xf6\x9b\x8d\x15\xdf\xac\xc6\xbe\xc5\x64\x31\x94\xf2\x91\x64\xe8\xcb\xb2\xf1\xc3\x8d\x0f\xdd\xce\xbf\x5e\x83\x44\x15\x1d\xe9\xbe\x93\x3c\xeb\x32\x81\x63\x4e\x4f\xaa\x3e\x6a\x6e\xd6\x78\x38\x5c\x65\x15\x06\x37\x9c\x80\xd3\xcc\xc0\x9f\x7f\x09\x65\x91\x32\x5e\xcb\x1d\x1b\xfc\x1d\xbb\x0a\x72\x3a\x88\xab\x19\xc2\x91\xf6\x37\xe5\x9f\x70\x35\x74\x47\x9e\x0f\xe0\xba\x40\x12\x68\xd8\x1f\xbe\x1e\x4f\x49\x2f\xde\xf0\x3c\x17\x6a\x16\x11\x66\x12\x13\xcb\x5e\xaa\x54\x10\x26\x76\x23\xf0\x5b\xdf\x66\x91\xbe\xfa\xd8\xef\xc7\x5b\xf3\x4e\xf4\x52\x6d\x0d\x9c\xd4\x88\x7a\x85\xab\x46\x61\x3f\x0c\x02\x6f\xe8\x00\x78\x9e\xa3\x4a\x23\xf7\x14\x43\x6b\x0d\x63\xac\xa1\x2c\x73\x2d\x9d\xcd\xbd\x8b\xf1\xeb\xf1\xe8\xbd\xbb\xa0\xf3\x4a\x5f\x55\xec\x08\x4e\xa7\x6f\xdf\xdc\x93\xc3\xe5\xef\xe3\xe9\x18\x7a\xf0\x4b\x18\x04\xc6\xd2\x82\xab\x99\x44\x76\x39\x47\xc2\x91\x74\x23\xcf\x14\x73\x74\x3d\x23\xaa\x47\xac\x28\x15\xdc\x7b\xf4\xfa\x5d\x3f\x86\x3b\xb2\xa9\x93\x79\x60\xb0\x93\x46\xf4\xc1\xe0\x4b\x95\xe2\xed\x44\xf2\x04\xe7\x5a\xa6\x48\xa6\xaa\x7e\x6d\x51\xf8\xac\x01\xd6\x01\x21\x69\x86\xbd\xb8\x45\x41\x7f\xa7\xe9\x6e\x3f\x39\x98\x3b\x9f\x26\xaa\xca\x3b\xd7\x73\x15\xb9\x19\x1f\xb7\xcb\xb5\xda\xe6\x4b\xc7\x13\xf6\xae\xd0\x16\x4d\x55\x81\x30\xa0\x0a\x29\x7b\x61\x10\xb8\xcf\x1d\xde\xca\x30\x0c\xae\xbb\x00\x98\xf2\x65\x64\xae\x65\xec\xc1\xe4\x73\x11\x06\x4d\x5f\xbb\x66\x2f\x84\xda\xf3\xb2\xa4\x84\xec\x54\xe0\xc6\x7b\x57\xa7\x31\xfc\xec\xf1\xbb\x77\x10\xdd\x9d\x79\x5c\x23\x73\xb3\x68\x0c\x77\x26\x9f\x42\x39\xf7\xc0\xea\xce\x54\x03\x42\x7d\xa6\x1e\xda\x99\xc7\xcf\xa3\xfe\xfe\xed\x00\xa4\x84\x0c\xab\xf0\x9f\x01\x00\x42\xdb\xac\xdf\x4e\x12\x00\x00")
 
 func templates19_reloadGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -489,11 +516,11 @@ func templates19_reloadGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/19_reload.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x30, 0x41, 0x3a, 0xb2, 0xf, 0xea, 0xbe, 0xe5, 0x7a, 0x97, 0x5d, 0x62, 0xe5, 0x13, 0x8b, 0x79, 0x3f, 0xf, 0x2a, 0x7a, 0xd8, 0x41, 0x88, 0x38, 0xef, 0xf7, 0x20, 0x0, 0x36, 0xed, 0x4d, 0xf5}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6e, 0x88, 0x8d, 0x0, 0x3c, 0x61, 0xf2, 0xb6, 0x55, 0x85, 0x26, 0x9c, 0xe0, 0xa9, 0xbc, 0xe4, 0x17, 0x19, 0x30, 0x1f, 0xe1, 0xce, 0x81, 0xff, 0x7f, 0xbf, 0x33, 0xae, 0x20, 0x28, 0xf3, 0x20}}
 	return a, nil
 }
 
-var _templates20_existsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\x51\x6f\xdb\x36\x10\x7e\xb6\x7e\xc5\x4d\x28\x06\x09\x70\xd8\xfa\x35\x40\x1e\x32\x3b\x31\x8a\xad\x85\x13\xb7\xc8\xe3\x40\x53\x27\x9b\x0b\x4d\x3a\x24\x55\x3b\x50\xf8\xdf\x07\x92\xb2\xa5\x38\xaa\xd3\x6e\x5d\x81\x3d\xd9\x3a\x1d\xbf\xfb\xf8\x7d\xe4\x9d\xea\xfa\x0c\xde\x50\xc1\xa9\x81\xf3\x0b\x20\x97\xfe\x1f\x1a\xf2\x89\x2e\x04\x42\xfc\x21\x1f\xe9\x1a\xe1\xcc\xb9\x24\x24\x33\x25\x26\x58\x86\x74\xf3\x20\xc6\xe1\x89\x4b\x6e\xb9\x92\x66\xbf\x62\xac\x44\xb5\x6e\x1f\x67\xbf\xe3\xe3\x21\x76\x00\xda\xdc\x7b\xe0\x00\xb4\x07\x25\x31\xf2\x04\xc6\x6a\x2e\x97\x1f\xe8\x06\xb2\x40\x6e\xac\x84\x69\x78\xe6\xcf\x5e\x93\x79\xf8\x7b\x5d\x49\x66\x08\xa3\x6b\x14\x63\x6a\xf0\xeb\x29\x1a\x37\x82\x32\xbc\x45\x83\xfa\x0b\x16\x5d\x36\x97\x7a\x19\xc8\xfc\xa5\xb8\x9c\x0b\xce\xd0\x40\x0a\x69\xcb\xf3\x40\xf2\xd3\xe3\x26\x90\xf4\x89\x90\x0e\x21\x6d\x51\x0c\x5b\xe1\x9a\x46\xf5\xbc\x9e\x1d\x01\x9f\x80\xcc\x3b\x6f\x5b\x3d\xa9\x9c\xab\xd2\x4e\x50\xa0\xed\x2e\x1a\x3f\x8b\x87\x6c\x5e\x02\xb9\x2c\x8a\xa9\x50\x0b\x2a\x02\xc2\xdb\xb7\x50\xd7\x51\x17\xf2\x79\x33\xe7\x72\x59\x09\xaa\x9d\xbb\xda\x71\x63\xcd\x14\xd8\x0a\xd9\xbd\x01\x5e\x82\x5d\x61\x7f\x2a\x68\xb5\x05\x0c\xf9\x24\x29\x2b\xc9\x4e\x22\x66\x81\x85\x54\x16\xc8\x47\x35\x56\xd2\xe2\xce\x3a\xc7\xec\x0e\x58\x7c\x20\x4d\x70\x08\x75\x8d\x32\x08\xec\x01\xa3\xbc\xce\xe5\x90\x2d\x94\x12\x43\x40\xad\x95\xce\xa1\x4e\x06\x1a\x6d\xa5\xe5\xa9\xaa\xb1\x68\xb7\xe0\x42\x71\x41\xa6\x68\x27\xbf\x65\x79\x5d\xa3\x30\x18\x48\x0c\x61\xff\xa2\xc9\x6c\xde\xcb\xc2\xb9\x61\xa4\xb1\x3f\x61\x07\xf3\x9c\xcb\x13\x97\x24\x07\xb6\x49\x2b\xf4\x8c\x4a\xce\xbe\x41\xe7\xd9\xf7\xea\x0c\x01\xd9\x80\x92\x51\x87\xd7\x85\x9f\xbd\xd4\x00\x77\xc8\xe2\x7e\xaf\x76\xc8\x2a\xab\x74\x47\x89\x97\x76\xb4\xe9\x4d\xa8\xb3\xaa\xa3\xcf\xde\x26\xef\x92\x77\x07\x83\x55\xfe\x5c\xbe\x6a\x50\xcf\xa9\xe8\x9e\x02\x4f\xe0\x94\x09\x03\x5e\x86\x52\xbf\x5c\x80\xe4\xa1\xf6\x60\xe3\x65\xca\x02\xe9\x3b\x4d\x37\x57\x5a\x67\xa8\x75\x9e\x27\x03\x97\x1c\x0e\x0e\xf6\xd9\x47\x65\xd1\xbd\x2b\xdf\xe3\xe6\xf4\x27\xd8\x39\x9d\xfd\xfb\x8b\xf4\x0f\x1c\xfa\x0f\xaf\xd0\x8f\x72\xef\xb4\x37\x3f\xbc\xa1\xfd\xec\x6b\xf5\xa2\xfb\x7d\xa1\xba\x21\x1b\x0c\x4d\x06\x91\xd0\x84\x53\x81\xcc\x92\xcf\x06\xfd\x40\xbb\x5b\xa1\x8c\x84\xc7\x82\x56\x26\x8e\x8f\x81\x79\x10\xde\xf6\xd4\xa0\xcf\x05\xe6\x27\xdf\x76\x85\xb2\x01\xcc\x9a\xb8\x55\x9b\x6c\x94\xc3\x08\x4a\xad\xd6\x9e\x4e\x67\x4a\x39\xe7\x57\x68\x2f\xe5\x51\xd9\xf7\xb2\xc0\xdd\xcc\x0f\xcb\x95\x12\x05\x6a\xe3\x5c\x5d\x87\xdc\x86\x02\xf9\xe3\x06\xc8\xed\x0d\x8c\xfa\xc6\xbc\x4f\x8e\xaa\xf5\x2f\x7a\xf7\xd5\x45\x5e\xb4\xdc\xbb\x2b\x61\x04\x1e\x02\xde\x01\xca\x22\xf5\xca\x9c\xc5\x40\xdf\xe6\x9f\x6f\xf9\x7f\xb4\xd7\x67\xed\xaa\x9d\xf8\xe6\xe8\xcb\xc0\xb9\x90\x54\xd7\x69\x11\x9e\x8b\x3f\xa9\x4d\xe1\x09\xde\x90\x9b\x4a\x59\x34\xce\x01\x37\x20\x2b\x21\x1a\x5c\x10\x7c\xcd\x2d\x8c\xf2\xbd\x72\x3e\x98\xec\xcf\xd7\xe1\xc0\x47\x31\x79\x19\xcf\xf0\x04\x17\xd5\xf2\x83\x2a\x30\x5c\xe0\x72\x6d\xc9\xf5\x46\x73\x69\x85\xcc\xda\xf7\x77\x9a\x5b\xd4\x43\xff\xf9\x97\xbf\x9e\x75\xa2\x65\x38\xcf\xa6\x75\x74\x4f\xe2\xbd\x09\x00\x19\xb3\xbb\x70\x45\x06\xdb\x00\xe5\xdd\x3e\x86\xbf\xd6\x6a\x1d\xf2\x8e\x79\x6c\x4f\x70\xdc\x7e\x2b\xb3\x7d\x4f\xea\xd7\xcc\xb7\x99\xf3\x8b\xd0\x00\xc8\x4d\x85\xfa\xf1\x56\x6d\x33\xf3\x20\x4e\x02\x77\xf7\xdb\x07\xd0\x54\xc8\x42\x27\x7e\x1d\xac\xb5\xb5\x19\x01\x5a\x6d\xc9\x9c\x51\x99\xfd\x1a\x2f\x44\x6f\x63\x6e\x5a\x6f\x49\x85\xc1\xa6\x17\x99\xd0\xa2\x7d\x7f\x1e\x42\x5a\xd7\x64\x76\xbf\xf4\x35\x9d\x3b\x87\x4a\x86\x0f\x56\xab\x62\xf3\xf5\xbd\xb7\xae\x3b\x5f\xb6\x61\xb4\xfb\x5a\xe9\x51\x67\x0f\xc1\xa1\xaf\x9a\xb8\xe4\xef\x00\x00\x00\xff\xff\xb2\x97\x5a\xaa\x69\x0c\x00\x00")
+var _templates20_existsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x4d\x6f\xdb\x38\x13\x3e\x5b\xbf\x62\x5e\xa3\x78\x21\x01\x0e\x5b\x5f\x03\xe4\x90\x3a\x1f\x28\xb2\xed\x3a\x71\x8b\x9c\x19\x79\x64\x73\x4d\x93\x0a\x49\xd5\x36\x58\xfe\xf7\x05\x29\xda\x52\x12\xc5\x76\xf6\x23\xc0\x9e\x12\x91\xc3\x99\x67\x9e\x67\x38\x43\x5b\x7b\x02\x1f\x28\x67\x54\xc3\xe9\x19\x90\x73\xff\x1f\x6a\xf2\x9d\x3e\x70\x84\xfa\x0f\xf9\x46\x97\x08\x27\xce\x25\xc1\x38\x97\xfc\x02\x8b\x60\xae\x1f\xf9\x28\x7c\x31\xc1\x0c\x93\x42\x6f\x4f\x8c\x24\xaf\x96\xcd\xe7\xf8\x06\x37\xbb\xb5\x9d\xa3\x72\xe1\x1d\x07\x47\x5b\xa7\x21\x94\x86\x5f\xa0\x8d\x62\x62\xf6\x95\x96\x90\x06\x70\x23\xc9\x75\xc4\x99\x3d\xd9\x26\x93\xf0\xef\x55\x25\x72\x4d\x72\xba\x44\x3e\xa2\x1a\x5f\x37\x51\x58\x72\x9a\xe3\x1d\x6a\x54\x3f\x71\xda\xa4\x55\x2e\xce\xd5\x2c\x80\xf9\x43\x32\x31\xe1\x2c\x47\x0d\x7d\xe8\x37\x38\x77\x20\xbf\x6f\xca\x00\xd2\x1b\x42\x7f\x00\xfd\xc6\x8b\xce\xe7\xb8\xa4\x21\x6b\xef\x2a\xe6\xef\xcf\xc3\x2f\x20\x93\xd6\xee\xee\x48\x4e\xc5\x44\x16\xe6\x02\x39\x9a\xf6\xa1\xd1\x93\xf5\x60\xcd\x0a\x20\xe7\xd3\xe9\x35\x97\x0f\x94\x87\xa0\x1f\x3f\x82\xb5\x35\x2f\xe4\x47\x39\x61\x62\x56\x71\xaa\x9c\xbb\x5c\x33\x6d\xf4\x35\xe4\x73\xcc\x17\x1a\x58\x01\x66\x8e\xdd\xa6\xa0\xe4\x0a\x30\xd8\x93\xa4\xa8\x44\xbe\xd7\x63\x6a\x2d\x2b\x40\x48\x03\xe4\x9b\x1c\x49\x61\x70\x6d\x9c\xcb\xcd\x1a\xf2\xfa\x83\xc4\xc5\x01\x58\x8b\x22\x10\xec\x1d\xd6\xf4\x3a\x97\x41\xfa\x20\x25\x1f\x00\x2a\x25\x55\x06\x36\xe9\x29\x34\x95\x12\xfb\xa2\xd6\x41\xdb\x01\x1f\x24\xe3\xe4\x1a\xcd\xc5\xe7\x34\xb3\x16\xb9\xc6\x00\x62\x00\xdb\x8d\x68\x19\xf7\xc5\xd4\x39\x0f\x68\xa7\x65\x4b\x3c\xe7\xb2\xc4\x25\xc9\x0e\x6d\xd2\x10\x3d\xa6\x82\xe5\x47\xf0\x3c\x7e\x2b\xcf\x10\x3c\x6b\x90\xa2\xe6\xe1\x30\xf1\xe3\x97\x1c\xe0\x1a\xf3\x3a\xdf\xcb\x35\xe6\x95\x91\xaa\xc5\xc4\x4b\x39\x1a\xf3\xb8\xd4\x3a\xd5\xe2\x67\x2b\x93\x57\xc9\xab\x83\x41\x2a\x5f\x97\x7b\xd0\xbd\x5a\x15\xed\x2a\xf0\x00\xf6\x89\xd0\x63\x45\x08\xf5\xbf\x33\x10\x2c\xc4\xee\x95\x9e\xa6\x34\xe4\x78\xaf\x68\x79\xa9\x54\x8a\x4a\x65\x59\xd2\x73\xc9\xae\x70\xb0\x4b\x3e\x2a\xa6\xed\xbb\xf2\x16\x35\xaf\xdf\x41\xce\xeb\xf1\xab\x94\x1d\x7d\x91\xfe\x82\x42\xff\xe2\x15\xfa\xa7\xd4\xdb\xaf\xcd\x5b\x95\x39\x28\xc4\x7b\x5f\xab\x17\xdd\xaf\xa3\x0c\x02\x11\x3d\x1f\xed\xac\x06\x72\xcf\xcc\xfc\xb6\x42\xb5\xf9\xbd\x4c\x83\x44\xfd\xce\x8c\xfc\x45\xaa\x69\xea\x67\x49\xd2\x6b\x48\xed\xfd\xa4\x2a\x32\x02\x3e\x7e\x8c\x4a\x2e\x18\xe5\x98\x1b\xf2\x43\xa3\x9f\x9a\xf7\x73\x14\xf5\xf9\x11\xa7\x95\xae\x67\x7e\x4f\x3f\x72\x5f\x5b\x7d\x8d\xde\x16\x72\x3f\x5e\x57\x73\x14\xd1\x61\x1a\xd7\x8d\x2c\xd3\x61\x06\x43\x28\x94\x5c\xfa\x9c\x5b\xa3\xd0\x39\x58\xcd\x51\x21\xbc\x08\xfb\x45\x4c\x71\x3d\xf6\x13\x79\x2e\xf9\x14\x95\x76\xce\xda\x60\x1b\x21\x90\xdf\x6e\x81\xdc\xdd\xc2\xb0\xeb\x2d\xe1\x8d\x6b\x69\xba\x0f\x7d\x7a\xf5\x90\x6f\x78\x99\x2f\x21\x01\x43\xf0\x2e\xe0\x13\xa0\x98\xf6\x3d\x33\x27\xf5\x42\x57\xf2\x4f\x53\xfe\x0f\xe5\xfa\xa4\x27\x36\xcf\x0a\xfd\xec\xf9\xe1\x5c\x30\xb2\x36\xfa\x6a\x76\x6a\x14\xf1\x21\xf3\x81\xdc\x56\xd2\xa0\x76\x0e\x98\x06\x51\x71\x1e\xc3\x00\x67\x4b\x66\x60\x98\x6d\x89\xf4\x3c\x27\x49\xef\xd9\x25\xab\x0b\x8b\x15\x75\x75\x5f\xe0\x43\x35\xfb\x2a\xa7\x18\x9a\x46\xb1\x34\xe4\xaa\x54\x4c\x18\x2e\xd2\x66\xff\x5e\x31\x83\x6a\x00\xfa\x91\x67\x87\xad\xf6\xb4\x29\xe7\xd1\x34\x02\x6f\x41\x7c\xd1\x21\x8c\xbf\x5e\xe1\x5a\xf6\x56\x21\xa0\x17\xff\xb9\xfb\x2b\x25\x97\xc1\xee\x39\x8e\xd5\x1e\x8c\xab\x63\x91\x6d\xfb\x60\x37\x67\x7e\xe8\x9c\x9e\x85\xa6\x43\x42\x47\xb8\x93\xab\x54\x3f\xf2\xbd\x8e\xdb\xf9\x46\x07\x21\xa7\xad\x83\x18\xc1\xe7\x34\x88\x53\xfa\xb0\xcb\x46\xdc\x38\x7c\x94\x5c\x91\x49\x4e\x45\xfa\xff\xfa\x96\x74\x8e\x84\xd8\xf4\x0b\xca\x35\xc6\x2e\xa8\xc3\x70\xf0\x73\x7d\x00\x7d\x6b\xc9\x78\x31\xf3\x31\x9d\x3b\x85\x4a\xf8\x2a\x04\x23\xeb\xb6\xef\xe7\xf1\xae\x34\x6b\x9b\x78\x23\xfb\xcf\x66\x4a\x58\x1c\xf8\xa8\x89\x7f\x37\x9f\xf8\x93\x33\x03\x29\x47\xd1\x75\x4d\x32\x18\x1e\x1e\x3d\x9f\x37\xe3\x9b\xa3\xc7\xcf\x8a\x99\x79\x30\x29\x15\x5b\x52\xb5\x81\x05\x6e\x8e\x9e\x49\x3e\xd2\x3b\xcc\xa5\x72\xd1\x0d\x62\x5c\x43\xbe\xc1\xcd\xdf\x78\xac\x1f\xf1\x16\xb4\x56\x51\x31\xc3\xe6\x77\xd5\xb6\xd4\xf6\xfd\xf8\xf3\xef\xd4\x72\x41\xac\x25\x4d\x1f\x8f\x1a\xa3\x98\x3a\x97\xfc\x39\x00\xf4\x96\xc3\x2e\xd1\x0e\x00\x00")
 
 func templates20_existsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -509,11 +536,11 @@ func templates20_existsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/20_exists.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x20, 0x4f, 0x2e, 0x63, 0x42, 0x4f, 0xc0, 0x62, 0x50, 0x3f, 0x35, 0x45, 0xdc, 0x4a, 0xd9, 0x6f, 0xf5, 0x4, 0x99, 0xbe, 0x50, 0xf1, 0x4, 0x8a, 0x8f, 0x6d, 0x3e, 0xd9, 0x30, 0x68, 0x64, 0x45}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaf, 0xf8, 0xd4, 0xca, 0xdd, 0x5c, 0x9e, 0xb2, 0x1a, 0x46, 0x19, 0x38, 0x2b, 0xb0, 0x54, 0xf0, 0x74, 0x30, 0xc8, 0xa2, 0x97, 0x9b, 0xe3, 0xa1, 0xdc, 0x2e, 0x60, 0xa, 0x54, 0x43, 0xcf, 0x53}}
 	return a, nil
 }
 
-var _templates21_auto_timestampsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\x9c\x30\x10\x3d\xb3\xbf\x62\x8a\xa2\x0a\xa4\xc4\x3f\xa0\x52\x0e\x28\x87\x2a\x52\xbb\x97\xdd\x53\x2f\x2b\xc7\x0c\xad\x55\xb0\x89\x6d\xd4\x44\x94\xff\x5e\xf9\x8b\x65\xd9\x94\x96\xaa\x8a\x9a\xa8\x7b\x59\xc0\xef\xcd\x8c\x9f\xe7\x8d\xfb\xfe\x0a\x4a\xac\xb8\x40\x48\x0d\x6f\x50\x1b\xda\xb4\x07\x2e\x34\x2a\x73\xf8\x82\x75\x8b\x2a\x85\xab\x61\xd8\x24\x16\xc9\x2b\x10\xd2\x00\xd9\xca\xa2\x33\x72\x1f\xf1\xfa\x88\xb8\xa0\x35\xa7\x1a\xde\x5d\x03\x29\xec\x13\x6a\xb2\xa7\x77\x35\x82\xff\x23\x5b\xda\xe0\x04\xcd\x64\x6d\xbf\x78\x82\x47\xdc\xc8\xba\x6b\x84\x86\xef\xc0\xdc\x93\x5f\x0f\x14\x5e\x01\x93\xc2\x50\x2e\x74\x21\x1e\x27\xfc\x94\x29\xa4\x06\xcb\x03\x35\x29\xa4\x5d\x5b\xc6\x17\xcb\xf3\xc4\x50\xf9\x8d\x14\x06\x1f\x8c\x8f\xc8\x2b\x78\x73\x27\x79\x4d\x8e\x7b\x29\x14\xee\xbe\xf2\xb6\xc5\x32\x63\xe6\x21\x87\xde\xf1\x51\x94\x9e\x91\xb0\x4e\x29\x8b\xb6\x25\x5b\xc5\xc8\x56\x7e\xcb\x72\x72\x2b\x32\x17\xe8\x3d\x9a\x0f\x92\x51\xc3\xa5\xc8\xf2\xdc\x71\x15\x15\x9f\x11\x2e\xb8\x28\x2f\x5d\xc5\xe7\x9b\x75\x81\x01\x00\xa2\x28\x45\x54\xd1\xeb\x19\x70\x6e\x69\x22\x61\x12\x0f\x05\xef\x27\x4b\x27\x52\x04\xdc\x1c\xb8\x7f\x6c\xc3\x81\xbb\x9d\xa7\xe0\x60\xbc\x02\x49\xfa\x7e\x2c\x60\x18\xc8\xad\xfe\x84\x4a\x66\x5e\x86\x64\xb6\x0a\xd7\x10\xd5\xd8\x24\xc9\x31\x11\xd6\x1a\x63\xc0\xfb\x0e\x15\x47\x4d\x3e\x76\xda\x58\x60\x36\x8b\x91\xcf\x52\x44\xfc\x0e\xcd\x8e\x51\x21\x50\x65\x6f\x67\x94\xcb\x31\x6b\x7e\x9a\x76\x3c\xa3\xa7\x5e\xcf\x64\x9a\x34\xc9\xef\xca\xf4\xaa\x55\x72\x4d\xbe\x6c\x97\xe1\xd4\x0b\x23\x23\x04\x1a\x86\xcd\x34\xe4\xd3\xb3\xc5\xcb\xfe\xa2\x66\xcb\x4b\x1c\x27\x10\x7e\x7f\x65\xa2\xfc\xa9\x55\x16\x9d\x30\x73\xc1\xea\x96\x7e\x9e\x76\xfe\x55\x33\xff\xbf\x28\x7f\xd2\xd9\xaf\xf2\x9e\x0c\x52\x2c\xce\xff\xa5\xa6\x3f\x9f\xfe\xeb\x87\xff\x5a\xa3\x3c\xf7\x05\xb9\xc2\xf4\xff\xb8\xe7\x7f\x04\x00\x00\xff\xff\x7f\x29\xd3\xb4\x1d\x0b\x00\x00")
+var _templates21_auto_timestampsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\x9b\x40\x10\x3d\x93\x5f\x31\x45\x91\x05\x55\xc2\x0f\x88\xe4\x03\xca\xa1\x8a\xd4\xfa\x62\x9f\x7a\xb1\x36\x30\x6e\x57\xc5\xbb\x64\x77\x51\x13\x61\xfe\x7b\xc5\x7e\xe0\x35\xd8\xc4\x54\x8e\x9a\x48\xcd\x25\xac\x79\xb3\xf3\xe6\xcd\xbe\x61\xeb\xfa\x16\x72\xdc\x50\x86\x10\x2a\xba\x45\xa9\xc8\xb6\x5c\x53\x26\x51\xa8\xf5\x4f\x2c\x4a\x14\x21\xdc\x36\xcd\x55\xd0\x22\xe9\x06\x18\x57\x90\x2c\x78\x5a\x29\xbe\x72\x78\xb9\x47\x5c\x93\x82\x12\x09\x77\x73\x48\xd2\xf6\x09\x65\xb2\x22\x8f\x05\x82\xf9\x97\x2c\xc8\x16\x3d\x74\xc6\x8b\xf6\x17\x13\x60\x10\xf7\xbc\xa8\xb6\x4c\xc2\x0e\x32\xfd\x64\xde\xdb\x10\xba\x81\x8c\x33\x45\x28\x93\x29\x7b\xf1\xe2\xc3\x4c\x20\x51\x98\xaf\x89\x0a\x21\xac\xca\xdc\x2d\xda\x38\x13\x68\x99\xdf\x73\xa6\xf0\x59\x19\x12\x74\x03\x9f\x1e\x39\x2d\x92\x7d\x2d\xa9\xc0\xe5\x2f\x5a\x96\x98\x47\x99\x7a\x8e\xa1\xd6\xf1\xc8\x72\x13\x11\x64\x95\x10\x2d\xba\xa5\xdc\x2a\x96\x2c\xf8\xef\x28\x4e\x1e\x58\xa4\x37\xfa\x82\xea\x2b\xcf\x88\xa2\x9c\x45\x71\xac\x63\x05\x61\x3f\x10\xae\x29\xcb\x6f\x34\xe3\x61\xb1\x7a\x63\x00\x00\x27\x4a\xea\x54\x34\x7a\x5a\x9c\x8e\xf6\x24\x0c\x5c\x53\xf0\xc9\x7b\x75\x20\x85\xc5\xf5\x81\xab\x97\xd2\x36\x5c\x57\x1e\x82\x86\xd1\x0d\xf0\xa4\xae\x3b\x02\x4d\x93\x3c\xc8\xef\x28\x78\x64\x64\x08\x7a\x6f\x61\x0e\x4e\x8d\xab\x20\xd8\x27\xc2\x42\xe2\x30\xdb\xe7\x7d\xba\x13\xd9\x60\x3e\x07\x46\x0b\xd8\xed\x5e\xe1\x61\x2b\x4c\x15\xdc\x1d\x50\x38\x42\x70\xd6\x61\x07\x14\x1d\x8b\xa7\x0a\x05\x45\x99\x7c\xab\xa4\x6a\x37\x8a\x7a\xbb\xc4\xbd\xec\x0e\xbf\x44\xb5\xcc\x08\x63\x28\xa2\x59\x2f\xe4\xa6\x63\x15\x1f\xa6\xed\x8e\xd1\xb1\xa5\x27\x99\xe9\xa4\x77\x8e\xcf\xed\xe4\x09\x69\x7b\x25\xbc\x97\x46\xda\x02\xcf\x6a\x64\x87\x1d\x50\x7c\x4f\x8d\xac\x6b\x64\xf9\xf8\xd0\xe9\x60\x6e\xaa\xd9\x08\xbb\x51\xd3\x5c\xf9\x5b\x1e\x9f\xd0\x46\x8c\x0f\x35\xa1\x3f\xe2\x50\x06\xfb\x77\x91\xb9\xec\x09\xe0\x70\x67\xb9\x79\xd4\xac\x53\x8c\x7a\xca\x6d\xc3\x04\xb3\x0e\x7a\x90\x42\xd3\x99\xec\x9a\x20\xf0\xcf\xf3\x60\x79\x21\xc7\xbc\xe6\x97\xff\x37\x9a\x13\x37\x9a\xb7\xf2\xce\x3f\xbd\xd0\x58\x29\x46\xbf\x3e\x63\xbe\x6a\xa6\x59\xeb\xef\x3f\x81\xb6\xb6\x73\x3c\xd9\x41\xfb\xf4\x2c\x83\x89\x9f\xbf\xa9\x3e\xf6\x72\xba\xa3\x73\x74\x79\xa9\xb9\x37\xd6\x9e\x29\xbd\x39\x31\xf5\x26\x0d\xbd\xa9\x5a\x1d\x57\xe6\xcd\x66\xde\x9f\x01\x00\x15\x24\x59\x1e\xc6\x0d\x00\x00")
 
 func templates21_auto_timestampsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -529,11 +556,351 @@ func templates21_auto_timestampsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/21_auto_timestamps.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6, 0xc7, 0xdc, 0xb2, 0x96, 0x8d, 0xe0, 0xd8, 0xb, 0x5f, 0x68, 0x24, 0xd5, 0xb1, 0xc6, 0xcd, 0xa, 0x4c, 0xee, 0xc, 0x46, 0x4c, 0x76, 0x24, 0xc0, 0x81, 0xdf, 0xdc, 0x22, 0x96, 0xd, 0x74}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9a, 0x63, 0x7a, 0x7, 0x50, 0x2, 0xb2, 0xf0, 0xe0, 0x70, 0x5f, 0xad, 0xb7, 0x83, 0x33, 0x6e, 0x23, 0xe6, 0x2b, 0xdb, 0x2d, 0xb0, 0xa1, 0xb9, 0xad, 0x9b, 0x24, 0x18, 0x4c, 0xc, 0x30, 0xd6}}
 	return a, nil
 }
 
-var _templatesSingletonBoil_queriesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd2\xcd\x8e\xda\x30\x14\x86\xe1\x75\x7c\x15\x47\x48\x45\x50\x21\xd3\x75\x24\x16\x08\xba\x40\xa5\x3f\x40\x51\xd7\x56\x7c\x68\x2c\x39\x4e\xe2\x63\x43\x98\x28\xf7\x3e\x82\x60\x86\xcc\x84\xd9\x7e\x7e\x9f\xd8\x8b\x1c\x85\x05\xa9\x84\xc6\xc4\xc1\x0c\xa4\x55\x47\xb4\xc4\x97\xed\x52\xb3\x68\xbd\x89\xe1\x5b\x55\xd7\x85\x55\xc6\x1d\x60\xf0\xa5\x1a\x40\x38\xe6\xeb\x4d\xd3\x4c\x58\xb4\xfd\xac\xd9\x5e\x1b\x16\xed\x09\x57\x46\x62\xf5\x47\x8b\x04\xd3\x5c\x4b\xb4\x14\x03\x00\xd4\xf5\xbd\xed\x6b\xae\x37\xec\x09\xd7\x82\xdc\xca\x10\x5a\xb7\x5a\x5e\x1d\x7c\xc4\x8f\x4d\x70\xbb\x24\xc5\x4c\xbc\x89\x3e\xd7\x36\x41\x2c\xf1\x20\xbc\x76\x3f\xf0\x7c\xca\xad\x8c\x7b\x45\xb7\x09\x72\xee\x5d\xbe\xc8\xb5\xcf\x0c\xc5\xcf\xee\x7a\x68\x02\xfb\x9b\x17\x0b\x2d\x3c\x61\xfc\xfc\x89\xf7\x26\xa0\xdf\xde\x15\xde\xbd\x77\x5d\xf4\xd8\x04\xb7\x10\x84\xff\x52\x34\xdf\x2b\x45\x8e\x82\xef\xba\xbe\xe6\xe2\x1b\xc6\xa6\x53\xf8\x85\xa7\x8d\x47\x7b\x06\x65\x94\x53\x42\xab\x17\x24\x10\x60\xf0\x04\xed\xee\x49\x99\xff\xe0\x52\x84\x42\x10\xa1\x04\x65\xda\x93\x9f\xb9\x24\x76\xf0\x26\xb9\x7f\x63\x94\xe5\x92\x80\x73\x5e\x66\x3c\x24\x63\xf8\x5a\x7a\xb4\x0a\xa9\x9d\xa0\x66\x51\x09\xf1\x0c\x86\x9d\xb9\x6e\x58\x14\x86\x1d\xba\xdb\xf3\x47\xe5\x04\x86\xb7\x1f\x7a\xcc\xa2\x32\xe3\xf3\xa2\xd0\xe7\xcb\x7c\xb9\x8a\x73\x3e\x66\x2c\xb2\xe8\xbc\x35\x50\xb2\x86\xbd\x06\x00\x00\xff\xff\xaf\x58\xd4\xae\x01\x03\x00\x00")
+var _templates22_validate_lengthsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\xdd\x6e\xe3\x36\x13\xbd\x8e\x9e\x62\xd6\xc8\xb7\x91\x02\x2f\xe3\xec\xd7\xa6\xdd\x14\x2e\xb0\xe9\x1f\xb6\x48\xbd\x8b\x26\xe9\x45\x83\x5c\x50\xd2\xc8\x26\x42\x91\x0e\x49\x65\x63\x08\x7a\xf7\x62\x48\xc9\x3f\x8a\x9d\xa6\x40\x8b\xa6\x57\x71\x48\xce\x99\x39\x33\xc3\xe1\x51\x5d\xbf\x81\x7d\x2e\x05\xb7\x70\x3a\x06\xf6\x9e\x7e\xa1\x65\x97\x3c\x95\x08\xe1\x0f\x9b\xf0\x12\x9b\x26\x3a\x3a\x82\xdf\xb8\x14\x39\x77\x78\x8e\x6a\xea\x66\x16\xb2\x19\x66\xb7\x16\xdc\x8c\x3b\xb0\xce\x08\x35\x05\xae\x72\x48\x85\xe2\x66\x01\xf7\x5c\x56\x68\xa1\x10\x0e\x3e\x0b\x37\x13\x0a\xdc\x0c\x09\x46\xb6\xe6\x39\x66\x92\x1b\xcc\x21\x5d\xd0\x96\x30\x90\x69\x59\x95\x0a\xdc\x62\x8e\x76\x08\xf8\x70\x0a\xdc\x41\xa9\xad\x83\xe3\x13\x48\x17\x8e\xe0\xb4\x81\x7b\x6e\x82\x8f\xf8\xf8\x24\x19\x12\x24\xb9\xcd\x31\x13\x25\x97\x76\xcd\x9b\x30\x30\x37\x98\x09\x2b\xb4\xf2\xa1\xd9\x8c\x4b\x0c\xc8\xc7\x6f\xff\xff\xc5\x97\x27\x5f\x7d\xcd\xde\xbd\xf3\xa0\xad\x79\x7c\x3c\x1a\xbe\x4d\x18\x81\x5e\xa9\x54\x57\x2a\xc7\xbc\x8d\xcb\x82\x14\xb7\xb8\xe6\xbd\xe4\x0f\x09\x70\x83\xa0\xb4\x0b\xc9\xc0\x9c\x45\x45\xa5\x32\x88\x35\x1c\xd6\x75\x48\x2d\xbb\x9a\x5f\x08\x35\xad\x24\x37\x4d\x93\xf4\xb3\x18\x27\x80\xc6\x68\x03\x75\xb4\x27\x0a\xfa\xed\x6b\xa1\x59\x48\xd3\x0f\xb4\x67\xe3\xe4\x1b\x90\xa8\x62\xda\x4d\xe0\xd5\x18\x46\x74\x7c\xcf\xa0\xab\x8c\xf2\x36\xd7\xa3\x9b\x68\xaf\x89\xa2\x6e\x4d\x09\x19\x35\xd1\x2a\xdd\x01\x07\xa4\xb0\x8e\x2a\x86\x5d\x79\x7c\xf1\x72\xad\x0e\x9c\xaf\xd4\xe3\x3a\xfc\x29\x9f\xcd\x38\xe1\xfa\x66\x49\xe7\x9e\x1b\x1f\x5b\xb7\x16\xed\x51\xbf\x19\xae\xa6\x08\xfb\x99\x96\xc4\xb3\x6d\xb2\xef\x42\x86\x9b\x26\x9c\xd9\x2f\xf9\x03\xed\xd2\x29\xf6\x0b\x7f\x08\x1d\xd7\xed\x8a\x02\xa6\x2e\x9c\x19\x2d\x2d\x32\x2d\xdf\x77\x8d\xdc\xc6\x19\x40\xbd\xab\xae\x8b\x3b\x7b\xbc\x0b\xcb\x97\x8b\x39\xc2\x20\x34\xef\x80\xb0\x44\xe1\x13\x7d\x7d\x63\x2a\x85\xb1\x66\x75\xbd\x44\x6e\x9a\x24\x81\x6f\xa1\xae\xc9\x73\xd3\xf8\x0a\x78\x7a\x63\xe0\xf3\x39\xaa\xdc\x97\x67\x48\x94\xb5\xb1\x6c\x82\x9f\xe3\x41\x5d\xef\xb3\x4f\xb7\xd3\xe0\xfd\x94\x6c\x37\x6e\x55\x0b\xdf\xfe\x07\xc2\x82\xd4\x6a\x8a\x86\xee\x94\x5a\x79\xca\x66\xdc\xf0\xcc\xa1\xb1\x83\x24\xa1\x32\x7b\x1a\x28\x2d\x12\x17\x6d\x20\xde\xe4\xa3\x2a\x29\xd9\x45\x20\x95\xf4\x37\xed\x9d\x64\x93\x4a\xca\x6e\xbf\x65\xdd\xa3\xca\x7c\x9b\xc2\xeb\xd7\x4f\xa4\xa3\x75\xf1\x32\xb3\xb2\x49\xfa\x70\xb3\xc4\x3d\x22\x74\xa3\x94\x90\x3d\xb6\x87\xff\x89\xea\x6f\xf2\xbc\xbe\xa1\x31\xb9\xde\xc9\x7d\x12\xff\x0e\x07\x8a\xea\x19\xe1\xfb\xc6\x3d\xf3\x67\x9f\xd3\x96\xfd\x4d\x6f\xf9\xb2\x19\x1e\x6e\x56\xe8\xe9\x46\x3c\x7c\xa9\xc5\x53\x79\xd3\x63\x49\xaf\x2b\x11\x61\x1f\xec\xf7\xe1\x25\x85\x98\x86\x34\x2d\x7d\x5a\xbe\xc1\xa3\x04\xe2\xc7\xe3\xca\xbf\xf7\xac\x35\x7b\x3c\xb0\xc2\x36\x8d\xac\xe5\x91\x6e\x68\xbd\xf2\x09\xda\x35\xed\xd9\x8f\xc2\xd9\xb8\xae\x37\x83\x68\x9a\x21\xb4\x6b\x17\x24\x07\x28\xad\x7f\x7f\x2e\x73\x8d\xd6\xeb\x02\x7a\x55\x85\xea\xd4\xc9\xb6\x68\x7a\xc1\xec\x4a\xb4\xcf\xf9\xf2\x7d\xa7\xf8\xa2\x26\x6a\x9f\x33\xf6\x13\x2a\x34\xdc\x61\x27\x2e\xe8\xe8\x9a\x62\x5b\x49\x35\x84\xad\xcf\x38\x64\x5c\x41\x8a\x20\x94\x45\xe3\x30\x3f\x05\xe1\x2c\x18\xbc\xab\x84\x59\x09\x20\xaf\xb5\x26\x1f\x2f\x61\x72\x75\x7e\xee\x75\x96\xae\x1c\x70\xc8\xb1\xe0\x95\x74\x43\x2f\x86\x2c\x3a\xaf\xb5\x08\x60\x4d\x03\x3e\x56\x16\xcc\xa3\x55\x65\x8a\xc6\x7a\x8b\x54\x6b\x69\x29\x92\x03\x47\xb1\x38\x2d\x73\xe0\x73\x6e\x1c\x14\x46\x97\x50\xa9\x0e\x9a\x1b\x54\x07\x6b\x92\xeb\xe8\x08\x2e\x67\xd8\xea\x28\x61\x81\x43\xaa\x85\x64\x2d\x7b\xa1\xd5\x9a\xfa\x21\x95\x8a\xf7\x68\x16\x30\x37\x3a\x95\x58\x42\x41\x2a\xef\xf9\xba\x6d\x5d\xb0\x2d\x15\xce\x56\x7f\xdb\xf4\x4e\x21\xa4\x43\xd3\xca\x9d\xb3\xc5\xaf\x5d\x86\x77\xe8\xa0\xbf\xa0\x6a\xf6\x43\x7e\x4e\xc7\x30\x18\x74\x6b\x5b\xd5\x81\xdd\x21\x0c\xda\xc9\xb4\xe3\xfe\xfd\x7c\xf1\x71\x42\x62\xa1\xae\x5b\x47\x63\x98\x1b\xa1\x5c\x01\x03\x1a\x55\x9a\xfd\xcf\x26\x30\x1e\xc3\x68\xb0\x8a\xba\x3f\x24\x7a\xb8\xa2\x44\x76\x29\x4a\x1c\x6c\x45\x25\x44\xf6\xc1\xfe\x8e\x46\xc7\xc9\xf3\x41\x37\x66\xc9\x6e\xe0\x33\x31\xa5\x68\x95\x90\xdb\xa0\x57\xd7\x4e\x14\x10\x00\xda\x89\xd3\xe1\xfd\x53\x03\xb8\xbb\x72\x4f\x4e\x81\xad\x6e\xfb\x5f\x0c\x8c\xb1\x24\x5a\xca\x80\xdd\x5f\x0e\x5b\x3e\x1b\xea\xfa\x0d\xa0\xca\x9b\x26\xfa\x63\x00\xb8\xd5\x41\x9a\x21\x0e\x00\x00")
+
+func templates22_validate_lengthsGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates22_validate_lengthsGoTpl,
+		"templates/22_validate_lengths.go.tpl",
+	)
+}
+
+func templates22_validate_lengthsGoTpl() (*asset, error) {
+	bytes, err := templates22_validate_lengthsGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/22_validate_lengths.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa5, 0x81, 0x72, 0x6a, 0x94, 0x34, 0xf2, 0xc9, 0x62, 0x9f, 0x2, 0xd4, 0xc2, 0x69, 0x28, 0xde, 0x86, 0xfe, 0xf8, 0x30, 0x7c, 0x12, 0x33, 0x6c, 0x75, 0xdb, 0x92, 0x1d, 0x67, 0xd1, 0xab, 0x7}}
+	return a, nil
+}
+
+var _templates23_indexesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x31\x8f\xe2\x30\x10\x85\x6b\xf2\x2b\x9e\x22\xae\x0b\xa6\x8f\x44\x71\x3a\x89\xd3\x15\x5c\xc3\xa1\x2b\x10\xc5\x90\x4c\xc0\x77\xc1\x49\x6c\x67\xc5\xca\xcc\x7f\x5f\xc5\x0e\x2b\x6d\xb1\x5b\xe5\xc5\x7e\xfe\xe6\xcd\x4c\x08\x2b\xe8\x06\xea\x27\x1b\xb6\xe4\xf9\x97\xa9\xf9\xbe\x63\x4f\x35\x79\xc2\x4a\x24\x9b\x1c\x4b\x6a\x35\x39\x94\x1b\xa8\xef\x93\x62\xa7\xfe\xd0\xb9\x65\xa4\x8f\xfa\x4d\x37\x16\xc9\xd6\x6b\x84\x90\xbc\xea\xd0\xef\xb5\xb9\x8c\x2d\x59\x91\x08\x65\x87\x9a\x5d\x65\xf5\x99\x1d\xfc\x95\xa1\xe7\xd3\xce\x20\x84\x0f\xa0\x02\x7c\x2f\xe1\xbb\x09\x58\x5d\xb9\xfa\x0f\x7f\x25\x0f\xc2\x30\xb2\x7d\x45\xa3\x5b\xcf\xd6\xa1\x33\x20\x33\x63\x6a\x54\x5d\x3b\xde\x8c\xca\x5e\xc8\x7e\x9d\x62\x83\xe3\x29\xea\x90\x2d\xa6\xe6\x2c\x99\x0b\x63\x19\x39\xb1\xc5\x14\x65\xb6\x8b\x64\x8b\x30\xf5\x57\x22\x84\xde\x6a\xe3\x1b\xe4\xdf\x86\x7c\x7e\xf0\x9e\xf8\x47\x2c\xef\x4a\x1c\x4f\xce\x5b\x6d\x2e\x01\x13\x7c\x76\xcd\xb7\x78\x20\x5d\xee\xa8\xc7\x52\xed\xa3\xde\x8e\xa6\x72\x6a\x18\x3b\xcf\x7f\x2d\xf5\x78\xe0\x5f\xa7\x0d\xf2\x02\xf9\xb4\x00\x48\x81\x83\xd1\xc3\x18\x13\xcc\xbc\x74\x20\x12\x82\x6e\x9e\x35\xb6\x71\x2c\x22\x05\x92\xfa\x24\xf0\xd3\x16\x02\x9b\x5a\x44\x8a\x34\x85\xf8\x93\xa5\x75\xb3\xa9\x45\xb2\xb7\x01\x00\x94\xfc\x07\x26\x1b\x02\x00\x00")
+
+func templates23_indexesGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates23_indexesGoTpl,
+		"templates/23_indexes.go.tpl",
+	)
+}
+
+func templates23_indexesGoTpl() (*asset, error) {
+	bytes, err := templates23_indexesGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/23_indexes.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0x5a, 0xd7, 0x21, 0x46, 0xeb, 0xed, 0x31, 0xbf, 0xeb, 0xc1, 0x69, 0xbc, 0x34, 0xb5, 0xb, 0x52, 0x18, 0xa6, 0x62, 0x4a, 0xef, 0xf4, 0xb7, 0x5a, 0x6a, 0x31, 0x8d, 0xe2, 0xb4, 0x56, 0x1c}}
+	return a, nil
+}
+
+var _templates24_jsonGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xc1\x6e\xdb\x3a\x10\x3c\x8b\x5f\xb1\x4f\xc8\x2b\xa4\x40\x51\xee\x29\x72\x08\x5c\x14\x68\x81\x38\x05\x92\xf4\x52\x14\x01\x6d\xad\x64\x06\x14\xe9\x90\x94\x0d\x97\xd8\x7f\x2f\x56\xb2\x2c\x3b\x70\x73\x32\xcd\x9d\x9d\x1d\xee\x0c\x14\xe3\x15\xa8\x1a\xca\xef\x8f\x0f\xf3\x7b\x0c\x2b\x5b\x79\xb8\x22\x12\x7c\x7f\x21\xb5\x92\x1e\x6e\x6e\xa1\xbc\xe3\x13\xfa\xf2\x49\x2e\x34\xc2\xf0\x53\xce\x65\x8b\x23\xd4\x3a\xd5\xbc\x84\x85\x7e\x31\xb2\xc5\xbe\xe5\x0c\xa6\x55\x61\xde\x69\xcd\x65\x7c\x1b\x66\xf2\xff\x1f\x56\xab\xe5\x0e\x52\xdb\xaa\x90\x12\x89\xeb\x6b\xb8\x97\xce\xaf\xa4\x66\x04\xa8\x76\xad\xb1\x45\x13\x3c\xbc\x7a\x6b\xca\x7d\x0d\x5d\x01\x5b\xa7\x82\x32\x0d\x84\x15\xc2\xd2\xea\xae\x35\x1e\x94\x81\xc0\xa3\xc1\xba\x0a\x1d\x93\x6d\x55\x58\x81\xe1\xc1\x23\x26\x46\x55\x4f\x7a\x88\x34\xd6\x01\x6c\x17\x62\x44\xed\x91\x48\xfa\x1e\x1f\x23\x9a\x8a\xa8\x14\x75\x67\x96\x90\x59\x88\x71\xd8\x49\xf9\xbc\x7e\x54\xa6\xe9\xb4\x74\x44\xf9\xb1\xda\x2c\x87\xec\xd7\xef\xc5\x2e\x60\x01\xe8\x9c\x75\x39\x44\x91\x38\x0c\x9d\x33\xb0\xb0\x4a\x8f\xf2\x19\xfc\xb0\x78\xc5\x65\x60\x3c\x17\xf8\xe6\xab\x42\x5d\x45\x91\x24\xbc\x7f\x27\x4d\x83\x70\x31\x88\x3e\xda\xe9\xac\xbf\xf0\x44\x7b\x1c\x23\xee\x46\xab\xf6\x02\x07\xcc\xd8\x3c\xda\x30\xe0\x55\x0d\xc6\x06\xc8\x54\x63\xac\xc3\xf7\xde\x1d\xb7\xc0\x45\xf9\x24\x9b\x6f\x3d\x2e\x9f\xe6\x8d\x1e\x07\xd9\xcc\xa4\x47\xc8\x7a\xd8\x4c\x7a\xf6\x22\x65\x93\xd2\xfc\x1d\xcf\x28\x71\x20\x61\xee\x1b\x88\x71\xed\x94\x09\x35\xa4\xff\xbf\xa5\x03\x2b\x51\x01\x3f\xa5\xee\xf0\x06\x6c\x19\xe3\xe1\x65\x44\x54\xec\xa7\xf7\x96\x9c\x9e\xa9\x60\x67\x26\x3b\x73\x41\x82\x8d\x7f\x36\xed\x07\x39\x3a\x54\x39\x49\x63\x32\x5a\xe5\xfb\x57\xd4\xce\xb6\x50\xc9\x20\x99\x47\x3a\x84\x3e\x22\x32\x70\xd4\x94\x83\x3f\xe8\x2c\x6c\x58\xe8\x14\x8e\xcb\x7f\xa4\xe3\x44\x45\xc6\x9c\x30\x24\x24\x1f\x12\xc2\x01\x09\xbb\x35\x4e\xe9\xfa\x62\xb7\x66\x62\xe8\xc5\x9f\xe5\x16\xc9\x46\x3a\xd8\x7c\xd4\x28\x12\x55\xf3\x18\xb6\xeb\xf4\xd5\xbd\x92\x02\x3e\x6d\xf2\xcf\x3d\xe0\xbf\x5b\x30\x4a\xb3\x98\x31\xae\xe8\x9c\x48\x48\x88\xe4\xd2\xc2\xed\x79\x05\xd9\x26\x3f\xa4\xdb\x28\x2d\x86\xaf\x01\x9a\x8a\x48\xfc\x1d\x00\xe6\x0e\x3c\x90\x5b\x04\x00\x00")
+
+func templates24_jsonGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates24_jsonGoTpl,
+		"templates/24_json.go.tpl",
+	)
+}
+
+func templates24_jsonGoTpl() (*asset, error) {
+	bytes, err := templates24_jsonGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/24_json.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0xa2, 0x9c, 0xd6, 0x22, 0x5e, 0x7f, 0x2e, 0x54, 0x77, 0x97, 0x9d, 0x21, 0x9c, 0x9e, 0x35, 0x57, 0x91, 0xf9, 0xb5, 0xc7, 0xf9, 0xe1, 0xf2, 0xfa, 0x37, 0x21, 0x7b, 0x5f, 0xc3, 0xc9, 0xf3}}
+	return a, nil
+}
+
+var _templates25_repositoryGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x8e\xdb\x36\x10\x3d\x5b\x5f\x31\x30\x7a\x90\x0a\x2f\x73\x29\x7a\x28\x90\x83\xe1\x4d\x8a\x45\x91\xed\xd6\x9b\xfd\x00\x9a\x1a\x39\xec\xd2\xa4\x42\x52\xb5\x0d\x56\xff\x5e\x90\x94\x4c\xab\x91\x63\x7b\xb3\x7b\xb2\x29\xcd\xbc\xf7\x66\x34\x6f\xe8\xdc\x0d\xf0\x0a\xc8\xef\x28\x51\x53\x8b\x77\xd2\xa2\xae\x28\x43\x03\x37\x6d\x9b\xf9\xd7\x3f\x51\xc1\xa9\x81\xdf\xde\x03\x99\xfb\x7f\x68\xc8\x67\xba\x12\x08\xf1\x87\xdc\xd3\x0d\xa6\x60\xa6\xc4\x2d\x56\x21\xdc\x7c\x15\x8b\x70\xe2\x92\x5b\xae\xa4\xe9\x33\x16\x4a\x34\x9b\x74\x7c\xf8\x03\xf7\x87\x67\x07\xa0\xfa\xd9\x03\x07\xa0\x1e\x34\x50\x19\xf8\x17\x8c\xd5\x5c\xae\x3f\xd1\x1a\xf2\x20\x6e\xa1\x84\xe9\x74\x16\x83\xd7\xe4\x31\xfc\xfd\xd8\x48\x66\x08\xa3\x1b\x14\x0b\x6a\xf0\x74\x88\xc6\x5a\x50\x86\x4b\x34\xa8\xff\xc1\x32\x95\x55\x3f\xcf\xf5\x3a\x88\xf9\x5b\x71\xf9\x28\xb8\xef\xd0\x14\xa6\x49\xe7\x41\xe4\xe7\x7d\x1d\x44\xfa\x40\x98\xce\x60\x9a\x50\x8c\xaa\xac\xc7\xa0\xb2\x04\x32\x2f\xcb\x47\x55\xd9\x5b\x14\x68\x31\xf5\x86\xca\xf4\x34\x65\xe2\x0e\xd9\x03\xd5\x74\x13\x34\x4c\x99\xdd\x01\x53\xd2\xe2\xce\x92\x45\xfc\x9d\x81\x8f\x81\x95\xe2\xa2\x7f\xf4\x61\x87\xac\xb1\x4a\x1f\x29\xf0\x31\x7d\x25\x1e\x25\x66\xa5\x00\x3f\x0b\xf7\xaa\xcb\x1f\xa5\x7f\x0f\xd3\x44\xf4\x3d\x86\x2e\x30\xbd\x42\x79\xd4\x50\xad\xb6\x66\x5e\x55\x41\x47\xce\xa5\xfd\xf5\x97\x19\xa0\xd6\x4a\x17\x29\x21\x8a\x59\xc6\x48\x64\x16\x47\xf2\x3d\x8b\x4f\x1b\xd0\xb4\x6d\xf6\xee\x1d\x38\x17\x47\x82\x3c\xd5\x8f\x5c\xae\x1b\x41\x75\xdb\x2e\xb1\x56\x86\x5b\xa5\xf7\xc0\x0d\xd8\x2f\x08\x06\x2d\xa8\x0a\x16\xcb\xa7\x5b\xa8\x1a\xc9\xe2\xa8\xae\x3b\x43\x94\x50\x29\x7d\x12\x6d\x06\x46\x01\x53\x25\x42\x63\xb8\x5c\x7b\xbc\x0d\x30\x2a\xc1\x6c\x69\x0d\x5c\x02\x85\x8d\x62\xcf\x24\xb3\xfb\x1a\xcf\x0b\xea\xcd\x07\x2e\x9b\x7c\xe4\xb2\xcc\x9d\x3b\x6a\x7d\xdb\xce\x3c\x44\x1c\x45\x7f\x30\x28\x90\xd9\x30\xfc\x84\x90\x68\x8a\x02\xf2\x9f\x4f\x48\x8d\xed\xcd\x26\x1f\x76\xdc\x58\xf3\x5d\xec\x02\xf2\x95\x52\x22\xe5\xcc\x85\xf8\x36\x61\xa3\x4a\x03\x84\x90\xaf\x1b\xf2\x57\x83\x7a\xff\x49\x95\x05\xe4\xa3\xec\xc1\x31\x09\x6e\xa1\x1a\x69\x2f\x05\x1c\x4c\x47\x36\x59\xa2\x50\x74\xa4\x35\x0a\xc6\x0b\x2f\x62\x66\x36\xe9\x46\x4a\x2a\xdb\x9b\xed\xce\x2c\x91\x96\x7f\x4a\xb1\x6f\xdb\x6c\x72\x27\x0d\x6a\x7b\x39\xf0\x0c\x58\xb7\xb4\x3a\xd3\x85\xc3\x81\xee\xa9\x2e\xa9\xc5\x1f\x87\x73\xae\x1f\x76\x2f\x32\x2e\x86\xcb\x51\x9d\xe3\x55\x5c\x3c\x3e\xea\x0b\xd5\x65\xb7\x5a\xfc\xf7\x75\x2e\x98\xe5\xff\x1c\xc9\x45\x6d\xe6\x47\xff\x1e\xb7\xe7\x46\x57\xa3\x6d\xb4\x8c\x86\x3a\x13\xeb\x11\x57\x94\x3d\x63\x09\xab\x7d\x48\x38\xf2\x5a\xef\x3f\x92\x79\x2b\x5e\xc0\x9c\x17\x67\x6d\xe5\xb2\x49\x94\x97\x22\x6f\xd5\x56\x8e\xc5\xba\x50\xf2\xd0\xac\xa7\x62\xfd\x1d\xd2\x30\xeb\xda\x2c\x6a\xcd\xcf\x66\x14\xf0\x56\xa6\x3e\xaa\xd1\x53\x8c\xc6\xf6\xbc\x3d\x51\x60\xed\x6f\xd4\xc3\x65\x35\x94\x40\x08\x29\xb2\x6b\x0a\xbc\x7e\xb7\x8c\x7d\x9e\x63\xd9\x43\xc4\x73\xe2\xaf\x53\xfb\xaa\x5b\x6d\xbc\x90\x07\xd1\x68\x2a\xda\x36\xf7\xbb\xcd\xb7\x93\x1c\x91\x76\x0d\xb9\x4a\xf3\xcb\x57\xe7\xa5\x02\x07\x0c\x2f\x91\xf8\xc2\x0d\x7d\xa4\x4f\x91\x21\x46\x52\x71\x66\x89\x5f\x2e\xf2\x55\xb7\xfd\x40\xfa\x10\xb9\x9f\xd8\x2e\xfb\xba\x4e\xbe\xc5\x1d\x32\xd0\x3a\x24\xf8\x21\xad\x6f\x7f\x33\x0d\x94\x0f\xe9\xa2\xf2\x53\x90\x3d\x5a\x37\x3f\xe1\x90\x39\x87\xb2\x84\x9b\xb6\xcd\xfe\x1b\x00\x2d\x46\x30\xe8\x05\x0d\x00\x00")
+
+func templates25_repositoryGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates25_repositoryGoTpl,
+		"templates/25_repository.go.tpl",
+	)
+}
+
+func templates25_repositoryGoTpl() (*asset, error) {
+	bytes, err := templates25_repositoryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/25_repository.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6e, 0x83, 0x8d, 0x58, 0x2a, 0x6b, 0x3d, 0xa0, 0xb3, 0x24, 0x8a, 0x88, 0x2e, 0xb, 0x98, 0xea, 0x71, 0xc7, 0x27, 0x84, 0x3a, 0x54, 0x73, 0x5a, 0x2d, 0xaa, 0x49, 0xb4, 0x34, 0x6d, 0xfd, 0xb6}}
+	return a, nil
+}
+
+var _templates26_dtoGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x41\x4f\xe3\x3c\x10\x3d\x93\x5f\x31\x8a\xfa\x49\xcd\xa7\x62\xee\x48\x1c\x10\xd5\xae\xb8\x50\x24\xb2\x67\x70\x9b\x49\xea\x95\x63\x5b\xb6\xb3\xab\xca\xf2\x7f\x5f\x4d\x9c\x94\xb4\x90\x65\x59\x69\x4f\x19\xc7\x6f\xde\x0c\xef\xbd\xd0\x10\x2e\x41\xd4\xc0\xbe\xa2\x42\xcb\x3d\xae\xcb\x8d\x83\xcb\x18\x33\xba\x58\x70\x29\xb8\x83\xeb\x1b\x60\xb7\x54\xa1\x63\x25\xdf\x4a\x84\xf4\x60\x0f\xbc\xc5\x11\xaa\xad\x68\x9e\xfd\x56\x3e\x2b\xde\x62\xdf\xf2\x16\x63\xb4\x50\x1e\x6d\xcf\xa8\x10\xd8\xba\xdc\x3c\x74\x52\x3e\xf9\x83\x44\xc8\x55\x27\x65\x1e\x63\x76\x75\x05\x21\xa4\xd1\xec\x9b\x79\x12\xaa\xe9\x24\xb7\x31\xae\xcb\x0d\x08\x07\x1c\x8c\xe4\x42\xc1\x4e\x9b\x03\xe8\x1a\xfc\x1e\x61\xa7\x65\xd7\x2a\x47\x47\xfe\x7e\x33\xb1\xd6\xda\xc2\xed\xe3\x3d\x6c\x75\xa7\x2a\x6e\x05\xba\x10\x44\xfd\xba\x56\x8c\x2b\xf8\x29\xfc\x1e\x68\x93\x23\x27\x77\xa0\x84\x84\x11\x14\x02\xaa\x2a\x46\x96\xf9\x83\xc1\xf9\x45\x9d\xb7\xdd\xce\x43\xc8\x2e\x48\x48\xcb\x55\x83\xb0\x48\x94\x13\x71\xee\xfa\x17\x2e\xc6\x04\x23\xc0\xed\x28\xf9\x40\x9c\x20\x63\xef\x28\x67\x82\x1b\x6f\x89\x8c\xd6\x7d\x4c\xeb\x95\x07\x73\x9c\xc3\xe8\x70\xc4\x8e\xb6\x78\xde\xdc\x71\x87\xb0\x5c\xb0\xb2\x2f\x85\x6a\x20\xff\xee\xb4\xca\x8b\x93\x29\xaf\xdb\x8c\x1c\xa2\x06\xd1\x28\x6d\xf1\xdc\xed\xd3\x36\xe2\xbd\xef\x71\x31\x86\x90\x06\xdf\x40\x7e\x99\x8f\x3c\x28\x1d\x52\xe8\x8e\x6d\x9d\x94\x24\xc7\x14\x6e\xac\x50\xbe\x86\xfc\x3f\xb7\xd2\xad\xf0\xd8\x1a\x7f\xc8\xa1\xbf\x3d\xd2\x90\x0f\x54\x4e\x16\x85\xde\x51\xae\xaa\x49\xd8\x16\xc6\xdb\x9e\x7a\x78\xd2\xf8\xfe\x3c\x8c\x4f\x32\x0d\xbe\xc2\x0b\x49\x71\x9d\x0f\x9b\xc4\x98\xbf\x4c\xa7\xc5\x8c\x82\x54\x6a\xb2\x78\xa7\x8d\x40\x77\x9e\x3f\xbf\x9f\x49\x05\x08\xe5\xf5\x5c\x3c\xd7\xe5\x86\x65\x75\xa7\x76\xb0\xd4\xf0\xff\xbb\x90\x22\x8d\x5d\x16\xb3\x0c\x94\x36\x8b\xbe\xb3\x6a\x16\x12\xb2\x8b\x3f\x0d\xe4\x67\x13\x39\x46\xe4\x54\xfc\xe5\xef\xc2\x59\x0c\x7d\x13\x03\xaf\x41\xb3\x93\x17\xec\xd1\xdb\x65\xb1\x1a\xf8\x93\x77\x1f\x36\x1d\xe1\x29\x22\xd3\x7a\xf4\xf0\x8b\xd5\x2d\x69\xe6\xd0\x7f\xc2\xc3\xda\xea\xb6\xbf\x5e\x97\x9b\x15\x48\xe4\x3f\x84\x6a\x88\x4e\x78\x07\x52\xf3\x0a\x2b\xb0\x28\xb9\x17\x5a\xb9\xbd\x30\x0e\x78\xcf\x7e\x00\x6e\xf1\x63\x87\x87\xa5\x96\xd5\xac\x81\xc5\xbf\xfd\x97\xf2\x17\xfe\x9d\x49\x0f\x37\x70\xfe\x69\xd1\x5f\x45\x2e\x56\xa7\xc8\x22\x3b\xb1\xf4\x2d\xcf\x19\x7e\xfa\x1d\x4e\xca\xf4\xfb\x83\xaa\x8a\x31\xfb\x35\x00\xf7\x97\xb6\x0a\xce\x06\x00\x00")
+
+func templates26_dtoGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates26_dtoGoTpl,
+		"templates/26_dto.go.tpl",
+	)
+}
+
+func templates26_dtoGoTpl() (*asset, error) {
+	bytes, err := templates26_dtoGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/26_dto.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0xf2, 0xc0, 0xdb, 0x1, 0x6a, 0x32, 0x64, 0xd3, 0x7f, 0x8a, 0x1c, 0xb0, 0x3f, 0x55, 0xcd, 0x2a, 0x52, 0xb7, 0x99, 0xe3, 0xde, 0xd5, 0xd7, 0x6a, 0x2f, 0x9c, 0xef, 0x7f, 0xfa, 0xce, 0x70}}
+	return a, nil
+}
+
+var _templates27_changesetGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xc1\x6e\xe3\x36\x10\x3d\x5b\x5f\x31\x35\x7a\x90\x0a\x2d\xd3\x73\x00\x1f\x02\x6f\x5b\x2c\x16\x4d\x83\x75\x82\x1e\x0b\x86\x1c\xd9\x6c\xa9\xa1\x4b\x52\xb1\x03\x85\xff\x5e\x0c\x45\xd9\x09\xea\x00\x9b\x9b\x48\x3f\xbe\x79\x7c\x6f\x86\x1e\xc7\x4f\x60\x3a\x90\xa4\x41\xfc\x86\x84\x5e\x46\x5c\xef\x24\x6d\x31\x60\x0c\x50\x93\x8b\x20\xee\xe5\xa3\x45\xf1\x25\x7c\x43\xa9\xff\x20\xfb\xdc\xc0\xa7\x94\x2a\x3e\xfa\xa3\xb4\x46\x06\xb8\x5e\x81\xb8\xe1\x2f\x0c\x13\x78\x3e\x73\x2b\x7b\xcc\xe0\xab\x2b\x78\xd8\x6b\x19\xf1\x4f\x13\x77\xa7\x02\x30\xe4\xbd\x00\x71\x87\xa0\x9c\x1d\x7a\x0a\xe0\xba\xbc\x1c\xc7\x89\x5c\x3c\xec\x37\x86\xb6\x83\x95\x3e\x25\x88\x3b\x19\x41\x9b\xae\x43\x0f\x9d\x77\x7d\x75\x75\x05\x26\x06\xf0\xee\x00\x86\xf2\x41\x2d\xa3\x7c\x94\x01\xf3\xa5\x3c\xc6\xc1\x53\x2e\xd0\xc3\xc1\xc4\x1d\x7f\x19\x0f\xce\xea\xfc\x3b\xe1\x01\x9e\xa4\x1d\x30\xb4\x4c\x85\xc7\x6b\xe8\x9c\x07\x49\x20\x07\x6d\x22\x58\xb7\x15\x70\xbf\xc3\xa9\x40\x00\x8f\x72\x3a\x38\x29\xd7\x5c\xd4\x11\x42\xf4\x92\x82\x54\xd1\x38\x82\xc3\x0e\x69\x22\x43\x05\x4a\x12\x3c\xe2\x76\x82\x09\xb8\x75\x71\x67\x68\xcb\x54\x33\x03\xc3\x81\x5c\xb9\x3f\xa8\x6c\x8e\x16\xcc\xb0\x41\x2c\xb6\xad\x8b\x39\x2c\xae\x77\x1e\x41\x3b\x35\xf4\x48\x51\x72\x49\x51\x75\x03\x29\xa8\x1d\xfc\x74\xd1\xb6\xe6\x92\xf9\xf5\x38\x9a\x0e\xc4\xad\x5b\x3b\x8a\x78\x8c\x29\x65\xc1\x8f\xce\x58\xf1\xcb\x11\xd5\x10\x9d\x1f\x47\xb4\x01\x53\x52\xf1\x08\x6a\x82\x89\x02\x6f\xe1\x0c\x2f\x5b\xaf\x4e\x91\x4e\xa9\x81\x3a\x93\x9d\x2a\xb6\x80\xde\x3b\xdf\xc0\x58\x2d\x4c\x07\x0e\x56\x2b\x20\x63\x79\xb9\x98\x82\xe2\x65\x41\x05\x71\x8b\x87\x7a\x39\x8e\xe2\xee\x9f\x2d\xf7\x51\x4a\xd7\x6c\xd3\xe5\xbe\xd8\x7b\xf7\x64\x34\xea\x9c\xde\xe4\xec\xb2\xa9\x16\xa9\xaa\x16\x4f\xd2\x17\x53\x03\xbc\xd5\x53\x2d\xd0\x7b\x6e\xde\xbc\xfd\x85\xee\x8f\xd9\x92\xdc\xf3\xaf\x6c\x29\x1f\xe5\x56\xf5\x45\x8c\x8a\xc7\x16\x32\x82\xdb\x3d\x5b\xd3\x02\x87\x52\x9f\x5d\xfa\x0e\xf2\xd9\xc1\x66\xf2\x20\x3b\xa3\x06\xef\x91\x62\xb6\x85\xc5\xfe\x6a\x48\x5f\x34\xe1\x03\xca\xc6\xb1\x0c\xe8\xdd\x57\x7c\x16\x73\x73\xbd\x40\x88\xde\xd0\xf6\x77\xb9\x87\x3a\x0f\xdf\xda\xd9\x50\x86\xbc\x81\x17\xd8\x7b\xec\xcc\x71\x93\x41\x1b\x6b\x14\xc2\xd2\x89\x25\xbc\xc0\xdf\xce\x10\x2c\x5b\x58\xa6\xd4\x54\x0b\x0e\x97\xc5\xfe\x70\x8e\x77\xce\x17\xbd\xaf\x16\x39\x96\x85\x62\xee\xeb\x15\xd7\xec\x25\x6d\x2d\x8a\x0d\xc6\xb5\xeb\xf7\x16\xb9\xb1\xeb\xd3\x1d\x3f\xbb\x03\x9d\x6f\x79\x63\x6d\xd1\xdb\xc2\x3b\x90\x3b\x6f\x7a\xe9\x9f\xbf\xe2\x73\x41\xb2\xa6\xf2\xd2\x89\xcf\x46\x5a\x54\x51\x3c\x04\xbc\x19\xa2\x2b\x88\x94\x66\x45\xef\x0b\x62\xc1\xef\xd6\x2c\x3c\xfc\xbc\x31\xed\x5c\x31\xa7\xca\xd4\xa5\x01\x57\xf0\xef\x80\xde\x60\x98\xbb\xb0\x3e\xc5\xeb\x5a\x7e\x02\x42\xf1\xcf\x22\xd5\xe5\x50\xc3\x73\xf2\xf3\x1b\x1b\xc9\xd8\xd9\xc6\xd7\xa1\x7f\x73\x87\x70\xd3\x75\xa8\x22\xea\x94\xfe\x2a\xb9\xa7\xc4\x61\xac\xc0\x89\x37\x6f\xc9\x07\xda\xa5\x08\x99\x1b\xa5\x6e\x84\x10\xcd\x79\x68\x73\xa8\x1c\xfc\xff\x73\x3f\xeb\xcd\xfd\x3b\x4d\x64\xd9\x2c\xac\x2d\xff\x5a\x4d\xff\x27\x48\x3a\xa5\xea\xbf\x01\x00\x2f\xa1\x1c\xa0\x95\x06\x00\x00")
+
+func templates27_changesetGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates27_changesetGoTpl,
+		"templates/27_changeset.go.tpl",
+	)
+}
+
+func templates27_changesetGoTpl() (*asset, error) {
+	bytes, err := templates27_changesetGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/27_changeset.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbb, 0x30, 0xc7, 0x26, 0xd0, 0x1f, 0x7f, 0xfe, 0xd2, 0x8c, 0x92, 0x37, 0xcc, 0x6, 0x69, 0x3, 0x21, 0xb2, 0x92, 0x4c, 0xf5, 0x90, 0x1e, 0xc2, 0xfc, 0x2, 0x8a, 0xab, 0x88, 0x13, 0xf, 0x85}}
+	return a, nil
+}
+
+var _templates28_projectionGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4d\x73\xdb\x36\x10\x3d\x0b\xbf\x62\xcb\x51\x5a\xa9\x43\x21\x77\xcf\xf8\xe0\xba\x3d\xa4\x07\x37\x19\xbb\x93\x43\x26\x93\xc0\xd4\x92\x86\x0b\x61\x29\x00\x8c\xc5\xa1\xf1\xdf\x3b\x4b\x90\x22\xa5\x38\x3e\xe5\x06\x02\x6f\xdf\xbe\xfd\x64\xd7\x6d\x40\x97\x60\x29\x80\xbc\x53\xf7\x06\xe5\x3b\xff\x37\x69\xdb\x9f\x61\x13\xa3\x60\xc4\x52\x19\xad\x3c\x5c\x5c\x82\xbc\xe2\x13\xfa\x04\x1e\x6d\x6e\xd4\x0e\x47\x28\x39\x5d\x7d\x09\xf7\xe6\x8b\x55\x3b\xec\x4d\xbe\xc3\x38\x65\x2b\x84\x65\xed\xe8\x71\x02\xbc\x77\xf4\x88\x45\xd0\x64\xfd\x0b\x26\x3d\xf8\x66\x60\xac\x9d\xb6\xa1\x84\xec\x8d\x7f\xe3\xb3\x41\x9c\xfc\xb7\xbe\xd5\xb6\x6a\x8c\x72\x89\x79\xb4\x16\x6f\xdf\x42\xd7\x1d\xed\x63\x04\xed\x21\x3c\xe0\x78\x39\xe0\xa0\x3e\xfa\x07\x2a\xf9\xf1\x9c\x36\xc6\x1c\x1e\xc8\x6c\xb5\xad\x80\xac\x69\x99\x98\x79\x0a\x32\xcd\xce\xfa\x23\xdf\xf5\xf0\xfd\x3c\xbc\x30\xbf\x87\x67\x78\x24\x6d\x21\xcb\x21\x8b\x51\x8a\xd0\xd6\x78\x26\xcb\x07\xd7\x14\x01\x3a\xb1\x98\xe5\x28\x51\x70\xd0\x27\xe4\x31\x26\xd4\xb2\x20\x73\x35\xd6\x66\x50\x9c\x20\xa3\xe9\x98\x86\x04\xa7\x3a\x30\x57\x96\xc5\xd8\x75\xba\x9c\x40\x8d\x31\x5c\x04\xbe\xee\x41\x97\x90\xe5\xb4\xd3\x01\x77\x75\x68\x7b\x34\xda\xed\x48\xa3\x4b\xd0\x95\x25\x87\xe7\xd5\x9e\xfb\x84\xa5\xbc\x53\xd5\xbb\x1e\x97\x0c\x8f\x62\x63\xe4\xd0\x07\xec\x5d\x5b\x73\xf4\x5f\xef\x49\x9b\x8b\x6c\xba\x67\x8e\x18\x33\x78\xf4\x64\x2f\xb2\x4d\x06\x81\x76\xa6\x3f\xb4\x2a\x1d\xbe\x32\xe9\x06\xd0\xf8\x9f\xe0\xa0\xeb\x82\xaa\xae\x95\x47\x58\xf5\xc2\xaf\x95\xe7\x42\x67\xec\x3e\x5b\x9f\x45\x36\xf9\x49\xe9\x62\x9d\x49\xde\x8f\x68\xf8\xf5\x15\x9a\x31\xa8\x1f\x99\xb7\xea\x55\xf3\xa3\x8a\x31\x25\x53\xad\x52\xd9\xd2\x18\x5c\xf9\xb3\x9e\x77\x18\x1a\x67\x4f\xc6\x61\x78\xa1\x12\xf0\x1b\xba\x16\x1c\x3d\xf1\x3c\x30\x62\xdf\xa0\x6b\x73\xf0\x68\x78\x4e\x6c\xc5\x94\x3c\x08\x27\x53\x30\x60\x67\xd3\xa4\x2d\xd4\x46\x15\x78\x42\xf3\x9b\x07\x7a\xb2\x23\x17\x59\x29\xca\xc6\x16\xb0\xda\x4f\x93\xf7\x27\x3d\xd9\x69\xf6\x3e\xb0\xf3\xf5\x77\x21\xac\x52\x1b\xcb\x1b\xba\x26\x1b\xf0\x10\x62\xc4\x03\x16\xc0\xdd\x24\xff\x3a\x60\xd1\x04\x72\x5d\x97\x7a\xa4\x08\x07\x28\x12\x4c\x0e\xf0\x1c\x26\xf8\x70\x35\xb3\xe2\xdc\xad\x61\xf5\xe9\xf3\xef\x27\xd9\xc9\x01\x9d\x23\xb7\x4e\xb3\x3a\xac\xcf\x99\x86\x7e\x6f\x2e\xd8\xdb\x65\x62\xfe\xa8\xc3\x43\x1f\xc0\x3f\xf5\xaa\x08\x87\x1c\xb2\x17\xf7\x0b\x2f\x87\xf3\x00\xb3\xb5\x60\x27\x68\xb7\x89\xf5\x9b\x72\x40\x70\xae\x48\x88\x05\xa7\x55\xa3\x97\xb7\x18\x6e\xfb\xac\xae\xf6\xb2\xf7\x99\xc3\xa7\xcf\x3e\x38\x6d\xab\x4e\x2c\xe6\xbb\x45\xe7\xaf\xec\x97\x3e\xae\xa5\xe6\xa5\x37\x24\x82\x35\xcf\x16\x33\x3c\xc3\x52\xde\x16\x0f\xb8\x53\xfd\x65\x8c\xf2\x74\xb6\x7a\xc0\x87\x86\x02\x72\x83\xcf\x42\x88\x6b\xb1\x40\xe7\xd8\xe9\x5e\xfe\xa1\xed\xf6\x85\x22\x5a\x6d\x66\x55\x1b\x14\xa4\x62\xe5\xf0\x2b\xad\xc5\x42\x97\x5c\x05\xf8\xe5\x12\xac\x36\x5c\x89\x45\xea\x66\xfe\x1c\x0a\xe4\xe5\x47\xa7\xea\x15\x3a\x97\x32\x2e\xdf\xff\x57\xb1\xb2\x18\x2f\xa0\x54\xda\xe0\x16\x02\x81\xf2\x5e\x57\x16\x94\x31\xc0\x39\x6c\xc1\xa1\x6f\x4c\xf0\xfc\x76\x92\x64\xf0\x46\x17\x98\xad\xc5\x22\x0a\x31\x7a\xa3\x9c\x1d\x8a\xf4\xf3\xeb\x55\x8a\xae\xdb\x00\xda\x6d\x8c\xe2\xff\x01\x00\x8f\x1e\x26\x23\x5e\x07\x00\x00")
+
+func templates28_projectionGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates28_projectionGoTpl,
+		"templates/28_projection.go.tpl",
+	)
+}
+
+func templates28_projectionGoTpl() (*asset, error) {
+	bytes, err := templates28_projectionGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/28_projection.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8a, 0x72, 0x85, 0x1a, 0xbd, 0x4f, 0x39, 0x8f, 0xa5, 0x77, 0x5d, 0x88, 0x30, 0x86, 0x83, 0xa3, 0xb4, 0x9c, 0xd8, 0x7a, 0x94, 0xd, 0x85, 0xdd, 0xb6, 0x9f, 0xa3, 0x7b, 0x1e, 0x4e, 0x50, 0x9e}}
+	return a, nil
+}
+
+var _templates29_insert_ignoreGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x5d\x73\xdb\xb6\x12\x7d\x16\x7f\xc5\x5e\x8d\xee\xbd\xe4\x1d\x06\x4e\x5e\x7d\x47\x0f\xae\x93\xb4\x9a\x34\xae\x63\x27\xcd\x43\xa7\x0f\x10\xb9\x14\x51\x43\x80\x02\x80\x96\x34\x34\xfe\x7b\x67\x41\x90\xa2\xbf\x6a\xbb\xd3\xe9\x4c\x9f\x4c\xd1\x07\x67\x17\x67\xf7\x2c\xc0\xb6\x7d\x05\xa2\x02\xae\x4a\x60\xdf\xa3\x42\xc3\x1d\x2e\x94\x45\xe3\x16\x2b\xa5\x0d\x42\xaa\xb4\x03\xf6\x99\x2f\x25\xb2\x85\xbd\x40\x5e\xfe\xa4\xe4\x3e\x83\x57\xde\x27\xb4\x78\xc6\xa5\xe0\x16\x8e\xe7\xc0\x4e\xe8\x09\x6d\x07\xee\xd7\x9c\xf1\x35\x1e\xc0\xb6\xa8\x71\xcd\xc3\x7f\xc2\x92\x11\xe6\x06\xd8\xe5\xe8\xbf\xc3\x92\x5a\x28\x17\xf8\xa7\xd3\x81\x47\x54\xc0\xde\x0a\x2e\xb1\x70\xec\x8b\xc5\xc0\xf2\x03\xe1\xbc\x6f\xdb\xb8\x62\x0e\x53\xd8\x0a\x57\x43\xda\x6c\x4a\xa9\x8b\xab\x1c\x6a\x2d\xc3\x53\x36\x25\x1c\xaa\xf2\x90\xd8\x72\x7f\xfe\x81\x82\x54\x5c\xda\x43\x70\x8a\x13\xc8\xd9\xf9\x07\xdc\xdf\x41\xcf\xc1\x99\xe6\x80\x35\x5c\xad\x86\x5d\x9f\x6a\xd9\xac\x95\xa5\x30\xbd\xba\x27\x8d\xd3\x0b\x55\x18\x5c\xa3\x72\x90\x5a\x74\x0b\x55\xc8\xa6\x44\xe8\xf6\x3f\x1b\x45\xea\xd7\x67\x44\xd0\x47\x0b\xa9\xc5\xc4\xef\xe6\x3f\x7e\x16\x15\x68\x13\x73\x8c\x9c\x5f\x94\xf8\xd6\xe0\x07\xdc\xdb\x31\x2a\x40\xbc\x4f\x8e\x8e\xe0\x56\xc9\x45\xf8\x61\xc1\xd5\x08\x6d\xdb\x15\x98\x7d\xd9\x5c\x0a\xb5\x6a\x24\x37\xde\x43\xa3\x24\x5a\x0b\x1c\x8c\xde\x76\x22\x0b\x67\x61\x63\xc4\x9a\x9b\x3d\x5c\xe1\x1e\xb8\x34\xc8\xcb\x3d\x71\xe3\x4e\x58\x67\xf3\xa0\x82\xc1\x8d\x36\xce\xc2\xb6\x46\x57\xa3\x01\xe1\xa0\x14\x25\x83\xcf\x35\x42\x51\x63\x71\x15\x50\x14\xb8\x4b\x02\x4c\xa3\x40\x28\xd0\x0a\xc1\x19\xae\x2c\x2f\x9c\xd0\x8a\x68\xb7\x35\x2a\xc0\x1d\x16\x50\x70\x05\x4b\x5c\x75\x30\x8a\xd3\x2f\x96\xda\x0a\xb5\xa2\x34\x79\x81\xe0\x34\x70\x28\xb4\x2a\x1a\x63\xa8\x04\xc4\x29\x6c\x4c\x09\x4b\xe2\xe4\x16\xa8\xdd\xbb\xe5\x58\x32\xb8\x44\x8c\xda\x40\xa5\x0d\x6c\x6b\xe1\x50\x0a\xeb\x60\x89\x35\xbf\x16\xda\x40\x89\xb6\x30\x62\x43\x59\xb1\xa4\x6a\x54\x01\xa9\x86\xff\x3d\x28\x5b\x76\x4b\xe6\x34\xb4\x06\x3b\xd3\xa7\x5a\x39\xdc\x39\xef\xc3\x66\x96\x5a\x48\xf6\x6e\x87\x45\xe3\xb4\x69\x5b\x0c\x35\x2f\xdc\x8e\x32\x27\x18\x8b\xf0\x1c\x0e\xf0\xf8\x6a\xb4\x4a\x95\xde\xe7\x50\x74\x5d\xd4\x83\xc2\x8f\x0c\xd2\xa5\xd6\x32\x07\x34\x46\x9b\x0c\xda\x64\xf2\xad\x41\xb3\xa7\xe6\x9f\x5a\x24\x4b\xc1\x1b\xa8\x8c\x5e\x53\xed\x47\x7e\x3d\x58\xcb\x7b\xaa\x9f\xa1\xe6\xb8\x63\xc4\x85\x2a\x71\x77\x2e\x79\x81\x64\x35\x34\xc1\x00\x01\x7b\x2a\x79\x63\x11\xd8\x8f\x9f\x80\x5d\x7c\x82\x37\xf0\x40\xb7\x13\xb8\xdb\xef\xc3\x8b\x5e\x3f\xba\x88\xb6\x3b\x4d\x26\x06\x5d\x63\x14\x68\x26\xee\xe9\x4c\x65\x1d\x6b\x5d\xb8\x5d\x0e\x83\x87\x82\x96\x83\x5e\x39\x04\x45\x72\xf8\xe5\x57\xa1\x1c\x9a\x8a\x17\xd8\xfa\x16\xc8\x5c\x0f\x64\x00\x37\x60\x9d\x11\x6a\xf5\x91\x6f\x20\x0d\x6e\x39\xd5\xd2\xc6\xc9\x98\xc1\x0d\x6c\x0c\x56\x62\x77\x19\x40\x97\x52\x14\x08\x53\xcd\xa6\x70\x03\xbf\x69\xa1\x60\x9a\x43\x98\x6c\xe0\xb3\xc4\x27\x43\x4e\xc9\x68\xaa\xcc\x0a\x22\x3c\x9e\xdf\xf7\x73\x74\xf3\x8c\x3a\x2f\x8c\x91\xe3\x79\x44\x3f\x99\x55\x17\xfc\x44\x95\x94\xc9\xc6\x08\xe5\x2a\x98\x8e\x3b\xf4\xbb\xfd\xbf\xed\xb4\x9b\x0f\x6d\x3b\x44\xf0\xfe\x4f\x0f\x88\x26\x8c\xa1\xc8\x17\x93\x1c\x24\xf0\xbe\x1f\x1a\xcf\x9a\x18\x67\x8d\x94\x70\xcd\x65\x83\x16\x14\x5e\xa3\x21\x83\x54\x52\x14\x8e\x51\x80\x17\x0e\x94\xc7\xa6\xc9\xd3\x96\xbe\xa5\x4c\xd7\x6b\xb3\x7f\xbe\xa9\x67\x2f\x72\xf5\x8c\x1c\x3a\x23\x8b\xbe\xe9\x9a\xef\x31\x27\x1f\x80\xaf\x47\xc0\xe7\xb9\x77\xf6\x57\xd8\xf7\x99\xd6\x78\xb1\x61\x3b\x97\x8c\x13\x8f\x3f\x2c\x68\xd2\x55\x05\xaf\xf4\x1d\xda\x25\x08\x95\x50\x25\x1d\x38\x74\x8a\x32\x38\xe9\x8f\x2d\x62\x72\x35\x77\x50\x71\x21\x2d\x68\x05\x3c\x3a\xa7\x3b\x59\xd5\x7e\xcb\xf7\x20\xb5\x75\x8f\x9d\x6c\xb1\xd5\x75\xd5\x51\x21\x58\x9a\x0c\x57\xb8\xcf\x41\xb8\xff\x5a\x30\x5a\x4a\x2c\x61\xc9\xa3\x3d\xfa\x23\xf0\xde\xf9\xf7\x64\xf7\xdf\x2f\xd5\xdf\xde\xfb\xb1\xdc\xb1\xa4\x39\x70\xb3\xb2\xb7\x4b\xff\x80\x3b\xe8\x8e\x04\xf3\x39\x28\x21\xc9\x2c\x7d\xef\x85\x2b\x56\xc4\x59\x76\x86\xdb\x74\xda\xb6\xec\xfc\x6a\x45\xa3\xd5\xfb\x63\x2a\x56\xdb\xc6\x21\x1c\x87\xe1\xc6\xe8\x6b\x51\x62\x19\x2e\x08\x9d\x1e\x42\xab\x69\x96\x4c\x7c\x92\x4c\x7a\x29\xf3\x61\x3c\x0d\xb7\xcc\xbc\xfb\x93\x4c\xd0\x18\x7a\x19\x76\xbe\x50\x9f\x77\x0f\x1e\x58\xf1\x21\x4a\xf1\x82\x43\x8d\x4a\x98\x1e\xa4\x7d\x06\x79\x2f\x7b\xd6\x09\x11\x04\xba\x53\x5b\xe2\x4f\x26\x13\x51\x75\xa4\x6f\x71\xd9\xac\x3e\xea\x12\x03\x76\x52\xad\x1d\x7b\x1f\x4e\x14\xa9\xd2\x03\xe0\xab\x11\x0e\x4d\xac\x57\xf6\x0c\x20\x95\x92\x70\x3e\x24\x80\xfd\xcd\xfc\x10\x77\x61\x03\x3e\x2d\xdc\x2e\x94\x75\x32\xd9\x86\xa5\x83\x9c\x23\xba\xf7\x46\xaf\x03\xf0\x5e\xe0\xed\x1f\xe7\xb5\x7d\x38\x9b\xfe\x94\x9e\x4c\xae\xb9\x81\x4a\x37\xaa\x04\xa1\xdc\xa3\x5a\xc5\x2a\x53\x25\xd8\x27\xf2\xff\x85\xde\xa6\x71\x52\x11\x35\x63\x2c\x63\x97\x05\x57\xe9\x7f\x02\x59\x76\x77\xd3\xe3\x36\xe9\x09\x62\x08\xda\x57\x1e\xeb\xfd\x34\x65\xcc\x3c\xc8\x48\xa4\x23\x1f\x4c\x8e\x8e\xe0\x84\xe6\x11\xd4\xbc\xfb\x00\x18\x5d\xe6\x73\xea\x9b\x9a\x6e\xd4\x4e\xc7\x19\x91\x4c\x06\xef\x28\x21\xa3\x38\x91\xf6\x5f\x73\xb0\xdf\x24\x7b\x67\xcc\x99\xbe\xd0\x5b\x0b\xed\x08\x1d\x2d\xf6\xd5\xf0\x4d\x8a\xc6\xe4\x70\xc7\x68\x8d\x22\x8f\xd1\x9d\xbd\x3b\xc5\xc9\x5c\x7c\xf0\x10\xe5\x70\xd7\x87\x46\x6f\xc9\x74\xc1\x75\xc3\xce\x40\xb3\xee\x46\xf3\x02\xc3\xc4\x21\x93\xfd\xbf\xdf\xc5\x20\xce\x60\xe1\x58\x85\x85\xed\x2e\x62\x3f\x0b\x2d\x39\x7d\x01\xd0\x56\xb2\xdb\xbb\xec\x35\x89\x73\x20\x7e\x35\x1e\x46\x4e\x90\xcd\x67\x09\xa5\x3c\xf0\xdf\x1f\x49\x1d\x2c\xe9\x37\x36\xca\xea\x36\x2e\x44\x24\x09\xe2\xeb\xc3\xfc\x21\x86\xfb\x1f\x8b\xa8\x4a\xef\x93\xdf\x07\x00\x35\x00\xa6\x16\x06\x10\x00\x00")
+
+func templates29_insert_ignoreGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates29_insert_ignoreGoTpl,
+		"templates/29_insert_ignore.go.tpl",
+	)
+}
+
+func templates29_insert_ignoreGoTpl() (*asset, error) {
+	bytes, err := templates29_insert_ignoreGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/29_insert_ignore.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x90, 0x77, 0xec, 0xa9, 0x87, 0x40, 0x88, 0xaa, 0x18, 0xaf, 0x19, 0x46, 0xa4, 0x37, 0xee, 0x5, 0xde, 0xaa, 0xef, 0xfe, 0x68, 0x50, 0x13, 0x8c, 0xf, 0xa2, 0x3e, 0x4c, 0xc1, 0x9b, 0x33, 0xb4}}
+	return a, nil
+}
+
+var _templates30_column_mapGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x92\x4d\x6b\xdb\x4e\x10\xc6\xcf\xd6\xa7\x98\x04\xff\xc1\x0e\x89\x72\xfa\x43\x31\xf8\x60\x7a\x28\x85\x3a\xb4\xc4\xe9\xa5\xf4\x30\xb6\x46\xf6\xe2\xd1\xae\xb2\x2f\xc1\x66\xd9\xef\x5e\x46\x6f\xd8\xb1\x2f\xe9\xa1\x27\x69\xa5\x67\x7e\xf3\xcc\x3c\x1b\xe3\x03\x8c\x91\x15\x3a\x98\xcd\x21\x5f\xc8\x1b\xb9\x7c\x85\x6b\x26\x68\x1f\xf9\x13\x56\x04\x0f\x29\x65\x8f\x8f\xb0\x32\x9f\x0d\x87\x4a\x2f\xb1\x06\x4b\x3e\x58\xed\xc0\xef\x08\x36\xcd\x57\x07\xa6\x6c\x8e\x31\xb6\xd0\xfc\xa5\x7e\x56\x7a\x1b\x18\x6d\x4a\xb0\xa7\x23\x15\xb0\x3e\x8a\x44\x59\xd0\xc2\x55\x5a\x4e\x82\x2e\xd0\xe3\x1a\x1d\xdd\x03\x1d\x66\x50\x1a\x0b\x2f\x75\x81\x9e\x16\xcc\x60\x2c\xbc\x06\xb2\x8a\x1c\xac\x83\x62\x2f\x90\x1d\xea\x22\x87\xa7\xc0\x3c\x74\x47\x4b\xa0\x15\x03\xea\x42\x88\xe2\xc4\xf8\x1d\x59\x07\x3b\xc3\x45\xd7\xb6\x66\x54\x1a\xde\x90\x43\xd7\x0a\xc1\x79\xab\xf4\xb6\xe9\x89\xa0\x03\xb3\xcc\x0d\x9e\x0e\xbe\x43\xe7\x82\xa3\xc3\x86\x43\x41\x8b\xe0\x0d\x30\xe1\x1b\x39\x30\xc1\x9f\x8d\x2f\xef\xfd\x1c\xb0\x25\x4d\x16\x3d\xb9\x3c\x2b\x83\xde\xc0\xc4\xc0\xdd\xd5\xc5\x4c\x4f\xd7\x3a\x39\x6d\xb3\x36\x86\xa7\xb0\x84\x98\x8d\x2a\x09\xa8\xc2\x3d\x4d\x96\xf7\x10\x23\x93\xee\xf3\x69\x4b\x5d\x4a\xd3\x6c\x24\x79\x5a\xd4\x5b\x82\x71\xeb\x5c\xaa\xde\xeb\x5a\x99\x08\x16\x7d\xf2\x9d\xab\x16\xd5\xd7\x36\xc9\x0f\xf2\x3d\x1d\x05\x56\x5b\xa5\x7d\x09\xb7\xff\xbd\xde\x5e\xd7\xa1\x18\x9f\xcd\x25\xb3\xfe\xbf\xac\xec\x4b\xb7\x8d\xe2\xec\xeb\x57\xbd\xb1\x54\x91\xf6\x7d\xb9\x2a\x5b\x82\x9c\x55\x09\x37\xa7\xdb\x88\xad\x84\x74\x71\xa2\x96\xb8\xbe\x1b\xa5\x3d\xd9\xd5\xb1\x1e\xe6\xce\xe5\xd0\x41\x4c\x1e\xe3\x30\x6d\x4a\xf9\x4f\x64\x55\xc8\x4e\x47\xd5\xaf\x18\xc7\x7b\x3a\xa6\xf4\x1b\xe6\x17\xba\x18\x1d\xab\xcd\x39\x12\xfe\x17\x68\x02\x62\x47\x57\x10\x5a\x71\x36\xea\xcc\x35\x12\x55\x82\x72\xcf\x3f\xbe\xc9\x3d\xfd\x27\xfe\x3e\xfd\x85\xbf\x8f\xed\x0f\x6e\x1a\xce\x15\xfa\xdd\x3b\xe5\x07\x8c\x48\xab\x73\xc5\x05\xeb\x32\xfb\xe1\xa6\x5c\xfe\x6d\x85\xd9\xc8\x92\x0f\x56\x43\x95\xa5\xec\xcf\x00\xc2\x08\x97\x41\xec\x04\x00\x00")
+
+func templates30_column_mapGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates30_column_mapGoTpl,
+		"templates/30_column_map.go.tpl",
+	)
+}
+
+func templates30_column_mapGoTpl() (*asset, error) {
+	bytes, err := templates30_column_mapGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/30_column_map.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd1, 0xf0, 0xbf, 0x5a, 0x79, 0xb, 0x47, 0xa1, 0x72, 0x33, 0x9f, 0x6b, 0x4, 0xbe, 0x3d, 0x52, 0x97, 0xe3, 0xa3, 0x5d, 0xf, 0x36, 0xe, 0x7a, 0x61, 0x25, 0x5a, 0x5, 0xf0, 0x3, 0xc6, 0xd5}}
+	return a, nil
+}
+
+var _templates31_mixinsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x41\x4f\x84\x30\x10\x85\xcf\xcc\xaf\x98\x83\x31\x60\xb2\xdd\xbb\xc9\x1e\x8c\x07\xa3\x89\x5e\x5c\xcf\xa6\xae\x05\x9b\x40\xbb\x29\xc5\x68\x9a\xf9\xef\x66\x3a\x40\x70\xe1\x36\xc3\xfb\xe6\xbd\x07\xa4\xb4\x43\x5b\xa3\xf3\x11\xd5\x51\x7f\xb4\x46\x3d\xf6\x4f\xde\xba\x3c\xe3\x8e\x08\x98\xb8\xd2\xad\xd5\x3d\xde\x1e\x50\xdd\xf1\x64\x7a\x81\xa7\x9b\x17\xdd\x2d\xe0\xce\xfe\x58\x27\x74\x96\x9f\x65\xdf\x62\x6d\x3d\xe3\xfc\xe8\x5b\x07\x2c\xa1\x60\x25\x68\xd7\x98\x51\x64\xab\x11\x23\x82\xe2\x1d\x53\x92\x35\x9b\x11\xe1\x01\xaf\x53\x92\x92\xea\xed\xfc\x6a\x5d\x33\xb4\x3a\x10\x25\x12\x33\xe3\x3e\x89\xa0\x82\x85\x71\x63\x62\x34\x81\x9d\x55\xee\xf7\x90\xf7\x7f\x2d\x89\x00\xf6\x7b\x0e\x13\x78\x4a\x0b\x26\x0e\xc1\xf5\x18\xbf\xcc\x42\xbc\xf7\xed\xd0\xcd\x8d\x4e\x79\x43\x5f\x4f\xd4\xba\x9c\x82\x7a\x70\x27\x2c\x3d\xde\x6c\xea\xd5\x2a\xb9\xac\xd6\x79\xc7\xdf\x33\x77\x4a\x50\x48\x2d\xf4\x6a\x76\x13\x04\x2f\x2e\xc6\x57\x93\x1f\x20\x5f\x66\x6b\xfa\x1b\x00\x0b\x0e\x54\xcd\x1a\x02\x00\x00")
+
+func templates31_mixinsGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates31_mixinsGoTpl,
+		"templates/31_mixins.go.tpl",
+	)
+}
+
+func templates31_mixinsGoTpl() (*asset, error) {
+	bytes, err := templates31_mixinsGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/31_mixins.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf4, 0x91, 0x2d, 0xb2, 0x33, 0x3a, 0xf9, 0x9d, 0x6c, 0x6c, 0x7a, 0x8, 0x55, 0xf6, 0x25, 0x0, 0x83, 0x53, 0xb0, 0x70, 0x55, 0x32, 0x28, 0xb5, 0x73, 0x67, 0xa7, 0x60, 0xf7, 0xcd, 0x87, 0xa1}}
+	return a, nil
+}
+
+var _templates32_find_or_createGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x56\x4d\x6f\xe3\x36\x10\x3d\x5b\xbf\xe2\xd5\xf0\xc1\x2e\xb4\xda\xfb\x02\x39\x6c\x83\x16\x08\x82\x66\x83\x66\xf7\x07\xd0\xd4\xc8\x66\xa3\x0c\x5d\x92\x72\x6c\x30\xfa\xef\xc5\x50\x72\xac\x20\x4a\x63\xb7\x0b\x14\xed\x29\x32\xf9\x38\x1f\x6f\x1e\x1f\x13\xe3\x07\x98\x0a\x6c\x03\x8a\xaf\x6a\x59\x53\x71\xe5\x7f\x23\x55\x7e\xe1\x7a\x8f\x0f\x6d\x9b\x09\x60\xa6\x6a\xa3\x3c\x3e\x5d\xa0\xf8\x2c\x5f\xe4\x3b\xec\xe1\xc8\x8d\x7a\xa0\x23\x78\xb9\xbf\xbd\x16\x6c\xa5\x6a\x7f\x5c\x36\xd5\x01\x7d\x7b\x4d\x83\xd0\x09\x7d\x81\xe0\x9a\x23\xd6\x29\x5e\x3d\x07\xbf\xb4\x75\xf3\xc0\xbe\x6d\x63\x34\x15\x14\x97\x28\x3e\x37\xc1\x5e\xb1\x76\xf4\x40\x1c\x30\xf7\x14\xae\x58\xd7\x4d\x49\xe8\x4a\x99\x0d\x32\x1d\xce\x2f\x24\xc0\x21\x5b\x2a\x4d\x16\x88\xcb\xfe\xcf\x73\xf2\xe1\xb7\xa9\xba\x76\xda\x36\xfb\xf8\x11\xbf\x18\x2e\xbf\xb8\x4b\x47\x2a\x50\x8c\x1d\x29\xc5\xb7\xcd\x9d\xe1\x55\x53\x2b\xd7\xb6\xa8\x0c\x97\x1e\x61\x4d\x18\xdf\x7f\x34\x61\x9d\xb6\x37\xce\x3c\x28\xb7\xc7\x3d\xed\x61\x2b\xd8\x1c\xd6\xc1\xb0\x27\x17\xbc\xa4\xb2\x78\x5c\x13\x0b\xd4\x11\x8c\x07\x5b\xa6\x3c\x35\xef\x68\x63\x5d\xf0\xb2\x2f\xbb\x30\x01\xa5\x29\x0b\x7c\x5d\x13\x6a\x6b\xef\x9b\x4d\x82\x49\x92\x2e\x1e\x5c\xc3\x30\x0c\xcb\x24\x91\x83\x53\xec\x95\x0e\xc6\xb2\xc4\x60\xd0\x8e\x34\xb4\x62\x2c\x69\xd5\xc1\x72\x78\x22\x2c\xad\xa9\x8b\x61\xcb\xa8\xac\x43\x6d\xbd\xe1\x15\x14\x9c\xd2\x84\x60\xa1\x24\xa8\xb6\xac\x1b\xe7\x64\x1a\x5d\xd2\x02\x77\x44\xb8\x4a\xdf\xe9\xdc\xe3\xda\x04\xaa\x8d\x0f\x58\xd2\x5a\x6d\x8d\x75\x28\xc9\x6b\x67\x36\x52\x49\x91\x55\x0d\xeb\xf7\x09\x9e\x27\x0d\x14\x37\xf6\xd2\x72\xa0\x5d\x68\xdb\x54\x7d\x2a\xf5\xe7\x1d\xe9\x26\x58\x17\x23\xa5\xe1\xea\xb0\x83\xee\x60\x45\x0f\xcf\x71\x84\xf7\x4b\x83\x53\x22\x85\x1c\x16\x3f\x8e\xa6\xce\xa1\x3b\x21\x1d\x8e\xa7\x1f\x0b\xcc\xdf\x82\x2f\xad\xad\x73\x90\x73\xd6\x2d\x10\xb3\x89\xa9\x60\x71\x71\x01\x36\xb5\xfc\x9c\x38\x0a\x8d\x63\xf9\x99\x77\x7a\xec\xc1\xbe\xb8\xa1\xc7\xf9\x34\xc6\xe2\xf6\x7e\x25\x72\x6e\xdb\x4f\x60\x8b\x18\x7b\x55\x77\x6b\xd8\x38\xbb\x35\x25\x95\x89\xde\x8e\x75\x63\x79\xba\xc8\x26\x6d\x96\x4d\xb6\xca\xa1\xb2\x0d\x97\x6f\xb4\x93\x4d\x74\xa2\xb9\x4c\x59\xe5\xba\xbe\x9a\x77\xe2\x3a\x19\xc3\x80\xef\xfe\xa3\xa7\x6b\x3e\x8a\xd1\x61\x97\xe3\xf9\x52\x25\xce\x73\xc8\x80\xe7\x47\xfa\x4f\x08\x7e\x18\xcd\x42\x2a\xb4\x2e\x91\x26\x6d\x49\xbd\x69\x25\x9b\x4c\x52\x8b\x5d\x0b\x17\x49\x3e\xa3\xcd\x9e\x51\x66\x8c\x23\xde\x81\x27\xf8\xe0\x0c\xaf\x7e\x55\x1b\xcc\xd3\xb0\x2f\x6d\xed\x7b\x5f\x5c\xe0\x09\x1b\x47\x95\xd9\xdd\x25\xd0\x5d\x6d\x34\x61\x6a\x8b\x29\x9e\xf0\xbb\x35\x8c\x69\x8e\x69\xdb\x2e\x8e\x43\x27\xe7\xb2\x49\xfb\xbd\x48\xe9\x83\xda\xa2\xbb\x6f\x67\x74\xdb\x4b\x5a\x34\xb3\x48\x0a\x15\x22\x7f\x78\x4f\xa3\xa2\x30\x01\xf7\x0a\x7a\x51\x43\x9e\x9c\x3c\x97\x23\x02\xcb\x0e\x1b\xfd\xa0\xfa\x20\xb2\xdb\x66\xcf\xd5\x64\x03\xd7\x9f\x69\x61\x56\x9e\x9a\x4e\xed\xdf\xd8\xfc\xd1\xd0\x35\xed\x7d\xef\xc9\xb3\x7b\xda\xcb\x15\x10\xcd\x76\xe0\x77\xa7\xd3\x0d\xe1\x33\x97\xd3\x13\x8d\xfc\xa7\x7d\x8c\x87\x3c\xe7\xd8\x7a\x93\x8a\x95\x14\x31\x1e\x6a\x1b\x28\xe0\x95\xd5\x9f\xef\xf3\x12\xfb\xa6\xa9\x6b\x6c\x55\xdd\x90\x07\xd3\x96\x1c\x1e\x54\xd0\xeb\xd3\xde\x80\xf1\x07\x40\xc2\xfe\x83\x37\x60\xe4\x01\x38\xd1\xd1\x5f\x32\xdd\x49\x77\x36\xd4\xed\xf1\x76\xfc\xef\x0c\x7e\xf6\xca\xe1\x67\xff\x96\xc5\xbf\xa0\xfc\xa5\xe3\xcc\xc7\x41\x67\x99\xfc\x5f\x84\x1f\x33\xb4\xbf\xe7\xf2\x63\x52\x3a\xad\xec\x18\x4f\x34\x92\xef\x6c\xf3\x67\xd2\xf2\xa6\xcf\xcf\xfe\x33\x46\x3f\xfc\x07\xfb\xcf\x01\x00\x30\x8e\x87\xd8\x7b\x0c\x00\x00")
+
+func templates32_find_or_createGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates32_find_or_createGoTpl,
+		"templates/32_find_or_create.go.tpl",
+	)
+}
+
+func templates32_find_or_createGoTpl() (*asset, error) {
+	bytes, err := templates32_find_or_createGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/32_find_or_create.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0x3a, 0x6f, 0x32, 0xcd, 0x5d, 0x7d, 0xd6, 0x82, 0xbb, 0x7f, 0x93, 0x0, 0xf3, 0x3a, 0x20, 0x69, 0xc6, 0x87, 0x2, 0x7c, 0x6f, 0xb4, 0x52, 0xe5, 0x97, 0xef, 0x6f, 0x9c, 0xd8, 0x18, 0xfb}}
+	return a, nil
+}
+
+var _templates33_load_by_keysGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xd1\x8e\xdb\x36\x10\x7c\x96\xbe\x62\x7b\x70\x50\xa9\xd5\x31\xf7\x7c\x89\x5a\x24\x87\xa2\x28\xae\x08\x52\x5c\x8a\x3e\x18\x46\x41\x53\x2b\x9b\x30\x45\xfa\x48\x2a\xb6\xaa\xf0\xdf\x8b\xa5\x24\x4b\x46\xef\xfa\x64\x8b\x33\x3b\x33\xbb\x5a\xaa\xef\x6f\x61\xc5\x95\xe4\x0e\xee\x4b\x60\x1f\xe8\x1f\x3a\xf6\x85\x6f\x15\xc2\xf0\xc3\x3e\xf1\x06\xe1\x36\x84\x34\x92\x9d\xd8\x63\xc3\x23\x12\x4b\x16\x9c\x6f\xc0\x9e\x16\xe8\x54\x62\xb9\xde\x21\xac\x64\x01\x2b\x61\x54\x64\xde\x97\xa0\x0c\xaf\x1e\xb1\x7b\x30\xaa\x6d\xb4\x1b\x75\x66\x1b\x61\x14\xc9\xaf\xc6\x0c\xbf\xa2\x1f\x98\xb3\xc6\x85\x5a\x4b\x54\x55\x24\xc7\x46\xd8\xeb\xc4\x56\x8b\xc9\xfe\x68\xa5\xf6\x35\xdc\xbc\x71\xbf\x1b\x5e\x7d\xec\xde\xb8\x47\xec\xdc\xcd\xa4\xf1\xe7\xf1\x49\xea\x5d\xab\xb8\x9d\xf4\x27\x15\x59\x03\xd7\x15\x64\xf8\x0c\x2b\x09\x77\x39\x64\xd4\xc9\xc7\xee\xb3\x95\x0d\xb7\xdd\x23\x76\x53\xe8\x3c\x84\xbe\x9f\x4d\xff\xeb\xf9\x8a\x23\x95\xa1\xae\x42\x48\xdf\xbe\x85\x85\x42\x08\x60\xd1\x5b\x89\x5f\xd1\x81\xdf\x23\x61\x8b\x57\x44\xb0\x39\x39\x38\x49\xbf\x8f\xf0\x4e\x7e\x45\x4d\xa4\x71\x10\x21\xc0\x01\x3b\x57\x40\xc3\x8f\x47\xac\x60\xdb\x91\x81\xdf\xa3\xb4\x04\x14\x20\x35\x70\x07\x35\x9e\xe0\xb9\x45\x2b\xd1\xd1\x23\x45\x7d\xd8\xb7\xfa\xf0\x24\xff\x41\xe0\x4a\x99\x93\x63\x40\xd1\xa3\x93\x69\x3d\x70\x32\x06\x6e\x11\xf8\xd6\xa1\xf6\x50\x5b\xd3\x8c\xda\x64\x16\x07\x56\xb5\x47\x25\x05\xf7\x48\x5e\x2e\xb2\x07\x97\x0a\x8c\x16\x58\x80\xf4\xdf\x3b\x68\x90\x6b\x0f\xde\xc0\x96\x8b\x03\x54\xdc\x73\x9a\x2e\x5a\x70\xbe\x53\x08\x5b\xee\xc5\x5e\xea\x1d\x4b\x69\xac\xd7\xc3\xc9\xfa\x5e\xd6\xb0\x62\x9f\xcc\x83\xd1\x1e\xcf\x3e\x04\x3c\xa3\x80\xad\x91\x8a\xfd\x72\x46\xd1\x7a\x63\xfb\x1e\x95\xc3\x10\x84\x3f\x83\x18\x68\x6c\xa4\x17\x30\xd3\xc7\xa3\x45\x95\xae\x42\x28\x86\xe8\xeb\xcd\x30\x53\xf6\xa5\x3b\x62\x08\x39\x64\x0d\x3f\xae\xaf\xce\x36\x3f\xf4\xfd\x0b\xef\xb5\x00\xb4\xd6\xd8\x1c\xfa\x34\x71\x88\x9a\x16\xb1\xe1\x07\x7c\x41\xc0\x79\xdb\x0a\xdf\x87\x02\x14\xea\x8c\x7c\xf3\x3c\x4d\xb8\xdd\xb9\x4b\xd1\x7a\x23\xb5\x47\x5b\x73\x81\xc4\xbb\xbb\xa6\xd6\xc6\xc2\xdf\x31\x31\x15\x0c\x77\x90\x30\xf2\x4e\x64\x4d\x98\x39\x10\x44\x41\xd6\x07\xec\x36\xef\xc0\x1c\x22\x9a\xd0\x64\xa4\x6e\x31\x4d\x92\x90\x26\xc9\x85\x01\x25\x4c\xb9\x7a\x02\x62\x9c\x12\x68\x9d\x74\x95\xd1\x53\x34\xcc\xd3\x24\xa4\x69\x22\x2e\x5b\x73\x5f\x5e\xaf\x51\x4a\x01\x66\xf8\x7d\x09\x77\xd1\x78\x3e\x2a\x63\x2f\xa4\x38\x8a\xd5\xa6\xd5\xd5\xff\xcc\xeb\xb5\x81\x5f\x64\xc6\x91\x38\xcf\xad\x27\x9d\xbb\x77\xe3\xff\xf7\x33\x67\x3a\xfa\xb1\x5c\xa4\xa3\x60\x38\x78\x8f\xe8\x0c\x0e\xa3\x24\xf4\xa7\x59\x25\xb6\x92\xd0\xe1\x55\x17\xb1\x8d\xc4\x29\x49\xcb\x8e\xd6\x92\xe0\x22\xf4\x67\xd5\x5a\xae\x42\xc8\x9e\x1b\xf6\xd7\x1e\x2d\xfe\xa6\xb3\x9b\xbe\x5f\x7e\x6f\x43\x60\xf3\x6d\x86\x6f\xb0\x62\x7f\xb4\xc6\xa3\x0b\x81\xae\xee\xcf\x37\x05\x90\xd5\x3a\xa6\xbc\x47\x5d\x6d\x18\x63\x79\xce\x3e\x28\x35\xdc\x0d\x6d\xfc\xf5\xfd\x10\xfe\x5c\x40\x5c\x6e\xfa\xf0\xc6\xfd\xcf\xc7\x96\xac\x85\xef\x4a\xd0\x52\x0d\xdd\x58\xf4\xad\xd5\xf4\x1c\xb3\x8f\x8b\x31\xee\x98\x99\x37\x2c\xb6\x37\x94\xc4\x37\xb6\x36\x14\x39\x7e\x41\x43\xd8\x40\x09\x66\x28\xa5\x59\x8c\x9a\x91\x57\x90\x74\x4a\x5f\x58\xd4\x15\xdc\x86\x90\xfe\x3b\x00\xd2\xc8\x4e\xed\x9a\x06\x00\x00")
+
+func templates33_load_by_keysGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates33_load_by_keysGoTpl,
+		"templates/33_load_by_keys.go.tpl",
+	)
+}
+
+func templates33_load_by_keysGoTpl() (*asset, error) {
+	bytes, err := templates33_load_by_keysGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/33_load_by_keys.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdb, 0xb, 0xef, 0xd5, 0xa9, 0xb6, 0x4c, 0x3a, 0xb2, 0xba, 0x27, 0x14, 0xb3, 0x26, 0x11, 0x96, 0x5f, 0xc4, 0xde, 0x6a, 0xd5, 0xa6, 0xdd, 0xb7, 0xc4, 0xe5, 0xd1, 0x56, 0xf4, 0xd, 0xb6, 0x11}}
+	return a, nil
+}
+
+var _templates34_delete_cascadeGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\x4f\x6f\xe3\xb6\x13\x3d\x5b\x9f\x62\x7e\xc6\x02\x3f\xb9\x50\xb8\xf7\x2d\xb6\x80\xe1\x78\x8b\xa0\x59\x6f\xba\xf6\x62\x8f\x05\x2d\x8d\x64\x36\x0a\xc7\x20\xe9\xd8\x06\xc3\xef\x5e\x0c\x45\x45\x56\x9a\x62\x03\xf4\xd2\x9b\x4c\x3e\xce\x9f\xf7\xde\x8c\xbd\xbf\x02\x55\x83\xd4\x15\x88\x5f\x51\xa3\x91\x0e\xaf\xb1\x45\x87\x0b\x69\x4b\x59\x21\xe4\x9a\x1c\x88\x8d\xdc\xb6\x28\x6e\xec\x57\x94\xd5\x17\xdd\x9e\x67\xfd\xd1\xdd\x6f\x78\x86\xab\x10\x32\x8e\xf4\x4e\xb6\x4a\x5a\xf8\xf0\x11\xc4\x9c\xbf\xd0\x76\xa8\x1e\xbc\x92\x0f\x38\x80\x2d\xd5\x8e\xb1\x31\xf9\xbc\xaa\xd6\x54\xbb\x2e\xb7\xed\x1f\x2c\xa4\x1e\x4e\x87\x97\xfb\x56\x6a\x7e\x29\x46\xa5\xde\xf1\xe9\x45\xa6\x10\xb2\xf7\xef\x61\xdc\x4d\x95\xe2\xbb\x1d\x82\xf7\x5d\xbd\xe2\xdb\x7e\xad\x74\x73\x68\xa5\x09\x01\x64\x4b\xba\x81\xa3\x72\xbb\x08\x32\x74\xb4\x60\xb0\x46\x83\xba\x54\xba\x01\xe5\x8a\xe7\x0b\x8e\x7f\x79\xe7\x76\x64\x11\x6a\x65\xac\x2b\xa0\x26\x13\x81\x35\x19\x54\x8d\x86\x7b\x3c\xdb\x78\x50\x49\x27\xb7\xd2\x22\x54\x84\x56\xff\xdf\x81\x41\x4b\xed\x23\x82\x72\x16\xdb\x3a\xe6\xe6\xc8\x5f\x56\x70\xbd\xbc\x5d\x6e\x96\xb0\x98\xaf\x17\xf3\xeb\x25\x90\x81\xf5\x72\x03\xab\x6f\xb7\xb7\x02\x6e\x1c\x98\x83\xb6\xa0\x34\x90\x46\x70\x46\x6a\x2b\x4b\xa7\x48\xc3\x71\x87\x1a\xf0\x84\x25\x94\x52\xc3\x16\x9b\x0e\x23\x38\xea\x66\x87\xa3\x9a\x63\x83\xd2\xf4\xd4\x54\xb0\x3d\x83\x75\xd2\xe1\x03\x6a\x57\xc4\x62\xe8\x10\x53\xe9\xae\x45\x54\x06\x76\x44\xf7\xd6\x7b\x55\x77\x2a\x86\x50\xb0\x85\x38\x3c\x3e\xa2\x39\x33\x39\xa0\xec\x73\x48\xa6\xa2\x21\xaa\x40\x69\xeb\x50\x56\x40\x35\xf0\xbb\x1e\xe0\x3d\xea\x2a\x04\x91\x25\x3b\x46\x81\xc5\xe2\x5c\xb6\x49\xc4\xcd\x4b\x1e\xa9\x66\xf9\x06\x18\x3c\xc1\x9f\xa4\x34\x4c\xe1\xea\x17\x98\x86\xc0\xec\x3f\x80\x84\x92\x2f\x0b\xb0\x04\xca\x81\x6c\x8f\xf2\x6c\x39\x9e\x41\x77\x30\xda\x82\xd4\x80\xc6\x90\xe9\x32\xc7\x22\xb2\xfa\xa0\x4b\xc8\x09\x7e\x7a\xd5\x1f\xb3\xb1\xa1\xf2\x48\x82\x58\xd1\x82\xb4\xc3\x93\x0b\x21\xd2\xbe\x25\xd5\x8a\xe5\x09\xcb\x83\x23\xe3\x3d\xb6\x16\x43\x28\xdd\x09\xca\x0e\x26\x12\xbc\x80\x01\x9e\x8e\x2e\x5e\x71\x39\xb3\xae\x40\xf0\xd9\x44\xd5\x40\xf0\xf1\x23\x68\xd5\xf2\xcf\x49\xd7\x44\x77\x6f\xc5\x0a\x8f\xf9\xd4\x7b\x71\x77\xdf\xf0\x98\x85\xf0\x01\x34\xfd\x83\xc7\xf7\x86\x1e\x55\x95\x84\xe9\x34\x98\xce\xb2\x49\xc8\x26\xaf\x0a\x90\xfd\x38\xd5\x41\xf3\xd8\x81\x23\x28\xd3\xa4\x45\xa7\xc7\xd0\xac\xb6\xf7\xa3\xc1\x2c\xc0\xfd\x2b\x45\xa7\xb3\x2c\x15\xdb\x51\x3b\x94\x18\xa9\xbc\xd1\x9b\x53\x14\x26\xee\xae\x0b\x71\xd2\x47\xe2\x36\x7f\x15\x53\xba\x53\x01\x11\xc1\x0b\x27\x0a\x54\x00\x9b\x22\x1f\xb4\x7a\x43\xf0\x5e\xc7\x0b\x01\x63\xc1\x46\xea\x06\xe1\x5d\x85\x2d\x6f\xb0\xae\xe1\xb4\xf5\x42\x48\x98\x77\xd2\x34\x36\x5e\x57\xd8\x8a\x39\xff\x78\x02\xeb\x8c\xd2\xcd\x67\xb9\x87\x3c\x4a\xba\xa0\xd6\xa6\x8d\x3b\x83\x27\xd8\x1b\xac\xd5\x69\x1d\x41\xeb\x56\x95\x08\x53\x12\xd3\x67\x16\x0b\x9e\x8a\x18\x9e\xe5\x1d\xca\xe6\x1e\xb3\x09\x7b\x2b\x32\x77\x8d\xdb\x43\xf3\x99\x2a\x8c\x0e\x9b\xd4\x0f\x4e\x7c\xda\x1b\xa5\x5d\xab\xf3\x01\xf0\xdd\x28\x87\xa6\x80\xa9\xf7\xdc\x88\x58\xff\x7e\x1b\x02\x3b\xe8\x87\x2f\xbc\x8f\xbd\x85\xc0\xe0\x94\xf8\x8f\x82\x29\xe2\x76\x99\xe0\x38\x36\xf9\x38\xf2\xe5\xbb\x9f\x23\xf8\x7f\xc3\x18\xbc\x30\xe7\x77\x23\xf7\x39\x9a\x54\xdd\xeb\x1e\xed\x2c\xdf\xff\x09\x70\x07\xd1\x9c\x21\xf0\xe2\xea\xad\x38\x32\xec\xb4\xaf\xb7\xf7\xdc\x0b\xde\x6e\x6c\x64\x2e\x2f\xdd\x69\xd6\x51\x77\x8c\x1c\x71\x57\x2f\x59\xf8\x64\xe8\x21\x02\xff\xc6\xd7\xf1\x8d\xbc\x1e\xdf\xc4\xe6\xf3\x12\x4a\x52\x73\xce\x22\xf9\xf9\xbf\xcd\x2f\x2f\xbe\xd1\x77\x6f\x5c\xb1\xa2\xaf\x74\xb4\xf3\xba\xc6\xd2\x61\x15\x42\xaa\xad\x5f\xb1\x43\xf3\x69\x0a\x29\xcd\xd6\x9b\x47\x7d\xfc\xa7\xe6\xcc\x01\x53\xa4\x59\x2a\x68\x08\x33\xae\x64\xb4\x90\x47\xc5\x4f\xc2\xb0\xad\xe2\x81\xf7\xa8\x2b\xb8\x0a\x21\xfb\x6b\x00\x22\xd4\xe2\xb6\x7f\x09\x00\x00")
+
+func templates34_delete_cascadeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates34_delete_cascadeGoTpl,
+		"templates/34_delete_cascade.go.tpl",
+	)
+}
+
+func templates34_delete_cascadeGoTpl() (*asset, error) {
+	bytes, err := templates34_delete_cascadeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/34_delete_cascade.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0xa, 0x82, 0x51, 0xd6, 0xdb, 0x41, 0xc1, 0x18, 0x12, 0x67, 0xef, 0xc9, 0x6a, 0x80, 0x3a, 0x57, 0x62, 0x5f, 0xbb, 0x24, 0x17, 0x3a, 0x67, 0xd7, 0x4b, 0xf4, 0x86, 0xa2, 0x4a, 0xab, 0xb0}}
+	return a, nil
+}
+
+var _templatesSingletonBoil_embedsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x3d\xee\xdb\x30\x0c\xc5\xe7\xf8\x14\x84\xe0\xa1\x05\x62\x65\x0f\x90\x29\x68\xc7\x2c\xf5\x01\x22\x47\x8c\xa3\x40\x96\x5c\x4b\x19\x0c\x42\x77\x2f\x28\xab\xf9\x2a\x9a\xff\x64\x99\x7c\xfc\xbd\x47\x12\x35\x30\x29\xd7\x23\xd4\x38\x74\xa8\x61\xbb\x03\xf9\x83\x5f\x21\xa5\x6a\xb3\x01\xa2\xa5\x21\x0f\x6a\xc0\x94\xe0\xe2\xad\x0e\x10\x2f\x08\x27\x6f\x6f\x83\x0b\x10\x2e\x6a\x42\x0d\xdd\x9c\xab\x44\x57\x6f\x1c\x88\x35\x88\x82\x94\xad\xea\x2c\x86\x94\x20\xe6\xc7\x9a\xb1\x26\x82\x09\x90\xfb\x1a\x35\x18\xc7\xc3\x66\x82\xc1\x6b\xb4\x41\x56\x71\x1e\xf1\x1f\xef\x10\xa7\xdb\x29\x02\x55\x2b\xa2\x12\xfa\x6c\xd0\xe6\xd0\x45\xf9\x93\xff\x03\x34\x29\xb1\xa8\x81\x7a\x49\x99\x15\x59\x2b\xf7\x4b\xe1\xa1\x88\xaa\x67\x7e\x96\x2c\xea\xe2\x47\x64\xce\x80\xbf\xa1\x96\xbf\xb2\x71\xab\xfa\xbd\x0a\xc6\xf5\x20\x94\x35\x2a\x88\x94\x88\xee\xe3\x77\x83\xbf\xc3\xe8\xf4\x93\x8b\x1f\x23\x3b\x08\x51\xb8\x25\x98\x3c\xdc\xac\xe5\xb3\x70\x39\x8b\x76\x20\xd6\x7e\x30\x11\x87\x31\xce\xe2\x0d\xf4\x62\xc1\xf7\x29\x94\x76\x1e\xb9\x70\x24\xea\xd1\xe1\xa4\x22\xb6\xaa\x0f\x50\xcb\xe5\x53\x32\xa6\xd4\x79\x63\xb7\xe2\x31\xb7\x64\x15\x70\x0d\xde\x71\x3d\xe6\x15\x11\xbe\xd5\xf2\x69\x5b\xee\x8a\xef\x2f\xd7\x79\x5f\x96\xa3\x33\x28\xfa\xc1\xfe\x1f\xc4\xdd\x8f\x20\x01\xb3\xfa\x04\x98\xd5\x17\x80\x7b\x92\x63\xb5\x7a\x5c\x2e\x55\x44\xe8\x34\x34\x29\x55\x7f\x06\x00\x7a\x0a\x35\x03\xf2\x02\x00\x00")
+
+func templatesSingletonBoil_embedsGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSingletonBoil_embedsGoTpl,
+		"templates/singleton/boil_embeds.go.tpl",
+	)
+}
+
+func templatesSingletonBoil_embedsGoTpl() (*asset, error) {
+	bytes, err := templatesSingletonBoil_embedsGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/singleton/boil_embeds.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0x27, 0xbe, 0x73, 0xa8, 0xb5, 0x7a, 0x2, 0xcf, 0xc6, 0x7, 0x50, 0x8, 0x8b, 0x33, 0x2d, 0x7d, 0x40, 0x2b, 0x27, 0x65, 0x8f, 0x8b, 0xba, 0x69, 0xd5, 0xdc, 0x16, 0xa8, 0x31, 0xc6, 0x8e}}
+	return a, nil
+}
+
+var _templatesSingletonBoil_functionsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x55\x51\x4f\xdb\x3c\x14\x7d\xae\x7f\xc5\xfd\x2a\x84\x12\x54\xcc\x3b\x9f\x78\x59\x05\xd2\xa4\x8d\xb1\x80\xb6\x87\x69\x12\xc6\xb9\x85\x6c\x8e\x0d\xb6\x43\x5a\x59\xfe\xef\xd3\x75\xdc\xd2\xac\x1d\xda\xc3\xa4\x69\x6f\xe9\xf5\xb9\xc7\xe7\x9c\x9c\xb6\x21\x1c\x83\x15\xfa\x1e\xe1\x60\xa1\xe1\xf4\x0c\xf8\x45\xa7\xa5\x6f\x8c\x76\x70\x1c\x23\xa3\xf3\x03\x2d\x5a\xa4\x33\xdf\x78\x85\x73\xe1\x12\x98\x5f\xd2\x74\x8d\x69\x16\x69\xf6\xd6\xdd\x88\x3b\x85\x9f\x84\xea\xb0\x8e\x91\x9d\x9c\x40\x08\x69\x3f\xc6\xca\xf4\xd0\x38\x10\x60\x4d\x0f\x16\x7d\x67\x35\xd6\x70\xb7\x02\xff\x80\x84\xca\x94\x31\xc2\x22\x4b\xe0\xcc\xaf\x1e\x71\xcc\xe0\xbc\xed\xa4\x87\xc0\x26\x21\x6c\x84\xf3\xb9\x51\x5d\x9b\x25\x4f\x42\x78\x11\xba\xa6\x0c\x81\xdf\xac\x1e\xe9\xe9\xf6\xce\x34\xea\x74\x1a\x42\x3e\x9a\xc2\x37\x67\xf4\x68\xe0\x4d\x3b\x46\xac\xc4\x78\x70\x4b\xb7\xa3\xae\xd3\x7d\x91\x8d\x6c\x66\x6b\x2e\xd9\xb2\xa6\x77\x60\x16\xbb\x16\x3d\xc5\x04\xcf\x29\xa7\x2d\xbf\xf4\xf4\x42\x55\x84\x40\xb9\xf2\x4b\x33\x37\xda\xe3\xd2\xc7\x88\x4b\x94\x40\x0e\xf8\xf9\x12\x65\xe7\x8d\x0d\x01\x95\xc3\x18\xa5\x5f\x82\x1c\x60\x3c\xc3\x67\xf0\x02\xcf\xa3\xad\x2d\x5d\xc7\xb8\x9d\xe1\x95\xb0\xa2\x75\x31\xce\x20\x04\x29\x5a\x54\xfb\x03\xcc\x9b\x25\x14\x5f\xbe\x1e\x6d\xa4\x56\xa6\x9f\x01\x5a\x6b\x6c\x49\xef\xe6\x59\x58\x7a\xcd\x0e\x7e\xc2\x30\x36\x79\xa2\x26\x3d\x75\x68\x1b\x74\xbc\x12\x7d\x31\xbd\x3e\x7f\x77\x3e\xbf\x81\x23\xb8\xa8\x3e\xbc\x27\xf7\xfc\x5a\x3e\x60\x2b\x52\x95\x36\x55\x4b\x71\x1c\xf0\x2b\x25\x24\x3e\x18\x55\xa3\x75\x50\x28\xd4\x5b\xda\xcb\x18\xcb\xe9\x6f\x5b\x5a\x1b\x61\x93\x66\x41\xd2\x93\x2e\xfe\xa6\xd1\xf5\x9e\xdc\x75\xa3\xb6\x82\xce\xab\x43\xbe\x33\x38\x24\xab\xe5\xff\x89\xe4\xbf\x33\xd0\x8d\xa2\x0c\x26\x43\x11\xe8\x63\x8e\xc6\xf1\xcf\x56\x3c\x16\x68\xed\x0c\xa6\xe4\xf3\xea\xfb\xfd\x10\xef\x29\x74\x3a\xb9\xf5\x06\xa4\x50\x6a\x54\x96\x69\xc9\x26\x91\xb1\x35\x1f\x5d\x36\x23\x56\x46\x5f\xbd\x41\xd3\x2b\x0d\x44\xd7\x29\xbf\xb7\x83\xff\x68\xed\x06\x0f\x55\x0a\x63\xa8\xe4\x4e\xf3\x06\xcf\xbb\xc0\xd7\xea\xf7\x97\x7b\xf7\xb1\x43\xbb\xaa\x4c\xbf\x9b\x7d\x41\x69\x96\xeb\xbc\xf3\xb8\x90\x7e\x39\xe4\x5c\x66\x3e\x7e\x2d\x85\x2e\x0e\x07\xef\xbf\x2c\xe3\x70\xfc\x67\xfb\x98\x29\x37\x8d\xcc\xbf\x8b\xf4\xb7\x80\xba\x86\xe3\x18\xd9\x8f\x01\x00\xe5\xfb\xba\x30\x67\x06\x00\x00")
+
+func templatesSingletonBoil_functionsGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSingletonBoil_functionsGoTpl,
+		"templates/singleton/boil_functions.go.tpl",
+	)
+}
+
+func templatesSingletonBoil_functionsGoTpl() (*asset, error) {
+	bytes, err := templatesSingletonBoil_functionsGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/singleton/boil_functions.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcd, 0xa7, 0x90, 0xa0, 0xd4, 0x90, 0xf8, 0x69, 0x1b, 0xa2, 0xfb, 0x43, 0x3b, 0x38, 0x8, 0xe0, 0xa7, 0x47, 0x1a, 0x68, 0x7, 0xe4, 0x25, 0x91, 0xb7, 0x54, 0xf4, 0x9d, 0x8a, 0x17, 0x44, 0x4e}}
+	return a, nil
+}
+
+var _templatesSingletonBoil_lookup_enumsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x31\x4f\xc3\x30\x14\x84\xe7\xfa\x57\x9c\x22\x90\x40\xa2\xee\x8e\xd4\x91\x09\x89\xa9\x62\x37\xf8\x92\x46\x4d\xec\xd4\x76\x88\xa2\x27\xff\x77\xe4\xb4\x94\xaa\x30\xd9\x77\x7e\xcf\xf7\x9d\xc8\x1a\xc1\xb8\x86\xb8\xa3\x1b\x7b\x3c\x6f\xa1\x5f\xdc\xd8\xc7\x9c\xd5\x66\x03\x91\xc5\xd6\x6f\xa6\x67\xce\x68\x23\xd2\x9e\x38\x70\x86\xaf\x61\x10\xfc\x54\x2e\xc5\xfb\x99\xdc\x99\x8f\xae\x8c\x76\xde\x1f\xc6\x01\xa9\x48\xad\xd2\x3c\xf0\xf6\xb3\xcb\xc6\x3c\x30\x67\xa5\x44\xda\xfa\x44\xa1\xdf\x4d\x37\x32\x62\xfd\x2f\xc4\xd7\xf2\xf8\x04\x13\x11\x68\x2c\xea\xe0\xfb\x3f\xf1\xd3\x9e\x6e\x61\xed\xbd\x65\x17\x31\x31\x10\x0d\x1d\x83\x49\xb4\x5a\x7d\x7a\x17\x13\x1e\xd4\x4a\xe4\xaa\xfe\x75\xf0\x4a\xe4\x96\xf4\x2c\xb7\x58\x50\x79\x3c\x2f\xed\x4a\xb9\x2a\xa6\xd0\xba\xa6\xca\x59\x64\x08\xad\x4b\x35\xaa\xfb\x63\x05\xfd\xca\xb9\x78\xec\x22\xcb\x79\xd1\xce\x9e\x42\xe8\xec\x52\xf4\x51\xa9\x5f\x21\x42\x67\xb1\xce\x59\x7d\x0f\x00\x68\x20\x7c\xaa\xa1\x01\x00\x00")
+
+func templatesSingletonBoil_lookup_enumsGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSingletonBoil_lookup_enumsGoTpl,
+		"templates/singleton/boil_lookup_enums.go.tpl",
+	)
+}
+
+func templatesSingletonBoil_lookup_enumsGoTpl() (*asset, error) {
+	bytes, err := templatesSingletonBoil_lookup_enumsGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/singleton/boil_lookup_enums.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb, 0x1d, 0xe9, 0xe7, 0x51, 0x1f, 0xde, 0x8f, 0xa1, 0x1b, 0x89, 0xed, 0xf9, 0xa7, 0xb1, 0x50, 0xad, 0x6d, 0xb9, 0x17, 0xd0, 0x6b, 0x3e, 0xa6, 0x48, 0x47, 0xa2, 0x25, 0x5a, 0xc5, 0x17, 0x8d}}
+	return a, nil
+}
+
+var _templatesSingletonBoil_mixinsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xb1\x4e\xc3\x30\x18\x84\xe7\xfa\x29\x4e\x15\x03\x48\xad\xbb\x23\x31\x31\x30\xc1\xd4\x17\x70\x9b\x6b\x62\x14\xdb\x91\xed\x4a\x44\xbf\xfe\x77\x47\x4e\x22\x40\x62\x3b\xdf\x9d\x3f\xfb\x44\x8e\xc8\x2e\xf6\xc4\x43\xf0\x5f\x3e\xe2\xf9\x05\xf6\xbd\xa9\xa2\x6a\x4e\x27\x88\xac\x81\xfd\x70\x81\xaa\xf0\x05\x3e\x4c\x23\x03\x63\x65\x87\xcb\x8c\x3a\x10\x21\x75\x1c\x0b\xd2\x6d\x39\x89\x7c\x26\x1f\xb1\x3f\x60\xbf\x61\xed\xd9\x5d\x46\x16\x55\xd4\x45\x1c\x1a\xba\x0e\x39\xdd\xfb\x01\x3d\x6b\x65\xfe\xb9\x7d\x4d\xe3\x3d\xc4\xd2\xf4\x8c\x32\xb8\x4c\x6b\xea\x3c\xf1\xff\x57\x62\x65\xbe\xb9\x2b\x21\x66\xf7\x67\xc8\xca\x6b\x4b\xb6\xfe\xdb\x62\xb4\x41\x3b\x91\x2d\xde\x20\x8f\x4f\xf8\xb5\x5e\x97\x97\xed\x79\x9e\xb8\x76\x8f\x60\xec\x54\x8d\x1a\x11\xc6\x0e\x47\x55\xf3\x3d\x00\xdc\xd6\xe5\x92\x32\x01\x00\x00")
+
+func templatesSingletonBoil_mixinsGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSingletonBoil_mixinsGoTpl,
+		"templates/singleton/boil_mixins.go.tpl",
+	)
+}
+
+func templatesSingletonBoil_mixinsGoTpl() (*asset, error) {
+	bytes, err := templatesSingletonBoil_mixinsGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/singleton/boil_mixins.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6f, 0xb1, 0xbd, 0xe8, 0xf9, 0x52, 0x2b, 0xc9, 0x18, 0xf0, 0x58, 0x59, 0xb3, 0x20, 0x29, 0x78, 0x14, 0xd5, 0xd1, 0x84, 0x89, 0x29, 0x8d, 0x72, 0x7, 0x1f, 0x25, 0xa4, 0x3a, 0xa, 0x76, 0x87}}
+	return a, nil
+}
+
+var _templatesSingletonBoil_queriesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x4d\x6f\x23\x37\x0c\x86\xcf\x9e\x5f\x41\x04\xe8\xd6\x6e\xdd\xd9\x9c\x8d\xa6\x80\x3f\x76\xb1\x41\x92\x66\xb3\xc9\x62\xcf\xf4\x88\xb6\x85\x68\xa4\xb1\xc8\x89\x3d\x6b\xf8\xbf\x17\xd2\x58\xfe\x48\x9d\x14\x3d\x0e\xf5\x3e\x7c\x29\x91\x9c\x17\xf4\xa0\x34\x1a\x2a\x04\xae\x40\x79\xfd\x42\x9e\xf3\x49\x1b\xd9\x64\x9d\xdb\x87\x01\x5c\xae\x37\x9b\xca\x6b\x2b\x33\xb8\xf8\x65\x7d\x01\xe9\x38\xbf\x7d\xd8\x6e\xfb\x59\xe7\xdb\x7b\x9a\x6f\x51\x93\x75\xbe\x33\x5d\x5b\x45\xeb\xaf\x06\x0b\x5a\x38\xa3\xc8\xf3\x00\x00\x60\xb3\xd9\x6b\xcf\x69\x02\x1d\xe0\x5b\x64\xb9\xb6\x4c\x5e\xae\x27\x91\x83\x7f\xc3\xc7\x9a\xc4\x3d\x16\x0b\x2a\xf1\x40\x9c\xe3\x5a\x4d\x22\x26\x34\xc3\xda\xc8\x0d\x35\x2b\xe7\xd5\xe0\x2c\x71\xaa\x89\xe4\x1d\xae\xbf\xa2\xc7\x92\xdf\xf1\xda\x6b\x92\xd7\xb0\x16\x37\x76\xa6\x2e\x2d\x0f\xce\x12\xa7\x9a\x84\x3d\xb9\x6a\x6c\xb0\x66\x1a\xbc\x61\x74\xac\x49\xd0\x7d\x2d\x55\x2d\xaf\xb9\x53\xe8\x58\x93\xb8\x31\x32\xfd\x58\x90\xfd\xb4\xd6\x2c\x9c\xf8\x53\xee\x9c\x66\xcf\xbb\xda\xca\x48\xcf\x4f\x6a\x7d\xcd\xef\x34\x89\x79\xc2\xa9\xa1\x2f\xda\x0a\x0f\xde\x64\x0e\x9a\x44\x4d\x34\x8b\xb6\x85\xdc\xdb\xb7\xa9\x83\x26\x51\x3f\xb4\x55\x6e\xf5\xb9\xb6\x85\x68\xb7\xef\xc3\x29\xf5\x4a\x93\xd0\xcf\xce\x7f\xaf\x14\xca\x7b\x7d\xd8\x6b\x02\xb4\xcd\xb2\x8f\x1f\xe1\xd6\xa1\x1a\x2f\x6a\xfb\xfc\xa8\x7f\x12\x68\x06\x59\x10\x94\x8e\x05\xaa\x30\x1d\x24\xe4\xdb\xd8\x33\x35\x0c\x6e\x06\x08\xac\xed\xdc\x10\x10\xce\xc9\x83\x71\xa8\xb4\x9d\x87\x54\xcb\x9a\x7c\x03\x33\xe7\x41\xdc\x1f\x25\xda\x06\x3c\x19\x8c\x45\x2e\x74\xc5\xe0\x7c\x74\x1b\x35\x37\x21\x55\xab\x9e\x6a\xab\xfa\x60\xd0\x87\x5c\x4c\x12\x2c\x42\xae\xe8\x86\x9e\x80\x2b\xa3\x05\xb0\xf0\x8e\x19\x98\x5e\xc8\xa3\x89\xac\x26\xce\xe1\x69\x41\xc7\x75\xba\x59\x2c\x35\x1c\x37\x50\x3a\xc5\x21\xd5\x5c\xbf\x90\x05\x71\xf1\xe8\x50\x74\xcc\x2e\xf8\x4c\x16\x5c\x2d\xe1\x66\x5a\xf2\xa0\xbf\x16\x50\xed\x4a\x71\xa2\x14\x0a\x4e\x91\xe9\x57\x3e\xb8\x81\xd1\xa5\x16\x30\xc4\xbc\xff\x57\xb5\x4b\x77\x87\x7e\xae\x6d\x3f\xe4\xba\x04\xa5\x39\xcc\x06\x43\x11\x1e\x59\xdb\x79\x9e\x85\xff\xdc\xe9\xb3\x5f\xa5\xff\x5e\x7e\x43\x4d\x9b\xa4\xdb\xcb\x42\x82\xf6\x17\x32\x34\x66\x84\x52\x2c\x8e\x7b\x64\xeb\x72\x4a\x3e\xd4\xed\xdd\xaa\x0d\xed\xc5\x50\x92\x2c\x9c\x62\xd0\x31\x02\x68\x55\x48\x56\xb8\x32\x94\x5c\x91\x07\xf1\x68\x19\xe3\x94\x41\x6d\xe3\x25\xc4\x19\x05\x4e\x16\xe4\x57\x9a\xa9\x0f\x97\x3b\x9a\x01\x8d\x69\x4d\x50\xc0\xd9\x82\xda\x2b\x9c\x29\xed\x2a\xac\xc5\xa8\x36\xcf\xed\xd9\xfe\x60\xdb\xce\xda\xdf\xb4\x7a\x88\xad\xd1\x56\x8b\x46\xa3\x7f\x12\x03\x82\xa5\x15\xb4\xf1\x3a\x8c\x56\xbc\x4a\x85\xcc\xa4\x40\xdb\xf6\xe4\x2e\xf4\x72\x56\xdb\x62\x9f\xa3\x1b\xda\x0b\x79\x9e\x2f\xcb\x3c\x49\x7a\xf0\x5b\x9a\x8c\x18\x82\x4d\xd6\x59\xc2\xe0\x0a\x3e\x9c\x84\x37\xdb\xac\x93\x02\x8f\x24\xbb\xfd\xe8\x2e\xfb\xf0\x61\xd7\x86\x5e\xd6\x59\x96\xf9\xb0\xaa\x4c\x13\xc2\xc1\x2a\xcf\xf3\x5e\x96\x75\x3c\x49\xed\x2d\x2c\x77\xdb\xe3\xbc\x22\x3f\x6a\xbe\x90\x09\x8f\x1a\xbf\x38\x4d\x27\x4c\x9b\xc3\xb2\x3c\x5b\xb7\xb2\x50\xc4\x5f\x67\x1f\x98\x28\xde\x72\x4e\x96\x3c\x0a\xc5\xee\xfc\x79\xe7\x14\x99\xbf\xee\x43\x92\x51\x03\x2f\xe8\x75\x9c\x9c\x3c\x93\xa6\xa2\x57\x56\x2c\xbe\x2e\x64\x03\x33\x4d\x46\x01\x8b\x0f\x0f\xd7\xd6\x34\xe4\x22\x55\x32\x6d\xa2\x4d\x6b\x0b\xc8\x05\xd9\xb8\xac\xf1\x25\xbb\xee\x34\x67\x0f\x86\x5c\x74\x7b\x70\xf4\xa0\xb0\x81\x74\xe1\x32\xdf\x15\xd6\x75\x79\x6b\xfa\x3b\x5c\xc0\xf0\x71\x7c\xd1\xdb\xf9\x4e\xe8\x2d\x63\x45\xff\xe5\x3c\xa1\xff\x6d\x3d\xf9\xf4\x38\xbe\xe8\xc1\x36\xfb\x67\x00\xad\xb8\xd2\xa8\x37\x08\x00\x00")
 
 func templatesSingletonBoil_queriesGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -549,11 +916,51 @@ func templatesSingletonBoil_queriesGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/singleton/boil_queries.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8f, 0x97, 0xe, 0x49, 0xf0, 0x80, 0x7, 0xf3, 0x8, 0x9f, 0x11, 0xca, 0x2, 0x9, 0x6d, 0xef, 0x56, 0x55, 0xb, 0x2c, 0xd2, 0x68, 0x6d, 0x7e, 0x5, 0x85, 0xba, 0x6d, 0x30, 0xa, 0x21, 0x2c}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfc, 0x9e, 0x25, 0x2a, 0x9e, 0x16, 0x5d, 0x29, 0x80, 0x86, 0x58, 0xe0, 0xae, 0xc, 0xfd, 0xeb, 0x7, 0x16, 0xad, 0x5a, 0xdc, 0x27, 0xda, 0x26, 0x13, 0x46, 0xc1, 0xfb, 0x3c, 0x84, 0x6e, 0xbf}}
 	return a, nil
 }
 
-var _templatesSingletonBoil_table_namesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x4b\x2c\x52\x08\x49\x4c\xca\x49\xf5\x4b\xcc\x4d\x2d\x56\xb0\x55\x28\x2e\x29\x2a\x4d\x2e\x51\xa8\xe6\xe2\xac\xae\x2e\x4a\xcc\x4b\x4f\x55\x50\x29\x01\xc9\x2b\x58\xd9\x2a\xe8\x81\x55\x16\x2b\xe8\xd6\xd6\x82\xa4\x4b\x32\x4b\x72\x52\x9d\x13\x8b\x61\x4a\xf4\x40\x66\xd4\xd6\x82\x8c\xc8\xcc\x4b\x07\xa9\x48\xcd\x4b\x01\x2b\xae\xa5\xc0\x38\x2b\x05\xa5\xea\x6a\x14\x11\x25\x1d\x14\xb3\xb9\x00\x01\x00\x00\xff\xff\x43\x72\x83\x22\xc4\x00\x00\x00")
+var _templatesSingletonBoil_scannersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\xc1\x6e\xc2\x30\x10\x44\xcf\xf8\x2b\xe6\x08\x15\x24\x5f\xc0\xa5\xea\x1f\x14\xf5\x8a\x36\xf1\x40\x2c\x99\x0d\xf5\x3a\x20\x64\xf9\xdf\x2b\xd3\xf6\xd0\x1e\x77\x76\x76\xde\x6a\x4a\xd9\x21\x9c\xd0\xbd\x8f\xa2\xca\x64\xd8\xd5\xea\xfa\x1e\x87\x89\x18\xe7\xb8\x5c\xd4\x30\x9f\x90\x27\x1a\xe1\x25\xcb\x20\x46\xe4\xc7\x95\x06\x49\xc4\x99\xca\x24\x99\x1e\x62\xcd\xf5\xb3\x1a\x68\xc1\xb3\x09\x97\x6d\x8b\xbb\x4f\x61\x9c\x70\x59\x2c\x63\x20\x64\x88\x44\x9e\x61\xa3\x28\x44\x3d\x2c\xcf\xe9\xe9\x0e\x09\x37\x89\x0b\x6d\x0b\x5d\x62\x34\x04\x1d\xe3\xe2\xe9\x3b\x77\x93\x84\xb5\x5b\x95\x92\x44\xcf\xfc\xf7\xf1\xea\x08\xfb\x8c\xbf\x1a\x80\x3d\x94\xf7\x75\x29\xdd\xe1\x71\x65\xad\x1b\xf4\x3d\x4a\xe9\xde\x5e\xbf\x67\xb7\x3a\xc2\xa7\x70\x63\xea\x3e\x1a\x2f\x61\x8f\x97\xbf\x17\x0d\x45\xf5\xcf\xf8\x8d\x6b\x3d\x51\x3d\x76\xb5\xba\xaf\x01\x00\xb1\x0d\xd4\x36\x34\x01\x00\x00")
+
+func templatesSingletonBoil_scannersGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSingletonBoil_scannersGoTpl,
+		"templates/singleton/boil_scanners.go.tpl",
+	)
+}
+
+func templatesSingletonBoil_scannersGoTpl() (*asset, error) {
+	bytes, err := templatesSingletonBoil_scannersGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/singleton/boil_scanners.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x60, 0x18, 0xbd, 0xf1, 0xd1, 0x37, 0x70, 0x65, 0xd7, 0xef, 0xb9, 0x38, 0x8d, 0x32, 0xa3, 0xc8, 0x7c, 0x80, 0xdc, 0xcc, 0xf3, 0x9a, 0xe5, 0x98, 0x40, 0x2e, 0x75, 0x84, 0x15, 0x8, 0x35, 0x18}}
+	return a, nil
+}
+
+var _templatesSingletonBoil_schemaGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5f\x6f\xe3\x38\x0e\x7f\xb6\x3e\x05\x1b\xb4\x73\x36\xe0\x71\xef\xb9\x83\xbc\xdc\xa0\x77\xc0\x3d\x74\x77\xd1\xc5\x0c\x16\x45\xb1\x50\x6d\x3a\x11\xa2\x48\x19\x49\x69\x1a\x18\xfe\xee\x0b\x52\xb2\x63\xa7\x9d\x3f\xd8\x3f\x83\x19\x4c\x24\x91\xfc\xfd\x48\x8a\xa4\xdc\x75\xef\x41\xb5\x50\xfd\x0f\x0d\x3a\x19\xf0\xbe\x5e\xe3\x56\x7e\x42\xe7\x95\x35\xf0\xbe\xef\xc5\xf5\x35\xcc\x37\x95\x07\x09\x6b\xe9\xd7\x60\x5b\x08\x6b\x84\x20\x9f\x34\xfa\x12\x6a\xab\xf7\x5b\x03\xe1\xb8\x43\x0f\xd2\x34\xb0\xc1\xa3\x27\x09\x8f\xb0\xb5\x0d\x6a\x4f\xc6\x0e\xe8\x10\x56\x09\xae\x81\xd6\xd9\x6d\x09\x2a\x40\xbd\x96\x66\x85\x1e\x0e\x6b\x34\xf8\x8c\x8e\x14\xc1\x33\x32\xfd\x3c\x9e\x29\x92\x29\xd2\x85\xc6\xa2\xaf\x44\x6d\x8d\x0f\x67\x44\x97\xb0\xe8\xba\x6a\xb6\xd7\xf7\x0b\x41\x9a\xb7\x66\xa5\x0c\x0e\x82\x8a\x59\xc2\x73\x5a\x26\xb7\x1a\x19\xe4\x93\xf4\x08\xc8\xc2\x33\x47\x98\x0c\x19\x3a\x39\x22\x57\x52\x19\x1f\x4a\xc0\xed\x2e\x1c\xd9\x0d\x52\x81\xc6\x29\xf2\xa6\xb6\x7b\xdd\x98\x7f\x05\x08\xa8\xf5\xc0\x77\x4e\x63\x09\x5d\xb7\x73\xca\x84\x16\x16\x57\x5f\x16\x50\xcd\x8e\xfb\x5e\x0c\xc9\x8a\x2e\xfd\xb2\x47\x77\xec\x7b\x21\x28\xe0\x29\x52\x1f\x63\x0a\x7c\x70\xfb\x3a\x40\x27\x32\x23\xb7\x08\xf4\xc7\x07\xa7\xcc\x4a\x64\x66\xaf\x35\xe5\x0b\x9e\xac\xd5\x62\xae\xfd\x2b\x1f\xbc\x56\x1e\x74\x63\x82\x3d\x3c\x3c\x4e\xd1\x44\x76\x7d\x0d\xd6\xe8\x23\xe7\xdc\x6f\xd4\x0e\xa4\x43\xf6\x7d\x50\xa0\xdf\x87\xb5\x0a\xa8\x95\x0f\xb0\xc1\x5d\x60\x59\xda\x7e\xd2\xb2\xde\xd0\x36\x9b\x69\x9c\xdd\xed\xb0\x29\xcf\xb5\x8f\xa0\xb1\x0d\x60\xf7\x61\xb8\x74\x29\x0f\xd2\x21\x05\xb5\x71\xaa\x0d\x22\x23\x16\x65\xa4\xf0\xf0\x98\x58\xf7\x9c\xf0\x89\x83\x7e\xa4\x77\x4a\x5e\x48\x07\xa6\x19\x51\x3f\xa1\x53\xed\x31\x86\x1a\xea\x35\xd6\x1b\x2f\x9e\xa5\x9b\x86\xca\xc3\x72\x8c\x05\x9b\xee\x44\xd6\x75\x8e\xee\x31\x5c\xb2\x49\xb8\x59\x42\x95\x64\xa9\x98\x32\x4a\xe1\x25\xd1\xa4\x93\xcb\x94\xc9\xcf\x63\x68\xa2\x56\x75\x47\x59\x3b\xc9\xb3\x43\x13\xf9\xff\x0c\x31\x7b\x43\x1e\x08\x61\x7a\x8b\x26\x22\x7d\x5f\x9e\xe5\x2e\x8a\x27\xc6\xaa\x84\xcb\xda\x6a\x66\x16\x95\x62\x82\x7d\xdf\x77\x9d\x6a\xe1\x52\x91\x81\xae\x43\xd3\xf4\xfd\x1b\x40\xb5\xd5\x23\x4c\xd7\x91\xa9\xea\x2e\xdd\xb6\x9e\x4c\xa0\x69\x88\x24\xf4\xa5\xc8\xb2\x74\x97\x39\x16\x7d\x3f\xa6\xeb\x35\x21\xa6\x13\xa5\xde\x60\x31\x2b\x98\xcb\x7a\x06\xd3\x75\xa8\x3d\xf6\x3d\x18\xa5\x93\xfc\x14\x99\xa2\xfa\x7d\xe4\x28\xf5\x57\x91\xc9\x6f\x91\x91\xe3\xa7\x65\xbc\x98\xad\xd2\x01\x1d\x36\xdc\x84\xdc\x1e\xa1\xb5\xee\x1b\xb5\x63\xdd\xa9\x62\xa6\x35\x41\xa6\x4e\x65\x21\xda\xbd\xa9\x21\x0f\xd3\xcb\x5a\x8c\x50\x79\x6a\xd4\xb1\x40\x0a\xee\x04\x54\xee\x84\xfc\x7b\x09\xec\x77\xcc\x40\xa8\xc8\x7d\x3a\xcb\x54\x0b\x35\x2c\x97\x89\x16\x6f\x65\x0e\xc3\xde\x19\xa6\x2d\xb2\xac\x17\xf4\x57\xb5\xa0\xd1\xe4\xa1\xa2\x94\x15\xa4\xf1\x6f\x16\x4e\xb2\xad\xd4\x1e\x59\xf0\x2d\x34\xd2\xf9\x1e\x5a\xb2\x90\xe0\xd2\x26\x53\x88\x01\x9d\xd7\xad\xdd\xee\xa4\x43\x3f\x99\x53\xb3\x22\x3f\x6f\xf5\x07\x15\xd6\xbc\x63\x0d\xfa\x79\x4c\xdf\x1e\x5c\x64\x2c\x72\xa0\x91\x07\xe8\x9c\x75\x40\xc9\x51\x66\xc5\xca\x8d\x6a\x5b\x74\x68\xea\x68\x6f\x18\x0b\x47\x9a\x0b\x2d\xd9\x91\x3b\xe9\x68\x6a\xbc\xdc\x80\x84\xad\x5a\x39\x19\x68\x18\x38\x69\x98\x0d\xa5\xd7\x61\x82\x55\x66\x55\xc1\x4f\x14\xa4\xb0\x46\x32\x97\xe2\x43\x8d\x9a\xf0\x1b\x88\xed\x5d\x69\x15\x8e\xdc\xe6\xb8\x71\x71\x43\x9d\x4f\x65\x3e\xb3\xcf\x7c\xf3\x9e\x8e\xaf\x87\xbc\x0c\x83\xab\xb4\x0a\x6a\x8b\x95\xe0\x22\xa8\xee\xec\x47\x6b\x02\xbe\x04\xbe\xd3\x7c\xd1\xa6\x21\xcf\xf1\x05\x6b\x78\xb2\x4a\x57\xb7\x2f\x58\xef\x83\x75\x45\x0a\x4b\x27\x32\x67\x0f\xbe\xa4\x25\x65\x9d\x24\x2b\x9e\x62\x39\x8f\x36\xaa\xd6\xaf\x18\xad\xc3\x0b\xd4\x11\xb6\x4a\xf0\x25\x9c\x90\xd2\xd6\x8f\x03\x26\x85\xbc\x0e\x2f\x65\xc4\xa6\x9a\x3e\x9f\xbf\xb3\x39\x1b\xbd\x8f\x5b\xb1\x0f\xbe\x21\x9b\xba\x40\xdf\x17\x5c\x09\xe4\xe8\xc5\x12\x8c\xd2\xd3\x1a\x60\x72\xbe\xfa\xec\xe4\x2e\x47\xe7\x4a\x7e\xa6\xfc\xbc\x59\xc5\xde\x79\x03\x7b\x43\xf5\x0a\xc1\x82\x43\xd9\x4c\xde\x41\x8b\x82\xef\x7c\x83\x2d\x3a\xa0\x50\x56\x1f\xb5\xf5\x98\x17\x42\x64\x5a\x3d\x23\xf9\xb8\x95\x1b\xcc\xb7\x72\xf7\x10\xab\xfb\x71\xf2\x93\xea\xbc\x88\x65\xce\xca\x77\x14\x81\x82\x99\xd1\x60\xe3\xf2\x18\x5e\x71\x65\xba\x49\xf1\x3d\xc0\x2f\x87\xc1\x21\xea\x0e\xa4\x7e\x5f\x4b\x93\xbf\x4b\x5a\xef\x06\xb5\x77\x83\x5e\xf1\xe1\xdc\xfd\xbf\xc5\x7f\x0a\x00\x31\x21\x7f\x1f\x18\xfc\x11\x96\x13\x8c\xd9\xfe\xab\x68\xa4\x10\xb0\x91\x89\xe4\x43\x64\x4f\x1a\x51\xd0\x57\xb7\x5f\xf6\x52\xff\xd7\xea\x26\x1f\x1c\x2a\x61\xf1\xdb\xed\x7d\xca\xc1\x59\x2c\x6e\x9d\xcb\x8b\x0f\xff\x4c\xbe\x05\x67\x87\xdb\xc5\x38\xac\xc6\xf6\x39\xbe\x32\x62\x0b\x9d\xf4\x7b\xcf\x89\x4d\x8d\xae\x04\xbb\x21\xb2\x27\x9f\x2b\xea\x19\x8f\x31\x96\x17\x76\xc3\xc2\x59\x04\x59\x82\xdc\xed\xd0\x34\x39\x2f\x4b\x68\xb7\xa1\xba\x8f\x97\x3d\x5f\x44\xc0\x2b\x4f\xf3\x6a\xab\xbc\x57\x66\xb5\x48\x34\xd8\x64\x41\xe1\xcd\xa8\x56\x95\x49\x63\x41\x64\xe3\x68\xb1\x7a\xd2\xee\xc9\x52\x35\x74\x62\xc6\x3f\x85\x3a\xd2\x4d\x87\x94\x9e\x91\x6f\xe6\x0f\x2a\xd4\xeb\x48\xb8\xa6\xa6\x7d\x61\x37\x37\x22\xfb\x31\xfa\xa9\x61\x5e\xf9\xea\xeb\x2e\x30\xcf\xa9\x33\x04\x32\x50\xa3\xfc\xf2\x71\x5a\xff\x39\xe4\x69\x9b\x8e\x1f\x3e\xcd\x37\x29\xd0\x7d\xcd\x1a\xd4\x18\x30\x4d\x6f\x3f\x11\x19\xa2\xfc\x2c\x1d\xc8\xa6\xc1\x66\x72\x4f\x38\xf4\xe4\xcb\x29\xf0\xc9\x40\x8c\x20\xa5\x3f\xba\x3e\x3e\x0f\x48\xba\x88\xa7\x59\x34\x37\x3a\xc6\xcb\x12\x06\x54\xae\x23\xfa\xe7\xad\x0b\xd5\x3d\x43\xfa\x28\x54\x9c\xb2\x3e\x47\xe7\xd3\x1f\xbe\x6e\xe7\xf9\x32\x78\x38\x0b\xd4\x18\x24\x7e\x10\x8c\x0f\x10\xb6\x5d\xc0\xc5\xd9\xfb\x23\xd5\xe2\x2d\xfd\xd7\xe6\x67\x85\x98\xbe\x36\xe3\xc8\xf6\xfc\x69\x3a\x7d\x00\xe4\xc3\x07\xe2\x95\x2f\x6e\xe0\xca\x2f\xca\xf9\xd0\x2c\xc7\xe6\xf1\x7f\xab\x12\x83\x12\x16\x1f\x60\x51\xa4\x42\x4e\x24\x8c\xa2\x2f\xaf\x71\xec\x88\xae\x7b\x0f\x68\x9a\xbe\x17\x7f\x0c\x00\x6e\x67\x8c\x66\x8f\x0f\x00\x00")
+
+func templatesSingletonBoil_schemaGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSingletonBoil_schemaGoTpl,
+		"templates/singleton/boil_schema.go.tpl",
+	)
+}
+
+func templatesSingletonBoil_schemaGoTpl() (*asset, error) {
+	bytes, err := templatesSingletonBoil_schemaGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/singleton/boil_schema.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x90, 0xf, 0xb4, 0x75, 0x2c, 0x4f, 0xe7, 0x62, 0x6c, 0x62, 0xcc, 0x6f, 0x59, 0x2b, 0x3a, 0x5, 0xc2, 0xb4, 0x0, 0xa1, 0xf5, 0x43, 0xe3, 0x48, 0x6b, 0x99, 0x87, 0x8c, 0x41, 0x47, 0xd8, 0xa}}
+	return a, nil
+}
+
+var _templatesSingletonBoil_table_namesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\x3c\x16\x0f\x0a\x6e\xf7\xbe\xd0\x53\xf1\xea\x41\xbc\xcb\x6c\x9b\x5d\x03\xed\x04\x9a\x59\x3d\x84\xf9\xef\x32\xd9\x16\x0d\x2a\x0a\x1e\x33\xef\xf1\xcd\x7c\x79\xa5\x19\x4f\x74\x18\xfd\x03\x4d\x3e\xa1\x45\x92\xf9\xdc\x0b\xb2\xbb\xca\x79\x26\x3e\x79\x5c\x8b\xe5\xd8\xb7\x68\x4a\x33\x61\xab\x6a\xb1\x04\x19\x7d\x47\x69\xad\x34\xc6\x50\x35\x44\xe0\x93\x35\x3c\x0f\xa5\xac\xff\xc0\xed\xb1\xc9\xb9\x9a\x6c\xee\x2a\xb6\xcb\x79\x8b\x70\x44\x73\x3f\x05\xb1\x13\xba\xc8\x49\x88\x25\xa9\x3a\xb7\xdb\xe1\x91\xde\xc0\x45\x2f\x1e\x21\x2f\x1e\x05\x96\x40\x3c\xd8\x33\xcc\xe8\xe3\x78\x9e\x38\x21\xb0\x0d\x30\x90\xd0\x81\x92\x77\xbd\x91\x70\xf3\xeb\xf1\x45\xe4\xe7\x0f\x69\xbf\x2a\x7c\x36\xb8\x75\x7f\x5e\x64\xaa\x97\xc8\x44\x2d\xfe\x76\xe7\x5a\x5e\x58\x7d\x1c\xad\xba\x14\xba\x45\xb6\x74\x2e\x8f\xf5\x3a\x83\xaa\x3e\x57\x26\x7d\x1c\x6b\x8f\x8f\x41\x65\x51\x09\xe5\xbc\x85\xe7\x41\xd5\xbd\x0f\x00\x5e\x28\xc9\xf7\x61\x02\x00\x00")
 
 func templatesSingletonBoil_table_namesGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -569,11 +976,11 @@ func templatesSingletonBoil_table_namesGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/singleton/boil_table_names.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4f, 0xe7, 0x4d, 0x77, 0xa4, 0x5, 0xca, 0xf4, 0x78, 0xa2, 0xe3, 0x36, 0x28, 0x8e, 0x64, 0xa8, 0x5c, 0xe9, 0x60, 0x4, 0xb1, 0x55, 0x4a, 0xa9, 0x85, 0x96, 0xb4, 0xab, 0x58, 0x8b, 0x1f, 0x4b}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x43, 0xcb, 0xe3, 0x7d, 0xff, 0x9, 0x86, 0xdb, 0x37, 0x89, 0x80, 0xff, 0x35, 0xa0, 0x8f, 0xa1, 0xdf, 0x9e, 0x44, 0x99, 0xf3, 0xda, 0x76, 0x41, 0x23, 0xf1, 0x57, 0xdc, 0x4b, 0xf1, 0xca, 0xd6}}
 	return a, nil
 }
 
-var _templatesSingletonBoil_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\xdc\x38\x12\x3d\x37\x7f\x45\xad\x61\xc0\xad\xc0\x91\x73\x58\xec\xc1\x80\x0f\x6b\x27\x9b\x35\x12\x1b\x0e\xec\x4d\x0e\x41\x10\xb0\xa9\x52\x8b\x68\x8a\x54\xc8\x62\xf7\xca\x1a\xfd\xf7\x41\x91\x92\xbb\xed\x64\x06\x73\x99\x5c\xe2\x2e\xd6\xe7\x7b\x8f\x45\x9d\x9d\xc1\x0d\x50\xdf\x21\xe8\x00\xb5\xf3\xd0\x79\xb7\xd5\x95\xb6\x6b\x50\xce\xc4\xd6\x06\x90\xb6\x9a\xfe\x86\xad\x34\x11\x03\x90\x83\xff\x75\x95\x24\xfc\xb7\x31\xa5\x48\xd1\x37\xd0\xca\xee\x6b\x20\xaf\xed\xfa\x9b\xb6\x84\xbe\x96\x0a\x87\x51\x88\xb3\x33\x78\xe7\xfd\x7d\x6f\xd5\x7f\xa4\x36\xe0\x94\x8a\x3e\x40\x15\xd9\x13\xb4\x0d\xe8\x09\x76\x0d\x5a\xa0\x06\xc1\xa3\x72\x9e\xcb\x45\x53\x81\x75\x04\x2b\xb6\x91\xd7\xb8\xc5\x0a\xb4\xe5\x6c\xce\x57\xe8\xb9\x87\xce\x75\xd1\x48\x42\xa8\xb0\x96\xd1\x50\x6e\x0f\xb4\xad\x9d\x6f\x25\x69\x67\x4b\x78\x68\x74\x80\x18\xa2\x34\xa6\x87\x46\x76\x1d\xda\x90\xcb\x7d\x94\x81\xae\x53\xf9\xeb\x8a\xd3\xd6\x52\x9b\x00\xce\x73\x1f\x1e\x61\x27\x03\x48\xe8\xbc\x6e\xa5\xef\x61\x83\x3d\x28\x67\x6b\xbd\x8e\x3e\x65\x06\x6a\x24\x25\x27\xee\xd2\x63\x70\x66\x2b\x57\x06\x4b\xb1\x95\xfe\xd9\xc0\x17\x80\xde\x3b\x1f\xca\x5b\xdc\x2d\x8f\x86\xa1\xbc\xdb\xac\x6f\x65\x8b\xe3\x78\x9e\x6a\x62\xc5\xb3\x84\xde\xaa\xc6\x3b\xab\x1f\x11\x2a\x49\x12\x64\x4d\xe8\x27\x7c\x8e\x0a\x91\x51\xce\x3f\xaf\xa4\x6a\x10\x02\xf9\xa8\x08\x06\xb1\xf8\x11\xd1\xf7\x30\xfd\xcb\x14\x88\x85\x47\xfa\xf4\x64\x9f\x8d\x09\xa0\x1b\xd9\x75\x8c\xfd\xd7\x6f\x51\x5b\xfa\xd7\x3f\x93\xef\x6c\x84\xbd\x79\x9c\x8a\xc6\x44\xf5\x5f\x2a\xfa\xeb\xfc\xa3\x10\x75\xb4\x0a\x5a\xb9\xc9\x69\x3e\x60\xbf\x54\xce\x04\x58\x39\x6d\xca\xab\xac\xb3\x53\xb0\x8f\x6f\x33\x91\x01\xbe\x7e\xcb\x29\x8b\x29\x35\x57\x5c\xc5\x1a\xce\x2f\xd8\xd0\x4a\xbb\x36\x58\xbe\x47\xba\x8c\x75\x8d\x7e\x59\x88\x74\x5c\x7e\xf1\x9a\xf0\x3e\x45\x2c\x03\x79\xe5\xec\xb6\xbc\x26\x27\x53\xb5\xf2\x83\xb6\x55\x51\x88\x05\xcb\xfc\xfb\x29\xec\x38\x9b\x97\x76\x8d\x90\x8e\xaf\xb8\xa3\x41\x2c\x7e\xca\xb4\x2b\xc4\x62\x14\x62\xa1\x6b\x30\x68\x97\xfb\x36\x0b\xf8\xc7\x05\xbc\x79\x1e\x73\xd9\x13\x2e\x4f\xca\x93\x14\x33\x97\xb2\x8f\xfb\x5a\x07\x53\xfe\xaa\x98\x7d\x9c\xaa\x05\xf2\x1c\xc4\xe7\xd3\x51\x91\x8c\xd3\xf0\x77\x71\x1e\x7e\x15\xeb\x22\x71\x18\xbd\x65\x74\x18\xef\x61\x38\x7b\x25\x1e\x1a\x84\xda\x19\xe3\x76\xe9\xaa\xb1\x9e\x8d\x26\x32\x08\x2b\x4d\xe0\x6a\x58\x19\xa9\x36\xd0\xca\xb5\x56\xe9\x96\x57\x18\xd0\x6f\x31\x40\x70\x2d\x02\xfe\xbf\x33\xd2\x26\xb5\x0b\x71\x89\x4a\xc6\x80\xd0\xb9\x40\x6b\x8f\x79\x2b\xb4\x7d\xf8\x61\xf8\xf6\x69\x8b\x80\x36\xb6\x01\x94\x6b\x3b\x83\x84\xa6\x87\x4a\x73\x7b\x68\xc9\xf4\xb0\x74\x16\x41\x12\xdf\x2d\xc1\xf2\x5e\xc9\x80\x60\x70\x8b\x06\xd2\x3d\x53\x31\x90\x6b\x93\xf2\x59\x73\xa7\x29\xfd\x3e\x06\x88\xef\xd6\xbc\x85\xe6\x38\x21\x21\x5a\xfd\x23\x22\x50\xc3\x13\x76\xbc\x16\xd8\xb1\x28\x4b\xbe\xf9\xe8\xf1\x24\x25\x6f\xa4\x55\x98\xf2\x70\x93\x8c\x84\x95\x2d\x56\xb0\x9c\xa7\x29\x04\xd7\xe3\x9b\xbc\x4c\x33\x15\x25\xdc\x3b\xd8\x21\x28\x69\x4f\x08\x2a\xc7\x15\xc2\xbe\x00\x84\xc9\xa2\x5c\x95\x36\x27\xd7\x2a\x85\xf8\x82\x60\x9c\xeb\x80\x1a\xef\xe2\xba\x01\x94\xaa\x99\x22\x0e\xb6\xa8\x71\x6e\xc3\xfd\xb2\x38\x12\x6a\x25\x5c\xd7\xa0\xe9\x64\xea\xeb\x14\x76\x28\x88\x77\x14\x23\x9e\xb8\xa8\x74\x58\xc7\x40\x1c\x95\xe9\x22\x07\x3b\x56\x0d\x04\x4a\x1b\x30\xaf\xd3\x04\x15\xb6\x5d\xda\x8a\x4c\x85\x36\x08\xe4\x38\x19\x1c\x39\xab\xf0\x88\xd7\xf4\xb4\x15\x0d\xd2\x0c\x44\xe6\xce\x59\xd3\xf3\xc2\xcd\x84\x32\xfe\x0a\x41\xd7\x9c\xb5\x3f\xf1\xbc\x88\x13\x9f\x0a\x2b\xd1\x46\x43\xba\xe3\xe4\xba\xc5\x00\xda\x42\x2b\x2d\xd3\xec\x01\xb7\xd3\x2e\x0f\xb2\xc5\x22\x4f\x1f\x4a\xc1\x6a\xb4\x09\xd2\x06\xd5\x86\xd3\x4a\x63\xf2\xd0\xd3\xab\x22\x3d\x82\xe5\xcd\x6d\x4e\xe7\xaa\xc9\xc6\x31\x1e\x79\xa2\x99\x41\xe1\x22\x75\x91\x92\x1b\x93\xb6\x43\xc8\x16\x90\x50\x7b\x8d\xb6\x32\x7d\xde\xba\xd0\x62\x08\x72\x8d\x93\xca\x5c\xdb\xa2\x25\x06\x4f\xea\xf4\x9c\x54\xb8\x8a\xeb\xb5\xb6\xeb\x52\x88\xbb\x59\xda\x53\x2e\xa6\x29\x80\xd1\x1b\x3c\x87\x77\x36\xb6\xbc\xb4\xf9\xff\xcf\xe9\x95\xb9\x80\x23\x6e\x25\xf5\x7e\x24\x6e\xfa\xfb\x4f\x1f\x7f\x15\x08\x00\x0f\x8c\x00\x07\x5f\x39\xf3\x67\x39\xc4\x35\x65\x0a\x48\x93\x41\x25\x03\xbf\xb4\x0d\xc2\xde\xbf\x73\x3e\xbd\x3d\x7a\x52\x4b\xb0\x72\x83\xaf\xd9\xb3\x2a\xc5\xab\xb3\x71\x14\xc3\x70\x9c\x58\x3b\xbf\x48\xec\xdd\xe2\x2e\x19\x5f\x4f\xbb\xe7\x38\x6b\xf1\xfc\x02\xca\xd4\x55\x80\xd7\xe3\x28\x16\x07\x0e\xca\x19\x3e\xce\x8e\xf3\x6a\x86\xdf\xa0\xd6\x86\xd0\x4f\xbf\x2f\x7b\xee\x29\xc7\xa6\xe0\x63\x96\x11\xc7\x75\xd2\x07\x9c\xc1\x4a\xd9\xca\xb7\x97\x0f\xfc\x8a\x1c\x38\x6f\xa5\x09\xcf\x9c\x3f\xb3\xe1\x0f\x9c\x75\xb8\x4d\x12\x3d\xbf\x00\x8b\xb0\x34\x68\x73\xb5\x02\xde\x3c\x39\xb1\x98\x6c\xb5\xf7\x5d\xf2\xec\xff\x95\x01\x32\x18\x93\xff\x3e\x29\x9a\x30\xd7\x98\xe3\x9f\x62\x27\xf3\x62\x18\x8e\xbf\xcf\x30\xde\x45\x3a\x4c\xb5\x0f\x44\x9b\x03\x0e\x9a\x58\xae\x69\xea\x92\xc7\x2c\xe0\x4d\x01\x4b\x1d\x12\x24\x49\xdb\x93\x7d\x1c\xd3\x87\x11\xc3\x38\xc9\x9f\xb7\xc1\x30\x1c\xb4\x32\x8e\xc3\x30\xd5\x1b\x06\x6e\x39\x19\x32\x31\xf9\x03\xa2\x1c\x86\x84\xda\xed\xec\x64\xab\x71\x14\xca\xd9\x40\xb0\x7c\x46\xeb\x56\x66\x5a\x13\xf4\x4f\x9c\xf3\x4f\x7e\x5b\xba\x2e\x03\xcc\x6f\x6d\xf7\xa5\xd1\x84\xa1\x93\x6a\x0a\x7b\xf2\x7e\xd1\x5a\x52\xe9\x15\xef\xf1\x9f\x9a\x3c\x38\x3a\x6c\xf7\xd9\xc1\x8b\xbe\xe7\x32\xba\x86\xd0\xf0\xd7\xdf\xc3\xec\x9a\x30\x3a\xec\xf4\x45\xa2\x17\x27\x4f\x40\xbd\xb4\x33\x36\x7c\xe3\xf2\xd1\x38\x1e\x71\xb9\xb9\x72\x21\x66\x5d\xfc\x7d\xc4\x4c\x0b\x8e\xf8\x13\x9b\x5f\x92\xf7\x0e\x74\x85\x96\x74\xad\xd1\x87\x53\x7e\x6b\xf8\x14\x5b\x4d\x90\x38\x94\x96\x82\x38\x94\xd9\x73\xd1\xfd\xa4\xc0\xf9\xef\xdf\x03\x00\x00\xff\xff\xbd\x92\xdd\xd2\xd4\x0b\x00\x00")
+var _templatesSingletonBoil_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5f\x6f\xdc\xb8\x11\x7f\x5e\x7e\x8a\xa9\x61\xc0\xab\xc3\x46\xce\x43\xd1\x87\xa0\x2e\x50\xfb\x92\xd4\xb8\xb3\x91\xbb\xf8\x2e\x0f\x41\x70\xe0\x8a\xa3\x15\x61\x8a\x54\x38\xa3\x55\x14\x55\xdf\xbd\x18\x4a\xda\x5d\x3b\x69\xd1\x97\xf3\x8b\xad\xe1\xfc\xfd\xcd\x70\x7e\xf4\xe5\x25\xdc\x01\xf7\x0d\x82\x25\x28\x43\x84\x26\x86\xbd\x35\xd6\xef\xa0\x08\xae\xad\x3d\x81\xf6\x66\xfe\x1b\xf6\xda\xb5\x48\xc0\x01\x7e\x6b\x8c\x66\xfc\xa7\x73\xb9\x4a\xd6\x77\x50\xeb\xe6\x23\x71\xb4\x7e\xf7\xc9\x7a\xc6\x58\xea\x02\x87\x51\xa9\xcb\x4b\x78\x1d\xe3\xfb\xde\x17\x6f\xb4\x75\x10\x8a\xa2\x8d\x04\xa6\x15\x4d\xb0\x9e\x30\x32\x74\x15\x7a\xe0\x0a\x21\x62\x11\xa2\x84\x6b\x9d\x01\x1f\x18\xb6\x22\xe3\x68\x71\x8f\x06\xac\x17\x6f\x21\x1a\x8c\x92\x43\x13\x9a\xd6\x69\x46\x30\x58\xea\xd6\xf1\x94\x1e\x58\x5f\x86\x58\x6b\xb6\xc1\xe7\xf0\x50\x59\x82\x96\x5a\xed\x5c\x0f\x95\x6e\x1a\xf4\x34\x85\xfb\x59\x13\xdf\xa6\xf0\xb7\x46\xdc\x96\xda\x3a\x82\x10\x25\x8f\x88\xd0\x69\x02\x0d\x4d\xb4\xb5\x8e\x3d\x3c\x62\x0f\x45\xf0\xa5\xdd\xb5\x31\x79\x06\xae\x34\x27\x25\xc9\x32\x22\x05\xb7\xd7\x5b\x87\xb9\xda\xeb\xf8\xa4\xe0\x2b\xc0\x18\x43\xa4\xfc\x1e\xbb\xf5\xd9\x30\xe4\xef\x1e\x77\xf7\xba\xc6\x71\x7c\x95\x62\xa2\x91\x5a\xa8\xf7\x45\x15\x83\xb7\x5f\x11\x8c\x66\x0d\xba\x64\x8c\x33\x3e\x67\xd9\x02\xe3\xaf\xe8\x82\x36\xf7\x81\xdf\x84\xd6\x9b\x67\x60\x4e\x87\x27\x60\x86\xee\x29\x92\x65\x32\xda\xf6\x60\x99\xc4\xe1\x49\x75\x9b\x03\x48\x5b\x2c\x74\x4b\x08\x76\x2a\xcf\xa0\x43\x46\x03\x64\x7d\x71\x10\x4a\x1c\x34\x39\xdc\x32\x74\x51\x37\xc9\x19\x7d\x76\xf9\xeb\x18\xef\xc3\xaf\xa1\x23\xa0\xb0\x94\x7d\x3b\x4d\xd0\xfc\x75\x93\x7c\x17\x15\x16\x8f\x04\x8f\x88\x0d\x74\x21\x3e\x5a\xbf\x3b\x00\xf7\xac\xc4\x03\x7c\x1f\x2c\x57\x77\x48\xa4\x77\xb8\x7e\x12\x6b\x03\xcf\x50\x8d\xa1\x13\x48\x63\x72\x04\x3e\x80\x0b\x7e\x87\x11\xf0\x8b\x25\x26\x01\x73\x18\x6c\x09\xf9\x5b\xf4\x18\x35\xe3\xad\x37\xf8\xe5\x0e\x59\x27\xe0\x5f\x8c\xa3\x94\x93\x84\x60\x90\x8a\x68\xb7\x28\x35\x80\x4d\xa2\xe0\x41\x03\x4b\xaf\x37\x40\x88\x32\x2e\xf0\xf7\xbb\x60\xd0\xfd\x23\xd9\x20\xc1\x5e\x47\x2b\x0a\x94\x8b\xa7\x37\xd6\xa5\x56\x52\x52\x6d\x22\x1a\x5b\xc8\xd4\x86\x52\x06\x4c\x47\xb6\xda\xc1\xba\x4c\x5a\x68\xb2\x29\xcc\x06\xb0\x6e\xb8\x87\x20\xd3\xd8\x59\xc2\xf9\x9e\xa5\x10\x40\x1c\xdb\x82\x61\x50\x2b\x99\x24\x00\x10\x89\xf5\x3b\xb5\xba\x99\x2f\xed\xc7\x4f\x8b\xe4\x37\x6f\x3f\xb7\x08\xb0\x0d\xc1\xa9\xd5\x9c\xcc\xa2\x3f\x0a\x16\xe8\x4d\xaa\x3a\x05\x98\x26\xee\x46\x17\x15\x9e\x84\xf9\xdc\x62\xec\x61\xfe\x59\x3c\x47\xe4\x5f\x0e\xf2\x45\x98\xee\xe0\x9d\x6e\x1a\xb9\xde\x1f\x3f\xb5\xd6\xf3\xdf\xfe\x9a\x74\x17\x21\x1c\xc5\xa3\x9a\xaa\x6a\xd3\x36\xf9\xbf\x82\x7e\xdf\xff\xa8\x54\xd9\xfa\x02\x6a\xfd\x38\xb9\xf9\x09\xfb\x75\x11\x1c\xc1\x36\x58\x97\xcf\xa8\x6c\xc0\x7f\xfd\x71\xda\x15\x47\x84\xb2\xd9\xb5\x44\xdc\xb6\x25\xbc\xba\x12\x41\xad\xfd\xce\x61\xfe\x16\xf9\xba\x2d\x4b\x8c\xeb\x4c\xa5\xe3\xfc\x43\xb4\x8c\xef\x93\xc5\x9a\x38\x16\xc1\xef\xf3\x5b\x0e\x3a\x45\xcb\x7f\xb2\xde\x64\x99\x5a\xc9\x26\xfd\x63\x03\x9d\x78\x8b\xda\xef\x50\x36\x28\x49\x1e\x24\x71\xbe\xf1\xd4\x65\x6a\x35\x2a\xb5\xb2\x25\x38\xf4\xeb\x63\x9a\x19\xfc\xe5\x0a\x5e\x3e\xb5\xb9\xee\x19\xd7\x17\xf9\x45\xb2\x59\x42\xf9\xaf\xc7\x58\x27\x55\x7e\x2f\x98\xff\x3a\x47\x23\x8e\x62\x24\xe7\xf3\x51\xa6\x56\xc7\xe2\xdf\xb5\x4b\xf1\xdb\xb6\xcc\x52\x0f\xdb\xe8\x05\x9d\x69\x6e\x2e\x7f\x50\x0f\x95\x6c\x15\xe7\x42\x27\x08\x5a\x59\x99\xce\x32\x3b\x84\xad\x65\x08\x25\x6c\x9d\x2e\x1e\xa1\xd6\x3b\x5b\xa4\x35\x60\x90\x30\xee\x51\x16\x44\x8d\x80\x5f\x1a\xa7\x7d\x5a\xa8\x4a\x5d\xcf\x8b\xa7\x09\xc4\xbb\x98\xae\x9c\x81\xba\xa7\xcf\x4e\x16\xbc\xf5\x08\xe8\xdb\x9a\xa0\x08\x75\x23\x3b\xc9\xf5\x60\xac\xf4\x06\x3d\xbb\x1e\xd6\xc1\x23\x68\x96\x4b\xa6\xe4\x22\x6f\x35\x21\x38\xdc\xa3\x83\xb4\xca\x8b\x96\x38\xd4\x69\xb9\xca\xcc\x6d\x92\xfb\xa3\xcd\x74\xa5\x17\xa2\x5b\xec\x94\x86\x76\xba\x3f\x5c\x49\x85\x8d\x30\x8f\x28\x66\x79\x2e\xe4\x82\x11\x2f\x92\xf3\x4a\xcb\x7a\x94\x0b\x2e\x49\x0a\x99\x7a\x5d\xa3\x81\xf5\x52\x4d\xa6\x24\x9e\x90\xc5\x3a\xd5\x94\xe5\xf0\x3e\x40\x87\x50\x68\x7f\xc1\x60\x02\xb0\x50\xd5\x21\x80\x6c\xd0\x24\x29\x82\x49\xe4\x2c\xac\x94\x2b\xf5\x01\xc1\x85\xd0\x00\x57\x31\xb4\xbb\x0a\x50\x17\xd5\x6c\x71\x42\xd4\x2e\x04\x59\xaa\x89\xd1\x25\x21\xca\xe1\xb6\x04\xcb\x17\x73\x5e\x1b\xe8\x50\xb1\x10\x85\xac\xe3\xd4\x0b\x63\x69\xd7\x12\x8b\xd5\xd4\x2e\x0e\xd0\xc9\xb8\x01\xb1\xac\xab\x99\x64\xa4\x44\xc6\xba\x49\xc4\x2b\xad\xb0\x0e\x81\x83\x38\x83\xb3\xe0\x0b\x3c\x93\x97\xc0\x4c\xbc\x0e\x79\x01\x22\x65\x01\xc1\x27\x86\x99\x1b\x6a\x40\x0c\xc0\x96\xd2\x80\xfe\x22\x22\x44\x4c\xfd\x2c\xd0\xa8\xba\x75\x6c\x1b\x71\x6e\x6b\x24\xb0\x1e\x6a\xed\xa5\xcd\x11\x70\x3f\x33\x1c\xe9\x1a\xb3\xa9\x7a\xca\x95\x4c\xa3\x4f\x90\x0a\xbd\x88\x5b\xed\xdc\x54\xf4\xfc\x70\xd1\x11\xc1\xcb\xe3\xc0\x6d\x96\xa8\x49\x26\x36\x11\x35\x1f\x3b\xa8\x42\xcb\x4d\xcb\x49\x4d\x9a\xd6\x21\x4c\x12\xd0\x50\x46\x8b\xde\xb8\x7e\x62\x26\xa8\x27\x52\x9a\xa7\x2c\xd4\x35\x7a\x16\xfe\xd1\x36\xbd\x58\x0c\x6e\xdb\xdd\x2e\x31\x9c\x7a\xb7\x8c\xf6\xec\x4b\xda\x44\xe0\xec\x23\xbe\x82\xd7\xbe\xad\x65\x9b\xcb\xef\xdf\x25\x5d\xb8\x82\x33\x49\x25\xe5\x7e\xa6\xee\xfa\xf7\xbf\xfc\xfc\x3d\x43\x00\x78\x10\x04\xc4\xf8\x26\xb8\xff\xe5\x43\xdd\xf2\xd4\x02\xb6\xec\xb0\xd0\x24\x8f\xb9\x0a\xe1\xa8\xdf\x84\x28\xb7\x51\xca\x4e\xc0\x91\xd7\x8f\xf8\x42\x34\x4d\xae\x7e\xb8\x1c\x47\x35\x0c\xe7\xa9\x6b\xaf\xae\x52\xf7\xee\xb1\x4b\xc2\x17\xf3\xee\x39\x4f\xdd\x90\xb5\x92\xa7\xac\x28\x51\xcb\xea\x44\xa1\x08\x4e\x8e\x27\xc5\x65\x35\xc3\xbf\x61\x62\xc0\xf9\xfb\xba\x97\x9c\x26\xdb\x64\x7c\x2e\x63\x24\x76\x8d\x8e\x84\x0b\x58\x70\x5e\x04\x97\xff\x78\xfd\x20\x2c\x72\xa2\xbc\xd7\x8e\x9e\x28\xff\x2e\x82\xff\xa2\x6c\x49\x5c\x19\xd1\xf7\x08\x6b\x87\x7e\x8a\x96\xc1\xcb\x83\x92\x0c\x93\x37\x47\xdd\xb5\xd4\xfe\x2f\x4d\x30\x81\x31\xeb\x1f\x9d\xa2\xa3\x25\xc6\x62\x7f\xb0\x9d\xc5\xab\x61\x38\xff\x63\x81\xf1\x5d\xcb\xa7\xae\x8e\x86\x0b\x39\x9f\x24\xb1\xde\xf1\x9c\xa5\x94\x99\xc1\xcb\x0c\xd6\x96\x12\x24\x69\xb6\x67\xf9\xf4\x8e\x11\xf1\x32\xfe\xb2\x0d\x86\xe1\x24\x95\x71\x1c\x86\x39\xde\x30\x48\xca\x49\x30\x35\x46\x14\xc6\x31\x1f\x86\x84\xda\xfd\xa2\xe4\xcd\x38\xaa\x22\x78\x62\x58\x3f\x69\xeb\x5e\x4f\x6d\x95\xd8\xc7\x9e\x4b\x2a\xc2\x2d\x4d\x83\x66\xa6\x56\xdb\x7c\xa8\x2c\x23\x35\xba\x98\xcd\x0e\xda\xcf\x52\x4b\x53\x7a\xa3\xe9\x00\xca\x31\xc9\x93\xa3\xd3\x74\x9f\x1c\x3c\xcb\x7b\x09\x63\x4b\xa0\x4a\x9e\xc5\x0f\x8b\x6a\xc2\xe8\x34\xd3\x67\x8e\x9e\x9d\x1c\x80\x7a\x2e\x17\x6c\xe4\xd6\x4e\x47\xe3\x78\xa6\x56\xc7\xc8\x99\x5a\xe6\xe2\xcf\x6b\x4c\x5a\x66\xb2\xae\x9a\x18\x84\x49\xde\x06\xb0\x06\x3d\xdb\xd2\x62\xa4\x8d\x70\x8d\x9c\x62\x6d\x59\xfe\x9d\x21\xd6\x9e\x49\x9d\x8e\xd9\xd3\xa1\xfb\x66\x02\xd1\x1b\x78\x31\x8e\xea\x3f\x03\x00\x58\x9c\xf1\x9c\x37\x0e\x00\x00")
 
 func templatesSingletonBoil_typesGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -589,11 +996,11 @@ func templatesSingletonBoil_typesGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/singleton/boil_types.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf5, 0xb1, 0xf1, 0xb6, 0xda, 0x1c, 0xe8, 0x60, 0x98, 0x80, 0x7b, 0x39, 0x12, 0x58, 0x34, 0xc0, 0xc7, 0x46, 0x9, 0xf8, 0x45, 0x81, 0xe4, 0x1f, 0x58, 0x90, 0x89, 0x0, 0xf1, 0x97, 0x6c, 0x44}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaf, 0x13, 0xf6, 0x15, 0xe9, 0x98, 0x51, 0xd7, 0xd6, 0x61, 0x30, 0x76, 0xc8, 0xb8, 0x16, 0x18, 0x99, 0x5d, 0xb4, 0xc2, 0xcb, 0x1c, 0x18, 0xdc, 0x95, 0xbc, 0x64, 0xac, 0xa0, 0x65, 0x8f, 0xc4}}
 	return a, nil
 }
 
-var _templates_test00_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\x31\xae\xc2\x30\x10\x84\xe1\x3e\xa7\x98\xee\x3d\x9a\xe4\x04\x14\x14\x5c\x80\x0b\xa0\x95\x33\x49\x56\x38\x6b\xc7\x6b\x23\xe5\xf6\x08\x50\x0a\xca\x91\xe6\xff\x9e\x52\xf0\xdf\x01\xc0\x30\xe0\xc6\x28\x55\x93\xf9\xa2\xd9\xe1\x69\x65\xd5\x95\x8e\xe6\x44\x5d\x88\xc2\x29\x32\xbc\x1f\x58\x18\x33\x0b\xb6\xc6\xa2\xf4\xfe\xba\x35\x89\xc3\xb1\x2e\xee\x3a\xdb\xa1\x7a\xc2\x94\x4a\x20\x04\x59\xc2\x43\x66\x62\x64\xa6\x8d\xb4\xb0\x43\x0d\x41\xbe\xfe\x8e\x31\xd9\x5f\xed\x3f\xe1\x1d\xe7\x5f\xbd\x3b\x75\xaf\x00\x00\x00\xff\xff\x1f\x1b\x4a\xa6\xad\x00\x00\x00")
+var _templates_test00_typesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\x31\xae\xc2\x30\x10\x84\xe1\x3e\xa7\x98\xee\x3d\x9a\xe4\x04\x14\x14\x5c\x80\x0b\xa0\x95\x33\x49\x56\x38\x6b\xc7\x6b\x23\xe5\xf6\x08\x50\x0a\xca\x91\xe6\xff\x9e\x52\xf0\xdf\x01\xc0\x30\xe0\xc6\x28\x55\x93\xf9\xa2\xd9\xe1\x69\x65\xd5\x95\x8e\xe6\x44\x5d\x88\xc2\x29\x32\xbc\x1f\x58\x18\x33\x0b\xb6\xc6\xa2\xf4\xfe\xba\x35\x89\xc3\xb1\x2e\xee\x3a\xdb\xa1\x7a\xc2\x94\x4a\x20\x04\x59\xc2\x43\x66\x62\x64\xa6\x8d\xb4\xb0\x43\x0d\x41\xbe\xfe\x8e\x31\xd9\x5f\xed\x3f\xe1\x1d\xe7\x5f\xbd\x3b\x75\xaf\x01\x00\x1f\x1b\x4a\xa6\xad\x00\x00\x00")
 
 func templates_test00_typesGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -613,7 +1020,7 @@ func templates_test00_typesGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testAllGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\x41\xaa\x83\x40\x0c\x06\xe0\xf5\xcc\x29\x82\xbc\x85\x3e\x9e\x39\xc0\x03\x17\x5d\x74\x5b\x2c\xd8\x03\xa4\x36\xda\x81\x74\xb4\x63\x84\x96\x61\xee\x5e\x46\xb7\x5d\x25\x90\xef\xcf\x1f\x63\x0d\x3f\x24\x8e\x16\xf8\x6f\x00\x0f\x79\xe3\x05\x3b\xba\x0a\xc3\x3e\xf0\x44\x0f\x86\x3a\x25\x3b\xac\xbe\x07\xe5\x45\x63\xdc\x33\x78\x99\x5b\x59\x03\x49\x4a\xa5\xc2\x6f\x3e\x39\x3f\x62\x57\x41\xb4\x46\xb1\xa5\x40\x22\x2c\x65\x65\xad\x79\xae\x1c\xde\xb9\xe4\x5b\x38\x03\x37\xc0\x66\xf0\xbc\xc9\xa6\x01\xef\x24\x3f\x32\x8a\xc7\x10\xa6\x50\x16\xfc\x9a\xb9\x57\xbe\x01\xed\xf4\x0f\xc6\x49\xc1\x4f\x7a\x77\x7e\x2c\x2a\x6b\x92\x4d\xf6\x13\x00\x00\xff\xff\x39\x32\xe3\xcd\xd3\x00\x00\x00")
+var _templates_testAllGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x41\xaa\xc2\x40\x0c\x86\xd7\x33\xa7\x08\xe5\x2d\xda\x87\xcd\x01\x84\x2e\x5c\xb8\x95\x0a\xf5\x00\xb1\xa6\x75\x20\x4e\xeb\x34\x05\x65\x98\xbb\xcb\xd8\xad\x9b\x24\x90\xef\xfb\xff\x18\x6b\xf8\x23\x71\xb4\xc0\xbe\x01\x3c\xe4\x8b\x17\xec\xe8\x2a\x0c\xdb\xc2\x13\x3d\x18\xea\x94\xec\xb0\xfa\x1e\x94\x17\x8d\x71\x73\xf0\x32\xb7\xb2\x06\x92\x94\x4a\x85\xff\xfc\x72\x7e\xc4\xae\x82\x68\x8d\x62\x4b\x81\x44\x58\xca\xca\x5a\xf3\x5c\x39\xbc\x73\xc9\x2f\x39\x03\x6e\x80\x2f\x83\xe7\x3c\xa1\x69\xc0\x3b\xc9\x41\x46\xf1\x18\xc2\x14\xca\x82\x5f\x33\xf7\xca\x37\xa0\x0d\xdd\xc1\x38\x29\xf8\x49\xef\xce\x8f\x45\x65\x4d\xb2\xc9\x7e\x06\x00\x39\x32\xe3\xcd\xd3\x00\x00\x00")
 
 func templates_testAllGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -633,7 +1040,27 @@ func templates_testAllGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testDeleteGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x98\x41\x4f\xe3\x3a\x10\xc7\xcf\xf1\xa7\x98\x17\xbd\xf7\x94\x3c\x05\xeb\xed\x95\x15\x87\xd2\xee\x81\xc3\x56\x2c\x2d\xda\xe3\xca\x4d\x26\x25\xc2\xd8\xc8\x76\x68\xc1\xf2\x77\x5f\xd9\xa1\x4d\x8b\x00\x55\x82\xd2\x5d\xad\x0f\x08\x1a\xfd\x67\xe6\x3f\x63\xe7\x27\xa6\xd6\x1e\xc1\xdf\x8c\x37\x4c\xc3\xf1\x09\xd0\x81\xff\x0b\x35\x9d\xb2\x19\x47\xe8\x7e\xd1\x31\xbb\x41\x38\x72\x8e\x04\x71\xc9\xc4\x44\xd6\x66\x84\x1c\x0d\x86\xa0\x4e\x35\xdc\x7a\xbe\x96\x6b\x59\x1b\xaf\x62\xa2\x02\x3a\xa8\xaa\x5e\xa3\x9f\xe6\x0a\x21\x4d\xfd\x18\xe3\x33\xd4\xad\x28\xc1\xa0\x36\xd6\x76\x26\xe9\xe5\xed\x39\x6f\x15\xe3\xce\xf5\x81\x99\x81\xff\xbc\xa8\x11\x73\x3a\xcd\xc1\x92\xc4\xd0\x73\xa6\x18\xe7\xc8\xb3\x9c\x90\x44\x23\x56\xde\x83\x62\xa2\x92\x37\xcd\x03\xd2\x31\x2e\x26\x88\x55\x96\x93\xe4\x8e\x29\x40\x15\x7e\xa4\x22\x89\xf4\xc2\x7f\x37\xea\x4d\x1a\x31\x6f\x39\x53\xce\x59\x47\x92\xa6\x0e\xe2\xcd\x5c\x13\xa3\xda\xd2\x64\xbe\x48\x01\xb2\x80\x75\xec\x48\x2e\x44\x1f\x3d\x3a\x9d\xde\xdf\xa2\x2e\xc0\xa8\x16\x5f\x54\x0d\x25\x6f\x6f\x84\xfe\xde\x98\xab\x11\xd6\xac\xe5\x86\x52\x9a\x7f\x0e\x45\xff\x3a\x01\xd1\x70\xdf\x5f\x62\xe8\x17\x6f\xb7\xce\xd2\x4b\x11\x8e\xca\xc8\xde\x11\x3c\xeb\x1e\x74\xf0\x79\x0c\xff\xe8\xb4\xf0\xf9\x72\x92\x38\x42\x92\x30\x72\x21\x0d\xd0\xb1\x1c\x4a\x61\x70\x69\x9c\x2b\xcd\xd2\xcf\xa1\xec\x3e\xd3\x53\x56\x5e\xcf\x95\x6c\x45\x95\xe5\xd6\xa2\xa8\x9c\x23\x49\x27\xf9\xda\x6a\x33\x5d\x66\x21\xcb\x66\x86\x99\x6c\x38\x3d\xc5\x79\x23\x42\x08\xd7\xb8\xf9\x6c\xba\xcc\x4a\xb3\x2c\x7c\x3f\xab\x84\x39\x49\x2a\xac\x51\x81\x3f\xf4\x2c\x07\x0b\x3f\xe0\x04\xcc\x92\x5e\x48\xce\x67\xac\xbc\xce\x72\x70\xfe\xc0\xd6\x47\x20\xe9\x99\xd0\xa8\x4c\xf6\x52\x0b\x7e\xca\x28\x2a\x7f\x95\xc0\x7f\x0a\xf5\xcf\x44\x8d\x2a\xcb\x5f\x9c\x69\xf6\x64\x34\x74\x2c\x2f\xe4\x42\x0f\xea\x1a\x4b\x83\x21\xd9\x96\x87\xc7\x3b\xb8\xab\x87\x9a\x71\x8d\xbb\x15\xf7\x43\x5b\x97\x53\x9d\x87\x70\x72\x7e\xee\x7b\x2a\x0c\xa1\x68\x5f\xcf\x4b\x3f\x6d\x09\x53\x7d\x25\x5b\x5e\x81\x14\xfc\x1e\xae\xd8\x1d\x42\x15\x8c\xf8\x27\xe8\xc3\x0a\x98\xb5\x06\xd8\xe3\xbc\x8e\xd3\x62\x95\xab\x6f\xac\xb3\x45\x48\x52\xca\x56\x98\x75\x4f\xcf\xbc\xe5\x59\x4e\x87\x5e\xb3\x63\x9b\xfd\xf5\x78\x75\xb6\x4d\x0d\xa1\xb2\x57\xfd\xbf\xdd\xdd\x82\x09\x03\x0f\xa8\x24\x28\x2c\xa5\xaa\x74\x01\x73\x69\x7c\x17\x21\x22\x24\x70\xe4\x55\x32\x7d\x6b\x51\xdd\xf7\x78\x1a\x70\x1e\x09\x15\x09\x75\x28\x42\x3d\xff\x52\xf5\x57\xf3\xa3\xc1\xf5\xb1\x7e\x22\xcf\xde\xce\xb3\x09\x6f\x4a\x8c\x3c\x8b\x3c\xdb\x3f\xcf\xb4\xbf\x6a\x4f\x5e\x9d\x7e\xa0\xe1\x22\x5a\x9b\xda\xd4\x39\x69\x6d\xea\x52\xb7\x23\x04\x43\xde\x03\x42\x6f\xbf\xf5\x23\xe4\x76\x83\xdc\x46\x13\xaf\xf1\x2e\x6e\x95\x91\x71\xbf\xd5\x56\x09\xd6\x1e\xc1\xea\x8b\x13\xe7\xba\xcb\xb0\x1a\xc0\x87\xaf\x9a\x6f\x72\x13\x51\xf6\x4e\xfb\x67\xfc\x5f\x2d\x72\xec\xe0\x1c\x7b\x9f\x5d\x6f\xcf\x78\xfb\x05\x4c\x46\xea\xbd\xd3\x96\x1a\xa9\x17\xa9\xf7\x27\x6d\xa8\x7b\x46\xe3\x01\x4c\x45\x14\xee\x84\xc2\x9f\x01\x00\x00\xff\xff\x31\xe4\x1e\xca\xb8\x1d\x00\x00")
+var _templates_testChangesetGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\x62\x6a\x34\x0b\x6a\xa1\xa5\x7b\xf6\xc2\x87\xcd\x07\x8a\xa0\x68\x1a\xc4\x36\x7a\x2c\x68\x69\x24\x11\xa6\x49\x97\xa4\x2c\xbb\x02\xff\x7b\x41\xca\x52\x1c\x24\xde\xe4\x92\x43\x02\x59\x7e\xf3\xf1\xde\xbc\x19\x77\xdd\x37\x10\x25\x70\x55\x00\xfb\x1d\x15\x1a\xee\xf0\xa6\xe6\xaa\x42\x8b\xce\x02\x55\xda\x01\x5b\xf2\xb5\x44\x76\x6f\x9f\x90\x17\x7f\x29\x79\x4c\xe1\x9b\xf7\x24\x84\xfe\xca\xa5\xe0\x16\x66\x73\x60\x3f\xc2\x13\xda\x1e\x3c\xc4\x3c\xf0\x2d\x7a\x4f\xca\x46\xe5\xe0\xd0\xba\xae\xeb\x23\xd8\x6a\xf7\x28\x1b\xc3\xa5\xf7\xab\x5d\xc1\x1d\xfe\x2d\x5c\x3d\xd6\xa5\x0e\xbe\x06\xb4\x50\x15\x5b\xa6\xd0\x91\xc4\xb1\x47\x6e\xb8\x94\x28\x69\x4a\x48\x22\x4a\x90\xa8\xe8\x98\xed\x56\xb7\x6a\x21\x54\xd5\x48\x6e\xbc\xff\x21\xe5\x8d\x96\xcd\x56\xd9\x14\xe6\xf3\x9f\x21\x1f\x8d\xd8\x72\x73\xfc\x03\x8f\x63\x40\x47\x92\xc4\xb1\xc5\x46\xec\xe8\x24\xfc\xdf\x09\x55\x81\x8b\x9c\x5a\xe1\x6a\xd0\x4a\x1e\x61\xd7\xc7\xc1\x06\x8f\x90\xf7\x91\x93\x94\x24\x9e\x90\xc4\x22\x16\x41\x0f\xc3\x55\xa1\xb7\xe2\x3f\x64\x0f\xd8\x2e\x10\x0b\x9a\x92\x64\xcf\x0d\xa0\x89\x7f\xda\x90\x44\x07\xe0\x97\xb1\xb7\xd5\xee\xb9\xb3\xce\x47\x96\x01\x7c\x96\x6b\xe1\x4c\x93\x3b\x1a\x6a\x64\xa0\x33\xb8\x40\xeb\xf6\x7a\x79\xdc\xa1\xcd\xc0\x99\x06\x2f\xa2\x4e\x94\x83\xf2\xb7\x58\xf2\x46\x3a\xc6\x58\xfa\x3d\x34\x07\xbf\xcc\x41\x09\x19\x94\x4f\x1c\xbb\x33\x46\x9b\x92\x4e\x56\x2a\xca\xe0\xf4\x73\x43\xf0\x66\xf3\x60\x63\x9f\x33\xb8\xb2\x93\x2c\xe4\x3b\x69\xd3\x75\xa2\x84\xe8\xa8\x07\x7d\xa3\x95\xc3\x83\xf3\x3e\x77\x87\x20\x43\xde\x7f\x66\xd7\x3c\xdf\x54\x46\x37\xaa\xa0\x69\xd7\xa1\x2a\xbc\x27\x49\x0f\xf9\xb3\xb1\x6e\x79\xa0\x31\xcb\x79\x86\xb5\x16\x92\x5d\x63\x25\x54\x0c\x91\x16\xcf\xdf\x2d\x0f\x34\x77\x87\x2c\xf0\x19\x12\xa6\x24\x29\xb0\x44\x03\xc1\x97\x34\x85\x0e\xfe\x81\x39\xb8\x03\x7b\xd2\x52\xae\x79\xbe\xa1\x29\x78\x9a\x9e\x4d\x40\xb3\x7b\x65\xd1\x38\x7a\x89\x42\x50\x19\x55\x11\x16\x03\x42\xb5\x58\xff\x5e\x95\x68\x68\x7a\x51\x53\x3a\x48\x73\x5e\xe8\x09\xa5\xe6\xc5\x07\x0b\xbd\x9f\x9a\x24\xd3\x29\x2c\x6b\x04\xa3\x5b\xe0\x16\x84\x83\x96\x5b\x30\xc8\x0b\x08\x54\xa1\xe6\x36\xcc\xa4\x8e\x3e\xd7\xd0\xc4\x75\x24\x49\xde\x2f\x63\x1c\x5f\x10\x5f\xb3\xb7\x16\xf5\x83\x5d\x8e\x04\xdf\xd1\x20\xac\xea\xa9\x70\x1a\x48\xfd\x76\x8e\x2c\xe9\xa4\xe5\xca\x81\xd2\x30\x36\x57\x69\x37\x83\xab\xfd\x24\x1b\x5e\xb1\x93\xab\x69\x7a\x62\xbf\xc6\x52\x1b\x0c\x0c\xbe\xea\x4f\x5f\xa9\x57\xf7\xe4\xb3\x37\x6a\x14\x62\x70\xcf\x67\x0f\x69\x3a\x85\xbb\x3d\x9a\xe1\xec\x81\x2e\xc1\xd5\x38\xa8\x8f\xbd\xb9\xb6\xba\x10\xa5\xc0\x02\xac\x50\x39\x46\x40\x70\xdf\x0b\xdf\xc5\x59\x8c\xc0\xd9\x1c\xfe\x6d\xd0\x88\x30\xc0\x3e\x15\xfd\xd2\x4f\x2e\xde\xb9\xd7\xc3\xfd\x1e\xcf\xfa\x10\x1f\xcd\xf2\xc2\x3c\x2f\x75\x8e\xbe\x89\x97\x7b\xac\x78\x3a\xdb\x19\x54\xda\xc1\xd5\x3e\x10\x69\x6b\x91\xd7\xe1\xb9\x45\x83\x23\x89\xb7\xbc\x95\x8d\xdf\x9e\xb5\x14\x26\xd2\xff\x2a\xa2\x2a\xbc\x27\xff\x0f\x00\xad\x56\xa3\x3e\x5b\x07\x00\x00")
+
+func templates_testChangesetGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testChangesetGoTpl,
+		"templates_test/changeset.go.tpl",
+	)
+}
+
+func templates_testChangesetGoTpl() (*asset, error) {
+	bytes, err := templates_testChangesetGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/changeset.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9a, 0x72, 0xe1, 0xe, 0x51, 0x75, 0x28, 0xef, 0xba, 0x1d, 0x1a, 0xcb, 0x4d, 0xbc, 0x88, 0x76, 0x62, 0xf3, 0xe2, 0x2d, 0x12, 0x94, 0xd0, 0x4c, 0x84, 0xab, 0xbd, 0x7f, 0x41, 0xb9, 0xc, 0xbc}}
+	return a, nil
+}
+
+var _templates_testDeleteGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x99\xc1\x6e\xe3\x36\x13\xc7\xcf\xd2\x53\xcc\x67\x7c\x2d\xa4\x42\x4b\xb4\xd7\x14\x39\x24\x76\x81\xee\xa1\xe9\x36\x76\xd0\x63\xc1\x48\x23\x47\x58\x86\x0c\xc8\xe1\xda\x59\x81\xef\x5e\x90\x52\x2c\x27\x70\xbc\x6a\x13\x6d\xb6\x00\x0f\x8b\x4d\x84\x99\xf9\xcf\x0c\x47\x3f\x8c\x98\xb6\x7d\x07\xff\xe7\xa2\xe1\x06\x4e\x4e\x81\x9d\xf9\x9f\xd0\xb0\x15\xbf\x16\x08\xdd\x7f\xec\x82\xdf\x22\xbc\x73\x2e\x0d\xc6\x25\x97\x4b\x55\xd3\x02\x05\x12\x06\xa7\xce\x6a\xfe\xe8\xf9\xce\xdc\xa8\x9a\xbc\x15\x97\x15\xb0\xb3\xaa\x1a\x6c\xcc\xd3\x58\xc1\xa5\xa9\x7b\x1f\x1f\xa1\xb6\xb2\x04\x42\x43\x6d\xdb\x25\xc9\xae\xee\x3e\x08\xab\xb9\x70\x6e\x70\xcc\x08\x7e\xf0\x46\x8d\x5c\xb3\x55\x0e\x6d\x9a\x10\xfb\xc0\x35\x17\x02\x45\x96\xa7\x69\x62\x10\x2b\x9f\x83\xe6\xb2\x52\xb7\xcd\x67\x64\x17\xb8\x59\x22\x56\x59\x9e\x26\x9f\xb8\x06\xd4\xe1\x9f\xd2\x69\xa2\xbc\xe1\xf7\x7b\x7a\xcb\x46\xae\xad\xe0\xda\xb9\xd6\xa5\x49\x53\x7b\x43\xd8\x8b\xb5\x24\x6d\x4b\xca\xbc\x46\x01\xaa\x80\x9d\xeb\x42\x6d\xe4\xe0\xbc\x38\x5f\xdd\xdf\xa1\x29\x80\xb4\xc5\x67\xad\xe6\x4a\xd8\x5b\x69\xfe\x6c\xe8\x66\x81\x35\xb7\x82\x18\x63\xf9\xcf\x41\xf3\x7f\xa7\x20\x1b\xe1\xcb\x4b\x88\xfd\xa2\xb5\xd2\x75\x36\xbb\x92\xbe\xf9\x40\x6a\x48\x08\x0e\x26\x0f\x26\xe4\x79\x02\xdf\x99\x59\xe1\xe3\xe5\x69\xe2\xd2\x34\x69\xdb\xa6\x06\xa9\x08\xd8\x85\x9a\x2b\x49\xb8\x25\xe7\x4a\xda\xfa\x36\x94\xdd\xef\xec\x9c\x97\x1f\xd7\x5a\x59\x59\x65\x79\xdb\xa2\xac\x9c\x4b\x93\xce\xe4\x37\x6b\x68\xb5\xcd\x42\x94\xfd\x08\xd7\xaa\x11\xec\x1c\xd7\x8d\x0c\x2e\xc2\xe0\xfe\xb3\xd5\x36\x2b\x69\x5b\xf8\x7a\x1e\x02\xe6\x69\x52\x61\x8d\x1a\xfc\x99\x67\x39\xb4\xf0\x17\x9c\x02\x6d\xd9\xa5\x12\xe2\x9a\x97\x1f\xb3\x1c\x5c\x96\xef\x9d\x80\x62\xef\xa5\x41\x4d\xd9\x73\x25\xf8\x2e\xa3\xac\xfc\xe8\x82\x57\x0b\xfa\xef\x65\x8d\x3a\xcb\x9f\xed\x69\xf6\xa4\x35\xec\x42\x5d\xaa\x8d\x39\xab\x6b\x2c\x09\x43\xb0\x47\x39\xf4\x23\x38\x36\x87\x9a\x0b\x83\xe3\xc4\x51\x18\xdc\xc9\xe9\x2e\x87\x70\x72\x70\x32\x99\x30\x04\xd1\x41\xcf\x9b\xfe\xf4\xc8\x70\x66\x6e\x94\x15\x15\x28\x29\xee\xe1\x86\x7f\x42\xa8\x42\x07\xfc\x13\xf4\x6e\x05\x5c\x5b\x02\xde\xf7\xeb\x64\x56\x3c\xc4\x1a\x0a\xeb\xd2\x4a\xd3\xa4\x54\x56\xd2\xae\xa6\x03\x2f\x79\x96\xb3\xb9\xb7\x19\x59\xe6\x30\x1e\x47\x7b\xdb\xd4\x10\x94\x7d\x75\x3f\x3e\xae\x6e\xc3\x25\xc1\x67\xd4\x0a\x34\x96\x4a\x57\xa6\x80\xb5\x22\x5f\x45\xf0\x08\x01\x5c\x7a\x14\x4c\x7f\x58\xd4\xf7\x03\x9d\xce\x84\x88\x80\x8a\x80\x7a\x23\x40\x1d\x98\xcf\x2c\xef\xd9\xe1\x27\xf3\x75\xf1\xf1\x65\x6e\x7d\xdd\x7c\x22\xce\x5e\x8e\xb3\xa5\x68\x4a\x8c\x38\x8b\x38\x9b\x1c\x67\xc6\x4f\xda\x93\x37\x67\x68\x68\x98\xc3\xb6\x9d\xb5\x33\xe7\x54\xdb\xce\xdc\xcc\x8d\x64\x60\x88\xfb\x86\xcc\x9b\x56\x3f\x32\x6e\x1c\xe3\x76\xa2\xc7\x71\xd7\xaf\xd5\x11\x71\x11\x71\x13\x20\xee\xf5\x3f\x29\xc1\xdf\xb2\x3c\x5c\x9a\x38\xd7\xad\xef\x0f\x0d\x78\x39\xbb\xbe\x66\x36\x71\x5b\x7b\xf9\xb6\x16\x3e\x3e\xe3\xa6\x16\x37\xb5\x49\x37\xb5\x11\x18\x3b\x30\x9b\xff\xe2\x43\x6f\x62\xba\x7d\x03\x49\x46\xe8\x8d\x83\x5e\x38\x0a\xb6\x68\xb8\xc0\x92\xd8\x95\xc1\xdf\x2d\xdd\x59\x9a\x0b\x6e\xfb\x03\x1e\x8f\xc5\x4b\x24\xab\x65\x23\xd7\x91\x8f\x91\x8f\x53\xf0\xb1\x7f\x41\x8b\x7f\x0a\x9c\x61\x30\x5f\x81\x3c\xbb\x82\x8f\xa4\x9a\x4c\x08\x9e\xa4\x4f\x2e\x8c\x48\xf7\x37\xc2\x5f\xb9\x59\xe9\x66\xbd\x46\x6d\x7a\x22\x0b\x94\x59\x1f\x35\x3f\x90\x41\xb8\x8e\x57\x72\x50\xd6\x6a\x03\x3a\x74\x09\xab\x1d\x28\xf6\x83\x0c\xd2\xdd\xe8\x1c\x54\x39\x84\x20\xa9\xf6\x45\xcc\x4e\x05\x94\x04\x0e\xe4\xf3\x87\x4d\x43\x37\x40\x7d\x05\x5f\x92\x97\xd5\x7f\x9d\xb8\xbd\xe8\xd1\x85\x33\x5c\xcb\xc4\x85\x33\x2e\x9c\x93\x2e\x9c\xdf\xd6\xd5\xe0\xc4\x5b\xe9\x1b\x24\x15\xb7\xd0\x51\x5b\xe8\xdf\x03\x00\xac\xb4\x0e\x0b\x2e\x23\x00\x00")
 
 func templates_testDeleteGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -649,11 +1076,31 @@ func templates_testDeleteGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/delete.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x56, 0x4f, 0xd2, 0xa8, 0x8b, 0xf, 0x31, 0x26, 0x99, 0xa3, 0xb3, 0x4c, 0x4b, 0xec, 0xe1, 0x3d, 0x7e, 0x3d, 0x9, 0x88, 0xe6, 0x68, 0x3a, 0xbe, 0x58, 0x2a, 0x40, 0xe9, 0xf8, 0xd2, 0x28, 0x4c}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3c, 0x4d, 0xce, 0x4b, 0xa9, 0xc8, 0x46, 0x78, 0x80, 0x25, 0xe5, 0x74, 0xfb, 0x48, 0xad, 0x5e, 0xc3, 0x8d, 0xba, 0x94, 0x66, 0x4a, 0x2b, 0xbe, 0xfd, 0xc2, 0xb4, 0x1e, 0xef, 0xc9, 0xda, 0x22}}
+	return a, nil
+}
+
+var _templates_testDtoGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\xc1\x6e\xd4\x30\x10\x3d\xc7\x5f\x31\x0d\x2d\x4a\xd0\xd6\xbd\x17\xf5\x00\xa4\x70\xeb\xae\xb4\xe9\x07\xb8\x9b\x49\xb0\x98\xb5\x83\xed\xb0\x2a\x91\xff\x1d\x8d\xbd\x4d\x29\x2a\x2b\x2e\x9c\xec\x78\xde\x9b\x79\x33\x6f\x32\xcf\x97\xa0\x7b\x90\x5f\xd0\xa0\x53\x01\x9b\x76\xed\xe1\x32\x46\xc1\x81\x73\x45\x5a\x79\xb8\xbe\x01\xf9\x81\x6f\xe8\x65\xab\x1e\x08\x21\x1f\xf2\x4e\xed\xf1\x09\x3a\x5a\x6d\x02\xba\x84\x36\x08\xb2\x69\xd7\x77\x13\xd1\x36\x3c\x12\x42\x69\x26\xa2\x32\x46\xd1\x4f\x66\x07\x01\x7d\x98\xe7\x9c\x5c\xde\x8f\x1b\x9a\x9c\xa2\x18\x9b\x76\x5d\x05\x78\xc7\x51\x6d\x06\xd9\xd6\x30\x8b\x22\xc8\x8d\x72\x8a\x08\xa9\xaa\x85\x28\x3c\x62\xc7\x15\x9c\x32\x9d\xdd\xeb\x9f\x28\xef\xf0\xb0\x45\xec\xaa\x5a\x14\x96\x23\x6f\x7f\xcb\xbc\xd5\x66\x98\x48\xb9\x18\xe7\x28\x0a\xdd\x03\x3a\xf7\x82\xbd\x0d\x6e\xda\x85\x8a\xb3\xae\xc0\xae\x60\xe1\x36\xf6\x60\x9e\xd9\xcd\xc7\xf6\x71\x44\xbf\x82\x5e\x91\xc7\xfa\x7d\xca\x73\x76\x03\x46\x13\x6b\x2c\x82\xbc\x75\xce\xba\xbe\x2a\xef\x0d\x0f\x06\x82\x7d\xae\x01\xaf\x0a\x02\x9f\x4a\x5f\xc3\x85\x2f\x57\x9c\xaf\x16\x45\x14\xa2\x18\x6c\x38\xdd\xc5\x60\x83\xfc\xec\xec\x9e\xa7\x65\x65\x6b\xf9\xac\xeb\xd4\xdd\x99\xc3\x9e\x70\x17\x64\x83\x38\xde\x7e\x9f\x14\x55\x76\x05\x83\x0d\xf5\x1f\x2a\x0f\xca\x04\xb8\x78\xf3\x03\x1e\xd4\xee\x1b\xf4\xce\xee\x21\x7c\x45\x68\xda\x75\x82\x73\xa8\x4c\xf3\x60\x6e\xd6\x75\x75\x05\x6c\x27\xec\x2c\x4d\x7b\xe3\xc1\xd9\xc9\x74\x10\x9c\x1e\x41\x79\x38\x20\x11\xcf\xff\x94\xf0\xe4\xdb\xa2\x58\x14\xc7\xcd\x5b\x16\x27\xc6\xfc\xe6\x94\x19\x10\xce\x73\x21\xe6\x1c\x97\xed\x53\x7a\x58\x60\xba\x07\x5e\xaa\x4d\x66\xb3\x3f\x4f\x1c\xc9\x1f\x0c\xd3\x3d\x74\x72\xd1\x93\xf9\x0b\x28\xef\xee\x2b\x2e\x1e\xc7\xa3\xf2\xfb\x69\x76\x6f\x1d\xa8\xa4\x83\x91\x2f\x62\x65\x32\x34\x75\x84\xa6\x8b\x7f\xbb\x26\x43\xe1\x5f\x0d\xef\xfe\xb7\xd1\xf9\x67\x46\xd3\xc5\x28\x7e\x0d\x00\xe3\xaa\x92\x0a\x1b\x04\x00\x00")
+
+func templates_testDtoGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testDtoGoTpl,
+		"templates_test/dto.go.tpl",
+	)
+}
+
+func templates_testDtoGoTpl() (*asset, error) {
+	bytes, err := templates_testDtoGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/dto.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x96, 0xec, 0xaa, 0x68, 0x1b, 0x2f, 0xce, 0x44, 0x99, 0x9c, 0x2d, 0x29, 0xe8, 0x54, 0x53, 0x3b, 0xb1, 0x30, 0x26, 0xca, 0xda, 0x44, 0xff, 0x22, 0x11, 0xb5, 0x68, 0xda, 0x74, 0x24, 0x44, 0xb9}}
 	return a, nil
 }
 
-var _templates_testExistsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xcd\x6e\xdb\x3c\x10\x3c\x8b\x4f\xb1\x11\x92\x0f\xd4\x07\x85\x0f\x90\x22\x87\xfc\x1d\x82\x22\x86\x51\x3b\xe8\xb1\xa0\xa5\x95\xc2\x9a\x26\x05\x72\xd5\xc8\x55\xf8\xee\x05\x29\x37\x11\x82\xb8\xed\x41\x90\x28\xcc\xce\xee\xce\x0c\xc7\xf1\x1c\x4e\xa5\x56\xd2\xc3\xc5\x25\x88\xab\xf8\x85\x5e\xac\xe5\x46\x23\x4c\x2f\xb1\x90\x3b\x0c\x81\x35\xbd\xa9\x80\xd0\xd3\x38\x4e\x15\xe2\xb1\x5b\xea\xde\x49\x1d\xc2\xdd\xa0\x3c\x79\x4e\xf0\x7f\x04\x28\xd3\x8a\x75\x01\x23\xcb\x48\x2c\xa5\x93\x5a\xa3\xe6\x05\x63\x99\x47\xac\x63\x1f\x27\x4d\x6d\x77\xea\x27\x8a\x05\x3e\xaf\x10\x6b\x5e\xb0\xec\x87\x74\x80\x2e\x3d\xd6\xb1\xcc\x46\xe0\x7f\xb3\x5e\x2b\x65\xda\x5e\x4b\x17\xc2\x18\x58\xa6\x9a\x04\x9e\x73\xad\xc8\xf5\x15\xf1\xd8\xa4\x04\x5b\xc2\x6b\xed\xad\x7d\x36\x6f\xd5\xb7\xd7\xeb\x7d\x87\xbe\x04\x72\x3d\x1e\x45\xdd\x58\xdd\xef\x8c\xff\xaa\xe8\xe9\x16\x1b\xd9\x6b\x12\x42\x14\x9f\x52\xd3\x93\x4b\x30\x4a\xc7\xfd\x32\x12\x77\x71\xdc\x86\xe7\x8f\x26\x69\x46\xf6\x6d\x22\xf8\x70\x7a\xf0\x69\xce\x0b\x38\xf3\x79\x19\xf9\x0a\x96\x05\xc6\xb2\x71\x54\x0d\x18\x4b\x20\x16\xf6\xc6\x1a\xc2\x81\x42\xa8\x68\x88\x3a\x54\xd3\x59\x5c\xcb\x6a\xdb\x3a\xdb\x9b\x9a\x17\xe3\x88\xa6\x0e\x81\x65\x13\xe4\xa1\xf7\xb4\x1e\x78\x62\x99\x33\x6c\xac\xd2\xe2\x1a\x5b\x65\x52\x89\xf6\x38\xff\xb7\x1e\x78\x45\x43\x19\xf7\xf9\x4d\x58\xb0\xac\xc6\x06\x1d\x44\xc3\x79\x01\x23\x7c\x83\x4b\xa0\x41\x7c\xb1\x5a\x6f\x64\xb5\xe5\x05\x84\x68\xd8\xab\x05\x56\xdc\x1b\x8f\x8e\xf8\xb1\x15\xa2\xca\x68\x6a\x38\x0f\x01\xe2\x29\xf5\xbf\x37\x0d\x3a\x5e\x1c\xd5\x94\xcf\xa5\x39\xed\xb6\xb8\xbf\x72\xed\x14\xd3\x29\x97\xcb\xcf\xb8\x17\x07\x9f\xe0\x25\xca\xaa\x4c\xfb\x20\x3b\xe0\x49\xf4\x1b\xab\xfd\x21\xdb\x05\xbc\x40\xe7\xb0\x51\xc3\x2a\x81\x56\x5a\x55\x08\xbc\x73\xca\x50\x03\xf9\x99\x17\x39\xe4\x36\x8f\xb0\xef\x56\x19\xc8\x4b\xc8\xe3\xb0\x2c\xc3\xe4\x50\x6c\xfa\xa1\x97\x87\xdc\xff\xeb\xde\xb3\x3d\x92\xce\x07\x05\xff\x92\xa7\xea\x09\xab\x2d\xa8\xe6\x48\x9c\x30\xcd\xf0\x2e\x4e\x91\xfa\x04\xdf\x51\xde\x0d\x1d\x56\x84\xf5\x9f\x76\x49\x01\x46\xea\x9d\x39\xdc\x8f\x4d\x4f\xd0\x5a\x82\x46\x6a\x8f\x22\x4f\xec\x81\xfd\x0a\x00\x00\xff\xff\x77\xbd\x12\x76\x38\x04\x00\x00")
+var _templates_testExistsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x5d\x4f\xdc\x30\x10\x7c\xb6\x7f\xc5\x12\x41\x65\x57\xc1\x3f\x80\x8a\x07\xbe\x1e\x50\x05\x42\xbd\x43\x7d\xac\x7c\xc9\x26\xb8\x67\xec\xc8\xde\x94\xd0\xe0\xff\x5e\x39\xb9\x96\x08\x71\x6d\x1f\x4e\x77\x17\xcd\xce\xec\xcc\x4e\xc6\xf1\x18\x0e\xb5\x35\x3a\xc2\xc9\x29\xa8\xb3\xfc\x0b\xa3\x5a\xeb\x8d\x45\x98\xbf\xd4\xad\x7e\xc4\x94\x78\xd3\xbb\x0a\x08\x23\x8d\xe3\x3c\xa1\xee\xbb\x3b\xdb\x07\x6d\x53\xba\x1a\x4c\xa4\x28\x08\x3e\x66\x80\x71\xad\x5a\x4b\x18\x39\x23\x75\xa7\x83\xb6\x16\xad\x90\x9c\xb3\x88\x58\x67\x9d\xa0\x5d\xed\x1f\xcd\x4f\x54\xb7\xf8\xb4\x42\xac\x85\xe4\xec\x87\x0e\x80\x61\xfa\xf8\xc0\x99\xcf\xc0\x0f\x0b\xad\x95\x71\x6d\x6f\x75\x48\x69\x4c\x9c\x99\x26\x03\x61\xc1\xb5\xa2\xd0\x57\x24\xb2\x46\x09\xbe\x84\x3f\xa3\x97\xfe\xc9\xbd\x0e\x5f\x9e\xaf\x9f\x3b\x8c\x25\x50\xe8\x71\x2f\xea\xc2\xdb\xfe\xd1\xc5\xaf\x86\x1e\x2e\xb1\xd1\xbd\x25\xa5\x94\xfc\x34\x69\x1e\x9c\x82\x33\x36\xdb\x63\xa4\xae\x42\xf0\xa1\x11\xc5\xbd\xcb\x59\x01\xf9\xd7\x85\xe0\xdd\xe5\x21\x4e\x7b\x9e\xc0\x51\x2c\xca\xcc\x27\x39\x4b\x9c\xb3\x71\x34\x0d\x38\x4f\xa0\x6e\xfd\x85\x77\x84\x03\xa5\x54\xd1\x90\x63\xa8\xe6\xff\xea\x5c\x57\xdb\x36\xf8\xde\xd5\x42\x8e\x23\xba\x3a\x25\xce\x66\xc8\x4d\x1f\x69\x3d\x88\x89\x65\xc9\xb0\xf1\xc6\xaa\x73\x6c\x8d\x9b\x46\x6c\xc4\xe5\xb3\xf5\x20\x2a\x1a\xca\xec\xe7\x37\xa1\xe4\xac\xc6\x06\x03\xe4\x7b\x0b\x09\x23\x7c\x83\x53\xa0\x41\x7d\xf1\xd6\x6e\x74\xb5\x15\x12\x92\x90\x8b\x0b\x78\x75\xed\x22\x06\x12\xfb\x2c\xe4\x94\xd1\xd5\x70\x9c\x12\x64\xb5\x49\xff\xda\x35\x18\x84\xdc\x9b\xa9\x58\x46\x73\xd8\x6d\xf1\xf9\x2c\xb4\x73\x4b\xe7\x5a\xde\x7d\xc6\x67\xb5\xbb\x13\xbc\xe4\x58\x8d\x6b\x6f\x74\x07\x62\x0a\xfd\xc2\xdb\xb8\xab\xb6\x84\x17\xe8\x02\x36\x66\x58\x4d\xa0\x95\x35\x15\x82\xe8\x82\x71\xd4\x40\x71\x14\x55\x01\x85\x2f\x32\xec\xbb\x37\x0e\x8a\x12\x8a\xbc\x2c\x67\x38\x5d\x28\x8b\xbe\x7b\xcb\x5d\xed\xff\xd7\xf7\xc2\x47\x4a\xaf\x09\xfe\xa3\x4f\xd5\x03\x56\x5b\x30\xcd\x9e\x3a\xe1\xb4\xc3\x9b\x3a\x65\xea\x03\x7c\x43\x79\x35\x74\x58\x11\xd6\x7f\xf3\x32\x15\x18\xa9\x0f\x6e\xf7\x7e\x6c\x7a\x82\xd6\x13\x34\xda\x46\x54\x85\xe4\x2c\xf1\xc4\x7f\x0d\x00\x25\xd0\x7c\xe6\x37\x04\x00\x00")
 
 func templates_testExistsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -669,11 +1116,11 @@ func templates_testExistsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/exists.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb4, 0xdb, 0xf5, 0x58, 0xac, 0x52, 0x4d, 0x5e, 0xc5, 0x5a, 0x10, 0xe6, 0xb8, 0x26, 0x44, 0xd5, 0x1f, 0xf4, 0x27, 0xf7, 0x22, 0xe, 0x8b, 0x9b, 0x5e, 0xd6, 0x1a, 0x99, 0x3b, 0x22, 0x13, 0x9d}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x45, 0x7b, 0x43, 0xc6, 0xa2, 0x1c, 0xdc, 0x27, 0x10, 0x71, 0x5, 0x1d, 0xa6, 0x94, 0xd4, 0xc8, 0x6d, 0x7b, 0xbc, 0x83, 0x17, 0x9b, 0xbc, 0x52, 0xb4, 0xf5, 0xcf, 0xe1, 0x82, 0x7d, 0xd, 0xfa}}
 	return a, nil
 }
 
-var _templates_testFindGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x51\x6f\xd3\x30\x10\xc7\x9f\xe3\x4f\x71\x44\x0c\x39\x28\xf3\x07\x18\xea\x03\x6d\x99\x34\xa1\x55\x15\xed\xc4\x23\x72\x93\x4b\x30\x73\xed\xca\xbe\xd0\x0c\xcf\xdf\x1d\xd9\x2d\x5b\x25\x5a\xc4\x43\x94\x38\xf9\xdf\xff\xee\x7e\x77\x09\xe1\x1a\xde\x4a\xad\xa4\x87\x9b\x09\x88\x8f\xe9\x09\xbd\x58\xcb\x8d\x46\x38\xdc\xc4\x42\x6e\x31\x46\xd6\x0d\xa6\x01\x42\x4f\x21\x1c\x22\xc4\xc3\x6e\xa9\x07\x27\x75\x8c\xb7\xca\xb4\x9c\xe0\x7d\xfa\xac\x4c\x2f\xd6\x15\x04\x56\x90\x58\x4a\x27\xb5\x46\xcd\x2b\xc6\x0a\x8f\xd8\xa6\x2c\x4e\x9a\xd6\x6e\xd5\x2f\x14\x0b\xdc\xaf\x10\x5b\x5e\xb1\xe2\xa7\x74\x80\x2e\x5f\xd6\xb1\xc2\x26\xe1\xbb\x93\x4c\x2b\x65\xfa\x41\x4b\x17\x63\x88\xac\x50\x5d\x16\x9f\x7a\xad\xc8\x0d\x0d\xf1\x94\xa4\x06\x5b\xc3\x4b\xec\xdc\xee\xcd\x6b\xf4\x7c\xba\x7e\xda\xa1\xaf\x81\xdc\x80\x17\x55\x33\xab\x87\xad\xf1\x5f\x15\x7d\x9f\x63\x27\x07\x4d\x42\x88\xea\x43\x4e\xfa\x66\x02\x46\xe9\xd4\x5f\x41\xe2\x53\x2a\xb7\xe3\xe5\x83\xc9\xc4\xc8\xbe\x56\x04\x67\xab\x07\x9f\xeb\xbc\x81\x2b\x5f\xd6\xc9\xaf\x62\x45\x64\xac\x08\x41\x75\x60\x2c\x81\x58\xd8\x99\x35\x84\x23\xc5\xd8\xd0\x98\x38\x34\x87\xb3\x98\xca\xe6\xb1\x77\x76\x30\x2d\xaf\x42\x40\xd3\xc6\xc8\x8a\x83\xe4\x7e\xf0\xb4\x1e\x79\x76\x39\x75\xd8\x58\xa5\xc5\x14\x7b\x65\x72\x88\xf6\x78\xfa\x6e\x3d\xf2\x86\xc6\x3a\xf5\xf3\xc7\xb0\x62\x45\x8b\x1d\x3a\x48\xe3\xe6\x15\x04\xf8\x06\x13\xa0\x51\x7c\xb1\x5a\x6f\x64\xf3\xc8\x2b\x88\x69\x60\x2f\x23\xb0\xe2\xce\x78\x74\xc4\x2f\xb5\x90\x28\xa3\x69\xe1\x3a\x46\x48\xa7\x9c\xff\xce\x74\xe8\x78\x75\x91\x29\x3f\x45\x73\x76\x46\xb7\x09\x44\x46\x98\x00\xa4\x0d\x3c\x0b\xfc\xbf\xcb\x0a\xe1\xb8\xef\xcb\xcf\xf8\x24\x8e\x1b\x00\xcf\x69\x60\xca\xf4\xf7\x72\x07\x3c\xbb\xcf\xac\xf6\xc7\x7f\xa6\x82\x67\xd8\x39\xec\xd4\xb8\xca\xa2\x95\x56\x0d\x02\xdf\x39\x65\xa8\x83\xf2\xca\x8b\x12\x4a\x5b\x26\xd9\x0f\xab\x0c\x94\x35\x94\x19\xf1\x11\xde\x3f\xdb\x56\xdd\xa5\xed\xcc\x9d\xc3\xe4\xef\xe0\x72\x2f\x0d\x81\x04\x87\x8d\x75\x6d\x0d\xbd\xa5\xa4\x29\xb3\x63\x64\xbf\x03\x00\x00\xff\xff\x53\x90\x79\x25\xed\x03\x00\x00")
+var _templates_testFindGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xdf\x6e\xdb\x3a\x0c\xc6\xaf\xad\xa7\xe0\x31\x4e\x07\x69\x70\xf5\x00\x1d\x72\xb1\x36\x2b\x50\x0c\x0d\x82\x25\xc5\x2e\x07\xc5\xa6\x3d\xad\x8a\x14\x48\xf4\xe2\x4e\xd5\xbb\x0f\x72\xb2\xc5\xc0\x92\x61\x17\x86\xff\x7d\xe4\x47\xfe\x48\xc5\x78\x0d\xff\x2b\xa3\x55\x80\x9b\x19\xc8\xf7\xf9\x09\x83\x5c\xab\x8d\x41\x38\xdc\xe4\x42\x6d\x31\x25\xd6\xf6\xb6\x06\xc2\x40\x31\x1e\x22\xe4\xd3\x6e\x69\x7a\xaf\x4c\x4a\xf7\xda\x36\x9c\xe0\x6d\xfe\xad\x6d\x27\xd7\x02\x22\x2b\x48\x2e\x95\x57\xc6\xa0\xe1\x82\xb1\x22\x20\x36\xd9\xc5\x2b\xdb\xb8\xad\xfe\x81\x72\x81\xfb\x15\x62\xc3\x05\x2b\xbe\x2b\x0f\xe8\xc7\xcb\x79\x56\xb8\x2c\x7c\x33\x71\x5a\x69\xdb\xf5\x46\xf9\x94\x62\x62\x85\x6e\xb3\x10\x26\xb9\x56\xe4\xfb\x9a\x78\xf6\xa8\xc0\x55\xf0\x3b\x74\xee\xf6\xf6\x14\x3c\xbf\x5d\xbf\xec\x30\x54\x40\xbe\xc7\x8b\xaa\x3b\x67\xfa\xad\x0d\x9f\x35\x7d\x9d\x63\xab\x7a\x43\x52\x4a\xf1\x6e\xf4\xfc\x6f\x06\x56\x9b\xdc\x5e\x41\xf2\x83\xf7\xce\xb7\xbc\x7c\xb2\x99\x14\x90\x3b\x15\x04\x67\x8b\x87\x30\xd6\x79\x03\x57\xa1\xac\x72\x3e\xc1\x8a\xc4\x58\x11\xa3\x6e\xc1\x3a\x02\xb9\x70\x77\xce\x12\x0e\x94\x52\x4d\x43\xc6\x50\x1f\xde\xe5\xad\xaa\x9f\x3b\xef\x7a\xdb\x70\x11\x23\xda\x26\x25\x56\x1c\x24\x8f\x7d\xa0\xf5\xc0\xc7\x2c\xd3\x0c\x1b\xa7\x8d\xbc\xc5\x4e\xdb\x31\xc4\x04\x9c\x7e\x5b\x0f\xbc\xa6\xa1\xca\xfd\xfc\x4a\x28\x58\xd1\x60\x8b\x1e\xf2\xb4\xb9\x80\x08\x5f\x60\x06\x34\xc8\x4f\xce\x98\x8d\xaa\x9f\xb9\x80\xc4\xc5\x64\x02\x4e\x3e\xd8\x80\x9e\xf8\xa5\x16\x32\x65\xb4\x0d\x5c\xa7\x04\xd9\x6d\xf4\x7f\xb0\x2d\x7a\x2e\x2e\x32\xe5\x53\x34\x67\x67\x74\x9f\x41\x8c\x08\x33\x80\xbc\x80\x67\x81\xff\x73\x59\x31\x1e\xd7\x7d\xf9\x11\x5f\xe4\x71\x03\xe0\x35\x0f\x4c\xdb\xee\x51\xed\x80\x8f\x65\xdc\x39\x13\x8e\x47\x46\xc0\x2b\xec\x3c\xb6\x7a\x58\x8d\xa2\x95\xd1\x35\x02\xdf\x79\x6d\xa9\x85\xf2\x2a\xc8\x12\x4a\x57\x66\xd9\x37\xa7\x2d\x94\x15\x94\x29\x9d\xe0\xfd\xb5\x6d\xdd\x5e\xda\xce\xb1\x73\x98\xfd\x19\x5c\xee\x95\x25\x50\xe0\xb1\x76\xbe\xa9\xa0\x73\x94\x35\xa5\x60\x45\x62\x89\xfd\x1c\x00\xd0\x6a\xd2\xe4\xec\x03\x00\x00")
 
 func templates_testFindGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -689,11 +1136,31 @@ func templates_testFindGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/find.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x38, 0x8c, 0x42, 0xd5, 0x43, 0xac, 0xdf, 0x79, 0x3a, 0x79, 0x69, 0x38, 0xf, 0x58, 0x11, 0x72, 0x69, 0xdb, 0x9, 0x52, 0x1f, 0xd7, 0xcb, 0x4d, 0x3f, 0xa7, 0x86, 0x83, 0x72, 0x68, 0x77, 0x33}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaa, 0x54, 0x84, 0x80, 0xcc, 0x80, 0xb5, 0xb0, 0xcf, 0x34, 0x77, 0x0, 0xec, 0xee, 0x44, 0x92, 0x7f, 0x7e, 0x6, 0x54, 0xa8, 0x30, 0xff, 0x32, 0x3b, 0xb3, 0xf2, 0x60, 0xa9, 0x2, 0x55, 0x90}}
 	return a, nil
 }
 
-var _templates_testFinishersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x51\x4f\xdb\x30\x10\xc7\x9f\xe3\x4f\x71\xab\xb6\xc9\x99\x82\x1f\x78\x64\xea\x03\x85\x3d\xf0\x30\x40\x23\x68\x8f\x93\x49\x2e\x9d\x85\xb1\x91\x7d\x59\xb3\x45\xfe\xee\x93\x5d\x28\xdd\x20\xa4\xda\x40\x62\x5a\x1f\xaa\x36\xcd\x9d\xff\x77\xe7\xff\xfd\xfa\x7e\x07\x5e\x4b\xad\xa4\x87\xbd\x29\x88\xfd\xf8\x0b\xbd\x28\xe5\x85\x46\x58\x7e\x89\x63\x79\x85\x21\xb0\xa6\x35\x15\x10\x7a\xea\xfb\x65\x86\x38\xbf\x3e\xd5\xad\x93\x3a\x84\x99\x32\x35\x27\x78\x17\x5f\x2b\x33\x17\x65\x0e\x3d\xcb\x48\x9c\x4a\x27\xb5\x46\xcd\x73\xc6\x32\x8f\x58\x47\x15\x27\x4d\x6d\xaf\xd4\x0f\x14\xc7\xb8\x38\x43\xac\x79\xce\xb2\x6f\xd2\x01\xba\xf4\xb1\x8e\x65\x36\x06\xbe\x5d\x53\x3a\x53\x66\xde\x6a\xe9\x42\xe8\x03\xcb\x54\x93\x82\xd7\xcf\x3a\x23\xd7\x56\xc4\xa3\x48\x01\xb6\x80\x55\xee\xa1\x5d\x98\xbb\xec\xc3\x59\xf9\xfd\x1a\x7d\x01\xe4\x5a\x1c\x8c\x3a\xb0\xba\xbd\x32\xfe\xb3\xa2\xaf\x87\xd8\xc8\x56\x93\x10\x22\x7f\x9f\x44\x5f\x4d\xc1\x28\x1d\xfb\xcb\x48\x7c\x88\xe5\x36\x7c\x72\x6e\xd2\xc4\xc8\xde\x55\x04\x0f\x56\x0f\x3e\xd5\xb9\x07\x6f\xfc\xa4\x88\xe7\xe5\x2c\x0b\x8c\x65\x7d\xaf\x1a\x30\x96\x40\x1c\xdb\x03\x6b\x08\x3b\x0a\xa1\xa2\x2e\xce\xa1\x5a\x3e\x8b\x99\xac\x2e\xe7\xce\xb6\xa6\xe6\x79\xdf\xa3\xa9\x43\x60\xd9\x32\xe4\x63\xeb\xa9\xec\x78\x3a\x65\xfd\x84\x0b\xab\xb4\x98\xe1\x5c\x99\x94\xa2\x3d\xae\xff\x57\x76\xbc\xa2\xae\x88\xfd\xdc\x1e\x98\xb3\xac\xc6\x06\x1d\xc4\xeb\xe6\x39\xf4\xf0\x05\xa6\x40\x9d\xf8\x64\xb5\xbe\x90\xd5\x25\xcf\x21\xc4\x0b\x5b\x5d\x81\x15\x47\xc6\xa3\x23\x3e\xd4\x42\x9c\x32\x9a\x1a\x76\x42\x80\xf8\x94\xf4\x8f\x4c\x83\x8e\xe7\x83\x33\xe5\x77\xa3\x59\x29\x3d\x60\x3c\x9e\x8b\xe4\xbd\x7b\x8d\x1b\xa5\x6f\xfb\xad\xa8\xbb\x69\xae\x48\xfa\x76\x5c\x34\xb0\x47\xed\x7e\x62\x70\xeb\xf6\xad\xdb\x9f\xcb\xed\x5d\x1a\x55\x6c\xf4\x61\xc7\x47\xfb\x6d\x26\x3f\x2a\x08\x71\x48\x10\x35\x61\x7a\x3f\x68\x82\xdd\x35\x56\x84\x75\xbc\xea\x39\x12\x48\x30\xd6\xa4\x30\x87\x95\x75\xf5\x64\x93\x6d\xd9\xd7\xfa\x49\xb7\x65\xc0\xc5\x27\x06\x1f\x5f\xa3\x81\xbc\x72\xf1\x97\xeb\x37\x5c\xcf\xf8\x5e\x36\x52\xfb\x97\xb3\x98\x7f\xda\x6a\xb9\xd8\x00\x41\x2f\xab\xd5\x7f\x99\x41\xc3\x86\x7b\x5e\x38\x8d\x56\x50\x2e\x9e\x1d\x8f\x5e\xab\x0a\x47\xf8\x18\x81\xb3\x21\x1f\x57\x3d\x8d\x31\x59\xa3\xe1\x49\x3b\x8f\xa1\xbb\xbf\x62\x72\x21\x0d\xc1\xee\x0d\x13\x7d\x01\x73\x4b\x7b\x93\x62\x2d\x67\x13\x4c\x1e\xd8\xd6\xd0\x28\x28\x7f\x63\xe1\xa3\xdc\xdc\x82\x72\x0b\xca\x2d\x28\xff\x53\x50\x56\x11\x27\x23\xa0\x5c\x22\xe7\xa9\x51\x99\x94\x37\xa7\x64\x0a\xbf\x01\xe4\xcf\x00\x00\x00\xff\xff\xde\xf3\x38\x87\x8f\x10\x00\x00")
+var _templates_testFind_or_createGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4d\x6b\xe3\x3a\x14\x5d\x5b\xbf\xe2\x36\xf4\x3d\xe4\x87\xab\xf2\xb6\x1d\xb2\x68\xd3\x29\x84\x32\x69\x68\xd2\xf5\xa0\xd8\xd7\x1e\x51\x45\x2a\x92\xdc\x38\xe3\xea\xbf\x0f\x92\xe3\x8f\x81\x14\x66\x39\x8b\x60\x45\x9c\x7b\xee\xb9\xe7\x48\x6a\xdb\x2b\x10\x25\x28\xed\x80\x6d\xf9\x4e\x22\x5b\xda\x67\xe4\xc5\x93\x92\x47\xb8\xf2\x9e\x04\xc0\x25\x97\x82\x5b\xb8\x99\x03\xbb\x0d\x2b\xb4\x1d\xb6\x2f\x59\xf1\x3d\x8e\xe0\xdd\x71\xfd\x18\xb0\x25\x97\x76\xdc\x16\x65\x8f\x5e\x3f\xe2\x84\x3a\xa2\xe7\xe0\x4c\x3d\x62\x0d\x57\xd5\x40\xbe\xd0\xb2\xde\x2b\xeb\x7d\xdb\x8a\x12\xb8\x2a\x80\xdd\xd6\x4e\x2f\x55\x6e\x70\x8f\xca\x01\xb5\xe8\x96\x2a\x97\x75\x81\xd0\x49\xb9\x9c\x74\xea\xeb\xd3\x40\xd0\x77\x8b\xd2\xc2\x06\xaa\xe2\xf4\x19\x9a\x4f\xd7\xa2\xec\xc6\xf1\x9e\x94\xb5\xca\xc1\xa1\x75\x6d\xdb\xd9\xc1\x5e\xde\xd6\xb2\x36\x5c\x7a\xff\x20\x54\xf1\x64\x16\x06\xb9\x43\xea\xe0\xbf\x00\x13\xaa\x62\xdb\x14\x5a\x92\x38\xb6\xe6\x86\x4b\x89\x92\xa6\x84\x24\x16\xb1\x08\xf6\x18\xae\x0a\xbd\x17\x3f\x91\xad\xf0\xb0\x41\x2c\x68\x4a\x92\x77\x6e\x00\x4d\xfc\x69\x43\x12\x1d\x80\xff\x4e\x3a\x6e\x84\xaa\x6a\xc9\x8d\xf7\xad\x27\x89\x28\x03\x10\x26\x5c\x1b\x67\xea\xdc\xd1\xd0\x23\x03\x9d\xc1\x50\x7a\xaf\x0f\x6a\x2c\xbe\xbf\xdb\x1e\xdf\xd0\x66\xd1\xf6\xf4\x4b\x64\xb9\x98\x83\x12\x32\x08\x4e\x1c\xfb\x6a\x8c\x36\x25\x9d\xbd\xa8\x60\x24\x38\x3d\xb6\x80\xb3\x72\xc0\xc6\xce\x37\xf0\x8f\x9d\x65\x81\x2f\x25\x89\x27\x24\x89\xa1\xc5\xd3\xb5\xd2\x0b\xad\x1c\x36\xce\xfb\xdc\x35\x61\xb0\xbc\xfb\xcf\xee\x78\xfe\x5a\x19\x5d\xab\x82\xa6\xa7\x48\x48\xd2\x41\xbe\xd5\xd6\x6d\x1b\x1a\x59\xa6\x0c\x3b\x2d\x24\xbb\xc3\x4a\xa8\x58\x12\xd3\x1c\xf7\xb6\x0d\xcd\x5d\x93\x85\x79\x7a\xc2\x94\x24\x05\x96\x68\x20\xe4\x48\x53\x68\xe1\x3b\xcc\xc1\x35\xec\x59\x4b\xb9\xe3\xf9\x2b\x4d\xc1\xc7\x7c\xf2\x18\x63\x91\x81\xb0\x2b\x3c\xc4\x59\x82\x92\x69\xc6\x67\x1d\xa0\x9f\x8d\x9a\xc1\x70\xc0\x20\xa8\xd2\x19\x44\xa9\x4b\x55\xa2\xa1\x69\x3a\xe4\xf8\x5b\x02\x0f\xdc\x71\x49\x7b\x23\x03\xe4\x22\x0a\x82\x8f\x0f\x38\x49\x84\x8b\x39\xe8\x69\x60\x74\x76\xe0\xca\x81\xfb\x81\xb0\x17\xd6\x0a\x55\x81\xd1\x87\x1e\x3e\x3b\x45\x72\x7d\x0d\x9b\x70\x4b\xde\x8c\xd8\x73\x73\x84\x57\x3c\x66\xb1\x26\x60\x85\xb2\x68\x02\x37\xdf\xe9\x77\x04\x61\xa1\x0c\xc1\x90\x24\x7e\xfe\x3e\x53\x06\x4f\xa2\x3e\x98\x7f\xee\x08\x36\x22\xde\xca\x68\x49\x44\xf7\x86\xe4\xba\x56\x6e\x98\xe9\xcc\xfd\xa6\x29\x5b\x04\xcc\x1f\x4e\xf3\x89\xf8\x4e\xd0\x54\x7c\x6c\x1c\x26\xfc\xff\x8c\x66\xad\x10\x0c\xe6\xda\x14\x19\x54\xda\xdd\xcc\xb2\x0e\x1f\x45\x0f\x4f\xd5\xf8\x68\x79\x4f\x7e\x0d\x00\x6f\x80\xed\xa6\xcd\x05\x00\x00")
+
+func templates_testFind_or_createGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testFind_or_createGoTpl,
+		"templates_test/find_or_create.go.tpl",
+	)
+}
+
+func templates_testFind_or_createGoTpl() (*asset, error) {
+	bytes, err := templates_testFind_or_createGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/find_or_create.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xee, 0x43, 0x68, 0xe5, 0x35, 0x75, 0x8b, 0x6b, 0xc9, 0xb9, 0xdb, 0xed, 0x4a, 0xe7, 0x80, 0xa4, 0xc3, 0xa, 0x77, 0xf9, 0xde, 0x78, 0x35, 0xbb, 0x16, 0x8a, 0x86, 0xbd, 0xec, 0x7, 0xdb, 0xdc}}
+	return a, nil
+}
+
+var _templates_testFinishersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x8f\xdb\x36\x10\x3d\x8b\xbf\x62\x6a\xf4\x83\x4a\x14\xa2\xc9\x71\x8b\x3d\xc4\xbb\x3d\xe4\xd0\x75\x50\x2b\xe8\xb1\xe0\x4a\x23\x47\x08\x4d\x1a\x24\x55\xab\x15\xf8\xdf\x8b\xa1\x9d\xb5\x77\x63\x59\x42\xb7\x0b\x64\x11\x1d\x0c\xdb\xf2\x70\xde\xbc\xc7\xe1\x1b\xba\xeb\x5e\xc1\xf7\x52\xd5\xd2\xc1\xc5\x25\x88\xb7\xf4\x09\x9d\xc8\xe5\xad\x42\xd8\xbd\x89\x1b\xb9\xc6\x10\x58\xd5\xe8\x02\x3c\x3a\xdf\x75\xbb\x15\xe2\xc3\xe6\xbd\x6a\xac\x54\x21\xcc\x6b\x5d\x72\x0f\x2f\xe8\xe7\x5a\xaf\x44\x9e\x42\xc7\x12\x2f\xde\x4b\x2b\x95\x42\xc5\x53\xc6\x12\x87\x58\x12\x8a\x95\xba\x34\xeb\xfa\x1f\x14\x37\xb8\x5d\x22\x96\x3c\x65\xc9\x5f\xd2\x02\xda\xf8\x32\x96\x25\x86\x02\x7f\x3c\x42\x5a\xd6\x7a\xd5\x28\x69\x43\xe8\x02\x4b\xea\x8a\x02\xe1\x28\xd7\xd2\xdb\xa6\xf0\x9c\x30\x32\x30\x19\xdc\x2d\xbd\x36\x5b\x7d\x58\x7c\x3d\xcf\xff\xde\xa0\xcb\xc0\xdb\x06\x7b\xa3\xae\x8c\x6a\xd6\xda\xfd\x51\xfb\x8f\xd7\x58\xc9\x46\x79\x21\x44\xfa\x4b\xc4\xfc\xee\x12\x74\xad\x88\x5e\xe2\xc5\xaf\xd6\x1a\x5b\xf1\xd9\x07\x4d\x4a\x81\x37\x87\x82\xe0\x64\xf1\xe0\x62\x9d\x17\xf0\x83\x9b\x65\x94\x2f\x65\x49\x60\x2c\xe9\xba\xba\x02\x6d\x3c\x88\x1b\x73\x65\xb4\xc7\xd6\x87\x50\xf8\x96\x64\x28\x76\xdf\xc5\x5c\x16\x9f\x56\xd6\x34\xba\xe4\x69\xd7\xa1\x2e\x43\x60\xc9\x2e\xe4\xb7\xc6\xf9\xbc\xe5\x31\xcb\x71\x86\x5b\x53\x2b\x31\xc7\x55\xad\xe3\x12\xe5\xf0\xf8\x59\xde\xf2\xc2\xb7\x19\xf1\xf9\x9c\x30\x65\x49\x89\x15\x5a\xa0\xdd\xe6\x29\x74\xf0\x27\x5c\x82\x6f\xc5\xef\x46\xa9\x5b\x59\x7c\xe2\x29\x04\x9e\x1e\xed\x80\x11\xef\xb4\x43\xeb\x79\x1f\x05\x52\x19\x75\x09\xaf\x42\x00\x42\x8b\xf8\xef\x74\x85\x96\xa7\xbd\x9a\xf2\x83\x34\x77\x48\x27\xfa\x8e\xa7\x22\xb6\xde\x17\xc4\x75\xad\x3e\xf3\x2d\x7c\xbb\x27\x97\x45\x7c\x33\x0c\x1a\xd8\xd9\x6e\x5f\x68\x9c\x9a\x7d\x6a\xf6\x27\x6a\xf6\x36\xfa\x02\x11\x3d\xd1\x7a\x3c\x15\xd4\x7d\xe3\xe0\x07\x01\x81\x44\x02\xc2\x84\xcb\x2f\x83\x66\xd8\x6e\xb0\xf0\x58\xd2\x56\xaf\xd0\x83\x04\x6d\x74\x0c\xb3\x58\x18\x5b\xce\xc6\x1c\x96\xb7\x4a\xfd\xaf\x87\xa5\xa7\x8b\x17\x1a\xcf\x9f\xa2\x9e\x75\xf9\xf6\x71\xa7\xaf\x27\xed\x42\xe3\xf0\xb1\xac\xa4\x72\x5f\xcf\xb9\xfc\x8f\x4c\xf3\xad\x79\x6e\x4c\x9f\xb3\x03\xf5\x48\xb8\xd0\xf8\xb4\xd6\x34\x58\x41\xbe\x7d\x72\x73\x74\xaa\x2e\x70\xc0\x1d\xc9\x6e\xc6\xe1\x1f\x54\x3d\x0b\x5a\x57\xa0\x50\xf3\x88\x9d\x92\x42\x6f\xee\x05\xce\xb6\x52\x7b\x78\xb3\x77\x44\x97\xc1\xca\xf8\x8b\x59\x76\xb4\x66\x8c\x49\x2e\xbd\x45\xb9\x9e\x7c\x72\xf2\xc9\xc9\x27\x27\x9f\x7c\xac\x4f\x16\xa6\xd1\x9e\xb6\xe8\x67\x96\x3c\xa8\xe5\x9e\x57\xee\x5d\x67\x6c\x19\x64\x60\xdc\xc0\x8b\xa3\x64\x07\x5a\x29\x95\x65\x6c\x6c\xc0\x88\xff\xf2\x25\x4b\x12\x8b\xbe\xb1\xf1\xca\xc8\x92\x30\xca\x70\x49\xbf\xb8\x7e\xbc\xd5\xc6\xf0\x3d\x75\xe7\xcd\x86\x98\xc7\x62\x1c\xd9\x23\x9f\xd1\x33\xba\xaa\xc6\x38\xf8\x7a\x54\xa1\xba\x1e\xca\x42\xcf\x4e\x90\xf6\x1f\x11\x0a\xb9\xfb\x53\xf2\x93\xa3\x60\x63\xef\xf8\x9f\x94\xee\xf5\x89\x2c\x2e\x32\xab\xf5\x8a\x6e\x4e\x11\x49\x56\x1e\x2d\xbc\xde\x4b\x7a\x42\xd1\x81\xb9\x75\x45\x81\x83\x63\xeb\xc1\x64\x3a\x3b\xc5\x7a\x4e\xcd\x34\xb6\xa6\xb1\x35\x8d\xad\x6f\x60\x6c\x0d\x5c\xef\x77\x86\x33\xae\x82\x7b\xc6\xda\x0f\xfb\x98\x81\x13\xd8\xbf\x03\x00\x25\xff\xdb\x2a\x41\x17\x00\x00")
 
 func templates_testFinishersGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -709,11 +1176,11 @@ func templates_testFinishersGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/finishers.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1f, 0x6b, 0xe9, 0x2a, 0x23, 0x1b, 0x2a, 0x8d, 0x5e, 0xbb, 0x58, 0x3f, 0xe3, 0xae, 0x5b, 0x35, 0x8d, 0xd0, 0x95, 0x56, 0x3, 0x2a, 0x59, 0xe9, 0xbf, 0xc9, 0x9, 0x1e, 0x88, 0x20, 0x3b, 0x9c}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x22, 0xe0, 0xb3, 0x76, 0xf5, 0xbb, 0xab, 0x6e, 0xdc, 0x18, 0xcc, 0xa8, 0xe8, 0x52, 0x8e, 0xe1, 0xf5, 0x5d, 0x70, 0x72, 0x14, 0x35, 0xce, 0x11, 0x17, 0x85, 0x19, 0xf6, 0xab, 0x48, 0x7a, 0x1c}}
 	return a, nil
 }
 
-var _templates_testHooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x4d\x4f\xe3\x30\x10\x3d\xc7\xbf\x62\x60\x3f\x94\xa0\x90\x1f\xc0\xaa\x07\xd8\x56\xda\xbd\x20\xa4\xc2\x69\xb5\x07\x37\x99\x54\x59\x8c\xdd\x75\x1c\x28\x1b\xf9\xbf\xaf\xec\x74\x71\x64\x25\xd0\x83\x83\xb4\x39\x41\x3b\x2f\xf3\xe6\xf9\x79\xec\x69\xdb\xb6\xe7\x50\x95\xc0\x85\x82\xec\x5a\x7c\x13\xe2\xbe\x86\x73\xad\x89\x79\xff\x23\x65\x15\xad\xe1\x62\x01\xd9\xa5\xf9\x0f\xeb\xec\x96\x6e\x18\x42\xf7\x27\xbb\xa6\x0f\xa8\x35\x29\x1b\x9e\x43\xdb\x76\xe8\x6c\x29\x9e\xf8\xba\xe2\xdb\x86\x51\xa9\xf5\x15\x96\x42\xe2\x77\x5e\xa3\x54\x26\x79\xdc\xb6\x55\x69\x98\xbe\x0a\xae\x70\xaf\xb4\x46\xd8\x88\x8a\x65\xab\x3d\xe6\x8d\x12\xb2\x6d\x91\xd5\xa8\x75\xae\xf6\x90\x77\x98\xec\x80\x4d\xe1\x80\x3d\xbc\xee\x3d\xc2\x0b\xad\x53\x10\x70\xf6\x52\xc6\xdd\xce\x15\x91\x00\x4a\x29\x24\xb4\x24\x3a\x13\xb0\x80\x41\x50\xab\x49\x24\x51\x35\x92\x03\xaf\x18\xd1\xe4\x55\x5d\x97\xa5\x42\x39\x53\x59\x6b\x64\x98\xcf\x4a\x56\xb7\x0b\xef\x76\x05\x55\x38\x27\x5d\xd6\xae\xf9\xc9\xea\xec\x5a\x22\xc3\x79\xe9\xb2\x76\xcd\x4f\xd6\xbf\xee\x9a\xe5\x61\xf8\x1f\xcb\x52\x58\xab\x1e\xfe\x86\x35\x92\x32\xad\xed\x1d\x1f\x2b\x38\x33\xf1\x8a\x6f\xb3\xdb\xc4\xa4\x57\xd9\x0d\x95\x94\x31\x64\x71\x42\x48\xf4\x48\xa5\xa1\xee\xe8\x09\x89\xac\xf8\xc3\x94\xf0\xb2\x00\x46\xe9\xc5\xe2\x45\xec\x15\xcd\xef\xb7\x52\x34\xbc\x88\x93\x83\x32\x12\xe1\xc3\x4e\x3d\x1b\xd4\xe7\xd1\xda\xc5\xab\x61\x12\xd5\x88\x85\x81\x48\xca\x0b\xf1\x50\xfd\xc1\xec\x1a\x9f\xd6\x88\x45\x9c\x90\xa8\x2a\x6d\x9d\xfd\xe8\x5a\xc9\x26\x57\xb1\x79\x2c\x05\x91\x8e\xf9\xbb\xbc\xba\x7d\xde\x61\x9d\x42\x49\x59\x8d\xc9\x17\x9b\xe7\x64\x61\xd6\xd0\x2c\x48\xa4\xb2\x95\x11\x5f\xc6\xa7\x77\xdc\x8e\x3d\x4a\x38\x92\x61\x27\x40\x6c\x7e\x61\xae\x2e\xe0\x53\x7d\x9a\x9a\x7c\x09\x89\x8c\x82\xcb\xa2\x18\xc4\xdb\x7d\x65\x37\x86\x3f\x28\x8d\x56\xed\x03\xfb\x4b\x20\xb2\x42\xf8\xf1\x3a\x1e\xb3\xce\x50\x20\x2f\xcc\xb8\x67\x34\x1f\xb7\x00\x68\x77\x2e\xc2\x00\x91\xa7\xda\x94\x75\x22\xb1\x34\xc3\x44\xb6\x44\xdc\xad\x7e\x37\x94\xc5\x22\x05\xbb\x25\x12\x8f\x62\xb5\xdf\x61\xae\xb0\x00\x3f\x2f\x98\xcd\xac\x2a\xc1\x2d\xbd\xdd\x4d\xdd\x2a\xa7\xb0\x69\x14\x6c\x85\x59\xee\x0f\x8f\xa7\x29\x88\x8e\xf7\xc8\x85\xab\x61\x01\x3f\x7e\x8e\xda\xd2\x1e\xe7\x9b\x37\x08\x8e\xda\xe6\xe1\x7c\xd7\xbc\xf0\x64\xa6\xf9\x3c\x81\x3c\xf3\xd2\x86\xb2\xcc\xaf\x36\x9c\x63\x6e\xc6\x7d\xdd\x31\x87\x1b\x74\xcc\x85\xa7\x75\xac\xc7\x13\xd2\x31\x97\x36\xa8\x63\xbd\x6a\x83\x38\xe6\x8f\xef\x6f\x9c\x8d\x0e\x38\x7c\x36\xba\xf8\xc4\x67\x63\x8f\x28\xe8\xd9\xe8\xf2\x86\x3d\x1b\x7b\xf5\x86\xeb\xb4\x23\x6c\xf3\x70\x83\x9d\xf6\x0e\xa6\xf9\x3c\x21\x3b\x2d\xbc\x65\x7e\xb5\x01\x3b\xcd\x7d\x44\x79\xa3\xd3\x1c\x70\xb8\xd3\x5c\x7c\xe2\x4e\xeb\x11\x05\xed\x34\x97\x37\x6c\xa7\xf5\xea\x0d\xd7\x69\x47\xd8\xe6\xe1\x06\x3b\xed\x1d\x4c\xf3\x79\x42\x76\x5a\x78\xcb\xfc\x6a\x83\xde\x69\x47\xce\xfb\x0e\x38\x76\xa7\xbd\xd3\xbc\xdf\x23\x0a\x7c\xa7\x4d\x33\xef\xf7\xea\x0d\x79\xa7\x1d\x37\xef\x8f\xbb\xe6\x85\xa7\xbe\xd3\x26\x99\xf7\xc3\x5b\xe6\x57\xfb\xa6\x63\xdd\xef\x14\xdd\x37\x0d\x7f\x03\x00\x00\xff\xff\x93\xd5\x90\x1f\xca\x18\x00\x00")
+var _templates_testHooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x99\x4f\x4f\xdb\x4c\x10\xc6\xcf\xde\x4f\x31\xf0\xbe\xad\x6c\x64\xfc\x01\xa8\x72\x80\x26\x52\x7b\x41\x48\x81\x53\xd5\xc3\xc6\x1e\x47\x2e\xcb\x6e\xba\x5e\x43\xa8\xb5\xdf\xbd\x9a\x38\xb0\xd6\xe2\x10\x1f\x36\x48\xcd\x01\xf1\x67\xc6\xf3\xcc\xec\x6f\x9f\x68\x2c\xda\xf6\x1c\xaa\x12\xa4\x32\x90\x5d\xab\x6f\x4a\xdd\xd7\x70\x6e\x2d\xa3\xbf\xff\xcf\x45\xc5\x6b\xb8\x98\x40\x76\x49\x3f\x61\x9d\xdd\xf2\x85\x40\xe8\xbe\x65\xd7\xfc\x01\xad\x65\x65\x23\x73\x68\xdb\x2e\x3b\x9b\xaa\x27\x39\xaf\xe4\xb2\x11\x5c\x5b\x7b\x85\xa5\xd2\xf8\x5d\xd6\xa8\x0d\x15\x8f\xdb\xb6\x2a\x49\xe9\xab\x92\x06\xd7\xc6\x5a\x84\x85\xaa\x44\x36\x5b\x63\xde\x18\xa5\xdb\x16\x45\x8d\xd6\xe6\x66\x0d\x79\x97\x93\x6d\x73\x53\xd8\xe6\x6e\x7f\xef\x3d\x22\x0b\x6b\x53\x50\x70\xf6\xda\xc6\xdd\xca\x35\x91\x00\x6a\xad\x34\xb4\x2c\x3a\x53\x30\x81\xc1\xa4\xd6\xb2\x48\xa3\x69\xb4\x04\x59\x09\x66\xd9\xbb\x73\x5d\x96\x06\xf5\x91\x8e\x35\x47\x81\xf9\x51\x8d\xd5\xdd\xc2\xbb\x55\xc1\x0d\x1e\x1d\xae\xe3\x1b\xab\xc3\x35\x45\x81\x47\x88\xeb\xf8\xc6\x7a\x71\xd7\x51\x7e\x18\xfe\xc3\x63\x19\xac\x4d\x2f\xff\x46\x34\x9a\x0b\x6b\x69\x96\x3a\x36\x70\x46\xf1\x4a\x2e\xb3\xdb\x84\xca\x9b\xec\x86\x6b\x2e\x04\x8a\x38\x61\x2c\x7a\xe4\x9a\xa4\xe9\x4b\x69\xc6\xa2\xb6\x75\x5b\xc2\x76\x88\xee\x63\xff\x62\xf2\x3a\xec\x15\xcf\xef\x97\x5a\x35\xb2\x88\x93\xed\x64\x2c\xc2\x87\x95\x79\xa6\x1d\xe2\xf3\xce\xde\xd5\xbb\x61\x16\xd5\x88\x05\xa5\x68\x2e\x0b\xf5\x50\xfd\xc1\xec\x1a\x9f\xe6\x88\x45\x9c\xb0\xa8\x2a\xa9\x47\xe8\x45\xe7\x46\x37\xb9\x89\xe9\xa9\x14\x54\xba\x0b\xef\xf4\xea\xf6\x79\x85\x75\x0a\x25\x17\x35\x26\x5f\x36\x65\x4e\x26\x74\x84\x74\x1e\x91\xc9\x66\x34\x7b\x19\x9f\xde\x49\x5a\x77\xc0\x28\xa7\x31\x0c\x02\xd4\xe2\x17\xe6\xe6\x02\x3e\xd5\xa7\x29\xd5\x4b\x58\x64\x19\x8b\x2e\x8b\x62\x30\x9f\x50\xc4\x9b\x7b\xe1\xef\x49\xe9\xd8\x85\xaa\x7f\x02\x2a\x2b\x94\x1f\xaf\xe3\x5d\xe4\x48\x02\x65\x41\xdb\x1e\xcd\x3c\xee\x00\x70\x73\xd7\x11\x06\x84\xbc\xa9\xa9\xad\x13\x8d\x25\xed\x12\xd9\x14\x71\x35\xfb\xdd\x70\x11\xab\x14\x36\x37\x22\xf1\x24\x66\xeb\x15\xe6\x06\x0b\xf0\xeb\x02\xdd\x65\x53\x29\xb9\x91\xa7\x47\xb7\xa7\x9c\xc2\xa2\x31\xb0\x54\x74\xdc\xff\x3d\x9e\xa6\xa0\x3a\xdd\x91\x07\x57\xc3\x04\x7e\xfc\xdc\x89\xa5\x1d\xc7\xcd\xdb\x03\xd3\x91\xfb\xa2\x4f\xcd\x0b\x1f\x0c\x9a\xaf\x13\x88\x99\x57\x36\x14\x32\xbf\xdb\x70\xc4\xdc\x8a\x9b\x8e\x5c\x85\x07\x89\xb9\xf0\x61\x89\xf5\x74\x42\x12\x73\x65\x83\x12\xeb\x75\x1b\x84\x98\xbf\xbd\xa7\xfb\x16\x91\x97\x44\x9f\x99\x1f\x3f\x18\xb4\x37\x42\x81\xa8\xf9\x75\x43\x61\x7b\xd3\x6f\x38\xa7\x8d\xc0\xe6\xe5\x0d\x3a\xed\x03\xa0\xf9\x3a\x21\x9d\x16\x1e\x99\xdf\x6d\x40\xa7\xb9\x37\x94\x3d\x4e\x73\x89\xc3\x4e\x73\xf1\x03\x3b\xad\x27\x14\xd4\x69\xae\x6e\x58\xa7\xf5\xfa\x0d\xe7\xb4\x11\xd8\xbc\xbc\x41\xa7\x7d\x00\x34\x5f\x27\xa4\xd3\xc2\x23\xf3\xbb\x0d\xe8\x34\xf7\x72\xb9\xc7\x69\x2e\x71\xd8\x69\x2e\x7e\x60\xa7\xf5\x84\x82\x3a\xcd\xd5\x0d\xeb\xb4\x5e\xbf\xe1\x9c\x36\x02\x9b\x97\x37\xe8\xb4\x0f\x80\xe6\xeb\x84\x74\x5a\x78\x64\x7e\xb7\x7b\x89\x75\xff\xa6\x40\x59\x58\xcb\xfe\x0e\x00\xde\x5d\xe8\xdd\xc9\x18\x00\x00")
 
 func templates_testHooksGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -729,11 +1196,11 @@ func templates_testHooksGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/hooks.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0x58, 0x7a, 0x86, 0xea, 0xf1, 0xe0, 0xb1, 0xe, 0x7e, 0xd4, 0xcc, 0xd6, 0xea, 0x25, 0x23, 0xf6, 0x18, 0xa6, 0x30, 0xef, 0x1, 0x51, 0x2f, 0x47, 0xad, 0xf3, 0x2f, 0x83, 0x93, 0x26, 0xb9}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfa, 0x9, 0xb2, 0x13, 0x0, 0x19, 0x5f, 0xaf, 0x82, 0xd8, 0x6, 0xbb, 0x34, 0x71, 0xee, 0x1a, 0x46, 0x80, 0x3, 0x8d, 0x92, 0xb6, 0x2, 0xdf, 0x1, 0x79, 0xd1, 0x5c, 0xbc, 0xe4, 0x6e, 0xfa}}
 	return a, nil
 }
 
-var _templates_testInsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x41\x6f\x13\x3d\x10\x3d\xaf\x7f\xc5\x7c\xd1\x07\xb2\xd1\xd6\x12\xd7\xa2\x1c\x48\xc2\xa1\x07\xa2\x8a\xa4\xea\x11\x39\xbb\xb3\xa9\x55\xc7\xae\xec\x31\x59\xb0\xfc\xdf\x91\x1d\x68\x82\xd4\x40\x0f\x5c\x40\x1c\x56\xbb\x5e\xbd\x99\xf7\xe6\x8d\x67\x52\xba\x80\xff\x95\xd1\x2a\xc0\xe5\x14\xe4\xdb\xf2\x85\x41\xae\xd5\xc6\x20\x1c\x5e\x72\xa9\x76\x98\x33\x1b\xa2\xed\x80\x30\x50\x4a\x87\x08\x79\xf3\x70\x6d\xa2\x57\x26\xe7\x2b\x1b\xd0\x13\x27\x78\x55\x00\xda\x6e\xe5\x5a\x40\x62\x0d\xc9\x6b\xe5\x95\x31\x68\xb8\x60\xac\x09\x88\x7d\xe1\xf1\xca\xf6\x6e\xa7\xbf\xa0\x5c\xe2\x7e\x85\xd8\x73\xc1\x9a\x4f\xca\x03\xfa\xfa\x38\xcf\x1a\x57\x80\x2f\x4f\xb8\x56\xda\x6e\xa3\x51\x3e\xe7\x94\x59\xa3\x87\x0a\x3e\xcd\xb5\x22\x1f\x3b\xe2\x85\xa4\x05\xd7\xc2\x63\xec\xc2\xed\xed\x31\x7a\x31\x5b\x7f\x7e\xc0\xd0\x02\xf9\x88\x67\x51\x73\x67\xe2\xce\x86\x5b\x4d\x77\x0b\x1c\x54\x34\x24\xa5\x14\x6f\x2a\xe9\x7f\x53\xb0\xda\x94\xfa\x1a\x92\xef\x8a\xdc\x81\x4f\x6e\x6c\xf5\x8c\xdc\x51\x11\x3c\xa9\x1e\x42\xd5\x79\x09\x2f\xc2\xa4\x2d\xf9\x04\x6b\x32\x63\x4d\x4a\x7a\x00\xeb\x08\xe4\xd2\xcd\x9d\x25\x1c\x29\xe7\x8e\xc6\xe2\x43\x77\x38\xcb\x99\xea\xee\xb7\xde\x45\xdb\x73\x91\x12\xda\x3e\x67\xd6\x1c\x20\xef\x63\xa0\xf5\xc8\x6b\x96\xd3\x0c\x1b\xa7\x8d\x9c\xe1\x56\xdb\x1a\x62\x02\x9e\xfe\x5b\x8f\xbc\xa3\xb1\x2d\xf5\x7c\x4f\x28\x58\xd3\xe3\x80\x1e\x4a\xc3\xb9\x80\x04\x1f\x61\x0a\x34\xca\x0f\xce\x98\x8d\xea\xee\xb9\x80\x5c\x1a\xf6\xd8\x02\x27\xbf\xf5\xff\x5c\x09\xc5\x65\xb4\x3d\x5c\xe4\x0c\xe5\x54\xf9\xaf\xec\x80\x9e\x8b\xb3\x9e\xf2\xa3\x35\x9d\x8b\x96\xaa\x57\xa5\xd2\x27\xee\x1f\x17\x72\x5e\x30\xcf\x54\x70\x14\xff\x53\x5a\x3d\x40\x65\x2e\xa8\xd7\x3f\x60\x26\x7b\x65\x09\x9c\x45\xf0\xd8\x39\xdf\xb7\xb0\x75\x74\x39\x69\x0f\xf8\x1a\x9e\xd9\x33\x26\xe6\xf6\x4e\x13\x1a\x1d\xfe\xc4\xd1\xf9\x37\x0c\xbf\x71\x18\x8e\x17\xe1\xd7\xfb\xc8\x45\x3a\x59\x49\x7f\xef\xfc\x7c\x0d\x00\x00\xff\xff\x8d\x4a\xa9\x88\x9c\x06\x00\x00")
+var _templates_testInsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x41\x4f\x1b\x3d\x10\x3d\xaf\x7f\xc5\x10\x7d\x7c\xb2\xab\xc5\x6a\xaf\x54\x1c\x0a\xe1\xc0\xa1\x08\x95\x20\x8e\x95\xb3\x9e\x0d\x16\x8e\x8d\xec\x59\x08\x5d\xf9\xbf\x57\xf6\x02\x59\xaa\xa4\x8d\xd4\x5e\x5a\x71\x88\xb2\xbb\x7a\x33\x6f\xe6\x79\x9e\xa7\xef\x0f\xe0\x3f\x65\x8d\x8a\x70\x78\x04\xf2\x53\x7e\xc2\x28\x67\x6a\x6e\x11\x86\x3f\x79\xae\x96\x98\x12\x6b\x3b\xd7\x00\x61\xa4\xbe\x1f\x22\xe4\xd5\xdd\x85\xed\x82\xb2\x29\x9d\xb9\x88\x81\x38\xc1\xbb\x0c\x30\x6e\x21\x67\x02\x7a\x56\x91\xbc\x50\x41\x59\x8b\x96\x0b\xc6\xaa\x88\xa8\x33\x4f\x50\x4e\xfb\xa5\xf9\x86\xf2\x1c\x1f\x2e\x11\x35\x17\xac\xba\x57\x01\x30\x94\x9f\x0f\xac\xf2\x19\xf8\xff\x88\xeb\xd2\xb8\x45\x67\x55\x48\xa9\x4f\xac\x32\x6d\x06\xc2\x28\xd7\x25\x85\xae\x21\x9e\x39\x6a\xf0\x35\xbc\x84\x4e\xfd\x83\x5b\x07\x4f\x8f\x67\x8f\x77\x18\x6b\xa0\xd0\xe1\x56\xd4\x89\xb7\xdd\xd2\xc5\x6b\x43\x37\x53\x6c\x55\x67\x49\x4a\x29\x3e\x16\xce\xbd\x23\x70\xc6\xe6\xf6\x2a\x92\xa7\x21\xf8\xd0\xf2\xc9\x95\xcb\x5a\x01\xf9\x75\x41\xb0\xb1\x78\x88\xa5\xce\x43\xd8\x8f\x93\x3a\xe7\x13\xac\x4a\x8c\x55\x7d\x6f\x5a\x70\x9e\x40\x9e\xfb\x13\xef\x08\x57\x94\x52\x43\xab\x2c\x43\x33\xbc\xcb\x63\xd5\xdc\x2e\x82\xef\x9c\xe6\xa2\xef\xd1\xe9\x94\x58\x35\x40\x3e\x77\x91\x66\x2b\x5e\xb2\x8c\x33\xcc\xbd\xb1\xf2\x18\x17\xc6\x95\x10\x1b\x71\xfc\x6d\xb6\xe2\x0d\xad\xea\xdc\xcf\x73\x42\xc1\x2a\x8d\x2d\x06\xc8\xe7\xcd\x05\xf4\xf0\x15\x8e\x80\x56\xf2\x8b\xb7\x76\xae\x9a\x5b\x2e\x20\x71\x31\x3a\x01\x2f\x9f\x8e\x7f\x5b\x0b\x59\x65\x74\x1a\x0e\x52\x82\xfc\x56\xf8\xcf\x5c\x8b\x81\x8b\xad\x9a\xf2\xb5\x34\x8d\xef\x1c\x15\xad\x72\xa7\x1b\xc6\x8f\x0b\x79\x92\x31\x3b\x56\xb0\x2e\xfe\xa7\xb4\xa6\x85\xc2\x9c\x8b\xfb\xf0\x0a\x33\x79\x50\x8e\xc0\x3b\x84\x80\x8d\x0f\xba\x86\x85\xa7\xc3\x49\x3d\xe0\x4b\x78\x62\x3b\x18\xe6\xfa\xc6\x10\x5a\x13\xff\x42\xe7\xbc\x79\xe1\x0f\x7a\x61\x3d\x07\xbf\xbe\x8e\x7c\x47\xa3\x1b\xe9\xdf\xb5\x4f\xde\x4c\x1b\xaa\xd9\xc5\x56\x53\x54\xda\x1a\x87\x6f\xfb\xe8\x37\xf6\xd1\x2b\x2b\xbd\x76\xc9\x06\x07\x0e\xb6\xd9\xd5\x2f\xac\x2a\x77\x72\xa3\x5c\x83\x76\x6c\xea\xdc\xdf\xcc\x2c\xd1\x77\xb4\x85\xe6\xfd\x0b\xc7\x10\xbd\xd9\x7d\x25\xfd\x86\x55\xb3\x57\x0e\x33\xca\xb3\x98\x07\xb5\x7e\xe1\x7d\x9e\x98\xd3\x55\x83\xa8\x51\x8b\x1f\xd4\x2c\x03\x4b\x37\x08\xa6\xe4\x07\x35\xf7\x81\x50\xc3\xfc\xb1\x7c\xd5\x4f\xe1\xc3\x1e\x80\xfd\xfb\x91\x92\xc3\x24\xa3\xd3\x29\xb1\xef\x03\x00\x8a\xd2\xac\x3e\x6e\x09\x00\x00")
 
 func templates_testInsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -749,11 +1216,71 @@ func templates_testInsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/insert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd2, 0xab, 0x6d, 0xb5, 0x29, 0x72, 0xba, 0x67, 0x4, 0xd5, 0x8b, 0x12, 0x80, 0x44, 0xd2, 0x22, 0x9e, 0x4a, 0x7b, 0x7a, 0x14, 0xa9, 0xf3, 0xdd, 0x52, 0x85, 0xef, 0xe5, 0x84, 0x52, 0xb7, 0x9d}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x62, 0x3, 0xda, 0x91, 0xf9, 0x6, 0x5a, 0xd9, 0x4d, 0x8e, 0xb1, 0x5, 0xed, 0x2b, 0x97, 0xd9, 0x6e, 0xcc, 0x24, 0x29, 0x6, 0x8e, 0x74, 0x70, 0x17, 0xd7, 0xe0, 0x7f, 0x76, 0x66, 0x17, 0x3e}}
 	return a, nil
 }
 
-var _templates_testRelationship_one_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x51\x6f\xdb\x36\x10\x7e\xb6\x7e\xc5\xc5\x70\x03\xca\x50\xd9\xf7\x0c\x7e\x68\x9a\x06\xc8\x90\x25\x43\xe2\x60\x0f\xc3\x30\x30\xd2\x49\xe6\xc2\x90\x29\x49\x39\xde\x08\xfd\xf7\x81\xa4\x15\xc9\x31\xdd\x06\xc3\x0a\xf4\xcd\xe4\xdd\x7d\xf7\xdd\x7d\xc7\xb3\x9c\x7b\x0f\xbc\x06\xba\x64\xf7\x02\xe9\x85\xf9\x59\x71\x19\x7e\xc3\xfb\xae\xcb\xbc\x15\x85\x89\x87\x89\x3f\x69\x26\x1b\x84\x99\x46\x01\x27\x8b\x3e\x6c\xa9\xae\x25\xde\xa0\x60\x96\x2b\x69\x56\xfc\xc9\xc4\x80\x10\x31\x13\x36\xe0\x9d\x2c\x60\x46\x3f\x0a\xce\x0c\x9a\x18\x17\x60\xe8\x90\x6d\xeb\x5f\x7f\xdd\xff\x5c\x69\xe4\x8d\xdc\x0b\xd3\x28\x82\x77\x08\x8c\x18\x74\xcc\x29\x46\x5f\xb1\xc7\x9d\xa8\xd6\xa0\xf9\x55\xf3\x47\x6e\xf9\x1a\x43\xec\xab\x9b\x59\xcc\x6d\xc6\x64\xc3\xcf\x4f\x4a\xb4\x8f\x32\xc1\x69\x7c\xb3\x75\x1a\x25\x2c\x95\x38\xe7\x28\xaa\x40\x33\xb6\x66\x07\x6a\x3f\xa2\xde\x09\xa9\xf7\x43\x76\x73\x75\x5d\x56\xb7\xb2\x04\x8b\xc6\x3a\xd7\xa7\xb8\x7b\xba\xe5\xb2\x69\x05\xd3\x5d\x77\x2d\x31\x28\xe6\x5c\x8f\x36\xb6\xde\x19\x2e\x1b\xe7\x5e\xfa\x49\x2f\x55\xc9\x44\xd7\x11\x0b\x73\x8f\xc9\x65\x43\x97\x39\x38\x3f\x0f\xbc\x06\xa9\x2c\xcc\xe8\x95\xfa\xa4\xa4\xc5\x8d\xed\xba\xd2\x6e\x3c\xd1\x32\x9e\xe9\x29\x2b\x1f\x1a\xad\x5a\x59\x91\xdc\x39\x94\x95\x2f\x2c\xba\xfc\xd2\x1a\xbb\xdc\x90\x00\xb3\x03\x71\xaf\xb8\xa0\xa7\xd8\x70\x19\x62\x84\xc1\xf1\xdd\x72\x43\x4a\xbb\x29\x40\x72\xd1\x23\xe6\xd9\xa4\xc2\x1a\x35\xf8\xca\x49\x0e\x0e\xfe\x84\x05\xd8\x0d\xbd\x51\x42\xdc\xb3\xf2\x81\xe4\xd0\x91\x3c\xcb\x26\x6b\xa6\xa1\x8e\xfd\x82\x74\xfd\xd1\x47\xf8\xa2\x21\xdd\xbf\x2c\x9b\x18\xc4\x20\x87\x66\xb2\x52\x8f\xfc\x1f\xa4\x57\xf8\x7c\x8b\x58\x91\x3c\x9b\xf0\x1a\x50\xeb\x5d\xf3\xad\xd5\x6d\x69\x89\x8f\x2b\xe0\x78\xcb\xa0\x18\x51\x38\x53\xcf\x72\x48\x71\x76\xba\xfc\xfb\x09\x4d\x01\x56\xb7\x78\xd8\x2d\x4a\x6e\x7e\xe3\x76\x75\x86\x35\x6b\x85\xa5\x94\xe6\x3f\x85\xf4\x47\x0b\xdf\x21\xaf\xd3\xc4\xd2\xcf\x5a\x2b\x5d\x93\xe9\x9d\x0c\x23\x6a\xd5\x40\xed\x40\x1b\xc0\x04\xc6\x27\xf0\xce\x4c\x0b\x0f\x98\x67\x93\xee\x2d\xb5\x85\xce\x15\xa3\xd6\x7d\xab\xb2\xa4\xdb\xff\x55\x59\x42\xbe\x74\x65\xe3\xd2\x42\x09\xf4\x42\x1a\xd4\x96\x1c\x9c\x72\x4f\x1e\x65\xe5\x9f\x2a\xf8\x53\x98\xd0\x0b\x59\xa3\x26\x79\x8a\xe9\x39\xb3\x4c\x90\x21\x5f\x9c\xfb\x57\xeb\x26\xbc\xfb\xed\x78\x50\xaf\x4c\xff\xf8\xbb\x0e\x7a\x62\xce\xcd\x86\x5b\x8f\x33\xac\xe8\x2f\x2d\x6a\x8e\x86\x7e\x34\x86\x37\x92\x1c\xa7\x91\x8a\x14\x50\x1e\x90\x62\x3d\xe3\x66\xf4\x10\xdf\xbb\x1d\xe5\x0a\xcb\x87\x62\x57\x82\xd4\x1a\xca\xe9\xb5\xc4\xb7\xd2\x18\x5e\xe3\x7f\x94\x82\xd7\x10\x88\xbd\xd6\xe2\x68\x68\xcc\x2b\x8b\xdb\x55\x84\xd7\x70\xd4\xab\xf2\xf9\x4b\xcb\x04\x49\xe1\x15\x07\xd0\xb6\x7b\xf6\x45\x96\xd1\xc0\x3f\x33\xe9\x67\x78\x5d\x40\xa3\x2c\xbc\x5b\x4f\x0f\x61\x14\xc9\x0a\xb6\x95\x1b\xc1\xcb\xf0\x5f\x9b\x7e\x2b\xb7\xde\xec\xe2\x9b\x1e\xa6\xa2\x97\xe7\x92\x5e\x2a\x56\xa5\x44\x7a\xf3\x94\xd4\x4c\x18\x2c\x80\xcc\x7f\xff\x63\x9e\xa6\x90\x93\xe3\x40\x32\x8f\x1b\xff\x9b\x93\xe4\x49\x46\x7a\x37\xa9\xf9\x81\xc5\xde\xf6\x20\xd3\xb8\x11\xc0\xac\x54\x2b\x2a\x58\xb1\x35\xc2\x3d\xa2\x04\x64\x0d\xfa\xff\x02\x56\x61\x35\xdd\x76\xec\xab\xd8\x1e\xfa\x7b\xb4\x29\x2e\xcb\x7e\xb7\xfe\x00\x7d\xe8\xb2\xec\x85\xa1\x73\x1f\xe6\xdb\x0f\xc3\xf9\x87\xfe\xab\x71\x64\xfa\x4b\x71\x09\xf1\xa3\x2e\xd8\xff\x0d\x00\x00\xff\xff\x86\x1e\xbd\x93\x74\x0a\x00\x00")
+var _templates_testInsert_ignoreGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4f\x6f\xda\x30\x14\x3f\x27\x9f\xe2\x15\x75\x93\x33\xa5\xae\x76\xa5\xe2\xd0\x3f\xdb\x84\xaa\x75\xa8\xd0\xf3\x64\x92\x97\xd4\xc2\x3c\x23\xdb\x59\x61\x91\xbf\xfb\x64\x87\x40\x98\x5a\xad\x87\x1d\x10\x49\xf4\x7b\xef\xf7\xcf\x49\xdb\x5e\x80\xac\x40\x50\x09\xfc\x1b\x12\x1a\xe1\x70\x4a\x16\x8d\x9b\xd6\xa4\x0d\x02\x23\xed\x80\x2f\xc4\x52\x21\x9f\xda\x47\x14\xe5\x0f\x52\xbb\x0c\x2e\xbc\x4f\xc3\xf0\xb9\x50\x52\x58\x18\x4f\x80\x5f\x87\x2b\xb4\x1d\xb8\x9f\x79\x10\x6b\x3c\x82\x97\xbb\xd9\x7d\xc0\x56\x42\xd9\xe3\x63\x59\xf5\xe8\xd9\x3d\xee\xfe\x42\x4f\xc0\x99\xe6\x88\x35\x82\xea\xc3\xf2\x5b\xad\x9a\x35\x59\xef\xdb\xb6\x37\x71\xdd\x38\x3d\xa5\xc2\xe0\x1a\xc9\x01\xb3\xe8\xa6\x54\xa8\xa6\x44\xe8\xa4\x9c\x0f\x98\xfa\xf9\x2c\x2c\xe8\xd9\xa2\xb4\xf0\x00\xa9\xdc\xff\x1d\xc8\x87\xd7\xb2\xea\x04\x7a\x9f\x56\x0d\x15\xe0\xd0\xba\xb6\xed\xe2\xe0\x4f\x9b\x99\x6a\x8c\x50\xde\x0f\xc3\x64\x0e\x3e\x05\x98\xa4\x9a\x2f\x32\x68\xd3\xc4\xf1\x99\x30\x42\x29\x54\x2c\x4b\xd3\xc4\x22\x96\x21\x1e\x23\xa8\xd4\x6b\xf9\x1b\xf9\x03\xbe\xcc\x11\x4b\x96\xa5\xc9\x2f\x61\x00\x4d\xfc\x69\x93\x26\x3a\x00\x3f\x0e\x18\xe7\x92\xea\x46\x09\xe3\x7d\xeb\xd3\x44\x56\x01\x08\x83\x5d\x73\x67\x9a\xc2\xb1\xc0\x91\x83\xce\xe1\x30\x7a\xa7\x5f\xe8\x38\x7c\x77\xb3\xd8\x6d\xd0\xe6\x31\xf6\xec\x2a\x6e\x39\x9b\x00\x49\x15\x04\x27\x8e\x7f\x31\x46\x9b\x8a\x8d\x9e\x28\x04\x09\x4e\x1f\x29\xe0\x55\x39\x60\x23\xf3\x18\x3e\xd8\x51\x1e\xf6\x65\x69\xe2\xd3\x34\x89\xa5\xc5\xe3\xf5\xa0\x6f\x35\x39\xdc\x3a\xef\x0b\xb7\x0d\xc6\x8a\xee\x9e\xdf\x88\x62\x55\x1b\xdd\x50\xc9\xb2\x7d\x25\x69\xd2\x41\xbe\x37\xd6\x2d\xb6\x2c\x6e\x19\x6e\x58\x6a\xa9\xf8\x0d\xd6\x92\xe2\x48\x6c\xf3\xf8\x6c\xb1\x65\x85\xdb\xe6\xc1\x4f\xbf\x30\x4b\x93\x12\x2b\x34\x10\x7a\x64\x19\xb4\xf0\x13\x26\xe0\xb6\xfc\x51\x2b\xb5\x14\xc5\x8a\x65\xe0\x63\x3f\x32\x96\x19\xf2\x0b\xa9\x8c\x27\xa0\xf9\x49\xbf\x6f\x39\xca\xe1\x70\x8e\x20\xdc\x45\x39\x53\xaa\xd0\xb0\x2c\x3b\x74\x75\x92\xf2\x57\xe1\x84\x62\x7d\x58\x01\x72\xd6\xb3\x0f\x7b\x60\xa3\x17\x41\x0e\xdc\x33\x42\x25\x8d\x75\xd0\x81\x42\x2b\xcf\x62\xb3\x41\x1a\xed\xc3\xbe\xbc\x84\x79\x38\xff\x1b\x23\xd7\xc2\xec\x60\x85\xbb\x3c\x8e\xed\x07\xa4\x05\xbb\x92\x9b\x0d\x96\x51\xcf\xa9\xd3\xff\x63\xf4\xea\x3d\x2e\xff\x61\xb2\xd0\x54\x29\x59\x38\x49\x75\x6f\x75\x2f\xbb\x37\x5a\xe8\x86\xdc\xa1\xa0\x57\xde\x48\x96\xf1\xdb\x80\x79\xa7\x89\x37\xfa\x89\x6f\xc1\x89\xf2\x48\x0c\x67\x13\xf8\xfc\x8a\x76\x4d\x08\x06\x0b\x6d\xca\x1c\x6a\xed\xc6\xa3\xbc\xc3\xc7\xe9\xc3\xc7\xe5\xf8\x99\xf1\x3e\xfd\x33\x00\x52\x3b\x24\x9b\x9b\x05\x00\x00")
+
+func templates_testInsert_ignoreGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testInsert_ignoreGoTpl,
+		"templates_test/insert_ignore.go.tpl",
+	)
+}
+
+func templates_testInsert_ignoreGoTpl() (*asset, error) {
+	bytes, err := templates_testInsert_ignoreGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/insert_ignore.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xba, 0x7d, 0x4a, 0xd5, 0x41, 0xec, 0x1a, 0x9d, 0x7f, 0x29, 0xc0, 0x93, 0xc2, 0x5a, 0xce, 0xe1, 0xa9, 0x91, 0x5d, 0x8d, 0xb8, 0x35, 0x26, 0x55, 0x89, 0x81, 0xd3, 0xe4, 0xcb, 0x38, 0x5f, 0x36}}
+	return a, nil
+}
+
+var _templates_testLoad_by_keysGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\xdb\x38\x10\x3d\x8b\xbf\x62\x5a\x34\x0b\xaa\x50\x95\x34\xbd\xa5\xf0\x21\x1f\xbb\x8b\x20\xbb\xd9\x20\x76\xb1\x07\xc3\x08\x68\x71\x64\x73\x45\x93\x06\x49\xc5\x56\x15\xfe\xf7\x05\x69\xd9\x96\x53\xf7\xe3\x10\x20\x1e\x0e\x1f\xdf\xbc\x79\x33\x6a\xdb\x0f\x20\x4a\x60\x8a\x03\x55\xda\x41\x3e\x62\x53\x89\xf9\xad\x7d\x44\xc6\xff\x51\xb2\x49\x81\x4a\xcd\xf8\x55\xf3\x60\xc4\x82\x99\xe6\x0e\x9b\x2e\x29\x85\x0f\xde\x93\x00\xf0\x8e\x49\xc1\x2c\x5c\x0c\x20\xbf\x0c\xff\xa1\xdd\x64\x6c\xd1\xee\xd9\x02\xf7\xc9\xcb\x2a\x64\x0a\xc5\x71\xbd\x4d\x78\xb8\xc3\x26\xbf\xd6\xb2\x5e\x28\x0b\x67\xf0\xd2\x21\x76\x21\xf0\x9e\x94\xb5\x2a\xc0\xa1\x75\x6d\xdb\x9d\x7d\x59\x3e\xc8\xda\x30\xe9\xfd\x5f\x91\xdf\x1d\x36\x96\x3a\x78\x1f\x92\x84\x9a\xe5\xa3\x14\x5a\x92\x9c\x9e\xc2\xbd\x76\xb0\x64\x86\x49\x89\x32\x83\x90\x7c\x3d\xaf\x55\x35\x14\x5f\x11\x84\x85\x62\xce\xd4\x0c\x39\x38\x0d\x76\x29\x85\x03\x37\x47\xa8\xb0\xb1\x84\x24\x16\x91\x07\xb2\x86\x29\xae\x17\xe2\x2b\xe6\xf7\xb8\x1a\x22\x72\x9a\x92\xe4\x99\x19\x40\x13\xff\xb4\x21\x89\x9e\xfe\x17\x25\x58\xb0\x0a\x69\x8f\xe5\x50\xa8\x59\x2d\x99\xf1\x7e\x28\x45\x81\x19\x7c\x4a\x49\x52\x6a\x03\xa2\x43\x9e\x21\xc4\xbb\x2d\x49\x22\xc8\x58\x4c\x60\x00\xbf\x1d\x85\x68\x3d\x49\x12\x51\x86\x37\xa1\x47\x6b\xe8\x4c\x5d\x38\x1a\xe8\x66\xd0\x61\x64\xb0\x43\xb8\xd1\x2b\xb5\xc7\xb8\xb9\x1a\x35\x4b\xb4\x19\x38\x53\xe3\x77\xb3\xba\x6e\xfc\x2b\xdc\xfc\x06\x4b\x56\x4b\x97\xe7\x79\xfa\x39\xbe\xfc\x66\x00\x4a\xc8\x20\x6f\x92\xb8\xfc\x77\x63\xb4\x29\xe9\xdb\x2f\x2a\xf6\xdc\xe9\x3d\x2f\x38\x5a\x04\xd8\x48\xf7\x02\x4e\xec\xdb\x2c\x00\xa6\x24\x49\x3c\x49\x3c\x21\x49\xdb\x8a\x12\xa2\x11\xef\xf5\xb5\x56\x0e\xd7\xce\xfb\xc2\xad\x83\x56\xc5\xe6\x77\x7e\xc5\x8a\x6a\x66\x74\xad\x38\x4d\xdb\x16\x15\xf7\x9e\x24\x9b\x94\xbf\x6b\xeb\x46\x6b\x1a\x51\xfa\x08\x53\x2d\x64\x7e\x85\x33\xa1\xe2\x15\x69\xb1\x1f\x1b\xad\x69\xe1\xd6\x59\x28\x6a\x0b\x98\x92\x84\x63\x89\x06\x82\xf1\x68\x0a\x2d\x3c\xc1\x00\xdc\x3a\x7f\xd4\x52\x4e\x59\x51\xd1\x14\x3c\xed\x3a\xf9\x94\x81\x3e\xd2\xcc\x5d\x9f\x74\x7e\xab\x2c\x1a\x47\xbf\x57\x5d\xe8\x02\x2a\x1e\x66\x04\x02\x91\x48\xed\x56\x95\x68\x68\x7a\x54\xf3\x3f\x98\x63\x92\x1e\x4a\x77\x7a\x0a\xa3\x39\x02\x47\x89\x0e\x39\x18\xbd\x02\x89\xec\x19\x2d\x30\x98\xb1\x25\x08\xb5\xb7\x76\xf0\x50\x9f\xcc\xa3\x5e\xd9\xcb\xb2\xc4\xc2\x21\xf7\xfe\xa9\xe3\xe3\x7d\xc7\x3f\x38\xea\xe3\x24\xbf\x89\xd0\xbf\x58\x45\xcc\x0a\x7b\x25\xbf\xe4\x7c\xa8\x4b\xb7\xb9\x6d\xb7\x33\x7f\xcd\xd4\x3e\xea\xfd\xc6\x8e\x5b\xf5\xbf\xa9\xf9\xb0\x64\x4f\x92\x30\xa1\x41\xf3\xf1\xa4\x6d\x69\x07\xf9\x27\xba\x6e\x63\xd0\x1f\xac\x97\x34\xcd\xc3\x00\x78\xdf\x86\x4e\x8d\xcf\x26\x79\xdb\xbe\x5b\x56\x81\xc2\xb6\xd0\xc3\xc0\xf9\xeb\xc0\xfe\x8a\x27\x07\x3e\xb1\x71\xa7\x28\x17\xfc\x72\xb8\x68\x06\x10\xcf\x3c\x3d\x08\xef\xfd\x13\x4f\x77\x16\x1a\x4f\x84\x72\xed\x59\x06\x1f\x33\x38\xcf\xe0\x93\x8f\x1a\x1c\x43\x24\x24\x49\xca\x30\x0a\x71\x90\x82\x20\x47\x47\xae\xb7\x22\x7f\xad\x7b\x59\xf4\x49\xba\x77\xf1\x8f\xfd\x17\xfc\x24\x51\xd1\x48\x25\x0d\x66\x3d\x7f\xbd\x1e\x8a\xb0\x74\x2d\xe8\x12\x4e\xf8\x05\xac\x98\x72\x70\x1e\x4c\x6a\x33\x98\x69\x77\x01\x27\xfc\xed\x46\x86\xac\x87\xd4\x83\x0f\x43\x16\xbf\x1c\xf1\x8d\xf1\xab\x56\x4d\x3e\x87\xd3\x9f\x3f\xe9\x7a\x13\x52\x61\x03\x6c\x6a\x51\xb9\xee\xe5\xed\x6b\xdf\xce\xf4\x78\xf2\xfe\xa8\xae\x5b\x0b\xed\x9c\xb2\xe9\x54\x90\x63\xa6\xdd\x2b\xc6\x3d\xae\x6f\x74\x05\x2f\x2f\xa1\xf0\x6d\x30\x68\xa6\x77\x3f\x22\xc8\xcf\x2b\x09\x33\x5e\x61\x83\x1c\x4e\x9e\x77\xea\xed\x50\x42\x39\xb1\x39\x71\xb7\x6e\x3e\xd5\xa8\xb8\xf7\xe4\xff\x01\x00\x7f\x8b\x14\xc2\xf6\x07\x00\x00")
+
+func templates_testLoad_by_keysGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testLoad_by_keysGoTpl,
+		"templates_test/load_by_keys.go.tpl",
+	)
+}
+
+func templates_testLoad_by_keysGoTpl() (*asset, error) {
+	bytes, err := templates_testLoad_by_keysGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/load_by_keys.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6, 0x14, 0x4e, 0x5e, 0x9, 0x72, 0xa7, 0x36, 0xde, 0x9f, 0xd1, 0xbd, 0x68, 0x5a, 0x84, 0x12, 0x1c, 0x94, 0x6f, 0xde, 0x3a, 0x56, 0x69, 0x1f, 0x27, 0x4e, 0x31, 0x18, 0xf1, 0xe5, 0xdd, 0xb9}}
+	return a, nil
+}
+
+var _templates_testProjectionGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x5d\x6f\xdb\x2c\x14\xbe\x36\xbf\xe2\xbc\x51\xdf\x09\x26\x17\x69\xb7\x9d\x7a\xd1\x8f\x5d\x74\xd2\xb2\xa8\x49\xb5\xcb\x89\xd8\xc7\x1e\x2d\x39\x44\x80\x17\x77\x88\xff\x3e\x41\xb2\x26\xd9\x9a\x69\x17\x96\x00\x3d\x5f\xe7\x01\xc7\x78\x0e\xba\x03\xb2\x01\xb8\x75\x20\x17\x6a\x69\x50\xde\xf9\x8f\x56\x53\x59\xef\x8f\xee\x51\xb5\x9f\xc9\x3c\x0b\x38\x4f\x89\x65\xe2\x99\x32\x5a\x79\xb8\xb8\x04\x79\x95\x57\xe8\xe5\x11\x67\xaa\x56\xb8\x83\x3a\x45\x3d\xc2\xd9\xda\xd9\xc7\x82\x2f\x80\x99\xb3\x8f\xd8\x04\x6d\xc9\xff\x46\xe9\x06\x6a\x20\xa0\x0f\x31\x6e\x4d\xe4\xc3\x7a\x66\x06\xa7\x4c\x4a\x57\x3e\xc6\x22\xb4\x03\xf3\x00\x6f\x33\x54\x53\x2f\x17\x02\x22\xab\x82\x9c\x29\xa7\x8c\x41\xc3\x05\x63\x95\x47\x6c\xb3\xa9\x53\xd4\xda\x95\xfe\x81\x72\x8a\x9b\x39\x62\xcb\x05\xab\xbe\x2b\x07\xe8\xca\x67\x1d\xab\x6c\x06\xbe\x39\x70\x9d\x6b\xea\x07\xa3\x5c\x4a\x31\xb1\x4a\x77\x19\x08\x07\x5a\xf3\xe0\x86\x26\xf0\xec\x51\x83\xad\xe1\x85\x7a\x6b\x37\xb4\x27\xdf\x5e\x2f\x9e\xd7\xe8\x6b\x08\x6e\xc0\x93\xa8\x1b\x6b\x86\x15\xf9\x2f\x3a\x7c\xbb\xc5\x4e\x0d\x26\x48\x29\xc5\xfb\xe2\xf9\xdf\x25\x90\x36\x79\xbc\x2a\xc8\x0f\xce\x59\xd7\xf1\xc9\x03\xe5\x1e\x21\xd8\x7d\x20\x78\x35\x3c\xf8\x92\xf3\x02\xfe\xf7\x93\x3a\xeb\x09\x56\x25\xc6\xaa\x18\x77\xb7\x7f\x26\xa7\xf6\xc6\x52\xc0\x31\xa4\xd4\x84\x31\xf7\xd0\x6c\xf7\xf2\x5a\x35\x4f\xbd\xb3\x03\xb5\x5c\xc4\x88\xd4\xa6\xc4\xaa\x2d\xe4\xd3\xe0\xc3\x62\xe4\x45\xe6\x48\x62\x69\xb5\x91\xd7\xd8\x6b\x2a\x1c\xe3\xf1\xf0\x6c\x31\xf2\x26\x8c\x75\x9e\xe8\x97\xa2\x60\x55\x8b\x1d\x3a\xc8\x77\xcf\x05\x44\xf8\x0a\x97\x10\x46\x79\x6f\x8d\x59\xaa\xe6\x89\x0b\x48\x5c\x1c\xdc\x81\x95\x77\xe4\xd1\x05\x7e\x72\x88\x5c\x34\x52\x9b\x1f\x2c\xe4\x5d\x09\x70\x47\x1d\x3a\x2e\x4e\xd6\xca\xf7\xed\x78\xa3\x1b\x2c\x75\xe5\x59\x5f\x79\x8b\x5c\xc8\x3f\x9e\xe3\x3f\xa6\xd9\x4f\xf2\xd7\x08\xba\x03\x83\xc4\x4b\x12\x91\xd3\xbe\x3b\x02\x4e\x36\x8a\x02\x58\x42\x70\xd8\x58\xd7\xd6\xd0\xdb\x70\x31\xa9\x0f\x48\x45\x28\xb1\x17\xef\xf2\x3f\x22\xb5\x70\x9e\x12\xfb\x39\x00\xd4\x69\x3e\x5f\x00\x04\x00\x00")
+
+func templates_testProjectionGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testProjectionGoTpl,
+		"templates_test/projection.go.tpl",
+	)
+}
+
+func templates_testProjectionGoTpl() (*asset, error) {
+	bytes, err := templates_testProjectionGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/projection.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe5, 0xab, 0xe2, 0x81, 0x1b, 0x6b, 0xc6, 0x10, 0x44, 0xca, 0x98, 0x9e, 0x2, 0x97, 0xaf, 0x48, 0x8f, 0x63, 0x66, 0x30, 0xcb, 0x63, 0x6, 0xa1, 0xf3, 0x3c, 0x89, 0xd6, 0x23, 0x88, 0xd6, 0xda}}
+	return a, nil
+}
+
+var _templates_testRelationship_one_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x41\x4f\xec\x36\x10\x3e\x6f\x7e\xc5\x80\x02\x72\x56\x79\xe6\x4e\xc5\xe1\x01\x0f\x89\x8a\x42\x05\x8b\x7a\xa8\xaa\xca\x9b\x4c\xb2\x2e\xc6\xe6\xd9\x0e\xec\xab\x95\xff\x5e\xd9\x49\x36\x09\x9b\x7d\xec\x05\xa9\x97\xd5\x26\x33\xf3\xcd\xf7\xcd\x8c\xc7\x71\xee\x0b\xf0\x02\xe8\x82\x2d\x05\xd2\x6b\xf3\xab\xe2\x32\xfc\x87\x2f\x75\x1d\x79\x2b\x0a\xd3\x3c\xcc\xfc\x93\x66\xb2\x44\x88\x35\x0a\x38\x3d\xeb\xc2\x16\xea\x4e\xe2\x3d\x0a\x66\xb9\x92\x66\xc5\x5f\x4c\x1f\x70\x32\x07\xbb\x42\xd0\xde\x8a\x39\x68\xf5\x66\x20\x63\x12\x94\x14\x3f\x60\x89\xc0\xa5\x41\x6d\x31\x07\x2e\xad\x82\x37\xcd\x6d\xc8\x1e\x7e\x0d\xcc\x4f\x7a\x24\x5e\x80\x54\x16\x48\x4c\xef\x91\xe5\x77\x3e\xde\xf3\xa0\x57\x4a\x23\x2f\x1b\xd6\x49\xe3\x1e\xa8\xc6\x22\x80\x78\x9e\x31\xfd\x2a\x38\x33\x68\x1a\xc2\x81\x7f\xfb\x77\xe0\x5f\xfc\xdc\x7f\x98\x67\x98\x46\xa3\x08\xe8\x21\x51\x83\x41\x87\xc5\x08\x1e\xf4\x96\x3d\x8f\xa2\x2a\x83\xe6\x77\xcd\x9f\xb9\xe5\xaf\x18\x62\xdf\xbd\x89\x9b\xdc\x66\x48\x36\xfc\xbd\x50\xa2\x7a\x96\x13\x9c\x86\x6f\x5a\xa7\x41\xc2\x4c\x89\x2b\x8e\x22\xf7\xa9\xda\xd2\x8c\xa0\xb6\x23\x8a\x51\x48\xb1\x1d\x32\xce\x55\xd7\x51\x51\xc9\x0c\x2c\x1a\xeb\x5c\x97\xe2\xf1\xe5\x81\xcb\xb2\x12\x4c\xd7\xf5\x9d\xc4\x30\x2a\xce\xc5\xc5\xb6\xf5\xd1\x70\x59\x3a\xb7\xa9\x27\xbd\x51\x19\x13\x75\x4d\x2c\xcc\x3d\x26\x97\x25\x5d\x24\xe0\xfc\x34\xb4\xb3\x10\xd3\x5b\x75\xa1\xa4\xc5\xb5\xad\xeb\xcc\xae\x3d\xd1\xac\x79\xa6\xe7\x2c\x7b\x2a\xb5\xaa\x64\x4e\x12\xe7\x50\xe6\x5e\x58\xe3\xf2\x5b\x65\xec\x62\x4d\x02\xcc\x08\x62\xa9\xb8\xa0\xe7\x58\x72\x19\x62\x84\xc1\xe1\xbb\xc5\x9a\x64\x76\x9d\x82\xe4\xa2\x43\x4c\xa2\x59\x8e\x05\x6a\xf0\xca\x49\x02\x0e\xfe\x86\x33\xb0\x6b\x7a\xaf\x84\x58\xb2\xec\x89\x24\x50\x93\x24\x8a\x66\xaf\x4c\x43\xd1\xd4\x0b\xa6\xf5\x37\x3e\xc2\x8b\x86\xe9\xfa\x45\xd1\xcc\x20\x86\x0e\x6a\x26\x73\xf5\xcc\xff\x45\x7a\x8b\x6f\x0f\x88\x39\x49\xa2\x19\x2f\x00\xb5\x1e\x99\x1f\xac\xae\x32\x4b\x7c\x58\x0a\xc7\x2d\x81\x74\xc0\xe0\x52\xbd\xc9\x3e\xc3\xe5\xf9\xe2\xc7\x0b\x9a\x14\xac\xae\x70\xb7\x5b\x33\x2b\xe6\x0f\x6e\x57\x97\x58\xb0\x4a\x58\x4a\x69\xf2\x4b\xc8\x7e\x70\xe6\x0b\xe4\xdb\x34\xb3\xf4\x9b\xd6\x4a\x17\xe4\xf0\x51\xfa\x64\x60\x55\xcf\x6c\x47\x15\xc0\x04\xc6\xa7\x70\x64\x0e\x53\x0f\x98\x44\xb3\x7a\x0f\x69\xa1\x6e\xe9\xa0\x70\x1f\x09\x13\x9f\x29\x4c\xec\x2b\x6c\xa8\x2c\x48\xa0\xd7\x61\x21\x92\x9d\x33\xee\x35\xa2\xcc\xfd\x41\x05\xff\x14\xe6\xf3\x5a\x16\xa8\x49\x32\xc5\xf4\x8a\x59\x26\x48\x9f\x2f\x00\xfb\x43\x46\xaf\xcd\x85\x7a\x7e\x51\x86\xdb\x76\x33\x39\xd7\xae\x77\x9e\x42\x9c\x79\x4a\xdb\xc7\xbc\x5d\xed\xdf\x2b\xd4\x1c\x0d\xfd\x6a\x0c\x2f\x25\xe9\x26\x8b\x3a\xf7\x7e\x51\x64\x75\x9d\xb6\xd2\xfa\xc2\xb4\x46\xc2\x65\x8e\xeb\xe1\xfe\x31\x10\xf3\x24\x9c\xab\x8d\x4a\x4f\x39\x5c\x42\xbc\xd8\x5a\x9b\xc1\x3c\xcc\xdd\xad\xac\xba\x86\xae\xa0\xce\xc5\xfd\xdb\x0d\xd8\x87\x2a\xfa\x98\x74\x0a\x68\xcc\xb0\x6f\x62\x07\xf1\xd9\x6d\xcc\x56\x98\x3d\xa5\xe3\xd1\x99\x5a\x9e\x09\xbd\x93\xb8\x2f\x8d\x7e\x87\xec\x31\x42\x53\xad\xe0\x05\x04\x62\xef\x7b\x71\x70\x06\xd3\xb5\x05\x37\xee\x08\x2f\xe0\xa0\xeb\xca\xb7\xef\x15\x13\x64\x0a\x2f\xdd\x81\xd6\xde\x0e\xad\xa0\xd1\x41\x7d\x63\xd2\x9e\xc2\xd1\x6b\x0a\xa5\xb2\x70\xf4\x7a\xb8\x0b\x23\x9d\x54\xd0\x2a\x37\x82\x67\xe1\x0b\x61\xfa\x8c\x3f\x78\xb3\x3b\x0e\xe3\xd2\x4f\x45\xd7\x9e\x1b\x7a\xa3\x58\x3e\xd5\xa4\xbd\xa7\xa4\x60\xc2\x60\x0a\x64\xfe\xe7\x5f\xf3\x69\x0a\x09\x39\x0e\x24\x93\xe6\x9e\xfa\x70\x92\x3c\xc9\x86\xde\x3d\x9d\xa0\x06\x67\xc3\xd0\xb0\xf5\xc8\x61\xb3\xc9\xc0\xac\x54\x25\x72\x58\xb1\x57\x84\x25\xa2\x04\x64\x25\xfa\x1b\x8c\xe5\x98\x1f\xb6\x15\xfb\x29\xb6\x87\xfe\x8c\x32\x35\xb7\x57\x77\x27\xfc\x0f\xea\x50\x47\xd1\x86\x61\xfb\x55\xdc\x4a\xd1\xc8\xf2\xe6\x63\xb8\xfd\xd6\xf5\xe6\x8d\xe7\xc9\xbc\xfd\xe2\x9e\x9f\x4c\x98\xfe\x51\x5c\x82\x65\x4b\x81\x30\x3f\xa9\xeb\xe8\xbf\x01\x00\xd2\xa0\x3d\x58\xcd\x0b\x00\x00")
 
 func templates_testRelationship_one_to_oneGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -769,11 +1296,11 @@ func templates_testRelationship_one_to_oneGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/relationship_one_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5, 0x16, 0xc7, 0xd, 0x8f, 0xc7, 0x1e, 0xbc, 0x75, 0x7f, 0xb3, 0xe9, 0x79, 0xeb, 0xba, 0xcf, 0x7b, 0xff, 0x8e, 0x9e, 0x18, 0xd4, 0x77, 0x3c, 0x92, 0xbc, 0xdf, 0xeb, 0x21, 0xac, 0xf5, 0x3d}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x91, 0x49, 0x41, 0x42, 0x37, 0xb9, 0x1e, 0x84, 0x6c, 0x73, 0x6f, 0xde, 0x4, 0x86, 0x15, 0xdf, 0xf0, 0xff, 0xd0, 0x44, 0x3, 0x61, 0xc8, 0x8e, 0xb7, 0xef, 0x36, 0x7, 0x35, 0x8d, 0xbc, 0xa3}}
 	return a, nil
 }
 
-var _templates_testRelationship_one_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x6f\xdb\xb6\x17\x7d\xb6\x3e\xc5\xad\x11\xa4\x52\xa0\xaa\xbf\xe7\xfe\x90\x87\xd6\x69\x80\x6c\x6b\x52\x38\xe9\x06\x6c\x18\x0a\x5a\xba\x52\xb9\xd2\xa4\x4b\x52\xb1\x52\x55\xdf\x7d\xb8\xa4\x64\x4b\xb6\x92\xb9\x2b\xf2\xb0\xad\x0f\x01\x2c\xe9\xfe\x39\xe7\xde\x4b\xf2\x30\x75\xfd\x0c\x78\x0e\xc9\x0d\x5b\x08\x4c\x2e\xcc\x0f\x8a\x4b\xf7\x1b\x9e\x35\x4d\x40\x5f\x51\x18\xff\x30\xa1\x27\xcd\x64\x81\x70\xa4\x51\xc0\x8b\xd3\xce\xed\x46\x5d\x49\x9c\xa3\x60\x96\x2b\x69\x3e\xf0\x95\xf1\x0e\xce\xe3\x48\x58\x17\xef\xc5\x29\x1c\x25\x2f\x05\x67\x06\x8d\xf7\x73\x61\x92\x6d\xb6\xd6\x3e\x7f\xd8\xfe\x5c\x69\xe4\x85\xdc\x73\xd3\x28\x9c\xb5\x73\xf4\x31\x92\x3e\x26\xef\x7d\xc9\x96\x03\xaf\xd2\xa0\x79\xab\xf9\x92\x5b\x7e\x8b\xce\x77\xe7\xcd\x91\xcf\x6d\xfa\x60\xdd\xcf\x99\x12\xe5\x52\x8e\x60\xea\xbf\x69\x8d\x7a\x09\x53\x25\xce\x39\x8a\xcc\xc1\xf4\xa5\x19\x84\xda\xf7\xc8\x07\x2e\xf9\xbe\xcb\xbd\xb9\x72\xff\xe1\xed\x8f\x78\x37\x53\xc2\xb1\x0b\x0b\xb4\x2d\xcc\x8e\xd8\x00\x7d\x94\x90\x75\x1b\xde\x40\x0f\x37\x93\xd7\x2a\xb7\x67\x28\xd0\xe2\x61\x91\x66\x03\x97\xa6\x09\xf2\x52\xa6\x60\xd1\xd8\xba\xee\xa8\xbf\x5b\x5d\x73\x59\x94\x82\xe9\xa6\xb9\x92\xe8\x26\xe9\x1a\xed\xd5\xaa\xae\x3b\xaa\x7d\x93\x77\x86\xcb\xa2\xae\x37\xcd\x4e\x7e\x52\x29\x13\x4d\x13\x5a\x38\xa1\xc0\x5c\x16\xc9\x4d\x04\x75\x30\xb9\x65\x1a\x50\xbb\x3f\xa5\x03\x1a\x5e\x9e\x83\x54\x16\x8e\x92\x4b\x35\x53\xd2\x62\x65\x9b\x26\xb5\x15\x71\x49\xfd\x73\xf2\x8a\xa5\x1f\x0b\xad\x4a\x99\x85\x51\x5d\xa3\xcc\x88\xbf\x37\x79\x53\x1a\x7b\x53\x85\x2e\xcc\x20\xc4\x42\x71\x91\xbc\xc2\x82\x4b\xe7\x23\x0c\xf6\xdf\xdd\x54\x61\x6a\xab\x18\x24\x17\x5d\xc4\x28\x98\x64\x98\xa3\x06\x2a\x47\x18\x41\x0d\xef\xe1\x14\x6c\x95\xcc\x95\x10\x0b\x96\x7e\x0c\x23\x68\xc2\x28\xf0\x1c\x18\x8c\x17\xcb\x7f\x5d\xc4\x90\xc2\x78\xa9\x82\x60\x62\x10\xdd\xd0\x68\x26\x33\xb5\xe4\x9f\x31\xb9\xc4\xf5\x35\x62\x16\x46\xc1\x84\xe7\xae\x3e\xfd\xaf\xd7\x56\x97\xa9\x0d\xc9\x2d\x86\x63\x16\xf7\x52\x9f\xa9\xb5\xdc\xc6\x3e\x7b\x75\x73\xb7\x42\x13\x43\xce\x84\xc1\x18\x8c\xd5\x4b\x26\x0b\x81\xc9\x35\xda\x99\x5a\xae\x04\x2e\x51\xda\xf0\x3e\x7f\x5a\x5f\x4c\xdf\xf9\xb9\xa4\x41\xbb\x3f\x55\x6b\xf0\x0b\xb7\x1f\x54\x69\xcf\x30\x67\xa5\xb0\x51\x92\x24\xd1\xff\x1d\xfe\x27\xa7\x54\x5b\xea\xf8\xc4\x26\xe7\xcc\x32\x11\xa2\xd6\x51\x30\x69\x0e\xa0\xb8\x88\x7b\xc5\xfb\xdb\x14\x47\xfd\xc7\x29\x8e\x9a\x3e\x2a\xc5\xf4\x9f\x4e\x71\xc3\xf1\xc5\x29\xb0\xe4\x42\x1a\xd4\x36\xbc\x77\x35\x13\x04\x94\x19\xed\x82\x40\x4f\x6e\x25\x5e\xc8\x1c\x75\x18\x7d\x4d\x39\x17\x8f\x9c\x29\x98\xe4\x4a\x03\x8f\xa1\x6a\x17\x68\x81\xf0\xdb\xef\x27\xe3\x4b\xb9\xa6\x41\x3d\x4e\x1b\x17\xc9\xe3\x63\xd4\xa3\xb1\x8d\xf0\x60\xbc\x9c\xe0\xfd\x2f\x86\x2a\x0a\x26\x1d\xef\x1e\xe0\x1d\xc4\x0e\x32\x99\xb1\x64\x9e\x8c\xe4\x25\xd7\xaa\x73\x7c\x4d\x7b\x6e\x38\xd5\xfd\xd3\xd7\xb8\xa9\x74\xc8\x0c\x5a\xb0\x0a\x52\xa5\x35\xa6\x16\x6e\x99\x28\x71\xea\x73\xb8\x14\xd5\x4e\x8a\xf6\x54\xf1\x49\x8e\xd9\x4e\x96\x9c\x71\x81\x19\x05\x64\xab\x15\x11\xb4\x0a\xda\x83\x0f\x46\x10\x4c\x37\x64\xfc\x56\xbe\x73\xdc\xfb\xd3\xd3\xf1\xac\xeb\xcd\x61\xdd\xf2\xa3\x57\x79\xef\x5d\xed\xa2\x6c\x05\x12\xf9\x3d\xf9\x54\xa2\xe6\x68\x92\xd7\x9f\x4a\x26\xc2\x9d\x30\xf1\x5e\x90\xa8\x8b\xe2\x5b\x33\xa4\xd6\xd2\xf8\x88\x77\xb0\x66\x06\xd6\x5a\xc9\xa2\xad\x57\xbc\x8b\x70\xc8\xcb\xa0\xbd\x90\xa9\x28\x33\x84\x1d\x7d\xb0\xa7\x0a\x36\xd0\xb1\xe2\xc6\x9a\xb8\x5b\x6c\xe3\xb3\xf8\xda\x19\x1d\x3e\x66\x9e\xef\x4e\xca\x2f\xd4\x0c\x2e\x8b\x37\x6c\x05\x47\xb4\x61\x71\x59\x9c\x97\x32\x35\x89\xe5\x56\xe0\x8c\x19\x84\x2f\xf0\x87\xe2\x12\xa6\x14\x62\xda\x34\xfb\x4b\x6a\x6f\x42\xbd\x54\xa5\x1e\x78\x26\x3b\x83\xb2\x66\xd2\xc2\xd3\xea\x29\x0d\x88\x33\xd8\xcc\xdc\xa0\x87\x9f\x51\x2b\xb7\x26\x31\x17\x98\xda\xe4\x57\xd4\x2a\xec\x1e\x68\xc3\xbc\xca\xc3\xbd\x26\x52\xa4\xce\xe6\x42\x66\x9c\x06\x7b\xe3\xf4\x33\x35\xec\x2a\x0f\x8f\xf7\xdd\x68\x15\x87\x94\x31\x0a\xb6\xab\x90\x26\x6d\x8e\x42\xb1\xec\xd0\x32\x3f\x50\x9c\xde\xfa\xd0\x2e\xe6\xd4\x35\x78\x4b\xfd\x19\x78\x9d\xf3\xef\x5b\x11\x23\xa1\x7b\x1b\xd9\xa0\xb4\x73\xb5\x36\x2f\xf3\x1c\x53\x8b\x59\xd3\xbc\xef\x57\xb7\xeb\x88\x97\xb1\x87\x76\x04\xda\x8b\x15\x93\x19\x5d\x63\xb2\x6c\xab\x84\xcd\x8e\x98\x26\xa0\x56\x97\xd8\xc9\xc3\x83\x7a\x99\x79\x51\x5d\x0d\xba\xd9\x04\xfe\xca\x46\x5d\xdc\xbb\x18\x5c\x96\x42\xd0\x6a\xa6\x56\x1f\x28\xc4\xe7\xb8\x54\xb7\xf8\x5d\x8b\x1f\xa8\xc5\xbf\x0b\xf1\xff\xae\x10\xef\x71\x7c\x6c\x91\x3a\x48\xf5\xad\x2a\x90\x36\x1e\xaa\xff\x57\xa6\xf5\x3b\xc3\x37\x65\x1e\xcf\xb9\x27\xea\xb4\xcb\x34\x50\x72\xd3\x16\x4f\xaa\x4a\x69\xe3\xed\xdd\x60\x0c\x4e\x94\xcc\xc8\xea\xe0\x83\x34\x18\x91\xc2\x1d\xaa\xfe\x38\xbb\xdc\x4e\x41\x0f\x81\x3b\x91\x21\xd5\x00\xaf\x21\x12\x8c\x4b\x2e\x8b\xe9\xb6\x94\x0f\x08\xe9\xbd\x72\xcc\x3b\xf9\x8c\xd2\xea\x3b\x30\x1f\x54\x29\x32\x58\x20\x59\xf6\x42\x6e\x4e\xda\x0b\xe3\x34\x87\xbe\xe4\x22\x5c\x8c\x1e\xaf\xa3\x27\x6a\xea\x15\xe2\x7d\xe1\x17\x0f\xe9\xf2\xbf\x6e\x21\x83\x5c\xab\x25\x2c\x9e\x9a\x61\x75\x7c\x06\x3a\xb9\xda\x3e\xd4\xf5\xf3\x13\x12\x25\x74\x8e\xf5\xe1\xc9\xf6\x04\x83\x93\xe7\xdd\xff\x26\x7b\x0e\xfe\x0e\x35\xfa\xc9\x09\x49\xbb\x75\xfd\x33\x00\x00\xff\xff\x39\xa7\x87\xa5\xf5\x14\x00\x00")
+var _templates_testRelationship_one_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5d\x6f\xdb\x3a\x12\x7d\xb6\x7e\xc5\x34\x30\x52\xc9\x70\x99\x3e\xb7\xc8\x43\x9b\x34\x40\x76\xb7\x49\x61\xa7\xbb\xc0\x2e\x16\x05\x2d\x0d\x5d\x6e\x68\xd2\x25\xa9\xc4\xa9\xaa\xff\xbe\x18\x52\xb2\x25\xdb\x69\xdd\x5b\xf4\x02\xf7\xde\x3e\x24\xf0\xc7\xcc\x99\x73\x66\x86\x9c\xb1\xaa\xea\x19\x48\x01\xec\x86\xcf\x14\xb2\x4b\xf7\x37\x23\x75\x78\x0d\xcf\xea\x3a\xa1\x6f\x51\xb9\xf8\x66\x40\xef\x2c\xd7\x73\x84\xa1\x45\x05\x2f\x4e\x5b\xb7\x1b\x73\xad\x71\x82\x8a\x7b\x69\xb4\xfb\x28\x97\x6e\xe3\x70\x32\x02\x87\xde\x2c\xc1\xa3\xf3\x0e\x8c\x56\x0f\x90\x9b\x3b\xb4\xe0\xa4\x9e\x2b\x84\xdc\xa8\x72\xa1\xe1\x16\x1f\xdc\x18\xb8\x2e\xa2\xc9\xbd\x95\x1e\xc1\x9b\xf0\x22\xf0\x09\xff\x1d\x8c\x4e\x36\xd8\x52\x04\x87\x54\x1b\x1f\x28\xb1\x4b\x77\x66\x16\x4b\xe3\xa4\xc7\x2c\x7e\x9c\x0e\xd9\x04\x79\x71\x4d\x98\xc1\xe4\xc2\x58\x94\xf3\xa8\x31\xcb\x22\x56\x50\x36\x54\x21\x02\xc9\x1a\xb2\x57\x4a\x72\x87\x2e\xea\x8b\xd8\x9b\xac\x34\xf6\xe2\xeb\xf6\xdd\x40\x5d\x37\x8b\x2a\xa0\x87\x40\x11\x83\x75\x73\x17\x2c\xd8\x15\x5f\xf4\xbc\x4a\x87\xee\x9d\x95\x0b\xe9\xe5\x1d\x06\xdf\xad\x4f\x86\x31\xb6\xeb\x92\x0d\x2f\xcf\x62\x7e\x77\x39\x75\x3f\x69\x8c\x3a\x01\x73\xa3\x2e\x24\xaa\x82\x42\x35\xa9\xe9\x41\xed\x7a\x88\x9e\x8b\xd8\x75\x79\x34\x96\x88\x5f\xbc\xfb\x3b\x3e\x9c\x19\x15\xd4\xa5\x73\xf4\x0d\xcd\x56\x58\x8f\x7d\xc6\xc8\xba\x81\x77\xb0\xc1\xca\xb9\x9e\x1a\xe1\xcf\x51\xa1\xc7\xc3\x90\xce\x7a\x2e\x75\x9d\x88\x52\xe7\xa1\x5f\xab\xaa\x95\xfe\x7e\x39\x95\x7a\x5e\x2a\x6e\xeb\xfa\x5a\x63\xe8\xf8\x29\xfa\xeb\x65\x55\x0d\xc5\xae\xc9\x7b\x6a\xee\xaa\x5a\x17\x9b\xfd\xc3\xe4\x5c\xd5\x75\xea\x61\x44\xc0\x52\xcf\xd9\x4d\x06\x55\x32\xb8\xe3\x16\xd0\x86\x3f\x63\x13\xea\x6b\x29\x80\x3a\x77\xc8\xae\xcc\x99\xd1\x1e\x57\xbe\xae\x73\xbf\x22\x2d\x79\x7c\xcf\x5e\xf3\xfc\x76\x6e\x4d\xa9\x8b\x34\xab\x2a\xd4\x05\xe9\x8f\x26\x6f\x4b\xe7\x6f\x56\x69\x80\xe9\x41\xcc\x8c\x54\xec\x35\xce\xa5\x0e\x3e\xca\x61\xf7\xb3\x9b\x55\x9a\xfb\xd5\x18\xb4\x54\x2d\x62\x96\x0c\x0a\x14\x68\x81\xd2\x91\x66\x50\xc1\x07\x38\x05\xbf\x62\x13\xa3\xd4\x8c\xe7\xb7\x69\x06\x75\x9a\x25\x51\x03\x87\xfd\xc9\x8a\xdf\xce\xc6\x90\xc3\xfe\x54\x25\xc9\xc0\x21\x86\x3e\xb3\x5c\x17\x66\x21\x3f\x23\xbb\xc2\xfb\x29\x62\x91\x66\xc9\x40\x0a\xca\x0d\x74\xbe\x9d\x7a\x5b\xe6\x3e\x25\xaf\x31\x1c\xf3\x71\x27\xf2\xb9\xb9\xd7\x1b\xe8\xf3\xd7\x37\x0f\x4b\x74\x63\x10\x5c\x39\x1c\x83\xf3\x76\xc1\xe9\xd2\x61\x53\xf4\x74\x53\x28\x5c\xa0\xf6\xe9\x63\xfe\x74\xbc\xb8\x7d\x88\x6d\x49\x7d\xf6\x78\xa8\xc6\xe0\x5f\xd2\x7f\x34\xa5\x3f\x47\xc1\x4b\xe5\x33\xc6\x58\xf6\x32\xd0\x7f\x72\x4a\xa9\xa5\x82\x0f\x3c\xbb\xe0\x9e\xab\x14\xad\xcd\x92\x41\xfd\x6d\x85\xb3\x71\x27\x75\xbf\x59\xa1\x38\x5c\xa1\xf8\xbd\x15\xe6\x7f\x74\x85\x6b\x89\x2f\x4e\x81\xb3\x4b\xed\xd0\xfa\xf4\xd1\xa3\x4c\x6a\x51\x17\x74\x79\x02\x1d\xba\x70\x0c\x2f\xb5\x40\x9b\x66\xdf\x93\xcd\xd9\x4f\x8e\x94\x0c\x84\xb1\x20\xc7\xb0\x6a\x4e\xe7\x1c\xe1\x3f\xff\x1d\xed\x3f\xc7\xd5\xf1\x8c\x2a\x59\x07\x24\x02\xa6\x4c\x4c\xd1\xef\xbb\x05\x0f\xe6\x2b\x29\x11\xcf\xc7\xb0\xca\x92\x41\xab\xbb\x43\x78\x8b\x71\xa0\x4c\x66\x9c\x4d\xd8\x9e\xb8\x04\xb6\x6a\x1d\xdf\x58\x6b\x6c\x7a\x64\xbb\xa3\xd7\x85\xae\x0c\xcc\x1c\x7a\x5a\x3f\x72\x63\x2d\xe6\x1e\xee\xb8\x2a\xf1\x28\xc6\x08\x4c\x56\x5b\x21\x9a\x91\x12\x83\x1c\xf3\xad\x28\x82\x4b\x85\x05\x01\xf2\xe5\x92\x4a\xef\x0d\x34\x53\x0f\xf6\x30\x68\x02\x85\x99\x26\xc5\xce\xf4\x8f\xa3\x33\xe8\xac\xaa\x61\x3b\x76\x1b\x7d\xc4\x6a\x3d\x8a\xeb\x58\x8e\x78\xdf\x6f\xfc\x9e\x7c\x2a\xd1\x4a\x74\xec\xcd\xa7\x92\xab\x74\x0b\x66\xbc\x03\x92\xb5\x28\xb1\x34\x7d\x69\x8d\x8c\x5b\x7c\x80\x7b\xee\xe0\xde\x1a\x3d\x6f\xf2\x35\xde\x66\xd8\xd7\xe5\xd0\x5f\xea\x5c\x95\x05\xc2\xd6\x72\xb0\xb3\x12\xac\xa9\xe3\x4a\x3a\xef\xc6\xed\x61\xdb\xdf\x8b\x6f\x82\xd1\xe1\x6d\x16\xf5\x6e\x85\xfc\x42\xc5\x90\x7a\xfe\x96\x2f\x61\xc8\xa6\xe1\xf5\x45\xa9\x73\xc7\xbc\xf4\x0a\xcf\xb8\x43\xf8\x02\xff\x33\x52\xc3\x11\x41\x1c\xd5\x75\xf6\xf2\x9b\x1d\x0a\xa1\x12\x52\xc0\x93\xa8\x64\xab\x51\xee\xb9\xf6\xf0\x74\xf5\x94\x5a\x25\x18\xac\x7b\xae\x57\xc3\xcf\x68\x0d\xc9\xb7\x28\x14\xe6\x9e\xfd\x1b\xad\x49\xdb\x37\x74\x61\x5e\x8b\x74\xa7\x88\x84\xd4\xda\x5c\xea\x42\x52\x63\xaf\x9d\xfe\x49\x05\xbb\x16\xe9\xf1\xae\x1b\x4d\xcb\x94\x22\x66\xcd\xf1\xa2\xdc\x53\xa7\x4d\x50\x19\x5e\x1c\x9a\xe6\xaf\x24\xa7\x73\x3e\x6c\xc0\x3c\x0a\x05\xde\x48\x7f\x06\x71\xc9\xf9\xf3\x9d\x88\x3d\xd0\xed\x19\x91\x02\x7a\xa9\x9d\x98\x7b\xf7\x4a\x08\xcc\x3d\x16\x75\xfd\xa1\x9b\xdd\xb6\x22\x71\x87\x3d\xb4\x22\xd0\xf9\x11\x35\x64\xaf\x8a\x62\xb3\x06\xbb\xad\x4d\x9a\x88\x7a\x5b\x62\xbb\x1b\x1e\x54\xcb\x22\xb8\xc2\xaa\x57\xcd\x3a\x89\xbf\x2b\xa5\xd8\xf3\xab\xe0\xaa\x54\x8a\x26\x73\x5d\x27\x87\x6e\xe1\x13\x5c\x98\x3b\xfc\xb5\x88\x1f\xb8\x88\xff\xda\xc2\xff\xaa\x5b\x78\x47\xe2\xcf\x5e\x51\x7b\xa1\x7e\x74\x07\xa4\x6b\x87\xf2\xff\x9d\x61\xe3\xbd\xf0\x43\x91\xf7\xc7\x6c\x6f\xf9\xce\xc8\xa2\x48\xbd\x3d\xee\xa8\xe1\x93\x9b\x52\xfb\xf5\xb2\xc2\xf7\x2d\xa5\x69\xc6\xce\xc8\xea\x50\x5a\x9b\xc3\xb8\x87\x55\x9b\x09\x32\x09\xb1\x89\xfa\xf3\x3e\xf1\xb0\x62\x68\xd3\xe3\xeb\x48\x04\x97\x5a\xea\x79\x4b\xfd\xeb\x6b\xf4\x4e\x3a\x26\xed\xf2\x8c\xda\xdb\x07\x70\x1f\x4d\xa9\x0a\x98\x21\x51\xec\x40\xae\xe7\xec\xa5\xbb\x92\x2a\x9d\xed\x1d\xab\x7b\x27\x69\xf3\x9c\xf0\x31\xe0\xd9\x16\xd7\x66\xac\xd4\xf5\x61\xc5\xe3\x20\xac\x59\xc0\xec\xa9\xeb\xe7\x25\x52\xaf\x93\x75\x05\xaa\xea\x64\x44\xcb\x08\x3d\x35\xed\xd2\xd3\xcd\xe4\x82\xd1\x49\xfb\xe0\xb4\x75\x08\x0f\x41\x9b\xda\xe6\xed\xf3\x49\x30\x16\x2c\xf2\xe6\x71\x67\xf3\x54\xb3\xe7\x76\x32\x6a\x7e\x72\xed\x22\x9e\x8c\xe2\xde\xe9\xf9\x4c\x21\x8c\x4e\xea\x3a\xf9\xff\x00\x2b\x7f\x27\x3f\xc9\x15\x00\x00")
 
 func templates_testRelationship_one_to_one_setopsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -789,11 +1316,11 @@ func templates_testRelationship_one_to_one_setopsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/relationship_one_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7d, 0xb7, 0xbf, 0x3a, 0x3e, 0x58, 0x5c, 0x2c, 0x60, 0x35, 0x68, 0x2c, 0x59, 0x14, 0x6e, 0x6, 0xdf, 0x8f, 0x8e, 0xd0, 0x1, 0x69, 0x5a, 0x65, 0x7d, 0xcd, 0xd8, 0x5, 0xd8, 0x9b, 0x3c, 0xd2}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa, 0x2a, 0xdf, 0x2e, 0xb9, 0xab, 0x79, 0x46, 0x70, 0xc9, 0x33, 0x7f, 0xa1, 0xfe, 0x79, 0xc, 0x63, 0x45, 0xf3, 0xee, 0xac, 0x45, 0xa3, 0xb5, 0x62, 0x57, 0xdb, 0x6a, 0x6a, 0xef, 0x14, 0x76}}
 	return a, nil
 }
 
-var _templates_testRelationship_to_manyGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x8b\xe7\x06\x54\xa0\xb2\x58\x1f\x53\x18\x45\xd2\x24\x40\xb6\x34\xe8\x12\x07\x7b\x18\x86\x80\xa6\x4e\x0a\x17\x9a\x4c\x49\xca\x71\xa6\xe9\x7f\x1f\x48\xc9\xb6\x6c\xcb\xa9\x1f\x56\x6c\xd8\x83\x61\x49\xbc\xfb\xee\xbb\x1f\xfa\x48\x55\xd5\x5b\x10\x39\xd0\x31\x9b\x48\xa4\x97\xf6\x27\x2d\x54\xb8\x86\xb7\x75\x1d\xfb\x55\x94\xb6\xb9\x89\xfc\xdd\xd0\x85\xc5\xe3\x51\xeb\x02\x8b\x05\xc3\x54\x81\x30\x34\x28\x57\x8b\x74\xac\x3f\x33\xf5\x72\x83\x92\x39\xa1\x95\x7d\x10\x4f\xb6\x81\x6a\xb0\xe4\x12\x6c\x48\x4f\xa4\x60\x16\x6d\x8b\xea\x71\xe8\x8a\x47\x6b\x9f\xbf\x6e\x7f\xa1\x0d\x8a\x42\x6d\xb9\x19\x94\xc1\x7a\xdd\x71\x93\x59\x0f\x46\x78\x72\xcd\xa6\xed\xd5\xaa\x36\xcb\xdb\x2b\xcd\x99\xbc\xf8\x19\x5f\x82\x55\x27\x26\xd7\xf2\x42\xa0\xcc\x42\xcc\x26\x4f\xfa\x49\xcb\x72\xaa\x1a\xe7\xf6\xba\x9b\xdc\x9a\x4b\xbe\xed\xd2\x52\xdb\xf6\x2c\x2d\xda\x2f\x46\x4c\x85\x13\x33\x0c\x59\x6e\x3c\x19\x36\x55\xb2\xdd\xb2\x76\x59\xec\xc8\x7c\x67\x40\xcb\x1f\x70\xca\xd6\x1c\x7c\xcf\xd7\x1e\xfc\x05\x43\x7a\x1b\xec\x96\x73\x92\x97\x8a\x83\x43\xeb\xaa\x6a\x51\x92\xbb\xa7\x5b\xa1\x8a\x52\x32\x53\xd7\xcd\xb0\x54\xd5\xb2\x5f\x34\x54\xb7\xae\x89\x83\x23\xef\x26\x54\x41\xc7\x09\x54\x71\x34\x63\x06\xd0\x84\x9f\x36\x7e\xfe\x44\x0e\x4a\x3b\x18\xd2\x6b\xfd\x49\x2b\x87\x73\x57\xd7\xdc\xcd\x3d\x2d\xde\xdc\xd3\x53\xc6\x1f\x0b\xa3\x4b\x95\x91\xa4\xaa\x50\x65\x3e\x9f\xc6\xe4\x73\x69\xdd\x78\x4e\x02\xcc\x1a\xc4\x44\x0b\x49\x4f\xb1\x10\x2a\xf8\x48\x8b\xdd\x67\xe3\x39\xe1\x6e\x9e\x82\x12\x72\x81\x98\xc4\x51\x86\x39\x1a\xf0\xb9\x92\x04\x2a\xb8\x87\x11\xb8\x39\xbd\xd1\x52\x4e\x18\x7f\x24\x09\xd4\x24\x89\x9b\x14\x18\xf4\x57\xa2\x59\x9d\xa4\xc0\xbd\x41\xde\x63\x10\x47\x16\x31\x4c\x8a\x61\x2a\xd3\x53\xf1\x27\xd2\x6b\x7c\xbe\x45\xcc\x48\x12\x47\x22\x0f\xe5\xe9\xae\xde\x3a\x53\x72\x47\xbc\x5b\x0a\x87\x2c\xed\x84\x3e\xd3\xcf\x6a\x85\x7d\x76\x3a\x7e\x79\x42\x9b\x82\x33\x25\xee\x36\x6b\xa6\xc2\xfe\x2a\xdc\xc3\x19\xe6\xac\x94\x8e\x52\x9a\x7c\x08\x71\x0f\x46\xbe\x26\xbe\x51\x91\xa3\xe7\xbe\x47\x39\x19\xdc\xa9\x30\x07\x4e\xaf\x48\xed\x48\x1f\x6c\xe0\x7a\x0c\x6f\xec\x20\xf5\x80\x49\x1c\xf9\x94\xdb\xac\x8e\x47\xc0\xe8\xa5\xb2\x68\x1c\xd9\xd9\x79\x4f\x1c\x55\xe6\xa7\x16\xfc\x5d\xe8\xda\xa5\xca\xd1\x90\xa4\x8f\xe5\x05\x73\x4c\x92\xad\x58\xbb\x2b\x38\x49\x3b\xbd\xd9\x51\xc1\x9c\x49\x8b\xbb\xed\xf6\x2e\xe1\x3a\xb9\x6f\x73\xe3\xff\x1a\xb7\xce\xbb\x48\xc7\x7a\x7d\x33\x89\x9a\xb5\x0d\xb9\xf2\x0b\x13\xea\x69\x2c\xf4\xaf\xae\xc1\x77\xb8\xaa\x86\xab\x27\x71\xc4\xf7\xb0\x89\x9a\x77\xb4\x89\xf6\xb5\x44\x23\xd0\xd2\x13\x6b\x45\xa1\xc8\xe1\x66\x90\x74\xd3\x3f\xd9\xf6\xd9\x0c\xda\xe7\x13\x24\xb1\x95\x93\xce\xe5\xb2\x49\x93\xef\x3c\xab\xab\x48\xfc\xbb\xbf\x15\x01\x78\xbb\xb1\xf7\x69\xcb\xc0\xcd\xe9\xf9\x1c\x39\x19\x88\x40\x04\x84\x72\x1a\xaa\xaa\xb3\x73\x6e\x6c\x0b\x75\x0d\xa4\x5d\x0f\x62\xdf\xee\x35\xde\xea\x97\x52\x3b\x3f\x1e\xe9\x02\x60\x7d\x3b\xea\x9a\x24\x30\x63\xb2\x44\x0b\xad\x82\x9f\x09\x26\x91\x3b\x7a\x67\xf1\x52\x65\x38\xff\x22\x19\xc7\x07\x2d\x33\x34\xb6\xae\xc9\xf0\xc7\x14\x86\xef\x97\x82\x4e\x3e\xa6\xf0\x71\x21\xe0\x83\xad\x16\xa7\xb0\x39\x39\x2b\x81\x7d\xad\x2d\xff\xf3\xa2\x6c\xbe\x1a\xfb\x15\x65\xb1\xf1\xc6\x11\x7f\x40\xfe\x98\xae\x04\xbd\x6f\xdf\x4f\xe8\x89\x94\xfb\x4e\xf3\x5e\x04\xe2\x68\x72\xe1\x8f\x00\x29\xf0\xf0\xef\x63\xb7\x4a\x18\xfe\xe2\x28\xd7\x06\xee\x53\x98\xb5\x7b\x6b\x81\x10\x98\x06\xb4\x3e\xfd\x6a\xa5\x4d\xe4\x30\xdb\x52\xa8\xd1\xd6\xe8\x04\x98\x96\x83\x1f\x0d\x53\x62\x1c\x45\xaf\x00\x6c\xc9\x5e\x00\xe0\x3d\x00\x5d\xed\xf3\x68\x0b\x2d\x3b\xff\x5a\x32\x49\x36\xb1\x7b\xa6\xfa\x55\x6e\xdf\x42\xdb\x1a\x87\x57\x89\x36\x4d\x5b\xee\xb4\x07\x6d\xd0\xce\x81\x81\x0c\x70\xfe\x84\xdc\x61\xe6\x4f\x0c\xb9\x50\x19\x4c\x06\x4b\xbd\x3b\xe0\xfb\x38\xf0\x41\xdb\x73\x2b\x05\x0f\x47\xd4\xfe\xf3\xc6\xad\x5f\xae\x0e\x59\x57\x4b\x19\xbd\xa2\x57\x9a\x65\x7d\x63\xb9\xb7\xbc\xb6\x93\x45\x8e\x7e\xfb\xfd\xa8\x3f\x74\x42\x0e\x03\xb9\xa4\x39\x45\xee\x25\xf6\x85\x76\x3e\x17\x89\x8a\x30\x7a\xd3\xf7\xe2\x24\x1f\x82\xd1\xc1\x08\xde\xaf\x97\x48\x95\xd3\x09\x1a\xd0\x39\x20\x2b\xd0\x80\xd4\x2c\xc3\x0c\x0c\x72\x6d\x32\x0b\xcf\x46\xab\x22\xf5\xbe\xc7\x83\xf0\xd7\xd6\x6f\x47\x18\x08\x3c\xff\xe9\xa2\x35\xc7\x4e\x7f\x42\xfd\x0f\x57\x44\xe4\xe0\x89\x08\xe9\xcf\xdb\x2d\xe2\x95\x2e\x72\x32\x78\xf3\xc3\x6c\x90\x36\x9a\x11\x6c\xeb\x38\x5e\xe6\xe7\x8f\x07\xef\x8e\x5a\x59\x39\x7a\xb7\xfa\xc4\x5e\x5b\xd6\xa5\x43\xe3\x3f\xca\xff\xd0\x42\x41\xf3\xc9\xdb\x1a\xff\x1d\x00\x00\xff\xff\xd0\x0f\xd8\x40\xae\x0f\x00\x00")
+var _templates_testRelationship_to_manyGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x19\x5b\x6f\xdb\xbc\xf5\xd9\xfa\x15\x27\x9e\x93\x49\x81\xca\xa0\x1f\x86\x3d\xa4\x30\x8a\x36\x69\x80\x6c\x69\xd7\x25\x29\xf6\x30\x0c\x05\x4d\x1d\xd9\x5c\x68\x32\x25\xa9\xc4\x99\xa6\xff\x3e\x90\xa2\x2d\x59\xb6\x12\x17\x4d\xd6\x62\x7b\x48\x6c\xea\xdc\x2f\x3c\x17\xb9\x2c\x5f\x01\xcf\x81\x5c\xd3\x89\x40\x72\x6e\xfe\xa4\xb8\xf4\xdf\xe1\x55\x55\x45\x0e\x8a\xc2\xd4\x87\x81\x3b\x8d\xac\x07\x1e\x8f\x03\x09\x2c\x01\x9a\xca\x29\xc2\x48\xa3\x68\x80\xe4\x5a\x7d\xa4\xf2\xe1\x12\x05\xb5\x5c\x49\x33\xe3\xb7\xa6\x61\x75\x74\x08\x76\x86\xa0\x1d\x14\x33\xd0\xea\xde\x00\xa3\x12\x94\x14\x0f\x30\x41\xe0\xd2\xa0\xb6\x98\x01\x97\x56\xc1\xbd\xe6\xb5\x68\xff\xdf\xc0\xe1\x51\xc3\x89\xe7\x20\x95\x85\x78\x44\x2e\x91\x66\x7f\x71\xf4\x4e\x11\x72\xa6\x34\xf2\x69\xb0\xc7\x3f\x59\x99\x97\xd4\xd4\xb5\x4d\x62\x65\xd4\x88\xbc\x13\x9c\x1a\x34\xc1\x3a\x4f\xd4\xf8\x23\xe0\xe7\x8f\xe3\xaf\x89\x6d\x91\x69\x14\x9e\xfb\x3a\x61\xd7\x43\x7d\xaa\x7f\xa2\xf3\xae\x11\xcd\xf1\x42\x31\x2a\xce\xfe\x8c\x0f\x1e\xab\x25\x93\x29\x71\xc6\x51\x64\x5e\x66\x6d\x27\x39\x51\xa2\x98\xcb\x9a\x38\x7c\x6f\x51\xe4\x6b\x24\xf9\x26\x49\x50\x6d\x93\xb2\x30\x68\x3e\x6b\x3e\xe7\x96\xdf\xa1\x71\xe4\x9d\x27\xa3\xda\x4b\xa6\xed\xd6\xb6\x16\x3d\x96\xf7\x0a\x34\x6c\x86\x73\xba\x46\xe0\x72\x6f\xed\xc1\xbf\x61\x44\xae\x3c\xde\x2a\x5f\xf3\x42\x32\xb0\x68\x6c\x59\x86\xd0\x93\x2f\xb7\x57\x5c\x4e\x0b\x41\x75\x55\xd5\x49\x5b\x96\xab\x78\x11\xef\xdd\xaa\x8a\x2d\x1c\x3a\x32\x2e\xa7\xe4\x3a\x81\x32\x1a\xdc\x51\x0d\xa8\xfd\x9f\xd2\x2e\x17\x43\x26\x8e\xc8\x27\x75\xa2\xa4\xc5\x85\xad\x2a\x66\x17\xce\x17\xac\x3e\x93\xf7\x94\xdd\x4c\xb5\x2a\x64\x16\x27\x65\x89\x32\x73\x0e\xac\x51\x3e\x16\xc6\x5e\x2f\x62\xcf\x66\x8d\xc5\x44\x71\x41\xde\xe3\x94\x4b\x4f\x23\x0c\xb6\x9f\x5d\x2f\x62\x66\x17\x29\x48\x2e\x96\x1c\x93\x68\x90\x61\x8e\x1a\x9c\xad\x71\x02\x25\x7c\x85\x31\xd8\x05\xb9\x54\x42\x4c\x28\xbb\x89\x13\xa8\xe2\x24\xaa\x4d\xa0\xb0\xdd\x13\x35\x74\x92\x02\x73\x08\xf9\x16\x84\x68\x60\x10\x7d\x72\x69\x2a\x33\x35\xe7\xff\x42\xf2\x09\xef\xaf\x10\xb3\x38\x89\x06\x3c\x77\xae\x81\x16\xf4\xca\xea\x82\xd9\xd8\x51\xa5\x70\x40\xd3\x96\xe4\x53\x75\x2f\x1b\xd6\xa7\xef\xaf\x1f\x6e\xd1\xa4\x60\x75\x81\xfd\x68\x75\x16\x9a\xbf\x71\x3b\x3b\xc5\x9c\x16\xc2\x12\x42\x92\x37\x5e\xec\xde\xd8\xb9\xc4\xc5\x69\x60\xc9\x07\xad\x95\xce\xe3\xe1\x17\xe9\x84\x81\x55\x8d\x4e\x3d\xd6\x83\xf1\xba\x1e\xc3\xbe\x19\xa6\x8e\x61\x12\x0d\xaa\x68\x65\xd4\xf1\x18\x28\x39\xf7\x35\x2a\xee\x0d\xbc\x53\x1c\x65\xe6\x6e\x09\xb8\x93\x0f\xda\xb9\xcc\x51\xc7\xc9\x36\x2d\xcf\xa8\xa5\x22\xde\x90\xd5\xeb\xc0\x49\xda\x8a\x4c\x8f\x03\x73\x2a\x0c\xf6\xe3\xed\xec\xc1\x75\xdd\x9e\x54\x8d\xfd\x34\xd5\xc2\x45\x74\xf7\x97\x9c\x9b\x13\x35\xbf\x55\x86\xdb\x50\x16\xcb\x32\x74\x2a\x9e\xc2\x88\xb9\xcc\xdd\xac\x31\xa1\x49\x7d\x2b\x50\x73\x34\xe4\x9d\x31\x7c\x2a\xe3\x83\x09\x69\x14\x5d\x56\x2c\x56\x55\x29\x50\xd2\x64\x50\x00\xc4\x5c\x66\xb8\x68\x57\x37\x03\x23\x9e\xf8\x9b\xb9\x4a\x89\x1f\xd2\x86\xbd\x84\x36\xa1\xdd\x87\x6c\x26\xd7\xaa\xe9\x35\xcb\xba\xcb\xf3\x6e\xa5\x77\x80\xda\x37\xcb\xd6\x51\x55\xe0\x6e\x47\x59\x8e\x9a\x27\xd1\x80\xed\x80\xe3\xd4\x59\x0d\x1c\xdb\x03\xd0\xa0\xa7\x5d\xfa\xa4\xcf\x4d\x8f\xd3\xf8\xf6\x15\x2a\x71\xeb\xeb\x2a\xc3\x27\x2f\x7c\xcf\x9b\xbb\xc4\x5e\xbc\xa2\x78\xc6\x9b\x81\xfd\x9a\x06\x0d\xec\x82\x7c\x58\x20\x8b\x87\xf5\xf8\x55\x0f\x5f\x65\xd9\x1a\x3a\x3a\x1d\xb5\xaa\x20\x0e\x70\xdf\x27\x43\xca\x39\xac\xbf\x16\xca\xba\xf4\x48\x97\x0c\xd6\xf2\x7a\x0d\x25\x81\x3b\x2a\x0a\x34\x10\x9a\xdf\x29\xa7\x02\x99\x25\x5f\x0c\x9e\xbb\x9b\xf4\x59\x50\x86\x33\x25\x32\xd4\xa6\xaa\xe2\xd1\xeb\x14\x46\xbf\xad\x7a\x61\xfc\x36\x85\xb7\xcb\xde\x37\xdc\x08\x71\x0a\xdd\xcc\x69\x7a\xd3\x63\x61\xf9\x1f\x77\x4a\xf7\x6a\xec\xe6\x94\xc0\x30\x8a\x06\x6c\x86\xec\x26\x6d\x9a\xe1\xb6\x91\x29\x21\xef\x84\xd8\x35\x9b\x77\x52\x20\x1a\x4c\xce\xdc\xf4\x94\x02\xf3\x9f\xae\x68\x86\x3e\xe2\x3f\xa2\x41\xae\x34\x7c\x4d\xe1\x2e\x8c\x25\x53\x04\xaf\x29\x94\x3d\xf5\xab\xbe\x01\x4e\xf4\x5d\xc7\x23\x30\x1e\x6f\xa4\x8e\x67\x13\x74\x70\xa9\xa1\x0b\x8c\x06\x83\x47\x18\x74\xdd\x5c\x33\x60\x5b\x18\xb4\x6b\x9f\xe3\xb6\xac\x65\x1f\xbe\x15\x54\xc4\x5d\xde\x5b\xb2\xfa\x51\xdd\x9e\xe2\xb6\x91\x0e\x8f\x2a\xba\xec\x19\x61\x4a\xd9\x0b\x42\x5b\xc3\x56\x3c\xc4\xc5\x2d\x32\xb7\xc1\x59\x05\x39\x97\x19\x4c\x86\xab\x7a\xb7\xc7\x76\x21\x60\xc3\x10\x73\xa6\x0a\x69\x5b\xb9\x76\xe2\xce\xdb\x12\xee\x39\x53\xcd\xc5\xc0\x0b\x76\x48\x5c\xda\x3f\xfe\x21\x16\x28\x63\x9f\x4d\x49\xd2\x56\x3d\x8f\x87\xf7\x54\x5a\xa0\x01\x5f\xe5\xb0\x9f\xa5\x30\x55\x16\xf6\xb3\x61\x0a\x0d\x59\x5a\x63\x04\xb3\x8c\xe0\xcc\x2f\x92\xdb\x47\xd0\x2b\x07\x2e\x0f\x68\xbb\x45\x50\x72\x41\x2e\x14\xcd\x7e\xc0\xf8\xd5\xe0\x15\x1f\xfe\xfd\x1f\x87\xdb\x45\x27\xf1\x81\x57\x2e\xa9\xf7\x8a\x9d\x7a\x98\x33\xf7\x78\xec\x8d\xa5\xe4\x92\x6c\xd1\x30\x79\xe3\x7d\xb2\x37\x86\xdf\xda\xee\x8b\x87\xb2\x98\x4f\x50\x83\xca\x01\xe9\x14\x35\x08\x45\x33\xf7\x52\x00\x99\xd2\x99\x81\x7b\xad\xe4\xd4\xfb\xf3\x78\xe8\x3f\x82\xff\x7a\xc4\x80\x8f\xe9\x73\x3b\xad\xde\x44\x0e\xe8\x2f\xed\x11\xb7\xb1\xf5\x6d\x34\xf5\x3e\x97\xb7\xe6\xed\x75\xe8\x53\x23\xfd\x23\x7b\xd8\xaf\xba\xae\x2d\x6d\x3a\x1e\x03\xfe\xd7\xa6\xb8\x1e\xf7\xe5\x3f\x6d\x23\x7a\xa1\x85\x28\xef\x5b\x41\xf0\x67\xac\x20\xf9\xe6\x0a\x82\xdf\xb5\x5e\x74\x19\xa4\x5d\xfa\x1d\x56\x85\xfc\xa5\x93\xec\xff\x62\x7e\xef\xf8\x3d\x85\x6e\x68\xbe\x77\x54\x3d\x3a\x02\xd7\x33\xe1\x9e\xdb\x19\xcc\x95\x46\xb8\xa5\x1a\xa5\x35\x60\x67\x54\xfa\xf7\xd0\x6c\x56\xc8\x1b\x30\xae\xc0\x18\x05\x48\xd9\x2c\xe0\xfc\xde\x78\x7a\x36\xe3\x22\xd3\x28\x81\xa9\x39\x82\x7b\x87\x06\xb9\x56\x73\xa0\x60\xf0\x96\x6a\x6a\x11\x5c\x42\x3d\xb8\xb9\xb8\x90\x37\x57\x8e\xd1\xf1\xd8\x8b\x3d\x59\x3e\x88\x06\x6b\x47\x18\xc3\xeb\x8d\x17\x75\x5d\x8c\x86\x5b\x15\x27\x4f\xb7\x3c\xdf\xb4\xe1\xa9\x81\x22\x85\x03\xac\x9e\xbb\x3f\xfe\xc2\x43\x85\x77\x22\x66\xdf\xd3\x4a\x3b\xb2\xf1\x29\xd9\xaf\x9f\x51\xb6\x8f\x8c\x73\x06\x17\xee\x45\x6a\xe0\x7c\xa1\xa6\x79\x3c\xdc\xff\xdd\xdd\x30\xad\x37\x1a\x8f\x5b\x45\xd1\x2a\x0c\xe1\x67\x95\x10\x2f\x8d\x34\xab\x7f\x4d\x09\x3f\x96\x84\x82\xd5\xc2\xac\x4b\x7f\x2f\x58\x15\x16\xb5\xfb\x7d\xe8\x9f\x8a\x4b\xf0\xf1\x84\xc3\x23\x78\x55\x55\xd1\x7f\x06\x00\x0c\x93\xb8\x92\x39\x1a\x00\x00")
 
 func templates_testRelationship_to_manyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -809,11 +1336,11 @@ func templates_testRelationship_to_manyGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/relationship_to_many.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x76, 0x21, 0x1d, 0x86, 0xfc, 0xf0, 0xa7, 0xa1, 0x52, 0xeb, 0xdc, 0x95, 0xe5, 0x3f, 0x41, 0x26, 0xe6, 0x4, 0xaa, 0x87, 0xba, 0xb9, 0x45, 0xf9, 0x92, 0xd2, 0x88, 0xd1, 0xf, 0x7a, 0xef, 0xe8}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4, 0x12, 0xa8, 0x56, 0x9f, 0xdf, 0xa9, 0xad, 0xba, 0xf3, 0x7a, 0xdf, 0xc5, 0x5a, 0xf1, 0x44, 0xd0, 0x94, 0x10, 0x78, 0x4f, 0x60, 0x93, 0xfb, 0x42, 0xb0, 0xb7, 0xab, 0x96, 0xd8, 0x84, 0xd3}}
 	return a, nil
 }
 
-var _templates_testRelationship_to_many_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\xb6\x3e\xc5\x6c\x10\xa4\x52\x56\x95\xdb\x62\x71\x0f\x5d\xf4\xa1\x4d\x37\x40\x6e\x77\x73\x87\x38\x77\xf7\x10\x04\x0b\x5a\x1a\xd9\xbc\xd0\xa4\x97\xa4\x62\xfb\x0c\x7d\xf7\x03\x49\x59\x92\x1d\xc9\x51\x12\x3b\x8b\x16\x7e\x08\xa0\x3f\xc3\xf9\xcd\x0c\x87\x33\xf3\x93\xb3\x5c\xbe\x05\x9a\x42\x74\x4d\x86\x0c\xa3\x0b\xf5\x77\x41\xb9\xbd\x86\xb7\x79\xee\x99\xb7\xc8\x94\xbb\xe9\x99\xbb\x63\x6d\x5f\x7e\xfc\x54\x2c\xa9\xde\x48\xc2\x47\x08\xc7\x12\x59\xf5\x36\xba\x16\xbf\x13\xbe\xb8\x42\x46\x34\x15\x5c\x8d\xe9\x54\xb9\x15\x4e\x19\x2b\xb5\x1d\x47\x9f\x19\x25\x0a\x55\xa1\xd6\xe8\xa9\x23\x38\xf9\x74\xbb\xfc\xb9\x90\x48\x47\xfc\xc1\x32\x89\xcc\x4a\xaf\x2f\xdc\xb4\xac\x41\x87\x7d\x72\x49\x26\xc5\x55\x15\x9c\xf2\xf6\x37\x11\x13\x76\xfe\x2b\x2e\xac\x54\x0d\x33\x53\xa8\xfe\x29\xe9\x84\x6a\x7a\x8f\x16\x79\xe3\xc9\xb1\xb3\x5c\xd5\x5d\xb5\x97\x67\x82\x65\x13\xde\x66\x4d\xf1\xa4\x10\xaa\x01\xc6\x82\x9d\x53\x64\x89\x75\xd2\x05\x76\x4d\xd5\xc3\x15\xe9\xda\x92\xf4\xe1\x92\x75\xac\x3c\xf7\xd2\x8c\xc7\xa0\x51\xe9\xe5\x72\x05\xf1\xaf\xe9\x80\xf2\x51\xc6\x88\xcc\x73\xb7\xdb\x9f\x93\xe4\x1f\xd3\xe5\xb2\x8c\x7a\x64\x63\x94\xe7\xbe\x86\x53\xb3\x96\xf2\x51\x74\x1d\xc0\xd2\xeb\xdd\x13\x09\x28\xed\x9f\x90\x9e\x49\x23\x9a\x02\x17\x1a\x8e\xa3\x4b\x71\x26\xb8\xc6\xb9\xce\xf3\x58\xcf\x8d\x81\xb1\xbb\x8f\xbe\x90\xf8\x6e\x24\x45\xc6\x13\x3f\x58\x2e\x91\x27\xc6\x21\x27\xf2\x7b\xa6\xf4\xf5\xdc\xb7\x6a\xd6\x54\x0c\x05\x65\xd1\x17\x1c\x51\x6e\xd7\x30\x85\xf5\x67\xd7\x73\x3f\xd6\xf3\x10\x38\x65\x2b\x8d\x81\xd7\x4b\x30\x45\x09\xc6\x63\x3f\x80\x25\xfc\x01\x9f\x40\xcf\xa3\x2b\xc1\xd8\x90\xc4\x77\x7e\x00\xb9\x1f\x78\xce\x07\x02\xcd\xf1\x70\x6f\x87\x21\xc4\x21\x24\x21\xa0\x11\x4b\x1b\xc4\xbc\x9e\x42\xb4\xbb\x20\x09\x4f\xc4\x84\xfe\x0f\xa3\x4b\x9c\x0d\x10\x13\x3f\xf0\x7a\x34\xb5\x51\xaa\xbf\x1d\x68\x99\xc5\xda\x37\xcb\x42\x38\x21\x61\xcd\x80\xaf\x62\xc6\x2b\xdd\x5f\xbf\x5c\x2f\xa6\xa8\x42\x48\x09\x53\x18\x82\xd2\x72\x42\xf8\x88\x61\x34\x40\x7d\x26\x26\x53\x86\x13\xe4\xda\x6f\x5b\x6f\x12\x96\xc8\xc5\xaf\xb8\x70\x59\xa0\xda\xa1\x0a\x81\xff\x50\x3d\x16\x99\xfe\x8a\x29\xc9\x98\x0e\xa2\x28\x0a\x7e\xb6\xf6\xff\xf0\xc9\x44\xd8\xec\x7b\x4f\x47\xe7\x44\x13\xe6\xa3\x94\x81\xd7\xcb\xbd\x5e\xea\x32\x0d\xa5\x3d\x29\x37\xb7\xa7\xcd\x91\x5a\x9e\x0c\x43\x38\x89\x43\x38\x31\x6e\xa3\x5b\x08\x7f\x84\x30\x2f\x82\x37\x42\xa8\xa9\x32\x50\x8f\x05\x6f\x1e\xd6\x76\xe5\xd9\xb1\x6b\x5c\xdf\x1c\xbb\x46\xd1\x27\xc4\x6e\x23\x78\x26\x7a\x26\x83\x0a\x3f\x3f\x7e\x02\x12\x5d\x70\x85\x52\xfb\xad\xc7\xc9\xd8\x81\x3c\x31\xb5\x00\xcc\x9d\x3d\x0a\x17\x3c\x45\xe9\x07\x1d\x36\xab\x0c\xe9\xf0\xd5\x90\xe2\x3d\x23\xd5\x33\x70\x30\x65\x54\x7f\x59\x38\x40\x2a\xb8\xcb\xc8\xf6\x9c\x34\xe5\xd4\xe5\x65\x1e\xda\x6b\x97\x9b\x61\xa9\x17\x68\x4b\x82\x3e\x40\x32\xba\x9c\xc3\x24\xfa\x9c\x24\x4d\x45\xb4\x73\x00\xa8\xf1\xf7\x5d\x08\x73\x93\x45\xd5\x41\xd8\x9a\x49\x5e\xaf\x97\x52\xa9\xb4\x31\x76\x7e\xf3\xee\xd6\xeb\xf5\x14\xc6\x82\x27\xee\xc1\xfb\xdb\xa2\x75\xd8\x89\x41\x94\xed\xd0\x96\x30\x03\x60\x17\x47\x57\x51\xdd\xf0\xa2\x89\xe4\xf9\xcd\xbb\x5b\x83\x7e\x42\x56\xe0\xbf\x98\xb2\xef\x1f\xc9\x7a\x0f\x9e\x11\x65\xbd\x23\x49\x82\x09\x4c\xa5\x98\xa2\x64\x0b\xd0\x02\xf4\x18\x41\x31\x1a\xe3\x51\x91\xf5\x06\xd0\x59\xf7\x6a\x88\xab\x79\xc8\x39\xec\xfa\xcc\x46\x5b\x77\xfd\x95\xa6\x40\x8c\x4d\xab\x0e\x9b\xe7\xc6\x10\x17\x1e\x93\x46\xb5\xe7\xeb\xa6\x15\xc9\x01\x77\xb8\xb0\x96\xcd\xa4\xe0\x23\xb8\x27\x2c\xc3\xa3\x70\x53\x67\xd8\xa8\xb1\x16\x9e\x06\x1b\x8a\x88\xed\xd2\x88\x46\x95\x55\xc8\xaa\x01\xd2\x98\xf4\xc3\x9f\x19\x4a\x8a\x2a\xfa\xe5\xcf\x8c\x30\xbf\x9b\x47\x7b\x0d\xd2\x63\x16\x35\xbb\xb7\xd7\x90\xbd\x05\x37\xd7\x74\x38\x55\x2f\x4d\xf0\x95\xe1\x4f\x3c\x5a\xfb\x81\xdd\xf4\x9c\x6c\xc0\x17\x45\xf0\x86\x9e\x7e\xb8\x2d\x0f\xd4\x36\x23\x94\x6d\xf5\x0e\xc5\x5a\xa3\x50\x1b\x13\x62\x21\x25\xc6\xba\xd8\xa0\xb5\x13\xd3\x8a\xf8\xe3\xfb\xdb\xea\x00\xed\x0a\xd4\xeb\xf5\x62\x91\x71\x1d\x56\xdd\xbb\xa9\xea\x07\xd1\x99\x91\xea\x5a\xfd\xbb\xd6\x7b\x2b\x35\x23\xdc\x16\x7c\xca\xf5\xdf\x7e\xf2\x7d\xfa\xe3\xfb\xe0\xf4\x43\xf0\x33\x58\xbb\xcc\x7a\x2b\xb0\xee\xaf\x79\x74\x14\xda\x37\x21\x1c\x8d\x84\xb9\xb1\xf2\xe5\x44\xe2\xc8\x23\x4d\xc1\x17\xb2\x81\x4d\x5c\x66\x8c\xd5\x88\x5e\xd5\x4b\x02\xb3\xf9\x9d\x78\xc6\x00\xf5\x81\x67\x1c\x78\xc6\x81\x67\xfc\x15\x3c\xe3\x40\x33\x9e\x47\x33\x56\xb1\x1b\xa0\x7e\xd1\x74\x5f\xa4\x8a\xcb\xd3\xea\xec\x6e\xc5\xde\x4f\xa3\x79\x1c\xd9\x88\x94\xcd\xe4\x43\x21\x52\x34\x12\xf7\xbc\x9c\x98\x3e\xd6\xfa\xc8\x2e\xc3\xa5\x65\x86\xab\xd3\xfc\xf4\x68\x7d\x23\xc1\x6a\x63\x66\xfd\x3e\x5c\xdb\x91\x8b\x31\x31\xa3\x7c\x04\xf1\x18\xe3\x3b\x05\x31\xe1\xc6\xe8\x21\x02\x5d\xd5\x18\x4c\x40\x51\x1e\x23\xcc\x10\xc6\xe4\xde\x8c\x2f\x30\x26\x3c\x61\x68\xd5\xb8\xe1\x4d\x21\xcc\xc6\xc8\x8d\x4c\x4c\x18\x83\x01\x6a\x3f\x88\xe0\x37\x24\xf7\x46\xbb\x1e\xe3\x04\xc6\x28\x11\x8c\xa1\x54\x8d\xd3\x8c\x81\x1e\x53\x7e\x47\xf9\xc8\xaa\x21\x3c\x31\xaa\x18\x6a\x98\xa2\x98\x32\x84\x3b\x2e\x66\x66\xa1\xc4\x37\x0a\x12\x49\x46\x82\xab\xc8\xc8\x5a\x79\x9a\x02\x43\xee\x0f\xdb\x86\xd1\xc0\x52\x5d\x13\xab\x7e\x1f\x1e\x19\x47\x25\x4e\xc4\x7d\x7d\x20\x4d\xa5\x98\xac\x53\xbd\x7e\x1f\xf2\x3a\x6e\xfc\xaa\xb8\x34\x85\x8e\x84\xf6\x45\x7c\xb6\x28\x7b\xaf\x83\xb4\xc6\x9b\xeb\x94\xeb\x42\xfd\xdb\x0c\xc3\xf2\x92\x32\x7f\xd8\x48\xb1\xd6\x46\x4e\x18\xbe\x51\x50\xa7\x5a\x76\x94\x36\x88\x43\x34\x27\xaa\x72\xac\x11\x21\xee\x80\x10\x77\x42\x68\xa7\xfe\xcd\xac\xbb\x91\x70\x3f\x97\x3c\x36\xf0\xc6\x56\x60\xdc\x25\xf0\xa6\xb2\x55\x2c\x2a\x86\xdf\x81\x4e\x37\x33\xe9\x9d\xc7\xe2\x31\x33\x1e\xf8\xb2\x97\xc8\x54\x74\x96\xa6\xd0\x5a\xbf\xd6\xfb\xc1\xf3\xaa\x48\x49\xa8\xed\xd0\x59\x1d\x84\xd6\xe2\xb5\x4f\xd0\x2e\x9f\x0d\x5e\xf4\xd5\x60\x03\xaf\xb5\x8c\xed\x03\x6f\x63\x57\xdb\xbe\x18\x14\x05\x34\xd9\x02\xde\xf9\x43\x41\xbe\x15\xc9\x7d\x97\x38\xc1\xdd\x20\x75\xa5\xdf\x57\x36\x2b\x0e\x0c\xfc\xc0\xc0\x0f\x0c\xfc\x3b\xfd\xa5\xcf\xdb\xd9\x8f\x51\x8e\x7f\x55\x1b\xe6\x7e\x93\xfa\x66\x48\xeb\x4f\xcf\x22\xad\xae\x42\xbe\x8c\xe6\x97\x11\xbb\xf9\xf8\xe1\xf6\x59\x51\xfb\xc6\xc9\x6b\x77\xee\xf7\x72\x02\x96\x97\x70\x1d\x28\xdf\xae\xe0\xf6\x48\xf6\x9e\x3c\x2e\xed\x09\xf2\x7b\xa3\x7f\x87\x69\xbe\xe3\x74\xad\x05\x10\x50\x63\x91\xb1\xc4\x7d\x4e\x1a\x22\x72\x98\x4a\x54\x28\xef\x31\xd9\xc5\x00\xdf\x0d\x62\x63\x66\x37\x47\xbc\x65\x9a\x0e\x1a\x4a\x57\x5d\x7d\xa9\x19\xf4\x4c\x40\xdd\x14\xb5\x4a\x8e\x7e\x1f\x6c\xe9\x27\x0c\x12\x81\x8a\xbf\xd1\x90\x58\x2b\xdd\x7f\x2f\x26\xc8\xd0\xfe\xb7\x87\x99\x68\xa6\x28\x53\x61\x86\x8d\x18\x41\x89\xf2\xb3\x9b\x16\x90\x32\xe3\xdd\x18\x41\xc8\x04\x65\x17\x02\xb0\x8d\x6a\x68\x01\x49\xa7\x9d\x78\x84\xce\x6c\x23\x19\x5a\x00\x3e\x8a\x91\x7b\x65\x57\xf1\x8a\x5d\x31\xd7\xe6\xb2\x7f\x5a\x0c\x76\x6b\x51\x85\xd3\x7e\x8b\xb0\xc8\x34\x4a\xd3\x34\xfe\x2b\x28\x07\x17\xdc\x42\xf8\xff\x01\x00\x00\xff\xff\x90\xab\x62\x85\xd7\x2a\x00\x00")
+var _templates_testRelationship_to_many_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdf\x6f\xe3\x36\x12\x7e\xb6\xfe\x8a\x69\x10\x64\xa5\x54\x2b\x67\x17\xc5\x3d\x6c\xb1\x0f\xfb\xa3\x0b\xe4\xda\xa6\x87\x24\x87\x7b\x08\x82\x82\x96\x46\x36\x2f\x34\xe9\x92\x54\x6c\x9f\xa1\xff\xfd\x30\xa4\x2c\xc9\x8e\x95\xa8\x59\x27\x45\x17\x79\x08\x62\x4b\xe4\x7c\xc3\x8f\xc3\x99\xf9\x24\xaf\x56\xaf\x81\xe7\x90\x5c\xb2\x91\xc0\xe4\xd4\xfc\x53\x71\xe9\x3e\xc3\xeb\xb2\x0c\xe8\x2e\x0a\xe3\xbf\x0c\xe8\xdb\xa1\x75\x37\xdf\xbd\xaf\xa6\x34\x77\x34\x93\x63\x84\x43\x8d\xa2\xb9\x9b\x5c\xaa\x5f\x99\x5c\x9e\xa3\x60\x96\x2b\x69\x26\x7c\x66\x9a\x19\xc3\x63\x30\x68\xd5\x0c\x2c\x1a\x6b\x40\x49\xb1\x84\x54\xdd\xa2\x06\xc3\xe5\x58\x20\xa4\x4a\x14\x53\x09\x37\xb8\x34\x31\x30\x99\xf9\x21\x73\xcd\x2d\x82\x55\xee\x83\xf3\xc6\xf9\x64\xe0\x78\xd8\xd8\xe6\xb9\x9b\x10\x4a\x65\x9d\x4f\xc9\xa9\xf9\xa4\xa6\x33\x65\xb8\xc5\xc8\x5f\x0e\x0f\x93\x73\x64\xd9\x6f\x64\xd3\x0d\xf9\xa2\x34\xf2\x71\xb5\x7c\x77\xa5\x66\x23\x8a\xbc\x69\xcf\x81\xa8\x49\x38\x4c\x3e\x08\xce\x0c\x9a\x8a\x0d\x37\xab\x45\x8c\x1f\x9f\xdf\x3f\x7e\x03\xb7\x35\x4d\xa3\x70\xd6\x37\x27\x6e\x13\xda\xe5\xfb\x19\x9b\x6e\xaf\xa2\xf9\xfa\x8b\x4a\x99\xf8\xf2\x33\x2e\xdd\xa8\x16\x66\x61\xd0\xfc\x4b\xf3\x29\xb7\xfc\x16\x1d\xf2\xd6\x95\x43\xef\xb9\x69\x2f\xd5\x7d\xfc\xe4\x37\xab\xc3\x9b\xea\x4a\x35\xa8\x05\x98\x2a\xf1\x85\xa3\xc8\x08\xaa\x22\x76\xc3\xd4\xdd\x19\xf9\xc6\x94\xfc\xee\x94\x4d\xac\xb2\x0c\xf2\x42\xa6\x2e\xc8\x56\xab\x35\xc4\xbf\x67\x17\x5c\x8e\x0b\xc1\x74\x59\xfa\x20\xfd\x90\x65\xbf\xcd\x56\xab\x9a\xf5\xc4\x71\x54\x96\xa1\x85\x63\x9a\xcb\xe5\x38\xb9\x8c\x60\x15\x0c\x6e\x99\x06\xd4\xee\x4f\xe9\x80\xe2\x8d\xe7\x40\x11\x75\x98\x9c\xa9\x4f\x4a\x5a\x5c\xd8\xb2\x4c\xed\x82\x1c\x4c\xfd\xf7\xe4\x23\x4b\x6f\xc6\x5a\x15\x32\x0b\xa3\xd5\x0a\x65\x46\x0b\xf2\x43\x7e\x2d\x8c\xbd\x5c\x84\xce\xcc\x86\x89\x91\xe2\x22\xf9\x88\x63\x2e\xdd\x1c\x61\xb0\x7d\xed\x72\x11\xa6\x76\x11\x83\xe4\x62\x6d\x31\x0a\x06\x19\xe6\xa8\x81\x56\x1c\x46\xb0\x82\xdf\xe1\x3d\xd8\x45\x72\xae\x84\x18\xb1\xf4\x26\x8c\xa0\x0c\xa3\xc0\xaf\x81\xc1\x6e\x3e\xfc\xdd\x51\x0c\x69\x0c\x59\x0c\x48\xc3\xf2\x1d\xc3\x82\x81\x41\x74\x1b\xa7\x99\xcc\xd4\x94\xff\x0f\x93\x33\x9c\x5f\x20\x66\x61\x14\x0c\x78\x4e\x0c\x41\xeb\xee\x85\xd5\x45\x6a\x43\x9a\x15\xc3\x11\x8b\x5b\xf8\x9f\xd5\x5c\x36\xa6\x3f\x7f\xbc\x5c\xce\xd0\xc4\x90\x33\x61\x30\x06\x63\xf5\x94\x51\x4a\x48\x2e\xd0\xd2\x39\x16\x38\x45\x69\xc3\xae\xf9\x14\xaf\x4c\x2f\x7f\xc6\xa5\x0f\x1f\xd3\x0d\x55\x0d\xf8\x0f\xb7\x13\x55\xd8\xcf\x98\xb3\x42\xd8\x28\x49\x92\xe8\x47\xe7\xfe\x77\xef\x89\x60\xda\xf6\x81\x4d\xbe\x30\xcb\x44\x88\x5a\x47\xc1\xa0\x0c\x06\xb9\x0f\x34\xd4\xee\xa0\x5c\x5d\x1f\xef\x26\x6a\x75\x34\x8a\xe1\x28\x8d\xe1\x28\x8b\xe1\x08\xfd\x44\xf8\x3d\x86\x45\xc5\xdd\x18\xa1\x65\x8a\xa0\x1e\xe0\x6e\x11\xb7\xf6\xe4\xd1\xd4\xe5\xfd\xa9\xcb\xbf\x92\xba\x2d\xee\x88\xbc\x32\xa8\x43\xe4\xdd\x7b\x60\xc9\xa9\x34\xa8\x6d\xd8\x79\x98\xc8\x0f\x94\x19\x65\x02\xa0\xb0\x77\x07\xe1\x54\xe6\xa8\xc3\xa8\xc7\x5e\xd5\x8c\x8e\x9e\x0d\x29\x7d\x62\xa4\x76\x00\x5e\xcc\x04\xb7\x1f\x97\x1e\x90\x2b\x49\x91\x75\x75\xdd\x1d\x92\x94\x4c\x7d\x58\x96\xb1\xfb\xec\x43\x33\xae\xed\x02\xef\x88\xcf\x3b\x48\x44\x37\x79\x4a\x9b\xf8\x21\xcb\x76\xa5\xd0\xde\x04\x70\xda\xc3\x93\x18\x16\x74\x00\x9b\x73\xd0\x22\x61\x8b\x05\xe7\xee\x20\xe7\xda\x58\x5a\xf2\xe2\xea\xe4\x3a\x18\x0c\x0c\xa6\x4a\xba\xcc\xb4\xb8\x7a\x73\x5d\x15\x0e\xd7\xe6\xa8\xba\x18\x96\x6e\x26\xcf\xc1\x4d\x4e\xce\x93\xb6\xe3\x55\x09\x29\xcb\xab\x93\x6b\x72\xe9\x88\xad\xc1\x7f\xd2\x5a\xe9\xf0\x40\xb7\x2b\xf0\x9c\x19\xb7\x3a\x96\x65\x98\xc1\x4c\xab\x19\x6a\xb1\xa4\x16\xc5\x4e\x10\x8c\xe0\x29\x1e\x54\x51\x4f\x2b\xf2\xde\x3d\x1b\xe2\xba\x89\xf3\x0b\x76\x5b\x71\xb8\x55\xd4\x7d\x3d\xa6\x9e\x89\x7c\x5a\xd7\xd7\xb2\xa4\xa5\x7b\x7a\x56\xab\xa6\xee\x96\xe5\x96\x6b\x55\x70\x50\xa3\xe6\x3c\x9b\x6b\x25\xc7\x70\xcb\x44\x81\x07\xf1\xb6\xcd\x78\xa7\xc5\x16\x3d\x3b\x7c\xa8\x18\xdb\xa7\x13\x3b\x4d\x36\x94\x35\x5d\x2f\xed\xd8\x77\x7f\x14\xa8\x39\x9a\xe4\xa7\x3f\x0a\x26\xc2\x7e\x2b\x7a\x52\x92\x1e\xf2\x68\xf7\xf2\x9e\x94\xb2\xd7\xe0\xbb\x9a\x1e\xa7\xea\x6b\x03\x7c\xed\xf8\x9f\x3c\x5a\x4f\x03\xbb\xbd\x72\xb6\x05\x5f\x25\xc1\x2b\x7e\xfc\xf6\xba\x3e\x50\xf7\x39\x61\x5c\x9b\xe4\x4f\xb1\xf3\xc6\xa0\x25\x17\x52\xa5\x35\xa6\xb6\xda\xa0\xd6\xaa\xef\x41\xfc\xfe\xcd\x75\x73\x80\xf6\x05\x1a\x0c\x06\xa9\x2a\xa4\x8d\x9b\xea\xbd\x03\x3e\x8c\x92\x4f\x34\xaa\x6f\xf6\xef\x9b\xef\xdd\xa8\x39\x93\x2e\xe1\x73\x69\xff\xf1\x43\x18\xf2\xef\xdf\x44\xc7\x6f\xa3\x1f\xc1\xf9\x45\xf3\xdd\x80\xcd\xf5\xd2\xa5\x83\x18\xe8\x5f\x0c\x07\x63\x65\x0f\x62\x3f\xbe\xb2\x5b\x06\x5e\xf1\xf2\x1c\x42\xa5\x77\x68\x89\xb3\x42\x88\x46\xd2\xb4\x6a\x49\x44\xb9\xb5\x97\xca\xb8\x40\xfb\xa2\x32\x5e\x54\xc6\x8b\xca\x78\x7e\x95\xf1\x22\x32\x1e\x27\x32\xd6\xdc\x5d\xa0\xdd\x95\xb8\x7a\xe3\x56\xa1\xe2\xc3\xb4\x39\xb9\xf7\x62\x3f\x4d\x99\x79\x18\x99\x9c\xab\x4b\xc9\xdb\x6a\x48\x55\x46\xfc\xf5\xba\x5f\x7a\xd7\xaa\x22\xfb\xa4\xcb\xea\x02\xd7\x87\xf9\xcf\xb3\xf5\x37\x21\xab\x4b\x97\x0d\x87\x70\xe9\x1a\x2e\x21\xd4\x9c\xcb\x31\xa4\x13\x4c\x6f\x0c\xa4\x4c\x12\x77\x23\x04\xbe\xce\x31\x98\xd1\xe3\xe2\x14\x61\x8e\x30\x61\xb7\x08\x52\xc1\x84\xc9\x4c\xa0\x33\xe3\x25\x91\x41\x98\x4f\x50\xd2\x98\x94\x09\x01\x17\x68\xc3\x28\x81\x5f\x90\xdd\x92\x75\x3b\xc1\x29\x4c\x50\x23\xd0\xae\x72\x33\xc9\x0b\x01\x76\xc2\xe5\x0d\x97\x63\x67\x86\x1e\x26\x5b\x05\x02\x2d\xcc\x50\xcd\x04\xc2\x8d\x54\x73\x32\xad\xf1\x95\x81\x4c\xb3\xb1\x92\x26\xa1\xb1\xf4\x47\xcf\xd4\x05\xca\x70\xd4\xd5\x8a\x46\x44\xe8\x09\x71\x35\x1c\x42\x47\x4b\xb6\x6e\x46\x35\x4e\xd5\x6d\x5b\xe6\xe5\x5a\x4d\x37\x85\xde\x70\x08\x65\x1b\x37\x7d\x56\x5c\x9e\x43\x4f\x39\xfb\x98\xae\xbb\x06\xab\xd2\xde\xf3\x20\x6d\xa8\xe6\xb6\xe0\x3a\x35\x67\x5c\x84\xa3\x9d\xd2\xaa\x06\xa5\x1e\x13\x46\xaf\x4c\x2d\x19\x48\x1a\xbb\x16\x9a\xb0\x46\x48\x87\xb8\x59\xd2\x96\xed\xb4\x87\xed\xb4\x97\xed\x6e\xb1\xbf\x5b\x67\x6f\x8b\xbb\x4d\xe0\x36\x5e\x7d\xa2\xbb\xe5\xe2\x0e\xa5\xd8\x09\x8c\xfb\x04\xde\x36\xb6\xe6\xa2\xd1\xf4\x3d\x04\xf4\x1d\xef\x9f\x86\x8b\x87\xdc\xc0\xbd\xba\xd1\xc5\x4c\x23\x60\x79\x0e\x9d\x39\x6b\xb3\x06\xdc\x77\xbc\xba\x33\xc7\xda\x61\x2f\x37\x9b\x23\x90\xfe\x15\xa0\x7d\x1e\x14\x3c\x26\x8f\x74\xe1\xe1\x73\xe2\x6d\xed\x6a\xd7\x33\x82\x2a\x69\x66\xf7\x80\xf7\x7e\x34\x50\xde\x8b\xe4\x9f\x44\x1c\xe1\x7e\x90\xfa\x0a\xee\x73\x17\x15\x2f\x6f\xf6\x5e\xde\xec\xbd\xbc\xd9\xfb\x26\xdf\xec\x05\x7b\x7b\xf9\xe4\x15\x57\xb3\x5f\x14\x2a\xf5\x4a\xfa\x0a\xaf\xbf\x4e\xa6\xfe\xb0\x99\x58\x7b\xca\x54\x9f\x1f\xbf\x8a\xb7\x86\xb1\xab\x77\x6f\xaf\x1f\xc5\xda\xdf\x5c\xae\xf6\x57\x7b\x1d\x85\xef\xe1\x1e\x66\x4b\x05\xf5\x14\x79\xfb\x82\x7b\x42\x79\xb7\xb3\x79\x79\x5a\x9d\xb7\x13\xf2\x5b\x10\x7c\x2f\xfd\x7b\xcf\xfe\xdd\x2a\x60\x60\x26\xaa\x10\x99\x7f\x68\x34\x42\x94\x30\xd3\x68\x50\xdf\x62\xd6\x23\x0a\xf7\x04\xb1\xd5\xa5\xd3\xb1\xee\xe8\x9f\xa3\x1d\xe9\xaa\x6d\xbe\xb6\x0c\x76\xae\xa0\xed\x8a\x59\x07\xc7\x70\x08\x2e\xdd\x33\x01\x99\x42\x23\x5f\x59\xc8\x9c\x97\xae\x5d\x80\x0c\x05\xd2\x1c\xe2\x1b\x66\xa8\x73\x45\x0d\x46\x8a\x60\x54\xfd\x70\xcd\x2a\xc8\x05\xad\x6e\x82\xa0\x74\x86\xba\x4f\xcb\x7f\x9f\xb8\xb0\x0a\xb2\x5e\x3b\xd1\x85\x71\xf2\xb0\xac\xb0\x0a\xf0\x41\x8c\x32\xa8\x2b\x49\x50\xed\x0a\x55\x95\xea\x87\xaa\x55\xf9\x49\xd7\xbf\x21\x05\xa5\x41\x23\xab\x7e\x92\x7a\x3c\xec\x98\xe6\x7f\x14\xdb\x76\xc6\x74\x0f\x56\x85\x45\x4d\xf5\xe5\xbf\x8a\x4b\xf0\x7b\x72\x3c\x84\xd7\x65\x19\xfc\x7f\x00\xcb\xad\xc6\xbf\xa7\x2b\x00\x00")
 
 func templates_testRelationship_to_many_setopsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -829,11 +1356,11 @@ func templates_testRelationship_to_many_setopsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/relationship_to_many_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x73, 0x52, 0x36, 0x36, 0x86, 0xdb, 0x1e, 0xee, 0x2c, 0x24, 0xe9, 0x2b, 0x10, 0xc8, 0xac, 0xe5, 0xab, 0xad, 0xf3, 0xcb, 0xa5, 0x1b, 0x49, 0xdc, 0x47, 0xa4, 0x0, 0x76, 0x4, 0xc0, 0xff, 0xe9}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0xa6, 0x7, 0x8b, 0xda, 0xdf, 0x24, 0x95, 0x9a, 0xd7, 0xb8, 0xe9, 0x73, 0x4b, 0xd, 0x5f, 0x5f, 0x81, 0xa6, 0xfb, 0x8, 0x6a, 0x61, 0xde, 0x53, 0x4d, 0xfb, 0x4f, 0x9d, 0x6c, 0xda, 0xdc}}
 	return a, nil
 }
 
-var _templates_testRelationship_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\xd1\x6e\xdb\x36\x14\x7d\xb6\xbe\xe2\x36\x70\x03\xca\x50\xd9\xf7\x0c\x7e\x68\x9a\x06\xc8\xd6\xa5\x43\xe2\x60\x0f\xc3\x30\x30\xd2\x95\xcc\x85\x21\x53\x92\x72\x9c\x11\xfc\xf7\x81\xa4\x54\xc9\xb1\x8c\x04\x43\x87\xbe\x99\x26\xcf\xe1\xb9\xe7\x1e\x5d\xc9\xb9\x77\xc0\x6b\xa0\x2b\x76\x2b\x90\x5e\x98\x9f\x15\x97\xf1\x37\xbc\xf3\x3e\x0b\xbb\x28\x4c\x5a\xcc\xc2\x4a\x33\xd9\x20\xcc\xeb\x3b\x7c\x82\x93\x65\x8f\x3b\xff\x05\x9f\x4c\x3a\x14\x4f\xcd\x85\x8d\x1c\x27\x4b\x98\xd3\x0f\x82\x33\x83\x26\x1d\x4d\x50\x3a\x5c\xd1\x01\xea\x17\x00\xe7\x4a\x23\x6f\xe4\x1e\x4e\xa3\x88\xa0\x74\x21\xbd\x42\xc1\x2c\x57\xd2\xac\xf9\x43\x87\xbc\x64\xf7\x3b\x88\x52\x89\x73\x8e\xa2\x1a\xc3\x3e\x2a\xd1\xde\xcb\x0e\xd0\x2d\xc6\xe2\x76\x30\xf5\x04\xa6\x93\xb7\x0f\x6d\x0d\x9a\xdf\x34\xbf\xe7\x96\x6f\xd0\x04\xfc\xb3\x7f\xe6\xa9\x4c\xb3\x63\xcc\x8e\x90\x09\x03\xa6\x2e\xf5\x3e\xab\x5b\x59\x82\x45\x63\x9d\xeb\x2b\xbb\x79\xb8\xe6\xb2\x69\x05\xd3\xde\xaf\xd4\x17\x89\xce\xf5\x05\x8c\xb7\x6e\x0c\x97\x8d\x73\xc1\xce\x9e\xd6\x7b\x62\x61\x11\xd8\xb8\x6c\xe8\x2a\x07\x17\x12\xc0\x6b\x90\xca\xc2\x9c\x5e\xaa\x8f\x4a\x5a\xdc\x5a\xef\x4b\xbb\x0d\x85\x95\x69\x4d\x4f\x59\x79\xd7\x68\xd5\xca\x8a\xe4\xce\xa1\xac\x82\x1b\xe9\xc8\xaf\xad\xb1\xab\x2d\x89\x34\x3b\x14\xb7\x8a\x0b\x7a\x8a\x0d\x97\x11\x23\x0c\x8e\xff\x5b\x6d\x49\x69\xb7\x05\x48\x2e\x7a\xc6\x3c\x9b\x55\x58\xa3\x86\x50\x33\xc9\xc1\xc1\x5f\xb0\x04\xbb\xa5\x57\x4a\x88\x5b\x56\xde\x91\x1c\x3c\xc9\xb3\x6c\xb6\x61\x1a\x84\x2a\x99\x80\x69\x57\xd2\x89\x3a\x15\x0d\xd3\xf6\x64\xd9\xcc\x20\xc6\xf6\x6b\x26\x2b\x75\xcf\xff\x41\x7a\x89\x8f\xd7\x88\x15\xc9\xb3\x19\xaf\x01\xb5\xde\xdd\xbe\xb6\xba\x2d\x2d\x09\xb8\x02\x8e\xa3\x82\x62\x24\xe1\x4c\x3d\xca\xe1\x82\xb3\xd3\xd5\xd3\x03\x9a\x70\x20\x58\x93\x82\xdb\x0a\x11\x8e\x7a\x6f\x75\x8b\xbd\x2b\x35\x13\x06\x3b\x13\x0e\xf3\xa5\x4c\x98\xdf\xb9\x5d\x9f\x61\xcd\x5a\x61\x29\xa5\xf9\x4f\x51\xe5\x9b\x65\x30\x32\xb4\x73\x66\xe9\x27\xad\x95\xae\xc9\xd1\x8d\x8c\xc1\xb2\x6a\xa8\xe0\x80\x5f\x60\x62\x61\x27\xf0\xd6\x1c\x15\x81\x30\xcf\x66\xfe\x35\x16\x74\x16\x17\x23\x8f\x5f\x36\x61\x27\xe3\xaf\x74\x64\x92\xfc\x7b\x39\x32\x91\x8e\x69\x47\xc6\x96\x74\xa5\xd3\x0b\x69\x50\x5b\x72\xf0\x31\x0a\xf2\x51\x56\x61\x80\x40\x58\xc5\x47\xe0\x42\xd6\xa8\x49\x3e\xa5\xf5\x9c\x59\x26\xc8\x70\x63\x32\xee\xd9\x84\x89\xd3\x28\xe6\x8f\x3a\xf7\x6d\xfc\x79\x0f\x83\xae\x50\xd7\xb0\x11\x78\x86\xa9\xff\xb5\x45\xcd\xd1\xd0\x0f\xc6\xf0\x46\x92\xe3\x09\xa6\xe2\x00\x51\x1e\x99\x52\x3d\x63\x3b\x12\xc5\xff\x6d\x46\xb9\xc6\xf2\xae\xd8\xbd\x73\x6f\xc4\xe5\xf4\x8b\xc4\xd7\x6a\x18\x9e\xf4\xff\xd8\x05\x5e\x43\x54\xf5\xcc\xa7\x40\x37\xed\x60\x1a\xba\x43\x33\x78\x0d\x6f\xfa\x86\x7c\xfa\xda\x32\x41\xa6\xf8\x0e\xf6\xa3\xa3\xeb\x3b\x32\x4a\xfb\x23\x93\x21\xc0\x9b\x02\x1a\x65\xe1\xed\xe6\xe8\x10\x47\x31\x59\x41\x57\xb9\x11\xbc\x8c\x2f\xf1\xe9\xd1\x71\x1d\xb6\x5d\x4a\xd0\x10\x88\xbe\x37\x9f\xe9\x67\xc5\xaa\xbd\x0e\xbd\x3a\x1f\x71\x0e\x14\x40\x16\x7f\xfc\xb9\x98\xbe\x3f\x27\xc7\x51\x61\x9e\x5e\x25\x2f\x66\x28\x28\x4c\xda\xae\xf6\x92\x03\xcb\xbd\xa1\x41\x8e\xd2\x20\x00\xb3\x56\xad\xa8\x60\xcd\x36\x08\xb7\x88\x12\x90\x35\x18\xde\x42\xac\xc2\xea\xa8\xf3\xea\x30\x71\xe0\xfd\xee\xee\x84\x89\x39\xbc\x87\x7e\x74\xf9\x3e\xcb\xbe\xc9\x73\xee\xfd\xa2\xfb\xae\x5c\xbc\xef\x3f\x3a\x47\x5b\x7f\x2b\x2e\x21\x7d\x1e\xc6\xfd\x7f\x03\x00\x00\xff\xff\xd3\x88\x32\x01\xb3\x0a\x00\x00")
+var _templates_testRelationship_to_oneGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x41\x6f\xe3\x36\x13\x3d\x5b\xbf\x62\x62\x28\x0b\xca\xd0\xd2\xf7\x7c\xc8\x61\x93\x6c\x80\x7c\xdd\x26\x45\xe2\xa0\x87\xa2\x28\x68\x69\x24\xb3\xa1\xc9\x2c\x49\xd9\x4e\x05\xfd\xf7\x82\x14\x15\xc9\x89\xdc\xf8\xd0\x45\x2f\x41\x68\xf2\xcd\xbc\xf7\x66\x34\x64\x5d\x7f\x06\x5e\x00\x5d\xb0\xa5\x40\x7a\x63\xfe\xaf\xb8\xf4\xff\xc3\xe7\xa6\x89\xdc\x2e\x0a\xd3\x2e\x26\x6e\xa5\x99\x2c\x11\xe2\xe2\x09\x5f\xe0\xec\xbc\xc3\x5d\xff\x84\x2f\xa6\x3f\x34\x9f\x81\x5d\x21\x68\x14\xcc\x62\x0e\x5a\x6d\x0d\x64\x4c\x82\x92\xe2\x05\x96\x08\x5c\x1a\xd4\x6e\x87\x4b\xab\x60\xab\xb9\xf5\x19\xfd\x5f\x03\xb3\x79\x1f\x89\x17\x20\x95\x05\x12\xd3\x7b\x64\xf9\x9d\xc3\xfb\xdc\xf4\x5a\x69\xe4\x65\x4b\x35\x69\xcf\x7b\x7e\xb1\xf0\x51\x1c\xb7\x98\x7e\x11\x9c\x19\x34\x2d\xc9\x00\xec\xc5\x05\x40\xf1\x01\x60\x98\x69\x88\xd3\x28\x7c\x96\x36\x21\xbd\x77\x62\xb9\x92\x66\xc5\x9f\x03\xf2\x96\xad\xf7\x10\x99\x12\xd7\x1c\x45\x3e\x84\x5d\x2a\x51\xad\x65\x00\x84\xc5\x00\x52\xec\x61\x8a\x11\x4c\xa0\xf7\x1e\x5a\x19\x34\xbf\x68\xbe\xe6\x96\x6f\xd0\x38\xfc\x9b\x5f\xe2\x56\xa6\x09\x81\x86\x9a\xc7\x32\x8c\x78\x12\x8e\x35\x4d\x54\x54\x32\x03\x8b\xc6\xd6\x75\xa7\xec\xf1\xf9\x81\xcb\xb2\x12\x4c\x37\xcd\x42\xdd\x49\xac\xeb\xb8\x78\xbf\xf5\x68\xb8\x2c\xeb\x3a\xd6\x28\xba\xb0\x4d\x43\x2c\xcc\x5c\x34\x2e\x4b\xba\x48\xa0\x76\xcd\x10\x5a\x21\xa6\xb7\xea\x52\x49\x8b\x3b\xdb\x34\x99\xdd\x39\x61\x59\xbb\xa6\x17\x2c\x7b\x2a\xb5\xaa\x64\x4e\x92\xba\x46\x99\x3b\x37\xda\x23\x3f\x57\xc6\x2e\x76\xc4\x87\xd9\x0b\xb1\x54\x5c\xd0\x0b\x2c\xb9\xf4\x18\x61\x70\xf8\xdb\x62\x47\x32\xbb\x4b\x41\x72\xd1\x45\x4c\xa2\x49\x8e\x05\x6a\x70\x9a\x49\x02\x35\xfc\x01\xe7\x60\x77\xf4\x5e\x09\xb1\x64\xd9\x13\x49\xa0\x21\x49\x14\x4d\x36\x4c\x83\x50\x19\x13\x30\xee\x4a\x7b\xa2\x68\x45\xc3\xb8\x3d\x51\x34\x31\x88\xbe\x65\x34\x93\xb9\x5a\xf3\xbf\x90\xde\xe2\xf6\x01\x31\x27\x49\x34\xe1\x05\xa0\xd6\x7b\xdb\x0f\x56\x57\x99\x25\x0e\x96\xc2\x27\x4f\x20\x1d\x30\xb8\x52\x5b\xd9\xc7\xbf\xba\x58\xbc\x3c\xa3\x71\x07\x9c\x33\xbe\xe0\xb7\x95\x10\xee\x68\xd3\x58\x5d\x61\x67\x4a\xc1\x84\xc1\xe0\xc1\xe1\x78\x6d\x4b\x98\x5f\xb9\x5d\x5d\x61\xc1\x2a\x61\x29\xa5\xc9\xff\x3c\xc9\x93\x73\xe7\xa3\xab\xe6\xc4\xd2\xaf\x5a\x2b\x5d\x90\xe9\xa3\x74\xa9\xc0\xaa\x5e\xc0\x01\xbb\xc0\x78\x61\x67\x70\x6a\xa6\xa9\x0b\x98\x44\x93\xe6\x08\x07\x82\xc1\xe9\xc0\xe1\x8f\x3d\xd8\xeb\xf0\x23\x0d\x29\x7e\xa4\x21\xc5\xb1\x86\x0c\x1d\x09\xd2\xe9\x8d\x1f\xb8\xe4\xe0\x47\xe4\xe8\xa3\xcc\xdd\xf8\x00\xb7\xf2\x1f\xc0\x8d\x2c\x50\x93\x64\x8c\xeb\x35\xb3\x4c\x90\x3e\xe3\xc0\xb8\x1b\x73\xa9\xd6\xcf\xca\x70\x1b\x66\x5f\x5d\x87\x3b\x83\xa7\x10\x67\x8e\xd5\x70\xc6\x84\x5b\xe3\x7b\x85\x9a\xa3\xa1\x5f\x8c\xe1\xa5\x24\x6d\xd7\xd2\xbe\x0f\xba\x81\x94\x39\xab\x3b\x55\xbd\x2b\x61\x9b\x70\x99\xe3\x6e\xac\x82\x06\x62\x9e\xf8\x6f\xf7\x55\xa8\x63\xed\x0a\xe9\xae\xbf\xb7\xc3\xd2\x6f\xbf\x72\xe8\x66\x70\xd3\x40\x6f\x69\x5d\xf7\xc3\x79\x10\xec\x9f\xd5\xf4\x80\xf4\x40\xa0\x7d\x86\x7d\x25\x5b\x32\x3f\xba\x8e\xd9\x0a\xb3\xa7\x74\x3f\xe7\xbb\xd9\x9c\xd0\x3b\x89\xc7\x72\xe8\x47\xd4\x11\x0d\x34\x56\x05\x5e\x80\x67\xf5\xc6\x27\x38\x39\x54\x0a\xa8\xf7\x8b\xc1\x0b\x38\xe9\x0a\xf2\xf5\x7b\xc5\x04\x19\x8b\x77\xb0\x1e\x50\xef\x55\x64\xf0\xa1\x6e\x99\xb4\x67\x70\xba\x49\xa1\x54\x16\x4e\x37\xd3\x43\x31\xd2\x51\x05\x41\xb9\x11\x3c\xf3\xaf\x8f\xf1\xa1\xf7\xe0\xb6\xeb\xb6\x83\xfa\x86\xe8\x6a\xf3\x8d\x7e\x53\x2c\x7f\x57\xa1\xa3\xfb\xc3\x8f\xb0\x14\xc8\xec\xb7\xdf\x67\xe3\xf9\x13\xf2\xc9\x33\x4c\xda\x3b\xf0\xc3\x1e\x72\x0c\x5b\x6e\xf7\xf4\x2d\x2f\x38\x1f\xe2\xfc\xbc\x23\xd3\x76\x86\x81\x59\xa9\x4a\xe4\xb0\x62\x1b\x84\x25\xa2\x04\x64\x25\xba\xeb\x93\xe5\x98\x4f\x83\x57\x87\x03\xbb\xb8\xff\xba\x3b\x6e\xd8\xf7\x37\xe8\x7f\x2d\xbf\x89\xa2\x57\x7a\xe1\x91\x1d\x74\x68\x64\x79\xfb\xb6\x0e\x4f\x67\xb7\xfd\x7a\x72\x3e\x0b\x8f\xf6\xd9\x7c\x64\xeb\x4f\xc5\x25\x58\xb6\x14\x08\xb3\x79\xd3\x44\x7f\x0f\x00\xf4\x81\x73\xd6\x10\x0c\x00\x00")
 
 func templates_testRelationship_to_oneGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -849,11 +1376,11 @@ func templates_testRelationship_to_oneGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/relationship_to_one.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x24, 0xf9, 0x25, 0xf8, 0x3d, 0xf2, 0x48, 0x13, 0xfd, 0x7b, 0x6, 0xe, 0xd6, 0xf0, 0xeb, 0x8e, 0xa, 0x4f, 0xc, 0xd2, 0x3e, 0xb1, 0x3c, 0xaa, 0xd, 0x6d, 0x68, 0xf, 0xb0, 0x26, 0x3b, 0x41}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1d, 0x62, 0x38, 0xbe, 0xd4, 0x28, 0x93, 0xbe, 0x78, 0x43, 0x8d, 0xe3, 0xe0, 0xc6, 0xe9, 0x9b, 0x1a, 0xb4, 0x57, 0xa, 0x48, 0xe0, 0x24, 0x8a, 0x36, 0xb, 0x73, 0x16, 0x2b, 0x97, 0xec, 0x6c}}
 	return a, nil
 }
 
-var _templates_testRelationship_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x6f\xdb\x46\x10\x3d\x8b\xbf\x62\x22\x18\x0e\x69\x30\x4c\xce\x29\x7c\x68\x9c\x18\x50\xd3\xd8\x81\xac\xb4\x40\x83\xa0\x58\x91\x43\x66\x9b\xd5\xae\xbc\xbb\xb4\xa5\x30\xfc\xef\xc5\x2c\x49\x89\x5f\x6e\xe4\xb4\x3e\xb4\xcd\x21\x40\x48\xee\xcc\x7b\xf3\xb1\x33\x4f\x2e\x8a\x27\xc0\x53\x88\x16\x6c\x29\x30\x9a\x99\x9f\x14\x97\xee\xff\xf0\xa4\x2c\x3d\xfa\x8a\xc2\x54\x0f\x13\x7a\xd2\x4c\x66\x08\x47\xe9\x27\xdc\xc2\xf3\xd3\xc6\xee\xfc\x35\x6e\x4d\x75\xc8\x9d\x3a\x12\xd6\xf9\x78\x7e\x0a\x47\xd1\x8f\x82\x33\x83\xa6\x3a\x5a\x99\x46\x7b\x88\xda\x20\xfd\x8a\xc1\xb9\xd2\xc8\x33\x39\xb0\xd3\x28\x9c\x51\x05\x18\xcd\x51\x30\xcb\x95\x34\x1f\xf9\xba\xb6\xbc\x60\xab\x8e\x45\xac\xc4\x39\x47\x91\xb4\xcd\xce\x94\xc8\x57\xb2\x36\xa8\x1f\xda\xe4\x3a\x36\xe9\x88\x4d\x4d\x6f\x68\x9a\x1b\x34\x6f\x35\x5f\x71\xcb\x6f\xd0\x90\x7d\xef\xcd\x51\x15\xa6\xe9\x24\xa6\x43\x64\x24\x01\x63\xa0\x65\xe9\xa5\xb9\x8c\xc1\xa2\xb1\x45\xd1\x44\xf6\x6e\x7d\xc5\x65\x96\x0b\xa6\xcb\x72\xa1\x2e\x25\x5e\xa1\xbd\x5c\x17\x45\x13\x45\xfb\xfb\x3b\xc3\x65\x56\x14\x94\xd3\xc6\x77\x59\xfa\x16\x4e\xc8\x25\x97\x59\xb4\x08\xa0\xf0\x26\x37\x4c\x03\x6a\xf7\x4f\x69\x8f\xda\x82\xa7\x20\x95\x85\xa3\xe8\x42\x9d\x29\x69\x71\x63\xcb\x32\xb6\x1b\x8a\x36\xae\x9e\xa3\x17\x2c\xfe\x94\x69\x95\xcb\xc4\x0f\x8a\x02\x65\x42\x29\xaa\x8e\xbc\xc9\x8d\x5d\x6c\x7c\xe7\xa6\xe3\x62\xa9\xb8\x88\x5e\x60\xc6\xa5\xb3\x11\x06\xdb\xef\x16\x1b\x3f\xb6\x9b\x10\x24\x17\x8d\xc7\xc0\x9b\x24\x98\xa2\x06\x4a\x84\x1f\x40\x01\xbf\xc3\x29\xd8\x4d\x34\x57\x42\x2c\x59\xfc\xc9\x0f\xa0\xf4\x03\xaf\x8a\x81\xc1\x78\x9a\xaa\xaf\xcb\x10\x62\x18\xcf\x93\xe7\x4d\x0c\xa2\x6b\x06\xcd\x64\xa2\x56\xfc\x33\x46\x17\x78\x7b\x85\x98\xf8\x81\x37\xe1\xa9\xcb\x4f\xfb\xeb\x95\xd5\x79\x6c\x7d\x32\x0b\xe1\x98\x85\x2d\xe8\x97\xea\x56\xee\x7d\xbf\x7c\xb1\xd8\xae\xd1\x84\x90\x32\x61\x30\x04\x63\xf5\x8a\xc9\x4c\x60\x74\x85\xf6\x4c\xad\xd6\x02\x57\x28\xad\x7f\x97\x3d\x75\x16\xd3\xdb\xd7\xb8\xad\xda\xc2\xdc\x0d\x55\x1f\xf8\x95\xdb\x8f\x2a\xb7\x2f\x31\x65\xb9\xb0\x41\x14\x45\xc1\x0f\x8e\xff\xa3\x53\xca\x2d\x55\x7c\x62\xa3\x73\x66\x99\xf0\x51\xeb\xc0\x9b\x94\x07\x84\xb8\x0c\x5b\xc9\xfb\xe6\x10\x47\xed\xc7\x43\x1c\x3d\xfa\xa0\x21\xc6\xff\xf6\x10\x77\x31\x3e\x3f\x05\x16\xcd\xa4\x41\x6d\xfd\x3b\x6f\x33\x51\x40\x99\xd0\x70\x03\x7a\x72\x37\x71\x26\x53\xd4\x7e\x70\x9f\x74\x2e\x1f\x18\xc9\x9b\xa4\x4a\x03\x0f\x61\x53\x5f\xd0\x0c\xe1\xfd\x87\x93\xf1\xab\x5c\x50\xa3\x1e\xc7\xa5\xf3\x54\xf1\x63\x54\xa3\xc1\x14\x3c\x98\x2c\x27\x6e\xcf\x42\xd8\x04\xde\xa4\x09\xba\xc5\xb6\x47\xd7\xf1\xa5\x63\x2c\x9a\x47\x7d\x50\xb2\xdb\x34\x56\xaf\x68\xda\xfa\x53\xdd\x5e\x70\xc6\xf5\xa3\xa3\x65\xd0\x82\x55\x10\x2b\xad\x31\xb6\x70\xc3\x44\x8e\xd3\x1d\x40\x35\x5b\xdd\xd2\x78\x27\xf9\x75\xde\x2c\x44\x9e\xc2\x66\x0f\xfc\xb3\x8a\x99\xa8\x60\x8f\x59\x0f\x37\x65\x5c\x60\x42\x10\x6c\xbd\xa6\x78\xad\x82\xb4\x22\x0a\x23\x9c\x6a\x68\x42\xde\xeb\x87\x71\xb8\xf7\xcf\x3e\xfc\xd3\x88\x55\x39\xf6\x81\xf7\x56\xee\x8e\x0c\x23\x2a\xcd\x6e\xaf\xf3\x4d\xaf\xd2\xd6\xbb\x62\x24\x88\x47\xd7\x39\x6a\x8e\x26\x7a\x75\x9d\x33\xe1\xf7\xdc\x84\x03\x27\x41\xe3\xa5\x26\xd6\x09\xb3\x0e\x89\x04\xd5\x2d\x33\x70\xab\x95\xcc\xea\xfa\x85\x7d\x86\xdd\x82\x1a\xb4\x33\x19\x8b\x3c\xe9\x0b\x86\x5a\x95\xbd\x7d\xbd\x7b\xd7\x0a\x1a\x37\xdc\x58\x13\x36\x17\x7f\x7c\x07\xbe\x72\x87\x0e\xef\x7a\xc7\x73\x0c\xf6\x0b\x95\x87\xcb\xec\x0d\x5b\x83\xcf\x48\xd8\x9d\x29\x61\x1a\xe1\x15\xc0\x17\xf8\x43\x71\x09\x2e\xd4\x69\x59\x0e\xaf\xf7\xe0\xc2\x54\x82\x94\xaa\x50\x45\xd2\x6b\x9b\x5b\x26\x2d\x3c\x66\x8f\xa9\x5d\xdc\x81\xf1\x56\xfc\x8c\x5a\xb9\xf9\x80\xa9\xc0\xd8\x46\xbf\xa1\x56\x7e\xf3\x40\xc3\xfb\x32\xed\xd7\x35\x20\x47\xcd\x91\x99\x4c\x38\xdd\xb4\x9d\xcd\x2f\x54\xb1\xcb\xd4\x3f\x1e\x58\xd1\x3c\xf1\x09\x2f\xf0\xf6\x23\x81\xe6\xcc\x1c\x85\x62\xc9\xa1\x49\xfe\x8b\xd4\xb4\xee\x8a\x76\x3e\xa7\xae\xbc\xbd\xeb\xff\x9f\xb9\x05\x23\xae\x77\x25\x7e\x02\xb5\xb6\x2c\xbd\xea\xe7\xcb\x6e\xf0\x5d\xe4\x42\x50\xcf\xd1\x60\x38\x44\x28\xcf\x71\xa5\x6e\xf0\xbb\x56\x3e\x44\x2b\x7f\x17\xca\xff\x5f\xa1\xec\xb5\x47\xda\x43\x4b\xbb\x16\xd4\xdf\x52\x69\x56\xe7\x48\xc9\xbf\x27\x66\x35\x13\xbe\x1d\x76\x1c\x70\x20\x78\xb4\x83\xe9\xa8\x9c\x69\x4d\x26\x56\xb9\xb4\xe1\x5e\xb5\x0f\xb8\x04\xd1\x19\x1d\x39\x78\xab\x78\x23\x22\xb5\xa1\xd4\xee\x62\x07\xec\xb4\x6d\x97\xb5\xdb\xb7\x52\x75\xc8\x1a\x8a\x80\x71\xc9\x65\x36\xdd\x27\xf1\x2e\x89\x3b\x48\xc4\xbc\x11\xb6\x28\xad\xde\x82\xf9\xa8\x72\x91\xc0\x12\xe9\x64\xcb\xdf\x6e\xff\xcc\x8c\x5b\xbe\xfa\x82\x0f\xb6\x50\xd0\x4b\x71\x6b\xcd\xb8\xe5\x32\xee\xfc\x0e\xb9\xcc\x53\x58\x8e\xaa\xe5\xaf\x97\x92\x41\xaa\xd5\x0a\x96\x8f\x4d\x37\x51\x15\x62\x67\xb5\xf2\x14\x04\x4a\x7f\x88\x14\x8c\x64\xff\xfe\x40\xcd\x82\xa4\xed\x58\xb7\x41\x51\x3c\x3d\xa1\xb7\xb4\x2b\xdb\x19\x92\xf5\xc6\x84\x93\xa7\xcd\xdf\x02\x5b\x06\xd5\x2f\xab\xd1\x4f\x4e\xd2\xd9\xbd\xe9\x9f\x01\x00\x00\xff\xff\x40\x9e\xb0\x48\x65\x14\x00\x00")
+var _templates_testRelationship_to_one_setopsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xd1\x6f\xd3\xc8\x13\x7e\x8e\xff\x8a\xa1\x8a\x8a\x1d\x19\x97\x67\x50\x1f\x7e\x14\x2a\xf5\xc7\xd1\xa2\xb6\xdc\x49\x87\xd0\x69\x63\xcf\x86\xbd\x6e\x76\xc3\xee\xba\x4d\x30\xfe\xdf\x4f\xb3\x6b\x27\x76\xe2\x42\xe0\x8e\x93\x0e\xf1\x00\xaa\xed\x9d\xf9\xbe\x6f\x76\x66\x67\xb2\x55\xf5\x08\x04\x87\xec\x9a\x4d\x25\x66\x67\xf6\xff\x5a\x28\xff\x37\x3c\xaa\xeb\x88\xbe\xa2\xb4\xe1\x61\x44\x4f\x86\xa9\x19\xc2\x98\xdf\xe0\x0a\x9e\x1c\xb7\x76\xa7\x2f\x71\x65\x37\x8b\x8e\x26\x60\xd1\xe9\x05\x38\xb4\xce\x82\x56\x72\x05\xb9\xbe\x45\x03\x56\xa8\x99\x44\xc8\xb5\x2c\xe7\x0a\x6e\x70\x65\x53\x60\xaa\x08\x4b\xee\x8c\x70\x08\x4e\xfb\x3f\x3c\x07\xff\xbf\x85\xc9\xd1\xc6\xb7\xe0\xde\x20\x56\xda\x05\x1a\xd9\x99\x3d\xd1\xf3\x85\xb6\xc2\x61\x12\xde\xc7\xe3\xec\x12\x59\x71\x41\x4e\xc3\x9a\x53\x6d\x50\xcc\x82\xb2\x24\x09\xde\xbc\x9e\xb1\xf4\x18\xa4\x65\x9c\xfd\x4f\x0a\x66\xd1\x06\x51\x8d\xe5\x26\x18\x8d\x01\xff\x82\x41\x17\xaa\x6b\x67\x50\x7a\x94\x00\x98\x5d\xa2\x64\x4e\x68\x65\xdf\x8b\x45\x63\x79\xce\xe6\x3d\x8b\x5c\xcb\x53\x81\xb2\xe8\x9a\x9d\x84\xc8\x05\x83\xe6\xa1\x63\xc2\x7b\x36\x7c\xc0\xa6\xa1\xb7\x6b\x5a\x5a\xb4\xaf\x8d\x98\x0b\x27\x6e\xd1\x92\xfd\xd6\x9b\x71\x90\x69\x1b\x47\x5d\xcd\x43\x08\x03\x31\x69\x96\xd5\x75\xc4\x4b\x95\xfb\xec\xa8\xaa\x56\xd9\x9b\xc5\x95\x50\xb3\x52\x32\x53\xd7\xd7\xfa\x42\xe1\x15\xba\x8b\x45\x55\x8d\xf9\xee\xf7\x37\x94\x47\x55\x35\x36\x28\x5b\xdf\x75\x1d\x3b\x98\x90\x4b\xa1\x66\xd9\x75\x02\x55\x34\xba\x65\x06\xd0\xf8\x7f\xda\x44\x94\x3f\x82\x03\x25\xc8\x38\x3b\xd7\x27\x5a\x39\x5c\xba\xba\xce\xdd\x92\xd4\xe6\xe1\x39\x7b\xc6\xf2\x9b\x99\xd1\xa5\x2a\xe2\xa4\xaa\x50\x15\x14\xa2\xb0\xe4\x55\x69\xdd\xf5\x32\xf6\x6e\x7a\x2e\xa6\x5a\xc8\xec\x19\xce\x84\xf2\x36\xd2\x62\xf7\xdd\xf5\x32\xce\xdd\x32\x05\x25\x64\xeb\x31\x89\x46\x05\x72\x34\x40\x81\x88\x13\xa8\xe0\x0f\x38\x06\xb7\xcc\x2e\xb5\x94\x53\x96\xdf\xc4\x09\xd4\x71\x12\x05\x0d\x0c\x86\xc3\x14\xbe\x4e\x53\xc8\x61\x38\x4e\x51\x34\xb2\x88\x3e\x81\x0c\x53\x85\x9e\x8b\x8f\x98\x9d\xe3\xdd\x15\x62\x11\x27\xd1\x48\x70\x8a\x0d\x74\xbe\x5e\x39\x53\xe6\x2e\x26\xab\x14\x0e\x59\xda\x41\x7e\xae\xef\xd4\xc6\xf5\xf3\x67\xd7\xab\x05\xda\x14\x38\x93\x16\x53\xb0\xce\xcc\x19\x15\x77\x76\x85\x8e\x0a\x52\xe2\x1c\x95\x8b\xef\xb3\xa7\xc4\x62\x66\xf5\x12\x57\x21\x2b\xec\xfd\x50\xcd\x82\xdf\x84\x7b\xaf\x4b\xf7\x1c\x39\x2b\xa5\x4b\xb2\x2c\x4b\x9e\x7a\xfa\x0f\x8e\x29\xb4\xb4\xe1\x23\x97\x9d\x32\xc7\x64\x8c\xc6\x24\xd1\xa8\xfe\xb2\xc2\x69\xda\x09\xdd\x37\x2b\xe4\xfb\x2b\xe4\xff\xb6\xc2\xfc\xbf\xae\x70\x2d\xf1\xc9\x31\xb0\xec\x4c\x59\x34\x2e\xbe\xb7\x94\x49\x2d\xaa\x82\x4e\x36\xa0\xa2\xf3\x65\x78\xa6\x38\x9a\x38\xf9\x9a\x68\x4e\xbf\x33\x52\x34\xe2\xda\x80\x48\x61\xd9\x54\xe7\x0c\xe1\xed\xbb\xc9\x70\x1d\x57\x87\x53\xda\xc9\xda\x7b\x22\xc7\x14\x89\x2b\x74\x3b\x47\xe0\xde\x64\x05\x45\xe1\x71\x0a\xcb\x24\x1a\xb5\xa2\x3b\x6c\xb7\xe8\x7a\xbe\xb4\x8c\x65\x97\xd9\x36\x28\x79\x5a\xb6\x56\x2f\x8c\xd1\x26\x3e\x30\xdd\xee\x66\x7d\x3e\x7a\x5a\x16\x1d\x35\xf8\x5c\x1b\x83\xb9\x83\x5b\x26\x4b\x3c\x58\x03\x78\xf6\xa1\x63\xbc\x51\xe2\x43\xd9\x76\x43\xc1\x61\xb9\x01\xfe\x45\xe7\x4c\x06\xd8\x43\xb6\x85\xcb\x99\x90\x58\x10\x04\x5b\x2c\x28\x0d\x9c\x06\x1e\x88\xc2\x00\xa7\x06\x9a\x9a\xe7\x66\xcc\x19\x86\x7b\xfb\xf8\xdd\x3f\x8d\x18\xb6\x63\x23\x7c\xab\xdf\xae\xc9\x30\x52\xde\x36\xf6\x26\xde\xf4\x6a\xdd\xec\xeb\x90\x18\xdb\x22\x1e\x7c\x28\xd1\x08\xb4\xd9\x8b\x0f\x25\x93\xf1\x96\x9b\x74\xc7\x49\x02\x55\x8f\x58\x4f\x66\x23\x89\xe6\xbe\x3b\x66\xe1\xce\x68\x35\x6b\xf6\x2f\x85\x2d\xd7\xfd\x0d\xb5\xe8\xce\x54\x2e\xcb\x62\x7b\x5a\x68\x86\xc7\xd7\x2f\xd7\xef\x3a\xa2\x71\x29\xac\xb3\x69\x5b\xf8\x9b\xde\xd0\xad\x8b\x17\x7e\xd1\xfe\x25\xea\x79\x0e\xc1\x7e\xa2\x84\x10\x6a\xf6\x8a\x2d\x20\x66\x34\xd5\x9d\x68\x69\xdb\xa9\x2b\x81\x4f\xf0\xa7\x16\x0a\x0e\xc8\xc5\x41\x5d\x27\x4f\xbf\x58\x30\xe0\xf7\x42\x70\x78\x10\x94\x6c\xa5\xcd\x1d\x53\x0e\x1e\xb2\x87\x94\xaa\x7e\xc1\x70\x2a\x7e\x44\xa3\x49\xbe\x41\x2e\x31\x77\xd9\xef\x68\x74\xdc\x3e\xd0\xe1\x7d\xc1\xb7\xf7\x35\x21\x47\xed\x92\x33\x55\x08\xaa\xb4\xb5\xcd\xaf\xb4\x63\x17\x3c\x3e\xdc\xb1\xa2\xbe\x1d\x13\x5e\xd2\xd4\x7a\x7b\xce\x5c\xa2\xd4\xac\xd8\x37\xc8\x9f\x09\x4d\xa7\x56\x8c\xf7\x79\xe0\xb7\x77\xab\xfc\x7f\x98\x2a\x18\x70\xbd\xde\xe2\x47\xd0\x0c\x96\x75\x14\x7e\x65\xad\x0f\xbe\xf3\x52\x4a\xca\x39\x3a\x18\xf6\x99\x92\x2f\x71\xae\x6f\xf1\xe7\xa0\xbc\xcf\xa0\x0c\xc3\x41\xfa\x39\x25\xff\xf0\x53\x72\x47\xe2\xf7\x1e\x21\x7b\x50\x7f\x6b\x46\x73\xa6\xc4\x14\x0e\xa7\x5f\x89\x19\x4e\x84\x6f\x87\x1d\x06\xdc\x19\x77\x8c\x87\xe9\xcd\x38\x07\x0d\x99\x5c\x97\xca\xad\x5b\x37\xcb\x76\xb8\x24\xd9\x09\x2d\xd9\x97\xd3\xa6\x06\x07\x28\xb5\x31\xa0\x25\x1e\x98\x78\x3f\xee\xb3\xf6\xdd\x56\xe9\x1e\x59\x0b\x06\xe7\x4c\x28\xa1\x66\x2d\xef\xcf\x0c\xb8\x3b\x81\xb8\x6c\x06\x3a\x40\xe5\xcc\x0a\xec\x7b\x5d\xca\x02\xa6\x48\xfc\x3a\xfe\xd6\xdd\xe7\xcc\x9e\x8b\x9d\xee\x93\xf4\x7d\x76\xdb\x8b\x6f\x2a\xc3\x6e\xef\x19\x93\x05\x87\xe9\xce\xd8\xba\xdf\x26\x32\xe0\x46\xcf\x61\xfa\xd0\xf6\x43\x14\x10\x7b\x2d\x55\x70\x90\xa8\xe2\x5d\xa4\x64\x20\xee\x5f\x0f\xd4\x36\xc6\x3a\x5a\x27\x40\x55\x1d\x4d\xe8\x2d\xdd\x53\x76\x23\xa4\x9a\x4e\x09\x93\xa3\xf6\xaa\xb2\x35\xf0\x57\x90\x4d\x6a\xe5\xed\xe5\x20\x68\x03\x06\x59\x73\xd9\xd8\xdc\x29\xf6\xcc\x8e\x26\xcd\x0f\xb1\x5d\x8f\x47\x93\x30\x01\x3a\x36\x95\x08\x93\xa3\xba\x8e\xfe\x1a\x00\x67\x3a\xde\x7b\x3b\x15\x00\x00")
 
 func templates_testRelationship_to_one_setopsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -869,11 +1396,11 @@ func templates_testRelationship_to_one_setopsGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/relationship_to_one_setops.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc6, 0xda, 0xa9, 0x73, 0x15, 0xfa, 0xfa, 0xc1, 0x56, 0x62, 0xb3, 0x95, 0xb4, 0x37, 0x8b, 0xef, 0x49, 0x30, 0xe0, 0xa7, 0xf1, 0x42, 0x94, 0x95, 0x1c, 0x71, 0xdd, 0x6d, 0x78, 0x46, 0xee, 0x77}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x98, 0x6e, 0xd7, 0xdc, 0xb9, 0xc0, 0x51, 0xb5, 0xf8, 0x27, 0x93, 0x63, 0xf7, 0xd4, 0xb7, 0xf2, 0xb2, 0x26, 0xb6, 0x47, 0xb1, 0x98, 0xab, 0xbc, 0x47, 0x50, 0x2, 0xd8, 0xd9, 0x3, 0xd9, 0xb5}}
 	return a, nil
 }
 
-var _templates_testReloadGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x53\xb1\x6e\xdb\x30\x10\x9d\xc9\xaf\xb8\x0a\x6d\x41\x16\x0a\x3f\x20\x85\x87\x38\xee\x90\xa1\x46\x10\x2b\xe8\x58\xd0\xd2\xc9\x25\x42\x93\x01\x49\x35\x6a\x89\xfb\xf7\x82\x72\x1b\xab\x40\x8c\x66\x68\xb6\x0c\x86\x45\xe9\xde\x7b\x77\xef\x1e\x73\x3e\x83\xb7\xda\x1a\x1d\xe1\x7c\x01\xea\xa2\x3c\x61\x54\x8d\xde\x5a\x84\xc3\x9f\x5a\xeb\x3d\x12\xf1\x7e\x70\x2d\x24\x8c\x29\xe7\x03\x42\xdd\xde\x5f\xdb\x21\x68\x4b\x74\x83\xd6\xeb\x4e\x24\xf8\x50\x0a\x8c\xdb\xa9\x46\x42\xe6\x2c\xa9\x6b\x1d\xb4\xb5\x68\x85\xe4\x9c\x45\xc4\xae\xe8\x04\xed\x3a\xbf\x37\x3f\x51\xad\xf1\x61\x83\xd8\x09\xc9\xd9\x77\x1d\x00\xc3\xf4\xf3\x81\x33\x5f\x0a\xdf\xcf\xb4\x36\xc6\xed\x06\xab\x03\x51\x26\xce\x4c\x3f\x15\xcf\xb9\x36\x29\x0c\x6d\x12\x45\xa4\x06\x5f\xc3\x23\x76\xe5\x1f\xdc\x11\xbd\x5a\x36\x3f\xee\x31\xd6\x90\xc2\x80\x27\xab\x2e\xbd\x1d\xf6\x2e\x7e\x31\xe9\xdb\x0a\x7b\x3d\xd8\xa4\x94\x92\x1f\x27\xd1\x37\x0b\x70\xc6\x96\xf9\x58\x52\x9f\x4a\xbb\xbd\xa8\x6e\xdd\xe4\x59\xf2\xc7\x8e\xe0\xc9\xee\x21\x4e\x7d\x9e\xc3\xbb\x58\xd5\x85\x4f\x72\x46\x9c\xb3\x9c\x4d\x0f\xce\x27\x50\x6b\x7f\xe9\x5d\xc2\x31\x11\xb5\x69\x2c\x3e\xb4\x87\xb3\x5a\xea\xf6\x6e\x17\xfc\xe0\x3a\x21\x73\x46\xd7\x11\x71\x76\x28\xf9\x3c\xc4\xd4\x8c\x62\x62\x99\x33\x6c\xbd\xb1\x6a\x89\x3b\xe3\x26\x88\x8d\x38\x7f\xd7\x8c\xa2\x4d\x63\x5d\xe6\xf9\x43\x28\x39\xeb\xb0\xc7\x00\x65\xe1\x42\x42\x86\xaf\xb0\x80\x34\xaa\x1b\x6f\xed\x56\xb7\x77\x42\x02\x95\x85\x3d\xae\xc0\xab\x2b\x17\x31\x24\x71\x6a\x84\xe2\x32\xba\x0e\xce\x88\xa0\x9c\x26\xfd\x2b\xd7\x63\x10\xf2\xa4\xa7\xe2\x68\xcd\x4c\xe9\x77\xd2\x9e\xa7\xf4\x6f\x6e\xe2\xcf\xc8\xf5\x85\xb5\xaf\xd1\x7e\x8d\xf6\x8b\x44\x3b\x5a\xd3\x62\x19\xf2\x49\x43\x37\xe5\x6b\xce\x55\xae\x88\x7c\xce\x15\x55\xf4\xd7\x7d\x98\xd0\xea\x98\xd2\xff\x77\x2d\x7e\x05\x00\x00\xff\xff\x7b\x53\x0d\x40\x19\x06\x00\x00")
+var _templates_testReloadGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x53\xcd\x6e\x13\x31\x10\x3e\xdb\x4f\x31\xac\x00\xd9\x68\xeb\x07\x28\xca\xa1\x69\x38\xf4\x40\x54\x35\x5b\x71\x44\xce\xee\x6c\xb0\xea\xd8\x95\xed\xa5\x0b\xd6\xbc\x3b\xf2\x06\xc8\x22\x35\x6a\x2e\xdc\x7a\x88\xb2\x3f\xf3\xfd\xcc\xe7\x6f\x73\xbe\x80\xb7\xda\x1a\x1d\xe1\x72\x01\xea\xaa\x5c\x61\x54\x8d\xde\x5a\x84\xc3\x9f\x5a\xeb\x3d\x12\xf1\x7e\x70\x2d\x24\x8c\x29\xe7\x03\x42\xdd\x3f\xde\xda\x21\x68\x4b\x74\x87\xd6\xeb\x4e\x24\xf8\x50\x06\x8c\xdb\xa9\x46\x42\xe6\x2c\xa9\x5b\x1d\xb4\xb5\x68\x85\xe4\x9c\x45\xc4\xae\xe8\x04\xed\x3a\xbf\x37\x3f\x51\xad\xf1\x69\x83\xd8\x09\xc9\xd9\x77\x1d\x00\xc3\xf4\xf3\x81\x33\x5f\x06\xdf\xcf\xb4\x36\xc6\xed\x06\xab\x03\x51\x26\xce\x4c\x5f\x06\x61\xc6\xb5\x49\x61\x68\x93\x28\x1a\x35\xf8\x1a\xfe\x42\x57\xfe\xc9\x1d\xc1\xab\x65\xf3\xe3\x11\x63\x0d\x29\x0c\x78\x72\xea\xda\xdb\x61\xef\xe2\x17\x93\xbe\xad\xb0\xd7\x83\x4d\x4a\x29\xf9\x71\xd2\x7c\xb3\x00\x67\x6c\x59\x8f\x25\xf5\x29\x04\x1f\x7a\x51\xdd\xbb\x92\x15\x24\x7f\x34\x04\xcf\x9a\x87\x38\xf9\xbc\x84\x77\xb1\xaa\x0b\x9f\xe4\x8c\x38\x67\x39\x9b\x1e\x9c\x4f\xa0\xd6\xfe\xda\xbb\x84\x63\x22\x6a\xd3\x58\x62\x68\x0f\xf7\x6a\xa9\xdb\x87\x5d\xf0\x83\xeb\x84\xcc\x19\x5d\x47\xc4\xd9\x61\xe4\xf3\x10\x53\x33\x8a\x89\x65\xce\xb0\xf5\xc6\xaa\x25\xee\x8c\x9b\x20\x36\xe2\xfc\x59\x33\x8a\x36\x8d\x75\xd9\xe7\x0f\xa1\xe4\xac\xc3\x1e\x03\x94\xf3\x16\x12\x32\x7c\x85\x05\xa4\x51\xdd\x79\x6b\xb7\xba\x7d\x10\x12\x48\xc8\xd9\x09\x78\x75\xe3\x22\x86\x24\x4e\xad\x50\x52\x46\xd7\xc1\x05\x11\x14\xb5\x49\xff\xc6\xf5\x18\x84\x3c\x99\xa9\x38\x46\x33\x53\xfa\x5d\xb4\xf3\x94\x5e\xe6\x26\x7e\x46\xad\xaf\xac\x7d\x6d\xf6\x6b\xb3\xff\x47\xb3\xa3\x35\x2d\x96\x25\x9f\x0d\x74\x53\xde\xe6\x5c\xe5\x8a\xc8\xe7\x5c\x51\x45\xff\x7c\x0e\x13\x5a\x1d\x4b\x7a\x9e\xcb\x97\x7d\x11\xff\x35\x00\x73\xd3\x2e\xb5\x17\x06\x00\x00")
 
 func templates_testReloadGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -889,11 +1416,11 @@ func templates_testReloadGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/reload.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x89, 0xb0, 0x6a, 0xf2, 0x66, 0x3b, 0x8e, 0x6b, 0xd3, 0xb3, 0x72, 0x5, 0x93, 0x9e, 0xfc, 0x20, 0xff, 0xd6, 0xfb, 0xda, 0xbc, 0x57, 0x27, 0x40, 0x5c, 0xd6, 0x3b, 0x23, 0x97, 0x6d, 0x7e, 0x65}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3d, 0xee, 0x3c, 0xf1, 0xf8, 0xd1, 0x6b, 0xb8, 0xd0, 0xb6, 0x36, 0xa5, 0xd1, 0xf4, 0x4e, 0xd2, 0xde, 0xca, 0xdc, 0xaf, 0x22, 0xfe, 0xc2, 0x4b, 0x9d, 0x81, 0xe8, 0x30, 0x98, 0xce, 0xe8, 0xa1}}
 	return a, nil
 }
 
-var _templates_testSelectGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x8b\xdb\x3c\x10\x3e\x5b\xbf\x62\xde\xf0\xb6\x48\xc5\x2b\xe8\x35\x25\x87\xcd\xa6\x87\x3d\x34\x2c\x4d\x96\x1e\x8b\x62\x8f\x53\xb1\x13\x69\x91\xc6\x8d\x5b\xa1\xff\x5e\xa4\xd0\x4d\x0a\x9b\xd2\x83\xb1\x65\x9e\xaf\x79\x46\x29\xdd\xc0\xff\x86\xac\x89\x30\x5f\x80\xbe\x2d\x5f\x18\xf5\xd6\xec\x08\xe1\xf4\xd2\x6b\x73\xc0\x9c\xc5\x30\xba\x0e\x18\x23\xa7\x74\x62\xe8\xc7\xe7\x07\x1a\x83\xa1\x9c\x37\x48\xd8\xb1\x64\x78\x57\x00\xd6\xed\xf5\x56\x41\x12\x0d\xeb\x07\x13\x0c\x11\x92\x54\x42\x34\x11\xb1\x2f\x3e\xc1\xb8\xde\x1f\xec\x4f\xd4\x6b\x3c\x6e\x10\x7b\xa9\x44\xf3\xdd\x04\xc0\x50\x1f\x1f\x44\xe3\x0b\xf0\xed\x85\xd7\xc6\xba\xfd\x48\x26\xe4\x9c\xb2\x68\xec\x50\xc1\x97\x5a\x1b\x0e\x63\xc7\xb2\x98\xb4\xe0\x5b\x78\xe1\xae\xfc\xd1\x9d\xd9\xab\xe5\xf6\xc7\x33\xc6\x16\x38\x8c\x78\x15\x75\xe7\x69\x3c\xb8\xf8\xc5\xf2\xb7\x15\x0e\x66\x24\xd6\x5a\xab\x0f\xd5\xf4\xbf\x05\x38\x4b\x65\xbe\x86\xf5\xc7\x12\x77\x90\xb3\x47\x57\x3b\x63\x7f\x4e\x04\xaf\xa6\x87\x58\x73\xce\xe1\x4d\x9c\xb5\x45\x4f\x89\x26\x0b\xd1\xa4\x64\x07\x70\x9e\x41\xaf\xfd\x9d\x77\x8c\x13\xe7\xdc\xf1\x54\x7a\xe8\x4e\x67\xbd\x34\xdd\xd3\x3e\xf8\xd1\xf5\x52\xa5\x84\xae\xcf\x59\x34\x27\xc8\xa7\x31\xf2\x76\x92\x55\xe5\x52\x61\xe7\x2d\xe9\x25\xee\xad\xab\x14\x8a\x78\xf9\x6f\x3b\xc9\x8e\xa7\xb6\xcc\xf3\x5b\x50\x89\xa6\xc7\x01\x03\x94\x85\x4b\x05\x09\xbe\xc2\x02\x78\xd2\x9f\x3d\xd1\xce\x74\x4f\x52\x41\x2e\x0b\x7b\x59\x81\xd7\xf7\x2e\x62\x60\x79\x6d\x84\xd2\x32\xba\x1e\x6e\x72\x86\x72\xaa\xfe\xf7\x6e\xc0\x20\xd5\xd5\x4e\xe5\xb9\x9a\x48\xb6\xc3\xda\x55\x99\xf4\x95\xfb\x27\x95\xbe\x25\xfa\x47\xff\x73\xf4\xbf\x9a\xda\x01\x08\x9d\xac\xde\xaa\x40\xdf\xff\x01\x9c\x1d\x8d\x63\xf0\x0e\x21\x60\xe7\x43\xdf\xc2\xde\xf3\x7c\xd6\x5e\x90\xaa\x50\x16\xbf\x02\x00\x00\xff\xff\x77\x31\xb2\xd3\x64\x03\x00\x00")
+var _templates_testSelectGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x4f\x8f\xd3\x30\x10\xc5\xcf\xf6\xa7\x18\x2a\x40\x36\xca\x5a\xe2\x5a\xd4\xc3\x76\xcb\x61\x0f\x54\x2b\xda\x15\x47\xe4\x3a\x93\x60\xad\x6b\xaf\xec\x09\x0d\x58\xfe\xee\xc8\xa9\xa0\x41\xda\x22\x0e\x51\xfe\xe8\xcd\xfb\xbd\x79\x4e\xce\x37\xf0\x5a\x3b\xab\x13\x2c\x57\xa0\x6e\xeb\x13\x26\xb5\xd7\x07\x87\x70\xbe\xa9\xad\x3e\x62\x29\xbc\x1b\xbc\x01\xc2\x44\x39\x9f\x27\xd4\xe3\xf3\x83\x1b\xa2\x76\xa5\xec\xd0\xa1\x21\x41\xf0\xae\x0a\xac\xef\xd5\x5e\x42\xe6\x8c\xd4\x83\x8e\xda\x39\x74\x42\x72\xce\x12\x62\x5b\x39\x51\xfb\x36\x1c\xed\x4f\x54\x5b\x3c\xed\x10\x5b\x21\x39\xfb\xae\x23\x60\x9c\xae\x10\x39\x0b\x55\xf8\x76\xc6\xda\x59\xdf\x0f\x4e\xc7\x52\x72\xe1\xcc\x76\x55\x08\x33\xaf\x1d\xc5\xc1\x90\xa8\x8c\x06\x42\x03\x7f\x46\x37\xe1\xe4\x2f\xc3\x9b\xf5\xfe\xc7\x33\xa6\x06\x28\x0e\x78\x55\x75\x17\xdc\x70\xf4\xe9\x8b\xa5\x6f\x1b\xec\xf4\xe0\x48\x29\x25\x3f\x4c\xcc\x57\x2b\xf0\xd6\xd5\xf5\x18\xa9\x8f\x31\x86\xd8\x89\xc5\xa3\xaf\x5d\x01\x85\x4b\x20\x78\x31\x3c\xa4\x29\xe7\x12\xde\xa4\x45\x53\xfd\x24\x67\x85\x73\x96\xb3\xed\xc0\x07\x02\xb5\x0d\x77\xc1\x13\x8e\x54\x8a\xa1\xb1\xd6\x60\xce\xef\x6a\xad\xcd\x53\x1f\xc3\xe0\x5b\x21\x73\x46\xdf\x96\xc2\xd9\x59\xf2\x69\x48\xb4\x1f\xc5\xe4\x32\x77\x38\x04\xeb\xd4\x1a\x7b\xeb\xa7\x11\x97\x70\xfe\x6d\x3f\x0a\x43\x63\x53\xf7\xf9\x6d\x28\x39\x6b\xb1\xc3\x08\xf5\xbc\x85\x84\x0c\x5f\x61\x05\x34\xaa\xcf\xc1\xb9\x83\x36\x4f\x42\x42\x11\x72\x76\x02\x41\xdd\xfb\x84\x91\xc4\xb5\x15\x6a\xcb\xe8\x5b\xb8\x29\x05\x2a\x6d\xe2\xdf\xfb\x0e\xa3\x90\x57\x3b\x15\x97\x6a\x92\xb3\x06\xa7\xae\xea\xa6\x2f\xfc\x7e\x42\xaa\x5b\xe7\xfe\x93\x7f\x89\xfe\x4f\xa8\xed\xc0\xa1\x17\x13\x5b\xd6\x7c\xef\xff\x12\x2e\x4e\xda\x13\x04\x8f\x10\xd1\x84\xd8\x36\xd0\x07\x5a\x2e\x9a\xd9\x90\xe4\xac\xf0\xc2\x7f\x0d\x00\xdf\xc3\x6c\xc3\x63\x03\x00\x00")
 
 func templates_testSelectGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -909,11 +1436,11 @@ func templates_testSelectGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/select.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x44, 0x5a, 0xad, 0x68, 0xde, 0x6a, 0x9a, 0x52, 0x9e, 0xda, 0x62, 0x3e, 0x13, 0x9e, 0x81, 0x0, 0xe3, 0xa7, 0xec, 0x8b, 0x5d, 0x64, 0xe5, 0x80, 0x88, 0x33, 0x6e, 0x81, 0xe8, 0x9d, 0x27, 0xc7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd5, 0x30, 0x2e, 0xc0, 0x36, 0x4a, 0x33, 0x4b, 0x32, 0xee, 0xc4, 0x10, 0x25, 0xf1, 0x2e, 0x7, 0x57, 0xa2, 0x76, 0x1d, 0xae, 0x13, 0x44, 0xec, 0x7f, 0x82, 0x84, 0x7b, 0xae, 0x21, 0xf3, 0x7}}
 	return a, nil
 }
 
-var _templates_testTypesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x41\x4b\x03\x31\x10\x85\xcf\xcd\xaf\x78\x2c\x7b\x50\xe8\x06\xcf\x85\x3d\xa8\xbd\xea\x41\x7b\x13\xb1\x53\x3b\x2e\x81\xec\x6c\x49\x5a\xa5\x0c\xf3\xdf\x25\x89\x78\x7a\x21\xf3\xcd\x7c\x4f\x75\x40\x4f\x31\x50\xc6\x66\x84\xbf\x2f\x2f\xce\x7e\x47\x87\xc8\x68\xe1\x9f\x69\x66\x33\xf7\x4d\x09\x37\x6e\xa5\xda\x78\xbf\x5d\x7e\xe4\x35\xc8\x74\x89\x94\xcc\xb6\x0f\xbb\xeb\x89\x33\x46\xcc\x74\x7a\xcb\xe7\x14\x64\x7a\x6f\xa1\xda\x69\x67\xa6\x9a\x48\x26\x46\x1f\xd6\xe8\x3f\x97\x58\x85\xcd\xf0\xb8\xc4\xcb\x2c\x19\x43\xa1\x06\x84\x2f\x48\xe1\x70\x67\xb6\x56\x65\x39\x9a\xed\xff\xbd\x0d\xae\x27\xfe\xaa\xed\x37\x28\xe3\xf2\xd1\x6a\x54\xbc\xae\xa9\x76\xd6\x99\xb9\xd5\x07\x46\x1c\xae\x67\xce\xfe\x29\xc8\x0b\xd3\xd1\xdd\xba\xdf\x00\x00\x00\xff\xff\x45\xa6\x71\x4c\xfd\x00\x00\x00")
+var _templates_testTypesGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x41\x4b\x03\x31\x10\x85\xcf\xcd\xaf\x78\x2c\x7b\x50\xe8\x06\xcf\x85\x3d\xa8\xbd\xea\x41\x7b\x13\xb1\x53\x3b\x2e\x81\xec\x6c\x49\x5a\xa5\x0c\xf3\xdf\x25\x89\x78\x7a\x21\xf3\xcd\x7c\x4f\x75\x40\x4f\x31\x50\xc6\x66\x84\xbf\x2f\x2f\xce\x7e\x47\x87\xc8\x68\xe1\x9f\x69\x66\x33\xf7\x4d\x09\x37\x6e\xa5\xda\x78\xbf\x5d\x7e\xe4\x35\xc8\x74\x89\x94\xcc\xb6\x0f\xbb\xeb\x89\x33\x46\xcc\x74\x7a\xcb\xe7\x14\x64\x7a\x6f\xa1\xda\x69\x67\xa6\x9a\x48\x26\x46\x1f\xd6\xe8\x3f\x97\x58\x85\xcd\xf0\xb8\xc4\xcb\x2c\x19\x43\xa1\x06\x84\x2f\x48\xe1\x70\x67\xb6\x56\x65\x39\x9a\xed\xff\xbd\x0d\xae\x27\xfe\xaa\xed\x37\x28\xe3\xf2\xd1\x6a\x54\xbc\xae\xa9\x76\xd6\x99\xb9\xd5\x07\x46\x1c\xae\x67\xce\xfe\x29\xc8\x0b\xd3\xd1\xdd\xba\xdf\x01\x00\x45\xa6\x71\x4c\xfd\x00\x00\x00")
 
 func templates_testTypesGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -933,7 +1460,7 @@ func templates_testTypesGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testUpdateGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\x62\x2a\xb4\x05\xd5\x75\xb8\xdb\x6b\x52\x1f\xf2\xb1\x2d\x82\x22\x69\x90\x38\xed\x61\xb1\x58\x30\xd2\x48\x21\x42\x91\x2a\x35\x8a\xed\x0a\xfc\xef\x05\x29\xd9\x72\xba\xb1\xd7\x40\x37\x7b\xca\x21\x1f\xb6\xe6\xe3\xcd\xd3\xe3\x1b\x76\xdd\x01\x7c\x2f\x94\x14\x0d\x1c\x4e\x81\x1f\xfb\xff\xb0\xe1\x33\x71\xa7\x10\xfa\x3f\xfc\x52\x54\xe8\x5c\x5c\xb4\x3a\x03\xc2\x86\xba\xae\xcf\xe0\xb7\xf5\x95\x6a\xad\x50\xce\xdd\xd6\xb9\x20\x64\x04\x3f\xf9\x00\xa9\x4b\x3e\x4b\xa1\x8b\x23\xe2\x57\xc2\x0a\xa5\x50\xb1\x34\x8e\x23\x59\xc0\x3b\x98\x4e\x41\xa1\x66\xeb\x2a\x67\x66\xae\x6f\xa4\x2e\x5b\x25\xac\x73\x57\x56\x56\xc2\x2e\x7f\xc7\xe5\xa9\x51\x6d\xa5\x9b\x50\x27\x22\x7e\xf3\x20\x6b\x96\xf8\xdf\xb5\xd4\x25\x50\x40\x38\x97\x74\x0f\xda\x40\xdd\x67\xc1\x03\x2e\x21\xeb\xf3\x92\x34\x8e\x5c\x68\xb9\xa3\xdb\xb1\x52\xeb\x36\x5f\x1d\x97\xd1\x6a\xb9\x1d\x59\x1c\x35\x88\xb9\x67\xdd\x0a\x9d\x9b\x4a\xfe\x83\xfc\x12\xe7\x37\x88\x39\x4b\xe3\xe8\x51\x58\x40\x1b\x7e\x8c\x8d\x23\xe3\x03\x7f\xdc\x60\x7e\x44\xd6\xf5\x53\xfa\xe0\xcd\x5a\x37\x64\xdb\x8c\x98\x6f\x32\x01\x33\x81\x2d\x73\x9d\x9d\xcc\x96\x35\x36\x13\x20\xdb\xe2\xd6\xa8\x61\xe6\xbf\x24\xdd\x9f\x61\x21\x5a\x45\x9c\xf3\xf4\x28\x34\xfd\x6e\x0a\x5a\xaa\x81\x8d\xf7\x1e\x6e\xc1\x92\x5b\x1d\x78\x20\x33\x22\x82\x67\xd1\x43\x13\x70\x1e\xc2\x0f\x4d\x32\xf1\xf5\x06\x72\xba\x4e\x16\xa0\x0d\x01\xbf\x34\xa7\x46\x13\x2e\xc8\xb9\x8c\x16\x9e\x87\xac\xff\xcc\x4f\x44\xf6\x50\x5a\xd3\xea\x9c\xa5\x5d\x87\x3a\x77\x2e\x8e\xfa\x90\x8b\xb6\xa1\xd9\x82\x85\x2a\x9b\x15\xee\x8c\x54\xfc\x04\x4b\xa9\x43\x8a\x6a\x70\xf3\xbb\xd9\x82\x65\xb4\x98\xf8\x79\x56\x05\xd3\x38\xca\xb1\x40\x0b\x5e\xfe\x2c\x85\x0e\x3e\xc1\x14\x68\xc1\xaf\x8d\x52\x77\x22\x7b\x60\x29\x38\xff\xc2\xd6\xaf\xc0\xf0\x73\xdd\xa0\x25\xb6\x6d\x04\xcf\x32\xea\x1c\x0e\x9c\x03\xff\x29\xf4\x3f\xd7\x05\x5a\x96\x6e\xe5\x94\x8d\xd4\x64\xa6\xd5\x14\xb8\xf2\x93\x3e\x73\x1a\x59\xca\x4f\x7d\xcc\x9e\x08\x46\xf0\x3b\xdb\xca\x02\x42\x67\x1f\xf5\xf3\x93\x98\x64\x2e\x34\x81\xd1\x08\x16\x33\x63\xf3\x09\x94\x86\x0e\x93\x49\x1f\x3f\xa6\xbf\xa8\x44\x3f\x3b\xa0\xdf\x44\xa1\xfc\xd2\x5c\x9b\x79\x73\x5c\x14\x98\x11\x06\x46\x9f\x48\x61\x30\xc6\x97\x91\x42\xd4\x2b\x78\xdd\xd4\xf6\x48\xd6\xd2\x78\xd9\xf6\x10\x7a\x8f\x6d\x9f\xd1\x45\x73\x6f\x5a\x95\xf7\x46\x28\x02\x45\xbd\x4a\xcc\x1c\xee\x5a\x1a\xbe\xc2\x3c\x99\xac\x6a\xac\xc7\xea\x41\xc5\x2e\xde\xb9\x76\x6e\x94\xcc\xb0\x9f\xf1\x58\xa9\x7d\xd6\xcf\xeb\x2e\x78\xdd\x05\xaf\xbb\xe0\x75\x17\x7c\x65\x85\xbe\x7d\x0b\xd7\x58\x99\x47\x84\xab\xf1\x8c\x37\x20\x74\x0e\xad\x96\x7f\xb7\xb8\x3a\xef\x50\x58\x53\xc1\xfc\x5e\x10\xcc\x11\x6a\x25\xb4\xef\xda\x06\x0b\xeb\x0f\x7a\x21\x51\xe5\x0d\x7c\xf8\xd8\x90\x95\xba\x0c\x64\x35\x64\x2b\xa1\x4b\x15\x68\x92\xba\x0c\xbe\x77\x21\x28\xbb\xff\xb2\x99\xed\x4f\x52\xef\x62\x43\xff\xe9\xb6\xb4\xb1\xf2\x7a\x07\x3c\x49\xdb\xc0\x8a\x74\x6a\xaa\x5a\x61\x85\x9a\x58\x1c\x45\xd1\x97\xc1\xee\x88\xfa\x0c\xaf\x0f\x4e\x63\x1f\x7f\xe0\xd7\x10\x3f\x93\x42\x61\x46\xfc\xb6\xc1\xe3\x96\xcc\x10\x05\xde\x08\xf6\x83\xd7\x07\xed\xc2\xb0\xe1\x7d\xbe\xc5\x26\x82\xc1\x72\xbc\x1a\x1e\x85\x6a\x31\xb8\x39\x16\x01\xd1\xb9\xce\xa5\xc5\x8c\xd8\xea\x8b\x3f\x7d\xc4\x1f\x05\x33\x69\x1a\x47\xb4\xac\x37\x83\xbd\xc0\xc3\x23\xfe\x5e\x61\xe5\xed\x44\xfb\xc7\xb4\xac\xf9\x65\x5b\xfd\xea\x31\x86\x6d\xd6\x8b\xe6\x42\x84\xe4\x0b\xef\xfc\x85\xb1\xf0\xc9\x9f\x34\x35\xac\x92\x12\x57\x72\x0a\xaf\xc8\x58\x90\xfe\xc9\xbb\x23\x90\xf0\x0b\xe8\x23\x90\x6f\xde\x84\x47\x51\xb1\x6a\xd1\xd7\x97\x7e\x2a\xaf\xbc\x82\xcf\x44\xc9\x7f\x43\x62\x89\xb7\xa6\x24\xac\x46\xdf\x20\x64\x8d\x18\x3e\x64\x46\x7d\x84\x29\x84\xd1\xd7\x45\xf8\xb9\x26\xb4\x85\xc8\x90\x85\x82\xfe\x4d\x0c\x1c\x35\x5e\xc2\xff\xf1\xac\x91\xe7\x20\xf0\xae\x4b\xba\xc4\x39\xd3\x75\x89\x4b\x9c\xdb\xeb\xc6\x15\xca\xf2\xf1\x4a\xb0\xaf\xef\xae\x07\xf9\xff\xb7\xae\x97\x87\xb0\xc7\xcd\xcb\xbb\x30\xe6\x1b\x3e\x3c\x94\xcf\xc3\xc5\xab\x34\xb4\xeb\xce\xf5\x6f\x00\x00\x00\xff\xff\x40\xe5\xdf\x78\x15\x10\x00\x00")
+var _templates_testUpdateGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x6f\xe3\x36\x13\x3e\x4b\xbf\x62\x5e\xe1\x6d\x41\x75\x1d\xee\xf6\x9a\xd4\x87\x7c\x6c\x8b\xa0\x48\x1a\xc4\x76\x7b\x58\x2c\x16\x8c\x34\x92\x89\x50\xa4\x4a\x51\xb1\x5d\x81\xff\xbd\x18\x4a\xfe\xd8\x6e\xec\x35\xd0\x4d\x4f\x39\xe4\xc3\xd2\xcc\xf3\x3c\x33\x1c\xce\x8c\xbb\xee\x04\xfe\x2f\x94\x14\x0d\x9c\x8e\x81\x9f\xd3\x7f\xd8\xf0\xa9\x78\x50\x08\xfd\x1f\x7e\x2b\x2a\xf4\x3e\x2e\x5a\x9d\x81\xc3\xc6\x75\x5d\xef\xc1\x67\xf5\x9d\x6a\xad\x50\xde\xcf\xea\x5c\x38\x64\x0e\x7e\x20\x03\xa9\x4b\x3e\x4d\xa1\x8b\x23\xc7\xef\x84\x15\x4a\xa1\x62\x69\x1c\x47\xb2\x80\x77\x30\x1e\x83\x42\xcd\x36\x28\x57\x66\xa1\x27\x52\x97\xad\x12\xd6\xfb\x3b\x2b\x2b\x61\x57\xbf\xe2\xea\xd2\xa8\xb6\xd2\x4d\xc0\x89\x1c\x9f\x3c\xca\x9a\x25\xf4\xbb\x96\xba\x04\x47\xd2\x60\x21\xdd\x1c\xb4\x81\xba\xf7\x82\x47\x5c\x41\xd6\xfb\x25\x69\x1c\xf9\x40\x79\x80\xed\x5c\xa9\x0d\xcd\x37\xd7\x65\xb4\x5a\xed\x57\x16\x47\x0d\x62\x4e\x59\xb7\x42\xe7\xa6\x92\x7f\x21\xbf\xc5\xc5\x04\x31\x67\x69\x1c\x3d\x09\x0b\x68\xc3\x8f\xb1\x71\x64\xc8\xf0\xfb\x8d\xb6\x59\xbd\x55\xd6\xf5\x51\x92\xf1\x0e\xd6\xc4\xd9\x36\x73\x8c\x38\x46\x60\x46\xb0\x27\xac\xab\x8b\xe9\xaa\xc6\x66\x04\xce\xb6\xb8\xd7\x6a\x08\xf9\x0f\xe9\xe6\x57\x58\x88\x56\x39\xce\x79\x7a\x46\xe2\xe0\x7f\x63\xd0\x52\x0d\xc9\x78\x6f\xad\xb1\x05\x4b\x66\x3a\x1c\x8f\x33\x5b\x41\xf0\xac\x78\x68\x82\xce\x53\xf8\xae\x49\x46\x84\x37\xe4\xa6\xeb\x64\x01\xda\x38\xe0\xb7\xe6\xd2\x68\x87\x4b\xe7\x7d\xe6\x96\x94\x86\xac\xff\xcc\x2f\x44\xf6\x58\x5a\xd3\xea\x9c\xa5\x5d\x87\x3a\xf7\x3e\x8e\x7a\x93\x9b\xb6\x71\xd3\x25\x0b\x28\xbb\x08\x0f\x46\x2a\x7e\x81\xa5\xd4\xc1\x45\x35\xb8\xfb\x6c\xba\x64\x99\x5b\x8e\x28\x9e\x35\x60\x1a\x47\x39\x16\x68\x81\xaa\x9f\xa5\xd0\xc1\x27\x18\x83\x5b\xf2\x7b\xa3\xd4\x83\xc8\x1e\x59\x0a\x9e\xa5\x3b\x27\x60\xf8\xb5\x6e\xd0\x3a\xb6\x2f\x04\xca\x32\xea\x1c\x4e\xbc\x07\x62\x0b\xfc\xd7\xba\x40\xcb\xd2\xbd\x39\x65\xdb\xd4\x64\xa6\xd5\x2e\xe4\x8a\x22\x7d\xe6\x32\xb2\x94\x5f\x92\xcd\x91\x0a\xb6\xe2\x0f\xd2\xca\x02\x02\x33\x89\xfb\xf1\x33\x9b\x64\x21\xb4\x03\xa3\x11\x2c\x66\xc6\xe6\x23\x28\x8d\x3b\x4d\x46\xbd\xfd\xd6\xfd\x25\x2b\xf4\x8b\xeb\xf9\x9f\x14\x28\xbf\x35\xf7\x66\xd1\x9c\x17\x05\x66\x0e\xc3\x91\xee\x44\x6a\xf8\xd0\x16\x5f\xa6\x12\xa2\xbe\x80\x37\xa4\xb6\x57\xb2\xa9\x8c\x97\xa5\x87\xc0\xbd\xa5\x7d\xa6\x2c\x9a\xb9\x69\x55\xde\xb7\x41\x11\x52\xd4\x17\x89\x59\xc0\x43\xeb\x40\x0c\x59\x4b\x46\x6b\x8c\x4d\x58\xbd\xa8\xd8\xc7\x07\x87\xce\x44\xc9\x0c\xfb\x18\xcf\x95\x3a\x66\xf8\xbc\x4e\x82\xd7\x49\xf0\x3a\x09\x5e\x27\xc1\x37\x9d\x04\x6f\xdf\xc2\x3d\x56\xe6\x09\x61\xa0\xa6\x1b\xde\x80\xd0\x39\xb4\x5a\xfe\xd9\xe2\xfa\xb6\x43\x61\x4d\x05\x8b\xb9\x70\xb0\x40\xa8\x95\xd0\xc4\xda\x86\x06\xd6\x2f\x7c\x85\x44\x95\x37\xf0\xe1\x63\xe3\xac\xd4\x65\x48\x75\xe3\x6c\x25\x74\xa9\x90\x4f\xc2\xc3\xd0\xf5\x6e\x84\xcb\xe6\x5f\x6f\x65\xc7\x27\xa9\xef\x61\x03\xff\x78\x9f\xdb\x16\x79\x33\x01\x3e\x73\xdb\xd1\x8a\xee\xd2\x54\xb5\xc2\x0a\xb5\x63\x71\x14\x45\x5f\x85\x1c\x1d\xb0\xfa\x42\x2f\x19\xa7\x31\xd9\x9f\x00\xdd\xec\x2b\x29\x14\x66\x8e\xcf\x1a\x3c\x6f\x9d\x19\xac\xc0\xfb\x63\xe5\xf5\x31\x1c\xd2\x30\x60\xd2\x12\x4c\x14\xbb\x0a\x86\x8e\x43\x97\xe3\x49\xa8\x16\xa9\x37\x59\x2c\x82\xa2\x6b\x9d\x4b\x8b\x99\x63\xeb\x07\xbf\x93\xc5\x6f\x05\x33\x69\x1a\x47\x6e\x55\xef\x1a\x53\x81\x87\x57\xfc\xbd\xc2\x8a\xf6\x4a\x4d\xaf\xdd\xaa\xe6\xb7\x6d\xf5\x33\x69\x0c\xb3\xac\x2f\x9a\x1b\x11\x9c\x6f\xe8\x1b\x40\x61\x2c\x7c\xa2\x95\x4b\x0d\x83\xa4\xc4\x75\x39\x85\x23\x32\x16\x24\xbd\x79\x77\x06\x12\x7e\x02\x7d\x06\xf2\xcd\x9b\x70\xe8\x51\xb1\xa6\xe8\xf1\x25\x45\x45\x95\x57\xf0\xa9\x28\xf9\x2f\xe8\x58\x42\x9b\x49\x12\x06\x23\x11\x04\xaf\xad\x86\x0f\x99\x51\x1f\x61\x0c\x21\xf4\x0d\x08\xbf\xd6\x0e\x6d\x21\x32\xa4\x30\x22\x9a\xec\xd1\x90\xa3\x86\x4a\xf8\x1f\x2d\x6b\x9b\xe7\x50\xe0\x5d\x97\x74\x89\xf7\xa6\xeb\x12\x9f\x78\x7f\xd4\xbe\x15\x60\x87\xa5\x87\x16\x82\x63\xdb\xee\x26\x90\x7f\xbf\x73\xbd\xbc\x84\x23\xf6\x2e\x5a\xc7\x31\xdf\x69\xc3\x03\x7c\x1e\xd6\xae\xd2\xb8\x43\x1b\xd7\xdf\x03\x00\x58\x81\xf4\x58\x11\x10\x00\x00")
 
 func templates_testUpdateGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -949,11 +1476,51 @@ func templates_testUpdateGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/update.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7, 0xf5, 0x2a, 0x19, 0x29, 0xc0, 0xb4, 0x32, 0x3, 0x4f, 0x9c, 0xe3, 0x96, 0xcd, 0x64, 0xf7, 0x90, 0xf8, 0x2, 0xbf, 0x90, 0xe4, 0x1f, 0x1f, 0x28, 0x9f, 0xff, 0xba, 0x25, 0xc, 0x25, 0x73}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3c, 0xa2, 0x76, 0xf4, 0x95, 0xdb, 0xa1, 0x0, 0xc8, 0xf0, 0x11, 0x41, 0xe4, 0x6f, 0xab, 0x94, 0x30, 0xdd, 0xf0, 0x2, 0x1a, 0x9b, 0x82, 0xa1, 0xb, 0xfa, 0x82, 0x46, 0x99, 0x10, 0xae, 0xf2}}
+	return a, nil
+}
+
+var _templates_testValidate_lengthsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x96\xdf\x6f\xdb\x36\x10\xc7\x9f\xa5\xbf\xe2\x2a\x24\x83\x94\x7a\x6c\xfb\x9a\xc2\x0f\xed\x96\x0d\x1b\xb6\x2c\x98\xbd\xbd\x04\xc6\xc0\x58\x67\x95\x08\x45\x3a\x24\xb5\xc4\x20\xf8\xbf\x0f\x27\x4a\x4a\xec\xc8\x8d\xbb\xee\x47\xfa\x92\x18\xe2\xdd\xf1\xee\x73\xfc\x92\xe7\xfd\xd7\x70\xc4\xa5\xe0\x16\x4e\xa7\xc0\xde\xd1\x2f\xb4\x6c\xce\xaf\x24\x42\xfc\xc7\xce\x79\x8d\x21\xa4\xab\x46\x2d\xc1\xa1\x75\xde\x47\x0f\xf6\xdb\xfa\x42\x36\x86\xcb\x10\x7e\xe7\x52\x94\xdc\xe1\x4f\xa8\x2a\xf7\xc1\xe6\x0e\x4e\xc8\x52\xa8\x8a\xcd\x0b\xf0\x69\xe2\xd8\x05\x37\x5c\x4a\x94\x79\x91\xa6\x89\xa6\xdd\xbe\x7a\x10\x68\x26\x54\xd5\x48\x6e\x42\xf0\x21\x4d\xc4\x0a\xd0\x18\xb2\xd1\x6c\x37\x74\xf1\xb6\x5d\x7b\x31\x05\x25\x24\x85\x4e\x1c\x3b\x33\x46\x9b\x1c\x8d\x29\xd2\x24\xa4\x09\x15\x65\xb8\xaa\x10\x8e\x96\x5a\x52\x98\xae\x92\x6f\xb4\x6c\x6a\x65\x43\x67\x73\x54\xf3\x3b\x5a\x25\x2b\xf6\x33\xbf\x8b\x5b\xf4\xab\x62\x05\x95\x8b\x36\xaf\x07\x8f\xa5\x96\xef\x7a\x5a\x5d\xf2\x31\x68\xbb\x55\x8f\xaa\xf7\xc7\x9b\xf8\x79\xbe\x59\x23\x64\xd6\x19\xa1\xaa\x2c\x84\x16\xc0\xc7\xea\xd7\xcc\xfb\x61\xab\x10\x60\x0a\xd1\x37\xaf\xf9\x35\xe6\x97\x0b\xd3\x28\x9c\x80\xf7\x94\x5c\x08\xf0\x12\xde\x14\xc5\x41\xd4\xa6\x8f\xa9\x65\x78\xb7\xc6\xa5\xc3\x12\xb8\x22\x1b\x6d\x60\xa5\x0d\x05\xbf\x2f\x08\xa4\x56\x15\x1a\x70\x1f\xb8\x1a\xb6\xcd\xee\x61\xa3\xb4\x48\xf5\x6a\x03\xf9\x76\xcd\xaa\x91\x92\xcd\xda\xe4\xb3\x62\x77\xd1\xde\x48\x76\xde\x48\xd9\xaf\x7f\x3a\x99\x2e\xf4\x81\x80\x76\x9d\x5b\x48\x30\x05\x67\x1a\x7c\x0e\xf8\xb6\xe9\x9c\x7c\xce\x79\x51\x78\x9b\x47\xff\x22\x4d\x4e\xbe\xd4\xf3\xb4\x0d\xe4\x72\x71\xb5\x71\xf8\xf7\x78\x74\x85\x52\x80\x9d\x42\xff\xf3\x3a\x81\x92\xb0\x5d\xb5\x07\x66\x5a\xfc\x23\x77\xe2\x38\xd6\x56\xa2\xef\xdb\x9c\x3e\x1d\x6d\x74\x7c\x02\xf0\xb3\xd2\xdd\x16\xfe\xfd\x54\x4e\x3e\xe7\xb4\x91\xfa\xa2\xff\xb8\xfa\x3e\x06\xeb\x7f\xc7\xa1\xca\xb0\x43\x86\xab\x32\xa2\xf9\xc1\x7e\x8b\x4b\x51\x73\x09\x39\x3d\x8d\xf4\xe9\xc2\xe0\x52\x58\xa1\x15\xbc\x2e\x20\x7f\xfc\x00\xb8\xcd\x1a\x2d\xeb\xdc\x1e\x3f\x01\x71\x99\x1e\x81\xc1\xe4\x90\x67\xe0\x21\x24\xef\xf7\x3e\xc4\x6c\xb6\xe4\x2a\xcf\xbc\x5f\x1b\xa1\xdc\x0a\xb2\x37\x67\x2f\x8f\xcb\x6c\x27\xf3\x10\xb2\x31\x01\x7d\xc7\x1d\x97\xf7\x02\xfa\x17\xfb\x72\x2b\xca\xbe\x2d\x65\xa4\x90\x7b\xbf\x9b\xe3\xa4\xfb\x34\x5b\x72\x89\x21\x14\x7b\x5a\xd6\xfe\x0c\x69\x37\x80\xb0\xef\x51\xa1\xe1\x0e\xfb\x8c\x89\xed\x21\x83\xdc\x93\x13\xdc\xab\x57\x70\xf6\x27\x9a\x0d\x18\xbc\x69\x84\xc1\x12\x96\x11\xbf\xa6\xf3\x02\x58\xaf\xdd\x06\x46\xfb\x07\xc2\x82\xc1\xb5\x36\x0e\xcb\x34\xe9\x98\xe6\xfb\x7a\x5d\x0c\xb0\xf3\x22\x4d\x7a\x3f\x6a\x43\x2b\xa2\x9a\xaf\x2f\xe3\x5b\xb6\xb8\xd2\x5a\x0e\xfa\xb1\x13\xd0\xd7\x64\x85\xc6\xb0\xfc\x4a\x0b\xd9\xc7\x11\x5a\xb5\xad\xb1\xc5\x5b\x32\xa1\x66\xd1\xbc\xf3\xc7\x04\x90\xec\xe3\xdc\x48\x11\xa8\xe8\x64\xd8\xf1\x12\xbb\x8e\x16\x8b\xe1\xe6\xa2\x63\x11\xee\xef\x8f\xd1\x03\xb4\xca\xb3\x5b\xae\x1c\x8c\xa6\x30\x81\x4a\x3b\x38\x9e\x9f\xc2\xb1\xcd\x26\x14\xa1\xfd\xb3\x6f\x8a\x5d\x09\xe9\xd0\x74\x43\xec\xfb\xcd\xaf\x3d\xf9\xf1\xe9\x76\x74\x1e\xb3\x7b\x46\xb1\xee\xb6\xdb\xa3\xcf\x1f\x67\xbf\x9c\x8f\x68\x57\xd4\xc8\xe6\xa2\xde\xeb\x36\x48\x9a\x52\x12\x2b\x78\x31\xc0\xcc\xbc\x3f\x62\x17\xd7\x55\x14\xc0\x29\x1d\x94\xae\x88\x4e\xb8\xdb\x02\x69\x4f\x4c\x2c\x36\x5b\x6c\xeb\xab\x85\xbb\x6d\xdd\xef\x02\xfc\x81\xdb\x93\x6a\x41\x55\x86\x90\xfe\x35\x00\x08\x81\xb6\x00\x10\x0d\x00\x00")
+
+func templates_testValidate_lengthsGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testValidate_lengthsGoTpl,
+		"templates_test/validate_lengths.go.tpl",
+	)
+}
+
+func templates_testValidate_lengthsGoTpl() (*asset, error) {
+	bytes, err := templates_testValidate_lengthsGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/validate_lengths.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x64, 0xbc, 0xdd, 0xdb, 0xc6, 0x8b, 0x8a, 0xba, 0x4d, 0xea, 0xfd, 0xd4, 0x1e, 0x1f, 0xec, 0x9c, 0x17, 0xe3, 0xd, 0x1, 0x60, 0xa6, 0xae, 0x96, 0xa4, 0xd4, 0xad, 0xac, 0x0, 0x42, 0xc9, 0x73}}
+	return a, nil
+}
+
+var _templates_testSingletonBoil_embeds_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x4f\x6f\xd4\x30\x10\xc5\xcf\xeb\x4f\xf1\x90\x22\x94\xa0\x6c\x7a\x41\x1c\x8a\xf6\xd2\x02\x12\x97\x1e\x80\x1b\x42\xaa\x1b\x8f\x77\x2d\xd9\x93\x95\xed\xf0\xcf\x9a\xef\x8e\x1c\x97\xb2\x5c\x7a\x4a\x26\x79\xf3\x7e\x7e\x33\x2e\x65\x8f\xa8\xf9\x48\xe8\x28\x3c\x90\xc1\xf5\x01\xd3\xfb\xfa\x96\x44\x54\xfd\xdb\xb1\x0e\x54\x3f\x37\xc1\x74\xa7\x03\x89\xa8\xef\x3a\xa2\x94\x59\x07\xf2\xb7\x3a\x51\x93\x89\xbc\xbb\xf9\xf2\xeb\x4c\x09\x07\x04\x7d\xfe\x9a\x72\x74\x7c\xfc\xd6\x1e\x05\x17\x30\x37\xa2\xb3\x17\xae\x1f\x1c\x79\x93\x44\x4a\x71\x16\x9d\x13\x19\x51\x0a\xb1\x11\xb9\x2f\xa5\xb3\x8f\xd4\xfb\x6b\xb4\xf2\x76\xf1\x6b\xe0\xa9\xd1\x36\x0d\xb1\xc1\x5e\x04\xa2\xd4\xd5\x15\x3e\x69\x36\x4b\x70\xbf\x09\x9e\x72\xaa\x09\x5b\x39\x7d\xce\x71\x9d\x33\xac\xf3\x1e\x8e\x91\x4f\x04\xbb\xa1\xb1\x58\x94\xf2\x98\x02\x3f\x4e\xc4\x70\xb9\x5a\x3d\xf5\x26\x68\x84\xc5\x90\xc7\x36\x08\xe3\xf8\x08\x97\x27\x65\x57\x9e\xd1\x13\x5e\x3d\xb5\x0f\xff\xf8\x3d\xd3\xcf\xfc\x91\x33\xaa\xaa\x1f\xe0\x38\xbf\x79\x3d\x36\x66\x3d\x3b\xda\x6c\x46\xa4\xd3\xb2\x7a\x73\x43\x77\xab\xf7\x78\x58\x16\x3f\xa0\xa8\x5d\xa2\xb6\x92\x8b\x00\x44\xe6\xaf\x69\x3f\x0c\x6a\xe7\x2c\x28\xc6\xff\x44\x2d\x64\xff\xb2\x76\x8f\xa0\xf1\x99\x45\x8d\xb0\xda\x27\x1a\xde\x6e\x26\x2f\x0e\x60\xe7\x2b\x78\x77\xd6\xec\xe6\x9e\x62\x1c\xd4\x4e\x54\xbd\x0a\xc4\x06\x7b\x11\xf5\x67\x00\x57\xd5\x0c\x6a\x33\x02\x00\x00")
+
+func templates_testSingletonBoil_embeds_testGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templates_testSingletonBoil_embeds_testGoTpl,
+		"templates_test/singleton/boil_embeds_test.go.tpl",
+	)
+}
+
+func templates_testSingletonBoil_embeds_testGoTpl() (*asset, error) {
+	bytes, err := templates_testSingletonBoil_embeds_testGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates_test/singleton/boil_embeds_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb6, 0xe4, 0x99, 0x2, 0xb8, 0x47, 0x4f, 0xb5, 0x80, 0x37, 0xe, 0xaa, 0xd3, 0xeb, 0xd8, 0xcb, 0x23, 0x8e, 0xd8, 0xf3, 0x7f, 0x97, 0x9a, 0x52, 0xea, 0x40, 0xe2, 0xf5, 0x9a, 0x4d, 0xf9, 0x3}}
 	return a, nil
 }
 
-var _templates_testSingletonBoil_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xdf\x6f\xe3\x36\x0c\x7e\xb6\xfe\x0a\xce\x0f\x07\xf9\x96\x29\xdb\xb0\xbd\x74\xc8\x80\xb5\xe9\xf5\xba\x5d\x7f\xac\xbd\x1b\x06\x0c\x43\xa1\x5a\x74\x22\x4c\xa1\x5c\x49\x8e\x5b\x14\xf9\xdf\x07\xc9\x8e\xe3\xe4\xb2\x01\xf7\xe0\x07\x93\x22\xf9\x7d\xe4\x27\x6a\x2d\x1d\x54\x46\x2e\xe6\xf8\xd8\x2c\xae\xac\x42\x98\xa5\x7f\x71\x6a\xad\xe1\x79\x40\x1f\x84\x7f\x32\x2a\xba\xf3\x09\x54\xd2\x78\x9c\x40\xfe\xb1\x71\xe4\xc1\x12\x24\x07\xac\x62\x60\x65\x1d\xdc\xff\xfe\x01\x7c\x90\x01\x57\x48\xc1\xe7\x05\xdb\xe6\x3f\xb3\x54\xe9\xc5\x3b\x6d\x86\x02\xf7\xc1\x69\x5a\xf4\x25\xca\xe4\xce\x27\x90\xc7\xef\x66\x8d\xce\x69\x85\x1e\xc2\x12\x41\x61\x25\x1b\x13\xa0\x3f\x53\x30\x56\x5a\xf2\x01\x6c\x13\xea\x26\xcc\xb5\x9b\x63\x1d\x96\x30\x83\xd7\x57\x71\xb3\x67\xdb\x6c\x58\x02\xc0\x59\xa6\x1e\xaf\xa4\x26\x88\xc5\xd0\xb1\x82\xb1\xf0\x52\x63\xff\x0b\x9a\x02\xba\x4a\x96\x08\xaf\x2c\xf3\x18\x9a\x9a\x17\x80\xce\x59\xc7\xb2\xd2\x12\xf1\x02\xf8\x5b\xff\x64\xc4\xfc\x74\xd2\xd9\x0b\x96\x05\x94\x4e\xd9\x96\x86\xa3\x1b\xc6\xaa\x86\x4a\xf8\x88\x3e\xc4\x62\x7c\x05\x6f\x63\x01\x4d\x0b\x71\x55\xc4\xd4\xba\x82\x1e\xc7\x6c\x06\xa4\x4d\xb4\x65\xd5\x2a\x88\x5b\xa7\x29\x18\xe2\x39\x59\xd8\x43\x3a\x82\xd6\x4a\x0f\x0e\xa5\x7a\xc9\x0b\x96\x65\xd6\x8b\xf3\x67\x1d\xf8\x37\xdf\x15\x2c\xdb\x30\x96\x39\x49\x4a\xdc\x23\x2a\x1e\xf4\x0a\xc5\xb5\x6d\x79\x21\x3e\x91\x7e\xbe\x96\x64\x79\x51\x30\x96\xa5\xb6\xdf\x4a\xe7\x91\xc7\xdf\xd8\x19\x74\xae\x47\xcf\xb2\xe9\x14\x3e\x58\xa9\xfa\x3e\x37\x4e\x06\x6d\x89\x65\xf1\xc8\x0c\x34\xe9\xf0\x87\xae\xd1\xf1\x22\xf1\x88\xd6\xaf\xfe\x83\x44\x43\xf2\xd1\x20\x04\x0b\x66\x97\x0f\x2a\x6d\x70\x1f\xfa\xf7\x3d\xf4\xe9\x14\xee\x31\xc0\x4e\x82\xde\x42\x8b\x50\x4a\x02\x8f\x08\x0b\x24\x74\x32\xa0\x02\xff\x64\x46\xea\x62\xd9\xa3\xd5\x46\x8c\x95\xfb\x76\x4f\xca\x6c\x40\x3a\xeb\xbb\x2a\xfa\xe1\xfe\xf4\xbf\xf8\x3f\x0d\xf8\xf1\x19\xcb\x26\x20\xa4\xb0\x93\x3c\x0d\x7f\x8f\xc2\x0f\x1d\x05\x88\x24\x29\xb9\xe1\x64\x28\xd6\x49\x87\x01\x1c\xf6\x0b\x00\x60\xaf\x62\x25\xb5\x41\x15\x2b\x2e\x30\x09\x9d\xb0\x8c\xdd\x1f\x4a\x02\x6c\xfa\x89\x95\x91\xa9\xa6\xd0\xb3\xbf\xc7\x30\x3f\xe5\x31\xa2\x88\x5a\x4d\x5d\x58\x89\xbb\x86\xd2\x88\x0f\xe9\xef\x34\xfb\xa5\x1d\xd8\x46\x1e\x6b\xc2\x8f\xfd\x1c\xb7\x86\x08\xa3\x18\xae\xc3\x48\x39\x9d\xd4\x62\x03\x22\xb2\x83\xb5\xd0\x63\x79\xf3\xa6\x9b\xe2\xbe\x27\xcf\x13\xc8\x75\xcc\x13\x39\xef\xbc\xfc\xe0\x74\x44\xd6\xd3\x3e\x99\x41\x17\x70\x87\x52\x5d\x52\x77\xe6\x08\xf5\xcc\x61\x68\x1c\x45\x33\xcb\xb2\x0d\x1b\x0c\xa4\x4d\x37\xde\xcf\xee\xca\x01\x90\x6b\xb9\x42\x9e\xfb\x27\x13\x67\x82\x2e\xee\xa8\xac\x93\xfd\x7b\xbb\xc2\x08\xc4\x7a\x71\x81\x01\x69\xcd\xf3\x3f\xe7\x17\x0f\x67\x37\xd7\xef\x2e\x2f\x1e\xde\xdf\x5c\x9d\xc7\x3b\xb1\xb4\x2b\xbc\x95\x61\x79\x70\x72\xeb\x6e\xd5\x20\xad\xce\xdb\xaa\xe3\xd7\xb0\x55\x30\x03\x9f\x16\xab\x17\x77\x58\xa3\x0c\x3c\x17\x62\x9a\x4f\x0e\xb6\x65\x9c\x18\xa0\xf1\xb8\x0b\x6b\x15\x7c\xfd\x59\xec\x54\x88\xa3\xb1\x03\xbf\x88\xda\x47\x60\x7f\xfd\xdd\xc5\xbe\xb6\x6a\x93\xa0\x19\x24\xbe\xeb\x41\x01\x3f\xc3\xb7\xa9\xd8\x38\x6e\x06\xb2\xae\x91\x14\x1f\x19\x27\x69\x53\xd4\x32\x2c\xc5\xaf\x56\x8f\x73\x4c\x60\xdc\xe2\x7d\x0a\x5f\x9a\x75\xdb\xf1\x09\xe4\xfd\xe3\x33\x3d\xc8\x1d\x17\xa6\x75\xf0\x30\x81\x3a\xf2\x73\x92\x16\x08\xe3\x32\x3b\x41\xfe\xa2\xd4\xd9\xe0\xe0\xf5\x6e\xad\x5d\x2e\xc8\x3a\xec\x54\xe3\x61\x89\x0e\xd3\x13\x6a\xe0\x51\x96\xff\xc4\xeb\xd5\xbf\x6d\x1e\x24\x29\x58\x4b\xa3\x55\xda\xba\xd1\x55\x3b\xbb\xd6\x2a\x05\x7b\x96\x3d\xc0\x71\x31\x8f\xa4\x78\x4e\xeb\xdf\xf0\xe5\x0e\x6b\x23\x4b\x74\x7c\x3b\xca\x6b\x6c\x07\x5b\x1e\xa7\x99\x3f\x24\x82\x3d\xf4\x26\xd8\x95\x0c\xba\x3c\xa7\x75\x5a\x19\x23\xe9\x6f\xd8\xbf\x01\x00\x00\xff\xff\xde\xdd\x84\xb7\x1e\x08\x00\x00")
+var _templates_testSingletonBoil_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\x62\xaa\xc3\x82\x4a\x5d\xba\x2d\xda\x4b\x0a\x17\x68\xe2\x6c\x36\xed\xe6\xa3\xf1\x6e\x51\xa0\x28\x02\x46\x1c\xd9\x44\xa9\xa1\x42\x52\x56\x82\xc0\xff\xbd\x20\x2d\xcb\x1f\x71\x0b\xec\xc1\x07\xcd\xf0\x71\xde\xbc\x79\x1c\x2f\xa5\x83\xca\xc8\xf9\x14\x1f\xdb\xf9\xb5\x55\x08\x93\xf4\x2d\xce\xac\x35\x3c\x0f\xe8\x83\xf0\x4f\x46\xc5\x74\x3e\x82\x4a\x1a\x8f\x23\xc8\x3f\xb5\x8e\x3c\x58\x82\x94\x80\x3a\x02\x2b\xeb\x60\xf6\xfb\x47\xf0\x41\x06\xac\x91\x82\xcf\x0b\xb6\xb9\xff\xdc\x52\xa5\xe7\xef\xb5\x19\x0a\xcc\x82\xd3\x34\xef\x4b\x94\x29\x9d\x8f\x20\x8f\xbf\xdb\x25\x3a\xa7\x15\x7a\x08\x0b\x04\x85\x95\x6c\x4d\x80\xfe\x4c\xc1\x58\x69\xc9\x07\xb0\x6d\x68\xda\x30\xd5\x6e\x8a\x4d\x58\xc0\x04\x5e\x5f\xc5\xed\x5e\x6c\xb5\x62\x89\x00\x67\x99\x7a\xbc\x96\x9a\x20\x16\x43\xc7\x0a\xc6\xc2\x4b\x83\xfd\x27\x68\x0a\xe8\x2a\x59\x22\xbc\xb2\xcc\x63\x68\x1b\x5e\x00\x3a\x67\x1d\xcb\x4a\x4b\xc4\x0b\xe0\x27\xfe\xc9\x88\xe9\xd9\x68\x1d\x2f\x58\x16\x50\x3a\x65\x3b\x1a\x8e\xae\x18\xab\x5a\x2a\xe1\x13\xfa\x10\x8b\xf1\x1a\x4e\x62\x01\x4d\x73\x71\x5d\xc4\xab\x75\x05\x3d\x8f\xc9\x04\x48\x9b\x18\xcb\xaa\x3a\x88\x3b\xa7\x29\x18\xe2\x39\xd9\xcd\x89\x37\xd4\x3a\xe9\xc1\xa1\x54\x2f\x79\xc1\xb2\xcc\x7a\x71\xf1\xac\x03\xff\xe6\xbb\x82\x65\x2b\xc6\x32\x27\x49\x89\x19\xa2\xe2\x41\xd7\x28\x6e\x6c\xc7\x0b\xf1\x99\xf4\xf3\x8d\x24\xcb\x8b\x82\xb1\x2c\xc9\x7e\x27\x9d\x47\x1e\x3f\xa3\x32\xe8\x5c\xcf\x9e\x65\xe3\x31\x7c\xb4\x52\xf5\x3a\xb7\x4e\x06\x6d\x89\x65\xf1\xc8\x04\x34\xe9\xf0\x87\x6e\xd0\xf1\x22\xf5\x11\xa3\x5f\xfd\x47\x13\x2d\xc9\x47\x83\x10\x2c\x98\xed\x7d\x50\x69\x83\xfb\xd4\xbf\xef\xa9\x8f\xc7\x30\xc3\x00\x5b\x0b\x7a\x0b\x1d\x42\x29\x09\x3c\x22\xcc\x91\xd0\xc9\x80\x0a\xfc\x93\xd9\x71\x17\xcb\x1e\xad\x36\x62\xd7\xb9\x27\x7b\x56\x66\x03\xd3\x49\xaf\xaa\xe8\x87\xfb\xd3\xff\xf2\xff\x3c\xf0\xc7\x67\x2c\xdb\x80\x90\x60\xa7\x79\x1a\xfe\x5e\x0b\x3f\xac\x5b\x80\x28\x1a\xa5\x34\x9c\x0e\xc5\x62\x8c\x17\x0c\xe0\x50\x2f\x00\x80\xbd\x8a\x95\xd4\x06\x55\x54\x6c\x8e\xc9\xe8\x84\x65\x54\x7f\x28\x09\xb0\xea\x27\x56\xc6\x4e\x35\x85\xbe\xfb\x19\x86\xe9\x19\x8f\x88\x22\x7a\x35\xa9\x50\x8b\xfb\x96\x78\x71\xa4\xfd\xad\x67\xbf\x54\x81\x0d\xf2\x98\x08\x3f\xf6\x73\xdc\x04\x22\x8d\x62\x78\x0e\x3b\xce\x89\x35\xad\x83\x57\x06\x91\xd9\xc1\x5a\xe8\xb9\xbc\x7b\x07\x27\x6f\x33\x79\x9e\x48\x2e\xa3\x03\xc5\x0c\xc3\x36\xcb\x0f\x4e\xc7\xf1\xf4\x6d\x9f\x4e\x60\x0d\xb8\x47\xa9\xae\x68\x8d\x39\xd2\x7a\xe6\x30\xb4\x8e\x22\x86\x65\xd9\x8a\x0d\x01\xd2\x26\x75\x06\x6f\xde\xca\x01\x91\x1b\x59\x23\xcf\xfd\x93\x89\x33\x41\x97\x47\xed\xd7\xb6\xff\x60\x6b\x8c\x96\xb0\x5e\x5c\x62\x40\x5a\xf2\xfc\xcf\xe9\xe5\xc3\xf9\xed\xcd\xfb\xab\xcb\x87\x0f\xb7\xd7\x17\xf1\x4d\x2c\x6c\x8d\x77\x32\x2c\x0e\x4e\x6e\xd2\x9d\x1a\xac\xb5\xce\x76\xea\xf8\x33\xec\x14\x4c\xc0\xa7\xc5\xea\xc5\x3d\x36\x28\x03\xcf\x85\x18\xe7\xa3\x83\x6d\x19\x27\x06\x68\x3c\x6e\x61\x9d\x82\xaf\xdf\x60\xc7\x42\x1c\xc5\x0e\xfd\x45\xd6\x3e\xd2\xfe\xeb\xef\x35\xf6\xb5\x53\xab\x44\xcd\x20\xf1\xad\x06\x05\xfc\x0c\xdf\xa6\x62\xbb\xb8\x09\xc8\xa6\x41\x52\xfd\xc1\x14\x1c\xa5\x4d\xd1\xc8\xb0\x10\xbf\x5a\xbd\x7b\xc7\x08\x76\x25\xde\x6f\xe1\x4b\x6f\xdd\x28\x3e\x82\x5c\xac\xb1\xe3\x83\xbb\xe3\xc2\xb4\x0e\x1e\x46\xd0\xc4\xfe\x9c\xa4\x39\xf6\xbb\x2c\x02\xfd\x8e\x21\x7f\x51\xea\x7c\x48\xf0\xa6\x47\x8f\xc7\x70\x35\x27\xeb\x30\x4e\xc9\x3a\x0f\x0b\x74\x98\xfe\x42\x0d\x3c\xca\xf2\x9f\xf8\xbc\xfa\xff\x36\x0f\x92\x14\x2c\xa5\xd1\x2a\x6d\xdd\x98\x6a\x9c\x5d\x6a\x95\xc0\x9e\x65\x0f\x70\xdc\xcc\x3b\x56\xbc\xa0\xe5\x6f\xf8\x72\x8f\x8d\x91\x25\x3a\xbe\x19\xe5\x0d\x76\x43\x2c\x8f\xd3\xcc\x1f\x92\x78\x3d\xf5\x36\xd8\x5a\x06\x5d\x5e\xd0\x32\xad\x8c\x1d\xeb\xaf\xd8\xbf\x03\x00\xde\xdd\x84\xb7\x1e\x08\x00\x00")
 
 func templates_testSingletonBoil_main_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -973,7 +1540,7 @@ func templates_testSingletonBoil_main_testGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonBoil_queries_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x52\xcd\x8e\xd3\x30\x10\x3e\xc7\x4f\x31\xac\x04\xb5\xab\xac\x05\xd7\x4a\x3d\xc0\x22\x2e\x88\x1e\xca\xde\x58\x0e\x4e\x32\xae\x2c\x79\xed\x68\xe2\xb0\xa9\x22\xbf\x3b\xb2\x9d\xfe\x01\x82\x1b\x87\xca\xf6\x74\xe6\xfb\x99\x2f\x3f\x14\x41\xd7\xec\xd4\x33\xee\x95\xeb\x60\x4d\xca\x75\x32\x5d\x19\x9b\x67\xa3\x41\xee\xfc\x83\x77\x01\xa7\x00\xf7\x31\x32\x3d\xba\x16\xbe\x8c\x43\x78\x9c\x78\x20\xe5\x06\xd5\x06\x4f\xd0\x78\x63\xe5\xe3\xf9\x5d\x03\x12\xa5\x9f\x27\xf1\xeb\x7f\x30\xb3\xca\xe8\xdc\xf0\x6a\x0b\xce\xd8\x54\xa8\x7a\xe5\x4c\xcb\xf5\x73\x90\x5f\x7b\x32\x2e\x68\x7e\xf7\xa0\x9c\xf3\x01\x5a\x42\x15\x10\x14\x5c\xe8\x36\xf0\x7a\xb8\xcb\x1c\x42\xb0\x2a\xb2\x8a\x30\x8c\xe4\xae\x3a\x58\x64\xf3\x7c\x0f\x68\x07\xfc\xa7\xec\xc5\xde\xdf\xd4\xff\xd6\xf2\x5f\x4d\xb8\x2e\x46\x56\x2c\x38\x7c\xf9\xf4\x19\x8f\x1f\x71\x08\xe4\x8f\x48\x9c\xf0\x80\x13\xac\xf3\xd1\xcb\x7d\x3e\x6a\x20\x54\x1d\x12\x18\x2f\xf7\xf9\x26\x2e\xd7\x24\x74\x61\x7a\xa3\xaf\xa1\x92\x81\x32\xb7\x59\xe6\xeb\x54\x39\x4c\x1b\x00\x80\x4c\x50\x27\x9d\x91\xb1\x70\xec\x11\x6e\x86\x61\x08\x34\xb6\xa1\x80\xdf\x72\xb3\xaa\x19\x75\x82\x58\x37\xc7\x80\x83\xfc\x30\x6a\x9d\xaa\x74\x98\x72\xf5\x46\x3a\x3b\x19\xe5\x1a\xd6\x37\x0c\x02\x12\x1c\x6f\xe0\xdb\xf7\x84\x23\x80\x1b\x17\xea\x53\x4a\x25\x0e\x2d\x13\xd5\xf6\x12\x88\xb2\xb6\x84\xb9\xd9\x82\xf1\x63\x30\x36\x8b\x7a\x6f\x2d\xd7\xb2\x08\x15\xac\xfa\x43\x92\xa7\x15\xbd\xcd\xe3\xac\xaa\x22\x2b\x70\xb0\x85\x62\x63\x8f\xbd\x55\x2d\xf2\x4c\x51\x34\xcd\xab\x27\x5a\xd5\xb0\x7a\x72\xab\x78\x55\xcb\xaf\xfb\x77\xe2\x0c\xa0\x25\x1d\xa6\x13\x40\xd2\x72\x8d\x11\x53\xdf\x62\x64\xa1\xda\xe1\x4b\x59\x5a\x6a\xcc\xdf\xca\x39\xc2\xdc\x28\xcb\x66\x44\x5a\xde\xcf\x00\x00\x00\xff\xff\x1d\xae\x6b\x43\xcf\x03\x00\x00")
+var _templates_testSingletonBoil_queries_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x4d\x73\xdb\x38\x12\x3d\x93\xbf\xa2\xad\xaa\xb5\x49\x17\x0d\x67\xaf\xda\xd2\x21\xce\xc7\x4e\x2a\x33\x8e\xc7\x76\x65\x0e\x8e\x0f\x10\xd9\x90\x50\x86\x40\x06\x00\x2d\x69\x14\xfe\xf7\xa9\x06\xf8\x25\x47\x76\x72\x48\xcd\x41\x25\x12\x40\x77\xbf\x7e\xfd\xba\x89\x47\x6e\xa0\x98\x5f\xf2\x15\x5e\x73\x5d\xc0\xa9\xe1\xba\x60\xf4\x18\xc7\xbb\x9d\x14\xc0\x2e\xcb\x37\xa5\x76\xb8\x71\x70\xd6\x34\xb1\xa8\x75\x0e\x7f\xd4\xd6\xdd\x6e\x12\x67\xb8\xb6\x3c\x77\xa5\x81\x79\x29\x15\xbb\xed\xdf\x33\x40\x63\xe8\x57\x9a\xf4\xe9\x1e\xec\xe2\x48\x0a\xda\x84\xa3\x19\x68\xa9\x68\x21\xaa\xb8\x96\x79\x22\x56\x8e\xdd\x54\x46\x6a\x27\x92\xc9\x1b\xae\x75\xe9\x20\x37\xc8\x1d\x02\x87\x21\xdc\x14\xfe\x63\x27\x3e\x46\x9a\xc6\x51\x13\x47\x06\x5d\x6d\xf4\xe8\x44\xdc\xc4\xbb\xdd\x19\xa0\xb2\xf8\x43\xd8\x6d\x7a\x2f\xa1\xff\xee\xc8\xbf\x9a\x84\x2e\x9a\x26\x0e\x29\x68\x5c\xbf\xff\x88\xdb\xb7\x68\x9d\x29\xb7\x68\x12\x83\x0b\xdc\xc0\xa9\xff\xab\xd8\xb5\xff\xcb\xc0\x20\x2f\xd0\x80\x2c\xd9\xb5\x7f\x4a\x87\x47\xd8\xf5\x91\x8e\xc5\xd8\x15\x25\x10\xec\xa6\xad\x7d\x46\x2b\x8b\xcd\x14\x00\xc0\x07\xc8\x88\xec\x26\x8e\xdd\xb6\x42\xd8\x33\x06\xeb\x4c\x9d\xbb\xe0\x7c\x3f\x76\x1c\xcd\x6b\x41\x2e\x4e\xe7\x5b\x87\x96\x5d\xd4\x42\xd0\xaa\x59\x6c\xfc\xea\x1e\xf4\xb8\x4b\x34\x11\x70\xba\x17\x21\x05\x72\x97\xcc\xe1\xee\x9e\xfc\xa4\x90\x48\xed\xb2\xae\x4a\xa1\x1c\x82\x51\xa8\xd9\x50\x10\xae\x94\x3f\x02\xd3\x19\xc8\xb2\x76\x52\x79\x42\x5e\x2b\x95\x08\x16\x80\xa6\x71\x74\xa0\x92\x1d\x45\xaf\xbc\x79\x1c\x45\x4d\x1c\xdc\xc1\x0c\x28\xbc\x65\xd7\x58\x29\x9e\x63\xe2\x43\x04\x4c\xbb\x93\x2f\xe6\x24\x83\x93\x2f\xfa\xa4\x19\xad\xf9\xb7\xb3\xff\xa6\xbd\x03\xc1\xcc\x62\xd3\x39\x20\x2c\x63\x1f\x0d\x9d\x6b\x13\x69\x43\x5d\xe2\x3a\x90\x46\xc1\xbc\x56\xfa\x12\xfa\x83\x3e\xa7\x64\x9e\x12\x79\xf1\xf9\x39\x50\x0b\x97\x2b\xf9\x37\xde\x84\xa2\x48\x3b\x2c\xb1\x76\x4d\x94\x06\x56\x65\x81\xca\xc2\x7a\x59\x5a\x04\x5d\x2b\xc5\xe7\x0a\x21\x2f\x55\xbd\xd2\x16\xb8\x41\x72\x56\x95\x52\x3b\x34\x16\x92\xb3\xb3\xee\xcc\x99\x75\x5b\x85\xfd\x56\x0a\xa5\x81\x82\x3b\x3e\xe7\x16\xcf\xed\x57\x75\x62\xbd\x3b\x20\x9d\x58\x72\x32\xb6\xad\x78\xfe\xc0\x17\x08\xd6\x15\x4a\xce\xd3\x0c\xd6\x4b\x99\x2f\x07\x84\x90\x73\x7d\xe2\x40\x48\xa5\x18\xdc\x7a\x6c\xdc\xe0\xb0\x5f\x90\x43\x6e\xc1\x2d\xd1\x07\xa0\x87\x2d\x2c\x4b\x55\x00\x0d\x30\xeb\xf8\x36\x44\x5f\x2f\x51\x83\x42\xe1\xa0\xd6\x16\x1d\x0b\xc2\xea\xfd\x04\x22\x12\x8b\xd8\x4e\xbd\x96\x1f\xc4\x22\x23\x39\x83\xcf\x5b\xf0\x1c\x77\x4d\x46\xac\xdc\x52\x36\xb0\xe2\xd5\x9d\x75\x46\xea\xc5\x7d\xf8\xcb\x08\xf0\x05\x5e\x52\xc8\x79\x59\xaa\x0c\xe6\x8a\xe7\x0f\x4a\x5a\x07\x8c\xb1\x70\x28\x25\x19\x85\xd9\xf7\xc8\x15\xc9\xd1\xa0\x50\x98\x3b\xf6\x99\xab\x1a\x3f\x89\xc4\x3a\x93\x7a\x11\x3f\x72\xc5\x3e\x4a\x5d\x24\x29\x1c\x0d\xc7\xae\x9c\x81\x6f\xdf\xfc\xe6\x3b\x85\xab\x24\x3d\x70\xa6\x2d\xed\x2e\xee\xe5\x3b\x4a\x6b\xc8\xd6\x67\x37\x64\x34\xc2\x3f\x82\xce\x18\xf3\x4a\xf3\x70\x67\xa3\xb8\x71\xe4\xb6\x15\x25\x40\x4b\x44\x49\x92\xc6\x71\x24\xed\x55\x10\xc3\x1b\xaf\x1e\xda\x27\xb6\x13\xf1\x04\xdc\x7b\x89\xaa\xa0\x4f\x42\xa9\xc6\x38\x85\xf7\xd4\xa5\x34\xdb\x4f\xfb\xf8\x18\x04\xbb\xe5\x0b\xf6\x7f\x74\xc9\x84\x46\xf6\xc4\xa7\x3d\x39\x9b\x78\x84\xd2\xde\xfc\xf9\x3b\xd1\xff\x8b\x63\xb7\x74\x86\xf0\x04\xef\xea\x61\x71\xc5\xdd\x32\x9c\x9a\x8c\x05\x3f\x81\xe3\x63\x62\x55\xea\x85\x65\xbf\x71\x7b\x65\x50\xc8\x4d\xd2\xda\xd1\xc7\x35\x49\x33\x98\x10\xc8\x49\xdb\xc0\x4b\x6e\xff\x32\xbc\xaa\xb0\xf0\x80\xb9\xb2\x18\x47\x82\x20\x5a\x5a\x58\xf1\x07\x4c\xee\xee\x0f\x64\x90\x91\xea\xd9\x65\xbd\xf2\x6f\x09\x7d\x01\xa9\x99\x25\x59\x19\xae\x17\x08\xad\x17\xe2\x37\x3c\xde\xc9\x7b\x98\x79\xb3\x60\x23\xd3\xf1\x16\x7b\xad\x4b\xbd\x5d\x95\xb5\x85\x1e\x08\x09\xf1\x49\x4d\x93\xde\x20\xf5\xf4\x8d\x1c\x50\x96\x64\xbb\xb7\xd0\xeb\x25\x1a\xe7\x3a\x03\x67\x6a\x0a\xd0\x84\x8f\xb3\x8f\xb3\x57\xbf\xef\xe2\x9c\x9f\xc3\xed\x12\x49\x82\x35\x82\x0c\x8d\x2f\xa4\xb1\x34\x23\x3c\x1f\xb8\x99\xc2\x8d\x27\x1f\xa4\x06\xfb\x55\x31\x22\x3a\xac\xfc\x0c\xd0\x40\xca\xab\xd4\xbf\x3d\x8b\x97\xca\x46\xb4\x1c\x8d\x76\x7f\x69\xab\xc5\xd1\xf9\x39\x5c\x77\x6e\x80\x43\x5e\x56\x5b\x58\x4b\xb7\xf4\x29\x77\x73\xd9\x84\xef\x46\x01\xf3\xed\x81\x19\x18\x47\x95\xe2\x52\x8f\x27\xcc\x25\xae\x93\x7d\x1d\x7d\x12\x2d\xc9\x69\xda\x57\xe9\x05\x0d\x75\xdd\x3e\x12\x8f\x5d\x4b\x97\x2f\xbd\x0e\x72\x6e\xf1\x3b\xad\x8c\xb5\x96\x4e\x89\x53\x62\x4e\xb0\x0f\xf6\x52\xaa\xa4\x2d\x6c\x80\xda\x9f\x63\x37\xe8\x12\xd1\x02\xf2\xba\x69\x06\xf7\xfb\x12\x39\xe8\x5e\x04\x4f\x17\x5b\xdf\x6f\x93\xcf\x5c\xc9\x62\x92\xb2\x8b\xb2\xfc\x41\xc4\xae\xfe\x7d\xcc\x02\x05\xaf\x95\x9b\xc6\xcf\x58\xa4\xbd\x1e\xe6\x28\x4a\x83\x87\xe6\x79\x88\xf4\xa1\xfb\x86\x50\x9f\xf6\x57\xc6\xe9\xec\x39\xb9\x04\xab\xd7\x45\x61\x92\x74\x6c\xfc\x33\x1a\xfa\xdf\xd3\x4b\x4c\xab\x4c\x7f\x81\x69\xe2\x17\x4b\x9c\xc1\xe3\xd3\x2a\x67\xb0\x9f\x3a\xdd\x93\xba\x2a\x67\x43\x45\xc8\xec\xc5\xea\x67\x2f\x57\x8f\xdc\x0a\x38\xea\x5d\xd0\x24\x3d\x1a\xbc\x13\xba\x48\xf8\x42\x3d\x12\xed\x51\x5e\x6a\x27\x75\xd7\x92\xd4\x32\x17\x1d\x09\x58\x8c\x2f\x2f\x74\x89\x70\x65\x9d\x2f\x31\x5c\x0c\x1e\x10\x2b\x6a\x18\x69\xc2\x28\x09\x81\xbb\xb2\xbd\x45\xac\xde\x7d\xad\xb9\x4a\x1e\xf7\x79\x0f\x15\xee\xf1\x8e\x37\x5b\x51\xed\x21\xea\x46\xe7\x13\xfc\xfd\x88\x19\x12\x79\x46\xae\x37\xe8\xbc\x62\x69\x4c\x1e\x4a\xb8\x72\xe6\x69\x73\x3f\xb6\xdf\x61\x3a\x5e\x39\xd3\x76\xd0\xc0\x59\xa0\xaf\x72\x66\xff\xee\xa8\xa5\x8a\x9b\xf8\x9f\x01\x00\x93\x0f\x1c\xfb\x00\x0e\x00\x00")
 
 func templates_testSingletonBoil_queries_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -989,11 +1556,11 @@ func templates_testSingletonBoil_queries_testGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/singleton/boil_queries_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc3, 0x6f, 0xe3, 0x63, 0x7c, 0x8f, 0x6b, 0x38, 0x2b, 0x53, 0x75, 0xd0, 0x1e, 0x40, 0xc9, 0x9b, 0xa3, 0xd5, 0x30, 0xa3, 0xbe, 0x36, 0xd9, 0xdc, 0xfb, 0xae, 0x3f, 0x42, 0x27, 0xaf, 0xad, 0xd3}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x78, 0x7c, 0x80, 0x47, 0xf, 0x6b, 0x22, 0x14, 0x1a, 0x3, 0xf5, 0x69, 0xa6, 0xd4, 0xec, 0x26, 0xef, 0x4a, 0x78, 0x55, 0x15, 0xea, 0xcd, 0x63, 0xab, 0x70, 0xca, 0xf1, 0x6b, 0x1d, 0xca, 0x25}}
 	return a, nil
 }
 
-var _templates_testSingletonBoil_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9a\xdf\x53\xe3\x36\x10\xc7\x9f\xc3\x5f\xb1\x73\xc3\x03\x61\x38\x67\xda\x7b\xbb\x99\x3e\xe4\xe8\x31\xa5\x3f\x08\x25\x61\xfa\x2c\xec\x75\xa2\x22\xa4\x8c\x24\x5f\x2f\xe3\xc9\xff\xde\x91\xe4\x9f\xb1\x63\x6c\x70\x0a\xa6\xf0\x42\x1c\x49\xab\xfd\xae\x3e\x5a\xaf\x1c\x4f\x26\xb0\x58\x51\x05\x1a\x95\x06\x15\x51\x8d\x20\x23\xae\x00\x89\xbf\x02\xb1\x46\x49\x34\x15\xdc\x35\x53\x0e\x6b\x22\x09\x63\xc8\xbc\xa3\xc9\x04\xbe\x7e\x27\x0f\x6b\x86\x67\x40\x43\xd8\x88\x48\x42\x40\x34\xb9\x23\x0a\x61\x45\x14\x7c\x02\x4d\xee\x18\xaa\x33\xd0\x2b\x4c\x4c\xff\x43\x19\x33\xf6\x3f\x9b\xe1\xb6\xf9\x87\x33\xf7\xff\x47\x20\x3c\x70\x1f\x3f\xc1\xcf\xc8\x50\x63\x71\xbe\xe6\xfe\x97\x5c\xa1\x2c\xf9\x77\x66\x9b\x95\x80\x50\x48\xbd\xb2\xde\x5e\x6a\x08\x04\x2a\xb8\x9a\x2d\x8c\x0b\xbb\x0a\x97\x52\x44\xeb\x8a\xc4\x39\x9a\x4b\x4d\xf9\xd2\xaa\x30\x61\x50\xa0\x57\x91\x62\x1b\x58\x4a\xc2\xb5\x02\xf2\x4d\xd0\x80\x70\x1f\x41\x84\x70\x2d\x94\x5e\x4a\x54\x10\x20\x09\x98\xf0\xef\x95\x77\x14\x46\xdc\x87\x05\x2a\x7d\x4d\x24\x72\x7d\xa2\xe1\xd4\xd8\xa1\x7c\xe9\x2d\xc6\x10\x1f\x01\xc4\xf1\x47\x90\x84\x2f\x11\xbc\x85\x8d\xd9\x76\x9b\x7c\x4b\x43\xf0\x2e\xd5\xaf\x82\x72\xdb\x00\x1f\xb3\x16\x64\xaa\x78\x79\x4c\x18\x25\x0a\x3e\xff\x04\xc7\xde\xd4\x7c\x44\xe5\x6c\x81\x77\x45\x1e\xd2\x9e\xda\xbb\x89\xf8\xc9\x87\x38\x76\xdd\xbd\xdb\xf5\x35\x8b\x24\x61\xdb\xed\x87\x33\x2b\xae\xa6\x65\x6c\x67\x40\x1e\x14\x27\x4f\xae\xb6\x47\x47\x71\x6c\x7c\x9c\x06\xc1\x5c\x84\xda\x2d\x9c\xb2\x6d\x99\xec\xbc\xa1\x7f\xe9\xa3\xb4\xe7\x39\xe1\xf9\x3c\x49\xa3\xf9\x6b\x1f\x1b\xf3\xf7\x94\xf8\xe4\xd3\x8e\x9d\x43\xc5\x50\xed\x0d\x5b\x16\x9d\x3f\x23\x94\x9b\xdc\xc6\x94\xb1\x37\x19\xa5\xaa\xcc\x27\x45\x6b\xce\xa8\x8f\x6f\x3f\x5a\x55\x99\x1d\xa2\x95\x5c\x6d\x8b\x71\x3b\xd4\xfe\x3b\x6c\xea\xc9\xb7\x55\xeb\x9d\x74\x40\x2e\x0e\xab\xb5\xec\x7d\x5b\xcd\x16\x94\xc1\x6a\x2e\x7b\xdf\x56\xf3\xd7\xef\x54\x69\x35\x34\xad\xce\xeb\xb6\x1a\x2f\x28\x0f\x86\xa6\xd0\xf8\xdc\x56\xdf\x97\x01\xea\xfb\xd2\x41\xdf\x8c\x0f\x2e\xd9\xce\x78\xeb\x4c\x3b\xc0\x54\xd3\x21\xbf\x9c\x8b\x68\x78\x55\xba\x75\xba\x4d\xa9\xce\x85\x06\xef\x4a\xfc\x22\xc4\xfd\x4e\x9d\x6e\xbf\x1a\x9a\x6e\xeb\x74\xb3\xee\xba\x7a\xc8\x9d\x18\x87\x26\xd6\x79\x3d\x7e\xd6\xe8\xbf\x56\x54\x23\xa3\xea\x31\x58\x26\x13\x1b\xa8\x85\x98\xf1\xf4\xdc\xeb\x13\x6e\xe8\xb9\xb3\x8f\x08\x76\x4f\xe7\x42\xe6\x67\x5e\xd3\x13\x84\xef\x47\xb2\x70\xfa\xb5\x96\x2a\x11\x7f\x66\xbc\x8b\x0b\x76\x1c\xde\xe3\xc6\x84\xdd\xbb\xf8\x0d\x37\x6a\xb7\x60\x67\xf6\x61\x41\xdd\xb2\xd8\x81\x5e\x71\x96\x6c\x50\xf8\xc8\xa0\x0b\x21\x91\x2e\x79\xed\x58\x89\x6c\x9a\x91\xe0\x66\xf7\x6e\x90\xd9\xc7\x0d\x6a\x45\xd7\x89\x89\x5a\x26\x92\xee\xb7\xeb\x39\xe5\xcb\x88\x11\xb9\xdd\x2e\x44\x1c\x27\xfe\x94\xbe\xbf\x55\x94\x2f\xe3\x38\x9b\x2e\xf5\xa9\x88\x42\xad\xb9\x19\xc7\xae\x16\xc7\x49\xc8\x13\x4e\x8c\xcc\xc9\x29\xd8\xb8\xbb\x35\x38\x9d\x54\x69\x4a\x7a\xd1\x10\xfe\x16\x94\xbb\x67\x36\x69\xc7\x6a\x37\xf7\xd0\xa8\x6c\x2e\xc7\x71\xc6\xb1\x3f\x22\x53\x63\x2d\xd3\xc0\x68\x1f\x95\xa3\x12\x94\xa3\x12\x93\x12\x99\x45\xd2\x4e\x54\x5c\xfd\x2e\x7c\x4a\x64\x9d\xf1\x34\x63\x5a\xd3\x19\xd6\xd1\x69\x2c\x64\x70\x8e\xfa\x61\xf3\x77\xe1\x97\x93\x54\x8d\xb1\x74\x59\xba\x99\x1c\xbb\xc0\x97\x61\x2a\x51\x34\xaa\xc2\x26\x22\x8d\xb2\x9e\xcc\x3a\x84\x5d\xf7\x66\x42\x17\xe2\x0f\xc2\x37\x3d\x65\x4c\x63\xaa\xf5\x4d\xaa\x29\x6d\xd6\x24\xce\x3d\x98\x9a\x29\xf7\x71\xfa\x34\x52\xeb\x80\xcb\xc6\xed\x4e\x57\x03\x6e\x0e\xa2\xfd\x94\x4b\xcb\x2e\x2d\x02\x26\xe9\x77\xca\xa5\x9d\xa0\x74\x81\xa9\xe5\x2e\xd5\xd8\x80\x5e\xb6\x00\x87\xa7\x6f\xc6\x71\x8e\xba\xbf\x3b\xf6\x1c\xab\x65\xd2\xfb\x4d\xbb\xf7\x9b\x76\x3b\x06\xdd\x7a\xcc\xd6\x83\xbb\x6f\x27\xb7\xbf\x07\xf1\xad\xc7\x62\xd2\xd9\x7b\x39\x3a\x69\x98\xd2\x10\x31\x56\x9f\xf4\xba\xf2\xfb\x3c\x82\x87\xc2\xb0\x5b\xb8\xa7\x62\x5c\x05\x99\x86\x10\xba\x3e\x60\xd6\x8b\xa7\xcb\xd1\x98\x7d\x5f\xa0\x6a\xed\x2b\x31\x17\xec\xbd\xd7\xae\xaf\xb2\x76\xed\x92\xa5\x1f\x2f\x60\xb5\x00\xc1\x11\x64\x69\x09\xfe\xd3\xaa\x36\xd5\xd5\x63\x0a\x2f\x9b\x7c\x41\x8e\x47\xa9\xd1\x22\x77\xe7\x82\x45\x0f\xbc\x26\xb1\xbf\xf3\x5e\xc7\x7b\xc7\x8c\x9e\x23\x3f\x6a\xc8\xe5\xbe\x5d\x83\x4a\x3a\x1f\xd5\x41\xfc\x62\x27\xbd\x69\x10\xf4\x78\xd8\x9b\x06\xed\x7f\x16\x79\x3f\xef\x1d\xfc\xbc\x37\x0d\x02\xcb\xf4\x6b\x3f\xf4\x19\x5f\xfb\x3b\xf5\x25\xd6\x5e\x1d\x88\xc9\xaf\x17\x27\x42\x36\xa5\x6a\xc7\xa4\xc8\x1c\x19\xef\x58\xd9\xf1\xe5\xe9\x94\xbf\x21\xce\xd3\x72\x65\x1f\xe7\x8d\x25\xf7\x9e\x34\x5d\x88\xf8\xab\xd9\x23\xbd\x9e\x40\x73\x83\xef\x3b\xe5\x7f\xb3\x53\x0a\x85\xce\x1b\xdc\x2c\x19\xdd\x37\xc8\x04\x19\xdc\x1b\x1a\xce\xeb\xb6\xbf\xf3\xbb\xde\x03\x7c\x97\x21\x73\xbc\xf5\x5b\x62\xc8\xd0\x1f\xdc\xaf\xdd\xce\xeb\xb6\x1a\x6f\xd7\x01\x19\xde\x1b\x8e\xce\xeb\x4e\x6f\xfb\xb9\x21\x03\xc4\xb6\xec\x7d\xb3\xe6\x7f\x03\x00\x00\xff\xff\x70\x75\xff\x03\x97\x30\x00\x00")
+var _templates_testSingletonBoil_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xcd\x6e\xdb\x3c\x16\x5d\x3b\x4f\x71\x51\x64\x61\x07\xa9\x82\x99\xee\x0a\x74\xe1\x3a\xed\x4c\xa6\x9d\x28\x93\x38\xd3\x35\x23\x5d\xdb\x6c\x69\xd2\x20\xa9\x4e\x0d\xc3\xef\x3e\x20\x29\xea\xcf\x8a\x2d\xd9\x6e\xbe\x2a\x09\xba\xa9\x44\xf2\x92\xe7\xdc\x73\xc4\x1f\x33\x17\x17\x30\x9e\x51\x05\x1a\x95\x06\x95\x50\x8d\x20\x13\xae\x00\x49\x34\x03\xb1\x40\x49\x34\x15\xdc\x15\x53\x0e\x0b\x22\x09\x63\xc8\x82\x93\x8b\x0b\xf8\xf4\x8b\xcc\x17\x0c\xcf\x81\x4e\x60\x29\x12\x09\x31\xd1\xe4\x81\x28\x84\x19\x51\xf0\x0e\x34\x79\x60\xa8\xce\x41\xcf\x30\x0d\xfd\x3f\xca\x98\x89\xff\xde\x34\xb7\xc5\x7f\x3b\x77\xd5\xfe\x0e\x84\xc7\xee\xbf\xef\xe0\x12\x19\x6a\x2c\xf6\xb7\xbd\xfe\x15\x57\x28\x4b\xe3\x3b\xb7\xc5\x4a\xc0\x44\x48\x3d\xb3\xa3\xbd\xd2\x10\x0b\x54\x70\x1d\x8e\xcd\x10\xaa\x08\xa7\x52\x24\x8b\x62\x08\xdb\xe8\x0e\xcd\xa3\xa6\x7c\x6a\x51\x18\x1a\x14\xe8\x59\xa2\xd8\x12\xa6\x92\x70\xad\x80\xfc\x14\x34\x26\x3c\x42\x10\x13\xb8\x11\x4a\x4f\x25\x2a\x88\x91\xc4\x4c\x44\x3f\x54\x70\x32\x49\x78\x04\x63\x54\xfa\x86\x48\xe4\xba\xaf\xe1\xcc\xc4\xa1\x7c\x1a\x8c\x07\xb0\x3a\x01\x58\xad\xde\x82\x24\x7c\x8a\x10\x8c\x0d\x22\xb5\x5e\xa7\x6f\xe9\x04\x84\x84\xe0\x4a\xfd\x4b\x50\x6e\xcb\xcc\xc3\x2d\x92\x38\xe4\x6c\x09\x6f\xb3\x8a\xc8\x14\x16\x1e\x4f\x09\xa3\x44\xc1\xfb\x0f\x70\x1a\x0c\xcd\x7f\x51\x05\x69\xf3\x6b\x32\xf7\x35\x75\x70\x9b\xf0\xfe\x9b\xd5\xca\x55\x0f\xee\x17\x37\x2c\x91\x84\xad\xd7\x6f\xce\x6d\xca\x6b\x4a\x06\xb6\x07\xe4\x71\xa1\x37\xff\xb4\x3e\x39\x59\xad\xe8\x04\x82\x61\x1c\xdf\x89\x89\x76\x79\x54\xb6\x66\xc6\x42\x5e\xf0\xdb\x99\xe8\xa5\x0d\x83\x11\xe1\x79\xb7\x69\x21\x40\x1b\xaa\xcc\xbf\x7d\xe8\xca\xbb\x35\xc4\xf5\xca\xcc\x3d\xca\x62\x46\xd6\x7f\x12\x94\xcb\x3c\xc6\x90\xb1\x97\x40\xda\x26\xea\xbd\xc8\xbb\x63\x34\xc2\x17\x47\xde\x26\xea\x16\xe4\xa5\x4f\xeb\x22\x8d\x4f\x64\xd6\xe6\xcc\xec\x23\xa9\xdc\x83\x8d\x6d\xf7\x74\xaa\xf9\xbd\xd0\xcb\x60\x1a\x7d\xbf\x2f\x29\x61\x18\xe9\xe0\x5e\x61\x98\xe8\x45\xa2\x47\x8c\x24\xe9\x70\x1f\x21\xe9\x16\x75\x22\x39\xe5\xd3\x67\xc5\x56\x86\x6a\x27\x6d\xfe\x21\xa3\xc7\xfa\xf0\xb9\x68\xa8\x0c\x66\x07\x19\x19\x05\x9f\x7e\x51\xa5\x55\xc7\xa1\x3b\x10\x4d\x21\x7f\xa6\x3c\xee\x38\x60\x03\xa1\x29\xdc\x8f\xdd\x87\xfb\xb1\x05\xdc\x90\x77\x7d\x1e\x0c\x79\xe3\x49\xb0\xfb\x5f\xad\x16\x9f\xaa\x3b\x2d\x91\xcc\x3b\x8e\xd7\x81\x68\x0a\x79\x24\x92\xce\xef\x46\x2d\x86\x1d\x80\xed\x96\x94\x0b\x0d\xc1\xb5\xf8\xa7\x10\x3f\x2a\xfb\x51\xfb\xaa\xe3\x34\x58\x0c\xdb\x69\xa8\x5b\xd9\xbb\x73\x93\x8e\x63\x77\x20\x06\x07\xb5\xfe\x36\xa3\x1a\x19\x55\x7a\x90\x0e\x37\x55\xcc\x69\x70\x2d\x46\x82\x6b\xfc\xa5\x0f\x1c\xdf\xa5\x39\x0f\xa2\xfe\xe3\xeb\x53\xb1\x5b\xb7\xc1\x3f\x90\x9b\x23\x2a\x74\x03\xbd\x9a\x72\x21\x2b\xeb\xf0\x62\xc9\x31\x73\xd9\xb7\x9e\xb9\xf9\x82\xcb\xc1\x96\xbc\xda\xd3\x38\xf3\xf1\x0e\x8a\x6f\x1f\x96\x37\x5f\xcc\x4b\x2d\x93\x62\xed\x74\x1c\x23\xc1\x92\x39\x57\xeb\xb5\xb5\xa6\x39\xa9\x0b\x86\x89\x16\x57\x3c\x92\x38\x47\xae\xa1\xaf\x50\x5f\xf1\x88\x25\xb1\xd7\x87\xeb\xc7\x8e\xc6\x37\x1f\x98\xf6\xae\xa7\x0f\x30\x21\x4c\xa1\x79\x61\x89\xad\xb2\x4a\x27\xe9\x90\x1a\x0b\xf2\xc0\x74\xbb\x6c\x14\x92\x5d\x18\xcc\xc6\x93\x4f\xb7\x2f\xc8\x32\x6b\x56\x62\xa1\x1c\x49\x24\xfa\x35\xb3\x7f\x79\x66\x8b\xd9\x68\x95\xd9\x2c\x9f\x5f\x05\x89\x3f\x2e\xbf\xe0\xf2\xa8\x7b\x22\x9b\xcd\x3e\xb3\xb1\x6f\x24\x9d\x13\x69\xba\x80\x60\xb0\x2d\xb9\xbf\x89\xa3\x1c\xe1\xa3\x0c\x39\xb5\x9b\xdf\x1d\x50\xe9\xb1\x08\xb9\x3f\x56\x8f\x08\x37\x48\x1e\xec\x2f\x10\xc5\x93\x78\x73\x10\x2f\x64\x7e\xa4\x0e\x11\xe1\x20\xa2\x28\x91\x85\xc3\x75\x1b\x69\x83\xd6\xbd\x49\xad\x65\xae\x98\xa4\xd3\xc9\x0f\x5c\x1a\x91\x05\x9f\x0d\x5c\x53\x23\x0d\x6b\x40\xf4\x4f\x83\x2c\x94\xad\x19\x7c\x16\x12\xe9\xd4\xf5\x34\xa8\x1e\x08\xb2\xcc\x6b\xd5\x74\xb8\xc6\xee\xff\x95\x46\x93\x1d\x8d\x8a\x3d\x56\xdb\x4a\x64\xc3\x4c\x01\xae\xf7\xe0\x16\x99\xfd\x29\x44\xcd\xe8\x22\x0d\x51\x3b\x35\xa7\xd5\xef\x17\x77\x94\x4f\x13\x46\xe4\x7a\x3d\x16\xab\xd5\xe9\x64\xf3\xfd\xbd\xa2\x7c\xba\x5a\x65\xdd\x79\x16\x8a\x12\xaa\x0d\x17\x72\x6c\x1b\x71\x90\x26\x28\x15\x9c\xa1\xe8\xe2\xcc\xe7\x43\x22\x89\x41\x98\x6f\xdf\xd9\x85\x2f\x2d\x57\x34\x78\xd3\xd4\x9e\x5d\x14\xd3\x5f\x0d\xf7\x5d\x50\xee\x7e\x78\x4a\x63\x9d\x6c\x56\xb3\xc5\xaa\x1c\x2e\x17\x7d\xc8\xf1\x78\xba\xf7\xc1\x1a\x7e\x51\x7a\x0d\xb5\xdf\x2b\x49\xbf\x57\x52\xbe\x44\x66\x85\x6f\x41\x14\x55\xb3\xd5\x05\x12\xd9\xde\x26\x30\x6d\xdb\x7a\xa0\xda\x5f\xb5\xa9\x57\x90\xed\x70\x52\x67\x01\x13\x21\x73\x40\xef\x38\x06\xf8\x2a\x22\xc2\x76\xc8\xdf\xa7\xb4\x5d\xc8\xc1\x49\xef\x00\xf9\x97\xa4\xda\xdb\x2c\x17\x89\x46\x59\x2f\xff\x3a\x9f\xb8\xea\xdb\x6d\x30\x16\xff\x26\x7c\x79\xa4\x8f\xbf\x09\xd5\xd0\x02\x00\x2d\x66\x00\x80\x92\x11\x00\x2a\xb3\x40\xee\x05\x33\x82\xbd\xcd\x60\xe5\x18\x64\x03\x29\x7a\x63\x3f\x77\xd4\x89\x3c\x6b\x57\x1d\xea\x63\xe3\xb1\xe2\x2f\x8f\x2c\x1f\xa8\x55\xb2\x99\xfb\x5a\x4d\x12\xad\x8c\xe0\x48\xad\xd5\xba\xc7\x78\x0c\xb9\x7b\xb6\x9e\x40\xf1\x21\xc7\x3b\xd4\x47\xd2\xbc\x0b\xb6\xa1\xfa\x7a\xcd\x37\x56\xfc\x86\xde\x9b\xac\x79\xcc\x4a\xdf\xae\x43\x6d\x95\xe0\x4a\x8d\xc4\x7c\x21\x14\xd5\x38\x80\x7e\x83\x05\xd1\xcb\x5d\x11\x35\xf3\x81\x4b\x75\xb8\x68\x18\xb4\xd9\xa2\x28\xf2\x39\x32\xaa\xf8\xa3\x56\x48\xe9\xca\x62\x2e\x7e\x1e\x71\x73\xe0\xe2\x3d\x4b\xbb\x98\xf3\x0d\xd3\x59\x70\x9d\x30\x56\x51\xf7\x9e\x86\x3a\xcc\x52\x69\xeb\x3f\xde\x54\x4e\x13\xfb\xfa\xaa\xd6\x59\x13\x57\x07\xcc\xa7\x92\xfb\x74\xa4\x0a\xf7\xc4\x74\xcc\x8e\x7e\x41\x7a\xac\xa9\xab\x10\x6f\xc3\x8e\x00\x75\x86\x7c\xb2\x6d\x4b\xee\x4c\xb3\xce\xd9\x61\xcc\xea\xaa\x69\xf0\xba\xa7\xd9\xb5\xa7\x69\x33\x8b\x35\xd8\xd8\xb4\xf4\x8c\x93\x95\x16\x20\x38\x82\x2c\x49\xe0\x49\xb7\x3e\x9e\x8d\x23\x4e\x71\xe5\x90\xcf\xd1\x56\x3d\x3f\xda\x62\x05\x77\xe0\x5c\x33\xed\xbd\xda\xaf\xce\x7e\x2d\xe7\xbb\xdc\x81\xf9\xfd\xc1\xcd\x99\x2e\xb2\x39\xd8\x98\xec\x7a\x75\xee\x38\xc4\xb7\x3e\xee\x93\x58\xd4\xed\x3d\x87\x71\x7c\x14\x77\x66\xd1\x1a\x1a\xd3\x4b\xaa\x81\x37\x7d\xd5\xcc\x9e\xb9\x20\x5b\x9d\x51\x1c\x64\xd1\xea\xf9\x45\x71\x22\xdc\xcf\x8b\x75\x96\xea\xe8\x01\xc6\x30\x8e\xc3\x45\x4d\xd3\x1d\xa7\x18\x87\x78\xc4\xf3\xf7\x44\x36\x39\xde\x99\x46\x1a\xed\xc5\xda\x24\xcd\x7d\x5f\xc8\x6d\xd3\x9c\x2d\x1a\x8b\x3c\x50\x25\x4a\x05\xa4\x7f\xdd\xde\x83\xcf\xc8\x85\x7e\xe5\xf9\x98\x0b\x01\xda\x4f\x71\x39\x45\x5d\x77\xf0\x51\x0f\x5b\xf2\x80\xaf\x3e\x7e\xf5\xf1\x91\x7d\x5c\x58\xc2\xbe\x5a\x39\xb5\x72\xe6\xbd\x5b\x34\xf7\x40\x1a\xbb\xae\xb1\xe7\xda\x5f\x21\xd9\x90\x40\xf3\x4b\x24\x0e\xc4\x8e\x5b\x9d\x15\xc8\xdd\xbf\xac\x9c\xe1\x68\x0a\xfc\xbf\x84\xd1\x98\x68\xfc\x8a\x7c\xaa\x67\x5d\xff\x33\x8b\x0a\x9a\x41\x9b\xbb\x91\xbe\x6d\xf9\xf6\x9c\x7f\xfb\x4c\x88\xd9\xc9\x88\x7f\xc8\x08\xb8\x43\xf3\x47\x5c\x1d\x87\xef\x40\xb4\x92\xc3\xe5\x38\xac\xdc\xf1\xbe\x1c\x87\x1d\xa7\xe1\x72\x1c\x36\x16\x80\xbd\x55\x1b\xdc\x48\xf1\x1d\x23\xbb\xf4\x29\x93\x51\x28\xf8\x83\x48\xc9\xfb\x3d\x5d\x48\xf1\xdd\x7d\x62\x6c\x2f\x45\x20\x87\xdd\x4e\x1c\xaa\xd5\xca\x46\x4f\xc3\x14\xee\x28\x6e\x30\x5a\x2c\xa9\x61\xd7\x6b\x6d\x34\x33\x5c\x29\xd4\x15\xc5\xdd\x2f\xcc\xa7\xe7\x1b\xd5\xb3\xac\x46\xc7\x15\x58\x83\xa8\xb1\x22\x2b\xb4\x3c\x0b\x26\x76\x80\xcf\x20\xdb\x3f\x9d\x74\xe4\x75\x7f\x91\x52\x06\xb3\x9d\x82\xff\x0f\x00\x3e\x99\x40\xc3\x5b\x44\x00\x00")
 
 func templates_testSingletonBoil_suites_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1009,7 +1576,7 @@ func templates_testSingletonBoil_suites_testGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/singleton/boil_suites_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe6, 0xb4, 0x44, 0x36, 0x3d, 0x2e, 0xd9, 0xae, 0xe8, 0x6b, 0xff, 0x17, 0xdb, 0x55, 0xd, 0xd6, 0xd, 0xc1, 0xde, 0xa, 0x5a, 0x73, 0x69, 0x17, 0x46, 0xbc, 0xbb, 0x74, 0x46, 0xe4, 0xcd, 0x6b}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb7, 0x3a, 0xf3, 0x4e, 0xda, 0xd3, 0xae, 0x23, 0x4c, 0xf0, 0xa, 0x98, 0xba, 0xaf, 0xfd, 0x4d, 0x87, 0xa8, 0xdc, 0xf3, 0xfe, 0x9f, 0xc1, 0x65, 0xaf, 0xe6, 0x2f, 0xf3, 0x69, 0xd5, 0xa9, 0x8a}}
 	return a, nil
 }
 
@@ -1125,17 +1692,42 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/19_reload.go.tpl":                           templates19_reloadGoTpl,
 	"templates/20_exists.go.tpl":                           templates20_existsGoTpl,
 	"templates/21_auto_timestamps.go.tpl":                  templates21_auto_timestampsGoTpl,
+	"templates/22_validate_lengths.go.tpl":                 templates22_validate_lengthsGoTpl,
+	"templates/23_indexes.go.tpl":                          templates23_indexesGoTpl,
+	"templates/24_json.go.tpl":                             templates24_jsonGoTpl,
+	"templates/25_repository.go.tpl":                       templates25_repositoryGoTpl,
+	"templates/26_dto.go.tpl":                              templates26_dtoGoTpl,
+	"templates/27_changeset.go.tpl":                        templates27_changesetGoTpl,
+	"templates/28_projection.go.tpl":                       templates28_projectionGoTpl,
+	"templates/29_insert_ignore.go.tpl":                    templates29_insert_ignoreGoTpl,
+	"templates/30_column_map.go.tpl":                       templates30_column_mapGoTpl,
+	"templates/31_mixins.go.tpl":                           templates31_mixinsGoTpl,
+	"templates/32_find_or_create.go.tpl":                   templates32_find_or_createGoTpl,
+	"templates/33_load_by_keys.go.tpl":                     templates33_load_by_keysGoTpl,
+	"templates/34_delete_cascade.go.tpl":                   templates34_delete_cascadeGoTpl,
+	"templates/singleton/boil_embeds.go.tpl":               templatesSingletonBoil_embedsGoTpl,
+	"templates/singleton/boil_functions.go.tpl":            templatesSingletonBoil_functionsGoTpl,
+	"templates/singleton/boil_lookup_enums.go.tpl":         templatesSingletonBoil_lookup_enumsGoTpl,
+	"templates/singleton/boil_mixins.go.tpl":               templatesSingletonBoil_mixinsGoTpl,
 	"templates/singleton/boil_queries.go.tpl":              templatesSingletonBoil_queriesGoTpl,
+	"templates/singleton/boil_scanners.go.tpl":             templatesSingletonBoil_scannersGoTpl,
+	"templates/singleton/boil_schema.go.tpl":               templatesSingletonBoil_schemaGoTpl,
 	"templates/singleton/boil_table_names.go.tpl":          templatesSingletonBoil_table_namesGoTpl,
 	"templates/singleton/boil_types.go.tpl":                templatesSingletonBoil_typesGoTpl,
 	"templates_test/00_types.go.tpl":                       templates_test00_typesGoTpl,
 	"templates_test/all.go.tpl":                            templates_testAllGoTpl,
+	"templates_test/changeset.go.tpl":                      templates_testChangesetGoTpl,
 	"templates_test/delete.go.tpl":                         templates_testDeleteGoTpl,
+	"templates_test/dto.go.tpl":                            templates_testDtoGoTpl,
 	"templates_test/exists.go.tpl":                         templates_testExistsGoTpl,
 	"templates_test/find.go.tpl":                           templates_testFindGoTpl,
+	"templates_test/find_or_create.go.tpl":                 templates_testFind_or_createGoTpl,
 	"templates_test/finishers.go.tpl":                      templates_testFinishersGoTpl,
 	"templates_test/hooks.go.tpl":                          templates_testHooksGoTpl,
 	"templates_test/insert.go.tpl":                         templates_testInsertGoTpl,
+	"templates_test/insert_ignore.go.tpl":                  templates_testInsert_ignoreGoTpl,
+	"templates_test/load_by_keys.go.tpl":                   templates_testLoad_by_keysGoTpl,
+	"templates_test/projection.go.tpl":                     templates_testProjectionGoTpl,
 	"templates_test/relationship_one_to_one.go.tpl":        templates_testRelationship_one_to_oneGoTpl,
 	"templates_test/relationship_one_to_one_setops.go.tpl": templates_testRelationship_one_to_one_setopsGoTpl,
 	"templates_test/relationship_to_many.go.tpl":           templates_testRelationship_to_manyGoTpl,
@@ -1146,11 +1738,16 @@ var _bindata = map[string]func() (*asset, error){
 	"templates_test/select.go.tpl":                         templates_testSelectGoTpl,
 	"templates_test/types.go.tpl":                          templates_testTypesGoTpl,
 	"templates_test/update.go.tpl":                         templates_testUpdateGoTpl,
+	"templates_test/validate_lengths.go.tpl":               templates_testValidate_lengthsGoTpl,
+	"templates_test/singleton/boil_embeds_test.go.tpl":     templates_testSingletonBoil_embeds_testGoTpl,
 	"templates_test/singleton/boil_main_test.go.tpl":       templates_testSingletonBoil_main_testGoTpl,
 	"templates_test/singleton/boil_queries_test.go.tpl":    templates_testSingletonBoil_queries_testGoTpl,
 	"templates_test/singleton/boil_suites_test.go.tpl":     templates_testSingletonBoil_suites_testGoTpl,
 }
 
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
+
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
@@ -1214,21 +1811,46 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"19_reload.go.tpl":                         &bintree{templates19_reloadGoTpl, map[string]*bintree{}},
 		"20_exists.go.tpl":                         &bintree{templates20_existsGoTpl, map[string]*bintree{}},
 		"21_auto_timestamps.go.tpl":                &bintree{templates21_auto_timestampsGoTpl, map[string]*bintree{}},
+		"22_validate_lengths.go.tpl":               &bintree{templates22_validate_lengthsGoTpl, map[string]*bintree{}},
+		"23_indexes.go.tpl":                        &bintree{templates23_indexesGoTpl, map[string]*bintree{}},
+		"24_json.go.tpl":                           &bintree{templates24_jsonGoTpl, map[string]*bintree{}},
+		"25_repository.go.tpl":                     &bintree{templates25_repositoryGoTpl, map[string]*bintree{}},
+		"26_dto.go.tpl":                            &bintree{templates26_dtoGoTpl, map[string]*bintree{}},
+		"27_changeset.go.tpl":                      &bintree{templates27_changesetGoTpl, map[string]*bintree{}},
+		"28_projection.go.tpl":                     &bintree{templates28_projectionGoTpl, map[string]*bintree{}},
+		"29_insert_ignore.go.tpl":                  &bintree{templates29_insert_ignoreGoTpl, map[string]*bintree{}},
+		"30_column_map.go.tpl":                     &bintree{templates30_column_mapGoTpl, map[string]*bintree{}},
+		"31_mixins.go.tpl":                         &bintree{templates31_mixinsGoTpl, map[string]*bintree{}},
+		"32_find_or_create.go.tpl":                 &bintree{templates32_find_or_createGoTpl, map[string]*bintree{}},
+		"33_load_by_keys.go.tpl":                   &bintree{templates33_load_by_keysGoTpl, map[string]*bintree{}},
+		"34_delete_cascade.go.tpl":                 &bintree{templates34_delete_cascadeGoTpl, map[string]*bintree{}},
 		"singleton": &bintree{nil, map[string]*bintree{
-			"boil_queries.go.tpl":     &bintree{templatesSingletonBoil_queriesGoTpl, map[string]*bintree{}},
-			"boil_table_names.go.tpl": &bintree{templatesSingletonBoil_table_namesGoTpl, map[string]*bintree{}},
-			"boil_types.go.tpl":       &bintree{templatesSingletonBoil_typesGoTpl, map[string]*bintree{}},
+			"boil_embeds.go.tpl":       &bintree{templatesSingletonBoil_embedsGoTpl, map[string]*bintree{}},
+			"boil_functions.go.tpl":    &bintree{templatesSingletonBoil_functionsGoTpl, map[string]*bintree{}},
+			"boil_lookup_enums.go.tpl": &bintree{templatesSingletonBoil_lookup_enumsGoTpl, map[string]*bintree{}},
+			"boil_mixins.go.tpl":       &bintree{templatesSingletonBoil_mixinsGoTpl, map[string]*bintree{}},
+			"boil_queries.go.tpl":      &bintree{templatesSingletonBoil_queriesGoTpl, map[string]*bintree{}},
+			"boil_scanners.go.tpl":     &bintree{templatesSingletonBoil_scannersGoTpl, map[string]*bintree{}},
+			"boil_schema.go.tpl":       &bintree{templatesSingletonBoil_schemaGoTpl, map[string]*bintree{}},
+			"boil_table_names.go.tpl":  &bintree{templatesSingletonBoil_table_namesGoTpl, map[string]*bintree{}},
+			"boil_types.go.tpl":        &bintree{templatesSingletonBoil_typesGoTpl, map[string]*bintree{}},
 		}},
 	}},
 	"templates_test": &bintree{nil, map[string]*bintree{
 		"00_types.go.tpl":                       &bintree{templates_test00_typesGoTpl, map[string]*bintree{}},
 		"all.go.tpl":                            &bintree{templates_testAllGoTpl, map[string]*bintree{}},
+		"changeset.go.tpl":                      &bintree{templates_testChangesetGoTpl, map[string]*bintree{}},
 		"delete.go.tpl":                         &bintree{templates_testDeleteGoTpl, map[string]*bintree{}},
+		"dto.go.tpl":                            &bintree{templates_testDtoGoTpl, map[string]*bintree{}},
 		"exists.go.tpl":                         &bintree{templates_testExistsGoTpl, map[string]*bintree{}},
 		"find.go.tpl":                           &bintree{templates_testFindGoTpl, map[string]*bintree{}},
+		"find_or_create.go.tpl":                 &bintree{templates_testFind_or_createGoTpl, map[string]*bintree{}},
 		"finishers.go.tpl":                      &bintree{templates_testFinishersGoTpl, map[string]*bintree{}},
 		"hooks.go.tpl":                          &bintree{templates_testHooksGoTpl, map[string]*bintree{}},
 		"insert.go.tpl":                         &bintree{templates_testInsertGoTpl, map[string]*bintree{}},
+		"insert_ignore.go.tpl":                  &bintree{templates_testInsert_ignoreGoTpl, map[string]*bintree{}},
+		"load_by_keys.go.tpl":                   &bintree{templates_testLoad_by_keysGoTpl, map[string]*bintree{}},
+		"projection.go.tpl":                     &bintree{templates_testProjectionGoTpl, map[string]*bintree{}},
 		"relationship_one_to_one.go.tpl":        &bintree{templates_testRelationship_one_to_oneGoTpl, map[string]*bintree{}},
 		"relationship_one_to_one_setops.go.tpl": &bintree{templates_testRelationship_one_to_one_setopsGoTpl, map[string]*bintree{}},
 		"relationship_to_many.go.tpl":           &bintree{templates_testRelationship_to_manyGoTpl, map[string]*bintree{}},
@@ -1238,12 +1860,14 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"reload.go.tpl":                         &bintree{templates_testReloadGoTpl, map[string]*bintree{}},
 		"select.go.tpl":                         &bintree{templates_testSelectGoTpl, map[string]*bintree{}},
 		"singleton": &bintree{nil, map[string]*bintree{
+			"boil_embeds_test.go.tpl":  &bintree{templates_testSingletonBoil_embeds_testGoTpl, map[string]*bintree{}},
 			"boil_main_test.go.tpl":    &bintree{templates_testSingletonBoil_main_testGoTpl, map[string]*bintree{}},
 			"boil_queries_test.go.tpl": &bintree{templates_testSingletonBoil_queries_testGoTpl, map[string]*bintree{}},
 			"boil_suites_test.go.tpl":  &bintree{templates_testSingletonBoil_suites_testGoTpl, map[string]*bintree{}},
 		}},
-		"types.go.tpl":  &bintree{templates_testTypesGoTpl, map[string]*bintree{}},
-		"update.go.tpl": &bintree{templates_testUpdateGoTpl, map[string]*bintree{}},
+		"types.go.tpl":            &bintree{templates_testTypesGoTpl, map[string]*bintree{}},
+		"update.go.tpl":           &bintree{templates_testUpdateGoTpl, map[string]*bintree{}},
+		"validate_lengths.go.tpl": &bintree{templates_testValidate_lengthsGoTpl, map[string]*bintree{}},
 	}},
 }}
 
@@ -3,21 +3,30 @@
 
 // {{$alias.UpSingular}} is an object representing the database table.
 type {{$alias.UpSingular}} struct {
+	{{- if .Table.EmbedStruct}}
+	{{.Table.EmbedStruct}} `boil:"-"`
+
+	{{end -}}
+	{{- range $.TableEmbeds $orig_tbl_name}}{{"\n\t"}}{{.Name}} `boil:",inline" yaml:",inline"`{{end}}
+	{{- if $.TableEmbeds $orig_tbl_name}}{{"\n\n\t"}}{{end -}}
 	{{- range $column := .Table.Columns -}}
+	{{- if not ($.Embedded $orig_tbl_name $column.Name) -}}
 	{{- $colAlias := $alias.Column $column.Name -}}
 	{{- $orig_col_name := $column.Name -}}
-	{{- range $column.Comment | splitLines -}} // {{ . }}
+	{{- range $column.Comment | columnComment -}} //{{if .}} {{.}}{{end}}
+	{{end -}}
+	{{- if $column.IsDecimal -}}
+	{{- if gt $column.Precision 0 -}} // Stored as decimal({{$column.Precision}},{{$column.Scale}}), see ValidateLengths.
+	{{end -}}
+	{{- else if gt $column.Precision 0 -}} // Stored with {{$column.Precision}} fractional second digits, finer values are rounded by the database.
 	{{end -}}
 	{{if ignore $orig_tbl_name $orig_col_name $.TagIgnore -}}
-	{{$colAlias}} {{$column.Type}} `{{generateIgnoreTags $.Tags}}boil:"{{$column.Name}}" json:"-" toml:"-" yaml:"-"`
-	{{else if eq $.StructTagCasing "title" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name | titleCase}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name | titleCase}}" yaml:"{{$column.Name | titleCase}}{{if $column.Nullable}},omitempty{{end}}"`
-	{{else if eq $.StructTagCasing "camel" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name | camelCase}}" yaml:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}"`
-	{{else if eq $.StructTagCasing "alias" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $colAlias}}boil:"{{$column.Name}}" json:"{{$colAlias}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$colAlias}}" yaml:"{{$colAlias}}{{if $column.Nullable}},omitempty{{end}}"`
+	{{$colAlias}} {{$column.Type}} `{{generateIgnoreTags $.Tags}}boil:"{{escapeString $column.Name}}" json:"-" toml:"-" yaml:"-"`
 	{{else -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name}}" yaml:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}"`
+	{{- $tagName := escapeString $column.Name}}{{if eq $.StructTagCasing "alias"}}{{$tagName = $colAlias}}{{end -}}
+	{{- $opt := ""}}{{if $column.Nullable}}{{$opt = ",omitempty"}}{{end -}}
+	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $tagName}}boil:"{{escapeString $column.Name}}" json:"{{tagCase ($.TagCasing "json") $column.Name $colAlias | escapeString}}{{$opt}}" toml:"{{tagCase ($.TagCasing "toml") $column.Name $colAlias | escapeString}}" yaml:"{{tagCase ($.TagCasing "yaml") $column.Name $colAlias | escapeString}}{{$opt}}"`
+	{{end -}}
 	{{end -}}
 	{{end -}}
 	{{- if .Table.IsJoinTable -}}
@@ -35,10 +44,51 @@ var {{$alias.UpSingular}}Columns = struct {
 }{
 	{{range $column := .Table.Columns -}}
 	{{- $colAlias := $alias.Column $column.Name -}}
-	{{$colAlias}}: "{{$column.Name}}",
+	{{$colAlias}}: {{printf "%q" $column.Name}},
+	{{end -}}
+}
+
+// {{$alias.UpSingular}}AllColumns holds every column of {{$orig_tbl_name}} in table order,
+// ex: to start a boil.Whitelist or qm.Select from.
+var {{$alias.UpSingular}}AllColumns = []string{
+	{{range $column := .Table.Columns -}}
+	{{printf "%q" $column.Name}},
 	{{end -}}
 }
 
+{{- range $column := .Table.Columns}}
+{{- if and $column.AutoIncrement $column.IdentityStep}}
+{{- $colAlias := $alias.Column $column.Name}}
+
+// {{$alias.UpSingular}}{{$colAlias}}IdentitySeed and {{$alias.UpSingular}}{{$colAlias}}IdentityStep are the first value
+// and the increment of the identity column {{$column.Name}}.
+const (
+	{{$alias.UpSingular}}{{$colAlias}}IdentitySeed int64 = {{$column.IdentitySeed}}
+	{{$alias.UpSingular}}{{$colAlias}}IdentityStep int64 = {{$column.IdentityStep}}
+)
+{{- end}}
+{{- end}}
+
+{{- if .GenerateConstructors}}
+{{- $required := filterColumnsByRequired .Table.Columns -}}
+{{- $reqDefs := sqlColDefinitions .Table.Columns (columnNames $required) -}}
+{{- $reqNames := $reqDefs.Names | stringMap (aliasCols $alias) | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved}}
+
+// New{{$alias.UpSingular}} creates a {{$alias.UpSingular}} with every column the database
+// requires a value for, the NOT NULL columns without a default. Columns
+// with a literal default start out with it.
+func New{{$alias.UpSingular}}({{joinSlices " " $reqNames $reqDefs.Types | join ", "}}) *{{$alias.UpSingular}} {
+	{{$alias.DownSingular}}Obj := &{{$alias.UpSingular}}{}
+	{{range $i, $column := $required -}}
+	{{$alias.DownSingular}}Obj.{{$alias.Column $column.Name}} = {{index $reqNames $i}}
+	{{end -}}
+	{{range $column := .Table.Columns}}{{if $column.GoDefault -}}
+	{{$alias.DownSingular}}Obj.{{$alias.Column $column.Name}} = {{$column.GoDefault}}
+	{{end}}{{end}}
+	return {{$alias.DownSingular}}Obj
+}
+{{- end}}
+
 {{/* Generated where helpers for all types in the database */}}
 // Generated where
 {{- range .Table.Columns -}}
@@ -82,7 +132,21 @@ var {{$alias.UpSingular}}Where = struct {
 }{
 	{{range $column := .Table.Columns -}}
 	{{- $colAlias := $alias.Column $column.Name -}}
-	{{$colAlias}}: whereHelper{{goVarname $column.Type}}{field: "{{$.Table.Name | $.SchemaTable}}.{{$column.Name | $.Quotes}}"},
+	{{$colAlias}}: whereHelper{{goVarname $column.Type}}{field: "{{$.Table.Name | $.SchemaTable}}.{{escapeString $column.Name | $.Quotes}}"},
+	{{end -}}
+}
+
+// {{$alias.UpSingular}}OrderBy orders queries by a column of {{$orig_tbl_name}},
+// ex: {{$alias.UpSingular}}OrderBy.{{$alias.Column (index .Table.Columns 0).Name}}.Desc()
+var {{$alias.UpSingular}}OrderBy = struct {
+	{{range $column := .Table.Columns -}}
+	{{- $colAlias := $alias.Column $column.Name -}}
+	{{$colAlias}} orderByHelper
+	{{end -}}
+}{
+	{{range $column := .Table.Columns -}}
+	{{- $colAlias := $alias.Column $column.Name -}}
+	{{$colAlias}}: orderByHelper{field: "{{$.Table.Name | $.SchemaTable}}.{{escapeString $column.Name | $.Quotes}}"},
 	{{end -}}
 }
 
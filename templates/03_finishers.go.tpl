@@ -36,6 +36,10 @@ func (q {{$alias.DownSingular}}Query) OneP({{if .NoContext}}exec boil.Executor{{
 
 // One returns a single {{$alias.DownSingular}} record from the query.
 func (q {{$alias.DownSingular}}Query) One({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (*{{$alias.UpSingular}}, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "One")
+
+	{{end -}}
 	o := &{{$alias.UpSingular}}{}
 
 	queries.SetLimit(q.Query, 1)
@@ -93,6 +97,10 @@ func (q {{$alias.DownSingular}}Query) AllP({{if .NoContext}}exec boil.Executor{{
 
 // All returns all {{$alias.UpSingular}} records from the query.
 func (q {{$alias.DownSingular}}Query) All({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) ({{$alias.UpSingular}}Slice, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "All")
+
+	{{end -}}
 	var o []*{{$alias.UpSingular}}
 
 	err := q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o)
@@ -113,6 +121,27 @@ func (q {{$alias.DownSingular}}Query) All({{if .NoContext}}exec boil.Executor{{e
 	return o, nil
 }
 
+// Stream calls fn with each {{$alias.UpSingular}} record from the query as its row is read,
+// rather than loading them all into a slice like All does.
+// The first error returned by fn stops the iteration and is returned as is{{if not .NoContext}},
+// cancelling ctx stops it between rows{{end}}.
+func (q {{$alias.DownSingular}}Query) Stream({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, fn func(*{{$alias.UpSingular}}) error) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Stream")
+
+	{{end -}}
+	return q.Query.Stream({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &{{$alias.UpSingular}}{}, func(obj interface{}) error {
+		o := obj.(*{{$alias.UpSingular}})
+		{{if not .NoHooks -}}
+		if err := o.doAfterSelectHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+		{{end -}}
+
+		return fn(o)
+	})
+}
+
 {{if .AddGlobal -}}
 // CountG returns the count of all {{$alias.UpSingular}} records in the query, and panics on error.
 func (q {{$alias.DownSingular}}Query) CountG({{if not .NoContext}}ctx context.Context{{end}}) (int64, error) {
@@ -149,6 +178,10 @@ func (q {{$alias.DownSingular}}Query) CountP({{if .NoContext}}exec boil.Executor
 
 // Count returns the count of all {{$alias.UpSingular}} records in the query.
 func (q {{$alias.DownSingular}}Query) Count({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (int64, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Count")
+
+	{{end -}}
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
@@ -202,6 +235,10 @@ func (q {{$alias.DownSingular}}Query) ExistsP({{if .NoContext}}exec boil.Executo
 
 // Exists checks if the row exists in the table.
 func (q {{$alias.DownSingular}}Query) Exists({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (bool, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Exists")
+
+	{{end -}}
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
@@ -5,12 +5,15 @@
 		{{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
 		{{- $rel := $ltable.Relationship $fkey.Name -}}
 		{{- $canSoftDelete := (getTable $.Tables $fkey.ForeignTable).CanSoftDelete }}
+		{{- $softDeleteColumn := (getTable $.Tables $fkey.ForeignTable).SoftDeleteColumnName }}
 // {{$rel.Foreign}} pointed to by the foreign key.
 func (o *{{$ltable.UpSingular}}) {{$rel.Foreign}}(mods ...qm.QueryMod) ({{$ftable.DownSingular}}Query) {
 	queryMods := []qm.QueryMod{
-		qm.Where("{{$fkey.ForeignColumn | $.Quotes}} = ?", o.{{$ltable.Column $fkey.Column}}),
+		{{range $i, $col := $fkey.Columns -}}
+		qm.Where("{{index $fkey.ForeignColumns $i | $.Quotes}} = ?", o.{{$ltable.Column $col}}),
+		{{end -}}
 		{{if and $.AddSoftDeletes $canSoftDelete -}}
-		qmhelper.WhereIsNull("deleted_at"),
+		qmhelper.WhereIsNull("{{$softDeleteColumn | $.Quotes}}"),
 		{{- end}}
 	}
 
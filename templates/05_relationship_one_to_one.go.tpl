@@ -5,12 +5,15 @@
 		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
 		{{- $relAlias := $ftable.Relationship $rel.Name -}}
 		{{- $canSoftDelete := (getTable $.Tables $rel.ForeignTable).CanSoftDelete }}
+		{{- $softDeleteColumn := (getTable $.Tables $rel.ForeignTable).SoftDeleteColumnName }}
 // {{$relAlias.Local}} pointed to by the foreign key.
 func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) ({{$ftable.DownSingular}}Query) {
 	queryMods := []qm.QueryMod{
-		qm.Where("{{$rel.ForeignColumn | $.Quotes}} = ?", o.{{$ltable.Column $rel.Column}}),
+		{{range $i, $col := $rel.Columns -}}
+		qm.Where("{{index $rel.ForeignColumns $i | $.Quotes}} = ?", o.{{$ltable.Column $col}}),
+		{{end -}}
         {{if and $.AddSoftDeletes $canSoftDelete -}}
-        qmhelper.WhereIsNull("deleted_at"),
+        qmhelper.WhereIsNull("{{$softDeleteColumn | $.Quotes}}"),
         {{- end}}
 	}
 
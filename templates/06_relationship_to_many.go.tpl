@@ -6,8 +6,9 @@
 		{{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
 		{{- $schemaForeignTable := .ForeignTable | $.SchemaTable -}}
 		{{- $canSoftDelete := (getTable $.Tables .ForeignTable).CanSoftDelete }}
+		{{- $softDeleteColumn := (getTable $.Tables .ForeignTable).SoftDeleteColumnName }}
 // {{$relAlias.Local}} retrieves all the {{.ForeignTable | singular}}'s {{$ftable.UpPlural}} with an executor
-{{- if not (eq $relAlias.Local $ftable.UpPlural)}} via {{$rel.ForeignColumn}} column{{- end}}.
+{{- if not (eq $relAlias.Local $ftable.UpPlural)}} via {{$rel.ForeignColumns | join ", "}} column{{if $rel.IsComposite}}s{{end}}{{- end}}.
 func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) {{$ftable.DownSingular}}Query {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
@@ -22,9 +23,11 @@ func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) {{$fta
 	)
 		{{else -}}
 	queryMods = append(queryMods,
-		qm.Where("{{$schemaForeignTable}}.{{$rel.ForeignColumn | $.Quotes}}=?", o.{{$ltable.Column $rel.Column}}),
+		{{range $i, $col := $rel.Columns -}}
+		qm.Where("{{$schemaForeignTable}}.{{index $rel.ForeignColumns $i | $.Quotes}}=?", o.{{$ltable.Column $col}}),
+		{{end -}}
 		{{if and $.AddSoftDeletes $canSoftDelete -}}
-		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
+		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{$softDeleteColumn | $.Quotes}}"),
 		{{- end}}
 	)
 		{{end}}
@@ -39,5 +42,10 @@ func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) {{$fta
 	return query
 }
 
+// Count{{$relAlias.Local}} counts the {{.ForeignTable | singular}}'s {{$ftable.UpPlural}} in the database without loading them.
+func (o *{{$ltable.UpSingular}}) Count{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, mods ...qm.QueryMod) (int64, error) {
+	return o.{{$relAlias.Local}}(mods...).Count({{if not $.NoContext}}ctx, {{end}}exec)
+}
+
 {{end -}}{{- /* range relationships */ -}}
 {{- end -}}{{- /* if isJoinTable */ -}}
@@ -9,6 +9,7 @@
 		{{- $fcol := $ftable.Column $fkey.ForeignColumn -}}
 		{{- $usesPrimitives := usesPrimitives $.Tables $fkey.Table $fkey.Column $fkey.ForeignTable $fkey.ForeignColumn -}}
 		{{- $canSoftDelete := (getTable $.Tables $fkey.ForeignTable).CanSoftDelete }}
+		{{- $softDeleteColumn := (getTable $.Tables $fkey.ForeignTable).SoftDeleteColumnName }}
 // Load{{$rel.Foreign}} allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
@@ -21,6 +22,37 @@ func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.E
 		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
 	}
 
+	{{if $fkey.IsComposite -}}
+	args := make([][]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &{{$ltable.DownSingular}}R{}
+		}
+		// A key with a null column can't match a row
+		if key := []interface{}{ {{- range $i, $c := $fkey.Columns}}{{if $i}}, {{end}}object.{{$ltable.Column $c}}{{end -}} }; !queries.HasNil(key...) {
+			args = append(args, key)
+		}
+	} else {
+		Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &{{$ltable.DownSingular}}R{}
+			}
+
+			key := []interface{}{ {{- range $i, $c := $fkey.Columns}}{{if $i}}, {{end}}obj.{{$ltable.Column $c}}{{end -}} }
+			if queries.HasNil(key...) {
+				continue
+			}
+			for _, a := range args {
+				if queries.EqualTuple(a, key) {
+					continue Outer
+				}
+			}
+
+			args = append(args, key)
+		}
+	}
+	{{else -}}
 	args := make([]interface{}, 0, 1)
 	if singular {
 		if object.R == nil {
@@ -59,16 +91,20 @@ func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.E
 			{{end}}
 		}
 	}
-
+	{{end}}
 	if len(args) == 0 {
 		return nil
 	}
 
 	query := NewQuery(
-	    qm.From(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}`),
-	    qm.WhereIn(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{.ForeignColumn}} in ?`, args...),
+	    qm.From("{{.ForeignTable | $.SchemaTable}}"),
+	    {{if $fkey.IsComposite -}}
+	    qmhelper.WhereInTuples([]string{ {{- range $i, $c := $fkey.ForeignColumns}}{{if $i}}, {{end}}"{{$fkey.ForeignTable | $.SchemaTable}}.{{$c | $.Quotes}}"{{end -}} }, args),
+	    {{else -}}
+	    qm.WhereIn("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} in ?", args...),
+	    {{end -}}
 	    {{if and $.AddSoftDeletes $canSoftDelete -}}
-	    qmhelper.WhereIsNull(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.deleted_at`),
+	    qmhelper.WhereIsNull("{{.ForeignTable | $.SchemaTable}}.{{$softDeleteColumn | $.Quotes}}"),
 	    {{- end}}
     )
 	if mods != nil {
@@ -128,7 +164,9 @@ func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.E
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			{{if $usesPrimitives -}}
+			{{if $fkey.IsComposite -}}
+			if queries.EqualTuple([]interface{}{ {{- range $i, $c := $fkey.Columns}}{{if $i}}, {{end}}local.{{$ltable.Column $c}}{{end -}} }, []interface{}{ {{- range $i, $c := $fkey.ForeignColumns}}{{if $i}}, {{end}}foreign.{{$ftable.Column $c}}{{end -}} }) {
+			{{else if $usesPrimitives -}}
 			if local.{{$col}} == foreign.{{$fcol}} {
 			{{else -}}
 			if queries.Equal(local.{{$col}}, foreign.{{$fcol}}) {
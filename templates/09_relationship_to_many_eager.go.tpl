@@ -10,6 +10,7 @@
 		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
 		{{- $schemaForeignTable := $rel.ForeignTable | $.SchemaTable -}}
 		{{- $canSoftDelete := (getTable $.Tables $rel.ForeignTable).CanSoftDelete }}
+		{{- $softDeleteColumn := (getTable $.Tables $rel.ForeignTable).SoftDeleteColumnName }}
 // Load{{$relAlias.Local}} allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
@@ -22,6 +23,37 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
 	}
 
+	{{if $rel.IsComposite -}}
+	args := make([][]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &{{$ltable.DownSingular}}R{}
+		}
+		// A key with a null column can't match a row
+		if key := []interface{}{ {{- range $i, $c := $rel.Columns}}{{if $i}}, {{end}}object.{{$ltable.Column $c}}{{end -}} }; !queries.HasNil(key...) {
+			args = append(args, key)
+		}
+	} else {
+		Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &{{$ltable.DownSingular}}R{}
+			}
+
+			key := []interface{}{ {{- range $i, $c := $rel.Columns}}{{if $i}}, {{end}}obj.{{$ltable.Column $c}}{{end -}} }
+			if queries.HasNil(key...) {
+				continue
+			}
+			for _, a := range args {
+				if queries.EqualTuple(a, key) {
+					continue Outer
+				}
+			}
+
+			args = append(args, key)
+		}
+	}
+	{{else -}}
 	args := make([]interface{}, 0, 1)
 	if singular {
 		if object.R == nil {
@@ -48,77 +80,107 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 			args = append(args, obj.{{.Column | titleCase}})
 		}
 	}
-
+	{{end}}
 	if len(args) == 0 {
 		return nil
 	}
 
-		{{if .ToJoinTable -}}
-			{{- $schemaJoinTable := .JoinTable | $.SchemaTable -}}
-			{{- $foreignTable := getTable $.Tables .ForeignTable -}}
-	query := NewQuery(
-		qm.Select("{{$foreignTable.Columns | columnNames | prefixStringSlice (print $schemaForeignTable ".") | join ", "}}, {{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}"),
-		qm.From("{{$schemaForeignTable}}"),
-		qm.InnerJoin("{{$schemaJoinTable}} as {{id 0 | $.Quotes}} on {{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} = {{id 0 | $.Quotes}}.{{.JoinForeignColumn | $.Quotes}}"),
-		qm.WhereIn("{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}} in ?", args...),
-		{{if and $.AddSoftDeletes $canSoftDelete -}}
-		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
-		{{- end}}
-	)
-		{{else -}}
-	query := NewQuery(
-	    qm.From(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}`),
-	    qm.WhereIn(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{.ForeignColumn}} in ?`, args...),
-	    {{if and $.AddSoftDeletes $canSoftDelete -}}
-	    qmhelper.WhereIsNull(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.deleted_at`),
-	    {{- end}}
-    )
-		{{end -}}
-	if mods != nil {
-		mods.Apply(query)
-	}
-
-	{{if $.NoContext -}}
-	results, err := query.Query(e)
-	{{else -}}
-	results, err := query.QueryContext(ctx, e)
-	{{end -}}
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load {{.ForeignTable}}")
-	}
-
-	var resultSlice []*{{$ftable.UpSingular}}
 	{{if .ToJoinTable -}}
-	{{- $foreignTable := getTable $.Tables .ForeignTable -}}
 	{{- $joinTable := getTable $.Tables .JoinTable -}}
-	{{- $localCol := $joinTable.GetColumn .JoinLocalColumn}}
+	{{- $localCol := $joinTable.GetColumn .JoinLocalColumn -}}
 	var localJoinCols []{{$localCol.Type}}
-	for results.Next() {
-		one := new({{$ftable.UpSingular}})
-		var localJoinCol {{$localCol.Type}}
+	{{end -}}
+	var resultSlice []*{{$ftable.UpSingular}}
 
-		err = results.Scan({{$foreignTable.Columns | columnNames | stringMap (aliasCols $ftable) | prefixStringSlice "&one." | join ", "}}, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for {{.ForeignTable}}")
+	// Keys are split into chunks so the IN clause, along with the
+	// parameters the query mods bind, stays under the parameter limit
+	var modParams int
+	if mods != nil && LoadChunkSize > 0 {
+		modQuery := NewQuery()
+		mods.Apply(modQuery)
+		_, modArgs := queries.BuildQuery(modQuery)
+		modParams = len(modArgs)
+	}
+	chunkSize := queries.KeyChunkSize(LoadChunkSize, modParams, {{if $rel.IsComposite}}{{len $rel.ForeignColumns}}{{else}}1{{end}})
+	if chunkSize == 0 {
+		chunkSize = len(args)
+	}
+
+	for start := 0; start < len(args); start += chunkSize {
+		end := start + chunkSize
+		if end > len(args) {
+			end = len(args)
 		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice {{.ForeignTable}}")
+
+			{{if .ToJoinTable -}}
+				{{- $schemaJoinTable := .JoinTable | $.SchemaTable -}}
+				{{- $foreignTable := getTable $.Tables .ForeignTable -}}
+		query := NewQuery(
+			qm.Select("{{range $foreignTable.Columns}}{{$schemaForeignTable}}.{{.Name | $.Quotes}}, {{end}}{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}"),
+			qm.From("{{$schemaForeignTable}}"),
+			qm.InnerJoin("{{$schemaJoinTable}} as {{id 0 | $.Quotes}} on {{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} = {{id 0 | $.Quotes}}.{{.JoinForeignColumn | $.Quotes}}"),
+			qm.WhereIn("{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}} in ?", args[start:end]...),
+			{{if and $.AddSoftDeletes $canSoftDelete -}}
+			qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{$softDeleteColumn | $.Quotes}}"),
+			{{- end}}
+		)
+			{{else -}}
+		query := NewQuery(
+		    qm.From("{{.ForeignTable | $.SchemaTable}}"),
+		    {{if $rel.IsComposite -}}
+		    qmhelper.WhereInTuples([]string{ {{- range $i, $c := $rel.ForeignColumns}}{{if $i}}, {{end}}"{{$rel.ForeignTable | $.SchemaTable}}.{{$c | $.Quotes}}"{{end -}} }, args[start:end]),
+		    {{else -}}
+		    qm.WhereIn("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} in ?", args[start:end]...),
+		    {{end -}}
+		    {{if and $.AddSoftDeletes $canSoftDelete -}}
+		    qmhelper.WhereIsNull("{{.ForeignTable | $.SchemaTable}}.{{$softDeleteColumn | $.Quotes}}"),
+		    {{- end}}
+		)
+			{{end -}}
+		if mods != nil {
+			mods.Apply(query)
 		}
 
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
-	}
-	{{- else -}}
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice {{.ForeignTable}}")
-	}
-	{{- end}}
+		{{if $.NoContext -}}
+		results, err := query.Query(e)
+		{{else -}}
+		results, err := query.QueryContext(ctx, e)
+		{{end -}}
+		if err != nil {
+			return errors.Wrap(err, "failed to eager load {{.ForeignTable}}")
+		}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on {{.ForeignTable}}")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{.ForeignTable}}")
+		{{if .ToJoinTable -}}
+		{{- $foreignTable := getTable $.Tables .ForeignTable -}}
+		{{- $joinTable := getTable $.Tables .JoinTable -}}
+		{{- $localCol := $joinTable.GetColumn .JoinLocalColumn}}
+		for results.Next() {
+			one := new({{$ftable.UpSingular}})
+			var localJoinCol {{$localCol.Type}}
+
+			err = results.Scan({{$foreignTable.Columns | columnNames | stringMap (aliasCols $ftable) | prefixStringSlice "&one." | join ", "}}, &localJoinCol)
+			if err != nil {
+				return errors.Wrap(err, "failed to scan eager loaded results for {{.ForeignTable}}")
+			}
+			if err = results.Err(); err != nil {
+				return errors.Wrap(err, "failed to plebian-bind eager loaded slice {{.ForeignTable}}")
+			}
+
+			resultSlice = append(resultSlice, one)
+			localJoinCols = append(localJoinCols, localJoinCol)
+		}
+		{{- else -}}
+		if err = queries.Bind(results, &resultSlice); err != nil {
+			return errors.Wrap(err, "failed to bind eager loaded slice {{.ForeignTable}}")
+		}
+		{{- end}}
+
+		if err = results.Close(); err != nil {
+			return errors.Wrap(err, "failed to close results in eager load on {{.ForeignTable}}")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{.ForeignTable}}")
+		}
 	}
 
 	{{if not $.NoHooks -}}
@@ -171,7 +233,9 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 	{{else -}}
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			{{if $usesPrimitives -}}
+			{{if $rel.IsComposite -}}
+			if queries.EqualTuple([]interface{}{ {{- range $i, $c := $rel.Columns}}{{if $i}}, {{end}}local.{{$ltable.Column $c}}{{end -}} }, []interface{}{ {{- range $i, $c := $rel.ForeignColumns}}{{if $i}}, {{end}}foreign.{{$ftable.Column $c}}{{end -}} }) {
+			{{else if $usesPrimitives -}}
 			if local.{{$col}} == foreign.{{$fcol}} {
 			{{else -}}
 			if queries.Equal(local.{{$col}}, foreign.{{$fcol}}) {
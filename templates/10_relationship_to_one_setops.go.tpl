@@ -1,6 +1,8 @@
-{{- if .Table.IsJoinTable -}}
+{{- if or .Table.IsJoinTable .Table.IsReadOnly -}}
 {{- else -}}
 	{{- range $fkey := .Table.FKeys -}}
+	{{- /* setops only write to writable tables */ -}}
+	{{- if not ($.ReadOnly $fkey.ForeignTable) -}}
 		{{- $ltable := $.Aliases.Table $fkey.Table -}}
 		{{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
 		{{- $rel := $ltable.Relationship $fkey.Name -}}
@@ -49,7 +51,19 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}GP({{if not $.NoContext}}ctx
 // Set{{$rel.Foreign}} of the {{$ltable.DownSingular}} to the related item.
 // Sets o.R.{{$rel.Foreign}} to related.
 // Adds o to related.R.{{$rel.Local}}.
+{{- if $fkey.IsComposite}}
+// Every column of the key is set in one transaction with the insert of related,
+// and related must have all of them populated.
+{{- end}}
 func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$ftable.UpSingular}}) error {
+	{{if $fkey.IsComposite -}}
+	return boil.InTx{{if not $.NoContext}}Context{{end}}({{if not $.NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not $.NoContext}}Context{{end}}Executor) error {
+		return o.set{{$rel.Foreign}}({{if not $.NoContext}}ctx, {{end -}} exec, insert, related)
+	})
+}
+
+func (o *{{$ltable.UpSingular}}) set{{$rel.Foreign}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$ftable.UpSingular}}) error {
+	{{end -}}
 	var err error
 	if insert {
 		if err = related.Insert({{if not $.NoContext}}ctx, {{end -}} exec, boil.Infer()); err != nil {
@@ -57,12 +71,26 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 		}
 	}
 
+	{{if $fkey.IsComposite -}}
+	if queries.HasNil({{range $i, $c := $fkey.ForeignColumns}}{{if $i}}, {{end}}related.{{$ftable.Column $c}}{{end}}) {
+		return errors.New("{{$.PkgName}}: unable to link {{$fkey.Table | singular}} to {{$fkey.ForeignTable | singular}}, a column of the {{$fkey.ForeignTable | singular}} key is null")
+	}
+
+	cols := []string{ {{- range $i, $c := $fkey.Columns}}{{if $i}}, {{end}}"{{$c}}"{{end -}} }
+	updateQuery := fmt.Sprintf(
+		"UPDATE {{$schemaTable}} SET %s WHERE %s",
+		strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, cols),
+		strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}len(cols)+1{{else}}0{{end}}, {{$ltable.DownSingular}}PrimaryKeyColumns),
+	)
+	values := []interface{}{ {{- range $c := $fkey.ForeignColumns}}related.{{$ftable.Column $c}}, {{end}}o.{{$.Table.PKey.Columns | stringMap (aliasCols $ltable) | join ", o."}}{{"}"}}
+	{{- else -}}
 	updateQuery := fmt.Sprintf(
 		"UPDATE {{$schemaTable}} SET %s WHERE %s",
 		strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.Column}}"{{"}"}}),
 		strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$ltable.DownSingular}}PrimaryKeyColumns),
 	)
 	values := []interface{}{related.{{$fcol}}, o.{{$.Table.PKey.Columns | stringMap (aliasCols $ltable) | join ", o."}}{{"}"}}
+	{{- end}}
 
 	{{if $.NoContext -}}
 	if boil.DebugMode {
@@ -82,12 +110,21 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 		return errors.Wrap(err, "failed to update local table")
 	}
 	{{- else -}}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+	if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
 	{{- end}}
 
-	{{if $usesPrimitives -}}
+	{{if $fkey.IsComposite -}}
+	{{range $i, $c := $fkey.Columns -}}
+	{{- $fc := index $fkey.ForeignColumns $i -}}
+	{{if usesPrimitives $.Tables $fkey.Table $c $fkey.ForeignTable $fc -}}
+	o.{{$ltable.Column $c}} = related.{{$ftable.Column $fc}}
+	{{else -}}
+	queries.Assign(&o.{{$ltable.Column $c}}, related.{{$ftable.Column $fc}})
+	{{end -}}
+	{{end -}}
+	{{else if $usesPrimitives -}}
 	o.{{$col}} = related.{{$fcol}}
 	{{else -}}
 	queries.Assign(&o.{{$col}}, related.{{$fcol}})
@@ -163,14 +200,33 @@ func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}GP({{if not $.NoContext}}
 // Remove{{$rel.Foreign}} relationship.
 // Sets o.R.{{$rel.Foreign}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
+{{- if $fkey.IsComposite}}
+// Every nullable column of the key is cleared by the same update.
+{{- end}}
 func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) error {
 	var err error
 
+	{{if $fkey.IsComposite -}}
+	{{range $c := $fkey.Columns -}}
+	{{if isPointerType ($.Table.GetColumn $c).Type -}}
+	o.{{$ltable.Column $c}} = nil
+	{{else if ($.Table.GetColumn $c).Nullable -}}
+	queries.SetScanner(&o.{{$ltable.Column $c}}, nil)
+	{{end -}}
+	{{end -}}
+	{{- $sep := "" -}}
+	if {{if not $.NoRowsAffected}}_, {{end -}} err = o.Update({{if not $.NoContext}}ctx, {{end -}} exec, boil.Whitelist({{range $c := $fkey.Columns}}{{if ($.Table.GetColumn $c).Nullable}}{{$sep}}"{{$c}}"{{$sep = ", "}}{{end}}{{end}})); err != nil {
+	{{else -}}
+	{{if isPointerType ($.Table.GetColumn .Column).Type -}}
+	o.{{$col}} = nil
+	{{else -}}
 	queries.SetScanner(&o.{{$col}}, nil)
+	{{end -}}
 	{{if $.NoContext -}}
 	if {{if not $.NoRowsAffected}}_, {{end -}} err = o.Update(exec, boil.Whitelist("{{.Column}}")); err != nil {
 	{{else -}}
 	if {{if not $.NoRowsAffected}}_, {{end -}} err = o.Update(ctx, exec, boil.Whitelist("{{.Column}}")); err != nil {
+	{{end -}}
 	{{end -}}
 		return errors.Wrap(err, "failed to update local table")
 	}
@@ -186,7 +242,9 @@ func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}({{if $.NoContext}}exec b
 	related.R.{{$rel.Local}} = nil
 	{{else -}}
 	for i, ri := range related.R.{{$rel.Local}} {
-		{{if $usesPrimitives -}}
+		{{if $fkey.IsComposite -}}
+		if ri != o {
+		{{else if $usesPrimitives -}}
 		if o.{{$col}} != ri.{{$col}} {
 		{{else -}}
 		if queries.Equal(o.{{$col}}, ri.{{$col}}) {
@@ -206,5 +264,6 @@ func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}({{if $.NoContext}}exec b
 	return nil
 }
 {{end -}}{{/* if foreignkey nullable */}}
+{{- end -}}{{- /* if not read only */ -}}
 {{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
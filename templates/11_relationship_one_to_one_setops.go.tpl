@@ -1,6 +1,8 @@
-{{- if .Table.IsJoinTable -}}
+{{- if or .Table.IsJoinTable .Table.IsReadOnly -}}
 {{- else -}}
 	{{- range $rel := .Table.ToOneRelationships -}}
+	{{- /* setops only write to writable tables */ -}}
+	{{- if not ($.ReadOnly $rel.ForeignTable) -}}
 		{{- $ltable := $.Aliases.Table $rel.Table -}}
 		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
 		{{- $relAlias := $ftable.Relationship $rel.Name -}}
@@ -49,13 +51,32 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}GP({{if not $.NoContext}}
 // Set{{$relAlias.Local}} of the {{$ltable.DownSingular}} to the related item.
 // Sets o.R.{{$relAlias.Local}} to related.
 // Adds o to related.R.{{$relAlias.Foreign}}.
+{{- if $rel.IsComposite}}
+// Every column of the key is set by the same insert or update, and o must
+// have all of them populated.
+{{- end}}
 func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$ftable.UpSingular}}) error {
 	var err error
+	{{- if $rel.IsComposite}}
+
+	if queries.HasNil({{range $i, $c := $rel.Columns}}{{if $i}}, {{end}}o.{{$ltable.Column $c}}{{end}}) {
+		return errors.New("{{$.PkgName}}: unable to link {{$rel.ForeignTable | singular}} to {{$rel.Table | singular}}, a column of the {{$rel.Table | singular}} key is null")
+	}
+	{{- end}}
 
 	if insert {
-		{{if $usesPrimitives -}}
+		{{- if $rel.IsComposite}}
+		{{- range $i, $c := $rel.ForeignColumns}}
+		{{- $lc := index $rel.Columns $i}}
+		{{- if usesPrimitives $.Tables $rel.Table $lc $rel.ForeignTable $c}}
+		related.{{$ftable.Column $c}} = o.{{$ltable.Column $lc}}
+		{{- else}}
+		queries.Assign(&related.{{$ftable.Column $c}}, o.{{$ltable.Column $lc}})
+		{{- end}}
+		{{- end}}
+		{{- else if $usesPrimitives}}
 		related.{{$fcol}} = o.{{$col}}
-		{{else -}}
+		{{- else}}
 		queries.Assign(&related.{{$fcol}}, o.{{$col}})
 		{{- end}}
 
@@ -63,12 +84,22 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 			return errors.Wrap(err, "failed to insert into foreign table")
 		}
 	} else {
+		{{if $rel.IsComposite -}}
+		cols := []string{ {{- range $i, $c := $rel.ForeignColumns}}{{if $i}}, {{end}}"{{$c}}"{{end -}} }
+		updateQuery := fmt.Sprintf(
+			"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
+			strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, cols),
+			strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}len(cols)+1{{else}}0{{end}}, {{$ftable.DownSingular}}PrimaryKeyColumns),
+		)
+		values := []interface{}{ {{- range $c := $rel.Columns}}o.{{$ltable.Column $c}}, {{end}}related.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", related."}}{{"}"}}
+		{{- else -}}
 		updateQuery := fmt.Sprintf(
 			"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
 			strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
 			strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$ftable.DownSingular}}PrimaryKeyColumns),
 		)
 		values := []interface{}{o.{{$col}}, related.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", related."}}{{"}"}}
+		{{- end}}
 
 		{{if $.NoContext -}}
 		if boil.DebugMode {
@@ -86,14 +117,23 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 		{{if $.NoContext -}}
 		if _, err = exec.Exec(updateQuery, values...); err != nil {
 		{{else -}}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
 		{{end -}}
 			return errors.Wrap(err, "failed to update foreign table")
 		}
 
-		{{if $usesPrimitives -}}
+		{{- if $rel.IsComposite}}
+		{{- range $i, $c := $rel.ForeignColumns}}
+		{{- $lc := index $rel.Columns $i}}
+		{{- if usesPrimitives $.Tables $rel.Table $lc $rel.ForeignTable $c}}
+		related.{{$ftable.Column $c}} = o.{{$ltable.Column $lc}}
+		{{- else}}
+		queries.Assign(&related.{{$ftable.Column $c}}, o.{{$ltable.Column $lc}})
+		{{- end}}
+		{{- end}}
+		{{- else if $usesPrimitives}}
 		related.{{$fcol}} = o.{{$col}}
-		{{else -}}
+		{{- else}}
 		queries.Assign(&related.{{$fcol}}, o.{{$col}})
 		{{- end}}
 	}
@@ -158,11 +198,31 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}GP({{if not $.NoContex
 // Remove{{$relAlias.Local}} relationship.
 // Sets o.R.{{$relAlias.Local}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
+{{- if $rel.IsComposite}}
+// Every nullable column of the key is cleared by the same update.
+{{- end}}
 func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) error {
 	var err error
 
+	{{if $rel.IsComposite -}}
+	{{- $fTableInfo := getTable $.Tables $rel.ForeignTable -}}
+	{{range $c := $rel.ForeignColumns -}}
+	{{if isPointerType ($fTableInfo.GetColumn $c).Type -}}
+	related.{{$ftable.Column $c}} = nil
+	{{else if ($fTableInfo.GetColumn $c).Nullable -}}
+	queries.SetScanner(&related.{{$ftable.Column $c}}, nil)
+	{{end -}}
+	{{end -}}
+	{{- $sep := "" -}}
+	if {{if not $.NoRowsAffected}}_, {{end -}} err = related.Update({{if not $.NoContext}}ctx, {{end -}} exec, boil.Whitelist({{range $c := $rel.ForeignColumns}}{{if ($fTableInfo.GetColumn $c).Nullable}}{{$sep}}"{{$c}}"{{$sep = ", "}}{{end}}{{end}})); err != nil {
+	{{else -}}
+	{{if isPointerType ((getTable $.Tables $rel.ForeignTable).GetColumn $rel.ForeignColumn).Type -}}
+	related.{{$fcol}} = nil
+	{{else -}}
 	queries.SetScanner(&related.{{$fcol}}, nil)
+	{{end -}}
 	if {{if not $.NoRowsAffected}}_, {{end -}} err = related.Update({{if not $.NoContext}}ctx, {{end -}} exec, boil.Whitelist("{{.ForeignColumn}}")); err != nil {
+	{{end -}}
 		return errors.Wrap(err, "failed to update local table")
 	}
 
@@ -177,5 +237,6 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 	return nil
 }
 {{end -}}{{/* if foreignkey nullable */}}
+{{- end -}}{{- /* if not read only */ -}}
 {{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -1,7 +1,9 @@
-{{- if .Table.IsJoinTable -}}
+{{- if or .Table.IsJoinTable .Table.IsReadOnly -}}
 {{- else -}}
 	{{- $table := .Table -}}
 	{{- range $rel := .Table.ToManyRelationships -}}
+	{{- /* setops only bind single column keys through join tables, and only write to writable tables */ -}}
+	{{- if and (not (and $rel.IsComposite $rel.ToJoinTable)) (not ($.ReadOnly $rel.ForeignTable $rel.JoinTable)) -}}
 		{{- $ltable := $.Aliases.Table $rel.Table -}}
 		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
 		{{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
@@ -9,7 +11,8 @@
 		{{- $fcol := $ftable.Column $rel.ForeignColumn -}}
 		{{- $usesPrimitives := usesPrimitives $.Tables $rel.Table $rel.Column $rel.ForeignTable $rel.ForeignColumn -}}
 		{{- $schemaForeignTable := $rel.ForeignTable | $.SchemaTable }}
-		{{- $foreignPKeyCols := (getTable $.Tables $rel.ForeignTable).PKey.Columns }}
+		{{- $fTableInfo := getTable $.Tables $rel.ForeignTable -}}
+		{{- $foreignPKeyCols := $fTableInfo.PKey.Columns }}
 {{if $.AddGlobal -}}
 // Add{{$relAlias.Local}}G adds the given related objects to the existing relationships
 // of the {{$table.Name | singular}}, optionally inserting them as new records.
@@ -54,12 +57,37 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}GP({{if not $.NoContext}}
 // of the {{$table.Name | singular}}, optionally inserting them as new records.
 // Appends related to o.R.{{$relAlias.Local}}.
 // Sets related.R.{{$relAlias.Foreign}} appropriately.
+{{- if $rel.IsComposite}}
+// Every column of the key is set in one transaction, and o must have all of
+// them populated.
+{{- end}}
 func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) error {
+	{{if $rel.IsComposite -}}
+	if queries.HasNil({{range $i, $c := $rel.Columns}}{{if $i}}, {{end}}o.{{$ltable.Column $c}}{{end}}) {
+		return errors.New("{{$.PkgName}}: unable to link {{$rel.ForeignTable | singular}} to {{$rel.Table | singular}}, a column of the {{$rel.Table | singular}} key is null")
+	}
+
+	return boil.InTx{{if not $.NoContext}}Context{{end}}({{if not $.NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not $.NoContext}}Context{{end}}Executor) error {
+		return o.add{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, insert, related...)
+	})
+}
+
+func (o *{{$ltable.UpSingular}}) add{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) error {
+	{{end -}}
 	var err error
 	for _, rel := range related {
 		if insert {
 			{{if not .ToJoinTable -}}
-				{{if $usesPrimitives -}}
+				{{if $rel.IsComposite -}}
+				{{range $i, $c := $rel.ForeignColumns -}}
+				{{- $lc := index $rel.Columns $i -}}
+				{{if usesPrimitives $.Tables $rel.Table $lc $rel.ForeignTable $c -}}
+			rel.{{$ftable.Column $c}} = o.{{$ltable.Column $lc}}
+				{{else -}}
+			queries.Assign(&rel.{{$ftable.Column $c}}, o.{{$ltable.Column $lc}})
+				{{end -}}
+				{{end -}}
+				{{else if $usesPrimitives -}}
 			rel.{{$fcol}} = o.{{$col}}
 				{{else -}}
 			queries.Assign(&rel.{{$fcol}}, o.{{$col}})
@@ -70,12 +98,22 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		}{{if not .ToJoinTable}} else {
+			{{if $rel.IsComposite -}}
+			cols := []string{ {{- range $i, $c := $rel.ForeignColumns}}{{if $i}}, {{end}}"{{$c}}"{{end -}} }
+			updateQuery := fmt.Sprintf(
+				"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
+				strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, cols),
+				strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}len(cols)+1{{else}}0{{end}}, {{$ftable.DownSingular}}PrimaryKeyColumns),
+			)
+			values := []interface{}{ {{- range $c := $rel.Columns}}o.{{$ltable.Column $c}}, {{end}}rel.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", rel."}}{{"}"}}
+			{{- else -}}
 			updateQuery := fmt.Sprintf(
 				"UPDATE {{$schemaForeignTable}} SET %s WHERE %s",
 				strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
 				strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$ftable.DownSingular}}PrimaryKeyColumns),
 			)
 			values := []interface{}{o.{{$col}}, rel.{{$foreignPKeyCols | stringMap (aliasCols $ftable) | join ", rel."}}{{"}"}}
+			{{- end}}
 
 			{{if $.NoContext -}}
 			if boil.DebugMode {
@@ -93,12 +131,21 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 			{{if $.NoContext -}}
 			if _, err = exec.Exec(updateQuery, values...); err != nil {
 			{{else -}}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			if _, err = boil.ExecContext(ctx, exec, updateQuery, values...); err != nil {
 			{{end -}}
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			{{if $usesPrimitives -}}
+			{{if $rel.IsComposite -}}
+			{{range $i, $c := $rel.ForeignColumns -}}
+			{{- $lc := index $rel.Columns $i -}}
+			{{if usesPrimitives $.Tables $rel.Table $lc $rel.ForeignTable $c -}}
+			rel.{{$ftable.Column $c}} = o.{{$ltable.Column $lc}}
+			{{else -}}
+			queries.Assign(&rel.{{$ftable.Column $c}}, o.{{$ltable.Column $lc}})
+			{{end -}}
+			{{end -}}
+			{{else if $usesPrimitives -}}
 			rel.{{$fcol}} = o.{{$col}}
 			{{else -}}
 			queries.Assign(&rel.{{$fcol}}, o.{{$col}})
@@ -127,7 +174,7 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 		{{if $.NoContext -}}
 		_, err = exec.Exec(query, values...)
 		{{else -}}
-		_, err = exec.ExecContext(ctx, query, values...)
+		_, err = boil.ExecContext(ctx, exec, query, values...)
 		{{end -}}
 		if err != nil {
 			return errors.Wrap(err, "failed to insert into join table")
@@ -221,10 +268,29 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}GP({{if not $.NoContext}}
 // Sets o.R.{{$relAlias.Foreign}}'s {{$relAlias.Local}} accordingly.
 // Replaces o.R.{{$relAlias.Local}} with related.
 // Sets related.R.{{$relAlias.Foreign}}'s {{$relAlias.Local}} accordingly.
+{{- if $rel.IsComposite}}
+// The previously related items are cleared in the same transaction, their
+// nullable key columns set to null.
+{{- end}}
 func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) error {
+	{{if $rel.IsComposite -}}
+	return boil.InTx{{if not $.NoContext}}Context{{end}}({{if not $.NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not $.NoContext}}Context{{end}}Executor) error {
+		return o.set{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, insert, related...)
+	})
+}
+
+func (o *{{$ltable.UpSingular}}) set{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) error {
+	{{end -}}
 	{{if .ToJoinTable -}}
 	query := "delete from {{.JoinTable | $.SchemaTable}} where {{.JoinLocalColumn | $.Quotes}} = {{if $.Dialect.UseIndexPlaceholders}}$1{{else}}?{{end}}"
 	values := []interface{}{{"{"}}o.{{$col}}}
+	{{else if $rel.IsComposite -}}
+	{{- $sep := "" -}}
+	query := fmt.Sprintf(
+		"update {{.ForeignTable | $.SchemaTable}} set {{range $c := $rel.ForeignColumns}}{{if ($fTableInfo.GetColumn $c).Nullable}}{{$sep}}{{$c | $.Quotes}} = null{{$sep = ", "}}{{end}}{{end}} where %s",
+		strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{ {{- range $i, $c := $rel.ForeignColumns}}{{if $i}}, {{end}}"{{$c}}"{{end -}} }),
+	)
+	values := []interface{}{ {{- range $i, $c := $rel.Columns}}{{if $i}}, {{end}}o.{{$ltable.Column $c}}{{end -}} }
 	{{else -}}
 	query := "update {{.ForeignTable | $.SchemaTable}} set {{.ForeignColumn | $.Quotes}} = null where {{.ForeignColumn | $.Quotes}} = {{if $.Dialect.UseIndexPlaceholders}}$1{{else}}?{{end}}"
 	values := []interface{}{{"{"}}o.{{$col}}}
@@ -245,7 +311,7 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 	{{if $.NoContext -}}
 	_, err := exec.Exec(query, values...)
 	{{else -}}
-	_, err := exec.ExecContext(ctx, query, values...)
+	_, err := boil.ExecContext(ctx, exec, query, values...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
@@ -259,7 +325,21 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 	{{else -}}
 	if o.R != nil {
 		for _, rel := range o.R.{{$relAlias.Local}} {
+			{{if $rel.IsComposite -}}
+			{{range $c := $rel.ForeignColumns -}}
+			{{if isPointerType ($fTableInfo.GetColumn $c).Type -}}
+			rel.{{$ftable.Column $c}} = nil
+			{{else if ($fTableInfo.GetColumn $c).Nullable -}}
+			queries.SetScanner(&rel.{{$ftable.Column $c}}, nil)
+			{{end -}}
+			{{end -}}
+			{{else -}}
+			{{if isPointerType ($fTableInfo.GetColumn $rel.ForeignColumn).Type -}}
+			rel.{{$fcol}} = nil
+			{{else -}}
 			queries.SetScanner(&rel.{{$fcol}}, nil)
+			{{end -}}
+			{{end -}}
 			if rel.R == nil {
 				continue
 			}
@@ -314,7 +394,18 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}GP({{if not $.NoContex
 // Remove{{$relAlias.Local}} relationships from objects passed in.
 // Removes related items from R.{{$relAlias.Local}} (uses pointer comparison, removal does not keep order)
 // Sets related.R.{{$relAlias.Foreign}}.
+{{- if $rel.IsComposite}}
+// The nullable key columns of every related item are cleared in one transaction.
+{{- end}}
 func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related ...*{{$ftable.UpSingular}}) error {
+	{{if $rel.IsComposite -}}
+	return boil.InTx{{if not $.NoContext}}Context{{end}}({{if not $.NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not $.NoContext}}Context{{end}}Executor) error {
+		return o.remove{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, related...)
+	})
+}
+
+func (o *{{$ltable.UpSingular}}) remove{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related ...*{{$ftable.UpSingular}}) error {
+	{{end -}}
 	var err error
 	{{if .ToJoinTable -}}
 	query := fmt.Sprintf(
@@ -342,20 +433,39 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 	{{if $.NoContext -}}
 	_, err = exec.Exec(query, values...)
 	{{else -}}
-	_, err = exec.ExecContext(ctx, query, values...)
+	_, err = boil.ExecContext(ctx, exec, query, values...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 	{{else -}}
 	for _, rel := range related {
+		{{if $rel.IsComposite -}}
+		{{range $c := $rel.ForeignColumns -}}
+		{{if isPointerType ($fTableInfo.GetColumn $c).Type -}}
+		rel.{{$ftable.Column $c}} = nil
+		{{else if ($fTableInfo.GetColumn $c).Nullable -}}
+		queries.SetScanner(&rel.{{$ftable.Column $c}}, nil)
+		{{end -}}
+		{{end -}}
+		{{else -}}
+		{{if isPointerType ($fTableInfo.GetColumn $rel.ForeignColumn).Type -}}
+		rel.{{$fcol}} = nil
+		{{else -}}
 		queries.SetScanner(&rel.{{$fcol}}, nil)
+		{{end -}}
+		{{end -}}
 		{{if not .ToJoinTable -}}
 		if rel.R != nil {
 			rel.R.{{$relAlias.Foreign}} = nil
 		}
 		{{end -}}
+		{{if $rel.IsComposite -}}
+		{{- $sep := "" -}}
+		if {{if not $.NoRowsAffected}}_, {{end -}} err = rel.Update({{if not $.NoContext}}ctx, {{end -}} exec, boil.Whitelist({{range $c := $rel.ForeignColumns}}{{if ($fTableInfo.GetColumn $c).Nullable}}{{$sep}}"{{$c}}"{{$sep = ", "}}{{end}}{{end}})); err != nil {
+		{{else -}}
 		if {{if not $.NoRowsAffected}}_, {{end -}} err = rel.Update({{if not $.NoContext}}ctx, {{end -}} exec, boil.Whitelist("{{.ForeignColumn}}")); err != nil {
+		{{end -}}
 			return err
 		}
 	}
@@ -412,5 +522,6 @@ func remove{{$relAlias.Local}}From{{$relAlias.Foreign}}Slice(o *{{$ltable.UpSing
 }
 				{{end -}}{{- /* if ToJoinTable */ -}}
 			{{- end -}}{{- /* if nullable foreign key */ -}}
+	{{- end -}}{{- /* if not composite join table or read only */ -}}
 	{{- end -}}{{- /* range relationships */ -}}
 {{- end -}}{{- /* if IsJoinTable */ -}}
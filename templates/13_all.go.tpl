@@ -4,9 +4,33 @@
 // {{$alias.UpPlural}} retrieves all the records using an executor.
 func {{$alias.UpPlural}}(mods ...qm.QueryMod) {{$alias.DownSingular}}Query {
     {{if and .AddSoftDeletes $canSoftDelete -}}
-    mods = append(mods, qm.From("{{$schemaTable}}"), qmhelper.WhereIsNull("{{$schemaTable}}.{{"deleted_at" | $.Quotes}}"))
+    mods = append(mods, qm.From("{{$schemaTable}}"), qmhelper.WhereIsNull("{{$schemaTable}}.{{.Table.SoftDeleteColumnName | $.Quotes}}"))
     {{else -}}
 	mods = append(mods, qm.From("{{$schemaTable}}"))
 	{{end -}}
 	return {{$alias.DownSingular}}Query{NewQuery(mods...)}
 }
+
+// {{$alias.UpSingular}}Page retrieves page pageNum, counting from 1, of pageSize {{.Table.Name}} rows
+// ordered by the order clauses, along with the number of rows matching mods on all pages.
+{{- if .Dialect.UseTopClause}}
+// At least one order clause is required.
+{{- end}}
+func {{$alias.UpSingular}}Page({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, pageNum, pageSize int, order []string, mods ...qm.QueryMod) ({{$alias.UpSingular}}Slice, int64, error) {
+	q := {{$alias.UpPlural}}(mods...)
+	if err := queries.SetPage(q.Query, pageNum, pageSize, order); err != nil {
+		return nil, 0, errors.Wrap(err, "{{.PkgName}}: unable to page {{.Table.Name}}")
+	}
+
+	total, err := {{$alias.UpPlural}}(mods...).Count({{if not .NoContext}}ctx, {{end -}} exec)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, err := q.All({{if not .NoContext}}ctx, {{end -}} exec)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return page, total, nil
+}
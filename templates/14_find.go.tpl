@@ -2,7 +2,10 @@
 {{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}
 {{- $pkNames := $colDefs.Names | stringMap (aliasCols $alias) | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}
 {{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
-{{- $canSoftDelete := .Table.CanSoftDelete }}
+{{- $canSoftDelete := .Table.CanSoftDelete -}}
+{{- $findWhere := whereClause .LQ .RQ 0 .Table.PKey.Columns -}}
+{{- if .Dialect.UseIndexPlaceholders}}{{$findWhere = whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{end -}}
+{{- if and .AddSoftDeletes $canSoftDelete}}{{$findWhere = printf "%s and %s is null" $findWhere (.Table.SoftDeleteColumnName | .Quotes)}}{{end}}
 {{if .AddGlobal -}}
 // Find{{$alias.UpSingular}}G retrieves a single record by ID.
 func Find{{$alias.UpSingular}}G({{if not .NoContext}}ctx context.Context, {{end -}} {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
@@ -37,18 +40,36 @@ func Find{{$alias.UpSingular}}GP({{if not .NoContext}}ctx context.Context, {{end
 
 {{end -}}
 
+{{if .Table.PKey.RowID -}}
+// {{$alias.DownSingular}}FindQuery is the query Find{{$alias.UpSingular}} runs when no columns
+// are selected, built once since the table is keyed by a single rowid column.
+var {{$alias.DownSingular}}FindQuery = "select * from {{.Table.Name | .SchemaTable}} where {{$findWhere}}"
+
+{{end -}}
 // Find{{$alias.UpSingular}} retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
 func Find{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Find")
+
+	{{end -}}
 	{{$alias.DownSingular}}Obj := &{{$alias.UpSingular}}{}
 
+	{{if .Table.PKey.RowID -}}
+	query := {{$alias.DownSingular}}FindQuery
+	if len(selectCols) > 0 {
+		sel := strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+		query = fmt.Sprintf("select %s from {{.Table.Name | .SchemaTable}} where {{$findWhere}}", sel)
+	}
+	{{- else -}}
 	sel := "*"
 	if len(selectCols) > 0 {
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from {{.Table.Name | .SchemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{"deleted_at" | $.Quotes}} is null{{end}}", sel,
+		"select %s from {{.Table.Name | .SchemaTable}} where {{$findWhere}}", sel,
 	)
+	{{- end}}
 
 	q := queries.Raw(query, {{$pkNames | join ", "}})
 
@@ -62,3 +83,62 @@ func Find{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx co
 
 	return {{$alias.DownSingular}}Obj, nil
 }
+
+{{if gt (len .Table.PKey.Columns) 1 -}}
+{{- $pkFields := $colDefs.Names | stringMap (aliasCols $alias) -}}
+// {{$alias.UpSingular}}PrimaryKey holds the primary key columns of a {{.Table.Name}} row,
+// for the ByPK variants of Find, Exists and Delete.
+type {{$alias.UpSingular}}PrimaryKey struct {
+	{{range $i, $f := $pkFields -}}
+	{{$f}} {{index $colDefs.Types $i}}
+	{{end -}}
+}
+
+// String returns the key as column=value pairs, ex: for logging.
+func (pk {{$alias.UpSingular}}PrimaryKey) String() string {
+	return fmt.Sprintf("{{range $i, $c := $colDefs.Names}}{{if $i}}, {{end}}{{$c}}=%v{{end}}", {{range $i, $f := $pkFields}}{{if $i}}, {{end}}pk.{{$f}}{{end}})
+}
+
+// Find{{$alias.UpSingular}}ByPK retrieves a single record by its primary key, see Find{{$alias.UpSingular}}.
+func Find{{$alias.UpSingular}}ByPK({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, pk {{$alias.UpSingular}}PrimaryKey, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	return Find{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} exec, {{range $pkFields}}pk.{{.}}, {{end -}} selectCols...)
+}
+
+{{end -}}
+
+{{range $cols := .Table.UniqueKeys -}}
+{{- $colDefs := sqlColDefinitions $.Table.Columns $cols -}}
+{{- $names := $colDefs.Names | stringMap (aliasCols $alias) | stringMap $.StringFuncs.camelCase | stringMap $.StringFuncs.replaceReserved -}}
+{{- $args := joinSlices " " $names $colDefs.Types | join ", " -}}
+{{- $funcName := $cols | stringMap (aliasCols $alias) | join "And" | printf "Find%sBy%s" $alias.UpSingular -}}
+// {{$funcName}} retrieves a single record by its unique {{$cols | join ", "}} with an executor.
+// If selectCols is empty it will return all columns.
+func {{$funcName}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$args}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	{{if not $.NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Find")
+
+	{{end -}}
+	{{$alias.DownSingular}}Obj := &{{$alias.UpSingular}}{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from {{$.Table.Name | $.SchemaTable}} where {{if $.Dialect.UseIndexPlaceholders}}{{whereClause $.LQ $.RQ 1 $cols}}{{else}}{{whereClause $.LQ $.RQ 0 $cols}}{{end}}{{if and $.AddSoftDeletes $canSoftDelete}} and {{$.Table.SoftDeleteColumnName | $.Quotes}} is null{{end}}", sel,
+	)
+
+	q := queries.Raw(query, {{$names | join ", "}})
+
+	err := q.Bind({{if not $.NoContext}}ctx{{else}}nil{{end}}, exec, {{$alias.DownSingular}}Obj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "{{$.PkgName}}: unable to select from {{$.Table.Name}}")
+	}
+
+	return {{$alias.DownSingular}}Obj, nil
+}
+
+{{end -}}
@@ -1,3 +1,4 @@
+{{- if not .Table.IsReadOnly -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -33,6 +34,10 @@ func (o *{{$alias.UpSingular}}) InsertGP({{if not .NoContext}}ctx context.Contex
 // Insert a single record using an executor.
 // See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
 func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Insert")
+
+	{{end -}}
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for insertion")
 	}
@@ -47,6 +52,10 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 	{{- end}}
 
 	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
+	{{- if .Table.Columns | filterColumnsByAutoIncrement true}}
+	// Identity columns are left to the database even when set
+	nzDefaults = strmangle.SetComplement(nzDefaults, {{$alias.DownSingular}}ColumnsWithAutoIncrement)
+	{{- end}}
 
 	key := makeCacheKey(columns, nzDefaults)
 	{{$alias.DownSingular}}InsertCacheMut.RLock()
@@ -85,7 +94,11 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 			{{if .Dialect.UseLastInsertID -}}
 			cache.retQuery = fmt.Sprintf("SELECT {{.LQ}}%s{{.RQ}} FROM {{$schemaTable}} WHERE %s", strings.Join(returnColumns, "{{.RQ}},{{.LQ}}"), strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns))
 			{{else -}}
-				{{if .Dialect.UseOutputClause -}}
+				{{if and .Dialect.UseOutputClause .Table.HasTriggers -}}
+			// OUTPUT without INTO is rejected on tables with triggers, select the
+			// returned columns in the same batch instead
+			queryReturning = fmt.Sprintf("; SELECT {{.LQ}}%s{{.RQ}} FROM {{$schemaTable}} WHERE %s", strings.Join(returnColumns, "{{.RQ}},{{.LQ}}"), {{if .Table.CanLastInsertID}}"{{.LQ}}{{index .Table.PKey.Columns 0}}{{.RQ}} = SCOPE_IDENTITY()"{{else}}strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", len(wl)+1, {{$alias.DownSingular}}PrimaryKeyColumns){{end}})
+				{{else if .Dialect.UseOutputClause -}}
 			queryOutput = fmt.Sprintf("OUTPUT INSERTED.{{.LQ}}%s{{.RQ}} ", strings.Join(returnColumns, "{{.RQ}},INSERTED.{{.LQ}}"))
 				{{else -}}
 			queryReturning = fmt.Sprintf(" RETURNING {{.LQ}}%s{{.RQ}}", strings.Join(returnColumns, "{{.RQ}},{{.LQ}}"))
@@ -98,6 +111,11 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 
 	value := reflect.Indirect(reflect.ValueOf(o))
 	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	{{- if and .Dialect.UseOutputClause .Table.HasTriggers (not .Table.CanLastInsertID)}}
+	if len(cache.retMapping) != 0 {
+		vals = append(vals, {{range .Table.PKey.Columns -}} o.{{$alias.Column .}}, {{end -}})
+	}
+	{{- end}}
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
@@ -118,13 +136,13 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 	result, err := exec.Exec(cache.query, vals...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
+	result, err := boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, vals...)
+	_, err = boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	{{- end}}
 	if err != nil {
@@ -177,7 +195,7 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 	{{if .NoContext -}}
 	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	{{else -}}
-	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	err = boil.QueryRowContext(ctx, exec, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
@@ -187,13 +205,13 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 		{{else -}}
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+		err = boil.QueryRowContext(ctx, exec, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 		{{end -}}
 	} else {
 		{{if .NoContext -}}
 		_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
+		_, err = boil.ExecContext(ctx, exec, cache.query, vals...)
 		{{end -}}
 	}
 
@@ -217,3 +235,54 @@ CacheNoHooks:
 	return nil
 	{{- end}}
 }
+
+{{if .AddGlobal -}}
+// InsertAllG inserts all rows in the slice, see InsertAll.
+func (o {{$alias.UpSingular}}Slice) InsertAllG({{if not .NoContext}}ctx context.Context, {{end -}} columns boil.Columns, opts ...boil.InsertAllOptions) (int, error) {
+	return o.InsertAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, columns, opts...)
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// InsertAllP inserts all rows in the slice, and panics on error. See InsertAll.
+func (o {{$alias.UpSingular}}Slice) InsertAllP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns, opts ...boil.InsertAllOptions) int {
+	n, err := o.InsertAll({{if not .NoContext}}ctx, {{end -}} exec, columns, opts...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return n
+}
+
+{{end -}}
+
+// InsertAll inserts all rows in the slice with Insert, InsertAllBatchSize rows
+// (or the BatchSize of opts) per transaction, reusing one prepared statement
+// per query within a transaction. On error it returns the number of rows
+// already committed, see boil.InsertBatches.
+func (o {{$alias.UpSingular}}Slice) InsertAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns, opts ...boil.InsertAllOptions) (int, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "InsertAll")
+
+	{{end -}}
+	var opt boil.InsertAllOptions
+	if len(opts) != 0 {
+		opt = opts[0]
+	}
+	size := opt.BatchSize
+	if size == 0 {
+		size = InsertAllBatchSize
+	}
+
+	{{if .NoContext -}}
+	return boil.InsertBatches(exec, len(o), size, opt.SingleTransaction, func(exec boil.Executor, i int) error {
+		return o[i].Insert(exec, columns)
+	})
+	{{- else -}}
+	return boil.InsertBatchesContext(ctx, exec, len(o), size, opt.SingleTransaction, func(exec boil.ContextExecutor, i int) error {
+		return o[i].Insert(ctx, exec, columns)
+	})
+	{{- end}}
+}
+{{end -}}
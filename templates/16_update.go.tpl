@@ -1,3 +1,4 @@
+{{- if not .Table.IsReadOnly -}}
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -45,6 +46,10 @@ func (o *{{$alias.UpSingular}}) UpdateGP({{if not .NoContext}}ctx context.Contex
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
 func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Update")
+
+	{{end -}}
 	{{- template "timestamp_update_helper" . -}}
 
 	var err error
@@ -105,14 +110,14 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, values...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, values...)
+	_, err = boil.ExecContext(ctx, exec, cache.query, values...)
 		{{end -}}
 	{{else -}}
 	var result sql.Result
 		{{if .NoContext -}}
 	result, err = exec.Exec(cache.query, values...)
 		{{else -}}
-	result, err = exec.ExecContext(ctx, cache.query, values...)
+	result, err = boil.ExecContext(ctx, exec, cache.query, values...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
@@ -140,6 +145,25 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 	{{- end}}
 }
 
+// UpdateColumns uses an executor to update only the named columns of the {{$alias.UpSingular}},
+// leaving the others as they are in the database. Primary key and auto generated
+// columns are never written, even when named.
+// See Update for more documentation.
+func (o *{{$alias.UpSingular}}) UpdateColumns({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols ...string) {{if .NoRowsAffected}}error{{else}}(int64, error){{end}} {
+	// Update leaves out the auto generated columns of the whitelist
+	wl := strmangle.SetComplement(cols, {{$alias.DownSingular}}PrimaryKeyColumns)
+	{{- if and (not .NoAutoTimestamps) (containsAny (.Table.Columns | columnNames) "updated_at")}}
+	if {{if not .NoContext}}!boil.TimestampsAreSkipped(ctx) && {{end}}!strmangle.SetInclude("updated_at", wl) {
+		wl = append(wl, "updated_at")
+	}
+	{{- end}}
+	if len(wl) == 0 {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, no columns to update")
+	}
+
+	return o.Update({{if not .NoContext}}ctx, {{end -}} exec, boil.Whitelist(wl...))
+}
+
 {{if .AddPanic -}}
 // UpdateAllP updates all rows with matching column names, and panics on error.
 func (q {{$alias.DownSingular}}Query) UpdateAllP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if not .NoRowsAffected}}int64{{end -}} {
@@ -167,6 +191,10 @@ func (q {{$alias.DownSingular}}Query) UpdateAllG({{if not .NoContext}}ctx contex
 
 // UpdateAll updates all rows with the specified column values.
 func (q {{$alias.DownSingular}}Query) UpdateAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "UpdateAll")
+
+	{{end -}}
 	queries.SetUpdate(q.Query, cols)
 
 	{{if .NoRowsAffected -}}
@@ -237,6 +265,10 @@ func (o {{$alias.UpSingular}}Slice) UpdateAllP({{if .NoContext}}exec boil.Execut
 
 // UpdateAll updates all rows with the specified column values, using an executor.
 func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "UpdateAll")
+
+	{{end -}}
 	ln := int64(len(o))
 	if ln == 0 {
 		return {{if not .NoRowsAffected}}0, {{end -}} nil
@@ -246,15 +278,7 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: update all requires at least one column argument")
 	}
 
-	colNames := make([]string, len(cols))
-	args := make([]interface{}, len(cols))
-
-	i := 0
-	for name, value := range cols {
-		colNames[i] = name
-		args[i] = value
-		i++
-	}
+	colNames, args := queries.UpdateColumnsSorted(cols)
 
 	// Append all of the primary key values for each column
 	for _, obj := range o {
@@ -283,13 +307,13 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 		{{if .NoContext -}}
 	_, err := exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	result, err := exec.Exec(sql, args...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	result, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
@@ -305,3 +329,4 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
+{{end -}}
@@ -1,3 +1,4 @@
+{{- if not .Table.IsReadOnly -}}
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $schemaTable := .Table.Name | .SchemaTable -}}
 {{- $canSoftDelete := .Table.CanSoftDelete -}}
@@ -47,7 +48,17 @@ func (o *{{$alias.UpSingular}}) DeleteGP({{if not .NoContext}}ctx context.Contex
 
 // Delete deletes a single {{$alias.UpSingular}} record with an executor.
 // Delete will match against the primary key column to find the record to delete.
+{{- range $rel := .Table.ToOneRelationships}}{{if $rel.OnDeleteCascade}}
+// The database also deletes the related {{$rel.ForeignTable}} record (ON DELETE CASCADE).
+{{- end}}{{end}}
+{{- range $rel := .Table.ToManyRelationships}}{{if $rel.OnDeleteCascade}}
+// The database also deletes the related {{if $rel.ToJoinTable}}{{$rel.JoinTable}}{{else}}{{$rel.ForeignTable}}{{end}} records (ON DELETE CASCADE).
+{{- end}}{{end}}
 func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Delete")
+
+	{{end -}}
 	if o == nil {
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.UpSingular}} provided for delete")
 	}
@@ -68,8 +79,14 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 		sql = "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
-		o.DeletedAt = null.TimeFrom(currTime)
-		wl := []string{"deleted_at"}
+		{{if isPointerType (.Table.GetColumn .Table.SoftDeleteColumnName).Type -}}
+		o.{{$alias.Column .Table.SoftDeleteColumnName}} = &currTime
+		{{else if eq (.Table.GetColumn .Table.SoftDeleteColumnName).Type "sql.NullTime" -}}
+		queries.SetScanner(&o.{{$alias.Column .Table.SoftDeleteColumnName}}, currTime)
+		{{else -}}
+		o.{{$alias.Column .Table.SoftDeleteColumnName}} = null.TimeFrom(currTime)
+		{{end -}}
+		wl := []string{"{{.Table.SoftDeleteColumnName}}"}
 		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 2 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}",
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
 		)
@@ -101,13 +118,13 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 	_, err := exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	result, err := exec.Exec(sql, args...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	result, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
@@ -132,7 +149,7 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 }
 
 {{if .AddGlobal -}}
-func (q {{$alias.DownSingular}}Query) DeleteAllG({{if not .NoContext}}ctx context.Context{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+func (q {{$alias.DownSingular}}Query) DeleteAllG({{if not .NoContext}}ctx context.Context{{if $soft}}, hardDelete bool{{end}}{{else}}{{if $soft}}hardDelete bool{{end}}{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
 	return q.DeleteAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}{{if $soft}}, hardDelete{{end}})
 }
 
@@ -155,6 +172,10 @@ func (q {{$alias.DownSingular}}Query) DeleteAllP({{if .NoContext}}exec boil.Exec
 
 // DeleteAll deletes all matching rows.
 func (q {{$alias.DownSingular}}Query) DeleteAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "DeleteAll")
+
+	{{end -}}
 	if q.Query == nil {
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.DownSingular}}Query provided for delete all")
 	}
@@ -164,7 +185,7 @@ func (q {{$alias.DownSingular}}Query) DeleteAll({{if .NoContext}}exec boil.Execu
 		queries.SetDelete(q.Query)
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
-		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+		queries.SetUpdate(q.Query, M{"{{.Table.SoftDeleteColumnName}}": currTime})
 	}
 	{{else -}}
 	queries.SetDelete(q.Query)
@@ -198,6 +219,65 @@ func (q {{$alias.DownSingular}}Query) DeleteAll({{if .NoContext}}exec boil.Execu
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
 
+{{if .Dialect.UseOutputClause -}}
+// DeleteAllReturning deletes all matching rows like DeleteAll, and returns the
+// deleted rows with only their primary key columns set, along with their count.
+// The keys come from an OUTPUT clause, which is more dependable than the
+// driver's rows affected.
+{{- if or (not .Table.PKey) .Table.HasTriggers}}
+// An OUTPUT clause can't be used on {{.Table.Name}}, so no rows are returned
+// and the count comes from rows affected.
+{{- end}}
+func (q {{$alias.DownSingular}}Query) DeleteAllReturning({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) ({{$alias.UpSingular}}Slice, int64, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "DeleteAll")
+
+	{{end -}}
+	if q.Query == nil {
+		return nil, 0, errors.New("{{.PkgName}}: no {{$alias.DownSingular}}Query provided for delete all")
+	}
+
+	{{if $soft -}}
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"{{.Table.SoftDeleteColumnName}}": currTime})
+	}
+	{{else -}}
+	queries.SetDelete(q.Query)
+	{{- end}}
+
+	{{if and .Table.PKey (not .Table.HasTriggers) -}}
+	queries.SetOutput(q.Query, {{$alias.DownSingular}}PrimaryKeyColumns...)
+
+	var o {{$alias.UpSingular}}Slice
+	if err := q.Query.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o); err != nil {
+		return nil, 0, errors.Wrap(err, "{{.PkgName}}: unable to delete all from {{.Table.Name}}")
+	}
+
+	return o, int64(len(o)), nil
+	{{- else -}}
+	{{if .NoContext -}}
+	result, err := q.Query.Exec(exec)
+	{{else -}}
+	result, err := q.Query.ExecContext(ctx, exec)
+	{{end -}}
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "{{.PkgName}}: unable to delete all from {{.Table.Name}}")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by deleteall for {{.Table.Name}}")
+	}
+
+	return nil, rowsAff, nil
+	{{- end}}
+}
+
+{{end -}}
+
 {{if .AddGlobal -}}
 // DeleteAllG deletes all rows in the slice.
 func (o {{$alias.UpSingular}}Slice) DeleteAllG({{if not .NoContext}}ctx context.Context{{if $soft}}, hardDelete bool{{end}}{{else}}{{if $soft}}hardDelete bool{{end}}{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
@@ -238,6 +318,10 @@ func (o {{$alias.UpSingular}}Slice) DeleteAllGP({{if not .NoContext}}ctx context
 
 // DeleteAll deletes all rows in the slice, using an executor.
 func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "DeleteAll")
+
+	{{end -}}
 	if len(o) == 0 {
 		return {{if not .NoRowsAffected}}0, {{end -}} nil
 	}
@@ -269,9 +353,16 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 		for _, obj := range o {
 			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$alias.DownSingular}}PrimaryKeyMapping)
 			args = append(args, pkeyArgs...)
-			obj.DeletedAt = null.TimeFrom(currTime)
+			{{if isPointerType (.Table.GetColumn .Table.SoftDeleteColumnName).Type -}}
+			deletedAt := currTime
+			obj.{{$alias.Column .Table.SoftDeleteColumnName}} = &deletedAt
+			{{else if eq (.Table.GetColumn .Table.SoftDeleteColumnName).Type "sql.NullTime" -}}
+			queries.SetScanner(&obj.{{$alias.Column .Table.SoftDeleteColumnName}}, currTime)
+			{{else -}}
+			obj.{{$alias.Column .Table.SoftDeleteColumnName}} = null.TimeFrom(currTime)
+			{{end -}}
 		}
-		wl := []string{"deleted_at"}
+		wl := []string{"{{.Table.SoftDeleteColumnName}}"}
 		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE " +
 			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)),
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
@@ -306,13 +397,13 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 		{{if .NoContext -}}
 	_, err := exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	result, err := exec.Exec(sql, args...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	result, err := boil.ExecContext(ctx, exec, sql, args...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
@@ -339,3 +430,66 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
+
+{{$colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}
+{{- $composite := gt (len .Table.PKey.Columns) 1 -}}
+// {{$alias.UpSingular}}DeleteAllByPK deletes the {{.Table.Name}} rows with the given primary keys,
+// in as few statements as the database's parameter limit allows.
+{{- if $composite}}
+// Each key holds the values of {{$alias.DownSingular}}PrimaryKeyColumns in order.
+{{- end}}
+func {{$alias.UpSingular}}DeleteAllByPK({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}, pks ...{{if $composite}}[]interface{}{{else}}{{index $colDefs.Types 0}}{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "DeleteAll")
+
+	{{end -}}
+	{{if $soft -}}
+	// A soft delete binds the deletion time besides the keys
+	{{end -}}
+	chunkSize := queries.KeyChunkSize(dialect.KeyParams(), {{if $soft}}1{{else}}0{{end}}, {{if $composite}}len({{$alias.DownSingular}}PrimaryKeyColumns){{else}}1{{end}})
+	if chunkSize <= 0 {
+		chunkSize = len(pks)
+	}
+
+	{{if not .NoRowsAffected -}}
+	var rowsAff int64
+	{{end -}}
+	for start := 0; start < len(pks); start += chunkSize {
+		end := start + chunkSize
+		if end > len(pks) {
+			end = len(pks)
+		}
+
+		{{if $composite -}}
+		mod := qmhelper.WhereInTuples([]string{ {{- range $i, $c := .Table.PKey.Columns}}{{if $i}}, {{end}}"{{$schemaTable}}.{{$c | $.Quotes}}"{{end -}} }, pks[start:end])
+		{{- else -}}
+		args := make([]interface{}, end-start)
+		for i, pk := range pks[start:end] {
+			args[i] = pk
+		}
+		mod := qm.WhereIn("{{$schemaTable}}.{{index .Table.PKey.Columns 0 | $.Quotes}} in ?", args...)
+		{{- end}}
+
+		{{if .NoRowsAffected -}}
+		if err := {{$alias.UpPlural}}(mod).DeleteAll({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}}); err != nil {
+			return err
+		}
+		{{- else -}}
+		n, err := {{$alias.UpPlural}}(mod).DeleteAll({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}})
+		if err != nil {
+			return 0, err
+		}
+		rowsAff += n
+		{{- end}}
+	}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+}
+{{- if $composite}}
+
+// {{$alias.UpSingular}}DeleteByPK deletes the {{.Table.Name}} row with the primary key.
+func {{$alias.UpSingular}}DeleteByPK({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}, pk {{$alias.UpSingular}}PrimaryKey) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return {{$alias.UpSingular}}DeleteAllByPK({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, hardDelete{{end}}, []interface{}{ {{- range $i, $f := $colDefs.Names | stringMap (aliasCols $alias)}}{{if $i}}, {{end}}pk.{{$f}}{{end -}} })
+}
+{{- end}}
+{{end -}}
@@ -35,9 +35,18 @@ func (o *{{$alias.UpSingular}}) ReloadGP({{if not .NoContext}}ctx context.Contex
 
 // Reload refetches the object from the database
 // using the primary keys with an executor.
+// Returns ErrReloadNotFound, which wraps sql.ErrNoRows, if the row no longer
+// exists.
 func (o *{{$alias.UpSingular}}) Reload({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Reload")
+
+	{{end -}}
 	ret, err := Find{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} exec, {{.Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}})
 	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return ErrReloadNotFound
+		}
 		return err
 	}
 
@@ -85,6 +94,10 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAllGP({{if not .NoContext}}ctx contex
 // ReloadAll refetches every row with matching primary key column values
 // and overwrites the original object slice with the newly updated slice.
 func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Reload")
+
+	{{end -}}
 	if o == nil || len(*o) == 0 {
 		return nil
 	}
@@ -98,7 +111,7 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Execut
 
 	sql := "SELECT {{$schemaTable}}.* FROM {{$schemaTable}} WHERE " +
 		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(*o)){{if and .AddSoftDeletes $canSoftDelete}} +
-		"and {{"deleted_at" | $.Quotes}} is null"
+		"and {{.Table.SoftDeleteColumnName | $.Quotes}} is null"
 		{{- end}}
 
 	q := queries.Raw(sql, args...)
@@ -40,11 +40,15 @@ func {{$alias.UpSingular}}ExistsGP({{if not .NoContext}}ctx context.Context, {{e
 
 // {{$alias.UpSingular}}Exists checks if the {{$alias.UpSingular}} row exists.
 func {{$alias.UpSingular}}Exists({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$pkArgs}}) (bool, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "Exists")
+
+	{{end -}}
 	var exists bool
 	{{if .Dialect.UseCaseWhenExistsClause -}}
 	sql := "select case when exists(select top(1) 1 from {{$schemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}) then 1 else 0 end"
 	{{- else -}}
-	sql := "select exists(select 1 from {{$schemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{"deleted_at" | $.Quotes}} is null{{end}} limit 1)"
+	sql := "select exists(select 1 from {{$schemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{.Table.SoftDeleteColumnName | $.Quotes}} is null{{end}} limit 1)"
 	{{- end}}
 
 	{{if .NoContext -}}
@@ -63,7 +67,7 @@ func {{$alias.UpSingular}}Exists({{if .NoContext}}exec boil.Executor{{else}}ctx
 	{{if .NoContext -}}
 	row := exec.QueryRow(sql, {{$pkNames | join ", "}})
 	{{else -}}
-	row := exec.QueryRowContext(ctx, sql, {{$pkNames | join ", "}})
+	row := boil.QueryRowContext(ctx, exec, sql, {{$pkNames | join ", "}})
 	{{- end}}
 
 	err := row.Scan(&exists)
@@ -73,3 +77,10 @@ func {{$alias.UpSingular}}Exists({{if .NoContext}}exec boil.Executor{{else}}ctx
 
 	return exists, nil
 }
+{{- if gt (len .Table.PKey.Columns) 1}}
+
+// {{$alias.UpSingular}}ExistsByPK checks if the {{$alias.UpSingular}} row with the primary key exists.
+func {{$alias.UpSingular}}ExistsByPK({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, pk {{$alias.UpSingular}}PrimaryKey) (bool, error) {
+	return {{$alias.UpSingular}}Exists({{if not .NoContext}}ctx, {{end -}} exec{{range $colDefs.Names | stringMap (aliasCols $alias)}}, pk.{{.}}{{end}})
+}
+{{- end}}
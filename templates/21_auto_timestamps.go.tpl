@@ -13,6 +13,11 @@
 				{{- if eq $col.Type "time.Time" }}
 		if o.{{$colAlias}}.IsZero() {
 			o.{{$colAlias}} = currTime
+		}
+				{{- else if eq $col.Type "*time.Time"}}
+		if o.{{$colAlias}} == nil || o.{{$colAlias}}.IsZero() {
+			createdAt := currTime
+			o.{{$colAlias}} = &createdAt
 		}
 				{{- else}}
 		if queries.MustTime(o.{{$colAlias}}).IsZero() {
@@ -24,6 +29,11 @@
 				{{- if eq $col.Type "time.Time"}}
 		if o.{{$colAlias}}.IsZero() {
 			o.{{$colAlias}} = currTime
+		}
+				{{- else if eq $col.Type "*time.Time"}}
+		if o.{{$colAlias}} == nil || o.{{$colAlias}}.IsZero() {
+			updatedAt := currTime
+			o.{{$colAlias}} = &updatedAt
 		}
 				{{- else}}
 		if queries.MustTime(o.{{$colAlias}}).IsZero() {
@@ -52,6 +62,9 @@
 			{{- if eq $col.Name "updated_at" -}}
 				{{- if eq $col.Type "time.Time"}}
 		o.{{$colAlias}} = currTime
+				{{- else if eq $col.Type "*time.Time"}}
+		updatedAt := currTime
+		o.{{$colAlias}} = &updatedAt
 				{{- else}}
 		queries.SetScanner(&o.{{$colAlias}}, currTime)
 				{{- end -}}
@@ -78,6 +91,11 @@
 				{{- if eq $col.Type "time.Time"}}
 	if o.{{$colAlias}}.IsZero() {
 		o.{{$colAlias}} = currTime
+	}
+				{{- else if eq $col.Type "*time.Time"}}
+	if o.{{$colAlias}} == nil || o.{{$colAlias}}.IsZero() {
+		createdAt := currTime
+		o.{{$colAlias}} = &createdAt
 	}
 				{{- else}}
 	if queries.MustTime(o.{{$colAlias}}).IsZero() {
@@ -88,6 +106,9 @@
 			{{- if eq $col.Name "updated_at" -}}
 				{{- if eq $col.Type "time.Time"}}
 	o.{{$colAlias}} = currTime
+				{{- else if eq $col.Type "*time.Time"}}
+	updatedAt := currTime
+	o.{{$colAlias}} = &updatedAt
 				{{- else}}
 	queries.SetScanner(&o.{{$colAlias}}, currTime)
 				{{- end -}}
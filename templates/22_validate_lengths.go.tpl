@@ -0,0 +1,84 @@
+{{- $alias := .Aliases.Table .Table.Name}}
+// ValidateLengths checks that string and binary values fit within the
+// lengths declared by their column types, ex: at most 16 bytes for varbinary(16),
+// and decimals within their precision and scale, ex: 12345678.99 for decimal(10,2).
+// Unbounded columns like varbinary(max) are not checked.
+func (o *{{$alias.UpSingular}}) ValidateLengths() error {
+	if errs := o.lengthErrors(); len(errs) != 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// lengthErrors lists the values that don't fit their column types
+func (o *{{$alias.UpSingular}}) lengthErrors() []error {
+	var errs []error
+	{{- range $col := .Table.Columns}}
+	{{- $max := $col.MaxLength}}
+	{{- if gt $max 0}}
+	{{- $colAlias := $alias.Column $col.Name}}
+	{{- if eq $col.Type "string"}}
+	if len([]rune(o.{{$colAlias}})) > {{$max}} {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is longer than {{$max}} characters"))
+	}
+	{{- else if or (eq $col.Type "null.String") (eq $col.Type "sql.NullString")}}
+	if o.{{$colAlias}}.Valid && len([]rune(o.{{$colAlias}}.String)) > {{$max}} {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is longer than {{$max}} characters"))
+	}
+	{{- else if eq $col.Type "*string"}}
+	if o.{{$colAlias}} != nil && len([]rune(*o.{{$colAlias}})) > {{$max}} {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is longer than {{$max}} characters"))
+	}
+	{{- else if eq $col.Type "[]byte"}}
+	if len(o.{{$colAlias}}) > {{$max}} {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is longer than {{$max}} bytes"))
+	}
+	{{- else if eq $col.Type "null.Bytes"}}
+	if o.{{$colAlias}}.Valid && len(o.{{$colAlias}}.Bytes) > {{$max}} {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is longer than {{$max}} bytes"))
+	}
+	{{- else if eq $col.Type "*[]byte"}}
+	if o.{{$colAlias}} != nil && len(*o.{{$colAlias}}) > {{$max}} {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is longer than {{$max}} bytes"))
+	}
+	{{- end}}
+	{{- else if and $col.IsDecimal (gt $col.Precision 0) (or (eq $col.Type "types.Decimal") (eq $col.Type "types.NullDecimal"))}}
+	if !o.{{$alias.Column $col.Name}}.Fits({{$col.Precision}}, {{$col.Scale}}) {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} does not fit in decimal({{$col.Precision}},{{$col.Scale}})"))
+	}
+	{{- end}}
+	{{- end}}
+
+	return errs
+}
+{{- if .GenerateValidate}}
+
+// Validate checks the {{$alias.UpSingular}} can be inserted: its required columns,
+// NOT NULL without a default, are set and its values fit their column types.
+// Numbers and bools can't be told apart from unset and aren't checked.
+// The error is a boil.ValidationErrors listing every problem found.
+func (o *{{$alias.UpSingular}}) Validate() error {
+	var errs boil.ValidationErrors
+	{{- range $col := filterColumnsByRequired .Table.Columns}}
+	{{- $colAlias := $alias.Column $col.Name}}
+	{{- $unset := ""}}
+	{{- if or (eq $col.Type "string") (eq $col.Type "[]byte") (eq $col.Type "types.JSON")}}{{$unset = printf "len(o.%s) == 0" $colAlias}}
+	{{- else if eq $col.Type "time.Time"}}{{$unset = printf "o.%s.IsZero()" $colAlias}}
+	{{- else if eq $col.Type "types.Decimal"}}{{$unset = printf "o.%s.Big == nil" $colAlias}}
+	{{- end}}
+	{{- if $unset}}
+	if {{$unset}} {
+		errs = append(errs, errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is required"))
+	}
+	{{- end}}
+	{{- end}}
+	errs = append(errs, o.lengthErrors()...)
+
+	if len(errs) != 0 {
+		return errs
+	}
+
+	return nil
+}
+{{- end}}
@@ -0,0 +1,10 @@
+{{- if .GenerateIndexMetadata -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+// {{$alias.UpSingular}}Indexes describes the indexes on {{.Table.Name}}, ex: to
+// check that a query filters on an indexed column.
+var {{$alias.UpSingular}}Indexes = []Index{
+	{{- range $index := .Table.Indexes}}
+	{Name: {{printf "%q" $index.Name}}, Columns: []string{ {{- $index.Columns | stringMap $.StringFuncs.quoteWrap | join ", " -}} }, Unique: {{$index.Unique}}{{if $index.Filter}}, Filter: {{printf "%q" $index.Filter}}{{end}}},
+	{{- end}}
+}
+{{- end}}
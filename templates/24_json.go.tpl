@@ -0,0 +1,31 @@
+{{- if .JSONMethods -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $orig_tbl_name := .Table.Name}}
+{{- $omitNull := eq .JSONNullPolicy "omit"}}
+// MarshalJSON implements json.Marshaler, writing the columns in table order
+// with null columns {{if $omitNull}}left out{{else}}as null{{end}}.
+func (o {{$alias.UpSingular}}) MarshalJSON() ([]byte, error) {
+	return boil.MarshalJSONObject([]boil.JSONField{
+		{{- range $column := .Table.Columns}}
+		{{- $colAlias := $alias.Column $column.Name}}
+		{{- if not (ignore $orig_tbl_name $column.Name $.TagIgnore)}}
+		{{- $name := tagCase ($.TagCasing "json") $column.Name $colAlias}}
+		{Name: {{printf "%q" $name}}, Value: o.{{$colAlias}}},
+		{{- end}}
+		{{- end}}
+	}, {{$omitNull}})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, columns missing from data
+// are left at their zero value.
+func (o *{{$alias.UpSingular}}) UnmarshalJSON(data []byte) error {
+	type {{$alias.DownSingular}}JSON {{$alias.UpSingular}}
+	var v {{$alias.DownSingular}}JSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*o = {{$alias.UpSingular}}(v)
+	return nil
+}
+{{- end}}
@@ -0,0 +1,73 @@
+{{- if .GenerateInterfaces -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}
+{{- $pkNames := $colDefs.Names | stringMap (aliasCols $alias) | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}
+{{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
+{{- $soft := and .AddSoftDeletes .Table.CanSoftDelete -}}
+{{- $execParams := "ctx context.Context, exec boil.ContextExecutor" -}}
+{{- $execArgs := "ctx, exec" -}}
+{{- if .NoContext -}}
+{{- $execParams = "exec boil.Executor" -}}
+{{- $execArgs = "exec" -}}
+{{- end -}}
+{{- $rowsAff := "(int64, error)" -}}
+{{- if .NoRowsAffected -}}
+{{- $rowsAff = "error" -}}
+{{- end}}
+// {{$alias.UpSingular}}Repository is the set of CRUD functions generated for
+// {{$alias.UpSingular}}, so code using them can swap in a mock.
+type {{$alias.UpSingular}}Repository interface {
+	Find({{$execParams}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error)
+	Exists({{$execParams}}, {{$pkArgs}}) (bool, error)
+	All({{$execParams}}, mods ...qm.QueryMod) ({{$alias.UpSingular}}Slice, error)
+	Count({{$execParams}}, mods ...qm.QueryMod) (int64, error)
+	Reload({{$execParams}}, o *{{$alias.UpSingular}}) error
+	{{- if not .Table.IsReadOnly}}
+	Insert({{$execParams}}, o *{{$alias.UpSingular}}, columns boil.Columns) error
+	Update({{$execParams}}, o *{{$alias.UpSingular}}, columns boil.Columns) {{$rowsAff}}
+	Delete({{$execParams}}, o *{{$alias.UpSingular}}{{if $soft}}, hardDelete bool{{end}}) {{$rowsAff}}
+	{{- end}}
+}
+
+// New{{$alias.UpSingular}}Repository returns the {{$alias.UpSingular}}Repository
+// backed by the generated functions.
+func New{{$alias.UpSingular}}Repository() {{$alias.UpSingular}}Repository {
+	return {{$alias.DownSingular}}Repository{}
+}
+
+type {{$alias.DownSingular}}Repository struct{}
+
+func ({{$alias.DownSingular}}Repository) Find({{$execParams}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	return Find{{$alias.UpSingular}}({{$execArgs}}, {{$pkNames | join ", "}}, selectCols...)
+}
+
+func ({{$alias.DownSingular}}Repository) Exists({{$execParams}}, {{$pkArgs}}) (bool, error) {
+	return {{$alias.UpSingular}}Exists({{$execArgs}}, {{$pkNames | join ", "}})
+}
+
+func ({{$alias.DownSingular}}Repository) All({{$execParams}}, mods ...qm.QueryMod) ({{$alias.UpSingular}}Slice, error) {
+	return {{$alias.UpPlural}}(mods...).All({{$execArgs}})
+}
+
+func ({{$alias.DownSingular}}Repository) Count({{$execParams}}, mods ...qm.QueryMod) (int64, error) {
+	return {{$alias.UpPlural}}(mods...).Count({{$execArgs}})
+}
+
+func ({{$alias.DownSingular}}Repository) Reload({{$execParams}}, o *{{$alias.UpSingular}}) error {
+	return o.Reload({{$execArgs}})
+}
+{{- if not .Table.IsReadOnly}}
+
+func ({{$alias.DownSingular}}Repository) Insert({{$execParams}}, o *{{$alias.UpSingular}}, columns boil.Columns) error {
+	return o.Insert({{$execArgs}}, columns)
+}
+
+func ({{$alias.DownSingular}}Repository) Update({{$execParams}}, o *{{$alias.UpSingular}}, columns boil.Columns) {{$rowsAff}} {
+	return o.Update({{$execArgs}}, columns)
+}
+
+func ({{$alias.DownSingular}}Repository) Delete({{$execParams}}, o *{{$alias.UpSingular}}{{if $soft}}, hardDelete bool{{end}}) {{$rowsAff}} {
+	return o.Delete({{$execArgs}}{{if $soft}}, hardDelete{{end}})
+}
+{{- end}}
+{{end -}}
@@ -0,0 +1,45 @@
+{{- if .GenerateDTOs -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $orig_tbl_name := .Table.Name}}
+{{- $pointers := ne .DTONullStyle "null"}}
+// {{$alias.UpSingular}}DTO is a plain copy of the columns of a {{$alias.UpSingular}}
+// for API boundaries{{if $pointers}}, with null columns as nil pointers{{end}}.
+type {{$alias.UpSingular}}DTO struct {
+	{{- range $column := .Table.Columns}}
+	{{- $colAlias := $alias.Column $column.Name}}
+	{{- $ptr := nullPointerType $column.Type}}
+	{{- $name := tagCase ($.TagCasing "json") $column.Name $colAlias}}
+	{{- if ignore $orig_tbl_name $column.Name $.TagIgnore}}{{$name = "-"}}
+	{{- else if $column.Nullable}}{{$name = printf "%s,omitempty" $name}}
+	{{- end}}
+	{{$colAlias}} {{if and $pointers $ptr}}{{$ptr}}{{else}}{{$column.Type}}{{end}} `json:"{{$name}}"`
+	{{- end}}
+}
+
+// ToDTO copies the columns of the {{$alias.UpSingular}} into a {{$alias.UpSingular}}DTO.
+func (o *{{$alias.UpSingular}}) ToDTO() {{$alias.UpSingular}}DTO {
+	return {{$alias.UpSingular}}DTO{
+		{{- range $column := .Table.Columns}}
+		{{- $colAlias := $alias.Column $column.Name}}
+		{{- if and $pointers (nullPointerType $column.Type)}}
+		{{$colAlias}}: o.{{$colAlias}}.Ptr(),
+		{{- else}}
+		{{$colAlias}}: o.{{$colAlias}},
+		{{- end}}
+		{{- end}}
+	}
+}
+
+// FromDTO sets the columns of the {{$alias.UpSingular}} from the DTO, leaving
+// its loaded relationships as they are.
+func (o *{{$alias.UpSingular}}) FromDTO(d {{$alias.UpSingular}}DTO) {
+	{{- range $column := .Table.Columns}}
+	{{- $colAlias := $alias.Column $column.Name}}
+	{{- if and $pointers (nullPointerType $column.Type)}}
+	o.{{$colAlias}} = {{$column.Type}}FromPtr(d.{{$colAlias}})
+	{{- else}}
+	o.{{$colAlias}} = d.{{$colAlias}}
+	{{- end}}
+	{{- end}}
+}
+{{- end}}
@@ -0,0 +1,38 @@
+{{- if and .GenerateChangesets (not .Table.IsReadOnly) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+// UpdateWithChangeset updates the columns of the {{$alias.UpSingular}} that differ from
+// its row in the database and returns them with their old and new values,
+// ex: for an audit log. The row is read and updated in one transaction when
+// exec can begin one. Nothing is updated when no column changed.
+// See UpdateColumns for more documentation.
+func (o *{{$alias.UpSingular}}) UpdateWithChangeset({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (boil.Changeset, error) {
+	if o == nil {
+		return nil, errors.New("{{.PkgName}}: no {{$alias.UpSingular}} provided for update")
+	}
+
+	var changes boil.Changeset
+	err := boil.InTx{{if not .NoContext}}Context{{end}}({{if not .NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not .NoContext}}Context{{end}}Executor) error {
+		current, err := Find{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} exec, {{.Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}})
+		if err != nil {
+			return err
+		}
+
+		cols := strmangle.SetComplement({{$alias.DownSingular}}AllColumns, {{$alias.DownSingular}}PrimaryKeyColumns)
+		{{- if .Dialect.UseAutoColumns}}
+		cols = strmangle.SetComplement(cols, {{$alias.DownSingular}}ColumnsWithAuto)
+		{{- end}}
+		changes = queries.Changes(current, o, cols)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		{{if not .NoRowsAffected}}_, {{end}}err = o.UpdateColumns({{if not .NoContext}}ctx, {{end -}} exec, changes.Columns()...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+{{- end}}
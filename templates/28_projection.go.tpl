@@ -0,0 +1,41 @@
+{{- if not .Table.IsJoinTable -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $orig_tbl_name := .Table.Name}}
+{{- range $proj := .TableProjections .Table.Name}}
+{{- $projName := printf "%s%s" $alias.UpSingular $proj.Name}}
+
+// {{$projName}} is the {{$proj.Name}} projection of {{$alias.UpSingular}}, holding only
+// the columns {{$proj.Columns | columnNames | join ", "}}.
+type {{$projName}} struct {
+	{{- range $column := $proj.Columns}}
+	{{- $colAlias := $alias.Column $column.Name}}
+	{{- $opt := ""}}{{if $column.Nullable}}{{$opt = ",omitempty"}}{{end}}
+	{{- if ignore $orig_tbl_name $column.Name $.TagIgnore}}
+	{{$colAlias}} {{$column.Type}} `boil:"{{$column.Name}}" json:"-" toml:"-" yaml:"-"`
+	{{- else}}
+	{{$colAlias}} {{$column.Type}} `boil:"{{$column.Name}}" json:"{{tagCase ($.TagCasing "json") $column.Name $colAlias}}{{$opt}}" toml:"{{tagCase ($.TagCasing "toml") $column.Name $colAlias}}" yaml:"{{tagCase ($.TagCasing "yaml") $column.Name $colAlias}}{{$opt}}"`
+	{{- end}}
+	{{- end}}
+}
+
+// As{{$proj.Name}} returns the {{$projName}} of every row of the query, selecting
+// only the columns of the projection in place of the query's own selection.
+func (q {{$alias.DownSingular}}Query) As{{$proj.Name}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) ([]*{{$projName}}, error) {
+	{{if not $.NoContext -}}
+	ctx = boil.WithQueryOp(ctx, "{{$alias.UpSingular}}", "As{{$proj.Name}}")
+
+	{{end -}}
+	var o []*{{$projName}}
+
+	queries.SetSelect(q.Query, []string{
+		{{- range $i, $column := $proj.Columns}}{{if $i}}, {{end}}"{{$.Table.Name | $.SchemaTable}}.{{$column.Name | $.Quotes}}"{{end -}}
+	})
+	err := q.Bind({{if $.NoContext}}nil{{else}}ctx{{end}}, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "{{$.PkgName}}: failed to assign all query results to {{$projName}} slice")
+	}
+
+	return o, nil
+}
+{{- end}}
+{{- end}}
@@ -0,0 +1,87 @@
+{{- if and .GenerateInsertIgnore (not .Table.IsReadOnly) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- $hints := "" -}}
+{{- if .Dialect.UseTableHints}}{{$hints = " with (updlock, holdlock)"}}{{end -}}
+{{- $byPK := false -}}
+{{- if .Table.PKey -}}
+{{- $byPK = true -}}
+{{- range .Table.Columns}}{{if and .AutoIncrement (setInclude .Name $.Table.PKey.Columns)}}{{$byPK = false}}{{end}}{{end -}}
+{{- end -}}
+{{- if or $byPK .Table.UniqueKeys -}}
+{{- if $byPK}}
+// InsertIgnore inserts the {{$alias.UpSingular}} unless a row with its primary key already
+// exists, and reports whether it did. The check and the insert run in one transaction
+// when exec can begin one, an insert losing a race to a concurrent one is reported
+// as not inserted. See Insert for whitelist behavior description.
+func (o *{{$alias.UpSingular}}) InsertIgnore({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) (bool, error) {
+	query := "select 1 from {{$schemaTable}}{{$hints}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
+	return o.insertIgnore({{if not .NoContext}}ctx, {{end -}} exec, columns, query, []interface{}{ {{- .Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", " -}} })
+}
+{{end -}}
+
+{{- range $cols := .Table.UniqueKeys}}
+{{- $funcName := $cols | stringMap (aliasCols $alias) | join "And" | printf "InsertIgnoreBy%s"}}
+// {{$funcName}} inserts the {{$alias.UpSingular}} unless a row with its unique
+// {{$cols | join ", "}} already exists, and reports whether it did. Null values never conflict.
+// The check and the insert run in one transaction when exec can begin one.
+func (o *{{$alias.UpSingular}}) {{$funcName}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) (bool, error) {
+	query := "select 1 from {{$schemaTable}}{{$hints}} where {{if $.Dialect.UseIndexPlaceholders}}{{whereClause $.LQ $.RQ 1 $cols}}{{else}}{{whereClause $.LQ $.RQ 0 $cols}}{{end}}"
+	return o.insertIgnore({{if not $.NoContext}}ctx, {{end -}} exec, columns, query, []interface{}{ {{- $cols | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", " -}} })
+}
+{{end}}
+// insertIgnore inserts o when the conflict query finds no row. An insert
+// that fails on a unique key anyway lost a race to a concurrent insert of
+// the same key, it's rolled back and reported as not inserted.
+func (o *{{$alias.UpSingular}}) insertIgnore({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns, query string, args []interface{}) (bool, error) {
+	if o == nil {
+		return false, errors.New("{{.PkgName}}: no {{.Table.Name}} provided for insertion")
+	}
+
+	inserted, conflict := false, false
+	err := boil.InTx{{if not .NoContext}}Context{{end}}({{if not .NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not .NoContext}}Context{{end}}Executor) error {
+		{{if .NoContext -}}
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+			fmt.Fprintln(boil.DebugWriter, args)
+		}
+		{{else -}}
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, args)
+		}
+		{{end -}}
+
+		var found int
+		{{if .NoContext -}}
+		err := exec.QueryRow(query, args...).Scan(&found)
+		{{else -}}
+		err := boil.QueryRowContext(ctx, exec, query, args...).Scan(&found)
+		{{end -}}
+		if err == nil {
+			// A row has the key already, nothing to insert
+			return nil
+		}
+		if err != sql.ErrNoRows {
+			return errors.Wrap(err, "{{.PkgName}}: unable to check for a conflicting {{.Table.Name}} row")
+		}
+
+		if err = o.Insert({{if not .NoContext}}ctx, {{end -}} exec, columns); err != nil {
+			conflict = boil.IsUniqueViolation(err)
+			return err
+		}
+		inserted = true
+		return nil
+	})
+	if conflict {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return inserted, nil
+}
+{{- end -}}
+{{- end}}
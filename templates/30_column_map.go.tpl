@@ -0,0 +1,42 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+// ToColumnMap returns the columns of the {{$alias.UpSingular}} keyed by their name in the
+// database, ex: for UpdateAll or queries built by hand. Null columns are nil and
+// the others hold their plain value, ex: a string for a nullable text column.
+// excludeAuto leaves out the columns the database generates.
+func (o *{{$alias.UpSingular}}) ToColumnMap(excludeAuto bool) M {
+	m := make(M, {{len .Table.Columns}})
+	{{- range $column := .Table.Columns}}
+	{{- $colAlias := $alias.Column $column.Name}}
+	{{- $key := printf "%q" $column.Name}}
+	{{- $auto := or $column.AutoGenerated $column.AutoIncrement}}
+	{{- if $auto}}
+	if !excludeAuto {
+	{{- end}}
+	{{- if nullPointerType $column.Type}}
+	if o.{{$colAlias}}.Valid {
+		m[{{$key}}] = o.{{$colAlias}}.{{slice $column.Type 5}}
+	} else {
+		m[{{$key}}] = nil
+	}
+	{{- else if isSQLNullType $column.Type}}
+	if o.{{$colAlias}}.Valid {
+		m[{{$key}}] = o.{{$colAlias}}.{{slice $column.Type 8}}
+	} else {
+		m[{{$key}}] = nil
+	}
+	{{- else if isPointerType $column.Type}}
+	if o.{{$colAlias}} != nil {
+		m[{{$key}}] = *o.{{$colAlias}}
+	} else {
+		m[{{$key}}] = nil
+	}
+	{{- else}}
+	m[{{$key}}] = o.{{$colAlias}}
+	{{- end}}
+	{{- if $auto}}
+	}
+	{{- end}}
+	{{- end}}
+
+	return m
+}
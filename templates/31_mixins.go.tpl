@@ -0,0 +1,18 @@
+{{- if not .Table.IsJoinTable -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $mixins := .TableMixins .Table.Name -}}
+{{- if $mixins -}}
+var (
+	{{- range $mixin := $mixins}}
+	_ {{$mixin.Name}} = &{{$alias.UpSingular}}{}
+	{{- end}}
+)
+{{- range $getter := .MixinGetters .Table.Name}}
+
+// {{$getter.Name}} returns the {{$getter.Column.Name}} column of the {{$alias.UpSingular}}.
+func (o *{{$alias.UpSingular}}) {{$getter.Name}}() {{$getter.Column.Type}} {
+	return o.{{$alias.Column $getter.Column.Name}}
+}
+{{- end}}
+{{- end}}
+{{- end}}
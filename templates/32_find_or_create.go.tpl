@@ -0,0 +1,66 @@
+{{- if not .Table.IsReadOnly -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $byPK := false -}}
+{{- if .Table.PKey -}}
+{{- $byPK = true -}}
+{{- range .Table.Columns}}{{if and .AutoIncrement (setInclude .Name $.Table.PKey.Columns)}}{{$byPK = false}}{{end}}{{end -}}
+{{- end -}}
+{{- if $byPK}}
+// FindOrCreate{{$alias.UpSingular}} finds the {{$alias.UpSingular}} with the primary key of o, or inserts
+// o when there is none, and reports whether it did. The lookup and the insert run in one
+// transaction when exec can begin one, see boil.FindOrCreate for losing a race to a
+// concurrent insert. See Insert for whitelist behavior description.
+func FindOrCreate{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, o *{{$alias.UpSingular}}, columns boil.Columns) (*{{$alias.UpSingular}}, bool, error) {
+	if o == nil {
+		return nil, false, errors.New("{{.PkgName}}: no {{.Table.Name}} provided for insertion")
+	}
+
+	var found *{{$alias.UpSingular}}
+	created, err := boil.FindOrCreate{{if not .NoContext}}Context{{end}}({{if not .NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not .NoContext}}Context{{end}}Executor) error {
+		var err error
+		found, err = Find{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} exec, {{.Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}})
+		return err
+	}, func(exec boil.{{if not .NoContext}}Context{{end}}Executor) error {
+		return o.Insert({{if not .NoContext}}ctx, {{end -}} exec, columns)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		return o, true, nil
+	}
+
+	return found, false, nil
+}
+{{end -}}
+
+{{- range $cols := .Table.UniqueKeys}}
+{{- $keyName := $cols | stringMap (aliasCols $alias) | join "And"}}
+// FindOrCreate{{$alias.UpSingular}}By{{$keyName}} finds the {{$alias.UpSingular}} with the unique
+// {{$cols | join ", "}} of o, or inserts o when there is none, and reports whether it did.
+// Null values never match. The lookup and the insert run in one transaction when exec
+// can begin one, see boil.FindOrCreate for losing a race to a concurrent insert.
+func FindOrCreate{{$alias.UpSingular}}By{{$keyName}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, o *{{$alias.UpSingular}}, columns boil.Columns) (*{{$alias.UpSingular}}, bool, error) {
+	if o == nil {
+		return nil, false, errors.New("{{$.PkgName}}: no {{$.Table.Name}} provided for insertion")
+	}
+
+	var found *{{$alias.UpSingular}}
+	created, err := boil.FindOrCreate{{if not $.NoContext}}Context{{end}}({{if not $.NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not $.NoContext}}Context{{end}}Executor) error {
+		var err error
+		found, err = Find{{$alias.UpSingular}}By{{$keyName}}({{if not $.NoContext}}ctx, {{end -}} exec, {{$cols | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}})
+		return err
+	}, func(exec boil.{{if not $.NoContext}}Context{{end}}Executor) error {
+		return o.Insert({{if not $.NoContext}}ctx, {{end -}} exec, columns)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		return o, true, nil
+	}
+
+	return found, false, nil
+}
+{{end -}}
+{{- end -}}
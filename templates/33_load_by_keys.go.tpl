@@ -0,0 +1,45 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- range $i, $colName := loadKeyColumns .Table -}}
+{{- $col := $.Table.GetColumn $colName -}}
+{{- $field := $alias.Column $colName -}}
+{{- $funcName := printf "%sLoadBy%sKeys" $alias.UpSingular $field -}}
+{{- if and (eq $i 0) (loadByPrimaryKey $.Table)}}{{$funcName = printf "%sLoadByKeys" $alias.UpSingular}}{{end}}
+// {{$funcName}} retrieves the {{$.Table.Name}} rows with the given {{$colName}} keys, mapped by
+// their key, in as few queries as LoadChunkSize allows. Keys without a row are absent from
+// the map and duplicate keys are queried once, it's meant to back dataloader style batching.
+func {{$funcName}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, keys []{{$col.Type}}) (map[{{$col.Type}}]*{{$alias.UpSingular}}, error) {
+	seen := make(map[{{$col.Type}}]struct{}, len(keys))
+	args := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		args = append(args, key)
+	}
+
+	chunkSize := LoadChunkSize
+	if chunkSize <= 0 {
+		chunkSize = len(args)
+	}
+
+	found := make(map[{{$col.Type}}]*{{$alias.UpSingular}}, len(args))
+	for start := 0; start < len(args); start += chunkSize {
+		end := start + chunkSize
+		if end > len(args) {
+			end = len(args)
+		}
+
+		slice, err := {{$alias.UpPlural}}(qm.WhereIn("{{$schemaTable}}.{{$colName | $.Quotes}} in ?", args[start:end]...)).All({{if not $.NoContext}}ctx, {{end -}} exec)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range slice {
+			found[o.{{$field}}] = o
+		}
+	}
+
+	return found, nil
+}
+{{end -}}
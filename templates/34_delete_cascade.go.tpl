@@ -0,0 +1,54 @@
+{{- if and .GenerateDeleteCascade (not .Table.IsReadOnly) .Table.PKey -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $soft := and .AddSoftDeletes .Table.CanSoftDelete -}}
+{{- $plan := .DeleteCascadePlan .Table.Name}}
+// DeleteCascade deletes the {{$alias.UpSingular}} along with the rows referencing it, the rows
+// referencing those first, for the foreign keys the database doesn't resolve itself with
+// ON DELETE CASCADE or SET NULL. It runs in one transaction when exec can begin one.
+// The referencing rows are deleted by statement, without running their hooks{{if $soft}}, and
+// every row is deleted for good instead of soft deleted{{end}}.
+{{- if $plan.Cycle}}
+// The foreign keys of {{$plan.Cycle | join " -> "}} form a cycle, so it always
+// returns an error.
+{{- end}}
+func (o *{{$alias.UpSingular}}) DeleteCascade({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	if o == nil {
+		return errors.New("{{.PkgName}}: no {{$alias.UpSingular}} provided for delete")
+	}
+	{{- if $plan.Cycle}}
+
+	return errors.New("{{.PkgName}}: unable to cascade the delete of {{.Table.Name}}, the foreign keys of {{$plan.Cycle | join " -> "}} form a cycle")
+}
+	{{- else}}
+
+	return boil.InTx{{if not .NoContext}}Context{{end}}({{if not .NoContext}}ctx, {{end -}} exec, func(exec boil.{{if not .NoContext}}Context{{end}}Executor) error {
+		{{- range $del := $plan.Deletes}}
+		{{- $args := $del.Args | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}}
+		{{if $.NoContext -}}
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, "{{$del.SQL}}")
+			fmt.Fprintln(boil.DebugWriter, {{$args}})
+		}
+		if _, err := exec.Exec("{{$del.SQL}}", {{$args}}); err != nil {
+			return errors.Wrap(err, "{{$.PkgName}}: unable to delete the {{$del.Table}} rows of {{$.Table.Name}}")
+		}
+		{{- else -}}
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, "{{$del.SQL}}")
+			fmt.Fprintln(writer, {{$args}})
+		}
+		if _, err := boil.ExecContext(ctx, exec, "{{$del.SQL}}", {{$args}}); err != nil {
+			return errors.Wrap(err, "{{$.PkgName}}: unable to delete the {{$del.Table}} rows of {{$.Table.Name}}")
+		}
+		{{- end}}
+		{{- end}}
+
+		{{if .NoRowsAffected}}return {{else}}_, err := {{end}}o.Delete({{if not .NoContext}}ctx, {{end -}} exec{{if $soft}}, true{{end}})
+		{{- if not .NoRowsAffected}}
+		return err
+		{{- end}}
+	})
+}
+	{{- end}}
+{{end -}}
@@ -0,0 +1,12 @@
+{{- range $embed := .Embeds}}
+// {{$embed.Name}} holds the columns shared by the {{join ", " $embed.Tables}} tables,
+// it is embedded in their models.
+type {{$embed.Name}} struct {
+	{{range $field := $embed.Fields -}}
+	{{- $column := $field.Column -}}
+	{{- $tagName := $column.Name}}{{if eq $.StructTagCasing "alias"}}{{$tagName = $field.Name}}{{end -}}
+	{{- $opt := ""}}{{if $column.Nullable}}{{$opt = ",omitempty"}}{{end -}}
+	{{$field.Name}} {{$column.Type}} `{{generateTags $.Tags $tagName}}boil:"{{$column.Name}}" json:"{{tagCase ($.TagCasing "json") $column.Name $field.Name}}{{$opt}}" toml:"{{tagCase ($.TagCasing "toml") $column.Name $field.Name}}" yaml:"{{tagCase ($.TagCasing "yaml") $column.Name $field.Name}}{{$opt}}"`
+	{{end -}}
+}
+{{end -}}
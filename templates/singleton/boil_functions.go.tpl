@@ -0,0 +1,35 @@
+{{- range $fn := .Functions -}}
+{{- $name := titleCase $fn.Name -}}
+{{- if $fn.IsTableValued}}
+// {{$name}}Row is a row returned by the {{$fn.Name}} function.
+type {{$name}}Row struct {
+	{{range $fn.Columns -}}
+	{{titleCase .Name}} {{.Type}} `boil:"{{.Name}}" json:"{{.Name}}" toml:"{{.Name}}" yaml:"{{.Name}}"`
+	{{end -}}
+}
+
+// {{$name}} returns the rows of the {{$fn.Name}} table valued function.
+func {{$name}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{range $fn.Params}}, {{camelCase .Name}} {{.Type}}{{end}}) ([]*{{$name}}Row, error) {
+	var rows []*{{$name}}Row
+
+	q := queries.Raw("SELECT * FROM {{$.SchemaTable $fn.Name}}({{$.Placeholders (len $fn.Params)}})"{{range $fn.Params}}, {{camelCase .Name}}{{end}})
+	if err := q.Bind({{if $.NoContext}}nil{{else}}ctx{{end}}, exec, &rows); err != nil {
+		return nil, errors.Wrap(err, "{{$.PkgName}}: unable to call {{$fn.Name}}")
+	}
+
+	return rows, nil
+}
+{{else}}
+// {{$name}} returns the result of the {{$fn.Name}} function.
+func {{$name}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{range $fn.Params}}, {{camelCase .Name}} {{.Type}}{{end}}) ({{$fn.ReturnType}}, error) {
+	var result {{$fn.ReturnType}}
+
+	q := queries.Raw("SELECT {{$.SchemaTable $fn.Name}}({{$.Placeholders (len $fn.Params)}})"{{range $fn.Params}}, {{camelCase .Name}}{{end}})
+	if err := q.QueryRow{{if $.NoContext}}(exec){{else}}Context(ctx, exec){{end}}.Scan(&result); err != nil {
+		return result, errors.Wrap(err, "{{$.PkgName}}: unable to call {{$fn.Name}}")
+	}
+
+	return result, nil
+}
+{{end -}}
+{{- end -}}
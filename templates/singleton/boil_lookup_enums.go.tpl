@@ -0,0 +1,14 @@
+{{- range $enum := .Enums}}
+// {{$enum.Name}} is the key of a row of the {{$enum.Table}} lookup table.
+type {{$enum.Name}} {{$enum.Type}}
+
+{{if $enum.Values -}}
+// {{$enum.Name}} values, as read from {{$enum.Table}} when the models were generated.
+const (
+	{{range $enum.Values -}}
+	{{.Name}} {{$enum.Name}} = {{if eq $enum.Type "string"}}{{printf "%q" .Key}}{{else}}{{.Key}}{{end}}
+	{{end -}}
+)
+
+{{end -}}
+{{end -}}
@@ -0,0 +1,9 @@
+{{- range $mixin := .Mixins}}
+// {{$mixin.Name}} is implemented by the models of the {{join ", " $mixin.Tables}} tables,
+// through getters of the columns they share.
+type {{$mixin.Name}} interface {
+	{{- range $getter := $mixin.Getters}}
+	{{$getter.Name}}() {{$getter.Column.Type}}
+	{{- end}}
+}
+{{end -}}
@@ -6,12 +6,30 @@ var dialect = drivers.Dialect{
 	UseLastInsertID:         {{.Dialect.UseLastInsertID}},
 	UseSchema:               {{.Dialect.UseSchema}},
 	UseDefaultKeyword:       {{.Dialect.UseDefaultKeyword}},
+	MaxParams:               {{.Dialect.MaxParams}},
 	UseAutoColumns:          {{.Dialect.UseAutoColumns}},
 	UseTopClause:            {{.Dialect.UseTopClause}},
 	UseOutputClause:         {{.Dialect.UseOutputClause}},
 	UseCaseWhenExistsClause: {{.Dialect.UseCaseWhenExistsClause}},
+	UseCountBig:             {{.Dialect.UseCountBig}},
+	UseTableHints:           {{.Dialect.UseTableHints}},
+	UseDistinctOn:           {{.Dialect.UseDistinctOn}},
+	UseWindowFunctions:      {{.Dialect.UseWindowFunctions}},
+	UseForUpdate:            {{.Dialect.UseForUpdate}},
 }
 
+// LoadChunkSize is the most parameters the keys of a single eager loading
+// query for to-many relationships or LoadByKeys query bind, larger sets of
+// keys are split across several queries. The parameters of the query mods
+// given to the eager load are taken out of it.
+// It defaults to the database's parameter limit less drivers.ParamsMargin,
+// 0 disables chunking.
+var LoadChunkSize = dialect.KeyParams()
+
+// InsertAllBatchSize is the number of rows the InsertAll methods insert and
+// commit per transaction unless told otherwise, 0 inserts all rows at once.
+var InsertAllBatchSize = {{.BulkInsertBatchSize}}
+
 // NewQuery initializes a new Query using the passed in QueryMods
 func NewQuery(mods ...qm.QueryMod) *queries.Query {
 	q := &queries.Query{}
@@ -20,3 +38,13 @@ func NewQuery(mods ...qm.QueryMod) *queries.Query {
 
 	return q
 }
+
+// orderByHelper orders queries by a single known column, see the generated
+// <Model>OrderBy variables.
+type orderByHelper struct{ field string }
+
+// Asc orders by the column ascending
+func (o orderByHelper) Asc() qm.QueryMod { return qm.OrderBy(o.field + " ASC") }
+
+// Desc orders by the column descending
+func (o orderByHelper) Desc() qm.QueryMod { return qm.OrderBy(o.field + " DESC") }
@@ -0,0 +1,10 @@
+{{- if .Scanners -}}
+// The columns of these database types are generated as the types beside them,
+// which must be able to scan and store their values, nulls included.
+var (
+	{{range .Scanners -}}
+	_ sql.Scanner   = new({{.Type}}) // {{.DBType}}
+	_ driver.Valuer = *new({{.Type}})
+	{{end -}}
+)
+{{- end -}}
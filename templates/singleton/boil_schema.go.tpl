@@ -0,0 +1,126 @@
+{{- if .GenerateSchemaVersion -}}
+// SchemaVersion is a hash of the tables, column types and keys these models
+// were generated from, it changes whenever the schema they were generated
+// from does.
+const SchemaVersion = "{{.SchemaVersion}}"
+
+// EngineVersion is the version of the database engine these models were
+// generated against, empty when the driver couldn't tell.
+const EngineVersion = {{printf "%q" .EngineVersion}}
+{{- if .SchemaQuery}}
+
+type schemaColumn struct {
+	name     string
+	nullable bool
+}
+
+type schemaTable struct {
+	name    string
+	columns []schemaColumn
+	// only and skip are the columns the whitelist kept and the blacklist
+	// dropped, the columns they left out of the models aren't drift
+	only, skip []string
+}
+
+// schemaTables are the generated tables and columns VerifySchema checks
+var schemaTables = []schemaTable{
+	{{range $table := .Tables -}}
+	{{- $only := $.SchemaWhitelist $table.Name -}}
+	{{- $skip := $.SchemaBlacklist $table.Name -}}
+	{ {{- printf "%q" $table.Name}}, []schemaColumn{ {{- range $i, $col := $table.Columns}}{{if $i}}, {{end}}{ {{- printf "%q" $col.Name}}, {{$col.Nullable}}}{{end -}} },
+		{{- if $only}} []string{ {{- range $i, $c := $only}}{{if $i}}, {{end}}{{printf "%q" $c}}{{end -}} }{{else}} nil{{end}},
+		{{- if $skip}} []string{ {{- range $i, $c := $skip}}{{if $i}}, {{end}}{{printf "%q" $c}}{{end -}} }{{else}} nil{{end -}}
+	},
+	{{end -}}
+}
+
+// filtered is true for the columns the whitelist or blacklist left out of
+// the models
+func (t schemaTable) filtered(column string) bool {
+	for _, c := range t.skip {
+		if c == column {
+			return true
+		}
+	}
+	if len(t.only) == 0 {
+		return false
+	}
+	for _, c := range t.only {
+		if c == column {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifySchema compares the tables and columns of the database with the ones
+// the models were generated from, and returns an error listing the differences
+// when they drifted apart, ex: a migration ran without regenerating. Only the
+// column names and nullability are checked, types and keys are covered by
+// SchemaVersion at generation time.
+{{if .NoContext -}}
+func VerifySchema(exec boil.Executor) error {
+	rows, err := exec.Query(
+{{- else -}}
+func VerifySchema(ctx context.Context, exec boil.ContextExecutor) error {
+	rows, err := exec.QueryContext(ctx,
+{{- end}} {{printf "%q" .SchemaQuery}}{{if .Schema}}, {{printf "%q" .Schema}}{{end}})
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to read the schema")
+	}
+	defer rows.Close()
+
+	live := make(map[string]map[string]bool)
+	for rows.Next() {
+		var table, column, nullable string
+		if err := rows.Scan(&table, &column, &nullable); err != nil {
+			return errors.Wrap(err, "{{.PkgName}}: unable to read the schema")
+		}
+		if live[table] == nil {
+			live[table] = make(map[string]bool)
+		}
+		live[table][column] = strings.EqualFold(nullable, "YES")
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to read the schema")
+	}
+
+	var drift []string
+	for _, table := range schemaTables {
+		columns, ok := live[table.name]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("table %s is missing", table.name))
+			continue
+		}
+
+		for _, col := range table.columns {
+			nullable, ok := columns[col.name]
+			switch {
+			case !ok:
+				drift = append(drift, fmt.Sprintf("column %s.%s is missing", table.name, col.name))
+			case nullable != col.nullable:
+				drift = append(drift, fmt.Sprintf("column %s.%s nullability changed", table.name, col.name))
+			}
+			delete(columns, col.name)
+		}
+
+		var added []string
+		for name := range columns {
+			if !table.filtered(name) {
+				added = append(added, name)
+			}
+		}
+		sort.Strings(added)
+		for _, name := range added {
+			drift = append(drift, fmt.Sprintf("column %s.%s is new", table.name, name))
+		}
+	}
+
+	if len(drift) != 0 {
+		return errors.Errorf("{{.PkgName}}: schema differs from the models (version %s): %s", SchemaVersion, strings.Join(drift, "; "))
+	}
+
+	return nil
+}
+{{- end}}
+{{- end}}
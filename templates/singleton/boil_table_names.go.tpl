@@ -7,3 +7,21 @@ var TableNames = struct {
 	{{titleCase $table.Name}}: "{{$table.Name}}",
 	{{end -}}
 }
+{{- if .EmitNameConstants}}
+
+// Raw names of the tables and their columns in the database
+const (
+	{{range $table := .Tables -}}
+	Table{{titleCase $table.Name}} = "{{$table.Name}}"
+	{{end -}}
+)
+
+const (
+	{{range $table := .Tables -}}
+	{{- $tableName := titleCase $table.Name -}}
+	{{range $col := $table.Columns -}}
+	Column{{$tableName}}_{{titleCase $col.Name}} = "{{$col.Name}}"
+	{{end -}}
+	{{end -}}
+)
+{{- end}}
@@ -6,6 +6,22 @@ type M map[string]interface{}
 // fails or there was a primary key configuration that was not resolvable.
 var ErrSyncFail = errors.New("{{.PkgName}}: failed to synchronize data after insert")
 
+// ErrReloadNotFound occurs during Reload when the row could not be found by its
+// primary key, usually because it was deleted since it was loaded. It wraps
+// sql.ErrNoRows so errors.Is and errors.Cause checks keep working.
+var ErrReloadNotFound = errors.WithMessage(sql.ErrNoRows, "{{.PkgName}}: row to reload no longer exists")
+
+{{if .GenerateIndexMetadata -}}
+// Index describes an index on a table, see the <Model>Indexes variables.
+// Filter is the predicate of a partial (filtered) index, empty otherwise.
+type Index struct {
+	Name    string
+	Columns []string
+	Unique  bool
+	Filter  string
+}
+
+{{end -}}
 type insertCache struct {
 	query        string
 	retQuery     string
@@ -0,0 +1,50 @@
+{{- if and .GenerateChangesets (not .Table.IsReadOnly) -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+func test{{$alias.UpPlural}}UpdateWithChangeset(t *testing.T) {
+	t.Parallel()
+
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = o.Reload({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
+		t.Error(err)
+	}
+
+	// The row as it was read back has nothing to update
+	changes, err := o.UpdateWithChangeset({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("want no changes, got: %v", changes.Columns())
+	}
+
+	before := *o
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	changes, err = o.UpdateWithChangeset({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	// Every column of the changeset was modified since the row was read back
+	if modified := queries.Changes(&before, o, changes.Columns()); len(modified) != len(changes) {
+		t.Errorf("want only modified columns, got %v of which %v were modified", changes.Columns(), modified.Columns())
+	}
+}
+{{- end}}
@@ -8,7 +8,7 @@ func test{{$alias.UpPlural}}SoftDelete(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -49,7 +49,7 @@ func test{{$alias.UpPlural}}QuerySoftDeleteAll(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -90,7 +90,7 @@ func test{{$alias.UpPlural}}SliceSoftDeleteAll(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -135,7 +135,7 @@ func test{{$alias.UpPlural}}Delete(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -176,7 +176,7 @@ func test{{$alias.UpPlural}}QueryDeleteAll(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -211,13 +211,59 @@ func test{{$alias.UpPlural}}QueryDeleteAll(t *testing.T) {
 	}
 }
 
+{{if .Dialect.UseOutputClause -}}
+func test{{$alias.UpPlural}}QueryDeleteAllReturning(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	deleted, rowsAff, err := {{$alias.UpPlural}}().DeleteAllReturning({{if not .NoContext}}ctx, {{end -}} tx {{- if $soft}}, true{{end}})
+	if err != nil {
+		t.Error(err)
+	}
+	if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+	{{- if not .Table.HasTriggers}}
+	if len(deleted) != 1 {
+		t.Error("want one deleted row returned, got:", len(deleted))
+	}
+	{{- else}}
+	if len(deleted) != 0 {
+		t.Error("want no deleted rows returned on a table with triggers, got:", len(deleted))
+	}
+	{{- end}}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+{{end -}}
 func test{{$alias.UpPlural}}SliceDeleteAll(t *testing.T) {
 	t.Parallel()
 
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
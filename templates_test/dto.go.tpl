@@ -0,0 +1,38 @@
+{{- if .GenerateDTOs -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $pointers := ne .DTONullStyle "null"}}
+func test{{$alias.UpPlural}}DTO(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	o := &{{$alias.UpSingular}}{}
+	if err := randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	got := &{{$alias.UpSingular}}{}
+	got.FromDTO(o.ToDTO())
+	if !reflect.DeepEqual(o, got) {
+		t.Errorf("want %#v back from the DTO, got %#v", o, got)
+	}
+
+	// Null columns round trip as well
+	o = &{{$alias.UpSingular}}{}
+	d := o.ToDTO()
+	{{- if $pointers}}
+	{{- range $column := .Table.Columns}}
+	{{- if nullPointerType $column.Type}}
+	if d.{{$alias.Column $column.Name}} != nil {
+		t.Error("want a nil {{$alias.Column $column.Name}} for a null {{$column.Name}}")
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+
+	got = &{{$alias.UpSingular}}{}
+	got.FromDTO(d)
+	if !reflect.DeepEqual(o, got) {
+		t.Errorf("want %#v back from the DTO, got %#v", o, got)
+	}
+}
+{{- end}}
@@ -5,7 +5,7 @@ func test{{$alias.UpPlural}}Find(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
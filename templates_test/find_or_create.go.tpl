@@ -0,0 +1,49 @@
+{{- if not .Table.IsReadOnly -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $byPK := false -}}
+{{- if .Table.PKey -}}
+{{- $byPK = true -}}
+{{- range .Table.Columns}}{{if and .AutoIncrement (setInclude .Name $.Table.PKey.Columns)}}{{$byPK = false}}{{end}}{{end -}}
+{{- end -}}
+{{- if $byPK}}
+func test{{$alias.UpPlural}}FindOrCreate(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	created, isNew, err := FindOrCreate{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} tx, o, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isNew || created != o {
+		t.Error("want the missing row created")
+	}
+
+	// Same primary key, the row inserted above is found
+	found, isNew, err := FindOrCreate{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} tx, o, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isNew || found == o {
+		t.Error("want the existing row found")
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+{{- end}}
+{{- end}}
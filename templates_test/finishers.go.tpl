@@ -5,7 +5,7 @@ func test{{$alias.UpPlural}}Bind(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -27,7 +27,7 @@ func test{{$alias.UpPlural}}One(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -52,10 +52,10 @@ func test{{$alias.UpPlural}}All(t *testing.T) {
 	var err error
 	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
 	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
-	if err = randomize.Struct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -79,6 +79,56 @@ func test{{$alias.UpPlural}}All(t *testing.T) {
 	}
 }
 
+func test{{$alias.UpPlural}}Stream(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
+	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomizeStruct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = {{$alias.DownSingular}}One.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = {{$alias.DownSingular}}Two.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count := 0
+	err = {{$alias.UpPlural}}().Stream({{if not .NoContext}}ctx, {{end -}} tx, func(o *{{$alias.UpSingular}}) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+
+	stop := errors.New("stop")
+	count = 0
+	err = {{$alias.UpPlural}}().Stream({{if not .NoContext}}ctx, {{end -}} tx, func(o *{{$alias.UpSingular}}) error {
+		count++
+		return stop
+	})
+	if err != stop {
+		t.Error("want the callback's error, got:", err)
+	}
+	if count != 1 {
+		t.Error("want streaming to stop after 1 record, got:", count)
+	}
+}
+
 func test{{$alias.UpPlural}}Count(t *testing.T) {
 	t.Parallel()
 
@@ -86,10 +136,10 @@ func test{{$alias.UpPlural}}Count(t *testing.T) {
 	seed := randomize.NewSeed()
 	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
 	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
-	if err = randomize.Struct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
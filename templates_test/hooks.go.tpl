@@ -55,7 +55,7 @@ func test{{$alias.UpPlural}}Hooks(t *testing.T) {
 	o := &{{$alias.UpSingular}}{}
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, false); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, false); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} object: %s", err)
 	}
 
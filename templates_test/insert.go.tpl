@@ -5,7 +5,7 @@ func test{{$alias.UpPlural}}Insert(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -32,7 +32,7 @@ func test{{$alias.UpPlural}}InsertWhitelist(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -52,3 +52,25 @@ func test{{$alias.UpPlural}}InsertWhitelist(t *testing.T) {
 		t.Error("want one record, got:", count)
 	}
 }
+{{- if not .NoContext}}
+
+func test{{$alias.UpPlural}}InsertDeadline(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	tx := MustTx(boil.BeginTx(context.Background(), nil))
+	defer func() { _ = tx.Rollback() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	if err = o.Insert(ctx, tx, boil.Infer()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want the insert aborted by the deadline, got: %v", err)
+	}
+}
+{{- end}}
@@ -0,0 +1,48 @@
+{{- if and .GenerateInsertIgnore (not .Table.IsReadOnly) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $byPK := false -}}
+{{- if .Table.PKey -}}
+{{- $byPK = true -}}
+{{- range .Table.Columns}}{{if and .AutoIncrement (setInclude .Name $.Table.PKey.Columns)}}{{$byPK = false}}{{end}}{{end -}}
+{{- end -}}
+{{- if $byPK}}
+func test{{$alias.UpPlural}}InsertIgnore(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	inserted, err := o.InsertIgnore({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !inserted {
+		t.Error("want the first insert to happen")
+	}
+
+	// Same primary key, the insert is skipped
+	if inserted, err = o.InsertIgnore({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if inserted {
+		t.Error("want the conflicting insert skipped")
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+{{- end}}
+{{- end}}
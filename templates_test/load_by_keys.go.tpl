@@ -0,0 +1,53 @@
+{{- if and (not .Table.IsReadOnly) (loadByPrimaryKey .Table) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $pk := index .Table.PKey.Columns 0 | $alias.Column }}
+func test{{$alias.UpPlural}}LoadByKeys(t *testing.T) {
+	// Not parallel, LoadChunkSize is changed to split the keys
+
+	seed := randomize.NewSeed()
+	var err error
+	objs := make({{$alias.UpSingular}}Slice, 3)
+	for i := range objs {
+		objs[i] = &{{$alias.UpSingular}}{}
+		if err = randomizeStruct(seed, objs[i], {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+			t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+		}
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	for _, o := range objs {
+		if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The deleted row leaves a gap in the keys
+	if {{if not .NoRowsAffected}}_, {{end}}err = objs[1].Delete({{if not .NoContext}}ctx, {{end -}} tx{{if and .AddSoftDeletes .Table.CanSoftDelete}}, true{{end}}); err != nil {
+		t.Fatal(err)
+	}
+	keys := []{{(.Table.GetColumn (index .Table.PKey.Columns 0)).Type}}{objs[0].{{$pk}}, objs[1].{{$pk}}, objs[2].{{$pk}}, objs[0].{{$pk}}}
+
+	defer func(size int) { LoadChunkSize = size }(LoadChunkSize)
+	for _, size := range []int{0, 1, 2, 3} {
+		LoadChunkSize = size
+
+		found, err := {{$alias.UpSingular}}LoadByKeys({{if not .NoContext}}ctx, {{end -}} tx, keys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != 2 {
+			t.Errorf("chunks of %d: want 2 rows, got: %d", size, len(found))
+		}
+		if _, ok := found[objs[1].{{$pk}}]; ok {
+			t.Errorf("chunks of %d: want the deleted key absent", size)
+		}
+		for _, o := range []*{{$alias.UpSingular}}{objs[0], objs[2]} {
+			if got, ok := found[o.{{$pk}}]; !ok || got.{{$pk}} != o.{{$pk}} {
+				t.Errorf("chunks of %d: want the row keyed %v", size, o.{{$pk}})
+			}
+		}
+	}
+}
+{{- end}}
@@ -0,0 +1,31 @@
+{{- if not (or .Table.IsJoinTable .Table.IsReadOnly) -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- range $proj := .TableProjections .Table.Name}}
+func test{{$alias.UpPlural}}As{{$proj.Name}}(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not $.NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := {{$alias.UpPlural}}().As{{$proj.Name}}({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+{{end -}}
+{{- end -}}
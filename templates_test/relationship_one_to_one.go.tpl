@@ -1,6 +1,8 @@
 {{- if .Table.IsJoinTable -}}
 {{- else -}}
 	{{- range $rel := .Table.ToOneRelationships -}}
+	{{- /* the related rows can only be inserted into writable tables */ -}}
+	{{- if not ($.ReadOnly $rel.ForeignTable) -}}
 		{{- $ltable := $.Aliases.Table $rel.Table -}}
 		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
 		{{- $relAlias := $ftable.Relationship $rel.Name -}}
@@ -16,10 +18,10 @@ func test{{$ltable.UpSingular}}OneToOne{{$ftable.UpSingular}}Using{{$relAlias.Lo
 	var local {{$ltable.UpSingular}}
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &foreign, {{$ftable.DownSingular}}DBTypes, true, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err := randomizeStruct(seed, &foreign, {{$ftable.DownSingular}}DBTypes, true, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ftable.UpSingular}} struct: %s", err)
 	}
-	if err := randomize.Struct(seed, &local, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err := randomizeStruct(seed, &local, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
 	}
 
@@ -27,7 +29,11 @@ func test{{$ltable.UpSingular}}OneToOne{{$ftable.UpSingular}}Using{{$relAlias.Lo
 		t.Fatal(err)
 	}
 
-	{{if $usesPrimitives -}}
+	{{if $rel.IsComposite -}}
+	{{range $i, $c := $rel.ForeignColumns -}}
+	queries.Assign(&foreign.{{$ftable.Column $c}}, local.{{$ltable.Column (index $rel.Columns $i)}})
+	{{end -}}
+	{{else if $usesPrimitives -}}
 	foreign.{{$fcolField}} = local.{{$colField}}
 	{{else -}}
 	queries.Assign(&foreign.{{$fcolField}}, local.{{$colField}})
@@ -66,5 +72,6 @@ func test{{$ltable.UpSingular}}OneToOne{{$ftable.UpSingular}}Using{{$relAlias.Lo
 	}
 }
 
-{{end -}}{{/* range */}}
+{{end -}}{{- /* if not read only */ -}}
+{{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -2,6 +2,8 @@
 {{- else -}}
 	{{- $table := .Table }}
 	{{- range $rel := .Table.ToManyRelationships -}}
+	{{- /* the related rows can only be inserted into writable tables */ -}}
+	{{- if not ($.ReadOnly $rel.ForeignTable $rel.JoinTable) -}}
 		{{- $ltable := $.Aliases.Table $rel.Table -}}
 		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
 		{{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
@@ -19,7 +21,7 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 	var b, c {{$ftable.UpSingular}}
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, &a, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
 	}
 
@@ -27,14 +29,21 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, &b, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, &c, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	{{if not .ToJoinTable -}}
+	{{if $rel.IsComposite -}}
+	{{range $i, $c := $rel.ForeignColumns -}}
+	queries.Assign(&b.{{$ftable.Column $c}}, a.{{$ltable.Column (index $rel.Columns $i)}})
+	{{end -}}
+	{{range $i, $c := $rel.ForeignColumns -}}
+	queries.Assign(&c.{{$ftable.Column $c}}, a.{{$ltable.Column (index $rel.Columns $i)}})
+	{{end -}}
+	{{- else if not .ToJoinTable -}}
 		{{if $usesPrimitives}}
 	b.{{$fcolField}} = a.{{$colField}}
 	c.{{$fcolField}} = a.{{$colField}}
@@ -92,6 +101,14 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Error("expected to find c")
 	}
 
+	count, err := a.Count{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != int64(len(check)) {
+		t.Errorf("want a count of %d, got %d", len(check), count)
+	}
+
 	slice := {{$ltable.UpSingular}}Slice{&a}
 	if err = a.L.Load{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ltable.UpSingular}})(&slice), nil); err != nil {
 		t.Fatal(err)
@@ -108,10 +125,61 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
+	var e {{$ltable.UpSingular}}
+	var f {{$ftable.UpSingular}}
+	if err = randomizeStruct(seed, &e, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
+	}
+	if err := e.Insert({{if not $.NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomizeStruct(seed, &f, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	{{if $rel.IsComposite -}}
+	{{range $i, $c := $rel.ForeignColumns -}}
+	queries.Assign(&f.{{$ftable.Column $c}}, e.{{$ltable.Column (index $rel.Columns $i)}})
+	{{end -}}
+	{{- else if not .ToJoinTable -}}
+		{{if $usesPrimitives}}
+	f.{{$fcolField}} = e.{{$colField}}
+		{{else -}}
+	queries.Assign(&f.{{$fcolField}}, e.{{$colField}})
+		{{- end}}
+	{{- end}}
+	if err = f.Insert({{if not $.NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	{{if .ToJoinTable -}}
+	_, err = tx.Exec("insert into {{.JoinTable | $.SchemaTable}} ({{.JoinLocalColumn | $.Quotes}}, {{.JoinForeignColumn | $.Quotes}}) values {{if $.Dialect.UseIndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}", e.{{$colField}}, f.{{$fcolField}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	{{end}}
+
+	// Load with more parents than the chunk size so each parent's
+	// children come back from a separate query
+	chunkSize := LoadChunkSize
+	LoadChunkSize = 1
+	defer func() { LoadChunkSize = chunkSize }()
+
+	a.R.{{$relAlias.Local}} = nil
+	slice = {{$ltable.UpSingular}}Slice{&a, &e}
+	if err = a.L.Load{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ltable.UpSingular}})(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.{{$relAlias.Local}}); got != 2 {
+		t.Error("number of chunked eager loaded records wrong, got:", got)
+	}
+	if got := len(e.R.{{$relAlias.Local}}); got != 1 {
+		t.Error("number of chunked eager loaded records wrong, got:", got)
+	}
+
 	if t.Failed() {
 		t.Logf("%#v", check)
 	}
 }
 
-{{end -}}{{- /* range */ -}}
+{{end -}}{{- /* if not read only */ -}}
+{{- end -}}{{- /* range */ -}}
 {{- end -}}{{- /* outer if join table */ -}}
@@ -1,6 +1,8 @@
 {{- if .Table.IsJoinTable -}}
 {{- else -}}
 	{{- range $fkey := .Table.FKeys -}}
+	{{- /* the related rows can only be inserted into writable tables */ -}}
+	{{- if not ($.ReadOnly $fkey.ForeignTable) -}}
 		{{- $ltable := $.Aliases.Table $fkey.Table -}}
 		{{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
 		{{- $rel := $ltable.Relationship $fkey.Name -}}
@@ -16,10 +18,10 @@ func test{{$ltable.UpSingular}}ToOne{{$ftable.UpSingular}}Using{{$rel.Foreign}}(
 	var foreign {{$ftable.UpSingular}}
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, {{$ltable.DownSingular}}DBTypes, {{if $fkey.Nullable}}true{{else}}false{{end}}, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err := randomizeStruct(seed, &local, {{$ltable.DownSingular}}DBTypes, {{if $fkey.Nullable}}true{{else}}false{{end}}, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
 	}
-	if err := randomize.Struct(seed, &foreign, {{$ftable.DownSingular}}DBTypes, {{if $fkey.ForeignColumnNullable}}true{{else}}false{{end}}, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err := randomizeStruct(seed, &foreign, {{$ftable.DownSingular}}DBTypes, {{if $fkey.ForeignColumnNullable}}true{{else}}false{{end}}, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ftable.UpSingular}} struct: %s", err)
 	}
 
@@ -27,7 +29,11 @@ func test{{$ltable.UpSingular}}ToOne{{$ftable.UpSingular}}Using{{$rel.Foreign}}(
 		t.Fatal(err)
 	}
 
-	{{if $usesPrimitives -}}
+	{{if $fkey.IsComposite -}}
+	{{range $i, $c := $fkey.Columns -}}
+	queries.Assign(&local.{{$ltable.Column $c}}, foreign.{{$ftable.Column (index $fkey.ForeignColumns $i)}})
+	{{end -}}
+	{{else if $usesPrimitives -}}
 	local.{{$colField}} = foreign.{{$fcolField}}
 	{{else -}}
 	queries.Assign(&local.{{$colField}}, foreign.{{$fcolField}})
@@ -66,5 +72,6 @@ func test{{$ltable.UpSingular}}ToOne{{$ftable.UpSingular}}Using{{$rel.Foreign}}(
 	}
 }
 
-{{end -}}{{/* range */}}
+{{end -}}{{- /* if not read only */ -}}
+{{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -0,0 +1,13 @@
+{{- range $embed := .Embeds}}
+{{- $name := $embed.Name}}
+var {{camelCase $name}}DBTypes = map[string]string{ {{- range $i, $f := $embed.Fields}}{{if $i}}, {{end}}`{{$f.Name}}`: `{{$f.Column.DBType}}`{{end -}} }
+
+// Randomize lets randomize.Struct fill in the fields of {{$name}} when it
+// randomizes a model embedding it.
+func (e *{{$name}}) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	seed := randomize.Seed(nextInt())
+	if err := randomizeStruct(&seed, e, {{camelCase $name}}DBTypes, false); err != nil {
+		panic(err)
+	}
+}
+{{end -}}
@@ -44,3 +44,88 @@ func (f *fKeyDestroyer) Read(b []byte) (int, error) {
 	return f.buf.Read(b)
 }
 
+
+// randomizeStruct is randomize.Struct for models whose nullable columns are
+// pointers (--nullable-style pointers) or database/sql's null types
+// (--nullable-package stdlib), which randomize can't fill. Those are randomized
+// as the type they hold and stay null when left unset.
+func randomizeStruct(seed *randomize.Seed, str interface{}, colTypes map[string]string, canBeNull bool, blacklist ...string) error {
+	val := reflect.ValueOf(str)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return randomize.Struct(seed, str, colTypes, canBeNull, blacklist...)
+	}
+	val = val.Elem()
+	typ := val.Type()
+
+	isPointerColumn := func(f reflect.StructField) bool {
+		return f.Type.Kind() == reflect.Ptr && f.Tag.Get("boil") != "-"
+	}
+	isSQLNullColumn := func(f reflect.StructField) bool {
+		return f.Type.Kind() == reflect.Struct && f.Type.PkgPath() == "database/sql" && strings.HasPrefix(f.Type.Name(), "Null")
+	}
+
+	hasWrapped := false
+	fields := make([]reflect.StructField, typ.NumField())
+	for i := range fields {
+		fields[i] = typ.Field(i)
+		fields[i].Anonymous = false
+		if isPointerColumn(fields[i]) {
+			fields[i].Type = fields[i].Type.Elem()
+			hasWrapped = true
+		} else if isSQLNullColumn(fields[i]) {
+			// The value is the first field, ex: String in sql.NullString
+			fields[i].Type = fields[i].Type.Field(0).Type
+			hasWrapped = true
+		}
+	}
+	if !hasWrapped {
+		return randomize.Struct(seed, str, colTypes, canBeNull, blacklist...)
+	}
+
+	// Randomize a copy with the columns replaced by the type they hold
+	plain := reflect.New(reflect.StructOf(fields)).Elem()
+	for i := range fields {
+		f := val.Field(i)
+		switch {
+		case isPointerColumn(typ.Field(i)):
+			if !f.IsNil() {
+				plain.Field(i).Set(f.Elem())
+			}
+		case isSQLNullColumn(typ.Field(i)):
+			if f.FieldByName("Valid").Bool() {
+				plain.Field(i).Set(f.Field(0))
+			}
+		default:
+			plain.Field(i).Set(f)
+		}
+	}
+	before := reflect.ValueOf(plain.Interface())
+
+	if err := randomize.Struct(seed, plain.Addr().Interface(), colTypes, canBeNull, blacklist...); err != nil {
+		return err
+	}
+
+	for i := range fields {
+		f, v := val.Field(i), plain.Field(i)
+		isPointer, isSQLNull := isPointerColumn(typ.Field(i)), isSQLNullColumn(typ.Field(i))
+		if !isPointer && !isSQLNull {
+			f.Set(v)
+			continue
+		}
+		// Blacklisted columns aren't touched and keep their value
+		if reflect.DeepEqual(v.Interface(), before.Field(i).Interface()) {
+			continue
+		}
+
+		if isSQLNull {
+			f.Field(0).Set(v)
+			f.FieldByName("Valid").SetBool(true)
+			continue
+		}
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		f.Set(ptr)
+	}
+
+	return nil
+}
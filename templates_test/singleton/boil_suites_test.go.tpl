@@ -6,7 +6,7 @@
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}})
@@ -17,7 +17,7 @@ func TestParent(t *testing.T) {
 {{if .AddSoftDeletes -}}
 func TestSoftDelete(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   	{{- if .CanSoftDelete -}}
       {{- $alias := $.Aliases.Table .Name -}}
@@ -29,7 +29,7 @@ func TestSoftDelete(t *testing.T) {
 
 func TestQuerySoftDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   	{{- if .CanSoftDelete -}}
       {{- $alias := $.Aliases.Table .Name -}}
@@ -41,7 +41,7 @@ func TestQuerySoftDeleteAll(t *testing.T) {
 
 func TestSliceSoftDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   	{{- if .CanSoftDelete -}}
       {{- $alias := $.Aliases.Table .Name -}}
@@ -54,7 +54,7 @@ func TestSliceSoftDeleteAll(t *testing.T) {
 
 func TestDelete(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Delete)
@@ -64,7 +64,7 @@ func TestDelete(t *testing.T) {
 
 func TestQueryDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}QueryDeleteAll)
@@ -72,9 +72,21 @@ func TestQueryDeleteAll(t *testing.T) {
   {{- end -}}
 }
 
+{{if .Dialect.UseOutputClause -}}
+func TestQueryDeleteAllReturning(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}QueryDeleteAllReturning)
+  {{end -}}
+  {{- end -}}
+}
+
+{{end -}}
 func TestSliceDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceDeleteAll)
@@ -84,7 +96,7 @@ func TestSliceDeleteAll(t *testing.T) {
 
 func TestExists(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Exists)
@@ -94,7 +106,7 @@ func TestExists(t *testing.T) {
 
 func TestFind(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Find)
@@ -104,7 +116,7 @@ func TestFind(t *testing.T) {
 
 func TestBind(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Bind)
@@ -114,7 +126,7 @@ func TestBind(t *testing.T) {
 
 func TestOne(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}One)
@@ -124,7 +136,7 @@ func TestOne(t *testing.T) {
 
 func TestAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}All)
@@ -132,9 +144,19 @@ func TestAll(t *testing.T) {
   {{- end -}}
 }
 
+func TestStream(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Stream)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestCount(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Count)
@@ -145,7 +167,7 @@ func TestCount(t *testing.T) {
 {{if not .NoHooks -}}
 func TestHooks(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Hooks)
@@ -156,27 +178,72 @@ func TestHooks(t *testing.T) {
 
 func TestInsert(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Insert)
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertWhitelist)
+  {{- if not $.NoContext}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertDeadline)
+  {{- end}}
   {{end -}}
   {{- end -}}
 }
 
+{{if .GenerateInsertIgnore -}}
+func TestInsertIgnore(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly (not .PKey) -}}
+  {{- else -}}
+  {{- $table := . -}}
+  {{- $byPK := true -}}
+  {{- range .Columns}}{{if and .AutoIncrement (setInclude .Name $table.PKey.Columns)}}{{$byPK = false}}{{end}}{{end -}}
+  {{- if $byPK -}}
+  {{- $alias := $.Aliases.Table .Name}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertIgnore)
+  {{- end -}}
+  {{- end -}}
+  {{- end}}
+}
+
+{{end -}}
+func TestFindOrCreate(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly (not .PKey) -}}
+  {{- else -}}
+  {{- $table := . -}}
+  {{- $byPK := true -}}
+  {{- range .Columns}}{{if and .AutoIncrement (setInclude .Name $table.PKey.Columns)}}{{$byPK = false}}{{end}}{{end -}}
+  {{- if $byPK -}}
+  {{- $alias := $.Aliases.Table .Name}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}FindOrCreate)
+  {{- end -}}
+  {{- end -}}
+  {{- end}}
+}
+
+func TestLoadByKeys(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly (not (loadByPrimaryKey .)) -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}LoadByKeys)
+  {{- end -}}
+  {{- end}}
+}
+
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
 {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
-    {{- range $fkey := .FKeys -}}
+    {{- range $fkey := .FKeys -}}{{- if not ($.ReadOnly $fkey.ForeignTable) -}}
       {{- $ltable := $.Aliases.Table $fkey.Table -}}
       {{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
       {{- $relAlias := $ltable.Relationship $fkey.Name -}}
   t.Run("{{$ltable.UpSingular}}To{{$ftable.UpSingular}}Using{{$relAlias.Foreign}}", test{{$ltable.UpSingular}}ToOne{{$ftable.UpSingular}}Using{{$relAlias.Foreign}})
-    {{end -}}{{- /* fkey range */ -}}
+    {{end -}}{{- /* if not read only */ -}}{{- end -}}{{- /* fkey range */ -}}
   {{- end -}}{{- /* if join table */ -}}
 {{- end -}}{{- /* tables range */ -}}
 }
@@ -185,14 +252,14 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
   {{- range .Tables}}
-	{{- if .IsJoinTable -}}
+	{{- if or .IsJoinTable .IsReadOnly -}}
 	{{- else -}}
-	  {{- range $rel := .ToOneRelationships -}}
+	  {{- range $rel := .ToOneRelationships -}}{{- if not ($.ReadOnly $rel.ForeignTable) -}}
       {{- $ltable := $.Aliases.Table $rel.Table -}}
       {{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
       {{- $relAlias := $ftable.Relationship $rel.Name -}}
 	t.Run("{{$ltable.UpSingular}}To{{$ftable.UpSingular}}Using{{$relAlias.Local}}", test{{$ltable.UpSingular}}OneToOne{{$ftable.UpSingular}}Using{{$relAlias.Local}})
-	  {{end -}}{{- /* range */ -}}
+	  {{end -}}{{- /* if not read only */ -}}{{- end -}}{{- /* range */ -}}
 	{{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -201,13 +268,13 @@ func TestOneToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsReadOnly -}}
     {{- else -}}
-      {{- range $rel := .ToManyRelationships -}}
+      {{- range $rel := .ToManyRelationships -}}{{- if not ($.ReadOnly $rel.ForeignTable $rel.JoinTable) -}}
         {{- $ltable := $.Aliases.Table $rel.Table -}}
         {{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
   t.Run("{{$ltable.UpSingular}}To{{$relAlias.Local}}", test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}})
-      {{end -}}{{- /* range */ -}}
+      {{end -}}{{- /* if not read only */ -}}{{- end -}}{{- /* range */ -}}
     {{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -216,14 +283,14 @@ func TestToMany(t *testing.T) {
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
 {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
-    {{- range $fkey := .FKeys -}}
+    {{- range $fkey := .FKeys -}}{{- if and (not $fkey.IsComposite) (not ($.ReadOnly $fkey.ForeignTable)) -}}
       {{- $ltable := $.Aliases.Table $fkey.Table -}}
       {{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
       {{- $relAlias := $ltable.Relationship $fkey.Name -}}
   t.Run("{{$ltable.UpSingular}}To{{$ftable.UpSingular}}Using{{$relAlias.Local}}", test{{$ltable.UpSingular}}ToOneSetOp{{$ftable.UpSingular}}Using{{$relAlias.Foreign}})
-    {{end -}}{{- /* fkey range */ -}}
+    {{end -}}{{- /* if not composite or read only */ -}}{{- end -}}{{- /* fkey range */ -}}
   {{- end -}}{{- /* if join table */ -}}
 {{- end -}}{{- /* tables range */ -}}
 }
@@ -232,16 +299,16 @@ func TestToOneSet(t *testing.T) {
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
 {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
-    {{- range $fkey := .FKeys -}}
+    {{- range $fkey := .FKeys -}}{{- if and (not $fkey.IsComposite) (not ($.ReadOnly $fkey.ForeignTable)) -}}
       {{- if $fkey.Nullable -}}
         {{- $ltable := $.Aliases.Table $fkey.Table -}}
         {{- $ftable := $.Aliases.Table $fkey.ForeignTable -}}
         {{- $relAlias := $ltable.Relationship $fkey.Name -}}
   t.Run("{{$ltable.UpSingular}}To{{$ftable.UpSingular}}Using{{$relAlias.Local}}", test{{$ltable.UpSingular}}ToOneRemoveOp{{$ftable.UpSingular}}Using{{$relAlias.Foreign}})
       {{end -}}{{- /* if foreign key nullable */ -}}
-    {{- end -}}{{- /* fkey range */ -}}
+    {{- end -}}{{- /* if not composite or read only */ -}}{{- end -}}{{- /* fkey range */ -}}
   {{- end -}}{{- /* if join table */ -}}
 {{- end -}}{{- /* tables range */ -}}
 }
@@ -250,14 +317,14 @@ func TestToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
   {{- range .Tables}}
-	{{- if .IsJoinTable -}}
+	{{- if or .IsJoinTable .IsReadOnly -}}
 	{{- else -}}
-	  {{- range $rel := .ToOneRelationships -}}
+	  {{- range $rel := .ToOneRelationships -}}{{- if and (not $rel.IsComposite) (not ($.ReadOnly $rel.ForeignTable)) -}}
       {{- $ltable := $.Aliases.Table $rel.Table -}}
       {{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
       {{- $relAlias := $ftable.Relationship $rel.Name -}}
 	t.Run("{{$ltable.UpSingular}}To{{$ftable.UpSingular}}Using{{$relAlias.Local}}", test{{$ltable.UpSingular}}OneToOneSetOp{{$ftable.UpSingular}}Using{{$relAlias.Local}})
-	  {{end -}}{{- /* range to one relationships */ -}}
+	  {{end -}}{{- /* if not composite or read only */ -}}{{- end -}}{{- /* range to one relationships */ -}}
 	{{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -266,16 +333,16 @@ func TestOneToOneSet(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneRemove(t *testing.T) {
   {{- range .Tables}}
-	{{- if .IsJoinTable -}}
+	{{- if or .IsJoinTable .IsReadOnly -}}
 	{{- else -}}
-	  {{- range $rel := .ToOneRelationships -}}
+	  {{- range $rel := .ToOneRelationships -}}{{- if and (not $rel.IsComposite) (not ($.ReadOnly $rel.ForeignTable)) -}}
 		{{- if $rel.ForeignColumnNullable -}}
       {{- $ltable := $.Aliases.Table $rel.Table -}}
       {{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
       {{- $relAlias := $ftable.Relationship $rel.Name -}}
 	t.Run("{{$ltable.UpSingular}}To{{$ftable.UpSingular}}Using{{$relAlias.Local}}", test{{$ltable.UpSingular}}OneToOneRemoveOp{{$ftable.UpSingular}}Using{{$relAlias.Local}})
 		{{end -}}{{- /* if foreign column nullable */ -}}
-	  {{- end -}}{{- /* range */ -}}
+	  {{- end -}}{{- /* if not composite or read only */ -}}{{- end -}}{{- /* range */ -}}
 	{{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -284,13 +351,13 @@ func TestOneToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsReadOnly -}}
     {{- else -}}
-      {{- range $rel := .ToManyRelationships -}}
+      {{- range $rel := .ToManyRelationships -}}{{- if and (not $rel.IsComposite) (not ($.ReadOnly $rel.ForeignTable $rel.JoinTable)) -}}
         {{- $ltable := $.Aliases.Table $rel.Table -}}
         {{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
   t.Run("{{$ltable.UpSingular}}To{{$relAlias.Local}}", test{{$ltable.UpSingular}}ToManyAddOp{{$relAlias.Local}})
-      {{end -}}{{- /* range */ -}}
+      {{end -}}{{- /* if not composite or read only */ -}}{{- end -}}{{- /* range */ -}}
     {{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -299,16 +366,16 @@ func TestToManyAdd(t *testing.T) {
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsReadOnly -}}
     {{- else -}}
-      {{- range $rel := .ToManyRelationships -}}
+      {{- range $rel := .ToManyRelationships -}}{{- if and (not $rel.IsComposite) (not ($.ReadOnly $rel.ForeignTable $rel.JoinTable)) -}}
         {{- if not (or $rel.ForeignColumnNullable $rel.ToJoinTable)}}
         {{- else -}}
           {{- $ltable := $.Aliases.Table $rel.Table -}}
           {{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
   t.Run("{{$ltable.UpSingular}}To{{$relAlias.Local}}", test{{$ltable.UpSingular}}ToManySetOp{{$relAlias.Local}})
         {{end -}}{{- /* if foreign column nullable */ -}}
-      {{- end -}}{{- /* range */ -}}
+      {{- end -}}{{- /* if not composite or read only */ -}}{{- end -}}{{- /* range */ -}}
     {{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -317,23 +384,23 @@ func TestToManySet(t *testing.T) {
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsReadOnly -}}
     {{- else -}}
-      {{- range $rel := .ToManyRelationships -}}
+      {{- range $rel := .ToManyRelationships -}}{{- if and (not $rel.IsComposite) (not ($.ReadOnly $rel.ForeignTable $rel.JoinTable)) -}}
         {{- if not (or $rel.ForeignColumnNullable $rel.ToJoinTable)}}
         {{- else -}}
           {{- $ltable := $.Aliases.Table $rel.Table -}}
           {{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
   t.Run("{{$ltable.UpSingular}}To{{$relAlias.Local}}", test{{$ltable.UpSingular}}ToManyRemoveOp{{$relAlias.Local}})
         {{end -}}{{- /* if foreign column nullable */ -}}
-      {{- end -}}{{- /* range */ -}}
+      {{- end -}}{{- /* if not composite or read only */ -}}{{- end -}}{{- /* range */ -}}
     {{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
 
 func TestReload(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Reload)
@@ -343,7 +410,7 @@ func TestReload(t *testing.T) {
 
 func TestReloadAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}ReloadAll)
@@ -351,9 +418,31 @@ func TestReloadAll(t *testing.T) {
   {{- end -}}
 }
 
+func TestValidateLengths(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}ValidateLengths)
+  {{end -}}
+  {{- end -}}
+}
+
+{{if .GenerateValidate -}}
+func TestValidate(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Validate)
+  {{end -}}
+  {{- end -}}
+}
+
+{{end -}}
 func TestSelect(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Select)
@@ -361,9 +450,47 @@ func TestSelect(t *testing.T) {
   {{- end -}}
 }
 
+{{if .GenerateDTOs -}}
+func TestDTO(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}DTO)
+  {{end -}}
+  {{- end -}}
+}
+
+{{end -}}
+{{if .Projections -}}
+func TestProjections(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  {{- range $proj := $.TableProjections .Name}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}As{{$proj.Name}})
+  {{- end}}
+  {{- end -}}
+  {{- end}}
+}
+
+{{end -}}
+{{if .GenerateChangesets -}}
+func TestUpdateWithChangeset(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}UpdateWithChangeset)
+  {{end -}}
+  {{- end -}}
+}
+
+{{end -}}
 func TestUpdate(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Update)
@@ -373,7 +500,7 @@ func TestUpdate(t *testing.T) {
 
 func TestSliceUpdateAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsReadOnly -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceUpdateAll)
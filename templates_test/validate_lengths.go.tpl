@@ -0,0 +1,99 @@
+{{- $alias := .Aliases.Table .Table.Name}}
+func test{{$alias.UpPlural}}ValidateLengths(t *testing.T) {
+	t.Parallel()
+
+	o := &{{$alias.UpSingular}}{}
+	if err := o.ValidateLengths(); err != nil {
+		t.Error(err)
+	}
+	{{- range $col := .Table.Columns}}
+	{{- $max := $col.MaxLength}}
+	{{- if gt $max 0}}
+	{{- $colAlias := $alias.Column $col.Name}}
+	{{- if eq $col.Type "string"}}
+
+	o = &{{$alias.UpSingular}}{}
+	o.{{$colAlias}} = string(make([]rune, {{$max}} + 1))
+	if err := o.ValidateLengths(); err == nil {
+		t.Error("expected an error for {{$col.Name}} longer than {{$max}}")
+	}
+	{{- else if or (eq $col.Type "null.String") (eq $col.Type "sql.NullString")}}
+
+	o = &{{$alias.UpSingular}}{}
+	o.{{$colAlias}}.String = string(make([]rune, {{$max}} + 1))
+	o.{{$colAlias}}.Valid = true
+	if err := o.ValidateLengths(); err == nil {
+		t.Error("expected an error for {{$col.Name}} longer than {{$max}}")
+	}
+	{{- else if eq $col.Type "*string"}}
+
+	o = &{{$alias.UpSingular}}{}
+	o.{{$colAlias}} = new(string)
+	*o.{{$colAlias}} = string(make([]rune, {{$max}} + 1))
+	if err := o.ValidateLengths(); err == nil {
+		t.Error("expected an error for {{$col.Name}} longer than {{$max}}")
+	}
+	{{- else if eq $col.Type "[]byte"}}
+
+	o = &{{$alias.UpSingular}}{}
+	o.{{$colAlias}} = make([]byte, {{$max}} + 1)
+	if err := o.ValidateLengths(); err == nil {
+		t.Error("expected an error for {{$col.Name}} longer than {{$max}} bytes")
+	}
+	o.{{$colAlias}} = make([]byte, {{$max}})
+	if err := o.ValidateLengths(); err != nil {
+		t.Error(err)
+	}
+	{{- else if eq $col.Type "null.Bytes"}}
+
+	o = &{{$alias.UpSingular}}{}
+	o.{{$colAlias}}.Bytes = make([]byte, {{$max}} + 1)
+	o.{{$colAlias}}.Valid = true
+	if err := o.ValidateLengths(); err == nil {
+		t.Error("expected an error for {{$col.Name}} longer than {{$max}} bytes")
+	}
+	{{- else if eq $col.Type "*[]byte"}}
+
+	o = &{{$alias.UpSingular}}{}
+	o.{{$colAlias}} = new([]byte)
+	*o.{{$colAlias}} = make([]byte, {{$max}} + 1)
+	if err := o.ValidateLengths(); err == nil {
+		t.Error("expected an error for {{$col.Name}} longer than {{$max}} bytes")
+	}
+	{{- end}}
+	{{- else if and $col.IsDecimal (gt $col.Precision 0) (or (eq $col.Type "types.Decimal") (eq $col.Type "types.NullDecimal"))}}
+
+	o = &{{$alias.UpSingular}}{}
+	if err := o.{{$alias.Column $col.Name}}.Scan("{{printf "1E+%d" $col.Precision}}"); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.ValidateLengths(); err == nil {
+		t.Error("expected an error for {{$col.Name}} wider than decimal({{$col.Precision}},{{$col.Scale}})")
+	}
+	{{- end}}
+	{{- end}}
+}
+{{- if .GenerateValidate}}
+
+func test{{$alias.UpPlural}}Validate(t *testing.T) {
+	t.Parallel()
+
+	// Every required column of an empty {{$alias.UpSingular}} is reported
+	err := (&{{$alias.UpSingular}}{}).Validate()
+	reported := make(map[string]bool)
+	if errs, ok := err.(boil.ValidationErrors); ok {
+		for _, e := range errs {
+			reported[e.Error()] = true
+		}
+	} else if err != nil {
+		t.Fatalf("want boil.ValidationErrors, got %T: %s", err, err)
+	}
+	{{- range $col := filterColumnsByRequired .Table.Columns}}
+	{{- if or (eq $col.Type "string") (eq $col.Type "[]byte") (eq $col.Type "types.JSON") (eq $col.Type "time.Time") (eq $col.Type "types.Decimal")}}
+	if !reported["{{$.PkgName}}: {{$.Table.Name}}.{{$col.Name}} is required"] {
+		t.Error("want {{$col.Name}} reported as required")
+	}
+	{{- end}}
+	{{- end}}
+}
+{{- end}}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var (
+	_ driver.Valuer = Bit(false)
+	_ driver.Valuer = NullBit{}
+	_ sql.Scanner   = new(Bit)
+	_ sql.Scanner   = &NullBit{}
+)
+
+// Bit is a BIT in sql, for providers that return it as the integer 0 or 1
+// instead of a bool. It scans either representation and always holds a bool.
+//
+// Scanning any other number is an error, as is scanning a "null" value.
+type Bit bool
+
+// NullBit is the same as Bit, but allows the value to be null.
+// See documentation for Bit for more details.
+type NullBit struct {
+	Bit   Bit
+	Valid bool
+}
+
+// NewNullBit creates a new null bit
+func NewNullBit(b bool, valid bool) NullBit {
+	return NullBit{Bit: Bit(b), Valid: valid}
+}
+
+// Value implements driver.Valuer.
+func (b Bit) Value() (driver.Value, error) {
+	return bool(b), nil
+}
+
+// Scan implements sql.Scanner.
+func (b *Bit) Scan(val interface{}) error {
+	if val == nil {
+		return fmt.Errorf("null cannot be scanned into bit")
+	}
+
+	v, err := bitScan(val)
+	if err != nil {
+		return err
+	}
+
+	*b = v
+	return nil
+}
+
+// Randomize implements sqlboiler's randomize interface
+func (b *Bit) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	*b = nextInt()%2 == 1
+}
+
+// Value implements driver.Valuer.
+func (n NullBit) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Bit.Value()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullBit) Scan(val interface{}) error {
+	if val == nil {
+		n.Bit, n.Valid = false, false
+		return nil
+	}
+
+	v, err := bitScan(val)
+	if err != nil {
+		return err
+	}
+
+	n.Bit, n.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, null when not valid.
+func (n NullBit) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Bit)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NullBit) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Bit, n.Valid = false, false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.Bit); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// IsZero implements qmhelper.Nullable
+func (n NullBit) IsZero() bool {
+	return !n.Valid
+}
+
+// Randomize implements sqlboiler's randomize interface
+func (n *NullBit) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		n.Bit, n.Valid = false, false
+		return
+	}
+
+	n.Bit.Randomize(nextInt, fieldType, false)
+	n.Valid = true
+}
+
+// bitScan converts the bool, 0/1 integer or its text form the provider
+// returned into a Bit.
+func bitScan(val interface{}) (Bit, error) {
+	var i int64
+	switch t := val.(type) {
+	case bool:
+		return Bit(t), nil
+	case int64:
+		i = t
+	case []byte:
+		return bitParse(string(t))
+	case string:
+		return bitParse(t)
+	default:
+		return false, fmt.Errorf("cannot scan %T into bit", val)
+	}
+
+	if i != 0 && i != 1 {
+		return false, fmt.Errorf("bit value must be 0 or 1, got: %d", i)
+	}
+	return i == 1, nil
+}
+
+// bitParse parses the text forms of a bit, ex: 1 or true
+func bitParse(s string) (Bit, error) {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("cannot scan %q into bit", s)
+	}
+	return Bit(b), nil
+}
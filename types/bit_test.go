@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBit_Scan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   interface{}
+		Want Bit
+	}{
+		{true, true},
+		{false, false},
+		{int64(1), true},
+		{int64(0), false},
+		{[]byte("1"), true},
+		{"false", false},
+	}
+
+	for i, test := range tests {
+		var b Bit
+		if err := b.Scan(test.In); err != nil {
+			t.Errorf("%d) %+v", i, err)
+		}
+		if b != test.Want {
+			t.Errorf("%d) want: %t, got: %t", i, test.Want, b)
+		}
+	}
+
+	for i, in := range []interface{}{nil, int64(2), "yes", 1.5} {
+		var b Bit
+		if err := b.Scan(in); err == nil {
+			t.Errorf("%d) expected an error scanning %#v", i, in)
+		}
+	}
+}
+
+func TestBit_Value(t *testing.T) {
+	t.Parallel()
+
+	val, err := Bit(true).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, ok := val.(bool); !ok || !b {
+		t.Errorf("want: true, got: %#v", val)
+	}
+}
+
+func TestNullBit_Scan(t *testing.T) {
+	t.Parallel()
+
+	var n NullBit
+	if err := n.Scan(int64(1)); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || !bool(n.Bit) {
+		t.Errorf("want a valid true bit, got: %#v", n)
+	}
+
+	if err := n.Scan(false); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || bool(n.Bit) {
+		t.Errorf("want a valid false bit, got: %#v", n)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid {
+		t.Error("want an invalid bit after scanning null")
+	}
+
+	val, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val != nil {
+		t.Errorf("want nil value, got: %#v", val)
+	}
+}
+
+func TestNullBit_JSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(NewNullBit(true, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "true" {
+		t.Errorf("want: true, got: %s", b)
+	}
+
+	var n NullBit
+	if err = json.Unmarshal([]byte("null"), &n); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid {
+		t.Error("want an invalid bit")
+	}
+}
@@ -113,6 +113,20 @@ func (n NullDecimal) IsZero() bool {
 	return n.Big == nil
 }
 
+// Fits reports whether d can be stored in a decimal(precision, scale) column
+// without losing digits: at most scale digits after the decimal point and
+// precision-scale before it. Trailing zeros of the fraction aren't counted.
+// A nil decimal fits.
+func (d Decimal) Fits(precision, scale int) bool {
+	return decimalFits(d.Big, precision, scale)
+}
+
+// Fits reports whether n can be stored in a decimal(precision, scale) column,
+// see Decimal.Fits. A null decimal fits.
+func (n NullDecimal) Fits(precision, scale int) bool {
+	return decimalFits(n.Big, precision, scale)
+}
+
 // Randomize implements sqlboiler's randomize interface
 func (n *NullDecimal) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	n.Big = randomDecimal(nextInt, fieldType, shouldBeNull)
@@ -132,6 +146,22 @@ func randomDecimal(nextInt func() int64, fieldType string, shouldBeNull bool) *d
 	return random
 }
 
+func decimalFits(d *decimal.Big, precision, scale int) bool {
+	if d == nil {
+		return true
+	}
+	if !d.IsFinite() {
+		return false
+	}
+
+	// Reducing to the decimal's own precision drops trailing zeros without rounding
+	r := decimal.Context{Precision: d.Precision()}.Reduce(new(decimal.Big).Copy(d))
+	if r.Scale() > scale {
+		return false
+	}
+	return r.Precision()-r.Scale() <= precision-scale
+}
+
 func decimalValue(d *decimal.Big, canNull bool) (driver.Value, error) {
 	if canNull && d == nil {
 		return nil, nil
@@ -191,3 +191,43 @@ func TestNullDecimal_IsZero(t *testing.T) {
 		t.Error("it should not be zero")
 	}
 }
+
+func TestDecimal_Fits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Value string
+		Fits  bool
+	}{
+		{"0", true},
+		{"12345678.99", true},
+		{"-12345678.99", true},
+		{"123456789", false},
+		{"1.5", true},
+		{"1.50000", true},
+		{"1.505", false},
+		{"0.01", true},
+		{"0.001", false},
+		{"1E+7", true},
+		{"1E+8", false},
+		{"NaN", false},
+	}
+
+	for _, test := range tests {
+		d, ok := new(decimal.Big).SetString(test.Value)
+		if !ok {
+			t.Fatal("unable to parse", test.Value)
+		}
+		if got := NewDecimal(d).Fits(10, 2); got != test.Fits {
+			t.Errorf("%s: want fits %t in decimal(10,2), got %t", test.Value, test.Fits, got)
+		}
+	}
+
+	if !(Decimal{}).Fits(10, 2) || !(NullDecimal{}).Fits(10, 2) {
+		t.Error("nil decimals should fit")
+	}
+	d, _ := new(decimal.Big).SetString("1000")
+	if NewNullDecimal(d).Fits(3, 0) {
+		t.Error("1000 should not fit in decimal(3,0)")
+	}
+}
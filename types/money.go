@@ -0,0 +1,160 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ericlagergren/decimal"
+)
+
+var (
+	// MoneyScale is the number of decimal places a Money minor unit takes up,
+	// ex: 2 when amounts are counted in cents. It should be set once before
+	// any sqlboiler and then assumed to be read-only after sqlboiler's first use.
+	MoneyScale = 2
+)
+
+var (
+	_ driver.Valuer = Money(0)
+	_ driver.Valuer = NullMoney{}
+	_ sql.Scanner   = new(Money)
+	_ sql.Scanner   = &NullMoney{}
+)
+
+// Money is a DECIMAL in sql holding a currency amount, stored in memory as
+// a count of minor units (see MoneyScale) so arithmetic on it is exact.
+//
+// Scanning a value with more decimal places than MoneyScale is an error rather
+// than silently rounding, as is scanning a "null" value. JSON carries the
+// minor units as a plain integer.
+type Money int64
+
+// NullMoney is the same as Money, but allows the value to be null.
+// See documentation for Money for more details.
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+// NewNullMoney creates a new null money from minor units
+func NewNullMoney(m Money, valid bool) NullMoney {
+	return NullMoney{Money: m, Valid: valid}
+}
+
+// String formats the amount in major units, ex: 19.99
+func (m Money) String() string {
+	return decimal.New(int64(m), MoneyScale).String()
+}
+
+// Value implements driver.Valuer.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan implements sql.Scanner.
+func (m *Money) Scan(val interface{}) error {
+	if val == nil {
+		return errors.New("null cannot be scanned into money")
+	}
+
+	minor, err := moneyScan(val)
+	if err != nil {
+		return err
+	}
+
+	*m = minor
+	return nil
+}
+
+// Randomize implements sqlboiler's randomize interface
+func (m *Money) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	*m = Money(nextInt() % 10000)
+}
+
+// Value implements driver.Valuer.
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Money.Value()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullMoney) Scan(val interface{}) error {
+	if val == nil {
+		n.Money, n.Valid = 0, false
+		return nil
+	}
+
+	minor, err := moneyScan(val)
+	if err != nil {
+		return err
+	}
+
+	n.Money, n.Valid = minor, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, null when not valid.
+func (n NullMoney) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Money)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NullMoney) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Money, n.Valid = 0, false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.Money); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// IsZero implements qmhelper.Nullable
+func (n NullMoney) IsZero() bool {
+	return !n.Valid
+}
+
+// Randomize implements sqlboiler's randomize interface
+func (n *NullMoney) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		n.Money, n.Valid = 0, false
+		return
+	}
+
+	n.Money.Randomize(nextInt, fieldType, false)
+	n.Valid = true
+}
+
+// moneyScan parses a decimal from the database into minor units.
+func moneyScan(val interface{}) (Money, error) {
+	d, err := decimalScan(nil, val, false)
+	if err != nil {
+		return 0, err
+	}
+
+	d.SetScale(d.Scale() - MoneyScale)
+	if !d.IsInt() {
+		return 0, fmt.Errorf("money value has more than %d decimal places: %v", MoneyScale, val)
+	}
+
+	minor, ok := d.Int64()
+	if !ok {
+		return 0, fmt.Errorf("money value out of range: %v", val)
+	}
+
+	return Money(minor), nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMoney_Value(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   Money
+		Want string
+	}{
+		{1999, "19.99"},
+		{5, "0.05"},
+		{-120, "-1.20"},
+	}
+
+	for i, test := range tests {
+		val, err := test.In.Value()
+		if err != nil {
+			t.Errorf("%d) %+v", i, err)
+		}
+
+		if s, ok := val.(string); !ok || s != test.Want {
+			t.Errorf("%d) want: %s, got: %v", i, test.Want, val)
+		}
+	}
+}
+
+func TestMoney_Scan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   interface{}
+		Want Money
+	}{
+		{"19.99", 1999},
+		{[]byte("3.5"), 350},
+		{"12.3400", 1234},
+		{int64(7), 700},
+	}
+
+	for i, test := range tests {
+		var m Money
+		if err := m.Scan(test.In); err != nil {
+			t.Errorf("%d) %+v", i, err)
+		}
+
+		if m != test.Want {
+			t.Errorf("%d) want: %d, got: %d", i, test.Want, m)
+		}
+	}
+
+	var m Money
+	if err := m.Scan("1.234"); err == nil {
+		t.Error("it should disallow values smaller than a minor unit")
+	}
+	if err := m.Scan(nil); err == nil {
+		t.Error("it should disallow scanning from a null value")
+	}
+}
+
+func TestNullMoney(t *testing.T) {
+	t.Parallel()
+
+	var n NullMoney
+	if err := n.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	if !n.IsZero() {
+		t.Error("should be null")
+	}
+	if val, err := n.Value(); err != nil || val != nil {
+		t.Errorf("want nil value, got: %v %v", val, err)
+	}
+
+	if err := n.Scan("0.99"); err != nil {
+		t.Error(err)
+	}
+	if !n.Valid || n.Money != 99 {
+		t.Errorf("want 99 minor units, got: %#v", n)
+	}
+
+	b, err := json.Marshal(NullMoney{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "null" {
+		t.Errorf("want null, got: %s", b)
+	}
+
+	if err = json.Unmarshal([]byte("1999"), &n); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Money != 1999 {
+		t.Errorf("want 1999 minor units, got: %#v", n)
+	}
+}